@@ -14,6 +14,9 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 )
 
+// PathParamGameID is the path parameter of routes that identify a game
+const PathParamGameID = "game_id"
+
 // AuthzMiddleware -
 func (rnr *Runner) AuthzMiddleware(hc HandlerConfig, h Handle) (Handle, error) {
 	authenTypes := set.New(hc.MiddlewareConfig.AuthenTypes...)
@@ -43,6 +46,16 @@ func (rnr *Runner) AuthzMiddleware(hc HandlerConfig, h Handle) (Handle, error) {
 			return err
 		}
 
+		// Credentials limited to specific games may only be used with routes
+		// that identify one of those games
+		if len(AuthenData.GameIDs) > 0 {
+			gameID := pp.ByName(PathParamGameID)
+			if gameID == "" || !AuthenData.HasGameAccess(gameID) {
+				l.Warn("(authzmiddleware) authenticated request limited to games >%v< does not have access to handler name >%s< game >%s<", AuthenData.GameIDs, hc.Name, gameID)
+				return coreerror.NewUnauthorizedError()
+			}
+		}
+
 		// If no permissions are required, allow the request
 		if len(authzPermissions) == 0 {
 			l.Debug("(authzmiddleware) handler name >%s< requires no permissions, allowing request", hc.Name)
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/log"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+)
+
+func Test_AuthzMiddlewareGameAccess(t *testing.T) {
+	l := log.NewDefaultLogger()
+	rnr := &Runner{}
+
+	const gameID = "00000000-0000-0000-0000-000000000001"
+	const otherGameID = "00000000-0000-0000-0000-000000000002"
+
+	tests := []struct {
+		name       string
+		gameIDs    []string
+		pathParams httprouter.Params
+		expectErr  bool
+	}{
+		{
+			name:       "credential without game limits when route has a game then allowed",
+			pathParams: httprouter.Params{{Key: PathParamGameID, Value: otherGameID}},
+		},
+		{
+			name: "credential without game limits when route has no game then allowed",
+		},
+		{
+			name:       "credential limited to a game when route has that game then allowed",
+			gameIDs:    []string{gameID},
+			pathParams: httprouter.Params{{Key: PathParamGameID, Value: gameID}},
+		},
+		{
+			name:       "credential limited to a game when route has another game then unauthorized",
+			gameIDs:    []string{gameID},
+			pathParams: httprouter.Params{{Key: PathParamGameID, Value: otherGameID}},
+			expectErr:  true,
+		},
+		{
+			name:      "credential limited to a game when route has no game then unauthorized",
+			gameIDs:   []string{gameID},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			h := func(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+				called = true
+				return nil
+			}
+
+			handle, err := rnr.AuthzMiddleware(HandlerConfig{
+				MiddlewareConfig: MiddlewareConfig{
+					AuthenTypes: []AuthenticationType{AuthenticationTypeToken},
+				},
+			}, h)
+			require.NoError(t, err, "AuthzMiddleware returns without error")
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r, err = SetRequestAuthenData(l, r, AuthenData{
+				Type:    AuthenticatedTypeToken,
+				GameIDs: tt.gameIDs,
+			})
+			require.NoError(t, err, "SetRequestAuthenData returns without error")
+
+			err = handle(httptest.NewRecorder(), r, tt.pathParams, nil, l, nil, nil)
+			if tt.expectErr {
+				require.Error(t, err, "handler returns an error")
+				require.False(t, called, "handler is not called")
+				return
+			}
+			require.NoError(t, err, "handler returns without error")
+			require.True(t, called, "handler is called")
+		})
+	}
+}
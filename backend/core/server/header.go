@@ -12,9 +12,12 @@ const (
 
 const (
 	HeaderContentTypeCSV       = "text/csv"
+	HeaderContentTypeHTML      = "text/html"
 	HeaderContentTypeJSON      = "application/json"
 	HeaderContentTypePDF       = "application/pdf"
 	HeaderContentTypeXML       = "application/xml"
+	HeaderContentTypeZIP       = "application/zip"
+	HeaderContentTypeImage     = "image/*"
 	HeaderContentTypeMultipart = "multipart/form-data"
 )
 
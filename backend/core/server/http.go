@@ -216,6 +216,11 @@ func (rnr *Runner) defaultRouter(r *httprouter.Router) (*httprouter.Router, erro
 		return nil, err
 	}
 
+	r, err = rnr.registerDefaultOpenAPIRoute(r)
+	if err != nil {
+		return nil, err
+	}
+
 	for key := range rnr.HandlerConfig {
 
 		hc, err := rnr.ResolveHandlerConfig(rnr.HandlerConfig[key])
@@ -0,0 +1,652 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+)
+
+const (
+	// OpenAPIPath is the well-known route the OpenAPI document is served at
+	OpenAPIPath = "/api/v1/openapi.json"
+
+	openAPIVersion = "3.1.0"
+
+	openAPISecuritySchemeBearer = "bearerAuth"
+	openAPISecuritySchemeJWT    = "bearerJWT"
+
+	openAPISchemaErrors = "Errors"
+)
+
+// OpenAPIInfo describes the API in the generated OpenAPI document
+type OpenAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// OpenAPIDocument is an OpenAPI 3.1 document describing the documented routes
+type OpenAPIDocument struct {
+	OpenAPI    string                     `json:"openapi"`
+	Info       OpenAPIInfo                `json:"info"`
+	Paths      map[string]OpenAPIPathItem `json:"paths"`
+	Components OpenAPIComponents          `json:"components"`
+}
+
+// OpenAPIPathItem maps a lower case HTTP method to an operation
+type OpenAPIPathItem map[string]*OpenAPIOperation
+
+type OpenAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Summary     string                     `json:"summary,omitempty"`
+	Description string                     `json:"description,omitempty"`
+	Parameters  []OpenAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *OpenAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]OpenAPIResponse `json:"responses"`
+	Security    []map[string][]string      `json:"security"`
+}
+
+type OpenAPIParameter struct {
+	Name        string `json:"name"`
+	In          string `json:"in"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Schema      any    `json:"schema,omitempty"`
+}
+
+type OpenAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]OpenAPIMediaType `json:"content"`
+}
+
+type OpenAPIResponse struct {
+	Description string                      `json:"description"`
+	Headers     map[string]OpenAPIHeader    `json:"headers,omitempty"`
+	Content     map[string]OpenAPIMediaType `json:"content,omitempty"`
+}
+
+type OpenAPIHeader struct {
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Schema      any    `json:"schema,omitempty"`
+}
+
+type OpenAPIMediaType struct {
+	Schema any `json:"schema,omitempty"`
+}
+
+type OpenAPIComponents struct {
+	Schemas         map[string]any                   `json:"schemas"`
+	SecuritySchemes map[string]OpenAPISecurityScheme `json:"securitySchemes"`
+}
+
+type OpenAPISecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+// ValidateDocumentationConfig returns an error for every documented route
+// that does not describe its request and response bodies
+func ValidateDocumentationConfig(handlerConfig map[string]HandlerConfig) []error {
+	var errs []error
+
+	for _, key := range sortedKeys(handlerConfig) {
+		hc := handlerConfig[key]
+		if !hc.DocumentationConfig.Document {
+			continue
+		}
+
+		if hc.DocumentationConfig.Title == "" {
+			errs = append(errs, fmt.Errorf("handler >%s< method >%s< path >%s< is documented without a title", key, hc.Method, hc.Path))
+		}
+
+		// Actions posted without a body are allowed, replacing or patching a
+		// resource always requires one
+		if hc.Method == http.MethodPut || hc.Method == http.MethodPatch {
+			if hc.MiddlewareConfig.ValidateRequestSchema.IsEmpty() && hc.DocumentationConfig.RequestContentType == "" {
+				errs = append(errs, fmt.Errorf("handler >%s< method >%s< path >%s< is documented without a request schema", key, hc.Method, hc.Path))
+			}
+		}
+
+		if hc.Method != http.MethodDelete &&
+			hc.MiddlewareConfig.ValidateResponseSchema.IsEmpty() &&
+			hc.DocumentationConfig.ResponseContentType == "" &&
+			!hc.DocumentationConfig.NoResponseBody {
+			errs = append(errs, fmt.Errorf("handler >%s< method >%s< path >%s< is documented without a response schema", key, hc.Method, hc.Path))
+		}
+	}
+
+	return errs
+}
+
+// GenerateOpenAPIDocument generates an OpenAPI document from the documented
+// handler configuration and the JSON schemas referenced by each route
+func (rnr *Runner) GenerateOpenAPIDocument() (*OpenAPIDocument, error) {
+	l := Logger(rnr.Log, "GenerateOpenAPIDocument")
+
+	info := rnr.OpenAPIInfo
+	if info.Title == "" {
+		info.Title = "API"
+	}
+	if info.Version == "" {
+		info.Version = "1.0.0"
+	}
+
+	doc := &OpenAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info:    info,
+		Paths:   map[string]OpenAPIPathItem{},
+		Components: OpenAPIComponents{
+			Schemas: map[string]any{
+				openAPISchemaErrors: openAPIErrorsSchema(),
+			},
+			SecuritySchemes: map[string]OpenAPISecurityScheme{},
+		},
+	}
+
+	schemas := newOpenAPISchemaSet()
+
+	for _, key := range sortedKeys(rnr.HandlerConfig) {
+		hc := rnr.HandlerConfig[key]
+		if !hc.DocumentationConfig.Document {
+			continue
+		}
+
+		hc, err := rnr.ResolveHandlerConfig(hc)
+		if err != nil {
+			l.Warn("(core) failed resolving handler config >%s< >%v<", key, err)
+			return nil, err
+		}
+
+		op, err := newOpenAPIOperation(key, hc, schemas, doc.Components.SecuritySchemes)
+		if err != nil {
+			l.Warn("(core) failed documenting handler >%s< >%v<", key, err)
+			return nil, err
+		}
+
+		p := openAPIPath(hc.Path)
+		if _, ok := doc.Paths[p]; !ok {
+			doc.Paths[p] = OpenAPIPathItem{}
+		}
+		doc.Paths[p][strings.ToLower(hc.Method)] = op
+	}
+
+	for key, schema := range schemas.resolve() {
+		doc.Components.Schemas[key] = schema
+	}
+
+	return doc, nil
+}
+
+func (rnr *Runner) registerDefaultOpenAPIRoute(r *httprouter.Router) (*httprouter.Router, error) {
+	l := Logger(rnr.Log, "registerDefaultOpenAPIRoute")
+
+	doc, err := rnr.GenerateOpenAPIDocument()
+	if err != nil {
+		l.Warn("(core) failed generating OpenAPI document >%v<", err)
+		return nil, err
+	}
+
+	h, err := rnr.ApplyMiddleware(
+		HandlerConfig{
+			Path: OpenAPIPath,
+			MiddlewareConfig: MiddlewareConfig{
+				AuthenTypes: []AuthenticationType{AuthenticationTypePublic},
+			},
+		},
+		func(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+			return WriteResponse(l, w, http.StatusOK, doc)
+		},
+	)
+	if err != nil {
+		l.Warn("(core) failed default middleware >%v<", err)
+		return nil, err
+	}
+	r.GET(OpenAPIPath, h)
+
+	l.Info("(core) registered %s", OpenAPIPath)
+
+	return r, nil
+}
+
+func newOpenAPIOperation(key string, hc HandlerConfig, schemas *openAPISchemaSet, securitySchemes map[string]OpenAPISecurityScheme) (*OpenAPIOperation, error) {
+	dc := hc.DocumentationConfig
+	mc := hc.MiddlewareConfig
+
+	op := &OpenAPIOperation{
+		OperationID: key,
+		Summary:     dc.Title,
+		Description: dc.Description,
+		Responses:   map[string]OpenAPIResponse{},
+		Security:    openAPISecurity(mc.AuthenTypes, securitySchemes),
+	}
+
+	// Path parameters
+	pathParams := extractPathParams(hc.Path)
+	for _, name := range pathParams {
+		op.Parameters = append(op.Parameters, OpenAPIParameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   map[string]any{"type": "string"},
+		})
+	}
+
+	// Query parameters
+	queryParams, err := openAPIQueryParameters(hc, pathParams, schemas)
+	if err != nil {
+		return nil, err
+	}
+	op.Parameters = append(op.Parameters, queryParams...)
+
+	// Request headers
+	for _, h := range dc.RequestHeaders {
+		p := OpenAPIParameter{
+			Name:     h.Name,
+			In:       "header",
+			Required: h.Required,
+		}
+		if !h.Schema.IsEmpty() {
+			ref, err := schemas.add(h.Schema)
+			if err != nil {
+				return nil, err
+			}
+			p.Schema = ref
+		}
+		op.Parameters = append(op.Parameters, p)
+	}
+
+	// Request body
+	if !mc.ValidateRequestSchema.IsEmpty() {
+		ref, err := schemas.add(mc.ValidateRequestSchema)
+		if err != nil {
+			return nil, err
+		}
+		op.RequestBody = &OpenAPIRequestBody{
+			Required: true,
+			Content: map[string]OpenAPIMediaType{
+				HeaderContentTypeJSON: {Schema: ref},
+			},
+		}
+	} else if dc.RequestContentType != "" {
+		op.RequestBody = &OpenAPIRequestBody{
+			Required: true,
+			Content: map[string]OpenAPIMediaType{
+				dc.RequestContentType: {},
+			},
+		}
+	}
+
+	// Success response
+	res := OpenAPIResponse{
+		Description: "Success",
+		Headers:     map[string]OpenAPIHeader{},
+	}
+	switch {
+	case !mc.ValidateResponseSchema.IsEmpty():
+		ref, err := schemas.add(mc.ValidateResponseSchema)
+		if err != nil {
+			return nil, err
+		}
+		res.Content = map[string]OpenAPIMediaType{
+			HeaderContentTypeJSON: {Schema: ref},
+		}
+	case dc.ResponseContentType != "":
+		res.Content = map[string]OpenAPIMediaType{
+			dc.ResponseContentType: {},
+		}
+	}
+
+	for _, h := range dc.ResponseHeaders {
+		header := OpenAPIHeader{
+			Required: h.Required,
+		}
+		if !h.Schema.IsEmpty() {
+			ref, err := schemas.add(h.Schema)
+			if err != nil {
+				return nil, err
+			}
+			header.Schema = ref
+		}
+		res.Headers[h.Name] = header
+	}
+
+	if dc.Collection {
+		res.Headers[HeaderXPagination] = OpenAPIHeader{
+			Description: `Pagination state of the collection, for example {"has_more":true}`,
+			Schema:      map[string]any{"type": "string"},
+		}
+	}
+
+	status := "2XX"
+	if hc.Method == http.MethodDelete && res.Content == nil {
+		status = "204"
+		res.Description = "No content"
+	}
+	op.Responses[status] = res
+
+	// Error response
+	op.Responses["default"] = OpenAPIResponse{
+		Description: "Error",
+		Content: map[string]OpenAPIMediaType{
+			HeaderContentTypeJSON: {Schema: openAPIComponentRef(openAPISchemaErrors)},
+		},
+	}
+
+	return op, nil
+}
+
+// openAPIQueryParameters documents query parameters from the route parameter
+// schema or the query parameter type, and the pagination parameters every
+// collection accepts
+func openAPIQueryParameters(hc HandlerConfig, pathParams []string, schemas *openAPISchemaSet) ([]OpenAPIParameter, error) {
+	var params []OpenAPIParameter
+
+	exclude := map[string]bool{}
+	for _, name := range pathParams {
+		exclude[name] = true
+	}
+
+	add := func(p OpenAPIParameter) {
+		if exclude[p.Name] {
+			return
+		}
+		exclude[p.Name] = true
+		params = append(params, p)
+	}
+
+	if vpc := hc.MiddlewareConfig.ValidateParamsConfig; vpc != nil {
+		if !vpc.Schema.IsEmpty() {
+			key, schema, err := schemas.load(vpc.Schema)
+			if err != nil {
+				return nil, err
+			}
+
+			required := map[string]bool{}
+			if req, ok := schema["required"].([]any); ok {
+				for _, name := range req {
+					if s, ok := name.(string); ok {
+						required[s] = true
+					}
+				}
+			}
+
+			properties, _ := schema["properties"].(map[string]any)
+			for _, name := range sortedKeys(properties) {
+				add(OpenAPIParameter{
+					Name:     name,
+					In:       "query",
+					Required: required[name],
+					Schema:   openAPIComponentRef(key + "/properties/" + openAPIEscapePointer(name)),
+				})
+			}
+		}
+
+		if vpc.QueryParams != nil {
+			types := jsonschema.CreateJSONTypeMap(vpc.QueryParams)
+			for _, name := range sortedKeys(types) {
+				t := types[name]
+				schema := map[string]any{"type": t.ElemType}
+				if t.IsArray {
+					schema = map[string]any{"type": "array", "items": schema}
+				}
+				add(OpenAPIParameter{
+					Name:   name,
+					In:     "query",
+					Schema: schema,
+				})
+			}
+		}
+	}
+
+	if hc.DocumentationConfig.Collection {
+		add(OpenAPIParameter{
+			Name:        queryparam.PageNumber,
+			In:          "query",
+			Description: "Page number, starting at 1",
+			Schema:      map[string]any{"type": "integer", "minimum": 1},
+		})
+		add(OpenAPIParameter{
+			Name:        queryparam.PageSize,
+			In:          "query",
+			Description: "Number of records per page",
+			Schema:      map[string]any{"type": "integer", "minimum": 1},
+		})
+		add(OpenAPIParameter{
+			Name:        queryparam.SortColumnKey,
+			In:          "query",
+			Description: "Property names to sort by, prefix a name with - to sort in descending order",
+			Schema:      map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+		})
+	}
+
+	return params, nil
+}
+
+// openAPISecurity maps route authentication types to security requirements,
+// adding the security schemes used to the document
+func openAPISecurity(authenTypes []AuthenticationType, securitySchemes map[string]OpenAPISecurityScheme) []map[string][]string {
+	security := []map[string][]string{}
+
+	for _, authenType := range authenTypes {
+		switch authenType {
+		case AuthenticationTypeToken, AuthenticationTypeOptionalToken:
+			securitySchemes[openAPISecuritySchemeBearer] = OpenAPISecurityScheme{
+				Type:        "http",
+				Scheme:      "bearer",
+				Description: "Session token or personal API token",
+			}
+			if authenType == AuthenticationTypeOptionalToken {
+				security = append(security, map[string][]string{})
+			}
+			security = append(security, map[string][]string{openAPISecuritySchemeBearer: {}})
+		case AuthenticationTypeJWT:
+			securitySchemes[openAPISecuritySchemeJWT] = OpenAPISecurityScheme{
+				Type:         "http",
+				Scheme:       "bearer",
+				BearerFormat: "JWT",
+			}
+			security = append(security, map[string][]string{openAPISecuritySchemeJWT: {}})
+		}
+	}
+
+	return security
+}
+
+func openAPIErrorsSchema() map[string]any {
+	return map[string]any{
+		"type": "array",
+		"items": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"code":             map[string]any{"type": "string"},
+				"message":          map[string]any{"type": "string"},
+				"validationErrors": map[string]any{"type": "array"},
+				"context":          map[string]any{},
+			},
+			"required": []string{"code", "message"},
+		},
+	}
+}
+
+// openAPIPath converts a router path with :param placeholders to an OpenAPI
+// path with {param} placeholders
+func openAPIPath(p string) string {
+	parts := strings.Split(p, "/")
+	for i, part := range parts {
+		if strings.HasPrefix(part, ":") {
+			parts[i] = "{" + strings.TrimPrefix(part, ":") + "}"
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+func openAPIComponentRef(key string) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + key}
+}
+
+// openAPIEscapePointer escapes a JSON pointer reference token
+func openAPIEscapePointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+// openAPISchemaSet collects the JSON schema files referenced by documented
+// routes so each file is added to the document components once
+type openAPISchemaSet struct {
+	schemas map[string]map[string]any
+	// ids maps a schema $id to its component key
+	ids map[string]string
+	// keyIDs maps a component key to its schema $id
+	keyIDs map[string]string
+}
+
+func newOpenAPISchemaSet() *openAPISchemaSet {
+	return &openAPISchemaSet{
+		schemas: map[string]map[string]any{},
+		ids:     map[string]string{},
+		keyIDs:  map[string]string{},
+	}
+}
+
+// add loads a main schema with its references and returns a reference to the
+// main schema component
+func (s *openAPISchemaSet) add(swr jsonschema.SchemaWithReferences) (map[string]any, error) {
+	key, _, err := s.load(swr)
+	if err != nil {
+		return nil, err
+	}
+	return openAPIComponentRef(key), nil
+}
+
+// load loads a main schema with its references and returns the main schema
+// component key and content
+func (s *openAPISchemaSet) load(swr jsonschema.SchemaWithReferences) (string, map[string]any, error) {
+	for _, ref := range swr.References {
+		if _, _, err := s.loadSchema(ref); err != nil {
+			return "", nil, err
+		}
+	}
+	return s.loadSchema(swr.Main)
+}
+
+func (s *openAPISchemaSet) loadSchema(schema jsonschema.Schema) (string, map[string]any, error) {
+	key := openAPISchemaKey(schema)
+	if content, ok := s.schemas[key]; ok {
+		return key, content, nil
+	}
+
+	b, err := os.ReadFile(schema.GetFullPath())
+	if err != nil {
+		return "", nil, fmt.Errorf("failed reading schema file >%s< err >%w<", schema.GetFullPath(), err)
+	}
+
+	var content map[string]any
+	if err := json.Unmarshal(b, &content); err != nil {
+		return "", nil, fmt.Errorf("failed parsing schema file >%s< err >%w<", schema.GetFullPath(), err)
+	}
+
+	if id, ok := content["$id"].(string); ok {
+		s.ids[id] = key
+		s.keyIDs[key] = id
+	}
+	s.schemas[key] = content
+
+	return key, content, nil
+}
+
+// resolve returns the loaded schemas with schema $id references replaced by
+// references to document components
+func (s *openAPISchemaSet) resolve() map[string]any {
+	resolved := map[string]any{}
+	for key, content := range s.schemas {
+		c := s.resolveRefs(key, content).(map[string]any)
+		delete(c, "$schema")
+		delete(c, "$id")
+		resolved[key] = c
+	}
+	return resolved
+}
+
+func (s *openAPISchemaSet) resolveRefs(key string, v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		c := make(map[string]any, len(t))
+		for k, val := range t {
+			if ref, ok := val.(string); ok && k == "$ref" {
+				c[k] = s.resolveRef(key, ref)
+				continue
+			}
+			c[k] = s.resolveRefs(key, val)
+		}
+		return c
+	case []any:
+		c := make([]any, len(t))
+		for i := range t {
+			c[i] = s.resolveRefs(key, t[i])
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+func (s *openAPISchemaSet) resolveRef(key, ref string) string {
+	base, fragment, _ := strings.Cut(ref, "#")
+	if base == "" {
+		return "#/components/schemas/" + key + fragment
+	}
+	if refKey, ok := s.ids[base]; ok {
+		return "#/components/schemas/" + refKey + fragment
+	}
+
+	// Relative references resolve against the $id of the referencing schema
+	id, err := url.Parse(s.keyIDs[key])
+	if err != nil {
+		return ref
+	}
+	rel, err := url.Parse(base)
+	if err != nil {
+		return ref
+	}
+	if refKey, ok := s.ids[id.ResolveReference(rel).String()]; ok {
+		return "#/components/schemas/" + refKey + fragment
+	}
+
+	return ref
+}
+
+// openAPISchemaKey returns the component key for a schema file, for example
+// "account_schema.account.response" for "api/account_schema/account.response.schema.json"
+func openAPISchemaKey(schema jsonschema.Schema) string {
+	name := strings.TrimSuffix(strings.TrimSuffix(schema.Name, ".json"), ".schema")
+	location := path.Base(schema.Location)
+	if location == "." || location == "/" || location == "" {
+		return name
+	}
+	return location + "." + name
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,248 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/config"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/log"
+)
+
+func testOpenAPISchema() jsonschema.SchemaWithReferences {
+	return jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Name: "test.main.schema.json",
+		},
+		References: []jsonschema.Schema{
+			{
+				Name: "test.data.schema.json",
+			},
+		},
+	}
+}
+
+func Test_GenerateOpenAPIDocument(t *testing.T) {
+	cwd, err := os.Getwd()
+	require.NoError(t, err, "Getwd returns without error")
+
+	rnr, err := NewRunner(config.Config{SchemaPath: fmt.Sprintf("%s/testdata", cwd)}, log.NewDefaultLogger(), nil, nil)
+	require.NoError(t, err, "NewRunner returns without error")
+
+	rnr.OpenAPIInfo = OpenAPIInfo{Title: "Test API", Version: "1.2.3"}
+	rnr.HandlerConfig = map[string]HandlerConfig{
+		"get-many-things": {
+			Method: http.MethodGet,
+			Path:   "/api/v1/things",
+			MiddlewareConfig: MiddlewareConfig{
+				AuthenTypes:            []AuthenticationType{AuthenticationTypeToken},
+				ValidateResponseSchema: testOpenAPISchema(),
+			},
+			DocumentationConfig: DocumentationConfig{
+				Document:   true,
+				Collection: true,
+				Title:      "Get things",
+			},
+		},
+		"create-one-thing-part": {
+			Method: http.MethodPost,
+			Path:   "/api/v1/things/:thing_id/parts",
+			MiddlewareConfig: MiddlewareConfig{
+				AuthenTypes:            []AuthenticationType{AuthenticationTypeToken},
+				ValidateRequestSchema:  testOpenAPISchema(),
+				ValidateResponseSchema: testOpenAPISchema(),
+			},
+			DocumentationConfig: DocumentationConfig{
+				Document: true,
+				Title:    "Create thing part",
+			},
+		},
+		"get-one-thing-sheet": {
+			Method: http.MethodGet,
+			Path:   "/api/v1/things/:thing_id/sheet",
+			MiddlewareConfig: MiddlewareConfig{
+				AuthenTypes: []AuthenticationType{AuthenticationTypePublic},
+			},
+			DocumentationConfig: DocumentationConfig{
+				Document:            true,
+				Title:               "Get thing sheet",
+				ResponseContentType: HeaderContentTypePDF,
+			},
+		},
+		"delete-one-thing": {
+			Method: http.MethodDelete,
+			Path:   "/api/v1/things/:thing_id",
+			MiddlewareConfig: MiddlewareConfig{
+				AuthenTypes: []AuthenticationType{AuthenticationTypeToken},
+			},
+			DocumentationConfig: DocumentationConfig{
+				Document: true,
+				Title:    "Delete thing",
+			},
+		},
+		"undocumented-thing": {
+			Method: http.MethodGet,
+			Path:   "/api/v1/undocumented",
+			MiddlewareConfig: MiddlewareConfig{
+				AuthenTypes: []AuthenticationType{AuthenticationTypePublic},
+			},
+		},
+	}
+
+	doc, err := rnr.GenerateOpenAPIDocument()
+	require.NoError(t, err, "GenerateOpenAPIDocument returns without error")
+
+	require.Equal(t, "3.1.0", doc.OpenAPI, "document is OpenAPI 3.1")
+	require.Equal(t, "Test API", doc.Info.Title, "document has the configured title")
+	require.NotContains(t, doc.Paths, "/api/v1/undocumented", "undocumented route is not included")
+
+	// Collection route
+	op := doc.Paths["/api/v1/things"]["get"]
+	require.NotNil(t, op, "collection route is documented")
+	require.Equal(t, "get-many-things", op.OperationID, "operation ID is the handler name")
+	require.Equal(t, []map[string][]string{{openAPISecuritySchemeBearer: {}}}, op.Security, "token route requires a bearer token")
+	require.Equal(t, map[string]any{"$ref": "#/components/schemas/test.main"}, op.Responses["2XX"].Content[HeaderContentTypeJSON].Schema, "response references the main schema component")
+	require.Contains(t, op.Responses["2XX"].Headers, HeaderXPagination, "collection response documents the pagination header")
+	var queryParams []string
+	for _, p := range op.Parameters {
+		queryParams = append(queryParams, p.Name)
+	}
+	require.Equal(t, []string{"page_number", "page_size", "sort_column"}, queryParams, "collection route documents pagination parameters")
+
+	// Route with a path parameter and request body
+	op = doc.Paths["/api/v1/things/{thing_id}/parts"]["post"]
+	require.NotNil(t, op, "path parameters are converted to OpenAPI placeholders")
+	require.Equal(t, "thing_id", op.Parameters[0].Name, "path parameter is documented")
+	require.Equal(t, "path", op.Parameters[0].In, "path parameter is in the path")
+	require.True(t, op.Parameters[0].Required, "path parameter is required")
+	require.NotNil(t, op.RequestBody, "request body is documented")
+	require.Equal(t, map[string]any{"$ref": "#/components/schemas/test.main"}, op.RequestBody.Content[HeaderContentTypeJSON].Schema, "request references the main schema component")
+
+	// Public route with a binary response
+	op = doc.Paths["/api/v1/things/{thing_id}/sheet"]["get"]
+	require.NotNil(t, op, "binary route is documented")
+	require.Empty(t, op.Security, "public route requires no security")
+	require.Contains(t, op.Responses["2XX"].Content, HeaderContentTypePDF, "response is documented with its media type")
+
+	// Route without a response body
+	op = doc.Paths["/api/v1/things/{thing_id}"]["delete"]
+	require.NotNil(t, op, "delete route is documented")
+	require.Contains(t, op.Responses, "204", "delete route has no content response")
+
+	// Schema components
+	require.Contains(t, doc.Components.Schemas, "test.main", "main schema is a component")
+	require.Contains(t, doc.Components.Schemas, "test.data", "reference schema is a component")
+	require.Contains(t, doc.Components.SecuritySchemes, openAPISecuritySchemeBearer, "bearer security scheme is defined")
+
+	main := doc.Components.Schemas["test.main"].(map[string]any)
+	require.Equal(t, "#/components/schemas/test.data", main["$ref"], "schema $id references are replaced with component references")
+	require.NotContains(t, main, "$id", "schema $id is removed")
+	require.NotContains(t, main, "$schema", "schema $schema is removed")
+
+	_, err = json.Marshal(doc)
+	require.NoError(t, err, "document marshals without error")
+}
+
+func Test_ValidateDocumentationConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		hc        HandlerConfig
+		expectErr bool
+	}{
+		{
+			name: "documented route with a response schema then valid",
+			hc: HandlerConfig{
+				Method:           http.MethodGet,
+				Path:             "/api/v1/things",
+				MiddlewareConfig: MiddlewareConfig{ValidateResponseSchema: testOpenAPISchema()},
+				DocumentationConfig: DocumentationConfig{
+					Document: true,
+					Title:    "Get things",
+				},
+			},
+		},
+		{
+			name: "documented route with a binary response then valid",
+			hc: HandlerConfig{
+				Method: http.MethodGet,
+				Path:   "/api/v1/things/:thing_id/sheet",
+				DocumentationConfig: DocumentationConfig{
+					Document:            true,
+					Title:               "Get thing sheet",
+					ResponseContentType: HeaderContentTypePDF,
+				},
+			},
+		},
+		{
+			name: "documented delete route without a response schema then valid",
+			hc: HandlerConfig{
+				Method: http.MethodDelete,
+				Path:   "/api/v1/things/:thing_id",
+				DocumentationConfig: DocumentationConfig{
+					Document: true,
+					Title:    "Delete thing",
+				},
+			},
+		},
+		{
+			name: "undocumented route without schemas then valid",
+			hc: HandlerConfig{
+				Method: http.MethodGet,
+				Path:   "/api/v1/undocumented",
+			},
+		},
+		{
+			name: "documented route without a response schema then invalid",
+			hc: HandlerConfig{
+				Method: http.MethodGet,
+				Path:   "/api/v1/things",
+				DocumentationConfig: DocumentationConfig{
+					Document: true,
+					Title:    "Get things",
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "documented update route without a request schema then invalid",
+			hc: HandlerConfig{
+				Method:           http.MethodPut,
+				Path:             "/api/v1/things/:thing_id",
+				MiddlewareConfig: MiddlewareConfig{ValidateResponseSchema: testOpenAPISchema()},
+				DocumentationConfig: DocumentationConfig{
+					Document: true,
+					Title:    "Update thing",
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "documented route without a title then invalid",
+			hc: HandlerConfig{
+				Method:           http.MethodGet,
+				Path:             "/api/v1/things",
+				MiddlewareConfig: MiddlewareConfig{ValidateResponseSchema: testOpenAPISchema()},
+				DocumentationConfig: DocumentationConfig{
+					Document: true,
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateDocumentationConfig(map[string]HandlerConfig{"test": tt.hc})
+			if tt.expectErr {
+				require.NotEmpty(t, errs, "ValidateDocumentationConfig returns errors")
+				return
+			}
+			require.Empty(t, errs, "ValidateDocumentationConfig returns no errors")
+		})
+	}
+}
@@ -87,6 +87,9 @@ type Runner struct {
 
 	// AuthenticateRequestFunc authenticates a request based on the authentication type
 	AuthenticateRequestFunc func(l logger.Logger, m domainer.Domainer, r *http.Request, authType AuthenticationType) (AuthenData, error)
+
+	// OpenAPIInfo describes the API in the OpenAPI document served at OpenAPIPath
+	OpenAPIInfo OpenAPIInfo
 }
 
 type HTTPCORSConfig struct {
@@ -120,6 +123,9 @@ type AuthenData struct {
 	Type        AuthenticatedType        `json:"type"`
 	AccountUser AuthenticatedAccountUser `json:"account_user"`
 	Permissions []AuthorizedPermission   `json:"permissions"`
+	SessionID   string                   `json:"session_id,omitempty"`   // Session record ID when authenticated with a session token
+	APITokenID  string                   `json:"api_token_id,omitempty"` // API token record ID when authenticated with an API token
+	GameIDs     []string                 `json:"game_ids,omitempty"`     // Games the credential is limited to, any game when empty
 }
 
 func (a AuthenData) IsAuthenticated() bool {
@@ -136,6 +142,20 @@ func (a AuthenData) HasPermission(permission AuthorizedPermission) bool {
 	return false
 }
 
+// HasGameAccess checks if the credential the request was authenticated with
+// may be used with a game
+func (a AuthenData) HasGameAccess(gameID string) bool {
+	if len(a.GameIDs) == 0 {
+		return true
+	}
+	for _, id := range a.GameIDs {
+		if id == gameID {
+			return true
+		}
+	}
+	return false
+}
+
 type AuthenticatedType string
 
 const (
@@ -202,6 +222,14 @@ type DocumentationConfig struct {
 	Description     string // used for API doc endpoint description
 	RequestHeaders  []Header
 	ResponseHeaders []Header
+	// RequestContentType is the media type of a request body that is not JSON,
+	// for example multipart/form-data
+	RequestContentType string
+	// ResponseContentType is the media type of a response body that is not
+	// JSON, for example application/pdf
+	ResponseContentType string
+	// NoResponseBody is set when a successful response has no body
+	NoResponseBody bool
 }
 
 type Header struct {
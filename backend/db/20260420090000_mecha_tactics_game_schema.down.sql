@@ -0,0 +1,18 @@
+-- Mecha Tactics Schema Rollback
+
+BEGIN;
+
+ALTER TABLE public.game DROP CONSTRAINT IF EXISTS game_type_check;
+ALTER TABLE public.game ADD CONSTRAINT game_type_check
+    CHECK (game_type IN ('adventure', 'mecha'));
+
+DROP TABLE IF EXISTS public.mecha_tactics_game_turn_sheet;
+DROP TABLE IF EXISTS public.mecha_tactics_game_mech_instance;
+DROP TABLE IF EXISTS public.mecha_tactics_game_mech;
+DROP TABLE IF EXISTS public.mecha_tactics_game_computer_opponent;
+DROP TABLE IF EXISTS public.mecha_tactics_game_hex;
+DROP TABLE IF EXISTS public.mecha_tactics_game_terrain_type;
+DROP TABLE IF EXISTS public.mecha_tactics_game_weapon;
+DROP TABLE IF EXISTS public.mecha_tactics_game_chassis;
+
+COMMIT;
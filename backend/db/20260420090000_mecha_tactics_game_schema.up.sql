@@ -0,0 +1,201 @@
+-- Mecha Tactics Schema Migration
+--
+-- Mecha tactics is individual mech combat on a hex grid. There are no
+-- squads; each runtime mech is owned directly by a player subscription
+-- instance or a computer opponent. The hex map is not copied per game
+-- instance, mech instances reference the design hex they occupy.
+
+BEGIN;
+
+-- ============================================================================
+-- MECHA TACTICS DESIGN-TIME TABLES
+-- ============================================================================
+
+CREATE TABLE public.mecha_tactics_game_chassis (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    chassis_class VARCHAR(20) NOT NULL DEFAULT 'medium',
+    armor_points INTEGER NOT NULL DEFAULT 100,
+    structure_points INTEGER NOT NULL DEFAULT 50,
+    heat_capacity INTEGER NOT NULL DEFAULT 30,
+    speed INTEGER NOT NULL DEFAULT 4,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT mecha_tactics_game_chassis_game_id_fkey FOREIGN KEY (game_id) REFERENCES public.game(id),
+    CONSTRAINT mecha_tactics_game_chassis_class_check CHECK (chassis_class IN ('light', 'medium', 'heavy', 'assault')),
+    CONSTRAINT mecha_tactics_game_chassis_armor_points_check CHECK (armor_points > 0),
+    CONSTRAINT mecha_tactics_game_chassis_structure_points_check CHECK (structure_points > 0),
+    CONSTRAINT mecha_tactics_game_chassis_heat_capacity_check CHECK (heat_capacity > 0),
+    CONSTRAINT mecha_tactics_game_chassis_speed_check CHECK (speed > 0)
+);
+CREATE INDEX idx_mecha_tactics_game_chassis_game_id ON public.mecha_tactics_game_chassis(game_id);
+COMMENT ON TABLE public.mecha_tactics_game_chassis IS 'Mech body blueprints. speed is the movement point budget per turn.';
+
+CREATE TABLE public.mecha_tactics_game_weapon (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    damage INTEGER NOT NULL DEFAULT 5,
+    heat_cost INTEGER NOT NULL DEFAULT 3,
+    range_band VARCHAR(20) NOT NULL DEFAULT 'medium',
+    mount_size VARCHAR(20) NOT NULL DEFAULT 'medium',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT mecha_tactics_game_weapon_game_id_fkey FOREIGN KEY (game_id) REFERENCES public.game(id),
+    CONSTRAINT mecha_tactics_game_weapon_range_band_check CHECK (range_band IN ('short', 'medium', 'long')),
+    CONSTRAINT mecha_tactics_game_weapon_mount_size_check CHECK (mount_size IN ('small', 'medium', 'large')),
+    CONSTRAINT mecha_tactics_game_weapon_damage_check CHECK (damage > 0),
+    CONSTRAINT mecha_tactics_game_weapon_heat_cost_check CHECK (heat_cost >= 0)
+);
+CREATE INDEX idx_mecha_tactics_game_weapon_game_id ON public.mecha_tactics_game_weapon(game_id);
+COMMENT ON TABLE public.mecha_tactics_game_weapon IS 'Weapon definitions used in mech loadouts and weapon swaps.';
+
+CREATE TABLE public.mecha_tactics_game_terrain_type (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    movement_point_cost INTEGER NOT NULL DEFAULT 1,
+    cover_modifier INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT mecha_tactics_game_terrain_type_game_id_fkey FOREIGN KEY (game_id) REFERENCES public.game(id),
+    CONSTRAINT mecha_tactics_game_terrain_type_movement_point_cost_check CHECK (movement_point_cost > 0)
+);
+CREATE INDEX idx_mecha_tactics_game_terrain_type_game_id ON public.mecha_tactics_game_terrain_type(game_id);
+COMMENT ON TABLE public.mecha_tactics_game_terrain_type IS 'Designer-defined terrain with movement point cost and cover modifier (negative is harder to hit).';
+
+CREATE TABLE public.mecha_tactics_game_hex (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL,
+    mecha_tactics_game_terrain_type_id UUID NOT NULL,
+    hex_column INTEGER NOT NULL,
+    hex_row INTEGER NOT NULL,
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    elevation INTEGER NOT NULL DEFAULT 0,
+    is_starting_hex BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT mecha_tactics_game_hex_game_id_fkey FOREIGN KEY (game_id) REFERENCES public.game(id),
+    CONSTRAINT mecha_tactics_game_hex_terrain_type_id_fkey FOREIGN KEY (mecha_tactics_game_terrain_type_id) REFERENCES public.mecha_tactics_game_terrain_type(id)
+);
+CREATE UNIQUE INDEX idx_mecha_tactics_game_hex_coordinates ON public.mecha_tactics_game_hex(game_id, hex_column, hex_row) WHERE deleted_at IS NULL;
+CREATE INDEX idx_mecha_tactics_game_hex_terrain_type_id ON public.mecha_tactics_game_hex(mecha_tactics_game_terrain_type_id);
+CREATE INDEX idx_mecha_tactics_game_hex_is_starting ON public.mecha_tactics_game_hex(is_starting_hex) WHERE is_starting_hex = true;
+COMMENT ON TABLE public.mecha_tactics_game_hex IS 'Battlefield cells at axial (column, row) coordinates. Adjacency is derived from coordinates. Starting hexes are depots.';
+
+CREATE TABLE public.mecha_tactics_game_computer_opponent (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    aggression INTEGER NOT NULL DEFAULT 5,
+    iq INTEGER NOT NULL DEFAULT 5,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT mecha_tactics_game_computer_opponent_game_id_fkey FOREIGN KEY (game_id) REFERENCES public.game(id),
+    CONSTRAINT mecha_tactics_game_computer_opponent_aggression_check CHECK (aggression BETWEEN 1 AND 10),
+    CONSTRAINT mecha_tactics_game_computer_opponent_iq_check CHECK (iq BETWEEN 1 AND 10)
+);
+CREATE INDEX idx_mecha_tactics_game_computer_opponent_game_id ON public.mecha_tactics_game_computer_opponent(game_id);
+COMMENT ON TABLE public.mecha_tactics_game_computer_opponent IS 'AI behaviour profiles for computer-controlled mechs.';
+
+CREATE TABLE public.mecha_tactics_game_mech (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL,
+    mecha_tactics_game_chassis_id UUID NOT NULL,
+    mech_type VARCHAR(20) NOT NULL DEFAULT 'starter',
+    callsign VARCHAR(50) NOT NULL,
+    weapon_config JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT mecha_tactics_game_mech_game_id_fkey FOREIGN KEY (game_id) REFERENCES public.game(id),
+    CONSTRAINT mecha_tactics_game_mech_chassis_id_fkey FOREIGN KEY (mecha_tactics_game_chassis_id) REFERENCES public.mecha_tactics_game_chassis(id),
+    CONSTRAINT mecha_tactics_game_mech_type_check CHECK (mech_type IN ('starter', 'opponent'))
+);
+CREATE INDEX idx_mecha_tactics_game_mech_game_id ON public.mecha_tactics_game_mech(game_id);
+CREATE INDEX idx_mecha_tactics_game_mech_chassis_id ON public.mecha_tactics_game_mech(mecha_tactics_game_chassis_id);
+COMMENT ON TABLE public.mecha_tactics_game_mech IS 'Mech templates. starter mechs are issued to players, opponent mechs to computer opponents. weapon_config is a JSONB array of weapon_id + slot_location pairs.';
+
+-- ============================================================================
+-- MECHA TACTICS RUNTIME TABLES
+-- ============================================================================
+
+CREATE TABLE public.mecha_tactics_game_mech_instance (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL,
+    game_instance_id UUID NOT NULL,
+    mecha_tactics_game_mech_id UUID NOT NULL,
+    game_subscription_instance_id UUID,
+    mecha_tactics_game_computer_opponent_id UUID,
+    mecha_tactics_game_chassis_id UUID NOT NULL,
+    mecha_tactics_game_hex_id UUID NOT NULL,
+    facing INTEGER NOT NULL DEFAULT 0,
+    callsign VARCHAR(50) NOT NULL,
+    current_armor INTEGER NOT NULL DEFAULT 100,
+    current_structure INTEGER NOT NULL DEFAULT 50,
+    current_heat INTEGER NOT NULL DEFAULT 0,
+    pilot_skill INTEGER NOT NULL DEFAULT 4,
+    status VARCHAR(20) NOT NULL DEFAULT 'operational',
+    weapon_config JSONB NOT NULL DEFAULT '[]'::jsonb,
+    supply_points INTEGER NOT NULL DEFAULT 0,
+    is_repairing BOOLEAN NOT NULL DEFAULT false,
+    last_turn_events JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT mecha_tactics_game_mech_instance_status_check CHECK (status IN ('operational', 'damaged', 'destroyed', 'shutdown')),
+    CONSTRAINT mecha_tactics_game_mech_instance_facing_check CHECK (facing BETWEEN 0 AND 5),
+    CONSTRAINT mecha_tactics_game_mech_instance_supply_points_check CHECK (supply_points >= 0),
+    CONSTRAINT mecha_tactics_game_mech_instance_owner_check CHECK (
+        (game_subscription_instance_id IS NOT NULL AND mecha_tactics_game_computer_opponent_id IS NULL) OR
+        (game_subscription_instance_id IS NULL AND mecha_tactics_game_computer_opponent_id IS NOT NULL)
+    ),
+    CONSTRAINT mecha_tactics_game_mech_instance_game_id_fkey FOREIGN KEY (game_id) REFERENCES public.game(id),
+    CONSTRAINT mecha_tactics_game_mech_instance_game_instance_id_fkey FOREIGN KEY (game_instance_id) REFERENCES public.game_instance(id),
+    CONSTRAINT mecha_tactics_game_mech_instance_mech_id_fkey FOREIGN KEY (mecha_tactics_game_mech_id) REFERENCES public.mecha_tactics_game_mech(id),
+    CONSTRAINT mecha_tactics_game_mech_instance_subscription_instance_id_fkey FOREIGN KEY (game_subscription_instance_id) REFERENCES public.game_subscription_instance(id),
+    CONSTRAINT mecha_tactics_game_mech_instance_computer_opponent_id_fkey FOREIGN KEY (mecha_tactics_game_computer_opponent_id) REFERENCES public.mecha_tactics_game_computer_opponent(id),
+    CONSTRAINT mecha_tactics_game_mech_instance_chassis_id_fkey FOREIGN KEY (mecha_tactics_game_chassis_id) REFERENCES public.mecha_tactics_game_chassis(id),
+    CONSTRAINT mecha_tactics_game_mech_instance_hex_id_fkey FOREIGN KEY (mecha_tactics_game_hex_id) REFERENCES public.mecha_tactics_game_hex(id)
+);
+CREATE INDEX idx_mecha_tactics_game_mech_instance_game_instance ON public.mecha_tactics_game_mech_instance(game_instance_id);
+CREATE INDEX idx_mecha_tactics_game_mech_instance_subscription_instance ON public.mecha_tactics_game_mech_instance(game_subscription_instance_id);
+CREATE INDEX idx_mecha_tactics_game_mech_instance_hex ON public.mecha_tactics_game_mech_instance(mecha_tactics_game_hex_id);
+COMMENT ON TABLE public.mecha_tactics_game_mech_instance IS 'Runtime mech state including position, facing, current combat stats and supply.';
+
+CREATE TABLE public.mecha_tactics_game_turn_sheet (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL,
+    mecha_tactics_game_mech_instance_id UUID NOT NULL,
+    game_turn_sheet_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT mecha_tactics_game_turn_sheet_unique UNIQUE (mecha_tactics_game_mech_instance_id, game_turn_sheet_id),
+    CONSTRAINT mecha_tactics_game_turn_sheet_game_id_fkey FOREIGN KEY (game_id) REFERENCES public.game(id),
+    CONSTRAINT mecha_tactics_game_turn_sheet_mech_instance_id_fkey FOREIGN KEY (mecha_tactics_game_mech_instance_id) REFERENCES public.mecha_tactics_game_mech_instance(id),
+    CONSTRAINT mecha_tactics_game_turn_sheet_game_turn_sheet_id_fkey FOREIGN KEY (game_turn_sheet_id) REFERENCES public.game_turn_sheet(id)
+);
+CREATE INDEX idx_mecha_tactics_game_turn_sheet_mech_instance ON public.mecha_tactics_game_turn_sheet(mecha_tactics_game_mech_instance_id);
+COMMENT ON TABLE public.mecha_tactics_game_turn_sheet IS 'Links game turn sheets to the mech instance they were issued for.';
+
+-- ============================================================================
+-- GAME TYPE
+-- ============================================================================
+
+ALTER TABLE public.game DROP CONSTRAINT IF EXISTS game_type_check;
+ALTER TABLE public.game ADD CONSTRAINT game_type_check
+    CHECK (game_type IN ('adventure', 'mecha', 'mecha_tactics'));
+
+COMMIT;
@@ -0,0 +1,44 @@
+-- Physical turn sheet print batches.
+--
+-- After turn processing creates turn sheets, a print batch is recorded for
+-- every game instance with physical post or local delivery enabled. The
+-- batch worker renders a cover/address sheet followed by each turn sheet for
+-- every post/local player, collated per player, and submits the result to
+-- the configured print device as a single job. The printer job state is
+-- polled until the job reaches a final state.
+--
+-- A reprint creates a new batch for the same turn, optionally limited to a
+-- single player via game_subscription_instance_id.
+BEGIN;
+
+CREATE TABLE public.game_print_batch (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL,
+    game_instance_id UUID NOT NULL,
+    game_subscription_instance_id UUID,
+    turn_number INTEGER NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    player_count INTEGER NOT NULL DEFAULT 0,
+    document_count INTEGER NOT NULL DEFAULT 0,
+    printer_job_id VARCHAR(50),
+    printer_job_state VARCHAR(30),
+    printer_job_state_message TEXT,
+    error_message TEXT,
+    submitted_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT game_print_batch_game_id_fkey FOREIGN KEY (game_id) REFERENCES public.game(id),
+    CONSTRAINT game_print_batch_game_instance_id_fkey FOREIGN KEY (game_instance_id) REFERENCES public.game_instance(id),
+    CONSTRAINT game_print_batch_game_subscription_instance_id_fkey FOREIGN KEY (game_subscription_instance_id) REFERENCES public.game_subscription_instance(id),
+    CONSTRAINT game_print_batch_status_check CHECK (status IN ('pending', 'submitted', 'completed', 'failed')),
+    CONSTRAINT game_print_batch_turn_number_check CHECK (turn_number >= 0),
+    CONSTRAINT game_print_batch_player_count_check CHECK (player_count >= 0),
+    CONSTRAINT game_print_batch_document_count_check CHECK (document_count >= 0)
+);
+CREATE INDEX idx_game_print_batch_game_instance_turn ON public.game_print_batch(game_instance_id, turn_number);
+CREATE INDEX idx_game_print_batch_status ON public.game_print_batch(status);
+COMMENT ON TABLE public.game_print_batch IS 'Per-turn print batch of turn sheets for physical post and local delivery players, tracked through to printer job completion.';
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE public.adventure_game_character_instance
+    DROP CONSTRAINT IF EXISTS adventure_game_character_instance_lives_lost_check,
+    DROP COLUMN IF EXISTS retired_at_turn,
+    DROP COLUMN IF EXISTS lives_lost;
+
+COMMIT;
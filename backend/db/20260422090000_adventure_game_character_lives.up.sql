@@ -0,0 +1,12 @@
+BEGIN;
+
+-- Track lives lost and retirement for character instances. A character whose
+-- health reaches zero loses a life and respawns at a starting location until
+-- the game's character_lives parameter is exhausted, at which point the
+-- character is retired (NULL = still adventuring).
+ALTER TABLE public.adventure_game_character_instance
+    ADD COLUMN lives_lost INT NOT NULL DEFAULT 0,
+    ADD COLUMN retired_at_turn INT,
+    ADD CONSTRAINT adventure_game_character_instance_lives_lost_check CHECK (lives_lost >= 0);
+
+COMMIT;
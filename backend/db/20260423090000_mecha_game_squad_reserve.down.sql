@@ -0,0 +1,19 @@
+BEGIN;
+
+DROP INDEX IF EXISTS public.idx_mecha_game_squad_reserve_unique;
+
+DELETE FROM public.mecha_game_squad_mech
+    WHERE mecha_game_squad_id IN (
+        SELECT id FROM public.mecha_game_squad WHERE squad_type = 'reserve'
+    );
+
+DELETE FROM public.mecha_game_squad
+    WHERE squad_type = 'reserve';
+
+ALTER TABLE public.mecha_game_squad
+    DROP CONSTRAINT IF EXISTS mecha_game_squad_type_check;
+
+ALTER TABLE public.mecha_game_squad
+    ADD CONSTRAINT mecha_game_squad_type_check CHECK (squad_type IN ('starter', 'opponent'));
+
+COMMIT;
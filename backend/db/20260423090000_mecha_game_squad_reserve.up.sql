@@ -0,0 +1,17 @@
+BEGIN;
+
+-- Add a 'reserve' squad type. A reserve squad is a designer-defined pool of
+-- mechs used to pad player squads up to the run's squad_size parameter when
+-- the starter squad holds fewer mechs than required.
+ALTER TABLE public.mecha_game_squad
+    DROP CONSTRAINT IF EXISTS mecha_game_squad_type_check;
+
+ALTER TABLE public.mecha_game_squad
+    ADD CONSTRAINT mecha_game_squad_type_check CHECK (squad_type IN ('starter', 'opponent', 'reserve'));
+
+-- At most one reserve squad per game
+CREATE UNIQUE INDEX idx_mecha_game_squad_reserve_unique
+    ON public.mecha_game_squad (game_id)
+    WHERE squad_type = 'reserve' AND deleted_at IS NULL;
+
+COMMIT;
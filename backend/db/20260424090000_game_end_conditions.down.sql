@@ -0,0 +1,23 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.game_instance_result;
+
+ALTER TABLE public.mecha_game_sector_instance
+    DROP CONSTRAINT IF EXISTS mecha_game_sector_instance_controlling_squad_instance_id_fkey,
+    DROP COLUMN IF EXISTS controlled_since_turn,
+    DROP COLUMN IF EXISTS controlling_squad_instance_id;
+
+ALTER TABLE public.mecha_game_sector
+    DROP COLUMN IF EXISTS is_objective_sector;
+
+ALTER TABLE public.adventure_game_item
+    DROP COLUMN IF EXISTS is_goal_item;
+
+ALTER TABLE public.adventure_game_location
+    DROP COLUMN IF EXISTS is_goal_location;
+
+ALTER TABLE public.game_instance
+    DROP CONSTRAINT IF EXISTS game_instance_max_turns_check,
+    DROP COLUMN IF EXISTS max_turns;
+
+COMMIT;
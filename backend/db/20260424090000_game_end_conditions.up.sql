@@ -0,0 +1,65 @@
+-- Game end conditions and run results.
+--
+-- After each turn the run's end conditions are evaluated: a run-level
+-- max_turns limit common to all game types, plus game type specific victory
+-- checks. Adventure games end when a character reaches a goal location,
+-- holds a goal item, or every character has been retired. Mecha games end
+-- when only one squad has mechs left standing or a squad has held an
+-- objective sector for the required number of turns.
+--
+-- When a run ends the placing of every player is recorded in
+-- game_instance_result and each player is sent a final results turn sheet.
+BEGIN;
+
+ALTER TABLE public.game_instance
+    ADD COLUMN max_turns INTEGER,
+    ADD CONSTRAINT game_instance_max_turns_check CHECK (max_turns IS NULL OR max_turns >= 1);
+
+ALTER TABLE public.adventure_game_location
+    ADD COLUMN is_goal_location BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE public.adventure_game_item
+    ADD COLUMN is_goal_item BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE public.mecha_game_sector
+    ADD COLUMN is_objective_sector BOOLEAN NOT NULL DEFAULT false;
+
+-- The squad instance currently holding a sector uncontested and the turn it
+-- took control (NULL = uncontrolled).
+ALTER TABLE public.mecha_game_sector_instance
+    ADD COLUMN controlling_squad_instance_id UUID,
+    ADD COLUMN controlled_since_turn INTEGER,
+    ADD CONSTRAINT mecha_game_sector_instance_controlling_squad_instance_id_fkey
+        FOREIGN KEY (controlling_squad_instance_id) REFERENCES public.mecha_game_squad_instance(id);
+
+CREATE TABLE public.game_instance_result (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL,
+    game_instance_id UUID NOT NULL,
+    game_subscription_instance_id UUID NOT NULL,
+    placing INTEGER NOT NULL,
+    is_winner BOOLEAN NOT NULL DEFAULT false,
+    end_condition VARCHAR(50) NOT NULL,
+    summary TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT game_instance_result_game_id_fkey FOREIGN KEY (game_id) REFERENCES public.game(id),
+    CONSTRAINT game_instance_result_game_instance_id_fkey FOREIGN KEY (game_instance_id) REFERENCES public.game_instance(id),
+    CONSTRAINT game_instance_result_game_subscription_instance_id_fkey FOREIGN KEY (game_subscription_instance_id) REFERENCES public.game_subscription_instance(id),
+    CONSTRAINT game_instance_result_placing_check CHECK (placing >= 1),
+    CONSTRAINT game_instance_result_end_condition_check CHECK (end_condition IN (
+        'max_turns',
+        'goal_location_reached',
+        'goal_item_held',
+        'all_characters_retired',
+        'last_squad_standing',
+        'objective_held'
+    ))
+);
+CREATE UNIQUE INDEX idx_game_instance_result_subscription_instance_unique
+    ON public.game_instance_result(game_instance_id, game_subscription_instance_id)
+    WHERE deleted_at IS NULL;
+COMMENT ON TABLE public.game_instance_result IS 'Final placing of each player when a game instance completes.';
+
+COMMIT;
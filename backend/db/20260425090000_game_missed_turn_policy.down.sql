@@ -0,0 +1,17 @@
+BEGIN;
+
+ALTER TABLE public.game_subscription_instance
+    DROP CONSTRAINT IF EXISTS game_subscription_instance_missed_turns_check,
+    DROP COLUMN IF EXISTS dropped_at,
+    DROP COLUMN IF EXISTS missed_turns,
+    DROP COLUMN IF EXISTS standing_orders;
+
+ALTER TABLE public.game_instance
+    DROP CONSTRAINT IF EXISTS game_instance_missed_turn_drop_after_check,
+    DROP CONSTRAINT IF EXISTS game_instance_missed_turn_takeover_after_check,
+    DROP CONSTRAINT IF EXISTS game_instance_missed_turn_policy_check,
+    DROP COLUMN IF EXISTS missed_turn_drop_after,
+    DROP COLUMN IF EXISTS missed_turn_takeover_after,
+    DROP COLUMN IF EXISTS missed_turn_policy;
+
+COMMIT;
@@ -0,0 +1,28 @@
+-- Standing orders and missed turn (no moves received) policy.
+--
+-- Managers choose how a run handles players who do not submit a turn sheet
+-- by the turn deadline: the player idles, or the standing orders they left
+-- for that sheet type are carried out. Independently, after a number of
+-- consecutive missed turns the computer can take command of the player, and
+-- after a further number the player can be dropped from the run.
+--
+-- Players keep their standing orders and consecutive missed turn count on
+-- their game subscription instance.
+BEGIN;
+
+ALTER TABLE public.game_instance
+    ADD COLUMN missed_turn_policy VARCHAR(50) NOT NULL DEFAULT 'idle',
+    ADD COLUMN missed_turn_takeover_after INTEGER,
+    ADD COLUMN missed_turn_drop_after INTEGER,
+    ADD CONSTRAINT game_instance_missed_turn_policy_check CHECK (missed_turn_policy IN ('idle', 'standing_orders')),
+    ADD CONSTRAINT game_instance_missed_turn_takeover_after_check CHECK (missed_turn_takeover_after IS NULL OR missed_turn_takeover_after >= 1),
+    ADD CONSTRAINT game_instance_missed_turn_drop_after_check CHECK (missed_turn_drop_after IS NULL OR missed_turn_drop_after >= 1);
+
+-- Standing orders are a JSON object of turn sheet type to standing order.
+ALTER TABLE public.game_subscription_instance
+    ADD COLUMN standing_orders JSONB NOT NULL DEFAULT '{}'::jsonb,
+    ADD COLUMN missed_turns INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN dropped_at TIMESTAMPTZ,
+    ADD CONSTRAINT game_subscription_instance_missed_turns_check CHECK (missed_turns >= 0);
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE public.game_subscription_instance
+    DROP COLUMN IF EXISTS turn_reminder_hours,
+    DROP COLUMN IF EXISTS turn_reminder_turn;
+
+ALTER TABLE public.account
+    DROP COLUMN IF EXISTS turn_reminder_opt_out;
+
+COMMIT;
@@ -0,0 +1,18 @@
+-- Turn deadline reminder emails.
+--
+-- Players with turn sheets still to submit are emailed a reminder at each
+-- configured number of hours before the turn deadline. Players can opt out
+-- of reminders on their account.
+--
+-- The game subscription instance records the turn and the hours before the
+-- deadline of the last reminder sent so each reminder is only sent once.
+BEGIN;
+
+ALTER TABLE public.account
+    ADD COLUMN turn_reminder_opt_out BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE public.game_subscription_instance
+    ADD COLUMN turn_reminder_turn INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN turn_reminder_hours INTEGER NOT NULL DEFAULT 0;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.game_scan_batch_page;
+DROP TABLE IF EXISTS public.game_scan_batch;
+
+COMMIT;
@@ -0,0 +1,63 @@
+-- Bulk scanned turn sheet batches.
+--
+-- A manager uploads a multi-page PDF, a ZIP of page images or a single page
+-- image of returned paper turn sheets. The scan batch worker splits the
+-- document into pages, reads the turn sheet code from each page to find the
+-- turn sheet it belongs to, scans the page and submits the turn sheet. The
+-- outcome of every page is recorded so the manager can follow progress and
+-- deal with pages that could not be matched.
+--
+-- The uploaded document is only kept until it has been split into pages, and
+-- each page image is only kept until the page has been processed.
+BEGIN;
+
+CREATE TABLE public.game_scan_batch (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL,
+    game_instance_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    file_name VARCHAR(255) NOT NULL,
+    document_data BYTEA,
+    page_count INTEGER NOT NULL DEFAULT 0,
+    pages_processed INTEGER NOT NULL DEFAULT 0,
+    matched_count INTEGER NOT NULL DEFAULT 0,
+    duplicate_count INTEGER NOT NULL DEFAULT 0,
+    unreadable_count INTEGER NOT NULL DEFAULT 0,
+    wrong_turn_count INTEGER NOT NULL DEFAULT 0,
+    wrong_game_count INTEGER NOT NULL DEFAULT 0,
+    error_message TEXT,
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT game_scan_batch_game_id_fkey FOREIGN KEY (game_id) REFERENCES public.game(id),
+    CONSTRAINT game_scan_batch_game_instance_id_fkey FOREIGN KEY (game_instance_id) REFERENCES public.game_instance(id),
+    CONSTRAINT game_scan_batch_status_check CHECK (status IN ('pending', 'processing', 'completed', 'failed')),
+    CONSTRAINT game_scan_batch_page_count_check CHECK (page_count >= 0),
+    CONSTRAINT game_scan_batch_pages_processed_check CHECK (pages_processed >= 0 AND pages_processed <= page_count)
+);
+CREATE INDEX idx_game_scan_batch_game_instance ON public.game_scan_batch(game_instance_id);
+COMMENT ON TABLE public.game_scan_batch IS 'Bulk upload of scanned turn sheets for a game instance, split into pages and routed to turn sheets.';
+
+CREATE TABLE public.game_scan_batch_page (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_scan_batch_id UUID NOT NULL,
+    page_number INTEGER NOT NULL,
+    source VARCHAR(255) NOT NULL,
+    image_data BYTEA,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    game_turn_sheet_id UUID,
+    sheet_type VARCHAR(50),
+    message TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT game_scan_batch_page_game_scan_batch_id_fkey FOREIGN KEY (game_scan_batch_id) REFERENCES public.game_scan_batch(id),
+    CONSTRAINT game_scan_batch_page_game_turn_sheet_id_fkey FOREIGN KEY (game_turn_sheet_id) REFERENCES public.game_turn_sheet(id),
+    CONSTRAINT game_scan_batch_page_status_check CHECK (status IN ('pending', 'matched', 'duplicate', 'unreadable', 'wrong_turn', 'wrong_game')),
+    CONSTRAINT game_scan_batch_page_page_number_check CHECK (page_number > 0),
+    CONSTRAINT game_scan_batch_page_number_unique UNIQUE (game_scan_batch_id, page_number)
+);
+COMMENT ON TABLE public.game_scan_batch_page IS 'Outcome of routing a single page of a scan batch to a turn sheet.';
+
+COMMIT;
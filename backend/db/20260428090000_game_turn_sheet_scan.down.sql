@@ -0,0 +1,11 @@
+BEGIN;
+
+UPDATE public.game_turn_sheet SET processing_status = 'error' WHERE processing_status = 'review';
+
+ALTER TABLE public.game_turn_sheet
+    DROP CONSTRAINT game_turn_sheet_processing_status_check,
+    ADD CONSTRAINT game_turn_sheet_processing_status_check CHECK (processing_status IN ('pending', 'processed', 'error'));
+
+DROP TABLE IF EXISTS public.game_turn_sheet_scan;
+
+COMMIT;
@@ -0,0 +1,35 @@
+-- Retained turn sheet scans and the manager review queue.
+--
+-- The image a turn sheet was scanned from is kept with the result of checking
+-- the scanned data: a confidence score and the issues found, such as a turn
+-- sheet code that could not be read, scanned data that does not match the
+-- scanned data schema or a choice that was not offered on the turn sheet.
+--
+-- Turn sheets with issues are held with a processing status of review until a
+-- manager has compared the scanned data with the image, corrected it where
+-- needed and approved it.
+BEGIN;
+
+CREATE TABLE public.game_turn_sheet_scan (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_turn_sheet_id UUID NOT NULL,
+    image_data BYTEA NOT NULL,
+    confidence DOUBLE PRECISION NOT NULL DEFAULT 1,
+    issues JSONB NOT NULL DEFAULT '[]',
+    reviewed_by_account_user_id UUID,
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT game_turn_sheet_scan_game_turn_sheet_id_fkey FOREIGN KEY (game_turn_sheet_id) REFERENCES public.game_turn_sheet(id),
+    CONSTRAINT game_turn_sheet_scan_reviewed_by_account_user_id_fkey FOREIGN KEY (reviewed_by_account_user_id) REFERENCES public.account_user(id),
+    CONSTRAINT game_turn_sheet_scan_confidence_check CHECK (confidence >= 0 AND confidence <= 1),
+    CONSTRAINT game_turn_sheet_scan_game_turn_sheet_id_unique UNIQUE (game_turn_sheet_id)
+);
+COMMENT ON TABLE public.game_turn_sheet_scan IS 'Scanned image of a turn sheet with the confidence and issues found checking the scanned data.';
+
+ALTER TABLE public.game_turn_sheet
+    DROP CONSTRAINT game_turn_sheet_processing_status_check,
+    ADD CONSTRAINT game_turn_sheet_processing_status_check CHECK (processing_status IN ('pending', 'processed', 'review', 'error'));
+
+COMMIT;
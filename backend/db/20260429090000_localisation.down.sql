@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.game_translation;
+
+ALTER TABLE public.account
+    DROP COLUMN IF EXISTS locale;
+
+COMMIT;
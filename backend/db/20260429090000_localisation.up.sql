@@ -0,0 +1,35 @@
+-- Localised turn sheets and emails.
+--
+-- Accounts choose the language their turn sheets and emails are written in.
+-- Accounts without a locale receive English.
+--
+-- Game designers may translate the text of their game content into other
+-- languages so one game can be run for players in several languages. A
+-- translation replaces a single text field of a single game record, such as
+-- the name of an adventure game location, for players using that locale.
+-- Content without a translation is shown as the designer wrote it.
+BEGIN;
+
+ALTER TABLE public.account
+    ADD COLUMN locale VARCHAR(16);
+
+COMMENT ON COLUMN public.account.locale IS 'Language turn sheets and emails are written in, defaults to English when not set.';
+
+CREATE TABLE public.game_translation (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    game_id UUID NOT NULL,
+    locale VARCHAR(16) NOT NULL,
+    record_id UUID NOT NULL,
+    field_name VARCHAR(64) NOT NULL,
+    text TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT game_translation_game_id_fkey FOREIGN KEY (game_id) REFERENCES public.game(id)
+);
+CREATE UNIQUE INDEX idx_game_translation_unique ON public.game_translation(game_id, locale, record_id, field_name) WHERE deleted_at IS NULL;
+COMMENT ON TABLE public.game_translation IS 'Designer supplied translation of a text field of a game content record.';
+COMMENT ON COLUMN public.game_translation.record_id IS 'The game content record the translated field belongs to, such as an adventure game location.';
+COMMENT ON COLUMN public.game_translation.field_name IS 'The translated field of the record, such as name or description.';
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE public.account_user
+    ADD COLUMN session_token TEXT,
+    ADD COLUMN session_token_expires_at TIMESTAMPTZ;
+
+DROP TABLE IF EXISTS public.account_user_session;
+
+COMMIT;
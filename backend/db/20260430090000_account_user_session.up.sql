@@ -0,0 +1,35 @@
+-- Multi-device sessions.
+--
+-- Account users previously held a single session token, so signing in on one
+-- device signed the user out of every other device. Each sign-in now creates
+-- its own session so a user may stay signed in on several devices, see where
+-- they are signed in and revoke sessions they no longer trust.
+BEGIN;
+
+CREATE TABLE public.account_user_session (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL,
+    account_user_id UUID NOT NULL,
+    session_token TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    user_agent TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT account_user_session_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id),
+    CONSTRAINT account_user_session_account_user_id_fkey FOREIGN KEY (account_user_id) REFERENCES public.account_user(id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX idx_account_user_session_session_token ON public.account_user_session(session_token);
+CREATE INDEX idx_account_user_session_account_user_id ON public.account_user_session(account_user_id);
+COMMENT ON TABLE public.account_user_session IS 'A signed in device or client of an account user.';
+COMMENT ON COLUMN public.account_user_session.session_token IS 'HMAC hash of the session token issued to the device, the token itself is never stored.';
+COMMENT ON COLUMN public.account_user_session.expires_at IS 'When the session expires, extended each time the session is used.';
+COMMENT ON COLUMN public.account_user_session.last_seen_at IS 'When the session was last used.';
+COMMENT ON COLUMN public.account_user_session.user_agent IS 'User agent of the device the session was signed in from.';
+
+ALTER TABLE public.account_user
+    DROP COLUMN session_token,
+    DROP COLUMN session_token_expires_at;
+
+COMMIT;
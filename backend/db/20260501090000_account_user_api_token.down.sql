@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS public.account_user_api_token;
+
+COMMIT;
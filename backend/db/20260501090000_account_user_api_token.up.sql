@@ -0,0 +1,39 @@
+-- Personal API tokens.
+--
+-- Account users may create long lived API tokens for scripting against the
+-- designer, manager and player APIs. Each token is limited to a subset of the
+-- account user's permissions and may be further limited to specific games.
+BEGIN;
+
+CREATE TABLE public.account_user_api_token (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL,
+    account_user_id UUID NOT NULL,
+    name VARCHAR(128) NOT NULL,
+    token_prefix VARCHAR(16) NOT NULL,
+    token_hash TEXT NOT NULL,
+    permissions TEXT[] NOT NULL,
+    game_ids UUID[] NOT NULL DEFAULT ARRAY[]::UUID[],
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT account_user_api_token_account_id_fkey FOREIGN KEY (account_id) REFERENCES public.account(id),
+    CONSTRAINT account_user_api_token_account_user_id_fkey FOREIGN KEY (account_user_id) REFERENCES public.account_user(id) ON DELETE CASCADE,
+    CONSTRAINT account_user_api_token_permissions_check CHECK (
+        cardinality(permissions) > 0
+        AND permissions <@ ARRAY['game_design', 'game_management', 'game_playing']::TEXT[]
+    )
+);
+CREATE UNIQUE INDEX idx_account_user_api_token_token_hash ON public.account_user_api_token(token_hash);
+CREATE INDEX idx_account_user_api_token_account_user_id ON public.account_user_api_token(account_user_id);
+COMMENT ON TABLE public.account_user_api_token IS 'A long lived API token created by an account user for automation.';
+COMMENT ON COLUMN public.account_user_api_token.token_prefix IS 'Leading characters of the token so a user can tell their tokens apart.';
+COMMENT ON COLUMN public.account_user_api_token.token_hash IS 'HMAC hash of the token, the token itself is never stored.';
+COMMENT ON COLUMN public.account_user_api_token.permissions IS 'Permissions the token grants, limited to the permissions of the account user when the token is used.';
+COMMENT ON COLUMN public.account_user_api_token.game_ids IS 'Games the token is limited to, or empty when the token may be used with any game.';
+COMMENT ON COLUMN public.account_user_api_token.expires_at IS 'When the token expires, or null when the token does not expire.';
+COMMENT ON COLUMN public.account_user_api_token.last_used_at IS 'When the token was last used.';
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+ALTER TABLE public.mecha_game_squad_instance
+    DROP COLUMN IF EXISTS contacts;
+
+DELETE FROM public.mecha_game_equipment
+    WHERE effect_kind = 'sensor';
+
+ALTER TABLE public.mecha_game_equipment
+    DROP CONSTRAINT IF EXISTS mecha_game_equipment_effect_kind_check;
+
+ALTER TABLE public.mecha_game_equipment
+    ADD CONSTRAINT mecha_game_equipment_effect_kind_check CHECK (effect_kind IN (
+        'heat_sink', 'targeting_computer', 'armor_upgrade', 'jump_jets', 'ecm', 'ammo_bin'
+    ));
+
+ALTER TABLE public.mecha_game_chassis
+    DROP CONSTRAINT IF EXISTS mecha_game_chassis_sensor_range_check,
+    DROP COLUMN IF EXISTS sensor_range;
+
+COMMIT;
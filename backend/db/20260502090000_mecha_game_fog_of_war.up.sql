@@ -0,0 +1,24 @@
+BEGIN;
+
+-- Fog of war. Each chassis has a sensor range in sector hops; sensor
+-- equipment extends it. A squad only sees enemy mechs inside the range of
+-- at least one of its mechs, less the concealment of the enemy's terrain.
+ALTER TABLE public.mecha_game_chassis
+    ADD COLUMN sensor_range INTEGER NOT NULL DEFAULT 2,
+    ADD CONSTRAINT mecha_game_chassis_sensor_range_check CHECK (sensor_range BETWEEN 0 AND 5);
+
+ALTER TABLE public.mecha_game_equipment
+    DROP CONSTRAINT IF EXISTS mecha_game_equipment_effect_kind_check;
+
+ALTER TABLE public.mecha_game_equipment
+    ADD CONSTRAINT mecha_game_equipment_effect_kind_check CHECK (effect_kind IN (
+        'heat_sink', 'targeting_computer', 'armor_upgrade', 'jump_jets', 'ecm', 'ammo_bin', 'sensor'
+    ));
+
+-- Enemy mechs a squad has detected, with the sector each was last seen in.
+ALTER TABLE public.mecha_game_squad_instance
+    ADD COLUMN contacts JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+COMMENT ON COLUMN public.mecha_game_squad_instance.contacts IS 'Enemy mechs this squad has detected and where each was last seen.';
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+ALTER TABLE public.mecha_game_squad_instance
+    DROP COLUMN IF EXISTS salvage;
+
+ALTER TABLE public.mecha_game_mech_instance
+    DROP CONSTRAINT IF EXISTS mecha_game_mech_instance_destroyed_by_squad_instance_id_fkey,
+    DROP CONSTRAINT IF EXISTS mecha_game_mech_instance_mecha_game_campaign_mech_id_fkey,
+    DROP COLUMN IF EXISTS destroyed_by_squad_instance_id,
+    DROP COLUMN IF EXISTS mecha_game_campaign_mech_id;
+
+DROP TABLE IF EXISTS public.mecha_game_campaign_mech;
+DROP TABLE IF EXISTS public.mecha_game_campaign_squad;
+DROP TABLE IF EXISTS public.mecha_game_campaign_instance;
+DROP TABLE IF EXISTS public.mecha_game_campaign;
+
+COMMIT;
@@ -0,0 +1,113 @@
+-- Persistent mecha campaigns.
+--
+-- A campaign is a manager-owned sequence of mecha game instances (battles),
+-- which may use different games and maps. Each player's squad roster is kept
+-- between battles: surviving mechs with their pilot experience, damage and
+-- loadout, the squad's supply points, and weapons salvaged from enemy mechs
+-- the squad destroyed. When a battle completes its survivors are written back
+-- to the roster; when the next battle starts the roster is deployed first and
+-- topped up from the starter squad.
+--
+-- Chassis, weapons and equipment are carried by name so a roster can move
+-- between games that share a catalog.
+BEGIN;
+
+CREATE TABLE public.mecha_game_campaign (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_user_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT mecha_game_campaign_account_user_id_fkey FOREIGN KEY (account_user_id) REFERENCES public.account_user(id)
+);
+CREATE INDEX idx_mecha_game_campaign_account_user ON public.mecha_game_campaign(account_user_id);
+COMMENT ON TABLE public.mecha_game_campaign IS 'Manager-owned sequence of mecha game instances that carries player squads from one battle to the next.';
+
+CREATE TABLE public.mecha_game_campaign_instance (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    mecha_game_campaign_id UUID NOT NULL,
+    game_instance_id UUID NOT NULL,
+    sequence_number INTEGER NOT NULL,
+    recorded_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT mecha_game_campaign_instance_mecha_game_campaign_id_fkey FOREIGN KEY (mecha_game_campaign_id) REFERENCES public.mecha_game_campaign(id),
+    CONSTRAINT mecha_game_campaign_instance_game_instance_id_fkey FOREIGN KEY (game_instance_id) REFERENCES public.game_instance(id),
+    CONSTRAINT mecha_game_campaign_instance_sequence_number_check CHECK (sequence_number >= 1)
+);
+CREATE UNIQUE INDEX idx_mecha_game_campaign_instance_game_instance_unique
+    ON public.mecha_game_campaign_instance(game_instance_id)
+    WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX idx_mecha_game_campaign_instance_sequence_unique
+    ON public.mecha_game_campaign_instance(mecha_game_campaign_id, sequence_number)
+    WHERE deleted_at IS NULL;
+COMMENT ON TABLE public.mecha_game_campaign_instance IS 'A battle in a mecha campaign. A game instance belongs to at most one campaign.';
+COMMENT ON COLUMN public.mecha_game_campaign_instance.recorded_at IS 'When the battle outcome was written back to the campaign squad rosters (NULL = not yet recorded).';
+
+CREATE TABLE public.mecha_game_campaign_squad (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    mecha_game_campaign_id UUID NOT NULL,
+    account_user_id UUID NOT NULL,
+    supply_points INTEGER NOT NULL DEFAULT 0,
+    salvage JSONB NOT NULL DEFAULT '[]'::jsonb,
+    battles_fought INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT mecha_game_campaign_squad_mecha_game_campaign_id_fkey FOREIGN KEY (mecha_game_campaign_id) REFERENCES public.mecha_game_campaign(id),
+    CONSTRAINT mecha_game_campaign_squad_account_user_id_fkey FOREIGN KEY (account_user_id) REFERENCES public.account_user(id),
+    CONSTRAINT mecha_game_campaign_squad_supply_points_check CHECK (supply_points >= 0),
+    CONSTRAINT mecha_game_campaign_squad_battles_fought_check CHECK (battles_fought >= 0)
+);
+CREATE UNIQUE INDEX idx_mecha_game_campaign_squad_account_user_unique
+    ON public.mecha_game_campaign_squad(mecha_game_campaign_id, account_user_id)
+    WHERE deleted_at IS NULL;
+COMMENT ON TABLE public.mecha_game_campaign_squad IS 'A player''s squad roster carried between the battles of a mecha campaign.';
+COMMENT ON COLUMN public.mecha_game_campaign_squad.salvage IS 'Weapons salvaged from destroyed enemy mechs, by weapon name and quantity.';
+
+CREATE TABLE public.mecha_game_campaign_mech (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    mecha_game_campaign_squad_id UUID NOT NULL,
+    callsign VARCHAR(50) NOT NULL,
+    chassis_name VARCHAR(100) NOT NULL,
+    current_armor INTEGER NOT NULL DEFAULT 0,
+    current_structure INTEGER NOT NULL DEFAULT 0,
+    pilot_skill INTEGER NOT NULL DEFAULT 0,
+    experience_points INTEGER NOT NULL DEFAULT 0,
+    weapon_config JSONB NOT NULL DEFAULT '[]'::jsonb,
+    equipment_config JSONB NOT NULL DEFAULT '[]'::jsonb,
+    battles_survived INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT mecha_game_campaign_mech_mecha_game_campaign_squad_id_fkey FOREIGN KEY (mecha_game_campaign_squad_id) REFERENCES public.mecha_game_campaign_squad(id),
+    CONSTRAINT mecha_game_campaign_mech_current_armor_check CHECK (current_armor >= 0),
+    CONSTRAINT mecha_game_campaign_mech_current_structure_check CHECK (current_structure >= 1),
+    CONSTRAINT mecha_game_campaign_mech_experience_points_check CHECK (experience_points >= 0),
+    CONSTRAINT mecha_game_campaign_mech_battles_survived_check CHECK (battles_survived >= 0)
+);
+CREATE INDEX idx_mecha_game_campaign_mech_campaign_squad ON public.mecha_game_campaign_mech(mecha_game_campaign_squad_id);
+COMMENT ON TABLE public.mecha_game_campaign_mech IS 'A surviving mech on a campaign squad roster. Chassis, weapons and equipment are held by name.';
+COMMENT ON COLUMN public.mecha_game_campaign_mech.weapon_config IS 'Mounted weapons as [{slot_location, weapon_name}].';
+COMMENT ON COLUMN public.mecha_game_campaign_mech.equipment_config IS 'Mounted equipment as [{slot_location, equipment_name}].';
+
+-- The roster mech a mech instance was deployed from (NULL = fresh from the
+-- starter squad), and the squad whose fire destroyed it.
+ALTER TABLE public.mecha_game_mech_instance
+    ADD COLUMN mecha_game_campaign_mech_id UUID,
+    ADD COLUMN destroyed_by_squad_instance_id UUID,
+    ADD CONSTRAINT mecha_game_mech_instance_mecha_game_campaign_mech_id_fkey
+        FOREIGN KEY (mecha_game_campaign_mech_id) REFERENCES public.mecha_game_campaign_mech(id),
+    ADD CONSTRAINT mecha_game_mech_instance_destroyed_by_squad_instance_id_fkey
+        FOREIGN KEY (destroyed_by_squad_instance_id) REFERENCES public.mecha_game_squad_instance(id);
+
+-- Salvaged weapons a squad brought into the battle and has not yet installed.
+ALTER TABLE public.mecha_game_squad_instance
+    ADD COLUMN salvage JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+COMMENT ON COLUMN public.mecha_game_squad_instance.salvage IS 'Salvaged weapons carried in from a campaign, by weapon name and quantity.';
+
+COMMIT;
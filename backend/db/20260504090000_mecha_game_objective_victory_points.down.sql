@@ -0,0 +1,17 @@
+BEGIN;
+
+ALTER TABLE public.mecha_game_squad_instance
+    DROP CONSTRAINT IF EXISTS mecha_game_squad_instance_victory_points_check,
+    DROP COLUMN IF EXISTS victory_points;
+
+ALTER TABLE public.mecha_game_sector_instance
+    DROP CONSTRAINT IF EXISTS mecha_game_sector_instance_owning_squad_instance_id_fkey,
+    DROP COLUMN IF EXISTS owning_squad_instance_id;
+
+ALTER TABLE public.mecha_game_sector
+    DROP CONSTRAINT IF EXISTS mecha_game_sector_capture_turns_check,
+    DROP CONSTRAINT IF EXISTS mecha_game_sector_victory_points_check,
+    DROP COLUMN IF EXISTS capture_turns,
+    DROP COLUMN IF EXISTS victory_points;
+
+COMMIT;
@@ -0,0 +1,26 @@
+BEGIN;
+
+-- Sector control objectives. Designers give objective sectors a victory
+-- point value and the number of consecutive turns a squad must hold the
+-- sector uncontested to capture it. A captured objective stays owned by the
+-- capturing squad until another squad captures it, and scores its victory
+-- points for the owner at the end of every turn.
+ALTER TABLE public.mecha_game_sector
+    ADD COLUMN victory_points INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN capture_turns INTEGER NOT NULL DEFAULT 1,
+    ADD CONSTRAINT mecha_game_sector_victory_points_check CHECK (victory_points >= 0),
+    ADD CONSTRAINT mecha_game_sector_capture_turns_check CHECK (capture_turns BETWEEN 1 AND 10);
+
+-- The squad instance that captured an objective sector (NULL = not captured).
+ALTER TABLE public.mecha_game_sector_instance
+    ADD COLUMN owning_squad_instance_id UUID,
+    ADD CONSTRAINT mecha_game_sector_instance_owning_squad_instance_id_fkey
+        FOREIGN KEY (owning_squad_instance_id) REFERENCES public.mecha_game_squad_instance(id);
+
+ALTER TABLE public.mecha_game_squad_instance
+    ADD COLUMN victory_points INTEGER NOT NULL DEFAULT 0,
+    ADD CONSTRAINT mecha_game_squad_instance_victory_points_check CHECK (victory_points >= 0);
+
+COMMENT ON COLUMN public.mecha_game_squad_instance.victory_points IS 'Victory points scored by this squad from the objectives it owns.';
+
+COMMIT;
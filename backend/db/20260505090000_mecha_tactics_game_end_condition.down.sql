@@ -0,0 +1,19 @@
+BEGIN;
+
+DELETE FROM public.game_instance_result
+    WHERE end_condition = 'last_side_standing';
+
+ALTER TABLE public.game_instance_result
+    DROP CONSTRAINT IF EXISTS game_instance_result_end_condition_check;
+
+ALTER TABLE public.game_instance_result
+    ADD CONSTRAINT game_instance_result_end_condition_check CHECK (end_condition IN (
+        'max_turns',
+        'goal_location_reached',
+        'goal_item_held',
+        'all_characters_retired',
+        'last_squad_standing',
+        'objective_held'
+    ));
+
+COMMIT;
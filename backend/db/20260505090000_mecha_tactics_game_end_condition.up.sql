@@ -0,0 +1,19 @@
+BEGIN;
+
+-- Mecha tactics runs end when only one player or computer opponent has mechs
+-- left standing.
+ALTER TABLE public.game_instance_result
+    DROP CONSTRAINT IF EXISTS game_instance_result_end_condition_check;
+
+ALTER TABLE public.game_instance_result
+    ADD CONSTRAINT game_instance_result_end_condition_check CHECK (end_condition IN (
+        'max_turns',
+        'goal_location_reached',
+        'goal_item_held',
+        'all_characters_retired',
+        'last_squad_standing',
+        'objective_held',
+        'last_side_standing'
+    ));
+
+COMMIT;
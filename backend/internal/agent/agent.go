@@ -8,6 +8,15 @@ import (
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
 )
 
+// Agent providers selectable with the AGENT_PROVIDER setting
+const (
+	ProviderOpenAI    = "openai"
+	ProviderAnthropic = "anthropic"
+	// ProviderLocal reads turn sheets with the local optical mark reader
+	// and does not use a hosted agent
+	ProviderLocal = "local"
+)
+
 // VisionAgent handles image-based AI operations for OCR and structured extraction
 type VisionAgent interface {
 	// ExtractText extracts plain text from an image
@@ -32,15 +41,39 @@ type MultiModalAgent interface {
 	TextAgent
 }
 
-// NewMultiModalAgent creates a new MultiModalAgent implementation
+// NewMultiModalAgent creates a new MultiModalAgent implementation for the
+// configured agent provider, defaulting to OpenAI
 func NewMultiModalAgent(l logger.Logger, cfg config.Config) MultiModalAgent {
 	l = l.WithFunctionContext("NewMultiModalAgent")
 
-	l.Info("instantiating multi modal agent")
+	l.Info("instantiating multi modal agent provider >%s<", cfg.AgentProvider)
+
+	if cfg.AgentProvider == ProviderAnthropic {
+		return NewAnthropicMultimodalAgent(l, cfg)
+	}
 
 	return NewOpenAIMultimodalAgent(l, cfg)
 }
 
+// NewTextAgent creates a new TextAgent implementation for the configured agent
+// provider, or returns nil when the provider has no API key configured
+func NewTextAgent(l logger.Logger, cfg config.Config) TextAgent {
+	l = l.WithFunctionContext("NewTextAgent")
+
+	switch cfg.AgentProvider {
+	case ProviderAnthropic:
+		if cfg.AnthropicAPIKey == "" {
+			return nil
+		}
+		return NewAnthropicTextAgent(l, cfg)
+	default:
+		if cfg.OpenAIAPIKey == "" {
+			return nil
+		}
+		return NewOpenAITextAgent(l, cfg)
+	}
+}
+
 // IsContentPolicyRefusal detects if the extracted text is a content policy refusal message.
 // This is a generic function that works across different AI agent providers, as they may
 // return similar refusal patterns when content policies are triggered.
@@ -0,0 +1,141 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+)
+
+// agentRequest is a JSON request to an agent provider API, sent by postWithRetry
+type agentRequest struct {
+	// provider names the API in errors and log messages, e.g. "OpenAI"
+	provider string
+	endpoint string
+	headers  map[string]string
+	body     []byte
+	client   *http.Client
+	// maxElapsedTime limits how long the request is retried for
+	maxElapsedTime time.Duration
+	// parseError returns the error message from an error response body
+	parseError func(body []byte) string
+	// checkResponse inspects a successful response body. Returned errors are
+	// retried unless wrapped with backoff.Permanent.
+	checkResponse func(body []byte) error
+}
+
+// postWithRetry sends the request, retrying network failures, server errors,
+// rate limits and errors the provider suggests retrying with exponential
+// backoff. It returns the status and body of the final response.
+func postWithRetry(ctx context.Context, l logger.Logger, req agentRequest) (int, []byte, error) {
+	var statusCode int
+	var respBody []byte
+
+	backoffConfig := backoff.NewExponentialBackOff()
+	backoffConfig.MaxElapsedTime = req.maxElapsedTime
+
+	err := backoff.RetryNotify(func() error {
+		// Create a new request for each retry (body reader can only be read once)
+		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.endpoint, bytes.NewReader(req.body))
+		if err != nil {
+			return backoff.Permanent(fmt.Errorf("failed to create %s request: %w", req.provider, err))
+		}
+
+		httpReq.Header.Set("Content-Type", "application/json")
+		for name, value := range req.headers {
+			httpReq.Header.Set(name, value)
+		}
+
+		resp, err := req.client.Do(httpReq)
+		if err != nil {
+			l.Debug("%s HTTP request failed error=%v", req.provider, err)
+			return err
+		}
+		defer resp.Body.Close()
+
+		statusCode = resp.StatusCode
+
+		respBody, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return backoff.Permanent(fmt.Errorf("failed to read %s response: %w", req.provider, err))
+		}
+
+		l.Debug("read response body size=%d status=%d", len(respBody), resp.StatusCode)
+
+		// Check for HTTP errors
+		if resp.StatusCode >= 300 {
+			errMsg := ""
+			if req.parseError != nil {
+				errMsg = req.parseError(respBody)
+			}
+			if errMsg == "" {
+				errMsg = fmt.Sprintf("HTTP status %d", resp.StatusCode)
+			}
+
+			if isRetryableError(nil, resp.StatusCode, errMsg) {
+				l.Debug("retryable error status=%d message=%s", resp.StatusCode, errMsg)
+				return fmt.Errorf("%s API error: %s", req.provider, errMsg)
+			}
+			return backoff.Permanent(fmt.Errorf("%s API error: %s", req.provider, errMsg))
+		}
+
+		if req.checkResponse != nil {
+			return req.checkResponse(respBody)
+		}
+
+		return nil
+	}, backoff.WithContext(backoffConfig, ctx), func(err error, duration time.Duration) {
+		l.Debug("retrying %s request after %v error=%v", req.provider, duration, err)
+	})
+	if err != nil {
+		return statusCode, nil, err
+	}
+
+	return statusCode, respBody, nil
+}
+
+// isRetryableError determines if an agent provider error should be retried
+func isRetryableError(err error, statusCode int, errorMessage string) bool {
+	// Retry on network errors
+	if err != nil {
+		return true
+	}
+
+	// Retry on 5xx server errors, including Anthropic 529 overloaded errors
+	if statusCode >= 500 && statusCode < 600 {
+		return true
+	}
+
+	// Retry on rate limit errors
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	// Retry on service unavailable
+	if statusCode == http.StatusServiceUnavailable {
+		return true
+	}
+
+	// Retry on provider errors that suggest retrying
+	if errorMessage != "" {
+		lowerMsg := strings.ToLower(errorMessage)
+		if strings.Contains(lowerMsg, "retry") ||
+			strings.Contains(lowerMsg, "error occurred while processing") ||
+			strings.Contains(lowerMsg, "rate limit") ||
+			strings.Contains(lowerMsg, "server error") ||
+			strings.Contains(lowerMsg, "overloaded") ||
+			strings.Contains(lowerMsg, "temporary") ||
+			strings.Contains(lowerMsg, "content policy") ||
+			strings.Contains(lowerMsg, "refusal") {
+			return true
+		}
+	}
+
+	return false
+}
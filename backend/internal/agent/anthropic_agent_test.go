@@ -0,0 +1,218 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/alienspaces/playbymail/core/log"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// pngHeader is enough of a PNG image for content type detection
+var pngHeader = []byte{0x89, 'P', 'N', 'G', 0x0D, 0x0A, 0x1A, 0x0A, 0, 0, 0, 0}
+
+func testAnthropicConfig() config.Config {
+	return config.Config{
+		AgentProvider:   ProviderAnthropic,
+		AnthropicAPIKey: "test-key",
+		AnthropicModel:  "test-model",
+	}
+}
+
+// newAnthropicTestServer returns a Messages API stand-in that records each
+// request and replies with the next of the given responses
+func newAnthropicTestServer(t *testing.T, requests *[]anthropicRequest, responses ...func(w http.ResponseWriter)) *httptest.Server {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "test-key", r.Header.Get("x-api-key"))
+		require.Equal(t, anthropicAPIVersion, r.Header.Get("anthropic-version"))
+
+		var req anthropicRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		*requests = append(*requests, req)
+
+		idx := int(calls.Add(1)) - 1
+		require.Less(t, idx, len(responses), "unexpected request")
+		responses[idx](w)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func anthropicTextResponse(text string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"type":        "message",
+			"role":        "assistant",
+			"stop_reason": "end_turn",
+			"content": []map[string]any{
+				{"type": "text", "text": text},
+			},
+		})
+	}
+}
+
+func anthropicErrorResponse(status int, errType, message string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"type":  "error",
+			"error": map[string]any{"type": errType, "message": message},
+		})
+	}
+}
+
+func TestAnthropicVisionAgent_ExtractText(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses []func(w http.ResponseWriter)
+		expect    string
+		expectErr string
+		calls     int
+	}{
+		{
+			name:      "returns extracted text",
+			responses: []func(w http.ResponseWriter){anthropicTextResponse("TURN SHEET CODE abc123def456")},
+			expect:    "TURN SHEET CODE abc123def456",
+			calls:     1,
+		},
+		{
+			name: "retries when overloaded",
+			responses: []func(w http.ResponseWriter){
+				anthropicErrorResponse(529, "overloaded_error", "Overloaded"),
+				anthropicTextResponse("TURN SHEET CODE abc123def456"),
+			},
+			expect: "TURN SHEET CODE abc123def456",
+			calls:  2,
+		},
+		{
+			name:      "does not retry invalid requests",
+			responses: []func(w http.ResponseWriter){anthropicErrorResponse(http.StatusBadRequest, "invalid_request_error", "max_tokens: field required")},
+			expectErr: "Anthropic API error: max_tokens: field required",
+			calls:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []anthropicRequest
+			server := newAnthropicTestServer(t, &requests, tt.responses...)
+
+			a := NewAnthropicVisionAgent(log.NewDefaultLogger(), testAnthropicConfig()).(*anthropicVisionAgent)
+			a.endpoint = server.URL
+
+			text, err := a.ExtractText(context.Background(), TextExtractionRequest{
+				ImageData: pngHeader,
+				ImageMIME: "image/png",
+			})
+			require.Len(t, requests, tt.calls)
+			if tt.expectErr != "" {
+				require.ErrorContains(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, text)
+
+			req := requests[0]
+			require.Equal(t, "test-model", req.Model)
+			require.Equal(t, anthropicMaxTokens, req.MaxTokens)
+			require.Len(t, req.Messages, 1)
+			require.Len(t, req.Messages[0].Content, 2)
+			require.Equal(t, "image", req.Messages[0].Content[0].Type)
+			require.Equal(t, "image/png", req.Messages[0].Content[0].Source.MediaType)
+			require.Equal(t, defaultImagePrompt, req.Messages[0].Content[1].Text)
+		})
+	}
+}
+
+func TestAnthropicVisionAgent_ExtractStructuredData(t *testing.T) {
+	var requests []anthropicRequest
+	server := newAnthropicTestServer(t, &requests,
+		anthropicTextResponse("```json\n{\"location_choice\": \"loc-1\"}\n```"),
+	)
+
+	a := NewAnthropicVisionAgent(log.NewDefaultLogger(), testAnthropicConfig()).(*anthropicVisionAgent)
+	a.endpoint = server.URL
+
+	data, err := a.ExtractStructuredData(context.Background(), StructuredExtractionRequest{
+		Instructions:      "Extract the location choice",
+		AdditionalContext: []string{"Locations: loc-1, loc-2"},
+		TemplateImage:     &ImageData{Data: pngHeader, MIME: "image/png"},
+		FilledImage:       ImageData{Data: pngHeader},
+		ExpectedSchema:    map[string]any{"location_choice": "string"},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"location_choice": "loc-1"}`, string(data))
+
+	require.Len(t, requests, 1)
+	var types []string
+	for _, c := range requests[0].Messages[0].Content {
+		types = append(types, c.Type)
+	}
+	require.Equal(t, []string{"text", "text", "image", "image", "text", "text"}, types)
+	require.Contains(t, requests[0].Messages[0].Content[4].Text, `{"location_choice":"string"}`)
+
+	_, err = a.ExtractStructuredData(context.Background(), StructuredExtractionRequest{
+		FilledImage: ImageData{Data: pngHeader},
+	})
+	require.ErrorContains(t, err, "expected JSON schema is required")
+}
+
+func TestAnthropicTextAgent(t *testing.T) {
+	var requests []anthropicRequest
+	server := newAnthropicTestServer(t, &requests,
+		anthropicTextResponse("MOVE Hammer to Northern Ridge"),
+		anthropicTextResponse(`{"result": {"sentiment": "positive"}, "confidence": 0.9}`),
+	)
+
+	a := NewAnthropicTextAgent(log.NewDefaultLogger(), testAnthropicConfig()).(*anthropicTextAgent)
+	a.endpoint = server.URL
+
+	text, err := a.GenerateContent(context.Background(), ContentGenerationRequest{
+		SystemPrompt: "You command a mecha squad",
+		UserPrompt:   "Give orders",
+		MaxTokens:    200,
+		Temperature:  0.5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "MOVE Hammer to Northern Ridge", text)
+
+	require.Equal(t, "You command a mecha squad", requests[0].System)
+	require.Equal(t, 200, requests[0].MaxTokens)
+	require.NotNil(t, requests[0].Temperature)
+	require.Equal(t, 0.5, *requests[0].Temperature)
+
+	result, err := a.AnalyzeText(context.Background(), TextAnalysisRequest{
+		Text: "Great game, thanks!",
+		Task: "sentiment",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "sentiment", result.Task)
+	require.Equal(t, map[string]any{"sentiment": "positive"}, result.Result)
+	require.Equal(t, 0.9, result.Confidence)
+}
+
+func TestNewMultiModalAgent(t *testing.T) {
+	l := log.NewDefaultLogger()
+
+	a := NewMultiModalAgent(l, testAnthropicConfig()).(*multimodalAgent)
+	require.IsType(t, &anthropicVisionAgent{}, a.VisionAgent)
+	require.IsType(t, &anthropicTextAgent{}, a.TextAgent)
+
+	a = NewMultiModalAgent(l, config.Config{AgentProvider: ProviderOpenAI}).(*multimodalAgent)
+	require.IsType(t, &openAIVisionAgent{}, a.VisionAgent)
+	require.IsType(t, &openAITextAgent{}, a.TextAgent)
+
+	require.Nil(t, NewTextAgent(l, config.Config{AgentProvider: ProviderAnthropic}))
+	require.IsType(t, &anthropicTextAgent{}, NewTextAgent(l, testAnthropicConfig()))
+}
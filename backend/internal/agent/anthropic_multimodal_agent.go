@@ -0,0 +1,18 @@
+package agent
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// NewAnthropicMultimodalAgent creates a new Anthropic MultiModalAgent implementation
+func NewAnthropicMultimodalAgent(l logger.Logger, cfg config.Config) MultiModalAgent {
+	l = l.WithFunctionContext("NewAnthropicMultimodalAgent")
+
+	l.Info("instantiating anthropic multi modal agent")
+
+	return &multimodalAgent{
+		VisionAgent: NewAnthropicVisionAgent(l, cfg),
+		TextAgent:   NewAnthropicTextAgent(l, cfg),
+	}
+}
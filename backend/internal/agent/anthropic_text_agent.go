@@ -0,0 +1,136 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+const anthropicTextAnalysisPrompt = `Perform the "%s" analysis task on the text below.%s
+
+Respond with a JSON object only, with no markdown or commentary, in this structure:
+{"result": {<task specific results>}, "confidence": <number between 0 and 1>}
+
+Text:
+%s`
+
+type anthropicTextAgent struct {
+	logger   logger.Logger
+	cfg      config.Config
+	client   *http.Client
+	endpoint string
+}
+
+// NewAnthropicTextAgent creates a new Anthropic TextAgent implementation that uses the
+// same API key and model as the vision agent.
+func NewAnthropicTextAgent(l logger.Logger, cfg config.Config) TextAgent {
+	l = l.WithFunctionContext("NewAnthropicTextAgent")
+
+	l.Info("instantiating anthropic text agent")
+
+	return &anthropicTextAgent{
+		logger:   l,
+		cfg:      cfg,
+		client:   &http.Client{Timeout: 45 * time.Second},
+		endpoint: anthropicMessagesEndpoint,
+	}
+}
+
+// GenerateContent calls the Anthropic Messages API with a text-only prompt
+func (a *anthropicTextAgent) GenerateContent(ctx context.Context, req ContentGenerationRequest) (string, error) {
+	l := a.logger.WithFunctionContext("AnthropicTextAgent/GenerateContent")
+	start := time.Now()
+
+	if err := checkAnthropicConfig(a.cfg); err != nil {
+		return "", err
+	}
+
+	if req.UserPrompt == "" {
+		return "", fmt.Errorf("user prompt is required")
+	}
+
+	reqPayload := anthropicRequest{
+		Model:     strings.TrimSpace(a.cfg.AnthropicModel),
+		MaxTokens: anthropicMaxTokens,
+		System:    req.SystemPrompt,
+		Messages: []anthropicMessage{
+			{
+				Role: "user",
+				Content: []anthropicContent{
+					{
+						Type: "text",
+						Text: req.UserPrompt,
+					},
+				},
+			},
+		},
+	}
+
+	if req.Temperature > 0 {
+		t := req.Temperature
+		reqPayload.Temperature = &t
+	}
+
+	if req.MaxTokens > 0 {
+		reqPayload.MaxTokens = req.MaxTokens
+	}
+
+	text, err := sendAnthropicRequest(ctx, l, a.client, a.endpoint, a.cfg, reqPayload, false)
+	if err != nil {
+		return "", err
+	}
+
+	l.Info("Anthropic text generation completed text_length=%d total_duration=%v", len(text), time.Since(start))
+
+	return text, nil
+}
+
+// AnalyzeText asks the model to perform the requested analysis task and return
+// its results as JSON
+func (a *anthropicTextAgent) AnalyzeText(ctx context.Context, req TextAnalysisRequest) (TextAnalysisResult, error) {
+	l := a.logger.WithFunctionContext("AnthropicTextAgent/AnalyzeText")
+
+	if strings.TrimSpace(req.Text) == "" {
+		return TextAnalysisResult{}, fmt.Errorf("text is required")
+	}
+	if strings.TrimSpace(req.Task) == "" {
+		return TextAnalysisResult{}, fmt.Errorf("task is required")
+	}
+
+	options := ""
+	if len(req.Options) > 0 {
+		optionsJSON, err := json.Marshal(req.Options)
+		if err != nil {
+			return TextAnalysisResult{}, fmt.Errorf("failed to marshal text analysis options: %w", err)
+		}
+		options = fmt.Sprintf("\nOptions: %s", optionsJSON)
+	}
+
+	text, err := a.GenerateContent(ctx, ContentGenerationRequest{
+		UserPrompt: fmt.Sprintf(anthropicTextAnalysisPrompt, req.Task, options, req.Text),
+	})
+	if err != nil {
+		return TextAnalysisResult{}, err
+	}
+
+	var analysis struct {
+		Result     map[string]any `json:"result"`
+		Confidence float64        `json:"confidence"`
+	}
+	if err := json.Unmarshal([]byte(trimJSONCodeFence(text)), &analysis); err != nil {
+		l.Warn("failed to decode text analysis response >%s< error=%v", text, err)
+		return TextAnalysisResult{}, fmt.Errorf("failed to decode Anthropic text analysis response: %w", err)
+	}
+
+	return TextAnalysisResult{
+		Task:       req.Task,
+		Result:     analysis.Result,
+		Confidence: analysis.Confidence,
+	}, nil
+}
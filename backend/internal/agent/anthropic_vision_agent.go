@@ -0,0 +1,375 @@
+package agent
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+const (
+	anthropicMessagesEndpoint = "https://api.anthropic.com/v1/messages"
+	anthropicAPIVersion       = "2023-06-01"
+	anthropicMaxTokens        = 4096
+)
+
+type anthropicVisionAgent struct {
+	logger   logger.Logger
+	cfg      config.Config
+	client   *http.Client
+	endpoint string
+}
+
+// NewAnthropicVisionAgent creates a new Anthropic VisionAgent implementation
+func NewAnthropicVisionAgent(l logger.Logger, cfg config.Config) VisionAgent {
+	l = l.WithFunctionContext("NewAnthropicVisionAgent")
+
+	l.Info("instantiating anthropic vision agent")
+
+	return &anthropicVisionAgent{
+		logger:   l,
+		cfg:      cfg,
+		client:   &http.Client{Timeout: 60 * time.Second},
+		endpoint: anthropicMessagesEndpoint,
+	}
+}
+
+func (a *anthropicVisionAgent) ExtractText(ctx context.Context, req TextExtractionRequest) (string, error) {
+	l := a.logger.WithFunctionContext("AnthropicVisionAgent/ExtractText")
+	start := time.Now()
+
+	if err := checkAnthropicConfig(a.cfg); err != nil {
+		return "", err
+	}
+
+	if len(req.ImageData) == 0 {
+		return "", fmt.Errorf("empty image data provided")
+	}
+
+	prompt := req.Prompt
+	if prompt == "" {
+		prompt = defaultImagePrompt
+	}
+
+	image, err := anthropicImageContent(req.ImageData, req.ImageMIME)
+	if err != nil {
+		return "", err
+	}
+
+	reqPayload := anthropicRequest{
+		Model:     a.modelName(),
+		MaxTokens: anthropicMaxTokens,
+		Messages: []anthropicMessage{
+			{
+				Role: "user",
+				Content: []anthropicContent{
+					image,
+					{
+						Type: "text",
+						Text: prompt,
+					},
+				},
+			},
+		},
+	}
+
+	text, err := sendAnthropicRequest(ctx, l, a.client, a.endpoint, a.cfg, reqPayload, true)
+	if err != nil {
+		return "", err
+	}
+
+	l.Info("Anthropic text extraction completed text_length=%d total_duration=%v", len(text), time.Since(start))
+
+	return text, nil
+}
+
+func (a *anthropicVisionAgent) ExtractStructuredData(ctx context.Context, req StructuredExtractionRequest) ([]byte, error) {
+	l := a.logger.WithFunctionContext("AnthropicVisionAgent/ExtractStructuredData")
+	start := time.Now()
+
+	if err := checkAnthropicConfig(a.cfg); err != nil {
+		return nil, err
+	}
+
+	if len(req.FilledImage.Data) == 0 {
+		return nil, fmt.Errorf("filled image data is required")
+	}
+
+	if req.ExpectedSchema == nil {
+		return nil, fmt.Errorf("expected JSON schema is required")
+	}
+
+	l.Debug("preparing structured extraction request has_template=%v", req.TemplateImage != nil)
+
+	skeleton, err := json.Marshal(req.ExpectedSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal expected JSON schema: %w", err)
+	}
+
+	contents := []anthropicContent{}
+
+	if strings.TrimSpace(req.Instructions) != "" {
+		contents = append(contents, anthropicContent{
+			Type: "text",
+			Text: req.Instructions,
+		})
+	}
+
+	for _, ctxLine := range req.AdditionalContext {
+		if strings.TrimSpace(ctxLine) == "" {
+			continue
+		}
+		contents = append(contents, anthropicContent{
+			Type: "text",
+			Text: ctxLine,
+		})
+	}
+
+	if req.TemplateImage != nil && len(req.TemplateImage.Data) > 0 {
+		template, err := anthropicImageContent(req.TemplateImage.Data, req.TemplateImage.MIME)
+		if err != nil {
+			l.Warn("failed to encode template image, skipping template image error=%v", err)
+		} else {
+			contents = append(contents, template)
+		}
+	}
+
+	filled, err := anthropicImageContent(req.FilledImage.Data, req.FilledImage.MIME)
+	if err != nil {
+		return nil, err
+	}
+
+	contents = append(contents,
+		filled,
+		anthropicContent{
+			Type: "text",
+			Text: fmt.Sprintf("Return a strict JSON object matching this structure: %s", string(skeleton)),
+		},
+		anthropicContent{
+			Type: "text",
+			Text: "Respond with JSON only. Do not include markdown, commentary, or extra keys.",
+		},
+	)
+
+	reqPayload := anthropicRequest{
+		Model:     a.modelName(),
+		MaxTokens: anthropicMaxTokens,
+		Messages: []anthropicMessage{
+			{
+				Role:    "user",
+				Content: contents,
+			},
+		},
+	}
+
+	text, err := sendAnthropicRequest(ctx, l, a.client, a.endpoint, a.cfg, reqPayload, true)
+	if err != nil {
+		return nil, err
+	}
+
+	text = trimJSONCodeFence(text)
+
+	l.Info("Anthropic structured extraction completed response_size=%d total_duration=%v", len(text), time.Since(start))
+
+	return []byte(text), nil
+}
+
+// modelName returns the configured model, or empty string if unset
+func (a *anthropicVisionAgent) modelName() string {
+	return strings.TrimSpace(a.cfg.AnthropicModel)
+}
+
+// Helper types and functions for the Anthropic Messages API
+type anthropicRequest struct {
+	Model       string             `json:"model"`
+	MaxTokens   int                `json:"max_tokens"`
+	System      string             `json:"system,omitempty"`
+	Messages    []anthropicMessage `json:"messages"`
+	Temperature *float64           `json:"temperature,omitempty"`
+}
+
+type anthropicMessage struct {
+	Role    string             `json:"role"`
+	Content []anthropicContent `json:"content"`
+}
+
+type anthropicContent struct {
+	Type   string                `json:"type"`
+	Text   string                `json:"text,omitempty"`
+	Source *anthropicImageSource `json:"source,omitempty"`
+}
+
+type anthropicImageSource struct {
+	Type      string `json:"type"`
+	MediaType string `json:"media_type"`
+	Data      string `json:"data"`
+}
+
+type anthropicResponse struct {
+	Content    []anthropicContent `json:"content"`
+	StopReason string             `json:"stop_reason"`
+	Error      *anthropicError    `json:"error,omitempty"`
+}
+
+type anthropicError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// checkAnthropicConfig returns an error when the Anthropic API key or model is not configured
+func checkAnthropicConfig(cfg config.Config) error {
+	if cfg.AnthropicAPIKey == "" {
+		return fmt.Errorf("Anthropic API key not configured")
+	}
+	if strings.TrimSpace(cfg.AnthropicModel) == "" {
+		return fmt.Errorf("ANTHROPIC_MODEL is not configured")
+	}
+	return nil
+}
+
+// sendAnthropicRequest sends a Messages API request with retry and returns the
+// text of the response. When checkRefusal is set a content policy refusal in
+// the response is retried.
+func sendAnthropicRequest(ctx context.Context, l logger.Logger, client *http.Client, endpoint string, cfg config.Config, reqPayload anthropicRequest, checkRefusal bool) (string, error) {
+	body, err := json.Marshal(reqPayload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Anthropic request: %w", err)
+	}
+
+	apiStart := time.Now()
+
+	l.Info("sending Anthropic request endpoint=%s model=%s request_size=%d",
+		endpoint, reqPayload.Model, len(body))
+
+	var apiResp anthropicResponse
+
+	statusCode, respBody, err := postWithRetry(ctx, l, agentRequest{
+		provider: "Anthropic",
+		endpoint: endpoint,
+		headers: map[string]string{
+			"x-api-key":         cfg.AnthropicAPIKey,
+			"anthropic-version": anthropicAPIVersion,
+		},
+		body:           body,
+		client:         client,
+		maxElapsedTime: 3 * time.Minute,
+		parseError:     parseAnthropicAPIError,
+		checkResponse: func(respBody []byte) error {
+			apiResp = anthropicResponse{}
+			if err := json.Unmarshal(respBody, &apiResp); err != nil {
+				return backoff.Permanent(fmt.Errorf("failed to decode Anthropic response: %w", err))
+			}
+
+			if apiResp.Error != nil && apiResp.Error.Message != "" {
+				if isRetryableError(nil, 0, apiResp.Error.Message) {
+					l.Debug("retryable Anthropic API error message=%s", apiResp.Error.Message)
+					return fmt.Errorf("Anthropic API error: %s", apiResp.Error.Message)
+				}
+				return backoff.Permanent(fmt.Errorf("Anthropic API error: %s", apiResp.Error.Message))
+			}
+
+			if apiResp.StopReason == "refusal" {
+				l.Debug("refusal stop reason in response, will retry")
+				return fmt.Errorf("Anthropic content policy refusal detected")
+			}
+
+			if checkRefusal {
+				text := extractAnthropicText(apiResp)
+				if text != "" && IsContentPolicyRefusal(text) {
+					l.Debug("content policy refusal detected in response, will retry")
+					return fmt.Errorf("Anthropic content policy refusal detected")
+				}
+			}
+
+			return nil
+		},
+	})
+
+	apiDuration := time.Since(apiStart)
+	if err != nil {
+		l.Warn("Anthropic request failed after %v error=%v", apiDuration, err)
+		return "", err
+	}
+
+	l.Info("received Anthropic response status=%d duration=%v content_length=%d stop_reason=%s",
+		statusCode, apiDuration, len(respBody), apiResp.StopReason)
+
+	text := extractAnthropicText(apiResp)
+	if text == "" {
+		l.Warn("Anthropic response contained no text content_count=%d", len(apiResp.Content))
+		return "", fmt.Errorf("Anthropic did not return any text")
+	}
+
+	return text, nil
+}
+
+func parseAnthropicAPIError(body []byte) string {
+	var errResp anthropicResponse
+	if err := json.Unmarshal(body, &errResp); err == nil {
+		if errResp.Error != nil && errResp.Error.Message != "" {
+			return errResp.Error.Message
+		}
+	}
+	return ""
+}
+
+func extractAnthropicText(resp anthropicResponse) string {
+	var builder strings.Builder
+
+	for _, content := range resp.Content {
+		if content.Type == "text" && strings.TrimSpace(content.Text) != "" {
+			if builder.Len() > 0 {
+				builder.WriteString("\n")
+			}
+			builder.WriteString(strings.TrimSpace(content.Text))
+		}
+	}
+
+	return strings.TrimSpace(builder.String())
+}
+
+// anthropicImageContent returns a base64 image content block. The Messages API
+// only accepts JPEG, PNG, GIF and WebP images.
+func anthropicImageContent(data []byte, mime string) (anthropicContent, error) {
+	if len(data) == 0 {
+		return anthropicContent{}, fmt.Errorf("empty image data provided")
+	}
+
+	if mime == "" || !strings.HasPrefix(mime, "image/") {
+		mime = http.DetectContentType(data)
+	}
+
+	switch mime {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+	default:
+		return anthropicContent{}, fmt.Errorf("unsupported image type %s", mime)
+	}
+
+	return anthropicContent{
+		Type: "image",
+		Source: &anthropicImageSource{
+			Type:      "base64",
+			MediaType: mime,
+			Data:      base64.StdEncoding.EncodeToString(data),
+		},
+	}, nil
+}
+
+// trimJSONCodeFence removes a markdown code fence the model may wrap JSON in
+func trimJSONCodeFence(text string) string {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "```") {
+		return text
+	}
+	text = strings.TrimPrefix(text, "```json")
+	text = strings.TrimPrefix(text, "```")
+	text = strings.TrimSuffix(text, "```")
+	return strings.TrimSpace(text)
+}
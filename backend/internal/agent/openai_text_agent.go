@@ -1,11 +1,9 @@
 package agent
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -101,65 +99,25 @@ func (a *openAITextAgent) GenerateContent(ctx context.Context, req ContentGenera
 	l.Info("sending OpenAI text generation request endpoint=%s model=%s request_size=%d",
 		openAIResponsesEndpoint, model, len(body))
 
-	var resp *http.Response
-	var respBody []byte
 	var apiResp openAIResponse
 
-	backoffConfig := backoff.NewExponentialBackOff()
-	backoffConfig.MaxElapsedTime = 2 * time.Minute
-
-	err = backoff.RetryNotify(func() error {
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, openAIResponsesEndpoint, bytes.NewReader(body))
-		if err != nil {
-			return backoff.Permanent(fmt.Errorf("failed to create OpenAI text request: %w", err))
-		}
-
-		httpReq.Header.Set("Content-Type", "application/json")
-		httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.cfg.OpenAIAPIKey))
-
-		resp, err = a.client.Do(httpReq)
-		if err != nil {
-			l.Debug("OpenAI HTTP request failed error=%v", err)
-			return err
-		}
-		defer resp.Body.Close()
-
-		respBody, err = io.ReadAll(resp.Body)
-		if err != nil {
-			return backoff.Permanent(fmt.Errorf("failed to read OpenAI text response: %w", err))
-		}
-
-		l.Debug("read response body size=%d status=%d", len(respBody), resp.StatusCode)
-
-		if resp.StatusCode >= 300 {
-			apiErr := parseOpenAIAPIError(respBody)
-			errMsg := apiErr
-			if errMsg == "" {
-				errMsg = fmt.Sprintf("HTTP status %d", resp.StatusCode)
-			}
-			if isRetryableError(nil, resp.StatusCode, errMsg) {
-				l.Debug("retryable error status=%d message=%s", resp.StatusCode, errMsg)
-				return fmt.Errorf("OpenAI API error: %s", errMsg)
-			}
-			return backoff.Permanent(fmt.Errorf("OpenAI API error: %s", errMsg))
-		}
-
-		err = json.Unmarshal(respBody, &apiResp)
-		if err != nil {
-			return backoff.Permanent(fmt.Errorf("failed to decode OpenAI text response: %w", err))
-		}
-
-		if apiResp.Error != nil && apiResp.Error.Message != "" {
-			if isRetryableError(nil, resp.StatusCode, apiResp.Error.Message) {
-				l.Debug("retryable OpenAI API error message=%s", apiResp.Error.Message)
-				return fmt.Errorf("OpenAI API error: %s", apiResp.Error.Message)
+	statusCode, respBody, err := postWithRetry(ctx, l, agentRequest{
+		provider: "OpenAI",
+		endpoint: openAIResponsesEndpoint,
+		headers: map[string]string{
+			"Authorization": fmt.Sprintf("Bearer %s", a.cfg.OpenAIAPIKey),
+		},
+		body:           body,
+		client:         a.client,
+		maxElapsedTime: 2 * time.Minute,
+		parseError:     parseOpenAIAPIError,
+		checkResponse: func(respBody []byte) error {
+			apiResp = openAIResponse{}
+			if err := json.Unmarshal(respBody, &apiResp); err != nil {
+				return backoff.Permanent(fmt.Errorf("failed to decode OpenAI text response: %w", err))
 			}
-			return backoff.Permanent(fmt.Errorf("OpenAI API error: %s", apiResp.Error.Message))
-		}
-
-		return nil
-	}, backoffConfig, func(err error, duration time.Duration) {
-		l.Debug("retrying OpenAI text request after %v error=%v", duration, err)
+			return checkOpenAIResponse(l, apiResp, false)
+		},
 	})
 
 	apiDuration := time.Since(apiStart)
@@ -169,7 +127,7 @@ func (a *openAITextAgent) GenerateContent(ctx context.Context, req ContentGenera
 	}
 
 	l.Info("received OpenAI text response status=%d duration=%v content_length=%d",
-		resp.StatusCode, apiDuration, len(respBody))
+		statusCode, apiDuration, len(respBody))
 
 	text := extractOpenAIText(apiResp)
 	if text == "" {
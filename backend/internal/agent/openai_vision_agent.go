@@ -6,7 +6,6 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -18,7 +17,7 @@ import (
 
 const (
 	openAIResponsesEndpoint = "https://api.openai.com/v1/responses"
-	openAIImagePrompt       = "Transcribe ALL text visible in this turn sheet image exactly as it appears. Include every character, especially any long alphanumeric codes or strings at the bottom of the page. Do not summarize or describe the code - output the actual characters."
+	defaultImagePrompt      = "Transcribe ALL text visible in this turn sheet image exactly as it appears. Include every character, especially any long alphanumeric codes or strings at the bottom of the page. Do not summarize or describe the code - output the actual characters."
 	defaultImageMimeType    = "image/png"
 )
 
@@ -58,7 +57,7 @@ func (a *openAIVisionAgent) ExtractText(ctx context.Context, req TextExtractionR
 
 	prompt := req.Prompt
 	if prompt == "" {
-		prompt = openAIImagePrompt
+		prompt = defaultImagePrompt
 	}
 
 	// Encode image as data URI (image should already be optimized by scanner)
@@ -98,78 +97,25 @@ func (a *openAIVisionAgent) ExtractText(ctx context.Context, req TextExtractionR
 	l.Info("sending OpenAI text extraction request endpoint=%s model=%s request_size=%d",
 		openAIResponsesEndpoint, model, len(body))
 
-	var resp *http.Response
-	var respBody []byte
 	var apiResp openAIResponse
 
-	backoffConfig := backoff.NewExponentialBackOff()
-	backoffConfig.MaxElapsedTime = 2 * time.Minute
-
-	err = backoff.RetryNotify(func() error {
-		// Create a new request for each retry (body reader can only be read once)
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, openAIResponsesEndpoint, bytes.NewReader(body))
-		if err != nil {
-			return backoff.Permanent(fmt.Errorf("failed to create OpenAI request: %w", err))
-		}
-
-		httpReq.Header.Set("Content-Type", "application/json")
-		httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.cfg.OpenAIAPIKey))
-
-		resp, err = a.client.Do(httpReq)
-		if err != nil {
-			l.Debug("OpenAI HTTP request failed error=%v", err)
-			return err
-		}
-		defer resp.Body.Close()
-
-		respBody, err = io.ReadAll(resp.Body)
-		if err != nil {
-			return backoff.Permanent(fmt.Errorf("failed to read OpenAI response: %w", err))
-		}
-
-		l.Debug("read response body size=%d status=%d", len(respBody), resp.StatusCode)
-
-		// Check for HTTP errors
-		if resp.StatusCode >= 300 {
-			apiErr := parseOpenAIAPIError(respBody)
-			errMsg := apiErr
-			if errMsg == "" {
-				errMsg = fmt.Sprintf("HTTP status %d", resp.StatusCode)
-			}
-
-			if isRetryableError(nil, resp.StatusCode, errMsg) {
-				l.Debug("retryable error status=%d message=%s", resp.StatusCode, errMsg)
-				return fmt.Errorf("OpenAI API error: %s", errMsg)
-			}
-			return backoff.Permanent(fmt.Errorf("OpenAI API error: %s", errMsg))
-		}
-
-		// Parse response
-		err = json.Unmarshal(respBody, &apiResp)
-		if err != nil {
-			return backoff.Permanent(fmt.Errorf("failed to decode OpenAI response: %w", err))
-		}
-
-		// Check for OpenAI API errors in response body
-		if apiResp.Error != nil && apiResp.Error.Message != "" {
-			if isRetryableError(nil, resp.StatusCode, apiResp.Error.Message) {
-				l.Debug("retryable OpenAI API error message=%s", apiResp.Error.Message)
-				return fmt.Errorf("OpenAI API error: %s", apiResp.Error.Message)
+	statusCode, respBody, err := postWithRetry(ctx, l, agentRequest{
+		provider:       "OpenAI",
+		endpoint:       openAIResponsesEndpoint,
+		headers:        a.headers(),
+		body:           body,
+		client:         a.client,
+		maxElapsedTime: 2 * time.Minute,
+		parseError:     parseOpenAIAPIError,
+		checkResponse: func(respBody []byte) error {
+			apiResp = openAIResponse{}
+			if err := json.Unmarshal(respBody, &apiResp); err != nil {
+				return backoff.Permanent(fmt.Errorf("failed to decode OpenAI response: %w", err))
 			}
-			return backoff.Permanent(fmt.Errorf("OpenAI API error: %s", apiResp.Error.Message))
-		}
-
-		// Check for content policy refusals in the response text (before returning from retry loop)
-		// This allows us to retry if we get a refusal
-		text := extractOpenAIText(apiResp)
-		if text != "" && IsContentPolicyRefusal(text) {
-			l.Debug("content policy refusal detected in response, will retry")
-			return fmt.Errorf("OpenAI content policy refusal detected")
-		}
-
-		return nil
-	}, backoffConfig, func(err error, duration time.Duration) {
-		l.Debug("retrying OpenAI request after %v error=%v", duration, err)
+			// Content policy refusals are checked before returning from the retry
+			// loop so a refusal is retried
+			return checkOpenAIResponse(l, apiResp, true)
+		},
 	})
 
 	apiDuration := time.Since(apiStart)
@@ -179,7 +125,7 @@ func (a *openAIVisionAgent) ExtractText(ctx context.Context, req TextExtractionR
 	}
 
 	l.Info("received OpenAI response status=%d duration=%v content_length=%d",
-		resp.StatusCode, apiDuration, len(respBody))
+		statusCode, apiDuration, len(respBody))
 
 	text := extractOpenAIText(apiResp)
 	if text == "" {
@@ -187,9 +133,6 @@ func (a *openAIVisionAgent) ExtractText(ctx context.Context, req TextExtractionR
 		return "", fmt.Errorf("OpenAI did not return any text")
 	}
 
-	// Note: Content policy refusal check is now done inside the retry loop
-	// so we don't need to check again here (it would have already triggered a retry)
-
 	totalDuration := time.Since(start)
 
 	l.Info("OpenAI text extraction completed text_length=%d total_duration=%v api_duration=%v prep_duration=%v",
@@ -309,79 +252,24 @@ func (a *openAIVisionAgent) ExtractStructuredData(ctx context.Context, req Struc
 	l.Info("sending OpenAI structured extraction request endpoint=%s model=%s request_size=%d content_items=%d images=%d text=%d",
 		openAIResponsesEndpoint, model, len(body), len(contents), imageCount, textCount)
 
-	var resp *http.Response
-	var respBody []byte
 	var apiResp openAIResponse
 
 	// Use longer timeout for structured extraction
-	client := &http.Client{Timeout: 60 * time.Second}
-	backoffConfig := backoff.NewExponentialBackOff()
-	backoffConfig.MaxElapsedTime = 3 * time.Minute
-
-	err = backoff.RetryNotify(func() error {
-		// Create a new request for each retry (body reader can only be read once)
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, openAIResponsesEndpoint, bytes.NewReader(body))
-		if err != nil {
-			return backoff.Permanent(fmt.Errorf("failed to create OpenAI structured request: %w", err))
-		}
-
-		httpReq.Header.Set("Content-Type", "application/json")
-		httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", a.cfg.OpenAIAPIKey))
-
-		resp, err = client.Do(httpReq)
-		if err != nil {
-			l.Debug("OpenAI HTTP request failed error=%v", err)
-			return err
-		}
-		defer resp.Body.Close()
-
-		respBody, err = io.ReadAll(resp.Body)
-		if err != nil {
-			return backoff.Permanent(fmt.Errorf("failed to read OpenAI structured response: %w", err))
-		}
-
-		l.Debug("read response body size=%d status=%d", len(respBody), resp.StatusCode)
-
-		// Check for HTTP errors
-		if resp.StatusCode >= 300 {
-			apiErr := parseOpenAIAPIError(respBody)
-			errMsg := apiErr
-			if errMsg == "" {
-				errMsg = fmt.Sprintf("HTTP status %d", resp.StatusCode)
-			}
-
-			if isRetryableError(nil, resp.StatusCode, errMsg) {
-				l.Debug("retryable error status=%d message=%s", resp.StatusCode, errMsg)
-				return fmt.Errorf("OpenAI API error: %s", errMsg)
-			}
-			return backoff.Permanent(fmt.Errorf("OpenAI API error: %s", errMsg))
-		}
-
-		// Parse response
-		err = json.Unmarshal(respBody, &apiResp)
-		if err != nil {
-			return backoff.Permanent(fmt.Errorf("failed to decode OpenAI structured response: %w", err))
-		}
-
-		// Check for OpenAI API errors in response body
-		if apiResp.Error != nil && apiResp.Error.Message != "" {
-			if isRetryableError(nil, resp.StatusCode, apiResp.Error.Message) {
-				l.Debug("retryable OpenAI API error message=%s", apiResp.Error.Message)
-				return fmt.Errorf("OpenAI API error: %s", apiResp.Error.Message)
+	statusCode, respBody, err := postWithRetry(ctx, l, agentRequest{
+		provider:       "OpenAI",
+		endpoint:       openAIResponsesEndpoint,
+		headers:        a.headers(),
+		body:           body,
+		client:         &http.Client{Timeout: 60 * time.Second},
+		maxElapsedTime: 3 * time.Minute,
+		parseError:     parseOpenAIAPIError,
+		checkResponse: func(respBody []byte) error {
+			apiResp = openAIResponse{}
+			if err := json.Unmarshal(respBody, &apiResp); err != nil {
+				return backoff.Permanent(fmt.Errorf("failed to decode OpenAI structured response: %w", err))
 			}
-			return backoff.Permanent(fmt.Errorf("OpenAI API error: %s", apiResp.Error.Message))
-		}
-
-		// Check for content policy refusals in structured extraction response
-		text := extractOpenAIText(apiResp)
-		if text != "" && IsContentPolicyRefusal(text) {
-			l.Debug("content policy refusal detected in structured extraction response, will retry")
-			return fmt.Errorf("OpenAI content policy refusal detected")
-		}
-
-		return nil
-	}, backoffConfig, func(err error, duration time.Duration) {
-		l.Debug("retrying OpenAI structured extraction request after %v error=%v", duration, err)
+			return checkOpenAIResponse(l, apiResp, true)
+		},
 	})
 
 	apiDuration := time.Since(apiStart)
@@ -391,7 +279,7 @@ func (a *openAIVisionAgent) ExtractStructuredData(ctx context.Context, req Struc
 	}
 
 	l.Info("received OpenAI response status=%d duration=%v content_length=%d",
-		resp.StatusCode, apiDuration, len(respBody))
+		statusCode, apiDuration, len(respBody))
 
 	text := extractOpenAIText(apiResp)
 	if text == "" {
@@ -452,43 +340,27 @@ func parseOpenAIAPIError(body []byte) string {
 	return ""
 }
 
-// isRetryableError determines if an OpenAI error should be retried
-func isRetryableError(err error, statusCode int, errorMessage string) bool {
-	// Retry on network errors
-	if err != nil {
-		return true
-	}
-
-	// Retry on 5xx server errors
-	if statusCode >= 500 && statusCode < 600 {
-		return true
-	}
-
-	// Retry on rate limit errors
-	if statusCode == http.StatusTooManyRequests {
-		return true
-	}
-
-	// Retry on service unavailable
-	if statusCode == http.StatusServiceUnavailable {
-		return true
+// checkOpenAIResponse returns an error for API errors reported in a successful
+// response body, and when checkRefusal is set for content policy refusals.
+// Errors the API suggests retrying are returned as retryable.
+func checkOpenAIResponse(l logger.Logger, apiResp openAIResponse, checkRefusal bool) error {
+	if apiResp.Error != nil && apiResp.Error.Message != "" {
+		if isRetryableError(nil, 0, apiResp.Error.Message) {
+			l.Debug("retryable OpenAI API error message=%s", apiResp.Error.Message)
+			return fmt.Errorf("OpenAI API error: %s", apiResp.Error.Message)
+		}
+		return backoff.Permanent(fmt.Errorf("OpenAI API error: %s", apiResp.Error.Message))
 	}
 
-	// Retry on OpenAI errors that suggest retrying
-	if errorMessage != "" {
-		lowerMsg := strings.ToLower(errorMessage)
-		if strings.Contains(lowerMsg, "retry") ||
-			strings.Contains(lowerMsg, "error occurred while processing") ||
-			strings.Contains(lowerMsg, "rate limit") ||
-			strings.Contains(lowerMsg, "server error") ||
-			strings.Contains(lowerMsg, "temporary") ||
-			strings.Contains(lowerMsg, "content policy") ||
-			strings.Contains(lowerMsg, "refusal") {
-			return true
+	if checkRefusal {
+		text := extractOpenAIText(apiResp)
+		if text != "" && IsContentPolicyRefusal(text) {
+			l.Debug("content policy refusal detected in response, will retry")
+			return fmt.Errorf("OpenAI content policy refusal detected")
 		}
 	}
 
-	return false
+	return nil
 }
 
 func extractOpenAIText(resp openAIResponse) string {
@@ -539,6 +411,13 @@ func encodeImageDataURI(data []byte, mime string) string {
 	return fmt.Sprintf("data:%s;base64,%s", mime, base64.StdEncoding.EncodeToString(data))
 }
 
+// headers returns the OpenAI API request headers
+func (a *openAIVisionAgent) headers() map[string]string {
+	return map[string]string{
+		"Authorization": fmt.Sprintf("Bearer %s", a.cfg.OpenAIAPIKey),
+	}
+}
+
 // modelName returns the configured vision model, or empty string if unset.
 // Public methods guard against empty up-front and return a clear error.
 func (a *openAIVisionAgent) modelName() string {
@@ -9,6 +9,7 @@ import (
 	coreerror "gitlab.com/alienspaces/playbymail/core/error"
 	"gitlab.com/alienspaces/playbymail/core/nullstring"
 	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
 )
 
@@ -48,8 +49,6 @@ func (m *Domain) UpsertAccount(
 		accountUserRec.ID = existingAccountUserRec.ID
 		accountUserRec.AccountID = existingAccountUserRec.AccountID
 		accountUserRec.CreatedAt = existingAccountUserRec.CreatedAt
-		accountUserRec.SessionToken = existingAccountUserRec.SessionToken
-		accountUserRec.SessionTokenExpiresAt = existingAccountUserRec.SessionTokenExpiresAt
 		accountUserRec.VerificationToken = existingAccountUserRec.VerificationToken
 		accountUserRec.VerificationTokenExpiresAt = existingAccountUserRec.VerificationTokenExpiresAt
 		if accountUserRec, err = m.UpdateAccountUserRec(accountUserRec); err != nil {
@@ -210,6 +209,17 @@ func (m *Domain) GetAccountRec(recID string, lock *coresql.Lock) (*account_recor
 	return rec, nil
 }
 
+// GetAccountLocale returns the locale turn sheets and emails are written in for
+// an account, or the default locale when the account has not chosen one.
+func (m *Domain) GetAccountLocale(accountID string) (string, error) {
+	rec, err := m.GetAccountRec(accountID, nil)
+	if err != nil {
+		return i18n.DefaultLocale, err
+	}
+
+	return i18n.NormalizeLocale(rec.Locale.String), nil
+}
+
 // GetManyAccountRecs returns account (parent/tenant) records.
 func (m *Domain) GetManyAccountRecs(opts *coresql.Options) ([]*account_record.Account, error) {
 	l := m.Logger("GetManyAccountRecs")
@@ -148,42 +148,6 @@ func (m *Domain) GetAccountUserRecByVerificationToken(verificationToken string)
 	return recs[0], nil
 }
 
-func (m *Domain) GetAccountUserRecBySessionToken(token string) (*account_record.AccountUser, error) {
-	l := m.Logger("GetAccountUserRecBySessionToken")
-
-	l.Debug("getting account user record by session token >%s<", token)
-
-	if token == "" {
-		return nil, coreerror.NewInvalidDataError("session token is required")
-	}
-
-	// HMAC hash the provided token
-	hash := hmacSHA256(m.config.TokenHMACKey, token)
-
-	// Look up user account by session token
-	repo := m.AccountUserRepository()
-
-	recs, err := repo.GetMany(&coresql.Options{
-		Params: []coresql.Param{
-			{Col: account_record.FieldAccountUserSessionToken, Val: hash},
-		},
-		Limit: 1,
-	})
-	if err != nil {
-		l.Warn("failed to get account by session token >%s< >%v<", token, err)
-		return nil, err
-	}
-
-	if len(recs) == 0 {
-		l.Info("no account found for session token >%s<", token)
-		return nil, nil
-	}
-
-	rec := recs[0]
-
-	return rec, nil
-}
-
 // CreateAccountUserRec creates an account user record
 func (m *Domain) CreateAccountUserRec(rec *account_record.AccountUser) (*account_record.AccountUser, error) {
 	l := m.Logger("CreateAccountUserRec")
@@ -325,8 +289,6 @@ func (m *Domain) RemoveAccountUserRec(recID string) error {
 	return nil
 }
 
-// User account session functions that should move out of this file..
-
 // Utility for HMAC-SHA256 hashing
 func hmacSHA256(key, data string) string {
 	h := hmac.New(sha256.New, []byte(key))
@@ -346,19 +308,6 @@ func isEmailAddress(s string) bool {
 	return strings.Contains(afterAt, ".") && !strings.HasSuffix(afterAt, ".")
 }
 
-// Session token expiry duration
-const sessionTokenExpiryDuration = 15 * time.Minute
-
-// SessionTokenExpiryDuration returns the duration after which session tokens expire.
-func (m *Domain) SessionTokenExpiryDuration() time.Duration {
-	return sessionTokenExpiryDuration
-}
-
-// SessionTokenExpirySeconds returns the number of seconds until session tokens expire.
-func (m *Domain) SessionTokenExpirySeconds() int {
-	return int(sessionTokenExpiryDuration.Seconds())
-}
-
 func (m *Domain) GenerateAccountUserVerificationToken(rec *account_record.AccountUser) (string, error) {
 	l := m.Logger("GenerateAccountUserVerificationToken")
 
@@ -384,9 +333,10 @@ func (m *Domain) GenerateAccountUserVerificationToken(rec *account_record.Accoun
 	return token, nil
 }
 
-// VerifyAccountUserVerificationToken verifies a verification token and returns a session token.
-// If testBypassEnabled is true, the token is treated as an email address for test authentication.
-func (m *Domain) VerifyAccountUserVerificationToken(token string, testBypassEnabled bool) (string, error) {
+// VerifyAccountUserVerificationToken verifies a verification token and returns a session token
+// for a new session on the device with the user agent. If testBypassEnabled is true, the token
+// is treated as an email address for test authentication.
+func (m *Domain) VerifyAccountUserVerificationToken(token, userAgent string, testBypassEnabled bool) (string, error) {
 	l := m.Logger("VerifyAccountUserVerificationToken")
 
 	l.Info("verifying user account verification token >%s<", token)
@@ -439,41 +389,20 @@ func (m *Domain) VerifyAccountUserVerificationToken(token string, testBypassEnab
 	if rec.Status == account_record.AccountUserStatusPendingApproval {
 		l.Info("promoting account user >%s< from pending_approval to active", rec.ID)
 		rec.Status = account_record.AccountUserStatusActive
-	}
 
-	// Generate session token (also persists any status change via UpdateAccountUserRec)
-	sessionToken, err := m.GenerateAccountUserSessionToken(rec)
-	if err != nil {
-		l.Warn("failed to generate session token >%v<", err)
-		return "", err
+		rec, err = m.UpdateAccountUserRec(rec)
+		if err != nil {
+			l.Warn("failed to update user account >%v<", err)
+			return "", err
+		}
 	}
 
-	return sessionToken, nil
-}
-
-// GenerateAccountUserSessionToken generates a session token for an account user record.
-func (m *Domain) GenerateAccountUserSessionToken(rec *account_record.AccountUser) (string, error) {
-	l := m.Logger("GenerateAccountUserSessionToken")
-
-	l.Debug("generating session token for user account ID >%s<", rec.ID)
-
-	// Generate session token
-	sessionToken := corerecord.NewRecordID()
-
-	// Hash the session token
-	hashedSessionToken := hmacSHA256(m.config.TokenHMACKey, sessionToken)
-
-	rec.SessionToken = nullstring.FromString(hashedSessionToken)
-	rec.SessionTokenExpiresAt = nulltime.FromTime(corerecord.NewRecordTimestamp().Add(sessionTokenExpiryDuration))
-
-	_, err := m.UpdateAccountUserRec(rec)
+	sessionToken, err := m.GenerateAccountUserSessionToken(rec, userAgent)
 	if err != nil {
-		l.Warn("failed to update user account >%v<", err)
+		l.Warn("failed to generate session token >%v<", err)
 		return "", err
 	}
 
-	l.Info("generated session token >%s< for user account ID >%s<", sessionToken, rec.ID)
-
 	return sessionToken, nil
 }
 
@@ -493,48 +422,3 @@ func (m *Domain) IsTestBypassEnabled(headerValue string) bool {
 func (m *Domain) GetTestBypassHeaderName() string {
 	return m.config.TestBypassHeaderName
 }
-
-func (m *Domain) VerifyAccountUserSessionToken(token string) (*account_record.AccountUser, error) {
-	l := m.Logger("VerifyAccountUserSessionToken")
-
-	l.Info("verifying user account session token >%s<", token)
-
-	rec, err := m.GetAccountUserRecBySessionToken(token)
-	if err != nil {
-		l.Warn("failed to get account user by session token >%s< >%v<", token, err)
-		return nil, err
-	}
-
-	if rec == nil {
-		l.Info("no account user found for session token >%s<", token)
-		return nil, nil
-	}
-
-	// Has the session token expired?
-	if rec.SessionTokenExpiresAt.Time.Before(corerecord.NewRecordTimestamp()) {
-		l.Info("session token >%s< has expired", token)
-		return nil, nil
-	}
-
-	// Now get the account record with a lock; we want to extend the
-	// session token expiration time and need to wait for any concurrent
-	// updates to the account record.
-	rec, err = m.GetAccountUserRec(rec.ID, coresql.ForUpdate)
-	if err != nil {
-		l.Warn("failed to get account >%v<", err)
-		return nil, err
-	}
-
-	// Extend the expiration time of the session token
-	rec.SessionTokenExpiresAt = nulltime.FromTime(corerecord.NewRecordTimestamp().Add(sessionTokenExpiryDuration))
-
-	_, err = m.UpdateAccountUserRec(rec)
-	if err != nil {
-		l.Warn("failed to update account >%v<", err)
-		return nil, err
-	}
-
-	l.Info("account found for session token >%s<", token)
-
-	return rec, nil
-}
@@ -0,0 +1,283 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	corerecord "gitlab.com/alienspaces/playbymail/core/record"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
+)
+
+// apiTokenPrefix starts every API token so API tokens can be told apart from
+// session tokens when a request is authenticated
+const apiTokenPrefix = "pbm_"
+
+// apiTokenRandomBytes is the number of random bytes in an API token
+const apiTokenRandomBytes = 32
+
+// apiTokenDisplayPrefixLength is the number of leading token characters stored
+// so a user can tell their tokens apart
+const apiTokenDisplayPrefixLength = 12
+
+// apiTokenLastUsedInterval limits how often the last used time of a token is
+// recorded, so scripts making many requests do not update the token on every
+// request
+const apiTokenLastUsedInterval = time.Minute
+
+// IsAccountUserAPIToken returns true when a bearer token is an API token rather
+// than a session token
+func IsAccountUserAPIToken(token string) bool {
+	return strings.HasPrefix(token, apiTokenPrefix)
+}
+
+// GetManyAccountUserAPITokenRecs -
+func (m *Domain) GetManyAccountUserAPITokenRecs(opts *coresql.Options) ([]*account_record.AccountUserAPIToken, error) {
+	l := m.Logger("GetManyAccountUserAPITokenRecs")
+
+	l.Debug("getting many account user API token records opts >%#v<", opts)
+
+	r := m.AccountUserAPITokenRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+// GetAccountUserAPITokenRec -
+func (m *Domain) GetAccountUserAPITokenRec(recID string, lock *coresql.Lock) (*account_record.AccountUserAPIToken, error) {
+	l := m.Logger("GetAccountUserAPITokenRec")
+
+	l.Debug("getting account user API token record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.AccountUserAPITokenRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(account_record.TableAccountUserAPIToken, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// CreateAccountUserAPITokenRec -
+func (m *Domain) CreateAccountUserAPITokenRec(rec *account_record.AccountUserAPIToken) (*account_record.AccountUserAPIToken, error) {
+	l := m.Logger("CreateAccountUserAPITokenRec")
+
+	l.Debug("creating account user API token record for account user ID >%s<", rec.AccountUserID)
+
+	if err := m.validateAccountUserAPITokenRecForCreate(rec); err != nil {
+		l.Warn("failed to validate account user API token record >%v<", err)
+		return rec, err
+	}
+
+	// Tokens not limited to any games store an empty list rather than null
+	if rec.GameIDs == nil {
+		rec.GameIDs = []string{}
+	}
+
+	r := m.AccountUserAPITokenRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// UpdateAccountUserAPITokenRec -
+func (m *Domain) UpdateAccountUserAPITokenRec(rec *account_record.AccountUserAPIToken) (*account_record.AccountUserAPIToken, error) {
+	l := m.Logger("UpdateAccountUserAPITokenRec")
+
+	currRec, err := m.GetAccountUserAPITokenRec(rec.ID, coresql.ForUpdate)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating account user API token record ID >%s<", rec.ID)
+
+	if err := m.validateAccountUserAPITokenRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate account user API token record >%v<", err)
+		return rec, err
+	}
+
+	r := m.AccountUserAPITokenRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+// DeleteAccountUserAPITokenRec -
+func (m *Domain) DeleteAccountUserAPITokenRec(recID string) error {
+	l := m.Logger("DeleteAccountUserAPITokenRec")
+
+	l.Debug("deleting account user API token record ID >%s<", recID)
+
+	_, err := m.GetAccountUserAPITokenRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.AccountUserAPITokenRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// RemoveAccountUserAPITokenRec -
+func (m *Domain) RemoveAccountUserAPITokenRec(recID string) error {
+	l := m.Logger("RemoveAccountUserAPITokenRec")
+
+	l.Debug("removing account user API token record ID >%s<", recID)
+
+	r := m.AccountUserAPITokenRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// GenerateAccountUserAPIToken creates an API token with the name, permissions,
+// games and expiry of the record, returning the created record and the token.
+// The token is only ever returned here, only its hash is stored.
+func (m *Domain) GenerateAccountUserAPIToken(rec *account_record.AccountUserAPIToken) (*account_record.AccountUserAPIToken, string, error) {
+	l := m.Logger("GenerateAccountUserAPIToken")
+
+	l.Debug("generating API token for account user ID >%s<", rec.AccountUserID)
+
+	b := make([]byte, apiTokenRandomBytes)
+	if _, err := rand.Read(b); err != nil {
+		l.Warn("failed to generate API token >%v<", err)
+		return nil, "", coreerror.NewInternalError("failed to generate API token: %v", err)
+	}
+
+	token := apiTokenPrefix + hex.EncodeToString(b)
+
+	rec.TokenHash = hmacSHA256(m.config.TokenHMACKey, token)
+	rec.TokenPrefix = token[:apiTokenDisplayPrefixLength]
+
+	rec, err := m.CreateAccountUserAPITokenRec(rec)
+	if err != nil {
+		l.Warn("failed to create account user API token >%v<", err)
+		return nil, "", err
+	}
+
+	l.Info("generated API token >%s< for account user ID >%s<", rec.ID, rec.AccountUserID)
+
+	return rec, token, nil
+}
+
+// VerifyAccountUserAPIToken returns the API token record for a token and the
+// account user it belongs to, recording when the token was used. Nil records
+// are returned when the token is unknown or has expired.
+func (m *Domain) VerifyAccountUserAPIToken(token string) (*account_record.AccountUserAPIToken, *account_record.AccountUser, error) {
+	l := m.Logger("VerifyAccountUserAPIToken")
+
+	l.Info("verifying account user API token")
+
+	if !IsAccountUserAPIToken(token) {
+		return nil, nil, coreerror.NewInvalidDataError("token is not an API token")
+	}
+
+	recs, err := m.GetManyAccountUserAPITokenRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: account_record.FieldAccountUserAPITokenTokenHash, Val: hmacSHA256(m.config.TokenHMACKey, token)},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		l.Warn("failed to get account user API token by token hash >%v<", err)
+		return nil, nil, err
+	}
+
+	if len(recs) == 0 {
+		l.Info("no account user API token found for token")
+		return nil, nil, nil
+	}
+
+	rec := recs[0]
+
+	now := corerecord.NewRecordTimestamp()
+	if rec.ExpiresAt.Valid && !rec.ExpiresAt.Time.After(now) {
+		l.Info("API token >%s< has expired", rec.ID)
+		return nil, nil, nil
+	}
+
+	accountUserRec, err := m.GetAccountUserRec(rec.AccountUserID, nil)
+	if err != nil {
+		l.Warn("failed to get account user >%v<", err)
+		return nil, nil, err
+	}
+
+	if accountUserRec.Status == account_record.AccountUserStatusDisabled {
+		l.Info("account user >%s< for API token >%s< is disabled", accountUserRec.ID, rec.ID)
+		return nil, nil, nil
+	}
+
+	if !rec.LastUsedAt.Valid || now.Sub(rec.LastUsedAt.Time) >= apiTokenLastUsedInterval {
+		rec.LastUsedAt = nulltime.FromTime(now)
+		rec, err = m.UpdateAccountUserAPITokenRec(rec)
+		if err != nil {
+			l.Warn("failed to update account user API token last used >%v<", err)
+			return nil, nil, err
+		}
+	}
+
+	l.Info("account user >%s< found for API token >%s<", accountUserRec.ID, rec.ID)
+
+	return rec, accountUserRec, nil
+}
+
+// RevokeAccountUserAPIToken revokes an API token of an account user
+func (m *Domain) RevokeAccountUserAPIToken(accountUserID, tokenID string) error {
+	l := m.Logger("RevokeAccountUserAPIToken")
+
+	rec, err := m.GetAccountUserAPITokenRec(tokenID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	// Tokens of other account users are reported as not found so token IDs
+	// cannot be probed
+	if rec.AccountUserID != accountUserID {
+		l.Warn("API token >%s< does not belong to account user >%s<", tokenID, accountUserID)
+		return coreerror.NewNotFoundError(account_record.TableAccountUserAPIToken, tokenID)
+	}
+
+	if err := m.RemoveAccountUserAPITokenRec(rec.ID); err != nil {
+		l.Warn("failed to remove API token >%s< >%v<", rec.ID, err)
+		return err
+	}
+
+	l.Info("revoked API token >%s< for account user >%s<", rec.ID, accountUserID)
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/collection/set"
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	corerecord "gitlab.com/alienspaces/playbymail/core/record"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+// maxAccountUserAPITokens limits the number of API tokens an account user may have
+const maxAccountUserAPITokens = 25
+
+var accountUserAPITokenPermissions = set.New(
+	account_record.AccountUserAPITokenPermissionGameDesign,
+	account_record.AccountUserAPITokenPermissionGameManagement,
+	account_record.AccountUserAPITokenPermissionGamePlaying,
+)
+
+type validateAccountUserAPITokenArgs struct {
+	nextRec *account_record.AccountUserAPIToken
+	currRec *account_record.AccountUserAPIToken
+	// tokenCount is the number of API tokens the account user already has
+	tokenCount int
+	// subscribedGameIDs are the games of the token the account has a game
+	// subscription for
+	subscribedGameIDs set.Set[string]
+}
+
+func (m *Domain) populateAccountUserAPITokenValidateArgs(currRec, nextRec *account_record.AccountUserAPIToken) (*validateAccountUserAPITokenArgs, error) {
+	args := &validateAccountUserAPITokenArgs{
+		currRec:           currRec,
+		nextRec:           nextRec,
+		subscribedGameIDs: set.New[string](),
+	}
+
+	// Counts and game subscriptions are only needed when creating a token
+	if currRec != nil || nextRec == nil {
+		return args, nil
+	}
+
+	if err := domain.ValidateUUIDField(account_record.FieldAccountUserAPITokenAccountUserID, nextRec.AccountUserID); err != nil {
+		return nil, err
+	}
+
+	tokenRecs, err := m.GetManyAccountUserAPITokenRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: account_record.FieldAccountUserAPITokenAccountUserID, Val: nextRec.AccountUserID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	args.tokenCount = len(tokenRecs)
+
+	for _, gameID := range nextRec.GameIDs {
+		if !domain.IsUUID(gameID) || args.subscribedGameIDs.Has(gameID) {
+			continue
+		}
+		gameSubscriptionRecs, err := m.GetManyGameSubscriptionRecs(&coresql.Options{
+			Params: []coresql.Param{
+				{Col: game_record.FieldGameSubscriptionAccountID, Val: nextRec.AccountID},
+				{Col: game_record.FieldGameSubscriptionGameID, Val: gameID},
+			},
+			Limit: 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(gameSubscriptionRecs) > 0 {
+			args.subscribedGameIDs.Add(gameID)
+		}
+	}
+
+	return args, nil
+}
+
+func (m *Domain) validateAccountUserAPITokenRecForCreate(rec *account_record.AccountUserAPIToken) error {
+	args, err := m.populateAccountUserAPITokenValidateArgs(nil, rec)
+	if err != nil {
+		return err
+	}
+	return validateAccountUserAPITokenRecForCreate(args)
+}
+
+func (m *Domain) validateAccountUserAPITokenRecForUpdate(currRec, nextRec *account_record.AccountUserAPIToken) error {
+	args, err := m.populateAccountUserAPITokenValidateArgs(currRec, nextRec)
+	if err != nil {
+		return err
+	}
+	return validateAccountUserAPITokenRecForUpdate(args)
+}
+
+func validateAccountUserAPITokenRecForCreate(args *validateAccountUserAPITokenArgs) error {
+	rec := args.nextRec
+
+	if err := validateAccountUserAPITokenRec(rec); err != nil {
+		return err
+	}
+
+	if args.tokenCount >= maxAccountUserAPITokens {
+		return coreerror.NewInvalidDataError("an account user may have at most %d API tokens", maxAccountUserAPITokens)
+	}
+
+	// A token may only be limited to games the account takes part in
+	for _, gameID := range rec.GameIDs {
+		if !args.subscribedGameIDs.Has(gameID) {
+			return InvalidField(account_record.FieldAccountUserAPITokenGameIDs, gameID, "game_ids must only contain games the account is subscribed to")
+		}
+	}
+
+	if rec.ExpiresAt.Valid && !rec.ExpiresAt.Time.After(corerecord.NewRecordTimestamp()) {
+		return InvalidField(account_record.FieldAccountUserAPITokenExpiresAt, rec.ExpiresAt.Time.String(), "expires_at must be in the future")
+	}
+
+	return nil
+}
+
+func validateAccountUserAPITokenRecForUpdate(args *validateAccountUserAPITokenArgs) error {
+	if err := validateAccountUserAPITokenRec(args.nextRec); err != nil {
+		return err
+	}
+
+	// A token always belongs to the account user it was created for and keeps
+	// the secret it was issued with
+	if args.currRec.AccountUserID != args.nextRec.AccountUserID {
+		return InvalidField(account_record.FieldAccountUserAPITokenAccountUserID, args.nextRec.AccountUserID, "account_user_id cannot be changed")
+	}
+	if args.currRec.TokenHash != args.nextRec.TokenHash {
+		return InvalidField(account_record.FieldAccountUserAPITokenTokenHash, "", "token_hash cannot be changed")
+	}
+
+	return nil
+}
+
+func validateAccountUserAPITokenRec(rec *account_record.AccountUserAPIToken) error {
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if err := domain.ValidateUUIDField(account_record.FieldAccountUserAPITokenAccountID, rec.AccountID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(account_record.FieldAccountUserAPITokenAccountUserID, rec.AccountUserID); err != nil {
+		return err
+	}
+
+	if rec.Name == "" {
+		return RequiredField(account_record.FieldAccountUserAPITokenName)
+	}
+
+	if len(rec.Name) > 128 {
+		return InvalidField(account_record.FieldAccountUserAPITokenName, rec.Name, "name must be 128 characters or less")
+	}
+
+	if rec.TokenHash == "" {
+		return RequiredField(account_record.FieldAccountUserAPITokenTokenHash)
+	}
+
+	if rec.TokenPrefix == "" {
+		return RequiredField(account_record.FieldAccountUserAPITokenTokenPrefix)
+	}
+
+	if len(rec.Permissions) == 0 {
+		return RequiredField(account_record.FieldAccountUserAPITokenPermissions)
+	}
+
+	seen := set.New[string]()
+	for _, permission := range rec.Permissions {
+		if !accountUserAPITokenPermissions.Has(permission) {
+			return InvalidField(account_record.FieldAccountUserAPITokenPermissions, permission, "permissions must be one of game_design, game_management or game_playing")
+		}
+		if seen.Has(permission) {
+			return InvalidField(account_record.FieldAccountUserAPITokenPermissions, permission, "permissions must not contain duplicates")
+		}
+		seen.Add(permission)
+	}
+
+	for _, gameID := range rec.GameIDs {
+		if err := domain.ValidateUUIDField(account_record.FieldAccountUserAPITokenGameIDs, gameID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/collection/set"
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
+)
+
+const testAPITokenGameID = "00000000-0000-0000-0000-000000000003"
+
+func validAPITokenRec() *account_record.AccountUserAPIToken {
+	return &account_record.AccountUserAPIToken{
+		AccountID:     "00000000-0000-0000-0000-000000000001",
+		AccountUserID: "00000000-0000-0000-0000-000000000002",
+		Name:          "Build pipeline",
+		TokenPrefix:   "pbm_0123abcd",
+		TokenHash:     "hash",
+		Permissions:   []string{account_record.AccountUserAPITokenPermissionGameDesign},
+	}
+}
+
+func runAPITokenCreateValidator(rec *account_record.AccountUserAPIToken) error {
+	return validateAccountUserAPITokenRecForCreate(&validateAccountUserAPITokenArgs{
+		nextRec:           rec,
+		subscribedGameIDs: set.New(testAPITokenGameID),
+	})
+}
+
+func TestValidateAccountUserAPIToken_ValidPasses(t *testing.T) {
+	require.NoError(t, runAPITokenCreateValidator(validAPITokenRec()))
+
+	rec := validAPITokenRec()
+	rec.Permissions = []string{
+		account_record.AccountUserAPITokenPermissionGameManagement,
+		account_record.AccountUserAPITokenPermissionGamePlaying,
+	}
+	rec.GameIDs = []string{testAPITokenGameID}
+	rec.ExpiresAt = nulltime.FromTime(time.Now().Add(24 * time.Hour))
+	require.NoError(t, runAPITokenCreateValidator(rec))
+}
+
+func TestValidateAccountUserAPIToken_InvalidRejected(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		tweak func(*account_record.AccountUserAPIToken)
+	}{
+		{"missing name", func(r *account_record.AccountUserAPIToken) { r.Name = "" }},
+		{"missing permissions", func(r *account_record.AccountUserAPIToken) { r.Permissions = nil }},
+		{"unknown permission", func(r *account_record.AccountUserAPIToken) { r.Permissions = []string{"game_admin"} }},
+		{"duplicate permission", func(r *account_record.AccountUserAPIToken) {
+			r.Permissions = []string{account_record.AccountUserAPITokenPermissionGameDesign, account_record.AccountUserAPITokenPermissionGameDesign}
+		}},
+		{"invalid game id", func(r *account_record.AccountUserAPIToken) { r.GameIDs = []string{"not-a-uuid"} }},
+		{"unsubscribed game", func(r *account_record.AccountUserAPIToken) {
+			r.GameIDs = []string{"00000000-0000-0000-0000-000000000004"}
+		}},
+		{"expired", func(r *account_record.AccountUserAPIToken) {
+			r.ExpiresAt = nulltime.FromTime(time.Now().Add(-time.Minute))
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := validAPITokenRec()
+			tc.tweak(rec)
+			require.Error(t, runAPITokenCreateValidator(rec))
+		})
+	}
+}
+
+func TestValidateAccountUserAPIToken_TokenLimitRejected(t *testing.T) {
+	err := validateAccountUserAPITokenRecForCreate(&validateAccountUserAPITokenArgs{
+		nextRec:           validAPITokenRec(),
+		tokenCount:        maxAccountUserAPITokens,
+		subscribedGameIDs: set.New[string](),
+	})
+	require.Error(t, err)
+}
+
+func TestIsAccountUserAPIToken(t *testing.T) {
+	require.True(t, IsAccountUserAPIToken("pbm_0123456789abcdef"))
+	require.False(t, IsAccountUserAPIToken("0b5f3c1e-6d2a-4a8e-9f1b-2c3d4e5f6a7b"))
+	require.False(t, IsAccountUserAPIToken(""))
+}
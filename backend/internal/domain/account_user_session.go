@@ -0,0 +1,371 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	corerecord "gitlab.com/alienspaces/playbymail/core/record"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
+)
+
+// Session token expiry duration
+const sessionTokenExpiryDuration = 15 * time.Minute
+
+// maxSessionUserAgentLength limits the user agent stored with a session
+const maxSessionUserAgentLength = 512
+
+// SessionTokenExpiryDuration returns the duration after which session tokens expire.
+func (m *Domain) SessionTokenExpiryDuration() time.Duration {
+	return sessionTokenExpiryDuration
+}
+
+// SessionTokenExpirySeconds returns the number of seconds until session tokens expire.
+func (m *Domain) SessionTokenExpirySeconds() int {
+	return int(sessionTokenExpiryDuration.Seconds())
+}
+
+// GetManyAccountUserSessionRecs -
+func (m *Domain) GetManyAccountUserSessionRecs(opts *coresql.Options) ([]*account_record.AccountUserSession, error) {
+	l := m.Logger("GetManyAccountUserSessionRecs")
+
+	l.Debug("getting many account user session records opts >%#v<", opts)
+
+	r := m.AccountUserSessionRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+// GetAccountUserSessionRec -
+func (m *Domain) GetAccountUserSessionRec(recID string, lock *coresql.Lock) (*account_record.AccountUserSession, error) {
+	l := m.Logger("GetAccountUserSessionRec")
+
+	l.Debug("getting account user session record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.AccountUserSessionRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(account_record.TableAccountUserSession, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// GetAccountUserSessionRecBySessionToken returns the session a session token was
+// issued for, or nil when no session has the token
+func (m *Domain) GetAccountUserSessionRecBySessionToken(token string, lock *coresql.Lock) (*account_record.AccountUserSession, error) {
+	l := m.Logger("GetAccountUserSessionRecBySessionToken")
+
+	if token == "" {
+		return nil, coreerror.NewInvalidDataError("session token is required")
+	}
+
+	recs, err := m.GetManyAccountUserSessionRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: account_record.FieldAccountUserSessionSessionToken, Val: hmacSHA256(m.config.TokenHMACKey, token)},
+		},
+		Limit: 1,
+		Lock:  lock,
+	})
+	if err != nil {
+		l.Warn("failed to get account user session by session token >%v<", err)
+		return nil, err
+	}
+
+	if len(recs) == 0 {
+		return nil, nil
+	}
+
+	return recs[0], nil
+}
+
+// GetActiveAccountUserSessionRecs returns the unexpired sessions of an account
+// user, most recently used first
+func (m *Domain) GetActiveAccountUserSessionRecs(accountUserID string) ([]*account_record.AccountUserSession, error) {
+	l := m.Logger("GetActiveAccountUserSessionRecs")
+
+	l.Debug("getting active account user session records for account user ID >%s<", accountUserID)
+
+	if err := domain.ValidateUUIDField(account_record.FieldAccountUserSessionAccountUserID, accountUserID); err != nil {
+		return nil, err
+	}
+
+	return m.GetManyAccountUserSessionRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: account_record.FieldAccountUserSessionAccountUserID, Val: accountUserID},
+			{Col: account_record.FieldAccountUserSessionExpiresAt, Val: corerecord.NewRecordTimestamp(), Op: coresql.OpGreaterThan},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: account_record.FieldAccountUserSessionLastSeenAt, Direction: coresql.OrderDirectionDESC},
+		},
+	})
+}
+
+// CreateAccountUserSessionRec -
+func (m *Domain) CreateAccountUserSessionRec(rec *account_record.AccountUserSession) (*account_record.AccountUserSession, error) {
+	l := m.Logger("CreateAccountUserSessionRec")
+
+	l.Debug("creating account user session record for account user ID >%s<", rec.AccountUserID)
+
+	if err := m.validateAccountUserSessionRecForCreate(rec); err != nil {
+		l.Warn("failed to validate account user session record >%v<", err)
+		return rec, err
+	}
+
+	r := m.AccountUserSessionRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// UpdateAccountUserSessionRec -
+func (m *Domain) UpdateAccountUserSessionRec(rec *account_record.AccountUserSession) (*account_record.AccountUserSession, error) {
+	l := m.Logger("UpdateAccountUserSessionRec")
+
+	currRec, err := m.GetAccountUserSessionRec(rec.ID, coresql.ForUpdate)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating account user session record ID >%s<", rec.ID)
+
+	if err := m.validateAccountUserSessionRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate account user session record >%v<", err)
+		return rec, err
+	}
+
+	r := m.AccountUserSessionRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+// DeleteAccountUserSessionRec -
+func (m *Domain) DeleteAccountUserSessionRec(recID string) error {
+	l := m.Logger("DeleteAccountUserSessionRec")
+
+	l.Debug("deleting account user session record ID >%s<", recID)
+
+	_, err := m.GetAccountUserSessionRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.AccountUserSessionRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// RemoveAccountUserSessionRec -
+func (m *Domain) RemoveAccountUserSessionRec(recID string) error {
+	l := m.Logger("RemoveAccountUserSessionRec")
+
+	l.Debug("removing account user session record ID >%s<", recID)
+
+	r := m.AccountUserSessionRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// GenerateAccountUserSessionToken signs an account user in on a new device,
+// returning the session token the device authenticates with. Sessions on the
+// account user's other devices are not affected.
+func (m *Domain) GenerateAccountUserSessionToken(rec *account_record.AccountUser, userAgent string) (string, error) {
+	l := m.Logger("GenerateAccountUserSessionToken")
+
+	l.Debug("generating session token for user account ID >%s<", rec.ID)
+
+	// Expired sessions are no longer usable so are removed as new sessions are created
+	if err := m.removeExpiredAccountUserSessionRecs(rec.ID); err != nil {
+		l.Warn("failed to remove expired sessions >%v<", err)
+		return "", err
+	}
+
+	// Generate session token
+	sessionToken := corerecord.NewRecordID()
+
+	if len(userAgent) > maxSessionUserAgentLength {
+		userAgent = userAgent[:maxSessionUserAgentLength]
+	}
+
+	now := corerecord.NewRecordTimestamp()
+
+	sessionRec := &account_record.AccountUserSession{
+		AccountID:     rec.AccountID,
+		AccountUserID: rec.ID,
+		SessionToken:  hmacSHA256(m.config.TokenHMACKey, sessionToken),
+		ExpiresAt:     now.Add(sessionTokenExpiryDuration),
+		LastSeenAt:    now,
+		UserAgent:     nullstring.FromString(userAgent),
+	}
+
+	sessionRec, err := m.CreateAccountUserSessionRec(sessionRec)
+	if err != nil {
+		l.Warn("failed to create account user session >%v<", err)
+		return "", err
+	}
+
+	l.Info("generated session >%s< for user account ID >%s<", sessionRec.ID, rec.ID)
+
+	return sessionToken, nil
+}
+
+// VerifyAccountUserSessionToken returns the session a session token was issued
+// for and the account user it belongs to, extending the session's expiry. Nil
+// records are returned when the token is unknown or the session has expired.
+func (m *Domain) VerifyAccountUserSessionToken(token string) (*account_record.AccountUserSession, *account_record.AccountUser, error) {
+	l := m.Logger("VerifyAccountUserSessionToken")
+
+	l.Info("verifying user account session token")
+
+	// Get the session with a lock; we want to extend the session expiration
+	// time and need to wait for any concurrent requests on the same session.
+	sessionRec, err := m.GetAccountUserSessionRecBySessionToken(token, coresql.ForUpdate)
+	if err != nil {
+		l.Warn("failed to get account user session by session token >%v<", err)
+		return nil, nil, err
+	}
+
+	if sessionRec == nil {
+		l.Info("no account user session found for session token")
+		return nil, nil, nil
+	}
+
+	// Has the session expired?
+	now := corerecord.NewRecordTimestamp()
+	if sessionRec.ExpiresAt.Before(now) {
+		l.Info("session >%s< has expired", sessionRec.ID)
+		return nil, nil, nil
+	}
+
+	rec, err := m.GetAccountUserRec(sessionRec.AccountUserID, nil)
+	if err != nil {
+		l.Warn("failed to get account user >%v<", err)
+		return nil, nil, err
+	}
+
+	// Extend the expiration time of the session
+	sessionRec.ExpiresAt = now.Add(sessionTokenExpiryDuration)
+	sessionRec.LastSeenAt = now
+
+	sessionRec, err = m.UpdateAccountUserSessionRec(sessionRec)
+	if err != nil {
+		l.Warn("failed to update account user session >%v<", err)
+		return nil, nil, err
+	}
+
+	l.Info("account user >%s< found for session >%s<", rec.ID, sessionRec.ID)
+
+	return sessionRec, rec, nil
+}
+
+// RevokeAccountUserSession signs an account user out of a single session
+func (m *Domain) RevokeAccountUserSession(accountUserID, sessionID string) error {
+	l := m.Logger("RevokeAccountUserSession")
+
+	sessionRec, err := m.GetAccountUserSessionRec(sessionID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	// Sessions of other account users are reported as not found so session
+	// IDs cannot be probed
+	if sessionRec.AccountUserID != accountUserID {
+		l.Warn("session >%s< does not belong to account user >%s<", sessionID, accountUserID)
+		return coreerror.NewNotFoundError(account_record.TableAccountUserSession, sessionID)
+	}
+
+	if err := m.RemoveAccountUserSessionRec(sessionRec.ID); err != nil {
+		l.Warn("failed to remove session >%s< >%v<", sessionRec.ID, err)
+		return err
+	}
+
+	l.Info("revoked session >%s< for account user >%s<", sessionRec.ID, accountUserID)
+
+	return nil
+}
+
+// RevokeAllAccountUserSessions signs an account user out on every device,
+// returning the number of sessions revoked
+func (m *Domain) RevokeAllAccountUserSessions(accountUserID string) (int, error) {
+	l := m.Logger("RevokeAllAccountUserSessions")
+
+	if err := domain.ValidateUUIDField(account_record.FieldAccountUserSessionAccountUserID, accountUserID); err != nil {
+		return 0, err
+	}
+
+	sessionRecs, err := m.GetManyAccountUserSessionRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: account_record.FieldAccountUserSessionAccountUserID, Val: accountUserID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get sessions for account user >%s< >%v<", accountUserID, err)
+		return 0, err
+	}
+
+	for _, sessionRec := range sessionRecs {
+		if err := m.RemoveAccountUserSessionRec(sessionRec.ID); err != nil {
+			l.Warn("failed to remove session >%s< >%v<", sessionRec.ID, err)
+			return 0, err
+		}
+	}
+
+	l.Info("revoked >%d< sessions for account user >%s<", len(sessionRecs), accountUserID)
+
+	return len(sessionRecs), nil
+}
+
+func (m *Domain) removeExpiredAccountUserSessionRecs(accountUserID string) error {
+	sessionRecs, err := m.GetManyAccountUserSessionRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: account_record.FieldAccountUserSessionAccountUserID, Val: accountUserID},
+			{Col: account_record.FieldAccountUserSessionExpiresAt, Val: corerecord.NewRecordTimestamp(), Op: coresql.OpLessThanEqual},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, sessionRec := range sessionRecs {
+		if err := m.RemoveAccountUserSessionRec(sessionRec.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
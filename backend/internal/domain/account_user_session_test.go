@@ -0,0 +1,138 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/harness"
+	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+	"gitlab.com/alienspaces/playbymail/internal/utils/deps"
+)
+
+func TestAccountUserSessions(t *testing.T) {
+	cfg, err := config.Parse()
+	require.NoError(t, err)
+
+	l, s, j, scanner, err := deps.NewDefaultDependencies(cfg)
+	require.NoError(t, err)
+
+	th, err := harness.NewTesting(cfg, l, s, j, scanner, harness.DataConfig{})
+	require.NoError(t, err)
+
+	th.ShouldCommitData = false
+
+	_, err = th.Setup()
+	require.NoError(t, err)
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err)
+	}()
+
+	m := th.Domain.(*domain.Domain)
+
+	newAccountUser := func(t *testing.T) *account_record.AccountUser {
+		_, accountUserRec, _, _, err := m.UpsertAccount(
+			&account_record.Account{},
+			&account_record.AccountUser{
+				Email:  harness.UniqueEmail("session@example.com"),
+				Status: account_record.AccountUserStatusActive,
+			},
+			nil,
+		)
+		require.NoError(t, err)
+		return accountUserRec
+	}
+
+	t.Run("signing in on a second device keeps the first device signed in", func(t *testing.T) {
+		accountUserRec := newAccountUser(t)
+
+		desktopToken, err := m.GenerateAccountUserSessionToken(accountUserRec, "desktop browser")
+		require.NoError(t, err)
+
+		phoneToken, err := m.GenerateAccountUserSessionToken(accountUserRec, "phone browser")
+		require.NoError(t, err)
+		require.NotEqual(t, desktopToken, phoneToken)
+
+		for _, token := range []string{desktopToken, phoneToken} {
+			sessionRec, rec, err := m.VerifyAccountUserSessionToken(token)
+			require.NoError(t, err)
+			require.NotNil(t, sessionRec, "session is found for token")
+			require.NotNil(t, rec, "account user is found for token")
+			require.Equal(t, accountUserRec.ID, rec.ID)
+			require.NotEqual(t, token, sessionRec.SessionToken, "session token is stored hashed")
+		}
+
+		sessionRecs, err := m.GetActiveAccountUserSessionRecs(accountUserRec.ID)
+		require.NoError(t, err)
+		require.Len(t, sessionRecs, 2)
+	})
+
+	t.Run("revoking a session signs out only that device", func(t *testing.T) {
+		accountUserRec := newAccountUser(t)
+
+		desktopToken, err := m.GenerateAccountUserSessionToken(accountUserRec, "desktop browser")
+		require.NoError(t, err)
+
+		phoneToken, err := m.GenerateAccountUserSessionToken(accountUserRec, "phone browser")
+		require.NoError(t, err)
+
+		phoneSessionRec, _, err := m.VerifyAccountUserSessionToken(phoneToken)
+		require.NoError(t, err)
+		require.NotNil(t, phoneSessionRec)
+
+		err = m.RevokeAccountUserSession(accountUserRec.ID, phoneSessionRec.ID)
+		require.NoError(t, err)
+
+		sessionRec, _, err := m.VerifyAccountUserSessionToken(phoneToken)
+		require.NoError(t, err)
+		require.Nil(t, sessionRec, "revoked session is no longer valid")
+
+		sessionRec, _, err = m.VerifyAccountUserSessionToken(desktopToken)
+		require.NoError(t, err)
+		require.NotNil(t, sessionRec, "other session remains valid")
+	})
+
+	t.Run("revoking another account user's session returns not found", func(t *testing.T) {
+		accountUserRec := newAccountUser(t)
+		otherAccountUserRec := newAccountUser(t)
+
+		otherToken, err := m.GenerateAccountUserSessionToken(otherAccountUserRec, "")
+		require.NoError(t, err)
+
+		otherSessionRec, _, err := m.VerifyAccountUserSessionToken(otherToken)
+		require.NoError(t, err)
+		require.NotNil(t, otherSessionRec)
+
+		err = m.RevokeAccountUserSession(accountUserRec.ID, otherSessionRec.ID)
+		require.Error(t, err)
+
+		sessionRec, _, err := m.VerifyAccountUserSessionToken(otherToken)
+		require.NoError(t, err)
+		require.NotNil(t, sessionRec, "other account user's session remains valid")
+	})
+
+	t.Run("signing out everywhere revokes every session", func(t *testing.T) {
+		accountUserRec := newAccountUser(t)
+
+		tokens := []string{}
+		for range 3 {
+			token, err := m.GenerateAccountUserSessionToken(accountUserRec, "")
+			require.NoError(t, err)
+			tokens = append(tokens, token)
+		}
+
+		count, err := m.RevokeAllAccountUserSessions(accountUserRec.ID)
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+
+		for _, token := range tokens {
+			sessionRec, rec, err := m.VerifyAccountUserSessionToken(token)
+			require.NoError(t, err)
+			require.Nil(t, sessionRec)
+			require.Nil(t, rec)
+		}
+	})
+}
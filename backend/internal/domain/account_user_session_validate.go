@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
+)
+
+type validateAccountUserSessionArgs struct {
+	nextRec *account_record.AccountUserSession
+	currRec *account_record.AccountUserSession
+}
+
+func (m *Domain) populateAccountUserSessionValidateArgs(currRec, nextRec *account_record.AccountUserSession) (*validateAccountUserSessionArgs, error) {
+	args := &validateAccountUserSessionArgs{
+		currRec: currRec,
+		nextRec: nextRec,
+	}
+	return args, nil
+}
+
+func (m *Domain) validateAccountUserSessionRecForCreate(rec *account_record.AccountUserSession) error {
+	args, err := m.populateAccountUserSessionValidateArgs(nil, rec)
+	if err != nil {
+		return err
+	}
+	return validateAccountUserSessionRecForCreate(args)
+}
+
+func (m *Domain) validateAccountUserSessionRecForUpdate(currRec, nextRec *account_record.AccountUserSession) error {
+	args, err := m.populateAccountUserSessionValidateArgs(currRec, nextRec)
+	if err != nil {
+		return err
+	}
+	return validateAccountUserSessionRecForUpdate(args)
+}
+
+func validateAccountUserSessionRecForCreate(args *validateAccountUserSessionArgs) error {
+	return validateAccountUserSessionRec(args.nextRec)
+}
+
+func validateAccountUserSessionRecForUpdate(args *validateAccountUserSessionArgs) error {
+	if err := validateAccountUserSessionRec(args.nextRec); err != nil {
+		return err
+	}
+
+	// A session always belongs to the account user it was created for and
+	// keeps the token it was issued with
+	if args.currRec.AccountUserID != args.nextRec.AccountUserID {
+		return InvalidField(account_record.FieldAccountUserSessionAccountUserID, args.nextRec.AccountUserID, "account_user_id cannot be changed")
+	}
+	if args.currRec.SessionToken != args.nextRec.SessionToken {
+		return InvalidField(account_record.FieldAccountUserSessionSessionToken, "", "session_token cannot be changed")
+	}
+
+	return nil
+}
+
+func validateAccountUserSessionRec(rec *account_record.AccountUserSession) error {
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if err := domain.ValidateUUIDField(account_record.FieldAccountUserSessionAccountID, rec.AccountID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(account_record.FieldAccountUserSessionAccountUserID, rec.AccountUserID); err != nil {
+		return err
+	}
+
+	if rec.SessionToken == "" {
+		return RequiredField(account_record.FieldAccountUserSessionSessionToken)
+	}
+
+	if rec.ExpiresAt.IsZero() {
+		return RequiredField(account_record.FieldAccountUserSessionExpiresAt)
+	}
+
+	return nil
+}
@@ -49,7 +49,7 @@ func TestVerifyAccountUserVerificationToken_StatusPromotion(t *testing.T) {
 		require.NoError(t, err)
 		require.NotEmpty(t, token)
 
-		_, err = m.VerifyAccountUserVerificationToken(token, false)
+		_, err = m.VerifyAccountUserVerificationToken(token, "", false)
 		require.NoError(t, err)
 
 		updated, err := m.GetAccountUserRec(accountUserRec.ID, nil)
@@ -72,7 +72,7 @@ func TestVerifyAccountUserVerificationToken_StatusPromotion(t *testing.T) {
 		token, err := m.GenerateAccountUserVerificationToken(accountUserRec)
 		require.NoError(t, err)
 
-		_, err = m.VerifyAccountUserVerificationToken(token, false)
+		_, err = m.VerifyAccountUserVerificationToken(token, "", false)
 		require.NoError(t, err)
 
 		updated, err := m.GetAccountUserRec(accountUserRec.ID, nil)
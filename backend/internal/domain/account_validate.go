@@ -2,6 +2,7 @@ package domain
 
 import (
 	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
 )
 
@@ -57,6 +58,10 @@ func validateAccountRecForCreate(args *validateAccountArgs) error {
 		return err
 	}
 
+	if err := validateAccountLocale(rec.Locale.String); rec.Locale.Valid && err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -75,6 +80,10 @@ func validateAccountRecForUpdate(args *validateAccountArgs) error {
 		return err
 	}
 
+	if err := validateAccountLocale(nextRec.Locale.String); nextRec.Locale.Valid && err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -97,3 +106,10 @@ func validateAccountStatus(status string) error {
 		return coreerror.NewInvalidDataError("invalid account status >%s<", status)
 	}
 }
+
+func validateAccountLocale(locale string) error {
+	if !i18n.IsSupportedLocale(locale) {
+		return coreerror.NewInvalidDataError("unsupported account locale >%s<", locale)
+	}
+	return nil
+}
@@ -84,5 +84,13 @@ func validateAdventureGameCharacterInstanceRec(args *validateAdventureGameCharac
 		)
 	}
 
+	if rec.LivesLost < 0 {
+		return InvalidField(
+			adventure_game_record.FieldAdventureGameCharacterInstanceLivesLost,
+			fmt.Sprintf("%d", rec.LivesLost),
+			"lives lost must be zero or greater",
+		)
+	}
+
 	return nil
 }
@@ -0,0 +1,803 @@
+package domain
+
+import (
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
+)
+
+// AdventureGamePathStep is one thing a player does on the way to a location
+type AdventureGamePathStep struct {
+	Message string `json:"message"`
+
+	// Catalogue message the step is localised from
+	MessageKey  string `json:"-"`
+	MessageArgs []any  `json:"-"`
+}
+
+func newAdventureGamePathStep(key string, args ...any) *AdventureGamePathStep {
+	return &AdventureGamePathStep{
+		Message:     i18n.T(i18n.DefaultLocale, key, args...),
+		MessageKey:  key,
+		MessageArgs: args,
+	}
+}
+
+// Localize returns the step with its message in a locale
+func (s AdventureGamePathStep) Localize(locale string) AdventureGamePathStep {
+	if s.MessageKey != "" {
+		s.Message = i18n.T(locale, s.MessageKey, s.MessageArgs...)
+	}
+	return s
+}
+
+// ExplainAdventureGameLocationPath returns the steps a player takes from a
+// starting location to reach a location, and false when the location can never
+// be reached.
+func (m *Domain) ExplainAdventureGameLocationPath(gameID, locationID string) ([]AdventureGamePathStep, bool, error) {
+	l := m.Logger("ExplainAdventureGameLocationPath")
+
+	locationRec, err := m.GetAdventureGameLocationRec(locationID, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	if locationRec.GameID != gameID {
+		l.Warn("location >%s< does not belong to game >%s<", locationID, gameID)
+		return nil, false, coreerror.NewNotFoundError("location", locationID)
+	}
+
+	def, err := m.getAdventureGameDefinition(gameID)
+	if err != nil {
+		l.Warn("failed getting adventure game definition >%s< >%v<", gameID, err)
+		return nil, false, err
+	}
+
+	a := analyseAdventureGame(def)
+
+	steps, ok := a.path(locationFact(locationID))
+	if !ok {
+		return nil, false, nil
+	}
+
+	return steps, true, nil
+}
+
+// adventureGameDefinition holds the records of an adventure game that decide
+// what players can reach, in creation order
+type adventureGameDefinition struct {
+	locations          []*adventure_game_record.AdventureGameLocation
+	links              []*adventure_game_record.AdventureGameLocationLink
+	requirements       []*adventure_game_record.AdventureGameLocationLinkRequirement
+	items              []*adventure_game_record.AdventureGameItem
+	creatures          []*adventure_game_record.AdventureGameCreature
+	itemPlacements     []*adventure_game_record.AdventureGameItemPlacement
+	creaturePlacements []*adventure_game_record.AdventureGameCreaturePlacement
+	objects            []*adventure_game_record.AdventureGameLocationObject
+	objectStates       []*adventure_game_record.AdventureGameLocationObjectState
+	objectEffects      []*adventure_game_record.AdventureGameLocationObjectEffect
+	itemEffects        []*adventure_game_record.AdventureGameItemEffect
+}
+
+func (m *Domain) getAdventureGameDefinition(gameID string) (*adventureGameDefinition, error) {
+	def := &adventureGameDefinition{}
+
+	var err error
+
+	if def.locations, err = m.GetManyAdventureGameLocationRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameLocationGameID, gameID)); err != nil {
+		return nil, err
+	}
+	if def.links, err = m.GetManyAdventureGameLocationLinkRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameLocationLinkGameID, gameID)); err != nil {
+		return nil, err
+	}
+	if def.requirements, err = m.GetManyAdventureGameLocationLinkRequirementRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameLocationLinkRequirementGameID, gameID)); err != nil {
+		return nil, err
+	}
+	if def.items, err = m.GetManyAdventureGameItemRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameItemGameID, gameID)); err != nil {
+		return nil, err
+	}
+	if def.creatures, err = m.GetManyAdventureGameCreatureRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameCreatureGameID, gameID)); err != nil {
+		return nil, err
+	}
+	if def.itemPlacements, err = m.GetManyAdventureGameItemPlacementRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameItemPlacementGameID, gameID)); err != nil {
+		return nil, err
+	}
+	if def.creaturePlacements, err = m.GetManyAdventureGameCreaturePlacementRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameCreaturePlacementGameID, gameID)); err != nil {
+		return nil, err
+	}
+	if def.objects, err = m.GetManyAdventureGameLocationObjectRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameLocationObjectGameID, gameID)); err != nil {
+		return nil, err
+	}
+	if def.objectStates, err = m.GetManyAdventureGameLocationObjectStateRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameLocationObjectStateGameID, gameID)); err != nil {
+		return nil, err
+	}
+	if def.objectEffects, err = m.GetManyAdventureGameLocationObjectEffectRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameLocationObjectEffectGameID, gameID)); err != nil {
+		return nil, err
+	}
+	if def.itemEffects, err = m.GetManyAdventureGameItemEffectRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameItemEffectGameID, gameID)); err != nil {
+		return nil, err
+	}
+
+	return def, nil
+}
+
+// Facts the analysis derives about what players can achieve. Creature presence
+// at the empty location means the creature can be summoned wherever a player is.
+func locationFact(locationID string) string {
+	return "location:" + locationID
+}
+
+func itemFact(itemID string) string {
+	return "item:" + itemID
+}
+
+func objectVisibleFact(objectID string) string {
+	return "object-visible:" + objectID
+}
+
+func objectStateFact(stateID string) string {
+	return "object-state:" + stateID
+}
+
+func linkOpenFact(linkID string) string {
+	return "link-open:" + linkID
+}
+
+func creaturePresentFact(creatureID, locationID string) string {
+	return "creature-present:" + creatureID + "@" + locationID
+}
+
+func creatureDefeatedFact(creatureID, locationID string) string {
+	return "creature-defeated:" + creatureID + "@" + locationID
+}
+
+func creatureClearedFact(creatureID string) string {
+	return "creature-cleared:" + creatureID
+}
+
+// adventureGameDerivation records how a fact was first achieved
+type adventureGameDerivation struct {
+	// Step a player takes to achieve the fact, nil when the fact follows from
+	// the game definition alone
+	step     *AdventureGamePathStep
+	requires []string
+}
+
+// adventureGameAnalysis models an adventure game as facts players can achieve.
+//
+// Starting from the starting locations and items, it repeatedly applies the
+// game's links, requirements, placements and effects until nothing new can be
+// achieved. Facts are only ever added, so closing links, removing items and
+// hiding objects are ignored and the analysis is optimistic: anything it
+// reports as unreachable can never be reached, while anything reachable may
+// still depend on players making the right choices. Item quantities are also
+// ignored, and creatures are assumed to be defeatable by anyone who finds them.
+type adventureGameAnalysis struct {
+	def   *adventureGameDefinition
+	facts map[string]*adventureGameDerivation
+
+	locations map[string]*adventure_game_record.AdventureGameLocation
+	links     map[string]*adventure_game_record.AdventureGameLocationLink
+	items     map[string]*adventure_game_record.AdventureGameItem
+	creatures map[string]*adventure_game_record.AdventureGameCreature
+	objects   map[string]*adventure_game_record.AdventureGameLocationObject
+	states    map[string]*adventure_game_record.AdventureGameLocationObjectState
+
+	requirementsByLinkID map[string][]*adventure_game_record.AdventureGameLocationLinkRequirement
+}
+
+func analyseAdventureGame(def *adventureGameDefinition) *adventureGameAnalysis {
+	a := &adventureGameAnalysis{
+		def:                  def,
+		facts:                make(map[string]*adventureGameDerivation),
+		locations:            make(map[string]*adventure_game_record.AdventureGameLocation),
+		links:                make(map[string]*adventure_game_record.AdventureGameLocationLink),
+		items:                make(map[string]*adventure_game_record.AdventureGameItem),
+		creatures:            make(map[string]*adventure_game_record.AdventureGameCreature),
+		objects:              make(map[string]*adventure_game_record.AdventureGameLocationObject),
+		states:               make(map[string]*adventure_game_record.AdventureGameLocationObjectState),
+		requirementsByLinkID: make(map[string][]*adventure_game_record.AdventureGameLocationLinkRequirement),
+	}
+
+	for _, rec := range def.locations {
+		a.locations[rec.ID] = rec
+	}
+	for _, rec := range def.links {
+		a.links[rec.ID] = rec
+	}
+	for _, rec := range def.items {
+		a.items[rec.ID] = rec
+	}
+	for _, rec := range def.creatures {
+		a.creatures[rec.ID] = rec
+	}
+	for _, rec := range def.objects {
+		a.objects[rec.ID] = rec
+	}
+	for _, rec := range def.objectStates {
+		a.states[rec.ID] = rec
+	}
+	for _, rec := range def.requirements {
+		a.requirementsByLinkID[rec.AdventureGameLocationLinkID] = append(a.requirementsByLinkID[rec.AdventureGameLocationLinkID], rec)
+	}
+
+	a.applyStart()
+	for changed := true; changed; {
+		changed = a.applyRules()
+	}
+
+	return a
+}
+
+// derive records a fact the first time it is achieved and reports whether it
+// is new
+func (a *adventureGameAnalysis) derive(fact string, step *AdventureGamePathStep, requires ...string) bool {
+	if _, ok := a.facts[fact]; ok {
+		return false
+	}
+	a.facts[fact] = &adventureGameDerivation{
+		step:     step,
+		requires: requires,
+	}
+	return true
+}
+
+func (a *adventureGameAnalysis) has(facts ...string) bool {
+	for _, fact := range facts {
+		if _, ok := a.facts[fact]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *adventureGameAnalysis) applyStart() {
+	for _, rec := range a.def.locations {
+		if rec.IsStartingLocation {
+			a.derive(locationFact(rec.ID), newAdventureGamePathStep("validation.adventure.path.start", "location", rec.Name))
+		}
+	}
+	for _, rec := range a.def.items {
+		if rec.IsStartingItem {
+			a.derive(itemFact(rec.ID), newAdventureGamePathStep("validation.adventure.path.start_item", "item", rec.Name))
+		}
+	}
+	for _, rec := range a.def.objects {
+		if !rec.IsHidden {
+			a.derive(objectVisibleFact(rec.ID), nil)
+		}
+		if rec.InitialAdventureGameLocationObjectStateID.Valid {
+			a.derive(objectStateFact(rec.InitialAdventureGameLocationObjectStateID.String), nil)
+		}
+	}
+}
+
+// applyRules applies every rule once and reports whether anything new was
+// achieved
+func (a *adventureGameAnalysis) applyRules() bool {
+	changed := false
+	for _, rule := range []func() bool{
+		a.applyPlacements,
+		a.applyCreatures,
+		a.applyLinks,
+		a.applyObjectEffects,
+		a.applyItemEffects,
+	} {
+		if rule() {
+			changed = true
+		}
+	}
+	return changed
+}
+
+func (a *adventureGameAnalysis) applyPlacements() bool {
+	changed := false
+	for _, rec := range a.def.itemPlacements {
+		location := locationFact(rec.AdventureGameLocationID)
+		if !a.has(location) {
+			continue
+		}
+		step := newAdventureGamePathStep("validation.adventure.path.pick_up", "item", a.itemName(rec.AdventureGameItemID), "location", a.locationName(rec.AdventureGameLocationID))
+		if a.derive(itemFact(rec.AdventureGameItemID), step, location) {
+			changed = true
+		}
+	}
+	for _, rec := range a.def.creaturePlacements {
+		location := locationFact(rec.AdventureGameLocationID)
+		if !a.has(location) {
+			continue
+		}
+		if a.derive(creaturePresentFact(rec.AdventureGameCreatureID, rec.AdventureGameLocationID), nil, location) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+func (a *adventureGameAnalysis) applyCreatures() bool {
+	changed := false
+
+	// Creatures summoned wherever a player is can be defeated at any location
+	// a requirement needs them defeated at
+	for _, rec := range a.def.requirements {
+		if rec.Condition != adventure_game_record.AdventureGameLocationLinkRequirementConditionDeadAtLocation {
+			continue
+		}
+		link, ok := a.links[rec.AdventureGameLocationLinkID]
+		if !ok {
+			continue
+		}
+		anywhere := creaturePresentFact(rec.AdventureGameCreatureID.String, "")
+		location := locationFact(link.FromAdventureGameLocationID)
+		if a.has(anywhere, location) && a.derive(creaturePresentFact(rec.AdventureGameCreatureID.String, link.FromAdventureGameLocationID), nil, anywhere, location) {
+			changed = true
+		}
+	}
+
+	for _, loc := range a.def.locations {
+		for _, creature := range a.def.creatures {
+			present := creaturePresentFact(creature.ID, loc.ID)
+			if !a.has(present) {
+				continue
+			}
+			step := newAdventureGamePathStep("validation.adventure.path.defeat_creature", "creature", creature.Name, "location", loc.Name)
+			if a.derive(creatureDefeatedFact(creature.ID, loc.ID), step, present) {
+				changed = true
+			}
+		}
+	}
+
+	// Every placed creature must be defeated before none remain in the game
+	for _, creature := range a.def.creatures {
+		var requires []string
+		for _, rec := range a.def.creaturePlacements {
+			if rec.AdventureGameCreatureID == creature.ID {
+				requires = append(requires, creatureDefeatedFact(creature.ID, rec.AdventureGameLocationID))
+			}
+		}
+		if a.has(requires...) && a.derive(creatureClearedFact(creature.ID), nil, requires...) {
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+func (a *adventureGameAnalysis) applyLinks() bool {
+	changed := false
+	for _, rec := range a.def.links {
+		from := locationFact(rec.FromAdventureGameLocationID)
+		if !a.has(from) {
+			continue
+		}
+		requires, ok := a.linkRequires(rec)
+		if !ok {
+			continue
+		}
+		step := newAdventureGamePathStep("validation.adventure.path.travel", "link", rec.Name, "from", a.locationName(rec.FromAdventureGameLocationID), "to", a.locationName(rec.ToAdventureGameLocationID))
+		if a.derive(locationFact(rec.ToAdventureGameLocationID), step, append([]string{from}, requires...)...) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// linkRequires returns the facts a player needs to travel a link, and false
+// when the link cannot yet be travelled. Links opened by an effect no longer
+// have traverse requirements but stay hidden until visible requirements are met.
+func (a *adventureGameAnalysis) linkRequires(rec *adventure_game_record.AdventureGameLocationLink) ([]string, bool) {
+	var visible, traverse []string
+	visibleOK, traverseOK := true, true
+
+	for _, req := range a.requirementsByLinkID[rec.ID] {
+		facts, ok := a.requirementFacts(rec, req)
+		if req.Purpose == adventure_game_record.AdventureGameLocationLinkRequirementPurposeVisible {
+			visible = append(visible, facts...)
+			visibleOK = visibleOK && ok
+			continue
+		}
+		traverse = append(traverse, facts...)
+		traverseOK = traverseOK && ok
+	}
+
+	if !visibleOK {
+		return nil, false
+	}
+	if traverseOK {
+		return append(visible, traverse...), true
+	}
+	if a.has(linkOpenFact(rec.ID)) {
+		return append(visible, linkOpenFact(rec.ID)), true
+	}
+	return nil, false
+}
+
+// requirementFacts returns the facts that satisfy a link requirement, and false
+// when the requirement cannot yet be met
+func (a *adventureGameAnalysis) requirementFacts(link *adventure_game_record.AdventureGameLocationLink, req *adventure_game_record.AdventureGameLocationLinkRequirement) ([]string, bool) {
+	var facts []string
+
+	switch req.Condition {
+	case adventure_game_record.AdventureGameLocationLinkRequirementConditionInInventory:
+		facts = []string{itemFact(req.AdventureGameItemID.String)}
+	case adventure_game_record.AdventureGameLocationLinkRequirementConditionEquipped:
+		if item, ok := a.items[req.AdventureGameItemID.String]; !ok || !item.CanBeEquipped {
+			return nil, false
+		}
+		facts = []string{itemFact(req.AdventureGameItemID.String)}
+	case adventure_game_record.AdventureGameLocationLinkRequirementConditionDeadAtLocation:
+		facts = []string{creatureDefeatedFact(req.AdventureGameCreatureID.String, link.FromAdventureGameLocationID)}
+	case adventure_game_record.AdventureGameLocationLinkRequirementConditionNoneAliveAtLocation:
+		// Players standing at the location can defeat any creature placed there
+		present := creaturePresentFact(req.AdventureGameCreatureID.String, link.FromAdventureGameLocationID)
+		if a.has(present) {
+			facts = []string{creatureDefeatedFact(req.AdventureGameCreatureID.String, link.FromAdventureGameLocationID)}
+		}
+	case adventure_game_record.AdventureGameLocationLinkRequirementConditionNoneAliveInGame:
+		facts = []string{creatureClearedFact(req.AdventureGameCreatureID.String)}
+	default:
+		return nil, false
+	}
+
+	return facts, a.has(facts...)
+}
+
+func (a *adventureGameAnalysis) applyObjectEffects() bool {
+	changed := false
+	for _, rec := range a.def.objectEffects {
+		obj, ok := a.objects[rec.AdventureGameLocationObjectID]
+		if !ok {
+			continue
+		}
+
+		requires := []string{locationFact(obj.AdventureGameLocationID), objectVisibleFact(obj.ID)}
+		if rec.RequiredAdventureGameLocationObjectStateID.Valid {
+			requires = append(requires, objectStateFact(rec.RequiredAdventureGameLocationObjectStateID.String))
+		}
+		if rec.RequiredAdventureGameItemID.Valid {
+			requires = append(requires, itemFact(rec.RequiredAdventureGameItemID.String))
+		}
+		if !a.has(requires...) {
+			continue
+		}
+
+		if a.applyObjectEffect(rec, obj, requires) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+func (a *adventureGameAnalysis) applyObjectEffect(rec *adventure_game_record.AdventureGameLocationObjectEffect, obj *adventure_game_record.AdventureGameLocationObject, requires []string) bool {
+	action := []any{"action", rec.ActionType, "source", obj.Name}
+
+	switch rec.EffectType {
+	case adventure_game_record.AdventureGameLocationObjectEffectEffectTypeGiveItem:
+		if rec.ResultAdventureGameItemID.Valid {
+			step := newAdventureGamePathStep("validation.adventure.path.receive_item", append(action, "item", a.itemName(rec.ResultAdventureGameItemID.String))...)
+			return a.derive(itemFact(rec.ResultAdventureGameItemID.String), step, requires...)
+		}
+	case adventure_game_record.AdventureGameLocationObjectEffectEffectTypePlaceItem:
+		if rec.ResultAdventureGameItemID.Valid && rec.ResultAdventureGameLocationID.Valid {
+			location := locationFact(rec.ResultAdventureGameLocationID.String)
+			if !a.has(location) {
+				return false
+			}
+			step := newAdventureGamePathStep("validation.adventure.path.place_item", append(action, "item", a.itemName(rec.ResultAdventureGameItemID.String), "location", a.locationName(rec.ResultAdventureGameLocationID.String))...)
+			return a.derive(itemFact(rec.ResultAdventureGameItemID.String), step, append(requires, location)...)
+		}
+	case adventure_game_record.AdventureGameLocationObjectEffectEffectTypeTeleport:
+		if rec.ResultAdventureGameLocationID.Valid {
+			step := newAdventureGamePathStep("validation.adventure.path.teleport", append(action, "location", a.locationName(rec.ResultAdventureGameLocationID.String))...)
+			return a.derive(locationFact(rec.ResultAdventureGameLocationID.String), step, requires...)
+		}
+	case adventure_game_record.AdventureGameLocationObjectEffectEffectTypeOpenLink:
+		if rec.ResultAdventureGameLocationLinkID.Valid {
+			step := newAdventureGamePathStep("validation.adventure.path.open_link", append(action, "link", a.linkName(rec.ResultAdventureGameLocationLinkID.String))...)
+			return a.derive(linkOpenFact(rec.ResultAdventureGameLocationLinkID.String), step, requires...)
+		}
+	case adventure_game_record.AdventureGameLocationObjectEffectEffectTypeRevealObject:
+		if rec.ResultAdventureGameLocationObjectID.Valid {
+			step := newAdventureGamePathStep("validation.adventure.path.reveal_object", append(action, "object", a.objectName(rec.ResultAdventureGameLocationObjectID.String))...)
+			return a.derive(objectVisibleFact(rec.ResultAdventureGameLocationObjectID.String), step, requires...)
+		}
+	case adventure_game_record.AdventureGameLocationObjectEffectEffectTypeChangeState,
+		adventure_game_record.AdventureGameLocationObjectEffectEffectTypeChangeObjectState:
+		if rec.ResultAdventureGameLocationObjectStateID.Valid {
+			state, ok := a.states[rec.ResultAdventureGameLocationObjectStateID.String]
+			if !ok {
+				return false
+			}
+			step := newAdventureGamePathStep("validation.adventure.path.change_state", append(action, "object", a.objectName(state.AdventureGameLocationObjectID), "state", state.Name)...)
+			return a.derive(objectStateFact(state.ID), step, requires...)
+		}
+	case adventure_game_record.AdventureGameLocationObjectEffectEffectTypeSummonCreature:
+		if rec.ResultAdventureGameCreatureID.Valid {
+			step := newAdventureGamePathStep("validation.adventure.path.summon_creature", append(action, "creature", a.creatureName(rec.ResultAdventureGameCreatureID.String))...)
+			return a.derive(creaturePresentFact(rec.ResultAdventureGameCreatureID.String, obj.AdventureGameLocationID), step, requires...)
+		}
+	}
+	return false
+}
+
+func (a *adventureGameAnalysis) applyItemEffects() bool {
+	changed := false
+	for _, rec := range a.def.itemEffects {
+		item, ok := a.items[rec.AdventureGameItemID]
+		if !ok {
+			continue
+		}
+
+		requires := []string{itemFact(item.ID)}
+		if rec.RequiredAdventureGameItemID.Valid {
+			requires = append(requires, itemFact(rec.RequiredAdventureGameItemID.String))
+		}
+		if rec.RequiredAdventureGameLocationID.Valid {
+			requires = append(requires, locationFact(rec.RequiredAdventureGameLocationID.String))
+		}
+		if !a.has(requires...) {
+			continue
+		}
+
+		if a.applyItemEffect(rec, item, requires) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+func (a *adventureGameAnalysis) applyItemEffect(rec *adventure_game_record.AdventureGameItemEffect, item *adventure_game_record.AdventureGameItem, requires []string) bool {
+	action := []any{"action", rec.ActionType, "source", item.Name}
+
+	switch rec.EffectType {
+	case adventure_game_record.AdventureGameItemEffectEffectTypeGiveItem:
+		if rec.ResultAdventureGameItemID.Valid {
+			step := newAdventureGamePathStep("validation.adventure.path.receive_item", append(action, "item", a.itemName(rec.ResultAdventureGameItemID.String))...)
+			return a.derive(itemFact(rec.ResultAdventureGameItemID.String), step, requires...)
+		}
+	case adventure_game_record.AdventureGameItemEffectEffectTypeTeleport:
+		if rec.ResultAdventureGameLocationID.Valid {
+			step := newAdventureGamePathStep("validation.adventure.path.teleport", append(action, "location", a.locationName(rec.ResultAdventureGameLocationID.String))...)
+			return a.derive(locationFact(rec.ResultAdventureGameLocationID.String), step, requires...)
+		}
+	case adventure_game_record.AdventureGameItemEffectEffectTypeOpenLink:
+		if rec.ResultAdventureGameLocationLinkID.Valid {
+			step := newAdventureGamePathStep("validation.adventure.path.open_link", append(action, "link", a.linkName(rec.ResultAdventureGameLocationLinkID.String))...)
+			return a.derive(linkOpenFact(rec.ResultAdventureGameLocationLinkID.String), step, requires...)
+		}
+	case adventure_game_record.AdventureGameItemEffectEffectTypeSummonCreature:
+		// Item effects summon at the character's location, which is only known
+		// when the effect is tied to a location
+		if rec.ResultAdventureGameCreatureID.Valid {
+			step := newAdventureGamePathStep("validation.adventure.path.summon_creature", append(action, "creature", a.creatureName(rec.ResultAdventureGameCreatureID.String))...)
+			return a.derive(creaturePresentFact(rec.ResultAdventureGameCreatureID.String, rec.RequiredAdventureGameLocationID.String), step, requires...)
+		}
+	}
+	return false
+}
+
+// path returns the steps that achieve a fact, each step following the steps
+// it depends on, and false when the fact can never be achieved
+func (a *adventureGameAnalysis) path(fact string) ([]AdventureGamePathStep, bool) {
+	if !a.has(fact) {
+		return nil, false
+	}
+
+	var steps []AdventureGamePathStep
+	visited := make(map[string]bool)
+
+	var visit func(fact string)
+	visit = func(fact string) {
+		if visited[fact] {
+			return
+		}
+		visited[fact] = true
+
+		derivation := a.facts[fact]
+		for _, required := range derivation.requires {
+			visit(required)
+		}
+		if derivation.step != nil {
+			steps = append(steps, *derivation.step)
+		}
+	}
+	visit(fact)
+
+	return steps, true
+}
+
+// issues reports content players can never reach and gates that can never be
+// passed
+func (a *adventureGameAnalysis) issues() []GameValidationIssue {
+	var issues []GameValidationIssue
+
+	for _, rec := range a.def.locations {
+		if a.has(locationFact(rec.ID)) {
+			continue
+		}
+		if rec.IsGoalLocation {
+			issues = append(issues, newGameValidationIssue("locations", ValidationSeverityError, "validation.adventure.goal_location_unreachable", "location", rec.Name))
+			continue
+		}
+		issues = append(issues, newGameValidationIssue("locations", ValidationSeverityWarning, "validation.adventure.location_unreachable", "location", rec.Name))
+	}
+
+	for _, rec := range a.def.items {
+		if a.has(itemFact(rec.ID)) {
+			continue
+		}
+		if rec.IsGoalItem {
+			issues = append(issues, newGameValidationIssue("items", ValidationSeverityError, "validation.adventure.goal_item_unobtainable", "item", rec.Name))
+			continue
+		}
+		issues = append(issues, newGameValidationIssue("items", ValidationSeverityWarning, "validation.adventure.item_unobtainable", "item", rec.Name))
+	}
+
+	issues = append(issues, a.requirementIssues()...)
+
+	for _, rec := range a.def.links {
+		if !a.has(locationFact(rec.FromAdventureGameLocationID)) {
+			continue
+		}
+		if _, ok := a.linkRequires(rec); !ok {
+			issues = append(issues, newGameValidationIssue("location_links", ValidationSeverityWarning, "validation.adventure.link_blocked", "link", rec.Name, "location", a.locationName(rec.FromAdventureGameLocationID)))
+		}
+	}
+
+	for _, rec := range a.def.objectEffects {
+		if rec.RequiredAdventureGameItemID.Valid && !a.has(itemFact(rec.RequiredAdventureGameItemID.String)) {
+			issues = append(issues, newGameValidationIssue("location_object_effects", ValidationSeverityWarning, "validation.adventure.effect_requires_unobtainable_item",
+				"action", rec.ActionType, "source", a.objectName(rec.AdventureGameLocationObjectID), "item", a.itemName(rec.RequiredAdventureGameItemID.String)))
+		}
+	}
+
+	for _, rec := range a.def.itemEffects {
+		if rec.RequiredAdventureGameItemID.Valid && !a.has(itemFact(rec.RequiredAdventureGameItemID.String)) {
+			issues = append(issues, newGameValidationIssue("item_effects", ValidationSeverityWarning, "validation.adventure.effect_requires_unobtainable_item",
+				"action", rec.ActionType, "source", a.itemName(rec.AdventureGameItemID), "item", a.itemName(rec.RequiredAdventureGameItemID.String)))
+		}
+	}
+
+	return issues
+}
+
+// requirementIssues reports link requirements that no play through can meet
+// because the game never provides what they need
+func (a *adventureGameAnalysis) requirementIssues() []GameValidationIssue {
+	var issues []GameValidationIssue
+
+	for _, rec := range a.def.requirements {
+		link, ok := a.links[rec.AdventureGameLocationLinkID]
+		if !ok {
+			continue
+		}
+
+		// Links an effect opens no longer need what their traverse requirements ask for
+		if rec.Purpose == adventure_game_record.AdventureGameLocationLinkRequirementPurposeTraverse && a.linkHasOpenEffect(link.ID) {
+			continue
+		}
+
+		switch rec.Condition {
+		case adventure_game_record.AdventureGameLocationLinkRequirementConditionInInventory,
+			adventure_game_record.AdventureGameLocationLinkRequirementConditionEquipped:
+			itemID := rec.AdventureGameItemID.String
+			if !a.itemHasSource(itemID) {
+				issues = append(issues, newGameValidationIssue("location_link_requirements", ValidationSeverityError, "validation.adventure.link_requirement_item_unavailable", "link", link.Name, "item", a.itemName(itemID)))
+				continue
+			}
+			if item, ok := a.items[itemID]; ok && rec.Condition == adventure_game_record.AdventureGameLocationLinkRequirementConditionEquipped && !item.CanBeEquipped {
+				issues = append(issues, newGameValidationIssue("location_link_requirements", ValidationSeverityError, "validation.adventure.link_requirement_item_not_equippable", "link", link.Name, "item", item.Name))
+			}
+		case adventure_game_record.AdventureGameLocationLinkRequirementConditionDeadAtLocation:
+			creatureID := rec.AdventureGameCreatureID.String
+			if !a.creatureHasSourceAt(creatureID, link.FromAdventureGameLocationID) {
+				issues = append(issues, newGameValidationIssue("location_link_requirements", ValidationSeverityError, "validation.adventure.link_requirement_creature_unavailable",
+					"link", link.Name, "creature", a.creatureName(creatureID), "location", a.locationName(link.FromAdventureGameLocationID)))
+			}
+		}
+	}
+
+	return issues
+}
+
+// linkHasOpenEffect reports whether any effect opens a link
+func (a *adventureGameAnalysis) linkHasOpenEffect(linkID string) bool {
+	for _, rec := range a.def.objectEffects {
+		if rec.EffectType == adventure_game_record.AdventureGameLocationObjectEffectEffectTypeOpenLink && rec.ResultAdventureGameLocationLinkID.String == linkID {
+			return true
+		}
+	}
+	for _, rec := range a.def.itemEffects {
+		if rec.EffectType == adventure_game_record.AdventureGameItemEffectEffectTypeOpenLink && rec.ResultAdventureGameLocationLinkID.String == linkID {
+			return true
+		}
+	}
+	return false
+}
+
+// itemHasSource reports whether the game ever starts players with, places or
+// gives an item
+func (a *adventureGameAnalysis) itemHasSource(itemID string) bool {
+	if item, ok := a.items[itemID]; ok && item.IsStartingItem {
+		return true
+	}
+	for _, rec := range a.def.itemPlacements {
+		if rec.AdventureGameItemID == itemID {
+			return true
+		}
+	}
+	for _, rec := range a.def.objectEffects {
+		if rec.ResultAdventureGameItemID.String == itemID &&
+			(rec.EffectType == adventure_game_record.AdventureGameLocationObjectEffectEffectTypeGiveItem ||
+				rec.EffectType == adventure_game_record.AdventureGameLocationObjectEffectEffectTypePlaceItem) {
+			return true
+		}
+	}
+	for _, rec := range a.def.itemEffects {
+		if rec.ResultAdventureGameItemID.String == itemID && rec.EffectType == adventure_game_record.AdventureGameItemEffectEffectTypeGiveItem {
+			return true
+		}
+	}
+	return false
+}
+
+// creatureHasSourceAt reports whether the game ever places or summons a
+// creature at a location
+func (a *adventureGameAnalysis) creatureHasSourceAt(creatureID, locationID string) bool {
+	for _, rec := range a.def.creaturePlacements {
+		if rec.AdventureGameCreatureID == creatureID && rec.AdventureGameLocationID == locationID {
+			return true
+		}
+	}
+	for _, rec := range a.def.objectEffects {
+		if rec.EffectType != adventure_game_record.AdventureGameLocationObjectEffectEffectTypeSummonCreature || rec.ResultAdventureGameCreatureID.String != creatureID {
+			continue
+		}
+		if obj, ok := a.objects[rec.AdventureGameLocationObjectID]; ok && obj.AdventureGameLocationID == locationID {
+			return true
+		}
+	}
+	for _, rec := range a.def.itemEffects {
+		if rec.EffectType != adventure_game_record.AdventureGameItemEffectEffectTypeSummonCreature || rec.ResultAdventureGameCreatureID.String != creatureID {
+			continue
+		}
+		if !rec.RequiredAdventureGameLocationID.Valid || rec.RequiredAdventureGameLocationID.String == locationID {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *adventureGameAnalysis) locationName(id string) string {
+	if rec, ok := a.locations[id]; ok {
+		return rec.Name
+	}
+	return id
+}
+
+func (a *adventureGameAnalysis) linkName(id string) string {
+	if rec, ok := a.links[id]; ok {
+		return rec.Name
+	}
+	return id
+}
+
+func (a *adventureGameAnalysis) itemName(id string) string {
+	if rec, ok := a.items[id]; ok {
+		return rec.Name
+	}
+	return id
+}
+
+func (a *adventureGameAnalysis) creatureName(id string) string {
+	if rec, ok := a.creatures[id]; ok {
+		return rec.Name
+	}
+	return id
+}
+
+func (a *adventureGameAnalysis) objectName(id string) string {
+	if rec, ok := a.objects[id]; ok {
+		return rec.Name
+	}
+	return id
+}
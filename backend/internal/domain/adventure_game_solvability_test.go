@@ -0,0 +1,263 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/record"
+	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
+)
+
+// testAdventureGameDefinition returns a small solvable adventure: a key found in
+// a chest in the hall unlocks the door to the vault, which is the goal.
+func testAdventureGameDefinition() *adventureGameDefinition {
+	return &adventureGameDefinition{
+		locations: []*adventure_game_record.AdventureGameLocation{
+			{Record: record.Record{ID: "entrance"}, Name: "Entrance", IsStartingLocation: true},
+			{Record: record.Record{ID: "hall"}, Name: "Hall"},
+			{Record: record.Record{ID: "vault"}, Name: "Vault", IsGoalLocation: true},
+		},
+		links: []*adventure_game_record.AdventureGameLocationLink{
+			{Record: record.Record{ID: "corridor"}, Name: "Corridor", FromAdventureGameLocationID: "entrance", ToAdventureGameLocationID: "hall"},
+			{Record: record.Record{ID: "vault-door"}, Name: "Vault Door", FromAdventureGameLocationID: "hall", ToAdventureGameLocationID: "vault"},
+		},
+		requirements: []*adventure_game_record.AdventureGameLocationLinkRequirement{
+			{
+				Record:                      record.Record{ID: "vault-door-key"},
+				AdventureGameLocationLinkID: "vault-door",
+				AdventureGameItemID:         nullstring.FromString("key"),
+				Purpose:                     adventure_game_record.AdventureGameLocationLinkRequirementPurposeTraverse,
+				Condition:                   adventure_game_record.AdventureGameLocationLinkRequirementConditionInInventory,
+				Quantity:                    1,
+			},
+		},
+		items: []*adventure_game_record.AdventureGameItem{
+			{Record: record.Record{ID: "key"}, Name: "Key"},
+		},
+		objects: []*adventure_game_record.AdventureGameLocationObject{
+			{Record: record.Record{ID: "chest"}, Name: "Chest", AdventureGameLocationID: "hall"},
+		},
+		objectEffects: []*adventure_game_record.AdventureGameLocationObjectEffect{
+			{
+				Record:                        record.Record{ID: "chest-open"},
+				AdventureGameLocationObjectID: "chest",
+				ActionType:                    adventure_game_record.AdventureGameLocationObjectEffectActionTypeOpen,
+				EffectType:                    adventure_game_record.AdventureGameLocationObjectEffectEffectTypeGiveItem,
+				ResultAdventureGameItemID:     nullstring.FromString("key"),
+			},
+		},
+	}
+}
+
+func TestAnalyseAdventureGame(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(def *adventureGameDefinition)
+		// Issues expected as message key and severity
+		wantIssues map[string]string
+	}{
+		{
+			name:       "solvable game then no issues",
+			modify:     func(def *adventureGameDefinition) {},
+			wantIssues: map[string]string{},
+		},
+		{
+			name: "key never given then gate and goal are errors",
+			modify: func(def *adventureGameDefinition) {
+				def.objectEffects = nil
+			},
+			wantIssues: map[string]string{
+				"validation.adventure.link_requirement_item_unavailable": ValidationSeverityError,
+				"validation.adventure.goal_location_unreachable":         ValidationSeverityError,
+				"validation.adventure.item_unobtainable":                 ValidationSeverityWarning,
+				"validation.adventure.link_blocked":                      ValidationSeverityWarning,
+			},
+		},
+		{
+			name: "key inside an unreachable location then goal is unreachable",
+			modify: func(def *adventureGameDefinition) {
+				def.objects[0].AdventureGameLocationID = "vault"
+			},
+			wantIssues: map[string]string{
+				"validation.adventure.goal_location_unreachable": ValidationSeverityError,
+				"validation.adventure.item_unobtainable":         ValidationSeverityWarning,
+				"validation.adventure.link_blocked":              ValidationSeverityWarning,
+			},
+		},
+		{
+			name: "hidden chest revealed by a lever then no issues",
+			modify: func(def *adventureGameDefinition) {
+				def.objects[0].IsHidden = true
+				def.objects = append(def.objects, &adventure_game_record.AdventureGameLocationObject{
+					Record: record.Record{ID: "lever"}, Name: "Lever", AdventureGameLocationID: "entrance",
+				})
+				def.objectEffects = append(def.objectEffects, &adventure_game_record.AdventureGameLocationObjectEffect{
+					Record:                              record.Record{ID: "lever-pull"},
+					AdventureGameLocationObjectID:       "lever",
+					ActionType:                          adventure_game_record.AdventureGameLocationObjectEffectActionTypePull,
+					EffectType:                          adventure_game_record.AdventureGameLocationObjectEffectEffectTypeRevealObject,
+					ResultAdventureGameLocationObjectID: nullstring.FromString("chest"),
+				})
+			},
+			wantIssues: map[string]string{},
+		},
+		{
+			name: "door opened by a lever instead of the key then only the key is unobtainable",
+			modify: func(def *adventureGameDefinition) {
+				def.objectEffects[0].EffectType = adventure_game_record.AdventureGameLocationObjectEffectEffectTypeOpenLink
+				def.objectEffects[0].ResultAdventureGameItemID = nullstring.FromString("")
+				def.objectEffects[0].ResultAdventureGameLocationLinkID = nullstring.FromString("vault-door")
+			},
+			wantIssues: map[string]string{
+				"validation.adventure.item_unobtainable": ValidationSeverityWarning,
+			},
+		},
+		{
+			name: "key must be equipped but cannot be then gate is an error",
+			modify: func(def *adventureGameDefinition) {
+				def.requirements[0].Condition = adventure_game_record.AdventureGameLocationLinkRequirementConditionEquipped
+			},
+			wantIssues: map[string]string{
+				"validation.adventure.link_requirement_item_not_equippable": ValidationSeverityError,
+				"validation.adventure.goal_location_unreachable":            ValidationSeverityError,
+				"validation.adventure.link_blocked":                         ValidationSeverityWarning,
+			},
+		},
+		{
+			name: "guardian required dead is never placed then gate is an error",
+			modify: func(def *adventureGameDefinition) {
+				def.creatures = []*adventure_game_record.AdventureGameCreature{
+					{Record: record.Record{ID: "guardian"}, Name: "Guardian"},
+				}
+				def.requirements = append(def.requirements, &adventure_game_record.AdventureGameLocationLinkRequirement{
+					Record:                      record.Record{ID: "vault-door-guardian"},
+					AdventureGameLocationLinkID: "vault-door",
+					AdventureGameCreatureID:     nullstring.FromString("guardian"),
+					Purpose:                     adventure_game_record.AdventureGameLocationLinkRequirementPurposeTraverse,
+					Condition:                   adventure_game_record.AdventureGameLocationLinkRequirementConditionDeadAtLocation,
+					Quantity:                    1,
+				})
+			},
+			wantIssues: map[string]string{
+				"validation.adventure.link_requirement_creature_unavailable": ValidationSeverityError,
+				"validation.adventure.goal_location_unreachable":             ValidationSeverityError,
+				"validation.adventure.link_blocked":                          ValidationSeverityWarning,
+			},
+		},
+		{
+			name: "guardian required dead is placed at the door then no issues",
+			modify: func(def *adventureGameDefinition) {
+				def.creatures = []*adventure_game_record.AdventureGameCreature{
+					{Record: record.Record{ID: "guardian"}, Name: "Guardian"},
+				}
+				def.creaturePlacements = []*adventure_game_record.AdventureGameCreaturePlacement{
+					{Record: record.Record{ID: "guardian-hall"}, AdventureGameCreatureID: "guardian", AdventureGameLocationID: "hall", InitialCount: 1},
+				}
+				def.requirements = append(def.requirements, &adventure_game_record.AdventureGameLocationLinkRequirement{
+					Record:                      record.Record{ID: "vault-door-guardian"},
+					AdventureGameLocationLinkID: "vault-door",
+					AdventureGameCreatureID:     nullstring.FromString("guardian"),
+					Purpose:                     adventure_game_record.AdventureGameLocationLinkRequirementPurposeTraverse,
+					Condition:                   adventure_game_record.AdventureGameLocationLinkRequirementConditionDeadAtLocation,
+					Quantity:                    1,
+				})
+			},
+			wantIssues: map[string]string{},
+		},
+		{
+			name: "location with no way in then warning",
+			modify: func(def *adventureGameDefinition) {
+				def.locations = append(def.locations, &adventure_game_record.AdventureGameLocation{
+					Record: record.Record{ID: "attic"}, Name: "Attic",
+				})
+			},
+			wantIssues: map[string]string{
+				"validation.adventure.location_unreachable": ValidationSeverityWarning,
+			},
+		},
+		{
+			name: "effect needs an item nobody can obtain then warning",
+			modify: func(def *adventureGameDefinition) {
+				def.items = append(def.items, &adventure_game_record.AdventureGameItem{
+					Record: record.Record{ID: "crowbar"}, Name: "Crowbar",
+				})
+				def.objectEffects = append(def.objectEffects, &adventure_game_record.AdventureGameLocationObjectEffect{
+					Record:                        record.Record{ID: "chest-break"},
+					AdventureGameLocationObjectID: "chest",
+					ActionType:                    adventure_game_record.AdventureGameLocationObjectEffectActionTypeBreak,
+					RequiredAdventureGameItemID:   nullstring.FromString("crowbar"),
+					EffectType:                    adventure_game_record.AdventureGameLocationObjectEffectEffectTypeNothing,
+				})
+			},
+			wantIssues: map[string]string{
+				"validation.adventure.item_unobtainable":                 ValidationSeverityWarning,
+				"validation.adventure.effect_requires_unobtainable_item": ValidationSeverityWarning,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := testAdventureGameDefinition()
+			tt.modify(def)
+
+			issues := analyseAdventureGame(def).issues()
+
+			got := map[string]string{}
+			for _, issue := range issues {
+				got[issue.MessageKey] = issue.Severity
+			}
+			require.Equal(t, tt.wantIssues, got, "analysis reports expected issues")
+		})
+	}
+}
+
+func TestAdventureGameAnalysisPath(t *testing.T) {
+	messages := func(steps []AdventureGamePathStep) []string {
+		var got []string
+		for _, step := range steps {
+			got = append(got, step.Message)
+		}
+		return got
+	}
+
+	t.Run("reachable location then steps in order", func(t *testing.T) {
+		a := analyseAdventureGame(testAdventureGameDefinition())
+
+		steps, ok := a.path(locationFact("vault"))
+		require.True(t, ok, "vault is reachable")
+		require.Equal(t, []string{
+			`Start at "Entrance"`,
+			`Travel from "Entrance" to "Hall" by "Corridor"`,
+			`Use "open" on "Chest" to receive "Key"`,
+			`Travel from "Hall" to "Vault" by "Vault Door"`,
+		}, messages(steps), "path explains each step")
+	})
+
+	t.Run("starting location then single step", func(t *testing.T) {
+		a := analyseAdventureGame(testAdventureGameDefinition())
+
+		steps, ok := a.path(locationFact("entrance"))
+		require.True(t, ok, "entrance is reachable")
+		require.Equal(t, []string{`Start at "Entrance"`}, messages(steps), "path is the start")
+	})
+
+	t.Run("unreachable location then not ok", func(t *testing.T) {
+		def := testAdventureGameDefinition()
+		def.objectEffects = nil
+
+		steps, ok := analyseAdventureGame(def).path(locationFact("vault"))
+		require.False(t, ok, "vault is unreachable")
+		require.Empty(t, steps, "no steps for an unreachable location")
+	})
+
+	t.Run("steps localise", func(t *testing.T) {
+		a := analyseAdventureGame(testAdventureGameDefinition())
+
+		steps, ok := a.path(locationFact("entrance"))
+		require.True(t, ok, "entrance is reachable")
+		require.Equal(t, `Empieza en "Entrance"`, steps[0].Localize("es").Message, "step is localised")
+	})
+}
@@ -9,11 +9,14 @@ import (
 	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/repository/account"
 	"gitlab.com/alienspaces/playbymail/internal/repository/account_contact"
 	"gitlab.com/alienspaces/playbymail/internal/repository/account_game_view"
 	"gitlab.com/alienspaces/playbymail/internal/repository/account_subscription"
 	"gitlab.com/alienspaces/playbymail/internal/repository/account_user"
+	"gitlab.com/alienspaces/playbymail/internal/repository/account_user_api_token"
+	"gitlab.com/alienspaces/playbymail/internal/repository/account_user_session"
 	"gitlab.com/alienspaces/playbymail/internal/repository/adventure_game_character"
 	"gitlab.com/alienspaces/playbymail/internal/repository/adventure_game_character_instance"
 	"gitlab.com/alienspaces/playbymail/internal/repository/adventure_game_creature"
@@ -34,6 +37,10 @@ import (
 	"gitlab.com/alienspaces/playbymail/internal/repository/adventure_game_turn_sheet"
 	"gitlab.com/alienspaces/playbymail/internal/repository/catalog_game_instance_view"
 	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_game_chassis"
+	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_game_campaign"
+	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_game_campaign_instance"
+	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_game_campaign_mech"
+	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_game_campaign_squad"
 	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_game_computer_opponent"
 	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_game_equipment"
 	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_game_squad"
@@ -45,14 +52,28 @@ import (
 	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_game_sector_link"
 	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_game_turn_sheet"
 	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_game_weapon"
+	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_tactics_game_chassis"
+	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_tactics_game_computer_opponent"
+	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_tactics_game_weapon"
+	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_tactics_game_terrain_type"
+	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_tactics_game_hex"
+	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_tactics_game_mech"
+	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_tactics_game_mech_instance"
+	"gitlab.com/alienspaces/playbymail/internal/repository/mecha_tactics_game_turn_sheet"
 	"gitlab.com/alienspaces/playbymail/internal/repository/game"
 	"gitlab.com/alienspaces/playbymail/internal/repository/game_image"
 	"gitlab.com/alienspaces/playbymail/internal/repository/game_instance"
 	"gitlab.com/alienspaces/playbymail/internal/repository/game_instance_parameter"
+	"gitlab.com/alienspaces/playbymail/internal/repository/game_instance_result"
+	"gitlab.com/alienspaces/playbymail/internal/repository/game_print_batch"
+	"gitlab.com/alienspaces/playbymail/internal/repository/game_scan_batch"
+	"gitlab.com/alienspaces/playbymail/internal/repository/game_scan_batch_page"
 	"gitlab.com/alienspaces/playbymail/internal/repository/game_subscription"
 	"gitlab.com/alienspaces/playbymail/internal/repository/game_subscription_instance"
 	"gitlab.com/alienspaces/playbymail/internal/repository/game_subscription_view"
+	"gitlab.com/alienspaces/playbymail/internal/repository/game_translation"
 	"gitlab.com/alienspaces/playbymail/internal/repository/game_turn_sheet"
+	"gitlab.com/alienspaces/playbymail/internal/repository/game_turn_sheet_scan"
 	"gitlab.com/alienspaces/playbymail/internal/repository/manager_game_instance_view"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
 )
@@ -78,6 +99,8 @@ func NewDomain(l logger.Logger, cfg config.Config) (*Domain, error) {
 		// Core repositories
 		account.NewRepository,
 		account_user.NewRepository,
+		account_user_session.NewRepository,
+		account_user_api_token.NewRepository,
 		account_contact.NewRepository,
 		account_subscription.NewRepository,
 		game.NewRepository,
@@ -91,6 +114,12 @@ func NewDomain(l logger.Logger, cfg config.Config) (*Domain, error) {
 		manager_game_instance_view.NewRepository,
 		catalog_game_instance_view.NewRepository,
 		game_turn_sheet.NewRepository,
+		game_turn_sheet_scan.NewRepository,
+		game_print_batch.NewRepository,
+		game_scan_batch.NewRepository,
+		game_scan_batch_page.NewRepository,
+		game_instance_result.NewRepository,
+		game_translation.NewRepository,
 
 		// Adventure game repositories
 		adventure_game_location.NewRepository,
@@ -125,6 +154,20 @@ func NewDomain(l logger.Logger, cfg config.Config) (*Domain, error) {
 		mecha_game_squad_instance.NewRepository,
 		mecha_game_mech_instance.NewRepository,
 		mecha_game_turn_sheet.NewRepository,
+		mecha_game_campaign.NewRepository,
+		mecha_game_campaign_instance.NewRepository,
+		mecha_game_campaign_squad.NewRepository,
+		mecha_game_campaign_mech.NewRepository,
+
+		// MechaTacticsGame repositories
+		mecha_tactics_game_chassis.NewRepository,
+		mecha_tactics_game_computer_opponent.NewRepository,
+		mecha_tactics_game_weapon.NewRepository,
+		mecha_tactics_game_terrain_type.NewRepository,
+		mecha_tactics_game_hex.NewRepository,
+		mecha_tactics_game_mech.NewRepository,
+		mecha_tactics_game_mech_instance.NewRepository,
+		mecha_tactics_game_turn_sheet.NewRepository,
 	}
 
 	cd, err := domain.NewDomain(l, repositoryConstructors)
@@ -147,6 +190,16 @@ func (m *Domain) AccountUserRepository() *repository.Generic[account_record.Acco
 	return m.Repositories[account_user.TableName].(*repository.Generic[account_record.AccountUser, *account_record.AccountUser])
 }
 
+// AccountUserAPITokenRepository -
+func (m *Domain) AccountUserAPITokenRepository() *repository.Generic[account_record.AccountUserAPIToken, *account_record.AccountUserAPIToken] {
+	return m.Repositories[account_user_api_token.TableName].(*repository.Generic[account_record.AccountUserAPIToken, *account_record.AccountUserAPIToken])
+}
+
+// AccountUserSessionRepository -
+func (m *Domain) AccountUserSessionRepository() *repository.Generic[account_record.AccountUserSession, *account_record.AccountUserSession] {
+	return m.Repositories[account_user_session.TableName].(*repository.Generic[account_record.AccountUserSession, *account_record.AccountUserSession])
+}
+
 // AccountRepository -
 func (m *Domain) AccountRepository() *repository.Generic[account_record.Account, *account_record.Account] {
 	return m.Repositories[account.TableName].(*repository.Generic[account_record.Account, *account_record.Account])
@@ -277,6 +330,31 @@ func (m *Domain) GameTurnSheetRepository() *repository.Generic[game_record.GameT
 	return m.Repositories[game_turn_sheet.TableName].(*repository.Generic[game_record.GameTurnSheet, *game_record.GameTurnSheet])
 }
 
+// GameTurnSheetScanRepository -
+func (m *Domain) GameTurnSheetScanRepository() *repository.Generic[game_record.GameTurnSheetScan, *game_record.GameTurnSheetScan] {
+	return m.Repositories[game_turn_sheet_scan.TableName].(*repository.Generic[game_record.GameTurnSheetScan, *game_record.GameTurnSheetScan])
+}
+
+// GamePrintBatchRepository -
+func (m *Domain) GamePrintBatchRepository() *repository.Generic[game_record.GamePrintBatch, *game_record.GamePrintBatch] {
+	return m.Repositories[game_print_batch.TableName].(*repository.Generic[game_record.GamePrintBatch, *game_record.GamePrintBatch])
+}
+
+// GameScanBatchRepository -
+func (m *Domain) GameScanBatchRepository() *repository.Generic[game_record.GameScanBatch, *game_record.GameScanBatch] {
+	return m.Repositories[game_scan_batch.TableName].(*repository.Generic[game_record.GameScanBatch, *game_record.GameScanBatch])
+}
+
+// GameScanBatchPageRepository -
+func (m *Domain) GameScanBatchPageRepository() *repository.Generic[game_record.GameScanBatchPage, *game_record.GameScanBatchPage] {
+	return m.Repositories[game_scan_batch_page.TableName].(*repository.Generic[game_record.GameScanBatchPage, *game_record.GameScanBatchPage])
+}
+
+// GameInstanceResultRepository -
+func (m *Domain) GameInstanceResultRepository() *repository.Generic[game_record.GameInstanceResult, *game_record.GameInstanceResult] {
+	return m.Repositories[game_instance_result.TableName].(*repository.Generic[game_record.GameInstanceResult, *game_record.GameInstanceResult])
+}
+
 // AdventureGameTurnSheetRepository -
 func (m *Domain) AdventureGameTurnSheetRepository() *repository.Generic[adventure_game_record.AdventureGameTurnSheet, *adventure_game_record.AdventureGameTurnSheet] {
 	return m.Repositories[adventure_game_turn_sheet.TableName].(*repository.Generic[adventure_game_record.AdventureGameTurnSheet, *adventure_game_record.AdventureGameTurnSheet])
@@ -287,6 +365,11 @@ func (m *Domain) GameImageRepository() *repository.Generic[game_record.GameImage
 	return m.Repositories[game_image.TableName].(*repository.Generic[game_record.GameImage, *game_record.GameImage])
 }
 
+// GameTranslationRepository -
+func (m *Domain) GameTranslationRepository() *repository.Generic[game_record.GameTranslation, *game_record.GameTranslation] {
+	return m.Repositories[game_translation.TableName].(*repository.Generic[game_record.GameTranslation, *game_record.GameTranslation])
+}
+
 // AdventureGameLocationObjectRepository -
 func (m *Domain) AdventureGameLocationObjectRepository() *repository.Generic[adventure_game_record.AdventureGameLocationObject, *adventure_game_record.AdventureGameLocationObject] {
 	return m.Repositories[adventure_game_location_object.TableName].(*repository.Generic[adventure_game_record.AdventureGameLocationObject, *adventure_game_record.AdventureGameLocationObject])
@@ -367,6 +450,66 @@ func (m *Domain) MechaGameTurnSheetRepository() *repository.Generic[mecha_game_r
 	return m.Repositories[mecha_game_turn_sheet.TableName].(*repository.Generic[mecha_game_record.MechaGameTurnSheet, *mecha_game_record.MechaGameTurnSheet])
 }
 
+// MechaGameCampaignRepository -
+func (m *Domain) MechaGameCampaignRepository() *repository.Generic[mecha_game_record.MechaGameCampaign, *mecha_game_record.MechaGameCampaign] {
+	return m.Repositories[mecha_game_campaign.TableName].(*repository.Generic[mecha_game_record.MechaGameCampaign, *mecha_game_record.MechaGameCampaign])
+}
+
+// MechaGameCampaignInstanceRepository -
+func (m *Domain) MechaGameCampaignInstanceRepository() *repository.Generic[mecha_game_record.MechaGameCampaignInstance, *mecha_game_record.MechaGameCampaignInstance] {
+	return m.Repositories[mecha_game_campaign_instance.TableName].(*repository.Generic[mecha_game_record.MechaGameCampaignInstance, *mecha_game_record.MechaGameCampaignInstance])
+}
+
+// MechaGameCampaignSquadRepository -
+func (m *Domain) MechaGameCampaignSquadRepository() *repository.Generic[mecha_game_record.MechaGameCampaignSquad, *mecha_game_record.MechaGameCampaignSquad] {
+	return m.Repositories[mecha_game_campaign_squad.TableName].(*repository.Generic[mecha_game_record.MechaGameCampaignSquad, *mecha_game_record.MechaGameCampaignSquad])
+}
+
+// MechaGameCampaignMechRepository -
+func (m *Domain) MechaGameCampaignMechRepository() *repository.Generic[mecha_game_record.MechaGameCampaignMech, *mecha_game_record.MechaGameCampaignMech] {
+	return m.Repositories[mecha_game_campaign_mech.TableName].(*repository.Generic[mecha_game_record.MechaGameCampaignMech, *mecha_game_record.MechaGameCampaignMech])
+}
+
+// MechaTacticsGameChassisRepository -
+func (m *Domain) MechaTacticsGameChassisRepository() *repository.Generic[mecha_tactics_game_record.MechaTacticsGameChassis, *mecha_tactics_game_record.MechaTacticsGameChassis] {
+	return m.Repositories[mecha_tactics_game_chassis.TableName].(*repository.Generic[mecha_tactics_game_record.MechaTacticsGameChassis, *mecha_tactics_game_record.MechaTacticsGameChassis])
+}
+
+// MechaTacticsGameComputerOpponentRepository -
+func (m *Domain) MechaTacticsGameComputerOpponentRepository() *repository.Generic[mecha_tactics_game_record.MechaTacticsGameComputerOpponent, *mecha_tactics_game_record.MechaTacticsGameComputerOpponent] {
+	return m.Repositories[mecha_tactics_game_computer_opponent.TableName].(*repository.Generic[mecha_tactics_game_record.MechaTacticsGameComputerOpponent, *mecha_tactics_game_record.MechaTacticsGameComputerOpponent])
+}
+
+// MechaTacticsGameWeaponRepository -
+func (m *Domain) MechaTacticsGameWeaponRepository() *repository.Generic[mecha_tactics_game_record.MechaTacticsGameWeapon, *mecha_tactics_game_record.MechaTacticsGameWeapon] {
+	return m.Repositories[mecha_tactics_game_weapon.TableName].(*repository.Generic[mecha_tactics_game_record.MechaTacticsGameWeapon, *mecha_tactics_game_record.MechaTacticsGameWeapon])
+}
+
+// MechaTacticsGameTerrainTypeRepository -
+func (m *Domain) MechaTacticsGameTerrainTypeRepository() *repository.Generic[mecha_tactics_game_record.MechaTacticsGameTerrainType, *mecha_tactics_game_record.MechaTacticsGameTerrainType] {
+	return m.Repositories[mecha_tactics_game_terrain_type.TableName].(*repository.Generic[mecha_tactics_game_record.MechaTacticsGameTerrainType, *mecha_tactics_game_record.MechaTacticsGameTerrainType])
+}
+
+// MechaTacticsGameHexRepository -
+func (m *Domain) MechaTacticsGameHexRepository() *repository.Generic[mecha_tactics_game_record.MechaTacticsGameHex, *mecha_tactics_game_record.MechaTacticsGameHex] {
+	return m.Repositories[mecha_tactics_game_hex.TableName].(*repository.Generic[mecha_tactics_game_record.MechaTacticsGameHex, *mecha_tactics_game_record.MechaTacticsGameHex])
+}
+
+// MechaTacticsGameMechRepository -
+func (m *Domain) MechaTacticsGameMechRepository() *repository.Generic[mecha_tactics_game_record.MechaTacticsGameMech, *mecha_tactics_game_record.MechaTacticsGameMech] {
+	return m.Repositories[mecha_tactics_game_mech.TableName].(*repository.Generic[mecha_tactics_game_record.MechaTacticsGameMech, *mecha_tactics_game_record.MechaTacticsGameMech])
+}
+
+// MechaTacticsGameMechInstanceRepository -
+func (m *Domain) MechaTacticsGameMechInstanceRepository() *repository.Generic[mecha_tactics_game_record.MechaTacticsGameMechInstance, *mecha_tactics_game_record.MechaTacticsGameMechInstance] {
+	return m.Repositories[mecha_tactics_game_mech_instance.TableName].(*repository.Generic[mecha_tactics_game_record.MechaTacticsGameMechInstance, *mecha_tactics_game_record.MechaTacticsGameMechInstance])
+}
+
+// MechaTacticsGameTurnSheetRepository -
+func (m *Domain) MechaTacticsGameTurnSheetRepository() *repository.Generic[mecha_tactics_game_record.MechaTacticsGameTurnSheet, *mecha_tactics_game_record.MechaTacticsGameTurnSheet] {
+	return m.Repositories[mecha_tactics_game_turn_sheet.TableName].(*repository.Generic[mecha_tactics_game_record.MechaTacticsGameTurnSheet, *mecha_tactics_game_record.MechaTacticsGameTurnSheet])
+}
+
 // Logger - Returns a logger with package context and provided function context
 func (m *Domain) Logger(functionName string) logger.Logger {
 	return m.Log.WithFunctionContext(functionName)
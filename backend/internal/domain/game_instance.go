@@ -76,6 +76,10 @@ func (m *Domain) CreateGameInstanceRec(rec *game_record.GameInstance) (*game_rec
 		rec.DeliveryPhysicalPost = true
 	}
 
+	if rec.MissedTurnPolicy == "" {
+		rec.MissedTurnPolicy = game_record.GameInstanceMissedTurnPolicyIdle
+	}
+
 	// Default turn_duration_hours and draft-specific settings from the parent game
 	if rec.GameID != "" {
 		gameRec, getErr := m.GetGameRec(rec.GameID, nil)
@@ -193,8 +197,9 @@ func (m *Domain) RemoveGameInstanceRec(recID string) error {
 // GameInstanceData holds the populated runtime records for a started game instance.
 // Exactly one of the game-type-specific fields will be non-nil, matching the game type of the instance.
 type GameInstanceData struct {
-	Adventure *AdventureGameInstanceData
-	MechaGame     *MechaGameInstanceData
+	Adventure        *AdventureGameInstanceData
+	MechaGame        *MechaGameInstanceData
+	MechaTacticsGame *MechaTacticsGameInstanceData
 }
 
 // StartGameInstance starts a game instance: populates all world and player data then transitions status to started.
@@ -240,6 +245,12 @@ func (m *Domain) StartGameInstance(instanceID string) (*game_record.GameInstance
 			l.Warn("failed to populate mecha game instance data >%v<", err)
 			return nil, nil, err
 		}
+	case game_record.GameTypeMechaTactics:
+		instanceData.MechaTacticsGame, err = m.PopulateMechaTacticsGameInstanceData(instanceID)
+		if err != nil {
+			l.Warn("failed to populate mecha tactics game instance data >%v<", err)
+			return nil, nil, err
+		}
 	}
 
 	now := time.Now()
@@ -299,17 +310,24 @@ func (m *Domain) CompleteTurn(instanceID string) (*game_record.GameInstance, err
 		return nil, fmt.Errorf("game instance must be started to complete turns")
 	}
 
-	// Check if we've reached max turns
-	// max turns handled via configuration; not tracked directly on instance
-	if false {
+	// Check whether the turn just processed has ended the game
+	outcome, err := m.EvaluateGameInstanceEndConditions(gameInstanceRec)
+	if err != nil {
+		l.Warn("failed evaluating end conditions for game instance >%s< >%v<", instanceID, err)
+		return nil, err
+	}
+
+	// Advance to next turn. When the game has ended the final results
+	// sheets are delivered as the sheets for this turn.
+	gameInstanceRec.CurrentTurn++
+
+	if outcome != nil {
 		gameInstanceRec.Status = game_record.GameInstanceStatusCompleted
 		now := time.Now()
 		gameInstanceRec.CompletedAt = nulltime.FromTime(now)
-		l.Info("game instance >%s< completed", instanceID)
+		gameInstanceRec.NextTurnDueAt = sql.NullTime{}
+		l.Info("game instance >%s< completed with end condition >%s<", instanceID, outcome.EndCondition)
 	} else {
-		// Advance to next turn
-		gameInstanceRec.CurrentTurn++
-
 		if gameInstanceRec.ProcessWhenAllSubmitted {
 			// Player-driven: leave NextTurnDueAt null so the periodic worker
 			// won't queue processing until the player submits all sheets.
@@ -328,6 +346,15 @@ func (m *Domain) CompleteTurn(instanceID string) (*game_record.GameInstance, err
 		return nil, err
 	}
 
+	if outcome != nil {
+		if _, err := m.RecordGameInstanceResults(gameInstanceRec, outcome); err != nil {
+			return nil, err
+		}
+		if err := m.RecordMechaGameCampaignBattle(gameInstanceRec); err != nil {
+			return nil, err
+		}
+	}
+
 	return gameInstanceRec, nil
 }
 
@@ -1146,6 +1173,12 @@ func (m *Domain) DeleteGameInstance(instanceID string) error {
 		return err
 	}
 
+	// Delete mecha tactics instance data (turn sheets, mech instances)
+	if err := m.deleteMechaTacticsGameInstanceData(instanceID); err != nil {
+		l.Warn("failed to delete mecha tactics instance data >%v<", err)
+		return err
+	}
+
 	// Delete adventure_game_turn_sheet records (linked via character instance)
 	charInstances, err := m.GetManyAdventureGameCharacterInstanceRecs(&coresql.Options{
 		Params: []coresql.Param{
@@ -1327,12 +1360,24 @@ func (m *Domain) RemoveGameInstance(instanceID string) error {
 		return err
 	}
 
+	// Remove game_scan_batch records first as scan batch pages reference turn sheets
+	if err := m.removeGameInstanceScanBatches(instanceID); err != nil {
+		l.Warn("failed to remove game scan batches >%v<", err)
+		return err
+	}
+
 	// Remove mecha instance data (turn sheets, mech instances, squad instances, sector instances)
 	if err := m.removeMechaGameInstanceData(instanceID); err != nil {
 		l.Warn("failed to remove mecha instance data >%v<", err)
 		return err
 	}
 
+	// Remove mecha tactics instance data (turn sheets, mech instances)
+	if err := m.removeMechaTacticsGameInstanceData(instanceID); err != nil {
+		l.Warn("failed to remove mecha tactics instance data >%v<", err)
+		return err
+	}
+
 	// Remove adventure_game_turn_sheet records (linked via character instance)
 	charInstances, err := m.GetManyAdventureGameCharacterInstanceRecs(&coresql.Options{
 		Params: []coresql.Param{
@@ -1362,6 +1407,36 @@ func (m *Domain) RemoveGameInstance(instanceID string) error {
 		}
 	}
 
+	// Remove game_print_batch records
+	printBatches, err := m.GetManyGamePrintBatchRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: game_record.FieldGamePrintBatchGameInstanceID, Val: instanceID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get game print batches >%v<", err)
+		return databaseError(err)
+	}
+	for _, pb := range printBatches {
+		if err := m.RemoveGamePrintBatchRec(pb.ID); err != nil {
+			l.Warn("failed to remove game print batch >%s< >%v<", pb.ID, err)
+			return err
+		}
+	}
+
+	// Remove game_instance_result records
+	results, err := m.GetGameInstanceResultRecsByGameInstance(instanceID)
+	if err != nil {
+		l.Warn("failed to get game instance results >%v<", err)
+		return err
+	}
+	for _, r := range results {
+		if err := m.RemoveGameInstanceResultRec(r.ID); err != nil {
+			l.Warn("failed to remove game instance result >%s< >%v<", r.ID, err)
+			return err
+		}
+	}
+
 	// Remove game_turn_sheet records
 	gameTurnSheets, err := m.GetManyGameTurnSheetRecs(&coresql.Options{
 		Params: []coresql.Param{
@@ -0,0 +1,714 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+
+	"gitlab.com/alienspaces/playbymail/core/collection/set"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+// Game end conditions are evaluated after each turn is processed. Each game type
+// registers an ordered list of victory checks and a standings function that ranks
+// its players. The run-level max turns limit applies to every game type and is
+// checked after the victory checks so a victory on the final turn is recorded as such.
+//
+// To add a new end condition:
+//  1. Add the condition constant to game_record (and the game_instance_result check constraint)
+//  2. Implement a gameEndConditionCheck and register it in gameEndConditionChecks below
+
+// GameEndPlacing is a player's final placing when a game instance ends
+type GameEndPlacing struct {
+	GameSubscriptionInstanceID string
+	Placing                    int
+	IsWinner                   bool
+	Summary                    string
+}
+
+// GameEndOutcome describes how a game instance ended and where each player placed
+type GameEndOutcome struct {
+	EndCondition string
+	Placings     []*GameEndPlacing
+}
+
+// gameEndConditionCheck returns the outcome when its end condition has been met,
+// or nil when the game instance should continue.
+type gameEndConditionCheck func(m *Domain, gameInstanceRec *game_record.GameInstance) (*GameEndOutcome, error)
+
+// gameEndStandingsFunc ranks the players of a game instance. Winners are the
+// players that met a victory condition and are always placed ahead of the rest.
+type gameEndStandingsFunc func(m *Domain, gameInstanceRec *game_record.GameInstance, winners set.Set[string]) ([]*gameEndStanding, error)
+
+var gameEndConditionChecks = map[string][]gameEndConditionCheck{
+	game_record.GameTypeAdventure: {
+		(*Domain).checkAdventureGameGoalLocationReached,
+		(*Domain).checkAdventureGameGoalItemHeld,
+		(*Domain).checkAdventureGameAllCharactersRetired,
+	},
+	game_record.GameTypeMecha: {
+		(*Domain).checkMechaGameObjectiveHeld,
+		(*Domain).checkMechaGameLastSquadStanding,
+	},
+	game_record.GameTypeMechaTactics: {
+		(*Domain).checkMechaTacticsGameLastSideStanding,
+	},
+}
+
+var gameEndStandingsFuncs = map[string]gameEndStandingsFunc{
+	game_record.GameTypeAdventure:    (*Domain).getAdventureGameEndStandings,
+	game_record.GameTypeMecha:        (*Domain).getMechaGameEndStandings,
+	game_record.GameTypeMechaTactics: (*Domain).getMechaTacticsGameEndStandings,
+}
+
+// gameEndStanding is a player's position before placings are assigned. Players
+// are ordered by winner, then tier, then score, highest first.
+type gameEndStanding struct {
+	gameSubscriptionInstanceID string
+	winner                     bool
+	tier                       int
+	score                      int
+	summary                    string
+}
+
+// EvaluateGameInstanceEndConditions checks whether the game instance has ended with
+// the turn that has just been processed. Returns nil when the game should continue.
+func (m *Domain) EvaluateGameInstanceEndConditions(gameInstanceRec *game_record.GameInstance) (*GameEndOutcome, error) {
+	l := m.Logger("EvaluateGameInstanceEndConditions")
+
+	gameRec, err := m.GetGameRec(gameInstanceRec.GameID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Victory checks only apply once players have had a turn to act.
+	if gameInstanceRec.CurrentTurn > 0 {
+		for _, check := range gameEndConditionChecks[gameRec.GameType] {
+			outcome, err := check(m, gameInstanceRec)
+			if err != nil {
+				l.Warn("failed evaluating end condition for game instance >%s< >%v<", gameInstanceRec.ID, err)
+				return nil, err
+			}
+			if outcome != nil {
+				l.Info("game instance >%s< ended at turn >%d< with end condition >%s<", gameInstanceRec.ID, gameInstanceRec.CurrentTurn, outcome.EndCondition)
+				return outcome, nil
+			}
+		}
+	}
+
+	if gameInstanceRec.MaxTurns.Valid && int64(gameInstanceRec.CurrentTurn) >= gameInstanceRec.MaxTurns.Int64 {
+		l.Info("game instance >%s< reached max turns >%d<", gameInstanceRec.ID, gameInstanceRec.MaxTurns.Int64)
+		return m.newGameEndOutcome(gameInstanceRec, gameRec.GameType, game_record.GameEndConditionMaxTurns, nil)
+	}
+
+	return nil, nil
+}
+
+// newGameEndOutcome ranks the players of the game instance for the given end condition.
+func (m *Domain) newGameEndOutcome(gameInstanceRec *game_record.GameInstance, gameType, endCondition string, winners set.Set[string]) (*GameEndOutcome, error) {
+	if winners == nil {
+		winners = set.New[string]()
+	}
+
+	var standings []*gameEndStanding
+	if standingsFunc, ok := gameEndStandingsFuncs[gameType]; ok {
+		var err error
+		standings, err = standingsFunc(m, gameInstanceRec, winners)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Game types without standings share first place
+		playerLinks, err := m.getGameEndPlayerLinks(gameInstanceRec.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, link := range playerLinks {
+			standings = append(standings, &gameEndStanding{
+				gameSubscriptionInstanceID: link.ID,
+				winner:                     winners.Has(link.ID),
+			})
+		}
+	}
+
+	return &GameEndOutcome{
+		EndCondition: endCondition,
+		Placings:     rankGameEndStandings(standings),
+	}, nil
+}
+
+// rankGameEndStandings assigns placings to the standings. Players with equal
+// standing share a placing and the next placing skips the tied positions.
+func rankGameEndStandings(standings []*gameEndStanding) []*GameEndPlacing {
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.winner != b.winner {
+			return a.winner
+		}
+		if a.tier != b.tier {
+			return a.tier > b.tier
+		}
+		return a.score > b.score
+	})
+
+	placings := make([]*GameEndPlacing, 0, len(standings))
+	for i, s := range standings {
+		placing := i + 1
+		if i > 0 {
+			prev := standings[i-1]
+			if prev.winner == s.winner && prev.tier == s.tier && prev.score == s.score {
+				placing = placings[i-1].Placing
+			}
+		}
+		placings = append(placings, &GameEndPlacing{
+			GameSubscriptionInstanceID: s.gameSubscriptionInstanceID,
+			Placing:                    placing,
+			IsWinner:                   s.winner,
+			Summary:                    s.summary,
+		})
+	}
+
+	return placings
+}
+
+// getGameEndPlayerLinks returns the subscription instances of the players in a game instance
+func (m *Domain) getGameEndPlayerLinks(gameInstanceID string) ([]*game_record.GameSubscriptionInstance, error) {
+	links, err := m.GetGameSubscriptionInstanceRecsByInstance(gameInstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var playerLinks []*game_record.GameSubscriptionInstance
+	for _, link := range links {
+		subscriptionRec, err := m.GetGameSubscriptionRec(link.GameSubscriptionID, nil)
+		if err != nil {
+			return nil, err
+		}
+		if subscriptionRec.SubscriptionType != game_record.GameSubscriptionTypePlayer {
+			continue
+		}
+		playerLinks = append(playerLinks, link)
+	}
+
+	return playerLinks, nil
+}
+
+// RecordGameInstanceResults creates a result record for each player placing
+func (m *Domain) RecordGameInstanceResults(gameInstanceRec *game_record.GameInstance, outcome *GameEndOutcome) ([]*game_record.GameInstanceResult, error) {
+	l := m.Logger("RecordGameInstanceResults")
+
+	var resultRecs []*game_record.GameInstanceResult
+	for _, placing := range outcome.Placings {
+		resultRec, err := m.CreateGameInstanceResultRec(&game_record.GameInstanceResult{
+			GameID:                     gameInstanceRec.GameID,
+			GameInstanceID:             gameInstanceRec.ID,
+			GameSubscriptionInstanceID: placing.GameSubscriptionInstanceID,
+			Placing:                    placing.Placing,
+			IsWinner:                   placing.IsWinner,
+			EndCondition:               outcome.EndCondition,
+			Summary:                    placing.Summary,
+		})
+		if err != nil {
+			l.Warn("failed creating game instance result for subscription instance >%s< >%v<", placing.GameSubscriptionInstanceID, err)
+			return nil, err
+		}
+		resultRecs = append(resultRecs, resultRec)
+	}
+
+	l.Info("recorded >%d< results for game instance >%s<", len(resultRecs), gameInstanceRec.ID)
+
+	return resultRecs, nil
+}
+
+// adventureGameEndCharacter is a player's character in an adventure game instance
+type adventureGameEndCharacter struct {
+	link              *game_record.GameSubscriptionInstance
+	characterRec      *adventure_game_record.AdventureGameCharacter
+	characterInstance *adventure_game_record.AdventureGameCharacterInstance
+}
+
+// getAdventureGameEndCharacters returns the character of each player in the game instance
+func (m *Domain) getAdventureGameEndCharacters(gameInstanceRec *game_record.GameInstance) ([]*adventureGameEndCharacter, error) {
+	playerLinks, err := m.getGameEndPlayerLinks(gameInstanceRec.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	characterInstanceRecs, err := m.GetManyAdventureGameCharacterInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: adventure_game_record.FieldAdventureGameCharacterInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var characters []*adventureGameEndCharacter
+	for _, characterInstanceRec := range characterInstanceRecs {
+		characterRec, err := m.GetAdventureGameCharacterRec(characterInstanceRec.AdventureGameCharacterID, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, link := range playerLinks {
+			if link.AccountUserID == characterRec.AccountUserID {
+				characters = append(characters, &adventureGameEndCharacter{
+					link:              link,
+					characterRec:      characterRec,
+					characterInstance: characterInstanceRec,
+				})
+				break
+			}
+		}
+	}
+
+	return characters, nil
+}
+
+// checkAdventureGameGoalLocationReached ends the game when a character is at a goal location
+func (m *Domain) checkAdventureGameGoalLocationReached(gameInstanceRec *game_record.GameInstance) (*GameEndOutcome, error) {
+	goalLocationRecs, err := m.GetManyAdventureGameLocationRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: adventure_game_record.FieldAdventureGameLocationGameID, Val: gameInstanceRec.GameID},
+			{Col: adventure_game_record.FieldAdventureGameLocationIsGoalLocation, Val: true},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(goalLocationRecs) == 0 {
+		return nil, nil
+	}
+
+	goalLocationIDs := set.New[string]()
+	for _, rec := range goalLocationRecs {
+		goalLocationIDs.Add(rec.ID)
+	}
+
+	locationInstanceRecs, err := m.GetManyAdventureGameLocationInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: adventure_game_record.FieldAdventureGameLocationInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	goalLocationInstanceIDs := set.New[string]()
+	for _, rec := range locationInstanceRecs {
+		if goalLocationIDs.Has(rec.AdventureGameLocationID) {
+			goalLocationInstanceIDs.Add(rec.ID)
+		}
+	}
+
+	characters, err := m.getAdventureGameEndCharacters(gameInstanceRec)
+	if err != nil {
+		return nil, err
+	}
+
+	winners := set.New[string]()
+	for _, c := range characters {
+		if !c.characterInstance.IsRetired() && goalLocationInstanceIDs.Has(c.characterInstance.AdventureGameLocationInstanceID) {
+			winners.Add(c.link.ID)
+		}
+	}
+	if len(winners) == 0 {
+		return nil, nil
+	}
+
+	return m.newGameEndOutcome(gameInstanceRec, game_record.GameTypeAdventure, game_record.GameEndConditionGoalLocationReached, winners)
+}
+
+// checkAdventureGameGoalItemHeld ends the game when a character is holding a goal item
+func (m *Domain) checkAdventureGameGoalItemHeld(gameInstanceRec *game_record.GameInstance) (*GameEndOutcome, error) {
+	goalItemRecs, err := m.GetManyAdventureGameItemRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: adventure_game_record.FieldAdventureGameItemGameID, Val: gameInstanceRec.GameID},
+			{Col: adventure_game_record.FieldAdventureGameItemIsGoalItem, Val: true},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(goalItemRecs) == 0 {
+		return nil, nil
+	}
+
+	goalItemIDs := set.New[string]()
+	for _, rec := range goalItemRecs {
+		goalItemIDs.Add(rec.ID)
+	}
+
+	itemInstanceRecs, err := m.GetManyAdventureGameItemInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: adventure_game_record.FieldAdventureGameItemInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	holderIDs := set.New[string]()
+	for _, rec := range itemInstanceRecs {
+		if goalItemIDs.Has(rec.AdventureGameItemID) && rec.AdventureGameCharacterInstanceID.Valid {
+			holderIDs.Add(rec.AdventureGameCharacterInstanceID.String)
+		}
+	}
+	if len(holderIDs) == 0 {
+		return nil, nil
+	}
+
+	characters, err := m.getAdventureGameEndCharacters(gameInstanceRec)
+	if err != nil {
+		return nil, err
+	}
+
+	winners := set.New[string]()
+	for _, c := range characters {
+		if !c.characterInstance.IsRetired() && holderIDs.Has(c.characterInstance.ID) {
+			winners.Add(c.link.ID)
+		}
+	}
+	if len(winners) == 0 {
+		return nil, nil
+	}
+
+	return m.newGameEndOutcome(gameInstanceRec, game_record.GameTypeAdventure, game_record.GameEndConditionGoalItemHeld, winners)
+}
+
+// checkAdventureGameAllCharactersRetired ends the game when every character has run out of lives
+func (m *Domain) checkAdventureGameAllCharactersRetired(gameInstanceRec *game_record.GameInstance) (*GameEndOutcome, error) {
+	characters, err := m.getAdventureGameEndCharacters(gameInstanceRec)
+	if err != nil {
+		return nil, err
+	}
+	if len(characters) == 0 {
+		return nil, nil
+	}
+
+	for _, c := range characters {
+		if !c.characterInstance.IsRetired() {
+			return nil, nil
+		}
+	}
+
+	return m.newGameEndOutcome(gameInstanceRec, game_record.GameTypeAdventure, game_record.GameEndConditionAllCharactersRetired, nil)
+}
+
+// getAdventureGameEndStandings ranks characters still adventuring ahead of retired
+// characters. Active characters rank by fewest lives lost and retired characters by
+// how long they survived.
+func (m *Domain) getAdventureGameEndStandings(gameInstanceRec *game_record.GameInstance, winners set.Set[string]) ([]*gameEndStanding, error) {
+	characters, err := m.getAdventureGameEndCharacters(gameInstanceRec)
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]*gameEndStanding, 0, len(characters))
+	for _, c := range characters {
+		s := &gameEndStanding{
+			gameSubscriptionInstanceID: c.link.ID,
+			winner:                     winners.Has(c.link.ID),
+		}
+		if c.characterInstance.IsRetired() {
+			s.score = int(c.characterInstance.RetiredAtTurn.Int64)
+			s.summary = fmt.Sprintf("%s fell for the last time on turn %d.", c.characterRec.Name, s.score)
+		} else {
+			s.tier = 1
+			s.score = -c.characterInstance.LivesLost
+			s.summary = fmt.Sprintf("%s survived having lost %d lives.", c.characterRec.Name, c.characterInstance.LivesLost)
+			if c.characterInstance.LivesLost == 1 {
+				s.summary = fmt.Sprintf("%s survived having lost 1 life.", c.characterRec.Name)
+			}
+		}
+		if s.winner {
+			s.summary = fmt.Sprintf("%s completed the adventure.", c.characterRec.Name)
+		}
+		standings = append(standings, s)
+	}
+
+	return standings, nil
+}
+
+// mechaGameEndSquad is a squad in a mecha game instance with its mechs still standing
+type mechaGameEndSquad struct {
+	squadInstance *mecha_game_record.MechaGameSquadInstance
+	standing      int
+	total         int
+}
+
+// getMechaGameEndSquads returns every squad in the game instance, including computer
+// opponents, with a count of mechs that have not been destroyed.
+func (m *Domain) getMechaGameEndSquads(gameInstanceRec *game_record.GameInstance) ([]*mechaGameEndSquad, error) {
+	squadInstanceRecs, err := m.GetManyMechaGameSquadInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameSquadInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mechInstanceRecs, err := m.GetManyMechaGameMechInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameMechInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	squads := make([]*mechaGameEndSquad, 0, len(squadInstanceRecs))
+	squadsByID := make(map[string]*mechaGameEndSquad, len(squadInstanceRecs))
+	for _, rec := range squadInstanceRecs {
+		squad := &mechaGameEndSquad{squadInstance: rec}
+		squads = append(squads, squad)
+		squadsByID[rec.ID] = squad
+	}
+
+	for _, rec := range mechInstanceRecs {
+		squad, ok := squadsByID[rec.MechaGameSquadInstanceID]
+		if !ok {
+			continue
+		}
+		squad.total++
+		if rec.Status != mecha_game_record.MechInstanceStatusDestroyed {
+			squad.standing++
+		}
+	}
+
+	return squads, nil
+}
+
+// checkMechaGameLastSquadStanding ends the game when no more than one squad has mechs
+// left standing. The surviving squad wins when it belongs to a player.
+func (m *Domain) checkMechaGameLastSquadStanding(gameInstanceRec *game_record.GameInstance) (*GameEndOutcome, error) {
+	squads, err := m.getMechaGameEndSquads(gameInstanceRec)
+	if err != nil {
+		return nil, err
+	}
+	if len(squads) < 2 {
+		return nil, nil
+	}
+
+	var survivors []*mechaGameEndSquad
+	for _, squad := range squads {
+		if squad.standing > 0 {
+			survivors = append(survivors, squad)
+		}
+	}
+	if len(survivors) > 1 {
+		return nil, nil
+	}
+
+	winners := set.New[string]()
+	if len(survivors) == 1 && survivors[0].squadInstance.GameSubscriptionInstanceID.Valid {
+		winners.Add(survivors[0].squadInstance.GameSubscriptionInstanceID.String)
+	}
+
+	return m.newGameEndOutcome(gameInstanceRec, game_record.GameTypeMecha, game_record.GameEndConditionLastSquadStanding, winners)
+}
+
+// checkMechaGameObjectiveHeld ends the game when a squad has controlled an objective
+// sector for the number of turns set by the objective_hold_turns parameter.
+func (m *Domain) checkMechaGameObjectiveHeld(gameInstanceRec *game_record.GameInstance) (*GameEndOutcome, error) {
+	holdTurns, err := m.GetGameInstanceIntegerParameterValue(gameInstanceRec.ID, game_record.GameTypeMecha, MechaGameParameterObjectiveHoldTurns)
+	if err != nil {
+		return nil, err
+	}
+
+	sectorInstanceRecs, err := m.GetManyMechaGameSectorInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameSectorInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var heldBy []string
+	for _, rec := range sectorInstanceRecs {
+		if !rec.ControllingSquadInstanceID.Valid || !rec.ControlledSinceTurn.Valid {
+			continue
+		}
+		if int64(gameInstanceRec.CurrentTurn)-rec.ControlledSinceTurn.Int64+1 >= int64(holdTurns) {
+			heldBy = append(heldBy, rec.ControllingSquadInstanceID.String)
+		}
+	}
+	if len(heldBy) == 0 {
+		return nil, nil
+	}
+
+	winners := set.New[string]()
+	for _, squadInstanceID := range heldBy {
+		squadInstanceRec, err := m.GetMechaGameSquadInstanceRec(squadInstanceID, nil)
+		if err != nil {
+			return nil, err
+		}
+		if squadInstanceRec.GameSubscriptionInstanceID.Valid {
+			winners.Add(squadInstanceRec.GameSubscriptionInstanceID.String)
+		}
+	}
+
+	return m.newGameEndOutcome(gameInstanceRec, game_record.GameTypeMecha, game_record.GameEndConditionObjectiveHeld, winners)
+}
+
+// getMechaGameEndStandings ranks player squads by the victory points scored from
+// objectives, then by the number of mechs left standing. Victory points are only
+// reported when a squad in the run has scored any.
+func (m *Domain) getMechaGameEndStandings(gameInstanceRec *game_record.GameInstance, winners set.Set[string]) ([]*gameEndStanding, error) {
+	squads, err := m.getMechaGameEndSquads(gameInstanceRec)
+	if err != nil {
+		return nil, err
+	}
+
+	victoryPointsScored := false
+	for _, squad := range squads {
+		if squad.squadInstance.VictoryPoints > 0 {
+			victoryPointsScored = true
+		}
+	}
+
+	var standings []*gameEndStanding
+	for _, squad := range squads {
+		if !squad.squadInstance.GameSubscriptionInstanceID.Valid {
+			continue
+		}
+		linkID := squad.squadInstance.GameSubscriptionInstanceID.String
+		summary := fmt.Sprintf("%d of %d mechs left standing.", squad.standing, squad.total)
+		if victoryPointsScored {
+			summary = fmt.Sprintf("%d victory points, %d of %d mechs left standing.",
+				squad.squadInstance.VictoryPoints, squad.standing, squad.total)
+		}
+		standings = append(standings, &gameEndStanding{
+			gameSubscriptionInstanceID: linkID,
+			winner:                     winners.Has(linkID),
+			tier:                       squad.squadInstance.VictoryPoints,
+			score:                      squad.standing,
+			summary:                    summary,
+		})
+	}
+
+	return standings, nil
+}
+
+// mechaTacticsGameEndSide is a player or computer opponent in a mecha tactics game
+// instance with its mechs still standing. Computer opponents have no subscription instance.
+type mechaTacticsGameEndSide struct {
+	gameSubscriptionInstanceID string
+	standing                   int
+	total                      int
+}
+
+// getMechaTacticsGameEndSides returns every player and computer opponent in the game
+// instance with a count of mechs that have not been destroyed.
+func (m *Domain) getMechaTacticsGameEndSides(gameInstanceRec *game_record.GameInstance) ([]*mechaTacticsGameEndSide, error) {
+	mechInstanceRecs, err := m.GetManyMechaTacticsGameMechInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return groupMechaTacticsGameEndSides(mechInstanceRecs), nil
+}
+
+// groupMechaTacticsGameEndSides groups mech instances by the player or computer opponent
+// that owns them, in the order each side first appears.
+func groupMechaTacticsGameEndSides(mechInstanceRecs []*mecha_tactics_game_record.MechaTacticsGameMechInstance) []*mechaTacticsGameEndSide {
+	var sides []*mechaTacticsGameEndSide
+	sidesByOwner := map[string]*mechaTacticsGameEndSide{}
+	for _, rec := range mechInstanceRecs {
+		owner := "player:" + rec.GameSubscriptionInstanceID.String
+		if !rec.GameSubscriptionInstanceID.Valid {
+			owner = "opponent:" + rec.MechaTacticsGameComputerOpponentID.String
+		}
+
+		side, ok := sidesByOwner[owner]
+		if !ok {
+			side = &mechaTacticsGameEndSide{gameSubscriptionInstanceID: rec.GameSubscriptionInstanceID.String}
+			sides = append(sides, side)
+			sidesByOwner[owner] = side
+		}
+
+		side.total++
+		if rec.Status != mecha_tactics_game_record.MechInstanceStatusDestroyed {
+			side.standing++
+		}
+	}
+
+	return sides
+}
+
+// mechaTacticsGameLastSideStanding reports whether no more than one side has mechs left
+// standing, returning the surviving player when there is one.
+func mechaTacticsGameLastSideStanding(sides []*mechaTacticsGameEndSide) (bool, set.Set[string]) {
+	if len(sides) < 2 {
+		return false, nil
+	}
+
+	var survivors []*mechaTacticsGameEndSide
+	for _, side := range sides {
+		if side.standing > 0 {
+			survivors = append(survivors, side)
+		}
+	}
+	if len(survivors) > 1 {
+		return false, nil
+	}
+
+	winners := set.New[string]()
+	if len(survivors) == 1 && survivors[0].gameSubscriptionInstanceID != "" {
+		winners.Add(survivors[0].gameSubscriptionInstanceID)
+	}
+
+	return true, winners
+}
+
+// checkMechaTacticsGameLastSideStanding ends the game when no more than one player or
+// computer opponent has mechs left standing. The surviving side wins when it is a player.
+func (m *Domain) checkMechaTacticsGameLastSideStanding(gameInstanceRec *game_record.GameInstance) (*GameEndOutcome, error) {
+	sides, err := m.getMechaTacticsGameEndSides(gameInstanceRec)
+	if err != nil {
+		return nil, err
+	}
+
+	ended, winners := mechaTacticsGameLastSideStanding(sides)
+	if !ended {
+		return nil, nil
+	}
+
+	return m.newGameEndOutcome(gameInstanceRec, game_record.GameTypeMechaTactics, game_record.GameEndConditionLastSideStanding, winners)
+}
+
+// getMechaTacticsGameEndStandings ranks players by the number of mechs left standing.
+func (m *Domain) getMechaTacticsGameEndStandings(gameInstanceRec *game_record.GameInstance, winners set.Set[string]) ([]*gameEndStanding, error) {
+	sides, err := m.getMechaTacticsGameEndSides(gameInstanceRec)
+	if err != nil {
+		return nil, err
+	}
+
+	return mechaTacticsGameEndStandings(sides, winners), nil
+}
+
+// mechaTacticsGameEndStandings returns the standing of each player side.
+func mechaTacticsGameEndStandings(sides []*mechaTacticsGameEndSide, winners set.Set[string]) []*gameEndStanding {
+	var standings []*gameEndStanding
+	for _, side := range sides {
+		if side.gameSubscriptionInstanceID == "" {
+			continue
+		}
+		standings = append(standings, &gameEndStanding{
+			gameSubscriptionInstanceID: side.gameSubscriptionInstanceID,
+			winner:                     winners.Has(side.gameSubscriptionInstanceID),
+			score:                      side.standing,
+			summary:                    fmt.Sprintf("%d of %d mechs left standing.", side.standing, side.total),
+		})
+	}
+
+	return standings
+}
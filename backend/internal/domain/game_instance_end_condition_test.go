@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/collection/set"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+func TestRankGameEndStandings(t *testing.T) {
+	cases := []struct {
+		name      string
+		standings []*gameEndStanding
+		want      map[string]int
+		winners   []string
+	}{
+		{
+			name: "winner ranks first regardless of score",
+			standings: []*gameEndStanding{
+				{gameSubscriptionInstanceID: "a", score: 10},
+				{gameSubscriptionInstanceID: "b", winner: true, score: 1},
+				{gameSubscriptionInstanceID: "c", score: 5},
+			},
+			want:    map[string]int{"b": 1, "a": 2, "c": 3},
+			winners: []string{"b"},
+		},
+		{
+			name: "tier outranks score",
+			standings: []*gameEndStanding{
+				{gameSubscriptionInstanceID: "a", tier: 0, score: 10},
+				{gameSubscriptionInstanceID: "b", tier: 1, score: 0},
+			},
+			want: map[string]int{"b": 1, "a": 2},
+		},
+		{
+			name: "ties share a placing and skip the next",
+			standings: []*gameEndStanding{
+				{gameSubscriptionInstanceID: "a", score: 3},
+				{gameSubscriptionInstanceID: "b", score: 3},
+				{gameSubscriptionInstanceID: "c", score: 1},
+			},
+			want: map[string]int{"a": 1, "b": 1, "c": 3},
+		},
+		{
+			name: "shared victory places every winner first",
+			standings: []*gameEndStanding{
+				{gameSubscriptionInstanceID: "a", winner: true},
+				{gameSubscriptionInstanceID: "b", winner: true},
+				{gameSubscriptionInstanceID: "c"},
+			},
+			want:    map[string]int{"a": 1, "b": 1, "c": 3},
+			winners: []string{"a", "b"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			placings := rankGameEndStandings(tc.standings)
+			require.Len(t, placings, len(tc.want))
+
+			got := map[string]int{}
+			var winners []string
+			for _, p := range placings {
+				got[p.GameSubscriptionInstanceID] = p.Placing
+				if p.IsWinner {
+					winners = append(winners, p.GameSubscriptionInstanceID)
+				}
+			}
+			require.Equal(t, tc.want, got)
+			require.ElementsMatch(t, tc.winners, winners)
+		})
+	}
+}
+
+func newMechaTacticsEndTestMech(player, opponent, status string) *mecha_tactics_game_record.MechaTacticsGameMechInstance {
+	return &mecha_tactics_game_record.MechaTacticsGameMechInstance{
+		GameSubscriptionInstanceID:         nullstring.FromString(player),
+		MechaTacticsGameComputerOpponentID: nullstring.FromString(opponent),
+		Status:                             status,
+	}
+}
+
+func TestMechaTacticsGameLastSideStanding(t *testing.T) {
+	const (
+		operational = mecha_tactics_game_record.MechInstanceStatusOperational
+		damaged     = mecha_tactics_game_record.MechInstanceStatusDamaged
+		destroyed   = mecha_tactics_game_record.MechInstanceStatusDestroyed
+	)
+
+	cases := []struct {
+		name      string
+		mechs     []*mecha_tactics_game_record.MechaTacticsGameMechInstance
+		wantEnded bool
+		winners   []string
+	}{
+		{
+			name: "two players with mechs standing then the game continues",
+			mechs: []*mecha_tactics_game_record.MechaTacticsGameMechInstance{
+				newMechaTacticsEndTestMech("a", "", operational),
+				newMechaTacticsEndTestMech("b", "", damaged),
+				newMechaTacticsEndTestMech("b", "", destroyed),
+			},
+		},
+		{
+			name: "only one player has mechs standing then that player wins",
+			mechs: []*mecha_tactics_game_record.MechaTacticsGameMechInstance{
+				newMechaTacticsEndTestMech("a", "", operational),
+				newMechaTacticsEndTestMech("b", "", destroyed),
+				newMechaTacticsEndTestMech("", "opp", destroyed),
+			},
+			wantEnded: true,
+			winners:   []string{"a"},
+		},
+		{
+			name: "only a computer opponent has mechs standing then the game ends without a winner",
+			mechs: []*mecha_tactics_game_record.MechaTacticsGameMechInstance{
+				newMechaTacticsEndTestMech("a", "", destroyed),
+				newMechaTacticsEndTestMech("", "opp", operational),
+			},
+			wantEnded: true,
+		},
+		{
+			name: "computer opponents are separate sides then the game continues",
+			mechs: []*mecha_tactics_game_record.MechaTacticsGameMechInstance{
+				newMechaTacticsEndTestMech("a", "", destroyed),
+				newMechaTacticsEndTestMech("", "opp-1", operational),
+				newMechaTacticsEndTestMech("", "opp-2", operational),
+			},
+		},
+		{
+			name: "every mech destroyed then the game ends without a winner",
+			mechs: []*mecha_tactics_game_record.MechaTacticsGameMechInstance{
+				newMechaTacticsEndTestMech("a", "", destroyed),
+				newMechaTacticsEndTestMech("b", "", destroyed),
+			},
+			wantEnded: true,
+		},
+		{
+			name: "a single side then the game continues",
+			mechs: []*mecha_tactics_game_record.MechaTacticsGameMechInstance{
+				newMechaTacticsEndTestMech("a", "", operational),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ended, winners := mechaTacticsGameLastSideStanding(groupMechaTacticsGameEndSides(tc.mechs))
+			require.Equal(t, tc.wantEnded, ended, "ended should match")
+			if !ended {
+				return
+			}
+			require.ElementsMatch(t, tc.winners, winners.ToSlice(), "winners should match")
+		})
+	}
+}
+
+func TestMechaTacticsGameEndStandings(t *testing.T) {
+	mechs := []*mecha_tactics_game_record.MechaTacticsGameMechInstance{
+		newMechaTacticsEndTestMech("a", "", mecha_tactics_game_record.MechInstanceStatusOperational),
+		newMechaTacticsEndTestMech("a", "", mecha_tactics_game_record.MechInstanceStatusDestroyed),
+		newMechaTacticsEndTestMech("b", "", mecha_tactics_game_record.MechInstanceStatusDestroyed),
+		newMechaTacticsEndTestMech("", "opp", mecha_tactics_game_record.MechInstanceStatusOperational),
+	}
+
+	standings := mechaTacticsGameEndStandings(groupMechaTacticsGameEndSides(mechs), set.New("a"))
+	require.Len(t, standings, 2, "computer opponents are not ranked")
+
+	require.Equal(t, "a", standings[0].gameSubscriptionInstanceID)
+	require.True(t, standings[0].winner, "winner should be marked")
+	require.Equal(t, 1, standings[0].score, "score is the mechs left standing")
+	require.Equal(t, "1 of 2 mechs left standing.", standings[0].summary)
+
+	require.Equal(t, "b", standings[1].gameSubscriptionInstanceID)
+	require.False(t, standings[1].winner)
+	require.Equal(t, "0 of 1 mechs left standing.", standings[1].summary)
+
+	placings := rankGameEndStandings(standings)
+	require.Equal(t, 1, placings[0].Placing)
+	require.Equal(t, 2, placings[1].Placing)
+}
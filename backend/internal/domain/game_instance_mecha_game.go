@@ -27,26 +27,24 @@ type mechInitialLoadoutState struct {
 
 // resolveMechInitialLoadout hydrates a squad mech's persisted JSON loadouts,
 // looks up the referenced weapon / equipment records, and returns the initial
-// armor bonus (from armor_upgrade magnitudes) and ammo pool (Σ weapon.ammo_capacity
-// + Σ ammo_bin magnitudes) to seed the new mech instance with.
+// armor bonus and ammo pool to seed the new mech instance with.
 func (m *Domain) resolveMechInitialLoadout(squadMech *mecha_game_record.MechaGameSquadMech) (*mechInitialLoadoutState, error) {
-	state := &mechInitialLoadoutState{
-		WeaponConfigJSON: squadMech.WeaponConfigJSON,
-		EquipmentJSON:    squadMech.EquipmentConfigJSON,
-	}
+	var weaponConfig []mecha_game_record.WeaponConfigEntry
+	var equipmentConfig []mecha_game_record.EquipmentConfigEntry
 
 	if len(squadMech.WeaponConfigJSON) > 0 {
-		if err := json.Unmarshal(squadMech.WeaponConfigJSON, &state.WeaponConfig); err != nil {
+		if err := json.Unmarshal(squadMech.WeaponConfigJSON, &weaponConfig); err != nil {
 			return nil, fmt.Errorf("resolveMechInitialLoadout: unmarshal weapon_config for squad mech >%s<: %w", squadMech.ID, err)
 		}
 	}
 	if len(squadMech.EquipmentConfigJSON) > 0 {
-		if err := json.Unmarshal(squadMech.EquipmentConfigJSON, &state.EquipmentConfig); err != nil {
+		if err := json.Unmarshal(squadMech.EquipmentConfigJSON, &equipmentConfig); err != nil {
 			return nil, fmt.Errorf("resolveMechInitialLoadout: unmarshal equipment_config for squad mech >%s<: %w", squadMech.ID, err)
 		}
 	}
 
-	for _, entry := range state.WeaponConfig {
+	weaponByID := make(map[string]*mecha_game_record.MechaGameWeapon, len(weaponConfig))
+	for _, entry := range weaponConfig {
 		if entry.WeaponID == "" {
 			continue
 		}
@@ -54,10 +52,11 @@ func (m *Domain) resolveMechInitialLoadout(squadMech *mecha_game_record.MechaGam
 		if err != nil {
 			return nil, err
 		}
-		state.AmmoRemaining += w.AmmoCapacity
+		weaponByID[w.ID] = w
 	}
 
-	for _, entry := range state.EquipmentConfig {
+	equipmentByID := make(map[string]*mecha_game_record.MechaGameEquipment, len(equipmentConfig))
+	for _, entry := range equipmentConfig {
 		if entry.EquipmentID == "" {
 			continue
 		}
@@ -65,6 +64,41 @@ func (m *Domain) resolveMechInitialLoadout(squadMech *mecha_game_record.MechaGam
 		if err != nil {
 			return nil, err
 		}
+		equipmentByID[eq.ID] = eq
+	}
+
+	state := newMechInitialLoadoutState(weaponConfig, equipmentConfig, weaponByID, equipmentByID)
+	state.WeaponConfigJSON = squadMech.WeaponConfigJSON
+	state.EquipmentJSON = squadMech.EquipmentConfigJSON
+
+	return state, nil
+}
+
+// newMechInitialLoadoutState returns the initial armor bonus (from armor_upgrade
+// magnitudes) and ammo pool (Σ weapon.ammo_capacity + Σ ammo_bin magnitudes) of
+// a loadout. The JSON fields are left for the caller to set.
+func newMechInitialLoadoutState(
+	weaponConfig []mecha_game_record.WeaponConfigEntry,
+	equipmentConfig []mecha_game_record.EquipmentConfigEntry,
+	weaponByID map[string]*mecha_game_record.MechaGameWeapon,
+	equipmentByID map[string]*mecha_game_record.MechaGameEquipment,
+) *mechInitialLoadoutState {
+	state := &mechInitialLoadoutState{
+		WeaponConfig:    weaponConfig,
+		EquipmentConfig: equipmentConfig,
+	}
+
+	for _, entry := range weaponConfig {
+		if w := weaponByID[entry.WeaponID]; w != nil {
+			state.AmmoRemaining += w.AmmoCapacity
+		}
+	}
+
+	for _, entry := range equipmentConfig {
+		eq := equipmentByID[entry.EquipmentID]
+		if eq == nil {
+			continue
+		}
 		switch eq.EffectKind {
 		case mecha_game_record.EquipmentEffectKindArmorUpgrade:
 			state.ArmorBonus += eq.Magnitude
@@ -73,7 +107,72 @@ func (m *Domain) resolveMechInitialLoadout(squadMech *mecha_game_record.MechaGam
 		}
 	}
 
-	return state, nil
+	return state
+}
+
+// getMechaGameSquadMechRecsForSquad returns the mechs belonging to a squad template in
+// creation order so truncation and padding are deterministic.
+func (m *Domain) getMechaGameSquadMechRecsForSquad(squadID string) ([]*mecha_game_record.MechaGameSquadMech, error) {
+	return m.GetManyMechaGameSquadMechRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameSquadMechMechaGameSquadID, Val: squadID},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: mecha_game_record.FieldMechaGameSquadMechCreatedAt, Direction: coresql.OrderDirectionASC},
+		},
+	})
+}
+
+// getMechaGameReserveSquadMechRecs returns the mechs in a game's reserve squad, or
+// nil when the game has no reserve squad configured.
+func (m *Domain) getMechaGameReserveSquadMechRecs(gameID string) ([]*mecha_game_record.MechaGameSquadMech, error) {
+	reserveRecs, err := m.GetManyMechaGameSquadRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameSquadGameID, Val: gameID},
+			{Col: mecha_game_record.FieldMechaGameSquadSquadType, Val: mecha_game_record.SquadTypeReserve},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(reserveRecs) == 0 {
+		return nil, nil
+	}
+	return m.getMechaGameSquadMechRecsForSquad(reserveRecs[0].ID)
+}
+
+// resolveMechaGamePlayerSquadMechs returns the squad mech templates each player's squad is
+// cloned from. The starter squad is truncated to squadSize; when it holds fewer mechs the
+// remainder is taken, in order, from the game's reserve squad.
+func (m *Domain) resolveMechaGamePlayerSquadMechs(gameID, starterSquadID string, squadSize int) ([]*mecha_game_record.MechaGameSquadMech, error) {
+	if squadSize < 1 {
+		return nil, coreerror.NewInvalidDataError("squad size must be at least 1, got >%d<", squadSize)
+	}
+
+	starterMechs, err := m.getMechaGameSquadMechRecsForSquad(starterSquadID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(starterMechs) >= squadSize {
+		return starterMechs[:squadSize], nil
+	}
+
+	reserveMechs, err := m.getMechaGameReserveSquadMechRecs(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	needed := squadSize - len(starterMechs)
+	if len(reserveMechs) < needed {
+		return nil, coreerror.NewInvalidDataError(
+			"game >%s< cannot fill a squad of >%d< mechs: starter squad has >%d< and reserve squad has >%d<",
+			gameID, squadSize, len(starterMechs), len(reserveMechs),
+		)
+	}
+
+	return append(starterMechs, reserveMechs[:needed]...), nil
 }
 
 // MechaGameInstanceData holds all instance records created when a mecha instance starts.
@@ -86,7 +185,10 @@ type MechaGameInstanceData struct {
 // PopulateMechaGameInstanceData creates all runtime records (sector instances, squad instances,
 // mech instances) for a mecha game instance from its design definitions and player subscriptions.
 //
-// Player squads: each subscribed player gets a squad instance cloned from the starter template.
+// Player squads: each subscribed player gets a squad instance cloned from the starter template,
+// truncated or padded from the reserve squad to the instance's squad_size parameter. In a
+// campaign battle the player's surviving roster is deployed first and the starter template
+// only fills the remaining places.
 // Opponent squads: each computer opponent is randomly assigned an opponent squad template.
 func (m *Domain) PopulateMechaGameInstanceData(instanceID string) (*MechaGameInstanceData, error) {
 	l := m.Logger("PopulateMechaGameInstanceData")
@@ -151,16 +253,35 @@ func (m *Domain) PopulateMechaGameInstanceData(instanceID string) (*MechaGameIns
 	}
 	starterSquad := starterRecs[0]
 
-	starterMechs, err := m.GetManyMechaGameSquadMechRecs(&coresql.Options{
-		Params: []coresql.Param{
-			{Col: mecha_game_record.FieldMechaGameSquadMechMechaGameSquadID, Val: starterSquad.ID},
-		},
-	})
+	squadSize, err := m.GetGameInstanceIntegerParameterValue(instanceID, game_record.GameTypeMecha, MechaGameParameterSquadSize)
+	if err != nil {
+		l.Warn("failed to get squad size parameter for instance >%s< >%v<", instanceID, err)
+		return nil, err
+	}
+
+	starterMechs, err := m.resolveMechaGamePlayerSquadMechs(gameID, starterSquad.ID, squadSize)
 	if err != nil {
-		l.Warn("failed to get mechs for starter squad >%s< >%v<", starterSquad.ID, err)
+		l.Warn("failed to resolve player squad mechs for game >%s< >%v<", gameID, err)
 		return nil, err
 	}
 
+	// Campaign battles deploy each player's surviving roster before topping
+	// the squad up from the starter template
+	campaignInstanceRec, err := m.GetMechaGameCampaignInstanceRecByGameInstance(instanceID)
+	if err != nil {
+		l.Warn("failed to get campaign battle for instance >%s< >%v<", instanceID, err)
+		return nil, err
+	}
+
+	var catalog *mechaGameCatalog
+	if campaignInstanceRec != nil {
+		catalog, err = m.getMechaGameCatalog(gameID)
+		if err != nil {
+			l.Warn("failed to get catalog for game >%s< >%v<", gameID, err)
+			return nil, err
+		}
+	}
+
 	// 3. Create a squad instance for each subscribed player, cloning mechs from the starter template
 	subscriptionInstances, err := m.GetManyGameSubscriptionInstanceRecs(&coresql.Options{
 		Params: []coresql.Param{
@@ -173,6 +294,7 @@ func (m *Domain) PopulateMechaGameInstanceData(instanceID string) (*MechaGameIns
 	}
 
 	playerNumber := 0
+	usedCallsigns := make(map[string]bool)
 	for _, subInst := range subscriptionInstances {
 		subRecs, err := m.GetManyGameSubscriptionRecs(&coresql.Options{
 			Params: []coresql.Param{
@@ -192,12 +314,27 @@ func (m *Domain) PopulateMechaGameInstanceData(instanceID string) (*MechaGameIns
 
 		playerNumber++
 
-		squadInst, err := m.CreateMechaGameSquadInstanceRec(&mecha_game_record.MechaGameSquadInstance{
+		var deployment *mechaGameCampaignDeployment
+		if campaignInstanceRec != nil {
+			deployment, err = m.resolveMechaGameCampaignDeployment(campaignInstanceRec, catalog, subInst.AccountUserID, squadSize)
+			if err != nil {
+				l.Warn("failed to resolve campaign roster for subscription >%s< >%v<", subInst.ID, err)
+				return nil, err
+			}
+		}
+
+		squadInstRec := &mecha_game_record.MechaGameSquadInstance{
 			GameID:                     gameID,
 			GameInstanceID:             instanceID,
 			MechaGameSquadID:               starterSquad.ID,
 			GameSubscriptionInstanceID: sql.NullString{String: subInst.ID, Valid: true},
-		})
+		}
+		if deployment != nil {
+			squadInstRec.SupplyPoints = deployment.SupplyPoints
+			squadInstRec.Salvage = deployment.Salvage
+		}
+
+		squadInst, err := m.CreateMechaGameSquadInstanceRec(squadInstRec)
 		if err != nil {
 			l.Warn("failed to create player squad instance for subscription >%s< >%v<", subInst.ID, err)
 			return nil, err
@@ -208,7 +345,19 @@ func (m *Domain) PopulateMechaGameInstanceData(instanceID string) (*MechaGameIns
 			return nil, fmt.Errorf("no starting sector instance found for game >%s<: cannot create mech instances", gameID)
 		}
 
-		for i, squadMech := range starterMechs {
+		var mechRecs []*mecha_game_record.MechaGameMechInstance
+		if deployment != nil {
+			for _, deployed := range deployment.Mechs {
+				mechRec := newMechaGameCampaignMechInstance(deployed)
+				if usedCallsigns[mechRec.Callsign] {
+					mechRec.Callsign = nextMechaGamePlayerCallsign(usedCallsigns, playerNumber)
+				}
+				usedCallsigns[mechRec.Callsign] = true
+				mechRecs = append(mechRecs, mechRec)
+			}
+		}
+
+		for _, squadMech := range starterMechs[:squadSize-len(mechRecs)] {
 			chassisRec, err := m.GetMechaGameChassisRec(squadMech.MechaGameChassisID, nil)
 			if err != nil {
 				l.Warn("failed to get chassis >%s< for starter mech >%s< >%v<", squadMech.MechaGameChassisID, squadMech.ID, err)
@@ -221,25 +370,29 @@ func (m *Domain) PopulateMechaGameInstanceData(instanceID string) (*MechaGameIns
 				return nil, err
 			}
 
-			callsign := fmt.Sprintf("P%d-%d", playerNumber, i+1)
-			mechInst, err := m.CreateMechaGameMechInstanceRec(&mecha_game_record.MechaGameMechInstance{
-				GameID:                    gameID,
-				GameInstanceID:            instanceID,
-				MechaGameSquadInstanceID:  squadInst.ID,
-				MechaGameSectorInstanceID: startingSectorInstanceID,
-				MechaGameChassisID:        squadMech.MechaGameChassisID,
-				Callsign:                  callsign,
-				CurrentArmor:              chassisRec.ArmorPoints + loadout.ArmorBonus,
-				CurrentStructure:          chassisRec.StructurePoints,
-				CurrentHeat:               0,
-				PilotSkill:                0,
-				Status:                    mecha_game_record.MechInstanceStatusOperational,
-				WeaponConfig:              loadout.WeaponConfig,
-				WeaponConfigJSON:          loadout.WeaponConfigJSON,
-				EquipmentConfig:           loadout.EquipmentConfig,
-				EquipmentConfigJSON:       loadout.EquipmentJSON,
-				AmmoRemaining:             loadout.AmmoRemaining,
+			mechRecs = append(mechRecs, &mecha_game_record.MechaGameMechInstance{
+				MechaGameChassisID:  squadMech.MechaGameChassisID,
+				Callsign:            nextMechaGamePlayerCallsign(usedCallsigns, playerNumber),
+				CurrentArmor:        chassisRec.ArmorPoints + loadout.ArmorBonus,
+				CurrentStructure:    chassisRec.StructurePoints,
+				CurrentHeat:         0,
+				PilotSkill:          0,
+				Status:              mecha_game_record.MechInstanceStatusOperational,
+				WeaponConfig:        loadout.WeaponConfig,
+				WeaponConfigJSON:    loadout.WeaponConfigJSON,
+				EquipmentConfig:     loadout.EquipmentConfig,
+				EquipmentConfigJSON: loadout.EquipmentJSON,
+				AmmoRemaining:       loadout.AmmoRemaining,
 			})
+		}
+
+		for _, mechRec := range mechRecs {
+			mechRec.GameID = gameID
+			mechRec.GameInstanceID = instanceID
+			mechRec.MechaGameSquadInstanceID = squadInst.ID
+			mechRec.MechaGameSectorInstanceID = startingSectorInstanceID
+
+			mechInst, err := m.CreateMechaGameMechInstanceRec(mechRec)
 			if err != nil {
 				l.Warn("failed to create mech instance for player >%d< >%v<", playerNumber, err)
 				return nil, err
@@ -247,7 +400,7 @@ func (m *Domain) PopulateMechaGameInstanceData(instanceID string) (*MechaGameIns
 			out.MechInstances = append(out.MechInstances, mechInst)
 		}
 
-		l.Info("created player squad instance >%s< with >%d< mechs for subscription >%s<", squadInst.ID, len(starterMechs), subInst.ID)
+		l.Info("created player squad instance >%s< with >%d< mechs for subscription >%s<", squadInst.ID, len(mechRecs), subInst.ID)
 	}
 
 	// 4. Fetch opponent squad templates and computer opponents; randomly assign one template per opponent
@@ -358,6 +511,19 @@ func (m *Domain) PopulateMechaGameInstanceData(instanceID string) (*MechaGameIns
 func (m *Domain) deleteMechaGameInstanceData(instanceID string) error {
 	l := m.Logger("deleteMechaGameInstanceData")
 
+	// Take the instance out of any campaign it is a battle of
+	campaignInstanceRec, err := m.GetMechaGameCampaignInstanceRecByGameInstance(instanceID)
+	if err != nil {
+		l.Warn("failed to get campaign battle >%v<", err)
+		return err
+	}
+	if campaignInstanceRec != nil {
+		if err := m.MechaGameCampaignInstanceRepository().DeleteOne(campaignInstanceRec.ID); err != nil {
+			l.Warn("failed to delete campaign battle >%s< >%v<", campaignInstanceRec.ID, err)
+			return databaseError(err)
+		}
+	}
+
 	// Delete mecha_game_turn_sheet records linked to squad instances for this game instance
 	squadInstances, err := m.GetManyMechaGameSquadInstanceRecs(&coresql.Options{
 		Params: []coresql.Param{
@@ -436,6 +602,19 @@ func (m *Domain) deleteMechaGameInstanceData(instanceID string) error {
 func (m *Domain) removeMechaGameInstanceData(instanceID string) error {
 	l := m.Logger("removeMechaGameInstanceData")
 
+	// Remove the instance from any campaign it is a battle of
+	campaignInstanceRec, err := m.GetMechaGameCampaignInstanceRecByGameInstance(instanceID)
+	if err != nil {
+		l.Warn("failed to get campaign battle >%v<", err)
+		return err
+	}
+	if campaignInstanceRec != nil {
+		if err := m.RemoveMechaGameCampaignInstanceRec(campaignInstanceRec.ID); err != nil {
+			l.Warn("failed to remove campaign battle >%s< >%v<", campaignInstanceRec.ID, err)
+			return err
+		}
+	}
+
 	// Remove mecha_game_turn_sheet records linked to squad instances
 	squadInstances, err := m.GetManyMechaGameSquadInstanceRecs(&coresql.Options{
 		Params: []coresql.Param{
@@ -482,15 +661,7 @@ func (m *Domain) removeMechaGameInstanceData(instanceID string) error {
 		}
 	}
 
-	// Remove squad instances
-	for _, squadInst := range squadInstances {
-		if err := m.RemoveMechaGameSquadInstanceRec(squadInst.ID); err != nil {
-			l.Warn("failed to remove squad instance >%s< >%v<", squadInst.ID, err)
-			return err
-		}
-	}
-
-	// Remove sector instances
+	// Remove sector instances before the squad instances that may control them
 	sectorInstances, err := m.GetManyMechaGameSectorInstanceRecs(&coresql.Options{
 		Params: []coresql.Param{
 			{Col: mecha_game_record.FieldMechaGameSectorInstanceGameInstanceID, Val: instanceID},
@@ -507,5 +678,13 @@ func (m *Domain) removeMechaGameInstanceData(instanceID string) error {
 		}
 	}
 
+	// Remove squad instances
+	for _, squadInst := range squadInstances {
+		if err := m.RemoveMechaGameSquadInstanceRec(squadInst.ID); err != nil {
+			l.Warn("failed to remove squad instance >%s< >%v<", squadInst.ID, err)
+			return err
+		}
+	}
+
 	return nil
 }
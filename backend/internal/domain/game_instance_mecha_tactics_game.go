@@ -0,0 +1,313 @@
+package domain
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+// MechaTacticsGameInstanceData holds all instance records created when a mecha tactics instance starts.
+type MechaTacticsGameInstanceData struct {
+	MechInstances []*mecha_tactics_game_record.MechaTacticsGameMechInstance
+}
+
+// PopulateMechaTacticsGameInstanceData creates the runtime mech instances for a
+// mecha tactics game instance from its design definitions and player subscriptions.
+// The hex map is shared with the design and is not copied.
+//
+// Player mechs: each subscribed player is issued "mech count" mechs cloned from
+// the starter mech templates, cycling through the templates when there are fewer
+// templates than mechs.
+// Opponent mechs: opponent mech templates are assigned round-robin to the
+// computer opponents.
+//
+// Owners are spread round-robin across the starting hexes.
+func (m *Domain) PopulateMechaTacticsGameInstanceData(instanceID string) (*MechaTacticsGameInstanceData, error) {
+	l := m.Logger("PopulateMechaTacticsGameInstanceData")
+
+	l.Info("populating mecha tactics instance data for instance >%s<", instanceID)
+
+	instanceRec, err := m.GetGameInstanceRec(instanceID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	gameID := instanceRec.GameID
+	out := &MechaTacticsGameInstanceData{}
+
+	// 1. Locate starting hexes
+	startingHexRecs, err := m.GetManyMechaTacticsGameHexRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameHexGameID, Val: gameID},
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameHexIsStartingHex, Val: true},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameHexHexRow, Direction: coresql.OrderDirectionASC},
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameHexHexColumn, Direction: coresql.OrderDirectionASC},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get starting hexes >%v<", err)
+		return nil, err
+	}
+	if len(startingHexRecs) == 0 {
+		return nil, coreerror.NewInvalidDataError("game >%s< has no starting hex configured", gameID)
+	}
+
+	// 2. Locate the starter mech templates and resolve the mech count
+	starterMechRecs, err := m.GetManyMechaTacticsGameMechRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechGameID, Val: gameID},
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechMechType, Val: mecha_tactics_game_record.MechTypeStarter},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechCreatedAt, Direction: coresql.OrderDirectionASC},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get starter mechs for game >%s< >%v<", gameID, err)
+		return nil, err
+	}
+	if len(starterMechRecs) == 0 {
+		return nil, coreerror.NewInvalidDataError("game >%s< has no starter mech configured", gameID)
+	}
+
+	mechCount, err := m.GetGameInstanceIntegerParameterValue(instanceID, game_record.GameTypeMechaTactics, MechaTacticsGameParameterMechCount)
+	if err != nil {
+		l.Warn("failed to resolve mech count for instance >%s< >%v<", instanceID, err)
+		return nil, err
+	}
+	if mechCount < 1 {
+		return nil, InvalidField(MechaTacticsGameParameterMechCount, fmt.Sprintf("%d", mechCount), "mech count must be at least 1")
+	}
+
+	// 3. Issue mechs to each subscribed player
+	subscriptionInstances, err := m.GetManyGameSubscriptionInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: game_record.FieldGameSubscriptionInstanceGameInstanceID, Val: instanceID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get subscription instances >%v<", err)
+		return nil, err
+	}
+
+	ownerNumber := 0
+	playerNumber := 0
+	for _, subInst := range subscriptionInstances {
+		subRec, err := m.GetGameSubscriptionRec(subInst.GameSubscriptionID, nil)
+		if err != nil {
+			l.Warn("failed to get game subscription >%s< >%v<", subInst.GameSubscriptionID, err)
+			continue
+		}
+
+		if subRec.SubscriptionType != game_record.GameSubscriptionTypePlayer {
+			continue
+		}
+
+		playerNumber++
+		hexRec := startingHexRecs[ownerNumber%len(startingHexRecs)]
+		ownerNumber++
+
+		for i := range mechCount {
+			template := starterMechRecs[i%len(starterMechRecs)]
+
+			mechInst, err := m.createMechaTacticsGameMechInstanceFromTemplate(instanceRec, template, hexRec, &mecha_tactics_game_record.MechaTacticsGameMechInstance{
+				GameSubscriptionInstanceID: sql.NullString{String: subInst.ID, Valid: true},
+				Callsign:                   fmt.Sprintf("P%d-%s", playerNumber, template.Callsign),
+			})
+			if err != nil {
+				l.Warn("failed to create mech instance for player >%d< >%v<", playerNumber, err)
+				return nil, err
+			}
+			out.MechInstances = append(out.MechInstances, mechInst)
+		}
+
+		l.Info("created >%d< mech instances for subscription >%s<", mechCount, subInst.ID)
+	}
+
+	// 4. Assign opponent mech templates round-robin to computer opponents
+	opponentMechRecs, err := m.GetManyMechaTacticsGameMechRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechGameID, Val: gameID},
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechMechType, Val: mecha_tactics_game_record.MechTypeOpponent},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechCreatedAt, Direction: coresql.OrderDirectionASC},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get opponent mechs >%v<", err)
+		return nil, err
+	}
+
+	if len(opponentMechRecs) > 0 {
+		computerOpponents, err := m.GetManyMechaTacticsGameComputerOpponentRecs(&coresql.Options{
+			Params: []coresql.Param{
+				{Col: mecha_tactics_game_record.FieldMechaTacticsGameComputerOpponentGameID, Val: gameID},
+			},
+			OrderBy: []coresql.OrderBy{
+				{Col: mecha_tactics_game_record.FieldMechaTacticsGameComputerOpponentCreatedAt, Direction: coresql.OrderDirectionASC},
+			},
+		})
+		if err != nil {
+			l.Warn("failed to get computer opponents >%v<", err)
+			return nil, err
+		}
+
+		if len(computerOpponents) > 0 {
+			opponentHexes := make(map[string]*mecha_tactics_game_record.MechaTacticsGameHex, len(computerOpponents))
+			for _, opponent := range computerOpponents {
+				opponentHexes[opponent.ID] = startingHexRecs[ownerNumber%len(startingHexRecs)]
+				ownerNumber++
+			}
+
+			for i, template := range opponentMechRecs {
+				opponent := computerOpponents[i%len(computerOpponents)]
+
+				mechInst, err := m.createMechaTacticsGameMechInstanceFromTemplate(instanceRec, template, opponentHexes[opponent.ID], &mecha_tactics_game_record.MechaTacticsGameMechInstance{
+					MechaTacticsGameComputerOpponentID: sql.NullString{String: opponent.ID, Valid: true},
+					Callsign:                           fmt.Sprintf("AI%d-%s", i%len(computerOpponents)+1, template.Callsign),
+				})
+				if err != nil {
+					l.Warn("failed to create mech instance for computer opponent >%s< >%v<", opponent.ID, err)
+					return nil, err
+				}
+				out.MechInstances = append(out.MechInstances, mechInst)
+			}
+		}
+	}
+
+	l.Info("populated mecha tactics instance data: mechs=%d", len(out.MechInstances))
+
+	return out, nil
+}
+
+// createMechaTacticsGameMechInstanceFromTemplate clones a mech template into a
+// runtime mech instance at the given hex. The owner and callsign are taken
+// from the partially populated rec; combat stats come from the chassis.
+func (m *Domain) createMechaTacticsGameMechInstanceFromTemplate(
+	instanceRec *game_record.GameInstance,
+	template *mecha_tactics_game_record.MechaTacticsGameMech,
+	hexRec *mecha_tactics_game_record.MechaTacticsGameHex,
+	rec *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+) (*mecha_tactics_game_record.MechaTacticsGameMechInstance, error) {
+	chassisRec, err := m.GetMechaTacticsGameChassisRec(template.MechaTacticsGameChassisID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var weaponConfig []mecha_tactics_game_record.WeaponConfigEntry
+	if len(template.WeaponConfigJSON) > 0 {
+		if err := json.Unmarshal(template.WeaponConfigJSON, &weaponConfig); err != nil {
+			return nil, fmt.Errorf("unmarshal weapon_config for mech template >%s<: %w", template.ID, err)
+		}
+	}
+
+	rec.GameID = instanceRec.GameID
+	rec.GameInstanceID = instanceRec.ID
+	rec.MechaTacticsGameMechID = template.ID
+	rec.MechaTacticsGameChassisID = chassisRec.ID
+	rec.MechaTacticsGameHexID = hexRec.ID
+	rec.Facing = mecha_tactics_game_record.FacingNorth
+	rec.CurrentArmor = chassisRec.ArmorPoints
+	rec.CurrentStructure = chassisRec.StructurePoints
+	rec.Status = mecha_tactics_game_record.MechInstanceStatusOperational
+	rec.WeaponConfig = weaponConfig
+	rec.WeaponConfigJSON = template.WeaponConfigJSON
+
+	return m.CreateMechaTacticsGameMechInstanceRec(rec)
+}
+
+// deleteMechaTacticsGameInstanceData removes mecha tactics instance records (soft delete) for the given instanceID.
+func (m *Domain) deleteMechaTacticsGameInstanceData(instanceID string) error {
+	l := m.Logger("deleteMechaTacticsGameInstanceData")
+
+	mechInstances, err := m.GetManyMechaTacticsGameMechInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceGameInstanceID, Val: instanceID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get mech instances >%v<", err)
+		return databaseError(err)
+	}
+
+	// Delete mecha_tactics_game_turn_sheet records linked to mech instances for this game instance
+	for _, mechInst := range mechInstances {
+		turnSheets, err := m.GetManyMechaTacticsGameTurnSheetRecs(&coresql.Options{
+			Params: []coresql.Param{
+				{Col: mecha_tactics_game_record.FieldMechaTacticsGameTurnSheetMechaTacticsGameMechInstanceID, Val: mechInst.ID},
+			},
+		})
+		if err != nil {
+			l.Warn("failed to get turn sheets for mech instance >%s< >%v<", mechInst.ID, err)
+			return databaseError(err)
+		}
+		for _, ts := range turnSheets {
+			if err := m.MechaTacticsGameTurnSheetRepository().DeleteOne(ts.ID); err != nil {
+				l.Warn("failed to delete mecha tactics turn sheet >%s< >%v<", ts.ID, err)
+				return databaseError(err)
+			}
+		}
+	}
+
+	// Delete mech instances
+	for _, mechInst := range mechInstances {
+		if err := m.MechaTacticsGameMechInstanceRepository().DeleteOne(mechInst.ID); err != nil {
+			l.Warn("failed to delete mech instance >%s< >%v<", mechInst.ID, err)
+			return databaseError(err)
+		}
+	}
+
+	return nil
+}
+
+// removeMechaTacticsGameInstanceData permanently removes mecha tactics instance records for the given instanceID.
+func (m *Domain) removeMechaTacticsGameInstanceData(instanceID string) error {
+	l := m.Logger("removeMechaTacticsGameInstanceData")
+
+	mechInstances, err := m.GetManyMechaTacticsGameMechInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceGameInstanceID, Val: instanceID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get mech instances >%v<", err)
+		return databaseError(err)
+	}
+
+	// Remove mecha_tactics_game_turn_sheet records linked to mech instances
+	for _, mechInst := range mechInstances {
+		turnSheets, err := m.GetManyMechaTacticsGameTurnSheetRecs(&coresql.Options{
+			Params: []coresql.Param{
+				{Col: mecha_tactics_game_record.FieldMechaTacticsGameTurnSheetMechaTacticsGameMechInstanceID, Val: mechInst.ID},
+			},
+		})
+		if err != nil {
+			l.Warn("failed to get turn sheets for mech instance >%s< >%v<", mechInst.ID, err)
+			return databaseError(err)
+		}
+		for _, ts := range turnSheets {
+			if err := m.RemoveMechaTacticsGameTurnSheetRec(ts.ID); err != nil {
+				l.Warn("failed to remove mecha tactics turn sheet >%s< >%v<", ts.ID, err)
+				return err
+			}
+		}
+	}
+
+	// Remove mech instances
+	for _, mechInst := range mechInstances {
+		if err := m.RemoveMechaTacticsGameMechInstanceRec(mechInst.ID); err != nil {
+			l.Warn("failed to remove mech instance >%s< >%v<", mechInst.ID, err)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"strconv"
 
 	"github.com/jackc/pgx/v5"
 
@@ -163,3 +164,47 @@ func (m *Domain) GetGameInstanceParameterRecsByGameInstanceID(gameInstanceID str
 
 	return r.GetMany(opts)
 }
+
+// GetGameInstanceIntegerParameterValue resolves an integer parameter for a
+// game instance. An instance-level value takes precedence; otherwise the
+// default registered for the game type in gameParameters is used.
+func (m *Domain) GetGameInstanceIntegerParameterValue(gameInstanceID, gameType, parameterKey string) (int, error) {
+	l := m.Logger("GetGameInstanceIntegerParameterValue")
+
+	paramRecs, err := m.GetGameInstanceParameterRecsByGameInstanceID(gameInstanceID)
+	if err != nil {
+		return 0, databaseError(err)
+	}
+
+	for _, paramRec := range paramRecs {
+		if paramRec.ParameterKey != parameterKey || !paramRec.ParameterValue.Valid {
+			continue
+		}
+		value, err := strconv.Atoi(paramRec.ParameterValue.String)
+		if err != nil {
+			l.Warn("game instance >%s< parameter >%s< value >%s< is not an integer", gameInstanceID, parameterKey, paramRec.ParameterValue.String)
+			return 0, InvalidField(game_record.FieldGameInstanceParameterParameterValue, paramRec.ParameterValue.String, "parameter value must be an integer")
+		}
+		return value, nil
+	}
+
+	return GetGameParameterIntegerDefault(gameType, parameterKey)
+}
+
+// GetGameInstanceStringParameterValue resolves a parameter for a game instance
+// as its raw string value. An instance-level value takes precedence; otherwise
+// the default registered for the game type in gameParameters is used.
+func (m *Domain) GetGameInstanceStringParameterValue(gameInstanceID, gameType, parameterKey string) (string, error) {
+	paramRecs, err := m.GetGameInstanceParameterRecsByGameInstanceID(gameInstanceID)
+	if err != nil {
+		return "", databaseError(err)
+	}
+
+	for _, paramRec := range paramRecs {
+		if paramRec.ParameterKey == parameterKey && paramRec.ParameterValue.Valid {
+			return paramRec.ParameterValue.String, nil
+		}
+	}
+
+	return GetGameParameterStringDefault(gameType, parameterKey)
+}
@@ -99,26 +99,59 @@ func (m *Domain) ValidateGameInstanceParameter(rec *game_record.GameInstancePara
 
 	// Validate that the parameter key is valid for this game type
 	gameParameters := GetGameParametersByGameType(game.GameType)
-	validParameter := false
-	var expectedValueType string
+	var gameParameter *game_record.GameParameter
 
 	for _, gp := range gameParameters {
 		if gp.ConfigKey == rec.ParameterKey {
-			validParameter = true
-			expectedValueType = gp.ValueType
+			gameParameter = gp
 			break
 		}
 	}
 
-	if !validParameter {
+	if gameParameter == nil {
 		return InvalidField(game_record.FieldGameInstanceParameterParameterKey, rec.ParameterKey, "parameter key is not valid for game type")
 	}
 
 	// Validate that the parameter value matches the expected type
-	if err := validateParameterValue(rec.ParameterValue.String, expectedValueType); err != nil {
+	if err := validateParameterValue(rec.ParameterValue.String, gameParameter.ValueType); err != nil {
 		return InvalidField(game_record.FieldGameInstanceParameterParameterValue, rec.ParameterValue.String, err.Error())
 	}
 
+	// Validate that the parameter value is within the allowed range
+	if err := validateParameterRange(rec.ParameterValue.String, gameParameter); err != nil {
+		return InvalidField(game_record.FieldGameInstanceParameterParameterValue, rec.ParameterValue.String, err.Error())
+	}
+
+	// Validate parameters that have a structured value
+	if game.GameType == game_record.GameTypeMecha && rec.ParameterKey == MechaGameParameterPilotSkillThresholds {
+		if _, err := parseMechaGamePilotSkillThresholds(rec.ParameterValue.String); err != nil {
+			return InvalidField(game_record.FieldGameInstanceParameterParameterValue, rec.ParameterValue.String, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// validateParameterRange validates that an integer parameter value is within
+// the minimum and maximum registered for the parameter
+func validateParameterRange(value string, gameParameter *game_record.GameParameter) error {
+	if gameParameter.ValueType != GameParameterValueTypeInteger {
+		return nil
+	}
+
+	i, err := parseInt(value)
+	if err != nil {
+		return fmt.Errorf("value '%s' is not a valid integer", value)
+	}
+
+	if gameParameter.MinValue != nil && i < int64(*gameParameter.MinValue) {
+		return fmt.Errorf("value '%s' is less than the minimum of %d", value, *gameParameter.MinValue)
+	}
+
+	if gameParameter.MaxValue != nil && i > int64(*gameParameter.MaxValue) {
+		return fmt.Errorf("value '%s' is greater than the maximum of %d", value, *gameParameter.MaxValue)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+// GetManyGameInstanceResultRecs -
+func (m *Domain) GetManyGameInstanceResultRecs(opts *coresql.Options) ([]*game_record.GameInstanceResult, error) {
+	l := m.Logger("GetManyGameInstanceResultRecs")
+
+	l.Debug("getting many game_instance_result records opts >%#v<", opts)
+
+	r := m.GameInstanceResultRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+// GetGameInstanceResultRec -
+func (m *Domain) GetGameInstanceResultRec(recID string, lock *coresql.Lock) (*game_record.GameInstanceResult, error) {
+	l := m.Logger("GetGameInstanceResultRec")
+
+	l.Debug("getting game_instance_result record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.GameInstanceResultRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(game_record.TableGameInstanceResult, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// CreateGameInstanceResultRec -
+func (m *Domain) CreateGameInstanceResultRec(rec *game_record.GameInstanceResult) (*game_record.GameInstanceResult, error) {
+	l := m.Logger("CreateGameInstanceResultRec")
+
+	l.Debug("creating game_instance_result record >%#v<", rec)
+
+	if err := m.validateGameInstanceResultRecForCreate(rec); err != nil {
+		l.Warn("failed to validate game_instance_result record >%v<", err)
+		return rec, err
+	}
+
+	r := m.GameInstanceResultRepository()
+
+	rec, err := r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// UpdateGameInstanceResultRec -
+func (m *Domain) UpdateGameInstanceResultRec(rec *game_record.GameInstanceResult) (*game_record.GameInstanceResult, error) {
+	l := m.Logger("UpdateGameInstanceResultRec")
+
+	currRec, err := m.GetGameInstanceResultRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating game_instance_result record >%#v<", rec)
+
+	if err := m.validateGameInstanceResultRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate game_instance_result record >%v<", err)
+		return rec, err
+	}
+
+	r := m.GameInstanceResultRepository()
+
+	rec, err = r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// DeleteGameInstanceResultRec -
+func (m *Domain) DeleteGameInstanceResultRec(recID string) error {
+	l := m.Logger("DeleteGameInstanceResultRec")
+
+	l.Debug("deleting game_instance_result record ID >%s<", recID)
+
+	_, err := m.GetGameInstanceResultRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.GameInstanceResultRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// RemoveGameInstanceResultRec -
+func (m *Domain) RemoveGameInstanceResultRec(recID string) error {
+	l := m.Logger("RemoveGameInstanceResultRec")
+
+	l.Debug("removing game_instance_result record ID >%s<", recID)
+
+	r := m.GameInstanceResultRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// GetGameInstanceResultRecsByGameInstance returns the results recorded for a
+// completed game instance, best placing first.
+func (m *Domain) GetGameInstanceResultRecsByGameInstance(gameInstanceID string) ([]*game_record.GameInstanceResult, error) {
+	return m.GetManyGameInstanceResultRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: game_record.FieldGameInstanceResultGameInstanceID, Val: gameInstanceID},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: game_record.FieldGameInstanceResultPlacing, Direction: coresql.OrderDirectionASC},
+		},
+	})
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+type validateGameInstanceResultArgs struct {
+	nextRec *game_record.GameInstanceResult
+	currRec *game_record.GameInstanceResult
+}
+
+func (m *Domain) populateGameInstanceResultValidateArgs(currRec, nextRec *game_record.GameInstanceResult) (*validateGameInstanceResultArgs, error) {
+	args := &validateGameInstanceResultArgs{
+		currRec: currRec,
+		nextRec: nextRec,
+	}
+	return args, nil
+}
+
+func (m *Domain) validateGameInstanceResultRecForCreate(rec *game_record.GameInstanceResult) error {
+	args, err := m.populateGameInstanceResultValidateArgs(nil, rec)
+	if err != nil {
+		return err
+	}
+	return validateGameInstanceResultRecForCreate(args)
+}
+
+func (m *Domain) validateGameInstanceResultRecForUpdate(currRec, nextRec *game_record.GameInstanceResult) error {
+	args, err := m.populateGameInstanceResultValidateArgs(currRec, nextRec)
+	if err != nil {
+		return err
+	}
+	return validateGameInstanceResultRecForUpdate(args)
+}
+
+func validateGameInstanceResultRecForCreate(args *validateGameInstanceResultArgs) error {
+	return validateGameInstanceResultRec(args, false)
+}
+
+func validateGameInstanceResultRecForUpdate(args *validateGameInstanceResultArgs) error {
+	if err := validateGameInstanceResultRec(args, true); err != nil {
+		return err
+	}
+
+	currRec := args.currRec
+	nextRec := args.nextRec
+
+	if nextRec.GameInstanceID != currRec.GameInstanceID {
+		return InvalidField(game_record.FieldGameInstanceResultGameInstanceID, nextRec.GameInstanceID, "game_instance_id cannot be changed")
+	}
+
+	if nextRec.GameSubscriptionInstanceID != currRec.GameSubscriptionInstanceID {
+		return InvalidField(game_record.FieldGameInstanceResultGameSubscriptionInstanceID, nextRec.GameSubscriptionInstanceID, "game_subscription_instance_id cannot be changed")
+	}
+
+	return nil
+}
+
+func validateGameInstanceResultRec(args *validateGameInstanceResultArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(game_record.FieldGameInstanceResultID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(game_record.FieldGameInstanceResultGameID, rec.GameID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(game_record.FieldGameInstanceResultGameInstanceID, rec.GameInstanceID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(game_record.FieldGameInstanceResultGameSubscriptionInstanceID, rec.GameSubscriptionInstanceID); err != nil {
+		return err
+	}
+
+	if rec.Placing < 1 {
+		return InvalidField(
+			game_record.FieldGameInstanceResultPlacing,
+			fmt.Sprintf("%d", rec.Placing),
+			"placing must be 1 or greater",
+		)
+	}
+
+	if err := domain.ValidateEnumField(
+		game_record.FieldGameInstanceResultEndCondition,
+		rec.EndCondition,
+		game_record.GameEndConditions,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -95,6 +95,31 @@ func TestDomain_StartGameInstance(t *testing.T) {
 	})
 	require.NoError(t, err, "CreateGameSubscriptionInstanceRec (mecha player) returns without error")
 
+	// A second mecha instance sets squad_size to 1, so the starter squad is
+	// cloned as it is without drawing on the reserve squad.
+	mechaGameSingleMechInstanceRec, err := m.CreateGameInstanceRec(&game_record.GameInstance{
+		GameID:              mechaGameRec.ID,
+		Status:              game_record.GameInstanceStatusCreated,
+		RequiredPlayerCount: 1,
+		DeliveryEmail:       true,
+	})
+	require.NoError(t, err, "CreateGameInstanceRec (mecha single mech) returns without error")
+
+	_, err = m.CreateGameInstanceParameterRec(&game_record.GameInstanceParameter{
+		GameInstanceID: mechaGameSingleMechInstanceRec.ID,
+		ParameterKey:   domain.MechaGameParameterSquadSize,
+		ParameterValue: nullstring.FromString("1"),
+	})
+	require.NoError(t, err, "CreateGameInstanceParameterRec (mecha squad_size) returns without error")
+
+	_, err = m.CreateGameSubscriptionInstanceRec(&game_record.GameSubscriptionInstance{
+		AccountID:          accountUserStdRec.AccountID,
+		AccountUserID:      accountUserStdRec.ID,
+		GameSubscriptionID: mechaGamePlayerSubRec.ID,
+		GameInstanceID:     mechaGameSingleMechInstanceRec.ID,
+	})
+	require.NoError(t, err, "CreateGameSubscriptionInstanceRec (mecha single mech player) returns without error")
+
 	testCases := []struct {
 		name        string
 		instanceID  string
@@ -131,7 +156,17 @@ func TestDomain_StartGameInstance(t *testing.T) {
 			name:         "mecha game starts and returns mecha instance data",
 			instanceID:   mechaGameInstanceRec.ID,
 			expectStatus: game_record.GameInstanceStatusStarted,
-			// GameMechaGameRef: 2 sectors; AccountUserStandardRef has MechaGameSquadOneRef with 1 mech.
+			// GameMechaGameRef: 2 sectors; the 1 mech starter squad is padded from the
+			// 3 mech reserve squad (MechaGameSquadReserveRef) up to the default squad_size of 4.
+			expectMechaGameSectorCount: 2,
+			expectMechaGameSquadCount:  1,
+			expectMechaGameMechCount:   4,
+		},
+		{
+			name:         "mecha game with squad_size 1 starts with only the starter squad mech",
+			instanceID:   mechaGameSingleMechInstanceRec.ID,
+			expectStatus: game_record.GameInstanceStatusStarted,
+			// The 1 mech starter squad fills the squad on its own; no reserve mechs are drawn.
 			expectMechaGameSectorCount: 2,
 			expectMechaGameSquadCount:  1,
 			expectMechaGameMechCount:   1,
@@ -192,9 +192,51 @@ func validateGameInstanceRec(args *validateGameInstanceArgs, requireID bool) err
 		)
 	}
 
+	if rec.MaxTurns.Valid && rec.MaxTurns.Int64 < 1 {
+		return InvalidField(
+			game_record.FieldGameInstanceMaxTurns,
+			fmt.Sprintf("%d", rec.MaxTurns.Int64),
+			"max_turns must be 1 or greater",
+		)
+	}
+
+	if err := validateGameInstanceMissedTurnPolicy(rec.MissedTurnPolicy); err != nil {
+		return err
+	}
+
+	if rec.MissedTurnTakeoverAfter.Valid && rec.MissedTurnTakeoverAfter.Int64 < 1 {
+		return InvalidField(
+			game_record.FieldGameInstanceMissedTurnTakeoverAfter,
+			fmt.Sprintf("%d", rec.MissedTurnTakeoverAfter.Int64),
+			"missed_turn_takeover_after must be 1 or greater",
+		)
+	}
+
+	if rec.MissedTurnDropAfter.Valid && rec.MissedTurnDropAfter.Int64 < 1 {
+		return InvalidField(
+			game_record.FieldGameInstanceMissedTurnDropAfter,
+			fmt.Sprintf("%d", rec.MissedTurnDropAfter.Int64),
+			"missed_turn_drop_after must be 1 or greater",
+		)
+	}
+
 	return nil
 }
 
+func validateGameInstanceMissedTurnPolicy(policy string) error {
+	switch policy {
+	case game_record.GameInstanceMissedTurnPolicyIdle,
+		game_record.GameInstanceMissedTurnPolicyStandingOrders:
+		return nil
+	default:
+		return InvalidField(
+			game_record.FieldGameInstanceMissedTurnPolicy,
+			policy,
+			"missed_turn_policy must be one of 'idle' or 'standing_orders'",
+		)
+	}
+}
+
 func validateGameInstanceStatus(status string) error {
 	switch status {
 	case game_record.GameInstanceStatusCreated,
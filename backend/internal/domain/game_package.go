@@ -0,0 +1,1226 @@
+package domain
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"net/http"
+
+	_ "golang.org/x/image/webp"
+
+	"gitlab.com/alienspaces/playbymail/core/convert"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/nullint32"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/record"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/gamepackage"
+	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+// gameDefinitionOpts returns options selecting all of a game's records of a
+// game definition table in creation order, so exports are deterministic
+func gameDefinitionOpts(gameIDCol, gameID string) *coresql.Options {
+	return &coresql.Options{
+		Params: []coresql.Param{
+			{Col: gameIDCol, Val: gameID},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: record.FieldCreatedAt, Direction: coresql.OrderDirectionASC},
+			{Col: record.FieldID, Direction: coresql.OrderDirectionASC},
+		},
+	}
+}
+
+// gamePackageExportRefs assigns package refs to exported record IDs
+type gamePackageExportRefs struct {
+	refs   map[string]string
+	counts map[string]int
+}
+
+func newGamePackageExportRefs(gameID string) *gamePackageExportRefs {
+	return &gamePackageExportRefs{
+		refs:   map[string]string{gameID: gamepackage.GameRef},
+		counts: map[string]int{},
+	}
+}
+
+// add assigns the next ref with the given prefix to a record ID
+func (e *gamePackageExportRefs) add(prefix, recID string) string {
+	e.counts[prefix]++
+	ref := fmt.Sprintf("%s-%d", prefix, e.counts[prefix])
+	e.refs[recID] = ref
+	return ref
+}
+
+// ref returns the ref assigned to a record ID, or an empty string when the
+// record has not been exported
+func (e *gamePackageExportRefs) ref(recID string) string {
+	return e.refs[recID]
+}
+
+func (e *gamePackageExportRefs) nullRef(recID sql.NullString) string {
+	if !recID.Valid {
+		return ""
+	}
+	return e.refs[recID.String]
+}
+
+// gamePackageImportIDs maps package refs to the IDs of imported records
+type gamePackageImportIDs map[string]string
+
+func (i gamePackageImportIDs) nullID(ref string) sql.NullString {
+	if ref == "" {
+		return sql.NullString{}
+	}
+	return nullstring.FromString(i[ref])
+}
+
+// ExportGamePackage returns a portable package of a game's complete definition,
+// including translations and images. Game instances and subscriptions are not
+// part of a game definition and are not exported.
+func (m *Domain) ExportGamePackage(gameID string) (*gamepackage.Package, error) {
+	l := m.Logger("ExportGamePackage")
+
+	l.Info("exporting game package for game >%s<", gameID)
+
+	gameRec, err := m.GetGameRec(gameID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &gamepackage.Package{
+		FormatVersion: gamepackage.FormatVersion,
+		Game: gamepackage.Game{
+			Name:              gameRec.Name,
+			Description:       gameRec.Description,
+			GameType:          gameRec.GameType,
+			TurnDurationHours: gameRec.TurnDurationHours,
+		},
+	}
+
+	e := newGamePackageExportRefs(gameRec.ID)
+
+	switch gameRec.GameType {
+	case game_record.GameTypeAdventure:
+		pkg.Adventure, err = m.exportAdventureGamePackage(e, gameRec.ID)
+	case game_record.GameTypeMecha:
+		pkg.Mecha, err = m.exportMechaGamePackage(e, gameRec.ID)
+	case game_record.GameTypeMechaTactics:
+		pkg.MechaTactics, err = m.exportMechaTacticsGamePackage(e, gameRec.ID)
+	default:
+		return nil, coreerror.NewInvalidDataError("game type >%s< cannot be exported", gameRec.GameType)
+	}
+	if err != nil {
+		l.Warn("failed exporting game >%s< definition >%v<", gameRec.ID, err)
+		return nil, err
+	}
+
+	translationRecs, err := m.GetManyGameTranslationRecs(gameDefinitionOpts(game_record.FieldGameTranslationGameID, gameRec.ID))
+	if err != nil {
+		l.Warn("failed getting game translation records >%v<", err)
+		return nil, err
+	}
+	for _, rec := range translationRecs {
+		ref := e.ref(rec.RecordID)
+		if ref == "" {
+			l.Warn("skipping translation >%s< of record >%s< that is not part of the game definition", rec.ID, rec.RecordID)
+			continue
+		}
+		pkg.Translations = append(pkg.Translations, gamepackage.Translation{
+			RecordRef: ref,
+			Locale:    rec.Locale,
+			FieldName: rec.FieldName,
+			Text:      rec.Text,
+		})
+	}
+
+	imageRecs, err := m.GetManyGameImageRecs(gameDefinitionOpts(game_record.FieldGameImageGameID, gameRec.ID))
+	if err != nil {
+		l.Warn("failed getting game image records >%v<", err)
+		return nil, err
+	}
+	for _, rec := range imageRecs {
+		ref := e.nullRef(rec.RecordID)
+		if rec.RecordID.Valid && ref == "" {
+			l.Warn("skipping image >%s< of record >%s< that is not part of the game definition", rec.ID, rec.RecordID.String)
+			continue
+		}
+		pkg.Images = append(pkg.Images, gamepackage.Image{
+			RecordRef:     ref,
+			Type:          rec.Type,
+			TurnSheetType: rec.TurnSheetType,
+			File:          gamepackage.ImageFile(fmt.Sprintf("image-%d", len(pkg.Images)+1), rec.MimeType),
+			Data:          rec.ImageData,
+		})
+	}
+
+	if err := gamepackage.Validate(pkg); err != nil {
+		l.Warn("exported game package is invalid >%v<", err)
+		return nil, coreerror.NewInternalError("exported game package is invalid: %v", err)
+	}
+
+	return pkg, nil
+}
+
+func (m *Domain) exportAdventureGamePackage(e *gamePackageExportRefs, gameID string) (*gamepackage.Adventure, error) {
+	out := &gamepackage.Adventure{}
+
+	locationRecs, err := m.GetManyAdventureGameLocationRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameLocationGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range locationRecs {
+		out.Locations = append(out.Locations, gamepackage.AdventureLocation{
+			Ref:                e.add("location", rec.ID),
+			Name:               rec.Name,
+			Description:        rec.Description,
+			IsStartingLocation: rec.IsStartingLocation,
+			IsGoalLocation:     rec.IsGoalLocation,
+		})
+	}
+
+	itemRecs, err := m.GetManyAdventureGameItemRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameItemGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range itemRecs {
+		out.Items = append(out.Items, gamepackage.AdventureItem{
+			Ref:            e.add("item", rec.ID),
+			Name:           rec.Name,
+			Description:    rec.Description,
+			CanBeEquipped:  rec.CanBeEquipped,
+			ItemCategory:   rec.ItemCategory,
+			EquipmentSlot:  rec.EquipmentSlot,
+			IsStartingItem: rec.IsStartingItem,
+			IsGoalItem:     rec.IsGoalItem,
+		})
+	}
+
+	creatureRecs, err := m.GetManyAdventureGameCreatureRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameCreatureGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range creatureRecs {
+		out.Creatures = append(out.Creatures, gamepackage.AdventureCreature{
+			Ref:               e.add("creature", rec.ID),
+			Name:              rec.Name,
+			Description:       rec.Description,
+			AttackDamage:      rec.AttackDamage,
+			Defense:           rec.Defense,
+			Disposition:       rec.Disposition,
+			MaxHealth:         rec.MaxHealth,
+			AttackMethod:      rec.AttackMethod,
+			AttackDescription: rec.AttackDescription,
+			BodyDecayTurns:    rec.BodyDecayTurns,
+			RespawnTurns:      rec.RespawnTurns,
+		})
+	}
+
+	linkRecs, err := m.GetManyAdventureGameLocationLinkRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameLocationLinkGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range linkRecs {
+		out.LocationLinks = append(out.LocationLinks, gamepackage.AdventureLocationLink{
+			Ref:                  e.add("location-link", rec.ID),
+			FromLocationRef:      e.ref(rec.FromAdventureGameLocationID),
+			ToLocationRef:        e.ref(rec.ToAdventureGameLocationID),
+			Name:                 rec.Name,
+			Description:          rec.Description,
+			LockedDescription:    nullstring.ToStringPtr(rec.LockedDescription),
+			TraversalDescription: nullstring.ToStringPtr(rec.TraversalDescription),
+		})
+	}
+
+	requirementRecs, err := m.GetManyAdventureGameLocationLinkRequirementRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameLocationLinkRequirementGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range requirementRecs {
+		out.LocationLinkRequirements = append(out.LocationLinkRequirements, gamepackage.AdventureLocationLinkRequirement{
+			Ref:             e.add("location-link-requirement", rec.ID),
+			LocationLinkRef: e.ref(rec.AdventureGameLocationLinkID),
+			ItemRef:         e.nullRef(rec.AdventureGameItemID),
+			CreatureRef:     e.nullRef(rec.AdventureGameCreatureID),
+			Purpose:         rec.Purpose,
+			Condition:       rec.Condition,
+			Quantity:        rec.Quantity,
+		})
+	}
+
+	objectRecs, err := m.GetManyAdventureGameLocationObjectRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameLocationObjectGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range objectRecs {
+		out.LocationObjects = append(out.LocationObjects, gamepackage.AdventureLocationObject{
+			Ref:         e.add("location-object", rec.ID),
+			LocationRef: e.ref(rec.AdventureGameLocationID),
+			Name:        rec.Name,
+			Description: rec.Description,
+			IsHidden:    rec.IsHidden,
+		})
+	}
+
+	stateRecs, err := m.GetManyAdventureGameLocationObjectStateRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameLocationObjectStateGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range stateRecs {
+		out.LocationObjectStates = append(out.LocationObjectStates, gamepackage.AdventureLocationObjectState{
+			Ref:               e.add("location-object-state", rec.ID),
+			LocationObjectRef: e.ref(rec.AdventureGameLocationObjectID),
+			Name:              rec.Name,
+			Description:       rec.Description,
+			SortOrder:         rec.SortOrder,
+		})
+	}
+
+	// Initial states reference states exported after their objects
+	for idx, rec := range objectRecs {
+		out.LocationObjects[idx].InitialStateRef = e.nullRef(rec.InitialAdventureGameLocationObjectStateID)
+	}
+
+	objectEffectRecs, err := m.GetManyAdventureGameLocationObjectEffectRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameLocationObjectEffectGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range objectEffectRecs {
+		out.LocationObjectEffects = append(out.LocationObjectEffects, gamepackage.AdventureLocationObjectEffect{
+			Ref:                     e.add("location-object-effect", rec.ID),
+			LocationObjectRef:       e.ref(rec.AdventureGameLocationObjectID),
+			ActionType:              rec.ActionType,
+			RequiredStateRef:        e.nullRef(rec.RequiredAdventureGameLocationObjectStateID),
+			RequiredItemRef:         e.nullRef(rec.RequiredAdventureGameItemID),
+			ResultDescription:       rec.ResultDescription,
+			EffectType:              rec.EffectType,
+			ResultStateRef:          e.nullRef(rec.ResultAdventureGameLocationObjectStateID),
+			ResultItemRef:           e.nullRef(rec.ResultAdventureGameItemID),
+			ResultLocationLinkRef:   e.nullRef(rec.ResultAdventureGameLocationLinkID),
+			ResultCreatureRef:       e.nullRef(rec.ResultAdventureGameCreatureID),
+			ResultLocationObjectRef: e.nullRef(rec.ResultAdventureGameLocationObjectID),
+			ResultLocationRef:       e.nullRef(rec.ResultAdventureGameLocationID),
+			ResultValueMin:          nullint32.ToInt32PtrOrNil(rec.ResultValueMin),
+			ResultValueMax:          nullint32.ToInt32PtrOrNil(rec.ResultValueMax),
+			IsRepeatable:            rec.IsRepeatable,
+		})
+	}
+
+	itemEffectRecs, err := m.GetManyAdventureGameItemEffectRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameItemEffectGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range itemEffectRecs {
+		out.ItemEffects = append(out.ItemEffects, gamepackage.AdventureItemEffect{
+			Ref:                   e.add("item-effect", rec.ID),
+			ItemRef:               e.ref(rec.AdventureGameItemID),
+			ActionType:            rec.ActionType,
+			RequiredItemRef:       e.nullRef(rec.RequiredAdventureGameItemID),
+			RequiredLocationRef:   e.nullRef(rec.RequiredAdventureGameLocationID),
+			ResultDescription:     rec.ResultDescription,
+			EffectType:            rec.EffectType,
+			ResultItemRef:         e.nullRef(rec.ResultAdventureGameItemID),
+			ResultLocationLinkRef: e.nullRef(rec.ResultAdventureGameLocationLinkID),
+			ResultCreatureRef:     e.nullRef(rec.ResultAdventureGameCreatureID),
+			ResultLocationRef:     e.nullRef(rec.ResultAdventureGameLocationID),
+			ResultValueMin:        nullint32.ToInt32PtrOrNil(rec.ResultValueMin),
+			ResultValueMax:        nullint32.ToInt32PtrOrNil(rec.ResultValueMax),
+			IsRepeatable:          rec.IsRepeatable,
+		})
+	}
+
+	itemPlacementRecs, err := m.GetManyAdventureGameItemPlacementRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameItemPlacementGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range itemPlacementRecs {
+		out.ItemPlacements = append(out.ItemPlacements, gamepackage.AdventurePlacement{
+			Ref:          e.add("item-placement", rec.ID),
+			EntityRef:    e.ref(rec.AdventureGameItemID),
+			LocationRef:  e.ref(rec.AdventureGameLocationID),
+			InitialCount: rec.InitialCount,
+		})
+	}
+
+	creaturePlacementRecs, err := m.GetManyAdventureGameCreaturePlacementRecs(gameDefinitionOpts(adventure_game_record.FieldAdventureGameCreaturePlacementGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range creaturePlacementRecs {
+		out.CreaturePlacements = append(out.CreaturePlacements, gamepackage.AdventurePlacement{
+			Ref:          e.add("creature-placement", rec.ID),
+			EntityRef:    e.ref(rec.AdventureGameCreatureID),
+			LocationRef:  e.ref(rec.AdventureGameLocationID),
+			InitialCount: rec.InitialCount,
+		})
+	}
+
+	return out, nil
+}
+
+func (m *Domain) exportMechaGamePackage(e *gamePackageExportRefs, gameID string) (*gamepackage.Mecha, error) {
+	out := &gamepackage.Mecha{}
+
+	chassisRecs, err := m.GetManyMechaGameChassisRecs(gameDefinitionOpts(mecha_game_record.FieldMechaGameChassisGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range chassisRecs {
+		out.Chassis = append(out.Chassis, gamepackage.MechaChassis{
+			Ref:             e.add("chassis", rec.ID),
+			Name:            rec.Name,
+			Description:     rec.Description,
+			ChassisClass:    rec.ChassisClass,
+			ArmorPoints:     rec.ArmorPoints,
+			StructurePoints: rec.StructurePoints,
+			HeatCapacity:    rec.HeatCapacity,
+			Speed:           rec.Speed,
+			SmallSlots:      rec.SmallSlots,
+			MediumSlots:     rec.MediumSlots,
+			LargeSlots:      rec.LargeSlots,
+			SensorRange:     convert.Ptr(rec.SensorRange),
+		})
+	}
+
+	weaponRecs, err := m.GetManyMechaGameWeaponRecs(gameDefinitionOpts(mecha_game_record.FieldMechaGameWeaponGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range weaponRecs {
+		out.Weapons = append(out.Weapons, gamepackage.MechaWeapon{
+			Ref:          e.add("weapon", rec.ID),
+			Name:         rec.Name,
+			Description:  rec.Description,
+			Damage:       rec.Damage,
+			HeatCost:     rec.HeatCost,
+			RangeBand:    rec.RangeBand,
+			MountSize:    rec.MountSize,
+			AmmoCapacity: rec.AmmoCapacity,
+		})
+	}
+
+	equipmentRecs, err := m.GetManyMechaGameEquipmentRecs(gameDefinitionOpts(mecha_game_record.FieldMechaGameEquipmentGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range equipmentRecs {
+		out.Equipment = append(out.Equipment, gamepackage.MechaEquipment{
+			Ref:         e.add("equipment", rec.ID),
+			Name:        rec.Name,
+			Description: rec.Description,
+			MountSize:   rec.MountSize,
+			EffectKind:  rec.EffectKind,
+			Magnitude:   rec.Magnitude,
+			HeatCost:    rec.HeatCost,
+		})
+	}
+
+	sectorRecs, err := m.GetManyMechaGameSectorRecs(gameDefinitionOpts(mecha_game_record.FieldMechaGameSectorGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range sectorRecs {
+		out.Sectors = append(out.Sectors, gamepackage.MechaSector{
+			Ref:               e.add("sector", rec.ID),
+			Name:              rec.Name,
+			Description:       rec.Description,
+			TerrainType:       rec.TerrainType,
+			Elevation:         rec.Elevation,
+			CoverModifier:     rec.CoverModifier,
+			IsStartingSector:  rec.IsStartingSector,
+			IsObjectiveSector: rec.IsObjectiveSector,
+			VictoryPoints:     rec.VictoryPoints,
+			CaptureTurns:      rec.CaptureTurns,
+		})
+	}
+
+	sectorLinkRecs, err := m.GetManyMechaGameSectorLinkRecs(gameDefinitionOpts(mecha_game_record.FieldMechaGameSectorLinkGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range sectorLinkRecs {
+		out.SectorLinks = append(out.SectorLinks, gamepackage.MechaSectorLink{
+			Ref:           e.add("sector-link", rec.ID),
+			FromSectorRef: e.ref(rec.FromMechaGameSectorID),
+			ToSectorRef:   e.ref(rec.ToMechaGameSectorID),
+		})
+	}
+
+	opponentRecs, err := m.GetManyMechaGameComputerOpponentRecs(gameDefinitionOpts(mecha_game_record.FieldMechaGameComputerOpponentGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range opponentRecs {
+		out.ComputerOpponents = append(out.ComputerOpponents, gamepackage.ComputerOpponent{
+			Ref:         e.add("computer-opponent", rec.ID),
+			Name:        rec.Name,
+			Description: rec.Description,
+			Aggression:  rec.Aggression,
+			IQ:          rec.IQ,
+		})
+	}
+
+	squadRecs, err := m.GetManyMechaGameSquadRecs(gameDefinitionOpts(mecha_game_record.FieldMechaGameSquadGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range squadRecs {
+		out.Squads = append(out.Squads, gamepackage.MechaSquad{
+			Ref:         e.add("squad", rec.ID),
+			SquadType:   rec.SquadType,
+			Name:        rec.Name,
+			Description: rec.Description,
+		})
+	}
+
+	squadMechRecs, err := m.GetManyMechaGameSquadMechRecs(gameDefinitionOpts(mecha_game_record.FieldMechaGameSquadMechGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range squadMechRecs {
+		mech := gamepackage.MechaSquadMech{
+			Ref:        e.add("squad-mech", rec.ID),
+			SquadRef:   e.ref(rec.MechaGameSquadID),
+			ChassisRef: e.ref(rec.MechaGameChassisID),
+			Callsign:   rec.Callsign,
+		}
+
+		var weaponConfig []mecha_game_record.WeaponConfigEntry
+		if len(rec.WeaponConfigJSON) > 0 {
+			if err := json.Unmarshal(rec.WeaponConfigJSON, &weaponConfig); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal squad mech >%s< weapon config: %w", rec.ID, err)
+			}
+		}
+		for _, w := range weaponConfig {
+			mech.Weapons = append(mech.Weapons, gamepackage.WeaponMount{
+				WeaponRef:    e.ref(w.WeaponID),
+				SlotLocation: w.SlotLocation,
+			})
+		}
+
+		var equipmentConfig []mecha_game_record.EquipmentConfigEntry
+		if len(rec.EquipmentConfigJSON) > 0 {
+			if err := json.Unmarshal(rec.EquipmentConfigJSON, &equipmentConfig); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal squad mech >%s< equipment config: %w", rec.ID, err)
+			}
+		}
+		for _, eq := range equipmentConfig {
+			mech.Equipment = append(mech.Equipment, gamepackage.EquipmentMount{
+				EquipmentRef: e.ref(eq.EquipmentID),
+				SlotLocation: eq.SlotLocation,
+			})
+		}
+
+		out.SquadMechs = append(out.SquadMechs, mech)
+	}
+
+	return out, nil
+}
+
+func (m *Domain) exportMechaTacticsGamePackage(e *gamePackageExportRefs, gameID string) (*gamepackage.MechaTactics, error) {
+	out := &gamepackage.MechaTactics{}
+
+	chassisRecs, err := m.GetManyMechaTacticsGameChassisRecs(gameDefinitionOpts(mecha_tactics_game_record.FieldMechaTacticsGameChassisGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range chassisRecs {
+		out.Chassis = append(out.Chassis, gamepackage.MechaTacticsChassis{
+			Ref:             e.add("chassis", rec.ID),
+			Name:            rec.Name,
+			Description:     rec.Description,
+			ChassisClass:    rec.ChassisClass,
+			ArmorPoints:     rec.ArmorPoints,
+			StructurePoints: rec.StructurePoints,
+			HeatCapacity:    rec.HeatCapacity,
+			Speed:           rec.Speed,
+		})
+	}
+
+	weaponRecs, err := m.GetManyMechaTacticsGameWeaponRecs(gameDefinitionOpts(mecha_tactics_game_record.FieldMechaTacticsGameWeaponGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range weaponRecs {
+		out.Weapons = append(out.Weapons, gamepackage.MechaTacticsWeapon{
+			Ref:         e.add("weapon", rec.ID),
+			Name:        rec.Name,
+			Description: rec.Description,
+			Damage:      rec.Damage,
+			HeatCost:    rec.HeatCost,
+			RangeBand:   rec.RangeBand,
+			MountSize:   rec.MountSize,
+		})
+	}
+
+	terrainTypeRecs, err := m.GetManyMechaTacticsGameTerrainTypeRecs(gameDefinitionOpts(mecha_tactics_game_record.FieldMechaTacticsGameTerrainTypeGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range terrainTypeRecs {
+		out.TerrainTypes = append(out.TerrainTypes, gamepackage.MechaTacticsTerrainType{
+			Ref:               e.add("terrain-type", rec.ID),
+			Name:              rec.Name,
+			Description:       rec.Description,
+			MovementPointCost: rec.MovementPointCost,
+			CoverModifier:     rec.CoverModifier,
+		})
+	}
+
+	hexRecs, err := m.GetManyMechaTacticsGameHexRecs(gameDefinitionOpts(mecha_tactics_game_record.FieldMechaTacticsGameHexGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range hexRecs {
+		out.Hexes = append(out.Hexes, gamepackage.MechaTacticsHex{
+			Ref:            e.add("hex", rec.ID),
+			TerrainTypeRef: e.ref(rec.MechaTacticsGameTerrainTypeID),
+			HexColumn:      rec.HexColumn,
+			HexRow:         rec.HexRow,
+			Name:           rec.Name,
+			Description:    rec.Description,
+			Elevation:      rec.Elevation,
+			IsStartingHex:  rec.IsStartingHex,
+		})
+	}
+
+	mechRecs, err := m.GetManyMechaTacticsGameMechRecs(gameDefinitionOpts(mecha_tactics_game_record.FieldMechaTacticsGameMechGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range mechRecs {
+		mech := gamepackage.MechaTacticsMech{
+			Ref:        e.add("mech", rec.ID),
+			ChassisRef: e.ref(rec.MechaTacticsGameChassisID),
+			MechType:   rec.MechType,
+			Callsign:   rec.Callsign,
+		}
+
+		var weaponConfig []mecha_tactics_game_record.WeaponConfigEntry
+		if len(rec.WeaponConfigJSON) > 0 {
+			if err := json.Unmarshal(rec.WeaponConfigJSON, &weaponConfig); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal mech >%s< weapon config: %w", rec.ID, err)
+			}
+		}
+		for _, w := range weaponConfig {
+			mech.Weapons = append(mech.Weapons, gamepackage.WeaponMount{
+				WeaponRef:    e.ref(w.WeaponID),
+				SlotLocation: w.SlotLocation,
+			})
+		}
+
+		out.Mechs = append(out.Mechs, mech)
+	}
+
+	opponentRecs, err := m.GetManyMechaTacticsGameComputerOpponentRecs(gameDefinitionOpts(mecha_tactics_game_record.FieldMechaTacticsGameComputerOpponentGameID, gameID))
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range opponentRecs {
+		out.ComputerOpponents = append(out.ComputerOpponents, gamepackage.ComputerOpponent{
+			Ref:         e.add("computer-opponent", rec.ID),
+			Name:        rec.Name,
+			Description: rec.Description,
+			Aggression:  rec.Aggression,
+			IQ:          rec.IQ,
+		})
+	}
+
+	return out, nil
+}
+
+// ImportGamePackage creates a new draft game from a game package. Every record
+// is created through the same validation as the game's design endpoints, so an
+// import either creates the complete game or, with the caller's transaction
+// rolled back, nothing at all.
+func (m *Domain) ImportGamePackage(pkg *gamepackage.Package) (*game_record.Game, error) {
+	l := m.Logger("ImportGamePackage")
+
+	if err := gamepackage.Validate(pkg); err != nil {
+		l.Warn("game package is invalid >%v<", err)
+		return nil, coreerror.NewInvalidDataError("game package is invalid: %v", err)
+	}
+
+	l.Info("importing game package for game >%s< type >%s<", pkg.Game.Name, pkg.Game.GameType)
+
+	gameRec, err := m.CreateGameRec(&game_record.Game{
+		Name:              pkg.Game.Name,
+		Description:       pkg.Game.Description,
+		GameType:          pkg.Game.GameType,
+		TurnDurationHours: pkg.Game.TurnDurationHours,
+		Status:            game_record.GameStatusDraft,
+	})
+	if err != nil {
+		l.Warn("failed creating game record >%v<", err)
+		return nil, err
+	}
+
+	ids := gamePackageImportIDs{gamepackage.GameRef: gameRec.ID}
+
+	switch {
+	case pkg.Adventure != nil:
+		err = m.importAdventureGamePackage(ids, gameRec.ID, pkg.Adventure)
+	case pkg.Mecha != nil:
+		err = m.importMechaGamePackage(ids, gameRec.ID, pkg.Mecha)
+	case pkg.MechaTactics != nil:
+		err = m.importMechaTacticsGamePackage(ids, gameRec.ID, pkg.MechaTactics)
+	}
+	if err != nil {
+		l.Warn("failed importing game >%s< definition >%v<", gameRec.ID, err)
+		return nil, err
+	}
+
+	for _, t := range pkg.Translations {
+		if _, err := m.CreateGameTranslationRec(&game_record.GameTranslation{
+			GameID:    gameRec.ID,
+			Locale:    t.Locale,
+			RecordID:  ids[t.RecordRef],
+			FieldName: t.FieldName,
+			Text:      t.Text,
+		}); err != nil {
+			l.Warn("failed creating translation of >%s< >%v<", t.RecordRef, err)
+			return nil, err
+		}
+	}
+
+	for idx := range pkg.Images {
+		img := &pkg.Images[idx]
+		rec, err := gamePackageImageToRecord(img)
+		if err != nil {
+			return nil, err
+		}
+		rec.GameID = gameRec.ID
+		rec.RecordID = ids.nullID(img.RecordRef)
+
+		if _, err := m.CreateGameImageRec(rec); err != nil {
+			l.Warn("failed creating image >%s< >%v<", img.File, err)
+			return nil, err
+		}
+	}
+
+	return gameRec, nil
+}
+
+// gamePackageImageToRecord reads the format and dimensions of a packaged image
+func gamePackageImageToRecord(img *gamepackage.Image) (*game_record.GameImage, error) {
+	mimeType := http.DetectContentType(img.Data)
+	if !game_record.GameImageMimeTypes.Has(mimeType) {
+		return nil, coreerror.NewInvalidDataError("image >%s< must be WebP, PNG or JPEG", img.File)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(img.Data))
+	if err != nil {
+		return nil, coreerror.NewInvalidDataError("image >%s< cannot be decoded: %v", img.File, err)
+	}
+
+	return &game_record.GameImage{
+		Type:          img.Type,
+		TurnSheetType: img.TurnSheetType,
+		ImageData:     img.Data,
+		MimeType:      mimeType,
+		FileSize:      len(img.Data),
+		Width:         cfg.Width,
+		Height:        cfg.Height,
+	}, nil
+}
+
+func (m *Domain) importAdventureGamePackage(ids gamePackageImportIDs, gameID string, a *gamepackage.Adventure) error {
+	l := m.Logger("importAdventureGamePackage")
+
+	for _, p := range a.Locations {
+		rec, err := m.CreateAdventureGameLocationRec(&adventure_game_record.AdventureGameLocation{
+			GameID:             gameID,
+			Name:               p.Name,
+			Description:        p.Description,
+			IsStartingLocation: p.IsStartingLocation,
+			IsGoalLocation:     p.IsGoalLocation,
+		})
+		if err != nil {
+			l.Warn("failed creating location >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range a.Items {
+		rec, err := m.CreateAdventureGameItemRec(&adventure_game_record.AdventureGameItem{
+			GameID:         gameID,
+			Name:           p.Name,
+			Description:    p.Description,
+			CanBeEquipped:  p.CanBeEquipped,
+			ItemCategory:   p.ItemCategory,
+			EquipmentSlot:  p.EquipmentSlot,
+			IsStartingItem: p.IsStartingItem,
+			IsGoalItem:     p.IsGoalItem,
+		})
+		if err != nil {
+			l.Warn("failed creating item >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for idx := range a.Creatures {
+		p := &a.Creatures[idx]
+		rec, err := m.CreateAdventureGameCreatureRec(&adventure_game_record.AdventureGameCreature{
+			GameID:            gameID,
+			Name:              p.Name,
+			Description:       p.Description,
+			AttackDamage:      p.AttackDamage,
+			Defense:           p.Defense,
+			Disposition:       p.Disposition,
+			MaxHealth:         p.MaxHealth,
+			AttackMethod:      p.AttackMethod,
+			AttackDescription: p.AttackDescription,
+			BodyDecayTurns:    p.BodyDecayTurns,
+			RespawnTurns:      p.RespawnTurns,
+		})
+		if err != nil {
+			l.Warn("failed creating creature >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range a.LocationLinks {
+		rec, err := m.CreateAdventureGameLocationLinkRec(&adventure_game_record.AdventureGameLocationLink{
+			GameID:                      gameID,
+			FromAdventureGameLocationID: ids[p.FromLocationRef],
+			ToAdventureGameLocationID:   ids[p.ToLocationRef],
+			Name:                        p.Name,
+			Description:                 p.Description,
+			LockedDescription:           nullstring.FromStringPtr(p.LockedDescription),
+			TraversalDescription:        nullstring.FromStringPtr(p.TraversalDescription),
+		})
+		if err != nil {
+			l.Warn("failed creating location link >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range a.LocationLinkRequirements {
+		rec, err := m.CreateAdventureGameLocationLinkRequirementRec(&adventure_game_record.AdventureGameLocationLinkRequirement{
+			GameID:                      gameID,
+			AdventureGameLocationLinkID: ids[p.LocationLinkRef],
+			AdventureGameItemID:         ids.nullID(p.ItemRef),
+			AdventureGameCreatureID:     ids.nullID(p.CreatureRef),
+			Purpose:                     p.Purpose,
+			Condition:                   p.Condition,
+			Quantity:                    p.Quantity,
+		})
+		if err != nil {
+			l.Warn("failed creating location link requirement >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	// Objects are created before their states, initial states are set once the
+	// states exist
+	objectRecs := make([]*adventure_game_record.AdventureGameLocationObject, len(a.LocationObjects))
+	for idx, p := range a.LocationObjects {
+		rec, err := m.CreateAdventureGameLocationObjectRec(&adventure_game_record.AdventureGameLocationObject{
+			GameID:                  gameID,
+			AdventureGameLocationID: ids[p.LocationRef],
+			Name:                    p.Name,
+			Description:             p.Description,
+			IsHidden:                p.IsHidden,
+		})
+		if err != nil {
+			l.Warn("failed creating location object >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+		objectRecs[idx] = rec
+	}
+
+	for _, p := range a.LocationObjectStates {
+		rec, err := m.CreateAdventureGameLocationObjectStateRec(&adventure_game_record.AdventureGameLocationObjectState{
+			GameID:                        gameID,
+			AdventureGameLocationObjectID: ids[p.LocationObjectRef],
+			Name:                          p.Name,
+			Description:                   p.Description,
+			SortOrder:                     p.SortOrder,
+		})
+		if err != nil {
+			l.Warn("failed creating location object state >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for idx, p := range a.LocationObjects {
+		if p.InitialStateRef == "" {
+			continue
+		}
+		rec := objectRecs[idx]
+		rec.InitialAdventureGameLocationObjectStateID = ids.nullID(p.InitialStateRef)
+		if _, err := m.UpdateAdventureGameLocationObjectRec(rec); err != nil {
+			l.Warn("failed setting location object >%s< initial state >%v<", p.Ref, err)
+			return err
+		}
+	}
+
+	for idx := range a.LocationObjectEffects {
+		p := &a.LocationObjectEffects[idx]
+		rec, err := m.CreateAdventureGameLocationObjectEffectRec(&adventure_game_record.AdventureGameLocationObjectEffect{
+			GameID:                        gameID,
+			AdventureGameLocationObjectID: ids[p.LocationObjectRef],
+			ActionType:                    p.ActionType,
+			RequiredAdventureGameLocationObjectStateID: ids.nullID(p.RequiredStateRef),
+			RequiredAdventureGameItemID:                ids.nullID(p.RequiredItemRef),
+			ResultDescription:                          p.ResultDescription,
+			EffectType:                                 p.EffectType,
+			ResultAdventureGameLocationObjectStateID:   ids.nullID(p.ResultStateRef),
+			ResultAdventureGameItemID:                  ids.nullID(p.ResultItemRef),
+			ResultAdventureGameLocationLinkID:          ids.nullID(p.ResultLocationLinkRef),
+			ResultAdventureGameCreatureID:              ids.nullID(p.ResultCreatureRef),
+			ResultAdventureGameLocationObjectID:        ids.nullID(p.ResultLocationObjectRef),
+			ResultAdventureGameLocationID:              ids.nullID(p.ResultLocationRef),
+			ResultValueMin:                             nullint32.FromInt32Ptr(p.ResultValueMin),
+			ResultValueMax:                             nullint32.FromInt32Ptr(p.ResultValueMax),
+			IsRepeatable:                               p.IsRepeatable,
+		})
+		if err != nil {
+			l.Warn("failed creating location object effect >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for idx := range a.ItemEffects {
+		p := &a.ItemEffects[idx]
+		rec, err := m.CreateAdventureGameItemEffectRec(&adventure_game_record.AdventureGameItemEffect{
+			GameID:                            gameID,
+			AdventureGameItemID:               ids[p.ItemRef],
+			ActionType:                        p.ActionType,
+			RequiredAdventureGameItemID:       ids.nullID(p.RequiredItemRef),
+			RequiredAdventureGameLocationID:   ids.nullID(p.RequiredLocationRef),
+			ResultDescription:                 p.ResultDescription,
+			EffectType:                        p.EffectType,
+			ResultAdventureGameItemID:         ids.nullID(p.ResultItemRef),
+			ResultAdventureGameLocationLinkID: ids.nullID(p.ResultLocationLinkRef),
+			ResultAdventureGameCreatureID:     ids.nullID(p.ResultCreatureRef),
+			ResultAdventureGameLocationID:     ids.nullID(p.ResultLocationRef),
+			ResultValueMin:                    nullint32.FromInt32Ptr(p.ResultValueMin),
+			ResultValueMax:                    nullint32.FromInt32Ptr(p.ResultValueMax),
+			IsRepeatable:                      p.IsRepeatable,
+		})
+		if err != nil {
+			l.Warn("failed creating item effect >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range a.ItemPlacements {
+		rec, err := m.CreateAdventureGameItemPlacementRec(&adventure_game_record.AdventureGameItemPlacement{
+			GameID:                  gameID,
+			AdventureGameItemID:     ids[p.EntityRef],
+			AdventureGameLocationID: ids[p.LocationRef],
+			InitialCount:            p.InitialCount,
+		})
+		if err != nil {
+			l.Warn("failed creating item placement >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range a.CreaturePlacements {
+		rec, err := m.CreateAdventureGameCreaturePlacementRec(&adventure_game_record.AdventureGameCreaturePlacement{
+			GameID:                  gameID,
+			AdventureGameCreatureID: ids[p.EntityRef],
+			AdventureGameLocationID: ids[p.LocationRef],
+			InitialCount:            p.InitialCount,
+		})
+		if err != nil {
+			l.Warn("failed creating creature placement >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	return nil
+}
+
+func (m *Domain) importMechaGamePackage(ids gamePackageImportIDs, gameID string, mc *gamepackage.Mecha) error {
+	l := m.Logger("importMechaGamePackage")
+
+	for _, p := range mc.Chassis {
+		sensorRange := mecha_game_record.DefaultChassisSensorRange
+		if p.SensorRange != nil {
+			sensorRange = *p.SensorRange
+		}
+		rec, err := m.CreateMechaGameChassisRec(&mecha_game_record.MechaGameChassis{
+			GameID:          gameID,
+			Name:            p.Name,
+			Description:     p.Description,
+			ChassisClass:    p.ChassisClass,
+			ArmorPoints:     p.ArmorPoints,
+			StructurePoints: p.StructurePoints,
+			HeatCapacity:    p.HeatCapacity,
+			Speed:           p.Speed,
+			SmallSlots:      p.SmallSlots,
+			MediumSlots:     p.MediumSlots,
+			LargeSlots:      p.LargeSlots,
+			SensorRange:     sensorRange,
+		})
+		if err != nil {
+			l.Warn("failed creating chassis >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range mc.Weapons {
+		rec, err := m.CreateMechaGameWeaponRec(&mecha_game_record.MechaGameWeapon{
+			GameID:       gameID,
+			Name:         p.Name,
+			Description:  p.Description,
+			Damage:       p.Damage,
+			HeatCost:     p.HeatCost,
+			RangeBand:    p.RangeBand,
+			MountSize:    p.MountSize,
+			AmmoCapacity: p.AmmoCapacity,
+		})
+		if err != nil {
+			l.Warn("failed creating weapon >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range mc.Equipment {
+		rec, err := m.CreateMechaGameEquipmentRec(&mecha_game_record.MechaGameEquipment{
+			GameID:      gameID,
+			Name:        p.Name,
+			Description: p.Description,
+			MountSize:   p.MountSize,
+			EffectKind:  p.EffectKind,
+			Magnitude:   p.Magnitude,
+			HeatCost:    p.HeatCost,
+		})
+		if err != nil {
+			l.Warn("failed creating equipment >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range mc.Sectors {
+		rec, err := m.CreateMechaGameSectorRec(&mecha_game_record.MechaGameSector{
+			GameID:            gameID,
+			Name:              p.Name,
+			Description:       p.Description,
+			TerrainType:       p.TerrainType,
+			Elevation:         p.Elevation,
+			CoverModifier:     p.CoverModifier,
+			IsStartingSector:  p.IsStartingSector,
+			IsObjectiveSector: p.IsObjectiveSector,
+			VictoryPoints:     p.VictoryPoints,
+			CaptureTurns:      p.CaptureTurns,
+		})
+		if err != nil {
+			l.Warn("failed creating sector >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range mc.SectorLinks {
+		rec, err := m.CreateMechaGameSectorLinkRec(&mecha_game_record.MechaGameSectorLink{
+			GameID:                gameID,
+			FromMechaGameSectorID: ids[p.FromSectorRef],
+			ToMechaGameSectorID:   ids[p.ToSectorRef],
+		})
+		if err != nil {
+			l.Warn("failed creating sector link >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range mc.ComputerOpponents {
+		rec, err := m.CreateMechaGameComputerOpponentRec(&mecha_game_record.MechaGameComputerOpponent{
+			GameID:      gameID,
+			Name:        p.Name,
+			Description: p.Description,
+			Aggression:  p.Aggression,
+			IQ:          p.IQ,
+		})
+		if err != nil {
+			l.Warn("failed creating computer opponent >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range mc.Squads {
+		rec, err := m.CreateMechaGameSquadRec(&mecha_game_record.MechaGameSquad{
+			GameID:      gameID,
+			SquadType:   p.SquadType,
+			Name:        p.Name,
+			Description: p.Description,
+		})
+		if err != nil {
+			l.Warn("failed creating squad >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range mc.SquadMechs {
+		weaponConfig := []mecha_game_record.WeaponConfigEntry{}
+		for _, w := range p.Weapons {
+			weaponConfig = append(weaponConfig, mecha_game_record.WeaponConfigEntry{
+				WeaponID:     ids[w.WeaponRef],
+				SlotLocation: w.SlotLocation,
+			})
+		}
+		equipmentConfig := []mecha_game_record.EquipmentConfigEntry{}
+		for _, eq := range p.Equipment {
+			equipmentConfig = append(equipmentConfig, mecha_game_record.EquipmentConfigEntry{
+				EquipmentID:  ids[eq.EquipmentRef],
+				SlotLocation: eq.SlotLocation,
+			})
+		}
+
+		rec, err := m.CreateMechaGameSquadMechRec(&mecha_game_record.MechaGameSquadMech{
+			GameID:             gameID,
+			MechaGameSquadID:   ids[p.SquadRef],
+			MechaGameChassisID: ids[p.ChassisRef],
+			Callsign:           p.Callsign,
+			WeaponConfig:       weaponConfig,
+			EquipmentConfig:    equipmentConfig,
+		})
+		if err != nil {
+			l.Warn("failed creating squad mech >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	return nil
+}
+
+func (m *Domain) importMechaTacticsGamePackage(ids gamePackageImportIDs, gameID string, mt *gamepackage.MechaTactics) error {
+	l := m.Logger("importMechaTacticsGamePackage")
+
+	for _, p := range mt.Chassis {
+		rec, err := m.CreateMechaTacticsGameChassisRec(&mecha_tactics_game_record.MechaTacticsGameChassis{
+			GameID:          gameID,
+			Name:            p.Name,
+			Description:     p.Description,
+			ChassisClass:    p.ChassisClass,
+			ArmorPoints:     p.ArmorPoints,
+			StructurePoints: p.StructurePoints,
+			HeatCapacity:    p.HeatCapacity,
+			Speed:           p.Speed,
+		})
+		if err != nil {
+			l.Warn("failed creating chassis >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range mt.Weapons {
+		rec, err := m.CreateMechaTacticsGameWeaponRec(&mecha_tactics_game_record.MechaTacticsGameWeapon{
+			GameID:      gameID,
+			Name:        p.Name,
+			Description: p.Description,
+			Damage:      p.Damage,
+			HeatCost:    p.HeatCost,
+			RangeBand:   p.RangeBand,
+			MountSize:   p.MountSize,
+		})
+		if err != nil {
+			l.Warn("failed creating weapon >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range mt.TerrainTypes {
+		rec, err := m.CreateMechaTacticsGameTerrainTypeRec(&mecha_tactics_game_record.MechaTacticsGameTerrainType{
+			GameID:            gameID,
+			Name:              p.Name,
+			Description:       p.Description,
+			MovementPointCost: p.MovementPointCost,
+			CoverModifier:     p.CoverModifier,
+		})
+		if err != nil {
+			l.Warn("failed creating terrain type >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range mt.Hexes {
+		rec, err := m.CreateMechaTacticsGameHexRec(&mecha_tactics_game_record.MechaTacticsGameHex{
+			GameID:                        gameID,
+			MechaTacticsGameTerrainTypeID: ids[p.TerrainTypeRef],
+			HexColumn:                     p.HexColumn,
+			HexRow:                        p.HexRow,
+			Name:                          p.Name,
+			Description:                   p.Description,
+			Elevation:                     p.Elevation,
+			IsStartingHex:                 p.IsStartingHex,
+		})
+		if err != nil {
+			l.Warn("failed creating hex >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range mt.Mechs {
+		weaponConfig := []mecha_tactics_game_record.WeaponConfigEntry{}
+		for _, w := range p.Weapons {
+			weaponConfig = append(weaponConfig, mecha_tactics_game_record.WeaponConfigEntry{
+				WeaponID:     ids[w.WeaponRef],
+				SlotLocation: w.SlotLocation,
+			})
+		}
+
+		rec, err := m.CreateMechaTacticsGameMechRec(&mecha_tactics_game_record.MechaTacticsGameMech{
+			GameID:                    gameID,
+			MechaTacticsGameChassisID: ids[p.ChassisRef],
+			MechType:                  p.MechType,
+			Callsign:                  p.Callsign,
+			WeaponConfig:              weaponConfig,
+		})
+		if err != nil {
+			l.Warn("failed creating mech >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	for _, p := range mt.ComputerOpponents {
+		rec, err := m.CreateMechaTacticsGameComputerOpponentRec(&mecha_tactics_game_record.MechaTacticsGameComputerOpponent{
+			GameID:      gameID,
+			Name:        p.Name,
+			Description: p.Description,
+			Aggression:  p.Aggression,
+			IQ:          p.IQ,
+		})
+		if err != nil {
+			l.Warn("failed creating computer opponent >%s< >%v<", p.Ref, err)
+			return err
+		}
+		ids[p.Ref] = rec.ID
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/harness"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+	"gitlab.com/alienspaces/playbymail/internal/utils/deps"
+)
+
+func TestDomain_GamePackageRoundTrip(t *testing.T) {
+
+	cfg, err := config.Parse()
+	require.NoError(t, err, "Parse returns without error")
+
+	l, s, j, scanner, err := deps.NewDefaultDependencies(cfg)
+	require.NoError(t, err, "NewDefaultDependencies returns without error")
+
+	th, err := harness.NewTesting(cfg, l, s, j, scanner, harness.DefaultDataConfig())
+	require.NoError(t, err, "NewTesting returns without error")
+
+	// Keep transaction open so domain can query the data it creates
+	th.ShouldCommitData = false
+
+	_, err = th.Setup()
+	require.NoError(t, err, "Test data setup returns without error")
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err, "Test data teardown returns without error")
+	}()
+
+	m := th.Domain.(*domain.Domain)
+
+	for _, gameRef := range []string{harness.GameOneRef, harness.GameMechaGameRef} {
+		t.Run(gameRef, func(t *testing.T) {
+			gameRec, err := th.Data.GetGameRecByRef(gameRef)
+			require.NoError(t, err, "GetGameRecByRef returns without error")
+
+			exported, err := m.ExportGamePackage(gameRec.ID)
+			require.NoError(t, err, "ExportGamePackage returns without error")
+
+			importedRec, err := m.ImportGamePackage(exported)
+			require.NoError(t, err, "ImportGamePackage returns without error")
+			require.NotEqual(t, gameRec.ID, importedRec.ID, "imported game is a new game")
+			require.Equal(t, game_record.GameStatusDraft, importedRec.Status, "imported game is a draft")
+			require.Equal(t, gameRec.GameType, importedRec.GameType, "imported game has the same game type")
+
+			reexported, err := m.ExportGamePackage(importedRec.ID)
+			require.NoError(t, err, "ExportGamePackage of imported game returns without error")
+			require.Equal(t, exported.Game, reexported.Game, "imported game exports the same game")
+			require.Len(t, reexported.Translations, len(exported.Translations), "imported game exports the same translations")
+			require.Len(t, reexported.Images, len(exported.Images), "imported game exports the same images")
+
+			// Records created in the same transaction may share a creation
+			// time so compare counts rather than reference order
+			switch gameRec.GameType {
+			case game_record.GameTypeAdventure:
+				require.Len(t, reexported.Adventure.Locations, len(exported.Adventure.Locations), "imported game exports the same locations")
+				require.Len(t, reexported.Adventure.LocationLinks, len(exported.Adventure.LocationLinks), "imported game exports the same location links")
+				require.Len(t, reexported.Adventure.Items, len(exported.Adventure.Items), "imported game exports the same items")
+				require.Len(t, reexported.Adventure.Creatures, len(exported.Adventure.Creatures), "imported game exports the same creatures")
+				require.Len(t, reexported.Adventure.LocationObjects, len(exported.Adventure.LocationObjects), "imported game exports the same location objects")
+				require.Len(t, reexported.Adventure.LocationObjectEffects, len(exported.Adventure.LocationObjectEffects), "imported game exports the same location object effects")
+			case game_record.GameTypeMecha:
+				require.Len(t, reexported.Mecha.Chassis, len(exported.Mecha.Chassis), "imported game exports the same chassis")
+				require.Len(t, reexported.Mecha.Weapons, len(exported.Mecha.Weapons), "imported game exports the same weapons")
+				require.Len(t, reexported.Mecha.Sectors, len(exported.Mecha.Sectors), "imported game exports the same sectors")
+				require.Len(t, reexported.Mecha.SectorLinks, len(exported.Mecha.SectorLinks), "imported game exports the same sector links")
+				require.Len(t, reexported.Mecha.SquadMechs, len(exported.Mecha.SquadMechs), "imported game exports the same squad mechs")
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package domain
 
 import (
+	"fmt"
+	"strconv"
+
+	"gitlab.com/alienspaces/playbymail/core/convert"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 )
 
@@ -26,7 +30,27 @@ const (
 )
 
 const (
-	MechaGameParameterSquadSize = "squad_size"
+	MechaGameParameterSquadSize          = "squad_size"
+	MechaGameParameterObjectiveHoldTurns = "objective_hold_turns"
+
+	// Ruleset parameters, resolved together by GetMechaGameRuleset
+	MechaGameParameterSupplyPointsPerTurn    = "supply_points_per_turn"
+	MechaGameParameterHeatDissipationDivisor = "heat_dissipation_divisor"
+	MechaGameParameterAutoRepairPercent      = "auto_repair_percent"
+	MechaGameParameterBaseHitChance          = "base_hit_chance"
+	MechaGameParameterHitChancePerSkill      = "hit_chance_per_skill"
+	MechaGameParameterMaxHitChance           = "max_hit_chance"
+	MechaGameParameterPilotSkillThresholds   = "pilot_skill_thresholds"
+
+	// Terrain ruleset parameters
+	MechaGameParameterRoughMovementCost         = "rough_movement_cost"
+	MechaGameParameterUrbanMovementCost         = "urban_movement_cost"
+	MechaGameParameterWaterHeatDissipationBonus = "water_heat_dissipation_bonus"
+	MechaGameParameterObstructedFireRange       = "obstructed_fire_range"
+)
+
+const (
+	MechaTacticsGameParameterMechCount = "mech_count"
 )
 
 var gameParameters = []game_record.GameParameter{
@@ -37,6 +61,7 @@ var gameParameters = []game_record.GameParameter{
 		Description:  "The number of lives a character has.",
 		ValueType:    GameParameterValueTypeInteger,
 		DefaultValue: "3",
+		MinValue:     convert.Ptr(1),
 	},
 	// MechaGame parameters
 	{
@@ -45,6 +70,121 @@ var gameParameters = []game_record.GameParameter{
 		Description:  "The number of mechs in a player squad.",
 		ValueType:    GameParameterValueTypeInteger,
 		DefaultValue: "4",
+		MinValue:     convert.Ptr(1),
+	},
+	{
+		GameType:     game_record.GameTypeMecha,
+		ConfigKey:    MechaGameParameterObjectiveHoldTurns,
+		Description:  "The number of consecutive turns a squad must hold an objective sector to win.",
+		ValueType:    GameParameterValueTypeInteger,
+		DefaultValue: "3",
+		MinValue:     convert.Ptr(1),
+	},
+	{
+		GameType:     game_record.GameTypeMecha,
+		ConfigKey:    MechaGameParameterSupplyPointsPerTurn,
+		Description:  "The supply points each player squad earns at the end of every turn.",
+		ValueType:    GameParameterValueTypeInteger,
+		DefaultValue: "2",
+		MinValue:     convert.Ptr(0),
+		MaxValue:     convert.Ptr(20),
+	},
+	{
+		GameType:     game_record.GameTypeMecha,
+		ConfigKey:    MechaGameParameterHeatDissipationDivisor,
+		Description:  "A mech sheds its heat capacity divided by this value at the end of every turn.",
+		ValueType:    GameParameterValueTypeInteger,
+		DefaultValue: "3",
+		MinValue:     convert.Ptr(1),
+		MaxValue:     convert.Ptr(10),
+	},
+	{
+		GameType:     game_record.GameTypeMecha,
+		ConfigKey:    MechaGameParameterAutoRepairPercent,
+		Description:  "The percentage of maximum armor field repairs restore at the end of every turn.",
+		ValueType:    GameParameterValueTypeInteger,
+		DefaultValue: "25",
+		MinValue:     convert.Ptr(0),
+		MaxValue:     convert.Ptr(100),
+	},
+	{
+		GameType:     game_record.GameTypeMecha,
+		ConfigKey:    MechaGameParameterBaseHitChance,
+		Description:  "The percentage chance a weapon hits before pilot skill, equipment and cover are applied.",
+		ValueType:    GameParameterValueTypeInteger,
+		DefaultValue: "50",
+		MinValue:     convert.Ptr(0),
+		MaxValue:     convert.Ptr(100),
+	},
+	{
+		GameType:     game_record.GameTypeMecha,
+		ConfigKey:    MechaGameParameterHitChancePerSkill,
+		Description:  "The percentage added to hit chance for each pilot skill level.",
+		ValueType:    GameParameterValueTypeInteger,
+		DefaultValue: "5",
+		MinValue:     convert.Ptr(0),
+		MaxValue:     convert.Ptr(20),
+	},
+	{
+		GameType:     game_record.GameTypeMecha,
+		ConfigKey:    MechaGameParameterMaxHitChance,
+		Description:  "The highest percentage chance a weapon can hit, so there is always some chance to miss.",
+		ValueType:    GameParameterValueTypeInteger,
+		DefaultValue: "95",
+		MinValue:     convert.Ptr(1),
+		MaxValue:     convert.Ptr(100),
+	},
+	{
+		GameType:     game_record.GameTypeMecha,
+		ConfigKey:    MechaGameParameterPilotSkillThresholds,
+		Description:  "Comma separated total experience required to reach each pilot skill level, starting with 0 for level 0.",
+		ValueType:    GameParameterValueTypeString,
+		DefaultValue: "0,3,8,15,24,35,48,63,80,99",
+	},
+	{
+		GameType:     game_record.GameTypeMecha,
+		ConfigKey:    MechaGameParameterRoughMovementCost,
+		Description:  "The movement points a mech spends to enter a rough sector; other terrain costs 1.",
+		ValueType:    GameParameterValueTypeInteger,
+		DefaultValue: "2",
+		MinValue:     convert.Ptr(1),
+		MaxValue:     convert.Ptr(5),
+	},
+	{
+		GameType:     game_record.GameTypeMecha,
+		ConfigKey:    MechaGameParameterUrbanMovementCost,
+		Description:  "The movement points a mech spends to enter an urban sector; other terrain costs 1.",
+		ValueType:    GameParameterValueTypeInteger,
+		DefaultValue: "2",
+		MinValue:     convert.Ptr(1),
+		MaxValue:     convert.Ptr(5),
+	},
+	{
+		GameType:     game_record.GameTypeMecha,
+		ConfigKey:    MechaGameParameterWaterHeatDissipationBonus,
+		Description:  "The extra heat a mech standing in a water sector sheds at the end of every turn.",
+		ValueType:    GameParameterValueTypeInteger,
+		DefaultValue: "5",
+		MinValue:     convert.Ptr(0),
+		MaxValue:     convert.Ptr(50),
+	},
+	{
+		GameType:     game_record.GameTypeMecha,
+		ConfigKey:    MechaGameParameterObstructedFireRange,
+		Description:  "The furthest distance in sector hops a weapon can fire into or out of a forest or urban sector.",
+		ValueType:    GameParameterValueTypeInteger,
+		DefaultValue: "1",
+		MinValue:     convert.Ptr(0),
+		MaxValue:     convert.Ptr(2),
+	},
+	// MechaTacticsGame parameters
+	{
+		GameType:     game_record.GameTypeMechaTactics,
+		ConfigKey:    MechaTacticsGameParameterMechCount,
+		Description:  "The number of mechs each player controls.",
+		ValueType:    GameParameterValueTypeInteger,
+		DefaultValue: "1",
+		MinValue:     convert.Ptr(1),
 	},
 }
 
@@ -71,3 +211,31 @@ func GetGameParametersByGameType(gameType string) []*game_record.GameParameter {
 	}
 	return filtered
 }
+
+// GetGameParameterIntegerDefault returns the default value registered for an
+// integer parameter of the given game type
+func GetGameParameterIntegerDefault(gameType, parameterKey string) (int, error) {
+	for _, param := range gameParameters {
+		if param.GameType == gameType && param.ConfigKey == parameterKey {
+			value, err := strconv.Atoi(param.DefaultValue)
+			if err != nil {
+				return 0, fmt.Errorf("default value for parameter >%s< is not an integer: %w", parameterKey, err)
+			}
+			return value, nil
+		}
+	}
+
+	return 0, fmt.Errorf("parameter >%s< is not defined for game type >%s<", parameterKey, gameType)
+}
+
+// GetGameParameterStringDefault returns the default value registered for a
+// parameter of the given game type
+func GetGameParameterStringDefault(gameType, parameterKey string) (string, error) {
+	for _, param := range gameParameters {
+		if param.GameType == gameType && param.ConfigKey == parameterKey {
+			return param.DefaultValue, nil
+		}
+	}
+
+	return "", fmt.Errorf("parameter >%s< is not defined for game type >%s<", parameterKey, gameType)
+}
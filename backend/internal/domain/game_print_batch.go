@@ -0,0 +1,298 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+// GetManyGamePrintBatchRecs -
+func (m *Domain) GetManyGamePrintBatchRecs(opts *coresql.Options) ([]*game_record.GamePrintBatch, error) {
+	l := m.Logger("GetManyGamePrintBatchRecs")
+
+	l.Debug("getting many game_print_batch records opts >%#v<", opts)
+
+	r := m.GamePrintBatchRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+// GetGamePrintBatchRec -
+func (m *Domain) GetGamePrintBatchRec(recID string, lock *coresql.Lock) (*game_record.GamePrintBatch, error) {
+	l := m.Logger("GetGamePrintBatchRec")
+
+	l.Debug("getting game_print_batch record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.GamePrintBatchRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(game_record.TableGamePrintBatch, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// CreateGamePrintBatchRec -
+func (m *Domain) CreateGamePrintBatchRec(rec *game_record.GamePrintBatch) (*game_record.GamePrintBatch, error) {
+	l := m.Logger("CreateGamePrintBatchRec")
+
+	l.Debug("creating game_print_batch record >%#v<", rec)
+
+	if rec.Status == "" {
+		rec.Status = game_record.GamePrintBatchStatusPending
+	}
+
+	if err := m.validateGamePrintBatchRecForCreate(rec); err != nil {
+		l.Warn("failed to validate game_print_batch record >%v<", err)
+		return rec, err
+	}
+
+	r := m.GamePrintBatchRepository()
+
+	rec, err := r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// UpdateGamePrintBatchRec -
+func (m *Domain) UpdateGamePrintBatchRec(rec *game_record.GamePrintBatch) (*game_record.GamePrintBatch, error) {
+	l := m.Logger("UpdateGamePrintBatchRec")
+
+	currRec, err := m.GetGamePrintBatchRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating game_print_batch record >%#v<", rec)
+
+	if err := m.validateGamePrintBatchRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate game_print_batch record >%v<", err)
+		return rec, err
+	}
+
+	r := m.GamePrintBatchRepository()
+
+	rec, err = r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// DeleteGamePrintBatchRec -
+func (m *Domain) DeleteGamePrintBatchRec(recID string) error {
+	l := m.Logger("DeleteGamePrintBatchRec")
+
+	l.Debug("deleting game_print_batch record ID >%s<", recID)
+
+	_, err := m.GetGamePrintBatchRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.GamePrintBatchRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// RemoveGamePrintBatchRec -
+func (m *Domain) RemoveGamePrintBatchRec(recID string) error {
+	l := m.Logger("RemoveGamePrintBatchRec")
+
+	l.Debug("removing game_print_batch record ID >%s<", recID)
+
+	r := m.GamePrintBatchRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// GetSubmittedGamePrintBatchRecs returns all print batches that have been
+// submitted to the print device and are waiting on the printer job to finish.
+func (m *Domain) GetSubmittedGamePrintBatchRecs() ([]*game_record.GamePrintBatch, error) {
+	return m.GetManyGamePrintBatchRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: game_record.FieldGamePrintBatchStatus, Val: game_record.GamePrintBatchStatusSubmitted},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: game_record.FieldGamePrintBatchSubmittedAt, Direction: coresql.OrderDirectionASC},
+		},
+	})
+}
+
+// MarkGamePrintBatchAsSubmitted records the printer job a batch was submitted as
+func (m *Domain) MarkGamePrintBatchAsSubmitted(rec *game_record.GamePrintBatch, printerJobID, printerJobState, printerJobStateMessage string) (*game_record.GamePrintBatch, error) {
+	rec.Status = game_record.GamePrintBatchStatusSubmitted
+	rec.PrinterJobID = nullstring.FromString(printerJobID)
+	rec.PrinterJobState = nullstring.FromString(printerJobState)
+	rec.PrinterJobStateMessage = nullstring.FromString(printerJobStateMessage)
+	rec.ErrorMessage = nullstring.FromString("")
+	rec.SubmittedAt = nulltime.FromTime(time.Now())
+
+	return m.UpdateGamePrintBatchRec(rec)
+}
+
+// MarkGamePrintBatchAsCompleted marks a batch as printed
+func (m *Domain) MarkGamePrintBatchAsCompleted(rec *game_record.GamePrintBatch) (*game_record.GamePrintBatch, error) {
+	rec.Status = game_record.GamePrintBatchStatusCompleted
+	rec.CompletedAt = nulltime.FromTime(time.Now())
+
+	return m.UpdateGamePrintBatchRec(rec)
+}
+
+// MarkGamePrintBatchAsFailed marks a batch as failed with the reason
+func (m *Domain) MarkGamePrintBatchAsFailed(rec *game_record.GamePrintBatch, errorMessage string) (*game_record.GamePrintBatch, error) {
+	rec.Status = game_record.GamePrintBatchStatusFailed
+	rec.ErrorMessage = nullstring.FromString(errorMessage)
+	rec.CompletedAt = nulltime.FromTime(time.Now())
+
+	return m.UpdateGamePrintBatchRec(rec)
+}
+
+// GamePrintBatchRecipient is a player whose turn sheets are included in a print batch
+type GamePrintBatchRecipient struct {
+	SubscriptionInstance *game_record.GameSubscriptionInstance
+	DeliveryMethod       string
+	// Contact is the player's postal contact, nil when none has been recorded
+	Contact    *account_record.AccountUserContact
+	TurnSheets []*game_record.GameTurnSheet
+}
+
+// GetGamePrintBatchRecipients returns every player in the batch's game instance
+// with an active subscription whose delivery method is post or local, and which
+// the game instance supports, along with their turn sheets for the batch turn
+// in sheet order. Players without turn sheets for the turn are omitted.
+func (m *Domain) GetGamePrintBatchRecipients(batchRec *game_record.GamePrintBatch) ([]*GamePrintBatchRecipient, error) {
+	l := m.Logger("GetGamePrintBatchRecipients")
+
+	gameInstanceRec, err := m.GetGameInstanceRec(batchRec.GameInstanceID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceLinks, err := m.GetGameSubscriptionInstanceRecsByInstance(batchRec.GameInstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var recipients []*GamePrintBatchRecipient
+	for _, link := range instanceLinks {
+		if nullstring.IsValid(batchRec.GameSubscriptionInstanceID) && batchRec.GameSubscriptionInstanceID.String != link.ID {
+			continue
+		}
+
+		subscriptionRec, err := m.GetGameSubscriptionRec(link.GameSubscriptionID, nil)
+		if err != nil {
+			l.Warn("failed to get game subscription >%s< >%v<", link.GameSubscriptionID, err)
+			return nil, err
+		}
+
+		if subscriptionRec.SubscriptionType != game_record.GameSubscriptionTypePlayer ||
+			subscriptionRec.Status != game_record.GameSubscriptionStatusActive {
+			continue
+		}
+
+		deliveryMethod := nullstring.ToString(subscriptionRec.DeliveryMethod)
+		switch deliveryMethod {
+		case game_record.GameSubscriptionDeliveryMethodPost:
+			if !gameInstanceRec.DeliveryPhysicalPost {
+				continue
+			}
+		case game_record.GameSubscriptionDeliveryMethodLocal:
+			if !gameInstanceRec.DeliveryPhysicalLocal {
+				continue
+			}
+		default:
+			continue
+		}
+
+		turnSheetRecs, err := m.GetManyGameTurnSheetRecs(&coresql.Options{
+			Params: []coresql.Param{
+				{Col: game_record.FieldGameTurnSheetGameInstanceID, Val: batchRec.GameInstanceID},
+				{Col: game_record.FieldGameTurnSheetAccountUserID, Val: link.AccountUserID},
+				{Col: game_record.FieldGameTurnSheetTurnNumber, Val: batchRec.TurnNumber},
+			},
+			OrderBy: []coresql.OrderBy{
+				{Col: game_record.FieldGameTurnSheetSheetOrder, Direction: coresql.OrderDirectionASC},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(turnSheetRecs) == 0 {
+			l.Info("no turn sheets for subscription instance >%s< turn >%d<, skipping", link.ID, batchRec.TurnNumber)
+			continue
+		}
+
+		contactRec, err := m.getGamePrintBatchRecipientContact(subscriptionRec, link.AccountUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		recipients = append(recipients, &GamePrintBatchRecipient{
+			SubscriptionInstance: link,
+			DeliveryMethod:       deliveryMethod,
+			Contact:              contactRec,
+			TurnSheets:           turnSheetRecs,
+		})
+	}
+
+	return recipients, nil
+}
+
+// getGamePrintBatchRecipientContact returns the contact recorded on the subscription,
+// falling back to the account user's contact. Returns nil when neither exists.
+func (m *Domain) getGamePrintBatchRecipientContact(subscriptionRec *game_record.GameSubscription, accountUserID string) (*account_record.AccountUserContact, error) {
+	if nullstring.IsValid(subscriptionRec.AccountUserContactID) {
+		contactRec, err := m.GetAccountUserContactRec(subscriptionRec.AccountUserContactID.String, nil)
+		if err == nil {
+			return contactRec, nil
+		}
+		if !coreerror.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	contactRec, err := m.GetAccountUserContactRecByAccountUserID(accountUserID, nil)
+	if err != nil {
+		if coreerror.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return contactRec, nil
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+type validateGamePrintBatchArgs struct {
+	nextRec *game_record.GamePrintBatch
+	currRec *game_record.GamePrintBatch
+}
+
+func (m *Domain) populateGamePrintBatchValidateArgs(currRec, nextRec *game_record.GamePrintBatch) (*validateGamePrintBatchArgs, error) {
+	args := &validateGamePrintBatchArgs{
+		currRec: currRec,
+		nextRec: nextRec,
+	}
+	return args, nil
+}
+
+func (m *Domain) validateGamePrintBatchRecForCreate(rec *game_record.GamePrintBatch) error {
+	args, err := m.populateGamePrintBatchValidateArgs(nil, rec)
+	if err != nil {
+		return err
+	}
+	return validateGamePrintBatchRecForCreate(args)
+}
+
+func (m *Domain) validateGamePrintBatchRecForUpdate(currRec, nextRec *game_record.GamePrintBatch) error {
+	args, err := m.populateGamePrintBatchValidateArgs(currRec, nextRec)
+	if err != nil {
+		return err
+	}
+	return validateGamePrintBatchRecForUpdate(args)
+}
+
+func validateGamePrintBatchRecForCreate(args *validateGamePrintBatchArgs) error {
+	return validateGamePrintBatchRec(args, false)
+}
+
+func validateGamePrintBatchRecForUpdate(args *validateGamePrintBatchArgs) error {
+	if err := validateGamePrintBatchRec(args, true); err != nil {
+		return err
+	}
+
+	currRec := args.currRec
+	nextRec := args.nextRec
+
+	if nextRec.GameInstanceID != currRec.GameInstanceID {
+		return InvalidField(game_record.FieldGamePrintBatchGameInstanceID, nextRec.GameInstanceID, "game_instance_id cannot be changed")
+	}
+
+	if nextRec.TurnNumber != currRec.TurnNumber {
+		return InvalidField(game_record.FieldGamePrintBatchTurnNumber, fmt.Sprintf("%d", nextRec.TurnNumber), "turn_number cannot be changed")
+	}
+
+	return nil
+}
+
+func validateGamePrintBatchRec(args *validateGamePrintBatchArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(game_record.FieldGamePrintBatchID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(game_record.FieldGamePrintBatchGameID, rec.GameID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(game_record.FieldGamePrintBatchGameInstanceID, rec.GameInstanceID); err != nil {
+		return err
+	}
+
+	if nullstring.IsValid(rec.GameSubscriptionInstanceID) {
+		if err := domain.ValidateNullUUIDField(game_record.FieldGamePrintBatchGameSubscriptionInstanceID, rec.GameSubscriptionInstanceID); err != nil {
+			return err
+		}
+	}
+
+	if rec.TurnNumber < 0 {
+		return InvalidField(
+			game_record.FieldGamePrintBatchTurnNumber,
+			fmt.Sprintf("%d", rec.TurnNumber),
+			"turn_number must be zero or greater",
+		)
+	}
+
+	if err := domain.ValidateEnumField(
+		game_record.FieldGamePrintBatchStatus,
+		rec.Status,
+		game_record.GamePrintBatchStatuses,
+	); err != nil {
+		return err
+	}
+
+	if rec.PlayerCount < 0 {
+		return InvalidField(
+			game_record.FieldGamePrintBatchPlayerCount,
+			fmt.Sprintf("%d", rec.PlayerCount),
+			"player_count must be zero or greater",
+		)
+	}
+
+	if rec.DocumentCount < 0 {
+		return InvalidField(
+			game_record.FieldGamePrintBatchDocumentCount,
+			fmt.Sprintf("%d", rec.DocumentCount),
+			"document_count must be zero or greater",
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+// GetManyGameScanBatchRecs -
+func (m *Domain) GetManyGameScanBatchRecs(opts *coresql.Options) ([]*game_record.GameScanBatch, error) {
+	l := m.Logger("GetManyGameScanBatchRecs")
+
+	l.Debug("getting many game_scan_batch records opts >%#v<", opts)
+
+	r := m.GameScanBatchRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+// GetGameScanBatchRec -
+func (m *Domain) GetGameScanBatchRec(recID string, lock *coresql.Lock) (*game_record.GameScanBatch, error) {
+	l := m.Logger("GetGameScanBatchRec")
+
+	l.Debug("getting game_scan_batch record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.GameScanBatchRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(game_record.TableGameScanBatch, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// CreateGameScanBatchRec -
+func (m *Domain) CreateGameScanBatchRec(rec *game_record.GameScanBatch) (*game_record.GameScanBatch, error) {
+	l := m.Logger("CreateGameScanBatchRec")
+
+	l.Debug("creating game_scan_batch record file name >%s< size >%d<", rec.FileName, len(rec.DocumentData))
+
+	if rec.Status == "" {
+		rec.Status = game_record.GameScanBatchStatusPending
+	}
+
+	if err := m.validateGameScanBatchRecForCreate(rec); err != nil {
+		l.Warn("failed to validate game_scan_batch record >%v<", err)
+		return rec, err
+	}
+
+	r := m.GameScanBatchRepository()
+
+	rec, err := r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// UpdateGameScanBatchRec -
+func (m *Domain) UpdateGameScanBatchRec(rec *game_record.GameScanBatch) (*game_record.GameScanBatch, error) {
+	l := m.Logger("UpdateGameScanBatchRec")
+
+	currRec, err := m.GetGameScanBatchRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating game_scan_batch record ID >%s< status >%s<", rec.ID, rec.Status)
+
+	if err := m.validateGameScanBatchRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate game_scan_batch record >%v<", err)
+		return rec, err
+	}
+
+	r := m.GameScanBatchRepository()
+
+	rec, err = r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// DeleteGameScanBatchRec -
+func (m *Domain) DeleteGameScanBatchRec(recID string) error {
+	l := m.Logger("DeleteGameScanBatchRec")
+
+	l.Debug("deleting game_scan_batch record ID >%s<", recID)
+
+	_, err := m.GetGameScanBatchRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.GameScanBatchRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// RemoveGameScanBatchRec -
+func (m *Domain) RemoveGameScanBatchRec(recID string) error {
+	l := m.Logger("RemoveGameScanBatchRec")
+
+	l.Debug("removing game_scan_batch record ID >%s<", recID)
+
+	r := m.GameScanBatchRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// MarkGameScanBatchAsProcessing records the pages a batch document was split into
+// and releases the uploaded document
+func (m *Domain) MarkGameScanBatchAsProcessing(rec *game_record.GameScanBatch, pageCount int) (*game_record.GameScanBatch, error) {
+	rec.Status = game_record.GameScanBatchStatusProcessing
+	rec.PageCount = pageCount
+	rec.DocumentData = nil
+
+	return m.UpdateGameScanBatchRec(rec)
+}
+
+// MarkGameScanBatchAsCompleted marks a batch as having processed every page
+func (m *Domain) MarkGameScanBatchAsCompleted(rec *game_record.GameScanBatch) (*game_record.GameScanBatch, error) {
+	rec.Status = game_record.GameScanBatchStatusCompleted
+	rec.CompletedAt = nulltime.FromTime(time.Now())
+
+	return m.UpdateGameScanBatchRec(rec)
+}
+
+// MarkGameScanBatchAsFailed marks a batch as failed with the reason
+func (m *Domain) MarkGameScanBatchAsFailed(rec *game_record.GameScanBatch, errorMessage string) (*game_record.GameScanBatch, error) {
+	rec.Status = game_record.GameScanBatchStatusFailed
+	rec.ErrorMessage = nullstring.FromString(errorMessage)
+	rec.DocumentData = nil
+	rec.CompletedAt = nulltime.FromTime(time.Now())
+
+	return m.UpdateGameScanBatchRec(rec)
+}
+
+// RecordGameScanBatchPageOutcome records the outcome of processing a page, releasing
+// the page image, and adds the outcome to the batch progress counts
+func (m *Domain) RecordGameScanBatchPageOutcome(batchRec *game_record.GameScanBatch, pageRec *game_record.GameScanBatchPage, status, message string) (*game_record.GameScanBatch, error) {
+	pageRec.Status = status
+	pageRec.Message = nullstring.FromString(message)
+	pageRec.ImageData = nil
+
+	if _, err := m.UpdateGameScanBatchPageRec(pageRec); err != nil {
+		return batchRec, err
+	}
+
+	batchRec.PagesProcessed++
+	switch status {
+	case game_record.GameScanBatchPageStatusMatched:
+		batchRec.MatchedCount++
+	case game_record.GameScanBatchPageStatusDuplicate:
+		batchRec.DuplicateCount++
+	case game_record.GameScanBatchPageStatusUnreadable:
+		batchRec.UnreadableCount++
+	case game_record.GameScanBatchPageStatusWrongTurn:
+		batchRec.WrongTurnCount++
+	case game_record.GameScanBatchPageStatusWrongGame:
+		batchRec.WrongGameCount++
+	}
+
+	return m.UpdateGameScanBatchRec(batchRec)
+}
+
+// removeGameInstanceScanBatches removes the scan batches of a game instance and their pages
+func (m *Domain) removeGameInstanceScanBatches(instanceID string) error {
+	batchRecs, err := m.GetManyGameScanBatchRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: game_record.FieldGameScanBatchGameInstanceID, Val: instanceID},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, batchRec := range batchRecs {
+		pageRecs, err := m.GetGameScanBatchPageRecsByBatch(batchRec.ID)
+		if err != nil {
+			return err
+		}
+		for _, pageRec := range pageRecs {
+			if err := m.RemoveGameScanBatchPageRec(pageRec.ID); err != nil {
+				return err
+			}
+		}
+		if err := m.RemoveGameScanBatchRec(batchRec.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+// GetManyGameScanBatchPageRecs -
+func (m *Domain) GetManyGameScanBatchPageRecs(opts *coresql.Options) ([]*game_record.GameScanBatchPage, error) {
+	l := m.Logger("GetManyGameScanBatchPageRecs")
+
+	l.Debug("getting many game_scan_batch_page records opts >%#v<", opts)
+
+	r := m.GameScanBatchPageRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+// GetGameScanBatchPageRec -
+func (m *Domain) GetGameScanBatchPageRec(recID string, lock *coresql.Lock) (*game_record.GameScanBatchPage, error) {
+	l := m.Logger("GetGameScanBatchPageRec")
+
+	l.Debug("getting game_scan_batch_page record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.GameScanBatchPageRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(game_record.TableGameScanBatchPage, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// CreateGameScanBatchPageRec -
+func (m *Domain) CreateGameScanBatchPageRec(rec *game_record.GameScanBatchPage) (*game_record.GameScanBatchPage, error) {
+	l := m.Logger("CreateGameScanBatchPageRec")
+
+	l.Debug("creating game_scan_batch_page record batch ID >%s< page >%d<", rec.GameScanBatchID, rec.PageNumber)
+
+	if rec.Status == "" {
+		rec.Status = game_record.GameScanBatchPageStatusPending
+	}
+
+	if err := m.validateGameScanBatchPageRecForCreate(rec); err != nil {
+		l.Warn("failed to validate game_scan_batch_page record >%v<", err)
+		return rec, err
+	}
+
+	r := m.GameScanBatchPageRepository()
+
+	rec, err := r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// UpdateGameScanBatchPageRec -
+func (m *Domain) UpdateGameScanBatchPageRec(rec *game_record.GameScanBatchPage) (*game_record.GameScanBatchPage, error) {
+	l := m.Logger("UpdateGameScanBatchPageRec")
+
+	currRec, err := m.GetGameScanBatchPageRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating game_scan_batch_page record ID >%s< status >%s<", rec.ID, rec.Status)
+
+	if err := m.validateGameScanBatchPageRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate game_scan_batch_page record >%v<", err)
+		return rec, err
+	}
+
+	r := m.GameScanBatchPageRepository()
+
+	rec, err = r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// DeleteGameScanBatchPageRec -
+func (m *Domain) DeleteGameScanBatchPageRec(recID string) error {
+	l := m.Logger("DeleteGameScanBatchPageRec")
+
+	l.Debug("deleting game_scan_batch_page record ID >%s<", recID)
+
+	_, err := m.GetGameScanBatchPageRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.GameScanBatchPageRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// RemoveGameScanBatchPageRec -
+func (m *Domain) RemoveGameScanBatchPageRec(recID string) error {
+	l := m.Logger("RemoveGameScanBatchPageRec")
+
+	l.Debug("removing game_scan_batch_page record ID >%s<", recID)
+
+	r := m.GameScanBatchPageRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// GetGameScanBatchPageRecsByBatch returns the pages of a scan batch in page order
+func (m *Domain) GetGameScanBatchPageRecsByBatch(batchID string) ([]*game_record.GameScanBatchPage, error) {
+	return m.GetManyGameScanBatchPageRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: game_record.FieldGameScanBatchPageGameScanBatchID, Val: batchID},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: game_record.FieldGameScanBatchPagePageNumber, Direction: coresql.OrderDirectionASC},
+		},
+	})
+}
+
+// GetNextPendingGameScanBatchPageRec returns the first page of a scan batch that has
+// not been processed, or nil when every page has been processed
+func (m *Domain) GetNextPendingGameScanBatchPageRec(batchID string) (*game_record.GameScanBatchPage, error) {
+	recs, err := m.GetManyGameScanBatchPageRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: game_record.FieldGameScanBatchPageGameScanBatchID, Val: batchID},
+			{Col: game_record.FieldGameScanBatchPageStatus, Val: game_record.GameScanBatchPageStatusPending},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: game_record.FieldGameScanBatchPagePageNumber, Direction: coresql.OrderDirectionASC},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(recs) == 0 {
+		return nil, nil
+	}
+
+	return recs[0], nil
+}
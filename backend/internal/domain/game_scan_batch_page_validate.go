@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+type validateGameScanBatchPageArgs struct {
+	nextRec *game_record.GameScanBatchPage
+	currRec *game_record.GameScanBatchPage
+}
+
+func (m *Domain) populateGameScanBatchPageValidateArgs(currRec, nextRec *game_record.GameScanBatchPage) (*validateGameScanBatchPageArgs, error) {
+	args := &validateGameScanBatchPageArgs{
+		currRec: currRec,
+		nextRec: nextRec,
+	}
+	return args, nil
+}
+
+func (m *Domain) validateGameScanBatchPageRecForCreate(rec *game_record.GameScanBatchPage) error {
+	args, err := m.populateGameScanBatchPageValidateArgs(nil, rec)
+	if err != nil {
+		return err
+	}
+	return validateGameScanBatchPageRecForCreate(args)
+}
+
+func (m *Domain) validateGameScanBatchPageRecForUpdate(currRec, nextRec *game_record.GameScanBatchPage) error {
+	args, err := m.populateGameScanBatchPageValidateArgs(currRec, nextRec)
+	if err != nil {
+		return err
+	}
+	return validateGameScanBatchPageRecForUpdate(args)
+}
+
+func validateGameScanBatchPageRecForCreate(args *validateGameScanBatchPageArgs) error {
+	return validateGameScanBatchPageRec(args, false)
+}
+
+func validateGameScanBatchPageRecForUpdate(args *validateGameScanBatchPageArgs) error {
+	if err := validateGameScanBatchPageRec(args, true); err != nil {
+		return err
+	}
+
+	currRec := args.currRec
+	nextRec := args.nextRec
+
+	if nextRec.GameScanBatchID != currRec.GameScanBatchID {
+		return InvalidField(game_record.FieldGameScanBatchPageGameScanBatchID, nextRec.GameScanBatchID, "game_scan_batch_id cannot be changed")
+	}
+
+	if nextRec.PageNumber != currRec.PageNumber {
+		return InvalidField(game_record.FieldGameScanBatchPagePageNumber, fmt.Sprintf("%d", nextRec.PageNumber), "page_number cannot be changed")
+	}
+
+	return nil
+}
+
+func validateGameScanBatchPageRec(args *validateGameScanBatchPageArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(game_record.FieldGameScanBatchPageID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(game_record.FieldGameScanBatchPageGameScanBatchID, rec.GameScanBatchID); err != nil {
+		return err
+	}
+
+	if rec.PageNumber < 1 {
+		return InvalidField(
+			game_record.FieldGameScanBatchPagePageNumber,
+			fmt.Sprintf("%d", rec.PageNumber),
+			"page_number must be one or greater",
+		)
+	}
+
+	if err := domain.ValidateStringField(game_record.FieldGameScanBatchPageSource, rec.Source); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateEnumField(
+		game_record.FieldGameScanBatchPageStatus,
+		rec.Status,
+		game_record.GameScanBatchPageStatuses,
+	); err != nil {
+		return err
+	}
+
+	if nullstring.IsValid(rec.GameTurnSheetID) {
+		if err := domain.ValidateNullUUIDField(game_record.FieldGameScanBatchPageGameTurnSheetID, rec.GameTurnSheetID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+type validateGameScanBatchArgs struct {
+	nextRec *game_record.GameScanBatch
+	currRec *game_record.GameScanBatch
+}
+
+func (m *Domain) populateGameScanBatchValidateArgs(currRec, nextRec *game_record.GameScanBatch) (*validateGameScanBatchArgs, error) {
+	args := &validateGameScanBatchArgs{
+		currRec: currRec,
+		nextRec: nextRec,
+	}
+	return args, nil
+}
+
+func (m *Domain) validateGameScanBatchRecForCreate(rec *game_record.GameScanBatch) error {
+	args, err := m.populateGameScanBatchValidateArgs(nil, rec)
+	if err != nil {
+		return err
+	}
+	return validateGameScanBatchRecForCreate(args)
+}
+
+func (m *Domain) validateGameScanBatchRecForUpdate(currRec, nextRec *game_record.GameScanBatch) error {
+	args, err := m.populateGameScanBatchValidateArgs(currRec, nextRec)
+	if err != nil {
+		return err
+	}
+	return validateGameScanBatchRecForUpdate(args)
+}
+
+func validateGameScanBatchRecForCreate(args *validateGameScanBatchArgs) error {
+	if err := validateGameScanBatchRec(args, false); err != nil {
+		return err
+	}
+
+	return domain.ValidateByteSliceField(game_record.FieldGameScanBatchDocumentData, args.nextRec.DocumentData)
+}
+
+func validateGameScanBatchRecForUpdate(args *validateGameScanBatchArgs) error {
+	if err := validateGameScanBatchRec(args, true); err != nil {
+		return err
+	}
+
+	currRec := args.currRec
+	nextRec := args.nextRec
+
+	if nextRec.GameInstanceID != currRec.GameInstanceID {
+		return InvalidField(game_record.FieldGameScanBatchGameInstanceID, nextRec.GameInstanceID, "game_instance_id cannot be changed")
+	}
+
+	return nil
+}
+
+func validateGameScanBatchRec(args *validateGameScanBatchArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(game_record.FieldGameScanBatchID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(game_record.FieldGameScanBatchGameID, rec.GameID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(game_record.FieldGameScanBatchGameInstanceID, rec.GameInstanceID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateStringField(game_record.FieldGameScanBatchFileName, rec.FileName); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateEnumField(
+		game_record.FieldGameScanBatchStatus,
+		rec.Status,
+		game_record.GameScanBatchStatuses,
+	); err != nil {
+		return err
+	}
+
+	if rec.PageCount < 0 {
+		return InvalidField(
+			game_record.FieldGameScanBatchPageCount,
+			fmt.Sprintf("%d", rec.PageCount),
+			"page_count must be zero or greater",
+		)
+	}
+
+	if rec.PagesProcessed < 0 || rec.PagesProcessed > rec.PageCount {
+		return InvalidField(
+			game_record.FieldGameScanBatchPagesProcessed,
+			fmt.Sprintf("%d", rec.PagesProcessed),
+			"pages_processed must be between zero and page_count",
+		)
+	}
+
+	return nil
+}
@@ -504,6 +504,24 @@ func (m *Domain) GetGameSubscriptionInstanceRecsByInstance(instanceID string) ([
 	return recs, nil
 }
 
+// GetDroppedGameSubscriptionInstanceRecsByInstance gets the game_subscription_instance
+// records of players dropped from a game instance for missing too many turns
+func (m *Domain) GetDroppedGameSubscriptionInstanceRecsByInstance(instanceID string) ([]*game_record.GameSubscriptionInstance, error) {
+	recs, err := m.GetGameSubscriptionInstanceRecsByInstance(instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var droppedRecs []*game_record.GameSubscriptionInstance
+	for _, rec := range recs {
+		if rec.IsDropped() {
+			droppedRecs = append(droppedRecs, rec)
+		}
+	}
+
+	return droppedRecs, nil
+}
+
 // ValidateInstanceLimit checks if adding another instance would exceed the subscription's limit
 func (m *Domain) ValidateInstanceLimit(subscriptionID string) error {
 	l := m.Logger("ValidateInstanceLimit")
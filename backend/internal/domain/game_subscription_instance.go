@@ -1,8 +1,10 @@
 package domain
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,7 +15,10 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/nullstring"
 	"gitlab.com/alienspaces/playbymail/core/nulltime"
 	"gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/utils/turnsheetutil"
 )
 
@@ -77,6 +82,10 @@ func (m *Domain) CreateGameSubscriptionInstanceRec(rec *game_record.GameSubscrip
 		return existingRecs[0], nil
 	}
 
+	if len(rec.StandingOrders) == 0 {
+		rec.StandingOrders = json.RawMessage("{}")
+	}
+
 	r := m.GameSubscriptionInstanceRepository()
 
 	if err := m.validateGameSubscriptionInstanceRecForCreate(rec); err != nil {
@@ -200,7 +209,7 @@ func (m *Domain) validateGameSubscriptionInstanceRecForCreate(rec *game_record.G
 		return err
 	}
 
-	return nil
+	return m.validateGameSubscriptionInstanceMissedTurns(instanceRec, rec)
 }
 
 func (m *Domain) validateGameSubscriptionInstanceRecForUpdate(currRec, nextRec *game_record.GameSubscriptionInstance) error {
@@ -247,9 +256,67 @@ func (m *Domain) validateGameSubscriptionInstanceRecForUpdate(currRec, nextRec *
 		return coreerror.NewInvalidDataError("game instance must belong to the same game as the subscription")
 	}
 
+	return m.validateGameSubscriptionInstanceMissedTurns(instanceRec, nextRec)
+}
+
+// validateGameSubscriptionInstanceMissedTurns validates the missed turn count and that
+// every standing order is available for its turn sheet type in the game type
+func (m *Domain) validateGameSubscriptionInstanceMissedTurns(instanceRec *game_record.GameInstance, rec *game_record.GameSubscriptionInstance) error {
+	if rec.MissedTurns < 0 {
+		return InvalidField(
+			game_record.FieldGameSubscriptionInstanceMissedTurns,
+			fmt.Sprintf("%d", rec.MissedTurns),
+			"missed_turns must be zero or greater",
+		)
+	}
+
+	standingOrders, err := rec.GetStandingOrders()
+	if err != nil {
+		return InvalidField(
+			game_record.FieldGameSubscriptionInstanceStandingOrders,
+			string(rec.StandingOrders),
+			"standing_orders must be an object of turn sheet type to standing order",
+		)
+	}
+
+	if len(standingOrders) == 0 {
+		return nil
+	}
+
+	gameRec, err := m.GetGameRec(instanceRec.GameID, nil)
+	if err != nil {
+		return coreerror.NewInvalidDataError("game instance references invalid game")
+	}
+
+	available := GameTypeStandingOrders(gameRec.GameType)
+	for sheetType, standingOrder := range standingOrders {
+		if !slices.Contains(available[sheetType], standingOrder) {
+			return InvalidField(
+				game_record.FieldGameSubscriptionInstanceStandingOrders,
+				standingOrder,
+				fmt.Sprintf("standing order >%s< is not available for turn sheet type >%s<", standingOrder, sheetType),
+			)
+		}
+	}
+
 	return nil
 }
 
+// GameTypeStandingOrders returns the standing orders available for each turn sheet
+// type of the game type
+func GameTypeStandingOrders(gameType string) map[string][]string {
+	switch gameType {
+	case game_record.GameTypeAdventure:
+		return adventure_game_record.AdventureGameStandingOrders
+	case game_record.GameTypeMecha:
+		return mecha_game_record.MechaGameStandingOrders
+	case game_record.GameTypeMechaTactics:
+		return mecha_tactics_game_record.MechaTacticsGameStandingOrders
+	default:
+		return nil
+	}
+}
+
 func (m *Domain) validateGameSubscriptionInstanceRecForDelete(rec *game_record.GameSubscriptionInstance) error {
 	if rec == nil {
 		return coreerror.NewInvalidDataError("record is nil")
@@ -376,6 +443,40 @@ func (m *Domain) VerifyGameSubscriptionInstanceTurnSheetKey(instanceID, turnShee
 	return rec, nil
 }
 
+// GetGameSubscriptionInstanceRecByTurnSheetToken returns the game subscription
+// instance holding a turn sheet token. Nil is returned when no instance holds
+// the token or the token has expired.
+func (m *Domain) GetGameSubscriptionInstanceRecByTurnSheetToken(turnSheetToken string) (*game_record.GameSubscriptionInstance, error) {
+	l := m.Logger("GetGameSubscriptionInstanceRecByTurnSheetToken")
+
+	if err := domain.ValidateUUIDField("turn_sheet_token", turnSheetToken); err != nil {
+		return nil, err
+	}
+
+	recs, err := m.GetManyGameSubscriptionInstanceRecs(&sql.Options{
+		Params: []sql.Param{
+			{Col: game_record.FieldGameSubscriptionInstanceTurnSheetToken, Val: turnSheetToken},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		l.Warn("failed to get game subscription instance by turn sheet token >%v<", err)
+		return nil, err
+	}
+
+	if len(recs) == 0 {
+		return nil, nil
+	}
+
+	rec := recs[0]
+	if !nulltime.IsValid(rec.TurnSheetTokenExpiresAt) || time.Now().After(nulltime.ToTime(rec.TurnSheetTokenExpiresAt)) {
+		l.Info("turn sheet token for game subscription instance >%s< has expired", rec.ID)
+		return nil, nil
+	}
+
+	return rec, nil
+}
+
 // GetGameSubscriptionInstanceRecFromCodeData retrieves a game subscription instance from a turn sheet identifier.
 func (m *Domain) GetGameSubscriptionInstanceRecFromCodeData(turnSheetCodeData *turnsheetutil.PlayGameTurnSheetCodeData) (*game_record.GameSubscriptionInstance, error) {
 	l := m.Logger("GetGameSubscriptionInstanceRecFromCodeData")
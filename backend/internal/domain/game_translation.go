@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+// GetManyGameTranslationRecs -
+func (m *Domain) GetManyGameTranslationRecs(opts *coresql.Options) ([]*game_record.GameTranslation, error) {
+	l := m.Logger("GetManyGameTranslationRecs")
+
+	l.Debug("getting many game translation records opts >%#v<", opts)
+
+	r := m.GameTranslationRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+// GetGameTranslationRec -
+func (m *Domain) GetGameTranslationRec(recID string, lock *coresql.Lock) (*game_record.GameTranslation, error) {
+	l := m.Logger("GetGameTranslationRec")
+
+	l.Debug("getting game translation record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.GameTranslationRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(game_record.TableGameTranslation, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// CreateGameTranslationRec -
+func (m *Domain) CreateGameTranslationRec(rec *game_record.GameTranslation) (*game_record.GameTranslation, error) {
+	l := m.Logger("CreateGameTranslationRec")
+
+	l.Debug("creating game translation record >%#v<", rec)
+
+	if err := m.validateGameTranslationRecForCreate(rec); err != nil {
+		l.Warn("failed to validate game translation record >%v<", err)
+		return rec, err
+	}
+
+	r := m.GameTranslationRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// UpdateGameTranslationRec -
+func (m *Domain) UpdateGameTranslationRec(rec *game_record.GameTranslation) (*game_record.GameTranslation, error) {
+	l := m.Logger("UpdateGameTranslationRec")
+
+	currRec, err := m.GetGameTranslationRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating game translation record >%#v<", rec)
+
+	if err := m.validateGameTranslationRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate game translation record >%v<", err)
+		return rec, err
+	}
+
+	r := m.GameTranslationRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+// DeleteGameTranslationRec -
+func (m *Domain) DeleteGameTranslationRec(recID string) error {
+	l := m.Logger("DeleteGameTranslationRec")
+
+	l.Debug("deleting game translation record ID >%s<", recID)
+
+	_, err := m.GetGameTranslationRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.GameTranslationRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// RemoveGameTranslationRec -
+func (m *Domain) RemoveGameTranslationRec(recID string) error {
+	l := m.Logger("RemoveGameTranslationRec")
+
+	l.Debug("removing game translation record ID >%s<", recID)
+
+	r := m.GameTranslationRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// GameTranslator replaces the text of game content records with a game's
+// translations into a single locale. A nil translator returns text unchanged.
+type GameTranslator struct {
+	locale string
+	texts  map[string]string
+}
+
+// GetGameTranslator returns a translator for a game's content in a locale
+func (m *Domain) GetGameTranslator(gameID, locale string) (*GameTranslator, error) {
+	l := m.Logger("GetGameTranslator")
+
+	locale = i18n.NormalizeLocale(locale)
+
+	recs, err := m.GetManyGameTranslationRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: game_record.FieldGameTranslationGameID, Val: gameID},
+			{Col: game_record.FieldGameTranslationLocale, Val: locale},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get game >%s< translations for locale >%s< >%v<", gameID, locale, err)
+		return nil, err
+	}
+
+	t := &GameTranslator{
+		locale: locale,
+		texts:  make(map[string]string, len(recs)),
+	}
+	for _, rec := range recs {
+		t.texts[gameTranslationKey(rec.RecordID, rec.FieldName)] = rec.Text
+	}
+
+	return t, nil
+}
+
+// Locale returns the locale content is translated into
+func (t *GameTranslator) Locale() string {
+	if t == nil {
+		return i18n.DefaultLocale
+	}
+	return t.locale
+}
+
+// Text returns the translation of a field of a game content record, or text when
+// the field has not been translated
+func (t *GameTranslator) Text(recordID, fieldName, text string) string {
+	if t == nil {
+		return text
+	}
+	if translated, ok := t.texts[gameTranslationKey(recordID, fieldName)]; ok {
+		return translated
+	}
+	return text
+}
+
+func gameTranslationKey(recordID, fieldName string) string {
+	return recordID + "/" + fieldName
+}
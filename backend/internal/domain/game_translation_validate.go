@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/collection/set"
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+// GameTranslationFieldNames are the game content record fields designers may translate
+var GameTranslationFieldNames = set.New(
+	game_record.GameTranslationFieldNameName,
+	game_record.GameTranslationFieldNameDescription,
+)
+
+type validateGameTranslationArgs struct {
+	nextRec *game_record.GameTranslation
+	currRec *game_record.GameTranslation
+	gameRec *game_record.Game
+}
+
+func (m *Domain) populateGameTranslationValidateArgs(currRec, nextRec *game_record.GameTranslation) (*validateGameTranslationArgs, error) {
+	args := &validateGameTranslationArgs{
+		currRec: currRec,
+		nextRec: nextRec,
+	}
+
+	if nextRec != nil && nextRec.GameID != "" {
+		gameRec, err := m.GetGameRec(nextRec.GameID, nil)
+		if err != nil {
+			return nil, err
+		}
+		args.gameRec = gameRec
+	}
+
+	return args, nil
+}
+
+func (m *Domain) validateGameTranslationRecForCreate(rec *game_record.GameTranslation) error {
+	args, err := m.populateGameTranslationValidateArgs(nil, rec)
+	if err != nil {
+		return err
+	}
+	return validateGameTranslationRecForCreate(args)
+}
+
+func (m *Domain) validateGameTranslationRecForUpdate(currRec, nextRec *game_record.GameTranslation) error {
+	args, err := m.populateGameTranslationValidateArgs(currRec, nextRec)
+	if err != nil {
+		return err
+	}
+	return validateGameTranslationRecForUpdate(args)
+}
+
+func validateGameTranslationRecForCreate(args *validateGameTranslationArgs) error {
+	return validateGameTranslationRec(args, false)
+}
+
+func validateGameTranslationRecForUpdate(args *validateGameTranslationArgs) error {
+	if err := validateGameTranslationRec(args, true); err != nil {
+		return err
+	}
+
+	// A translation always replaces the same field of the same record
+	if args.currRec.GameID != args.nextRec.GameID {
+		return InvalidField(game_record.FieldGameTranslationGameID, args.nextRec.GameID, "game_id cannot be changed")
+	}
+	if args.currRec.RecordID != args.nextRec.RecordID {
+		return InvalidField(game_record.FieldGameTranslationRecordID, args.nextRec.RecordID, "record_id cannot be changed")
+	}
+
+	return nil
+}
+
+func validateGameTranslationRec(args *validateGameTranslationArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(game_record.FieldGameTranslationID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(game_record.FieldGameTranslationGameID, rec.GameID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(game_record.FieldGameTranslationRecordID, rec.RecordID); err != nil {
+		return err
+	}
+
+	if !i18n.IsSupportedLocale(rec.Locale) {
+		return InvalidField(game_record.FieldGameTranslationLocale, rec.Locale, "locale is not supported")
+	}
+
+	if !GameTranslationFieldNames.Has(rec.FieldName) {
+		return InvalidField(game_record.FieldGameTranslationFieldName, rec.FieldName, "field cannot be translated")
+	}
+
+	if err := domain.ValidateStringField(game_record.FieldGameTranslationText, rec.Text); err != nil {
+		return err
+	}
+
+	return nil
+}
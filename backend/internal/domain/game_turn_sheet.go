@@ -127,6 +127,17 @@ func (m *Domain) RemoveGameTurnSheetRec(recID string) error {
 
 	l.Debug("removing game_turn_sheet record ID >%s<", recID)
 
+	// Remove the retained scan of the turn sheet
+	scanRec, err := m.GetGameTurnSheetScanRecByTurnSheet(recID)
+	if err != nil {
+		return err
+	}
+	if scanRec != nil {
+		if err := m.RemoveGameTurnSheetScanRec(scanRec.ID); err != nil {
+			return err
+		}
+	}
+
 	r := m.GameTurnSheetRepository()
 
 	if err := r.RemoveOne(recID); err != nil {
@@ -186,6 +197,87 @@ func (m *Domain) GetGameTurnSheetRecsByAccount(accountID string) ([]*game_record
 	return recs, nil
 }
 
+// GetOpenGameTurnSheetRecsByAccountUser retrieves the turn sheets an account user has not
+// yet completed for the current turn of their started game instances
+func (m *Domain) GetOpenGameTurnSheetRecsByAccountUser(accountUserID string) ([]*game_record.GameTurnSheet, error) {
+	l := m.Logger("GetOpenGameTurnSheetRecsByAccountUser")
+
+	l.Debug("getting open game_turn_sheet records for account_user_id >%s<", accountUserID)
+
+	r := m.GameTurnSheetRepository()
+
+	recs, err := r.GetMany(&coresql.Options{
+		Params: []coresql.Param{
+			{
+				Col: game_record.FieldGameTurnSheetAccountUserID,
+				Val: accountUserID,
+			},
+			{
+				Col: game_record.FieldGameTurnSheetIsCompleted,
+				Val: false,
+			},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: game_record.FieldGameTurnSheetSheetOrder, Direction: coresql.OrderDirectionASC},
+		},
+	})
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	gameInstanceRecs := map[string]*game_record.GameInstance{}
+
+	var openRecs []*game_record.GameTurnSheet
+	for _, rec := range recs {
+		if !rec.GameInstanceID.Valid {
+			continue
+		}
+
+		gameInstanceRec, ok := gameInstanceRecs[rec.GameInstanceID.String]
+		if !ok {
+			gameInstanceRec, err = m.GetGameInstanceRec(rec.GameInstanceID.String, nil)
+			if err != nil {
+				return nil, err
+			}
+			gameInstanceRecs[rec.GameInstanceID.String] = gameInstanceRec
+		}
+
+		if gameInstanceRec.Status != game_record.GameInstanceStatusStarted || rec.TurnNumber != gameInstanceRec.CurrentTurn {
+			continue
+		}
+
+		openRecs = append(openRecs, rec)
+	}
+
+	return openRecs, nil
+}
+
+// IsGameInstanceTurnSubmitted reports whether every turn sheet for the current turn of a
+// game instance has been completed
+func (m *Domain) IsGameInstanceTurnSubmitted(gameInstanceRec *game_record.GameInstance) (bool, error) {
+	l := m.Logger("IsGameInstanceTurnSubmitted")
+
+	recs, err := m.GetGameTurnSheetRecsByGameInstance(gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
+	if err != nil {
+		return false, err
+	}
+
+	if len(recs) == 0 {
+		return false, nil
+	}
+
+	for _, rec := range recs {
+		if !rec.IsCompleted {
+			l.Debug("turn sheet >%s< not yet completed for instance >%s< turn >%d<", rec.ID, gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
+			return false, nil
+		}
+	}
+
+	l.Info("all >%d< turn sheets completed for instance >%s< turn >%d<", len(recs), gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
+
+	return true, nil
+}
+
 // MarkGameTurnSheetAsScanned marks a turn sheet as scanned
 func (m *Domain) MarkGameTurnSheetAsScanned(turnSheetID string, scannedBy string) error {
 	l := m.Logger("MarkGameTurnSheetAsScanned")
@@ -0,0 +1,242 @@
+package domain
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+// GetManyGameTurnSheetScanRecs -
+func (m *Domain) GetManyGameTurnSheetScanRecs(opts *coresql.Options) ([]*game_record.GameTurnSheetScan, error) {
+	l := m.Logger("GetManyGameTurnSheetScanRecs")
+
+	l.Debug("getting many game_turn_sheet_scan records opts >%#v<", opts)
+
+	r := m.GameTurnSheetScanRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+// GetGameTurnSheetScanRec -
+func (m *Domain) GetGameTurnSheetScanRec(recID string, lock *coresql.Lock) (*game_record.GameTurnSheetScan, error) {
+	l := m.Logger("GetGameTurnSheetScanRec")
+
+	l.Debug("getting game_turn_sheet_scan record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.GameTurnSheetScanRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(game_record.TableGameTurnSheetScan, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// CreateGameTurnSheetScanRec -
+func (m *Domain) CreateGameTurnSheetScanRec(rec *game_record.GameTurnSheetScan) (*game_record.GameTurnSheetScan, error) {
+	l := m.Logger("CreateGameTurnSheetScanRec")
+
+	l.Debug("creating game_turn_sheet_scan record for turn sheet >%s<", rec.GameTurnSheetID)
+
+	if len(rec.Issues) == 0 {
+		rec.Issues = json.RawMessage("[]")
+	}
+
+	if err := m.validateGameTurnSheetScanRecForCreate(rec); err != nil {
+		l.Warn("failed to validate game_turn_sheet_scan record >%v<", err)
+		return rec, err
+	}
+
+	r := m.GameTurnSheetScanRepository()
+
+	rec, err := r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// UpdateGameTurnSheetScanRec -
+func (m *Domain) UpdateGameTurnSheetScanRec(rec *game_record.GameTurnSheetScan) (*game_record.GameTurnSheetScan, error) {
+	l := m.Logger("UpdateGameTurnSheetScanRec")
+
+	currRec, err := m.GetGameTurnSheetScanRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating game_turn_sheet_scan record ID >%s<", rec.ID)
+
+	if err := m.validateGameTurnSheetScanRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate game_turn_sheet_scan record >%v<", err)
+		return rec, err
+	}
+
+	r := m.GameTurnSheetScanRepository()
+
+	rec, err = r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+// DeleteGameTurnSheetScanRec -
+func (m *Domain) DeleteGameTurnSheetScanRec(recID string) error {
+	l := m.Logger("DeleteGameTurnSheetScanRec")
+
+	l.Debug("deleting game_turn_sheet_scan record ID >%s<", recID)
+
+	_, err := m.GetGameTurnSheetScanRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.GameTurnSheetScanRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// RemoveGameTurnSheetScanRec -
+func (m *Domain) RemoveGameTurnSheetScanRec(recID string) error {
+	l := m.Logger("RemoveGameTurnSheetScanRec")
+
+	l.Debug("removing game_turn_sheet_scan record ID >%s<", recID)
+
+	r := m.GameTurnSheetScanRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+// GetGameTurnSheetScanRecByTurnSheet returns the scan of a turn sheet, or nil when the
+// turn sheet has not been scanned
+func (m *Domain) GetGameTurnSheetScanRecByTurnSheet(turnSheetID string) (*game_record.GameTurnSheetScan, error) {
+	recs, err := m.GetManyGameTurnSheetScanRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: game_record.FieldGameTurnSheetScanGameTurnSheetID, Val: turnSheetID},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(recs) == 0 {
+		return nil, nil
+	}
+
+	return recs[0], nil
+}
+
+// UpsertGameTurnSheetScanRec records the image a turn sheet was scanned from with the
+// confidence and issues found checking the scanned data, replacing any earlier scan
+// of the turn sheet
+func (m *Domain) UpsertGameTurnSheetScanRec(turnSheetID string, imageData []byte, confidence float64, issues json.RawMessage) (*game_record.GameTurnSheetScan, error) {
+	l := m.Logger("UpsertGameTurnSheetScanRec")
+
+	rec, err := m.GetGameTurnSheetScanRecByTurnSheet(turnSheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rec == nil {
+		l.Debug("creating scan for turn sheet >%s< confidence >%g<", turnSheetID, confidence)
+		return m.CreateGameTurnSheetScanRec(&game_record.GameTurnSheetScan{
+			GameTurnSheetID: turnSheetID,
+			ImageData:       imageData,
+			Confidence:      confidence,
+			Issues:          issues,
+		})
+	}
+
+	l.Debug("replacing scan >%s< for turn sheet >%s< confidence >%g<", rec.ID, turnSheetID, confidence)
+
+	rec.ImageData = imageData
+	rec.Confidence = confidence
+	rec.Issues = issues
+	rec.ReviewedByAccountUserID = sql.NullString{}
+	rec.ReviewedAt = sql.NullTime{}
+
+	return m.UpdateGameTurnSheetScanRec(rec)
+}
+
+// GetGameTurnSheetRecsForReview returns the turn sheets of a game instance with scanned
+// data waiting on a manager to review it
+func (m *Domain) GetGameTurnSheetRecsForReview(gameInstanceID string) ([]*game_record.GameTurnSheet, error) {
+	return m.GetManyGameTurnSheetRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: game_record.FieldGameTurnSheetGameInstanceID, Val: gameInstanceID},
+			{Col: game_record.FieldGameTurnSheetProcessingStatus, Val: game_record.TurnSheetProcessingStatusReview},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: game_record.FieldGameTurnSheetTurnNumber, Direction: coresql.OrderDirectionASC},
+			{Col: game_record.FieldGameTurnSheetScannedAt, Direction: coresql.OrderDirectionASC},
+		},
+	})
+}
+
+// ApproveGameTurnSheetReview accepts the scanned data of a turn sheet held for review,
+// completing the turn sheet and recording the manager who reviewed the scan
+func (m *Domain) ApproveGameTurnSheetReview(turnSheetRec *game_record.GameTurnSheet, accountUserID string) (*game_record.GameTurnSheet, error) {
+	l := m.Logger("ApproveGameTurnSheetReview")
+
+	if turnSheetRec.ProcessingStatus != game_record.TurnSheetProcessingStatusReview {
+		return nil, coreerror.NewInvalidDataError("turn sheet is not waiting on review")
+	}
+
+	l.Info("approving turn sheet >%s< reviewed by account user >%s<", turnSheetRec.ID, accountUserID)
+
+	now := time.Now()
+
+	scanRec, err := m.GetGameTurnSheetScanRecByTurnSheet(turnSheetRec.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if scanRec != nil {
+		scanRec.ReviewedByAccountUserID = nullstring.FromString(accountUserID)
+		scanRec.ReviewedAt = nulltime.FromTime(now)
+		if _, err := m.UpdateGameTurnSheetScanRec(scanRec); err != nil {
+			return nil, err
+		}
+	}
+
+	turnSheetRec.ProcessingStatus = game_record.TurnSheetProcessingStatusProcessed
+	turnSheetRec.ErrorMessage = sql.NullString{}
+	turnSheetRec.IsCompleted = true
+	turnSheetRec.CompletedAt = nulltime.FromTime(now)
+
+	return m.UpdateGameTurnSheetRec(turnSheetRec)
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+type validateGameTurnSheetScanArgs struct {
+	nextRec *game_record.GameTurnSheetScan
+	currRec *game_record.GameTurnSheetScan
+}
+
+func (m *Domain) populateGameTurnSheetScanValidateArgs(currRec, nextRec *game_record.GameTurnSheetScan) (*validateGameTurnSheetScanArgs, error) {
+	args := &validateGameTurnSheetScanArgs{
+		currRec: currRec,
+		nextRec: nextRec,
+	}
+	return args, nil
+}
+
+func (m *Domain) validateGameTurnSheetScanRecForCreate(rec *game_record.GameTurnSheetScan) error {
+	args, err := m.populateGameTurnSheetScanValidateArgs(nil, rec)
+	if err != nil {
+		return err
+	}
+	return validateGameTurnSheetScanRecForCreate(args)
+}
+
+func (m *Domain) validateGameTurnSheetScanRecForUpdate(currRec, nextRec *game_record.GameTurnSheetScan) error {
+	args, err := m.populateGameTurnSheetScanValidateArgs(currRec, nextRec)
+	if err != nil {
+		return err
+	}
+	return validateGameTurnSheetScanRecForUpdate(args)
+}
+
+func validateGameTurnSheetScanRecForCreate(args *validateGameTurnSheetScanArgs) error {
+	return validateGameTurnSheetScanRec(args, false)
+}
+
+func validateGameTurnSheetScanRecForUpdate(args *validateGameTurnSheetScanArgs) error {
+	if err := validateGameTurnSheetScanRec(args, true); err != nil {
+		return err
+	}
+
+	if args.nextRec.GameTurnSheetID != args.currRec.GameTurnSheetID {
+		return InvalidField(game_record.FieldGameTurnSheetScanGameTurnSheetID, args.nextRec.GameTurnSheetID, "game_turn_sheet_id cannot be changed")
+	}
+
+	return nil
+}
+
+func validateGameTurnSheetScanRec(args *validateGameTurnSheetScanArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(game_record.FieldGameTurnSheetScanID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(game_record.FieldGameTurnSheetScanGameTurnSheetID, rec.GameTurnSheetID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateByteSliceField(game_record.FieldGameTurnSheetScanImageData, rec.ImageData); err != nil {
+		return err
+	}
+
+	if rec.Confidence < 0 || rec.Confidence > 1 {
+		return InvalidField(
+			game_record.FieldGameTurnSheetScanConfidence,
+			fmt.Sprintf("%g", rec.Confidence),
+			"confidence must be between 0 and 1",
+		)
+	}
+
+	var issues []any
+	if err := json.Unmarshal(rec.Issues, &issues); err != nil {
+		return InvalidField(game_record.FieldGameTurnSheetScanIssues, string(rec.Issues), "issues must be a JSON array")
+	}
+
+	if nullstring.IsValid(rec.ReviewedByAccountUserID) {
+		if err := domain.ValidateNullUUIDField(game_record.FieldGameTurnSheetScanReviewedByAccountUserID, rec.ReviewedByAccountUserID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
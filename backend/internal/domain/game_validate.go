@@ -5,9 +5,11 @@ import (
 
 	"gitlab.com/alienspaces/playbymail/core/domain"
 	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
 )
 
 const (
@@ -19,6 +21,30 @@ type GameValidationIssue struct {
 	Field    string `json:"field"`
 	Message  string `json:"message"`
 	Severity string `json:"severity"`
+
+	// Catalogue message the issue is localised from
+	MessageKey  string `json:"-"`
+	MessageArgs []any  `json:"-"`
+}
+
+// newGameValidationIssue returns an issue with a catalogue message, with Message
+// set to the English text. Arguments are pairs of placeholder name and value.
+func newGameValidationIssue(field, severity, key string, args ...any) GameValidationIssue {
+	return GameValidationIssue{
+		Field:       field,
+		Message:     i18n.T(i18n.DefaultLocale, key, args...),
+		Severity:    severity,
+		MessageKey:  key,
+		MessageArgs: args,
+	}
+}
+
+// Localize returns the issue with its message in a locale
+func (i GameValidationIssue) Localize(locale string) GameValidationIssue {
+	if i.MessageKey != "" {
+		i.Message = i18n.T(locale, i.MessageKey, i.MessageArgs...)
+	}
+	return i
 }
 
 // ValidateGameReadyForInstance checks whether a game is ready to create an
@@ -47,6 +73,12 @@ func (m *Domain) ValidateGameReadyForInstance(gameID string) ([]GameValidationIs
 			l.Warn("failed validating mecha game >%s< >%v<", gameID, err)
 			return nil, err
 		}
+	case game_record.GameTypeMechaTactics:
+		issues, err = m.validateMechaTacticsGameReadyForInstance(gameID)
+		if err != nil {
+			l.Warn("failed validating mecha tactics game >%s< >%v<", gameID, err)
+			return nil, err
+		}
 	}
 
 	return issues, nil
@@ -66,11 +98,7 @@ func (m *Domain) validateAdventureGameReadyForInstance(gameID string) ([]GameVal
 	}
 
 	if len(locationRecs) == 0 {
-		issues = append(issues, GameValidationIssue{
-			Field:    "locations",
-			Message:  "Adventure game must have at least one location",
-			Severity: ValidationSeverityError,
-		})
+		issues = append(issues, newGameValidationIssue("locations", ValidationSeverityError, "validation.adventure.no_locations"))
 		return issues, nil
 	}
 
@@ -86,11 +114,7 @@ func (m *Domain) validateAdventureGameReadyForInstance(gameID string) ([]GameVal
 	}
 
 	if len(startingLocationRecs) == 0 {
-		issues = append(issues, GameValidationIssue{
-			Field:    "starting_location",
-			Message:  "Adventure game must have at least one starting location before creating an instance",
-			Severity: ValidationSeverityError,
-		})
+		issues = append(issues, newGameValidationIssue("starting_location", ValidationSeverityError, "validation.adventure.no_starting_location"))
 	}
 
 	stateIssues, err := m.validateAdventureGameObjectStateGraph(gameID)
@@ -99,6 +123,16 @@ func (m *Domain) validateAdventureGameReadyForInstance(gameID string) ([]GameVal
 	}
 	issues = append(issues, stateIssues...)
 
+	// Without a starting location nothing is reachable, so reachability issues
+	// would only repeat the missing starting location
+	if len(startingLocationRecs) > 0 {
+		def, err := m.getAdventureGameDefinition(gameID)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, analyseAdventureGame(def).issues()...)
+	}
+
 	return issues, nil
 }
 
@@ -187,11 +221,7 @@ func (m *Domain) validateAdventureGameObjectStateGraph(gameID string) ([]GameVal
 
 		// Check: object has states but no initial state.
 		if !obj.InitialAdventureGameLocationObjectStateID.Valid {
-			issues = append(issues, GameValidationIssue{
-				Field:    "location_objects",
-				Message:  fmt.Sprintf("Object %q has states defined but no initial state is set", obj.Name),
-				Severity: ValidationSeverityWarning,
-			})
+			issues = append(issues, newGameValidationIssue("location_objects", ValidationSeverityWarning, "validation.adventure.object_no_initial_state", "object", obj.Name))
 		}
 
 		// Build sets of state IDs that are reachable (can be entered) or required
@@ -242,11 +272,7 @@ func (m *Domain) validateAdventureGameObjectStateGraph(gameID string) ([]GameVal
 			// cross-object effect). This still fires even for objects with cross-object
 			// effects, because an unreachable state is always a configuration mistake.
 			if !reachableStateIDs[s.ID] {
-				issues = append(issues, GameValidationIssue{
-					Field:    "location_objects",
-					Message:  fmt.Sprintf("Object %q state %q is unreachable: it is not the initial state and no effect produces it", obj.Name, s.Name),
-					Severity: ValidationSeverityWarning,
-				})
+				issues = append(issues, newGameValidationIssue("location_objects", ValidationSeverityWarning, "validation.adventure.object_state_unreachable", "object", obj.Name, "state", s.Name))
 			}
 
 			// Warn about dead-end states: no own effect requires this state, meaning
@@ -256,11 +282,7 @@ func (m *Domain) validateAdventureGameObjectStateGraph(gameID string) ([]GameVal
 			//   - no cross-object effects target this object (external forces can still move it), and
 			//   - the object is not destructible (terminal state before removal is intentional).
 			if len(requiredStateIDs) > 0 && !requiredStateIDs[s.ID] && !hasInboundCrossEffects && !isDestructible {
-				issues = append(issues, GameValidationIssue{
-					Field:    "location_objects",
-					Message:  fmt.Sprintf("Object %q state %q is a dead-end: no effects require it, so players cannot interact further once the object reaches this state", obj.Name, s.Name),
-					Severity: ValidationSeverityWarning,
-				})
+				issues = append(issues, newGameValidationIssue("location_objects", ValidationSeverityWarning, "validation.adventure.object_state_dead_end", "object", obj.Name, "state", s.Name))
 			}
 		}
 	}
@@ -353,7 +375,9 @@ func validateGameRec(args *validateGameArgs) error {
 		return err
 	}
 
-	if rec.GameType != game_record.GameTypeAdventure && rec.GameType != game_record.GameTypeMecha {
+	if rec.GameType != game_record.GameTypeAdventure &&
+		rec.GameType != game_record.GameTypeMecha &&
+		rec.GameType != game_record.GameTypeMechaTactics {
 		return InvalidField(game_record.FieldGameType, rec.GameType, "game type is not valid")
 	}
 
@@ -396,11 +420,7 @@ func (m *Domain) validateMechaGameReadyForInstance(gameID string) ([]GameValidat
 	}
 
 	if len(sectorRecs) == 0 {
-		issues = append(issues, GameValidationIssue{
-			Field:    "sectors",
-			Message:  "Mecha game must have at least one sector before creating an instance",
-			Severity: ValidationSeverityError,
-		})
+		issues = append(issues, newGameValidationIssue("sectors", ValidationSeverityError, "validation.mecha.no_sectors"))
 		return issues, nil
 	}
 
@@ -416,11 +436,7 @@ func (m *Domain) validateMechaGameReadyForInstance(gameID string) ([]GameValidat
 	}
 
 	if len(startingSectorRecs) == 0 {
-		issues = append(issues, GameValidationIssue{
-			Field:    "starting_sector",
-			Message:  "Mecha game must have at least one starting sector before creating an instance",
-			Severity: ValidationSeverityError,
-		})
+		issues = append(issues, newGameValidationIssue("starting_sector", ValidationSeverityError, "validation.mecha.no_starting_sector"))
 	}
 
 	chassisRecs, err := m.GetManyMechaGameChassisRecs(&coresql.Options{
@@ -434,11 +450,7 @@ func (m *Domain) validateMechaGameReadyForInstance(gameID string) ([]GameValidat
 	}
 
 	if len(chassisRecs) == 0 {
-		issues = append(issues, GameValidationIssue{
-			Field:    "chassis",
-			Message:  "Mecha game must have at least one chassis defined before creating an instance",
-			Severity: ValidationSeverityError,
-		})
+		issues = append(issues, newGameValidationIssue("chassis", ValidationSeverityError, "validation.mecha.no_chassis"))
 	}
 
 	// Require exactly one player starter squad with at least one mech.
@@ -454,29 +466,115 @@ func (m *Domain) validateMechaGameReadyForInstance(gameID string) ([]GameValidat
 	}
 
 	if len(starterSquadRecs) == 0 {
-		issues = append(issues, GameValidationIssue{
-			Field:    "player_starter_squad",
-			Message:  "Mecha game must have a player starter squad defined",
-			Severity: ValidationSeverityError,
-		})
+		issues = append(issues, newGameValidationIssue("player_starter_squad", ValidationSeverityError, "validation.mecha.no_starter_squad"))
 	} else {
-		starterMechs, err := m.GetManyMechaGameSquadMechRecs(&coresql.Options{
-			Params: []coresql.Param{
-				{Col: mecha_game_record.FieldMechaGameSquadMechMechaGameSquadID, Val: starterSquadRecs[0].ID},
-			},
-			Limit: 1,
-		})
+		starterMechs, err := m.getMechaGameSquadMechRecsForSquad(starterSquadRecs[0].ID)
 		if err != nil {
 			return nil, err
 		}
 		if len(starterMechs) == 0 {
-			issues = append(issues, GameValidationIssue{
-				Field:    "player_starter_squad",
-				Message:  "Player starter squad must have at least one mech",
-				Severity: ValidationSeverityError,
-			})
+			issues = append(issues, newGameValidationIssue("player_starter_squad", ValidationSeverityError, "validation.mecha.starter_squad_no_mechs"))
+		} else {
+			// The starter squad plus the reserve squad should hold enough mechs to fill
+			// a squad of the default squad_size. Runs can set a smaller squad_size, so
+			// a shortfall is a warning; starting a run that cannot fill its squads fails.
+			squadSize, err := GetGameParameterIntegerDefault(game_record.GameTypeMecha, MechaGameParameterSquadSize)
+			if err != nil {
+				return nil, err
+			}
+
+			reserveMechs, err := m.getMechaGameReserveSquadMechRecs(gameID)
+			if err != nil {
+				return nil, err
+			}
+
+			if available := len(starterMechs) + len(reserveMechs); available < squadSize {
+				issues = append(issues, newGameValidationIssue("player_starter_squad", ValidationSeverityWarning,
+					"validation.mecha.squad_size",
+					"size", squadSize, "starter", len(starterMechs), "reserve", len(reserveMechs), "available", available,
+				))
+			}
 		}
 	}
 
 	return issues, nil
 }
+
+func (m *Domain) validateMechaTacticsGameReadyForInstance(gameID string) ([]GameValidationIssue, error) {
+	var issues []GameValidationIssue
+
+	chassisRecs, err := m.GetManyMechaTacticsGameChassisRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameChassisGameID, Val: gameID},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chassisRecs) == 0 {
+		issues = append(issues, newGameValidationIssue("chassis", ValidationSeverityError, "validation.mecha_tactics.no_chassis"))
+	}
+
+	terrainTypeRecs, err := m.GetManyMechaTacticsGameTerrainTypeRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameTerrainTypeGameID, Val: gameID},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(terrainTypeRecs) == 0 {
+		issues = append(issues, newGameValidationIssue("terrain_types", ValidationSeverityError, "validation.mecha_tactics.no_terrain_types"))
+	}
+
+	hexRecs, err := m.GetManyMechaTacticsGameHexRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameHexGameID, Val: gameID},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hexRecs) == 0 {
+		issues = append(issues, newGameValidationIssue("hexes", ValidationSeverityError, "validation.mecha_tactics.no_hexes"))
+		return issues, nil
+	}
+
+	startingHexRecs, err := m.GetManyMechaTacticsGameHexRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameHexGameID, Val: gameID},
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameHexIsStartingHex, Val: true},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(startingHexRecs) == 0 {
+		issues = append(issues, newGameValidationIssue("starting_hex", ValidationSeverityError, "validation.mecha_tactics.no_starting_hex"))
+	}
+
+	starterMechRecs, err := m.GetManyMechaTacticsGameMechRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechGameID, Val: gameID},
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechMechType, Val: mecha_tactics_game_record.MechTypeStarter},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(starterMechRecs) == 0 {
+		issues = append(issues, newGameValidationIssue("starter_mechs", ValidationSeverityError, "validation.mecha_tactics.no_starter_mechs"))
+	}
+
+	return issues, nil
+}
@@ -10,6 +10,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
 	"gitlab.com/alienspaces/playbymail/internal/utils/deps"
 )
@@ -316,6 +317,55 @@ func TestValidateGameReadyForInstance(t *testing.T) {
 					},
 				},
 			},
+			{
+				Reference: "mecha-game-short-squad",
+				Record: &game_record.Game{
+					Name:              harness.UniqueName("Short Squad Game"),
+					GameType:          game_record.GameTypeMecha,
+					TurnDurationHours: 168,
+				},
+				MechaGameChassisConfigs: []harness.MechaGameChassisConfig{
+					{
+						Reference: "short-squad-chassis",
+						Record: &mecha_game_record.MechaGameChassis{
+							Name:            harness.UniqueName("Short Squad Chassis"),
+							ChassisClass:    mecha_game_record.ChassisClassMedium,
+							ArmorPoints:     100,
+							StructurePoints: 50,
+							HeatCapacity:    30,
+							Speed:           4,
+						},
+					},
+				},
+				MechaGameSectorConfigs: []harness.MechaGameSectorConfig{
+					{
+						Reference: "short-squad-sector",
+						Record: &mecha_game_record.MechaGameSector{
+							Name:             harness.UniqueName("Short Squad Sector"),
+							TerrainType:      mecha_game_record.SectorTerrainTypeOpen,
+							IsStartingSector: true,
+						},
+					},
+				},
+				MechaGameSquadConfigs: []harness.MechaGameSquadConfig{
+					{
+						Reference: "short-squad-starter",
+						SquadType: mecha_game_record.SquadTypeStarter,
+						Record: &mecha_game_record.MechaGameSquad{
+							Name: harness.UniqueName("Short Starter Squad"),
+						},
+						SquadMechConfigs: []harness.MechaGameSquadMechConfig{
+							{
+								Reference:  "short-squad-starter-mech",
+								ChassisRef: "short-squad-chassis",
+								Record: &mecha_game_record.MechaGameSquadMech{
+									Callsign: "Short-1",
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		AccountConfigs: []harness.AccountConfig{
 			{
@@ -398,6 +448,27 @@ func TestValidateGameReadyForInstance(t *testing.T) {
 		require.True(t, foundStartingIssue, "should report missing starting location issue")
 	})
 
+	t.Run("returns warning when mecha starter and reserve squads cannot fill the default squad size", func(t *testing.T) {
+		gameID, ok := th.Data.Refs.GameRefs["mecha-game-short-squad"]
+		require.True(t, ok)
+
+		issues, err := m.ValidateGameReadyForInstance(gameID)
+		require.NoError(t, err)
+		require.NotEmpty(t, issues)
+
+		foundSquadIssue := false
+		for _, issue := range issues {
+			if issue.Field == "player_starter_squad" {
+				foundSquadIssue = true
+				// Runs can set a smaller squad_size, so the shortfall must not block instances
+				require.Equal(t, domain.ValidationSeverityWarning, issue.Severity, "squad size issue should be a warning")
+				require.Contains(t, issue.Message, "default to 4 mechs", "squad size issue should name the default squad size")
+				require.Contains(t, issue.Message, "squad_size to 1 or less", "squad size issue should name the largest squad size that can be filled")
+			}
+		}
+		require.True(t, foundSquadIssue, "should report insufficient squad mechs issue")
+	})
+
 	t.Run("returns error for non-existent game", func(t *testing.T) {
 		_, err := m.ValidateGameReadyForInstance("00000000-0000-0000-0000-000000000000")
 		require.Error(t, err)
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+func (m *Domain) GetManyMechaGameCampaignRecs(opts *coresql.Options) ([]*mecha_game_record.MechaGameCampaign, error) {
+	l := m.Logger("GetManyMechaGameCampaignRecs")
+
+	l.Debug("getting many mecha_game_campaign records opts >%#v<", opts)
+
+	r := m.MechaGameCampaignRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+func (m *Domain) GetMechaGameCampaignRec(recID string, lock *coresql.Lock) (*mecha_game_record.MechaGameCampaign, error) {
+	l := m.Logger("GetMechaGameCampaignRec")
+
+	l.Debug("getting mecha_game_campaign record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.MechaGameCampaignRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(mecha_game_record.TableMechaGameCampaign, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) CreateMechaGameCampaignRec(rec *mecha_game_record.MechaGameCampaign) (*mecha_game_record.MechaGameCampaign, error) {
+	l := m.Logger("CreateMechaGameCampaignRec")
+
+	l.Debug("creating mecha_game_campaign record >%#v<", rec)
+
+	if err := m.validateMechaGameCampaignRecForCreate(rec); err != nil {
+		l.Warn("failed to validate mecha_game_campaign record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaGameCampaignRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) UpdateMechaGameCampaignRec(rec *mecha_game_record.MechaGameCampaign) (*mecha_game_record.MechaGameCampaign, error) {
+	l := m.Logger("UpdateMechaGameCampaignRec")
+
+	currRec, err := m.GetMechaGameCampaignRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating mecha_game_campaign record >%#v<", rec)
+
+	if err := m.validateMechaGameCampaignRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate mecha_game_campaign record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaGameCampaignRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+func (m *Domain) DeleteMechaGameCampaignRec(recID string) error {
+	l := m.Logger("DeleteMechaGameCampaignRec")
+
+	l.Debug("deleting mecha_game_campaign record ID >%s<", recID)
+
+	_, err := m.GetMechaGameCampaignRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	campaignInstanceRecs, err := m.GetMechaGameCampaignInstanceRecsByCampaign(recID)
+	if err != nil {
+		return err
+	}
+	if len(campaignInstanceRecs) > 0 {
+		l.Warn("mecha game campaign >%s< has >%d< battles, cannot be deleted", recID, len(campaignInstanceRecs))
+		return coreerror.NewInvalidActionError("delete", "campaign can only be deleted when it has no battles")
+	}
+
+	r := m.MechaGameCampaignRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+func (m *Domain) RemoveMechaGameCampaignRec(recID string) error {
+	l := m.Logger("RemoveMechaGameCampaignRec")
+
+	l.Debug("removing mecha_game_campaign record ID >%s<", recID)
+
+	r := m.MechaGameCampaignRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+func (m *Domain) GetManyMechaGameCampaignInstanceRecs(opts *coresql.Options) ([]*mecha_game_record.MechaGameCampaignInstance, error) {
+	l := m.Logger("GetManyMechaGameCampaignInstanceRecs")
+
+	l.Debug("getting many mecha_game_campaign_instance records opts >%#v<", opts)
+
+	r := m.MechaGameCampaignInstanceRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+func (m *Domain) GetMechaGameCampaignInstanceRec(recID string, lock *coresql.Lock) (*mecha_game_record.MechaGameCampaignInstance, error) {
+	l := m.Logger("GetMechaGameCampaignInstanceRec")
+
+	l.Debug("getting mecha_game_campaign_instance record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.MechaGameCampaignInstanceRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(mecha_game_record.TableMechaGameCampaignInstance, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) CreateMechaGameCampaignInstanceRec(rec *mecha_game_record.MechaGameCampaignInstance) (*mecha_game_record.MechaGameCampaignInstance, error) {
+	l := m.Logger("CreateMechaGameCampaignInstanceRec")
+
+	l.Debug("creating mecha_game_campaign_instance record >%#v<", rec)
+
+	if err := m.validateMechaGameCampaignInstanceRecForCreate(rec); err != nil {
+		l.Warn("failed to validate mecha_game_campaign_instance record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaGameCampaignInstanceRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) UpdateMechaGameCampaignInstanceRec(rec *mecha_game_record.MechaGameCampaignInstance) (*mecha_game_record.MechaGameCampaignInstance, error) {
+	l := m.Logger("UpdateMechaGameCampaignInstanceRec")
+
+	currRec, err := m.GetMechaGameCampaignInstanceRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating mecha_game_campaign_instance record >%#v<", rec)
+
+	if err := m.validateMechaGameCampaignInstanceRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate mecha_game_campaign_instance record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaGameCampaignInstanceRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+func (m *Domain) DeleteMechaGameCampaignInstanceRec(recID string) error {
+	l := m.Logger("DeleteMechaGameCampaignInstanceRec")
+
+	l.Debug("deleting mecha_game_campaign_instance record ID >%s<", recID)
+
+	rec, err := m.GetMechaGameCampaignInstanceRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	gameInstanceRec, err := m.GetGameInstanceRec(rec.GameInstanceID, nil)
+	if err != nil {
+		return err
+	}
+	if gameInstanceRec.Status != game_record.GameInstanceStatusCreated {
+		l.Warn("mecha game campaign battle cannot be removed in game instance status >%s<", gameInstanceRec.Status)
+		return coreerror.NewInvalidActionError("delete", "campaign battle can only be removed before it has started")
+	}
+
+	r := m.MechaGameCampaignInstanceRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+func (m *Domain) RemoveMechaGameCampaignInstanceRec(recID string) error {
+	l := m.Logger("RemoveMechaGameCampaignInstanceRec")
+
+	l.Debug("removing mecha_game_campaign_instance record ID >%s<", recID)
+
+	r := m.MechaGameCampaignInstanceRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+type validateMechaGameCampaignInstanceArgs struct {
+	currRec *mecha_game_record.MechaGameCampaignInstance
+	nextRec *mecha_game_record.MechaGameCampaignInstance
+}
+
+func (m *Domain) validateMechaGameCampaignInstanceRecForCreate(rec *mecha_game_record.MechaGameCampaignInstance) error {
+	args := &validateMechaGameCampaignInstanceArgs{nextRec: rec}
+	return validateMechaGameCampaignInstanceRec(args, false)
+}
+
+func (m *Domain) validateMechaGameCampaignInstanceRecForUpdate(currRec, nextRec *mecha_game_record.MechaGameCampaignInstance) error {
+	args := &validateMechaGameCampaignInstanceArgs{currRec: currRec, nextRec: nextRec}
+	return validateMechaGameCampaignInstanceRec(args, true)
+}
+
+func validateMechaGameCampaignInstanceRec(args *validateMechaGameCampaignInstanceArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameCampaignInstanceID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameCampaignInstanceMechaGameCampaignID, rec.MechaGameCampaignID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameCampaignInstanceGameInstanceID, rec.GameInstanceID); err != nil {
+		return err
+	}
+
+	if args.currRec != nil {
+		if args.currRec.MechaGameCampaignID != rec.MechaGameCampaignID {
+			return coreerror.NewInvalidDataError("mecha_game_campaign_id cannot be changed")
+		}
+		if args.currRec.GameInstanceID != rec.GameInstanceID {
+			return coreerror.NewInvalidDataError("game_instance_id cannot be changed")
+		}
+	}
+
+	if rec.SequenceNumber < 1 {
+		return coreerror.NewInvalidDataError("sequence_number must be at least 1, got %d", rec.SequenceNumber)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+func (m *Domain) GetManyMechaGameCampaignMechRecs(opts *coresql.Options) ([]*mecha_game_record.MechaGameCampaignMech, error) {
+	l := m.Logger("GetManyMechaGameCampaignMechRecs")
+
+	l.Debug("getting many mecha_game_campaign_mech records opts >%#v<", opts)
+
+	r := m.MechaGameCampaignMechRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+func (m *Domain) GetMechaGameCampaignMechRec(recID string, lock *coresql.Lock) (*mecha_game_record.MechaGameCampaignMech, error) {
+	l := m.Logger("GetMechaGameCampaignMechRec")
+
+	l.Debug("getting mecha_game_campaign_mech record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.MechaGameCampaignMechRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(mecha_game_record.TableMechaGameCampaignMech, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) CreateMechaGameCampaignMechRec(rec *mecha_game_record.MechaGameCampaignMech) (*mecha_game_record.MechaGameCampaignMech, error) {
+	l := m.Logger("CreateMechaGameCampaignMechRec")
+
+	l.Debug("creating mecha_game_campaign_mech record >%#v<", rec)
+
+	if err := m.validateMechaGameCampaignMechRecForCreate(rec); err != nil {
+		l.Warn("failed to validate mecha_game_campaign_mech record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaGameCampaignMechRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) UpdateMechaGameCampaignMechRec(rec *mecha_game_record.MechaGameCampaignMech) (*mecha_game_record.MechaGameCampaignMech, error) {
+	l := m.Logger("UpdateMechaGameCampaignMechRec")
+
+	currRec, err := m.GetMechaGameCampaignMechRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating mecha_game_campaign_mech record >%#v<", rec)
+
+	if err := m.validateMechaGameCampaignMechRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate mecha_game_campaign_mech record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaGameCampaignMechRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+func (m *Domain) DeleteMechaGameCampaignMechRec(recID string) error {
+	l := m.Logger("DeleteMechaGameCampaignMechRec")
+
+	l.Debug("deleting mecha_game_campaign_mech record ID >%s<", recID)
+
+	_, err := m.GetMechaGameCampaignMechRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.MechaGameCampaignMechRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+func (m *Domain) RemoveMechaGameCampaignMechRec(recID string) error {
+	l := m.Logger("RemoveMechaGameCampaignMechRec")
+
+	l.Debug("removing mecha_game_campaign_mech record ID >%s<", recID)
+
+	r := m.MechaGameCampaignMechRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
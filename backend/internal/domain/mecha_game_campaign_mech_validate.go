@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+type validateMechaGameCampaignMechArgs struct {
+	currRec *mecha_game_record.MechaGameCampaignMech
+	nextRec *mecha_game_record.MechaGameCampaignMech
+}
+
+func (m *Domain) validateMechaGameCampaignMechRecForCreate(rec *mecha_game_record.MechaGameCampaignMech) error {
+	args := &validateMechaGameCampaignMechArgs{nextRec: rec}
+	return validateMechaGameCampaignMechRec(args, false)
+}
+
+func (m *Domain) validateMechaGameCampaignMechRecForUpdate(currRec, nextRec *mecha_game_record.MechaGameCampaignMech) error {
+	args := &validateMechaGameCampaignMechArgs{currRec: currRec, nextRec: nextRec}
+	return validateMechaGameCampaignMechRec(args, true)
+}
+
+func validateMechaGameCampaignMechRec(args *validateMechaGameCampaignMechArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameCampaignMechID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameCampaignMechMechaGameCampaignSquadID, rec.MechaGameCampaignSquadID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateStringField(mecha_game_record.FieldMechaGameCampaignMechCallsign, rec.Callsign); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateStringField(mecha_game_record.FieldMechaGameCampaignMechChassisName, rec.ChassisName); err != nil {
+		return err
+	}
+
+	if rec.CurrentStructure < 1 {
+		return coreerror.NewInvalidDataError("current_structure must be at least 1 for a surviving mech, got %d", rec.CurrentStructure)
+	}
+
+	if rec.CurrentArmor < 0 {
+		return coreerror.NewInvalidDataError("current_armor must not be negative, got %d", rec.CurrentArmor)
+	}
+
+	if rec.ExperiencePoints < 0 {
+		return coreerror.NewInvalidDataError("experience_points must not be negative, got %d", rec.ExperiencePoints)
+	}
+
+	return nil
+}
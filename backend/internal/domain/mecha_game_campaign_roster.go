@@ -0,0 +1,710 @@
+package domain
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+// DecodeMechaGameSalvage decodes stored salvage. Empty input decodes to an
+// empty list.
+func DecodeMechaGameSalvage(raw json.RawMessage) ([]mecha_game_record.MechaGameSalvageItem, error) {
+	items := []mecha_game_record.MechaGameSalvageItem{}
+	if len(raw) == 0 {
+		return items, nil
+	}
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// EncodeMechaGameSalvage encodes salvage for storage.
+func EncodeMechaGameSalvage(items []mecha_game_record.MechaGameSalvageItem) (json.RawMessage, error) {
+	if items == nil {
+		items = []mecha_game_record.MechaGameSalvageItem{}
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(data), nil
+}
+
+// AddMechaGameSalvage adds a quantity of a weapon to salvage. Items are kept
+// ordered by weapon name.
+func AddMechaGameSalvage(items []mecha_game_record.MechaGameSalvageItem, weaponName string, quantity int) []mecha_game_record.MechaGameSalvageItem {
+	if weaponName == "" || quantity < 1 {
+		return items
+	}
+
+	for i := range items {
+		if items[i].WeaponName == weaponName {
+			items[i].Quantity += quantity
+			return items
+		}
+	}
+
+	items = append(items, mecha_game_record.MechaGameSalvageItem{WeaponName: weaponName, Quantity: quantity})
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].WeaponName < items[j].WeaponName
+	})
+
+	return items
+}
+
+// TakeMechaGameSalvage removes one of a weapon from salvage. Returns false
+// when the weapon is not held.
+func TakeMechaGameSalvage(items []mecha_game_record.MechaGameSalvageItem, weaponName string) ([]mecha_game_record.MechaGameSalvageItem, bool) {
+	for i := range items {
+		if items[i].WeaponName != weaponName {
+			continue
+		}
+		if items[i].Quantity <= 1 {
+			return append(items[:i:i], items[i+1:]...), true
+		}
+		items[i].Quantity--
+		return items, true
+	}
+	return items, false
+}
+
+// MechaGameBattleSalvage returns the salvage each squad instance earns from a
+// battle: every weapon mounted on an enemy mech the squad destroyed.
+func MechaGameBattleSalvage(
+	mechInstanceRecs []*mecha_game_record.MechaGameMechInstance,
+	weaponByID map[string]*mecha_game_record.MechaGameWeapon,
+) (map[string][]mecha_game_record.MechaGameSalvageItem, error) {
+	salvage := make(map[string][]mecha_game_record.MechaGameSalvageItem)
+
+	for _, mechInstanceRec := range mechInstanceRecs {
+		if mechInstanceRec.Status != mecha_game_record.MechInstanceStatusDestroyed || !mechInstanceRec.DestroyedBySquadInstanceID.Valid {
+			continue
+		}
+		squadInstanceID := mechInstanceRec.DestroyedBySquadInstanceID.String
+		if squadInstanceID == mechInstanceRec.MechaGameSquadInstanceID {
+			continue
+		}
+
+		var weaponConfig []mecha_game_record.WeaponConfigEntry
+		if len(mechInstanceRec.WeaponConfigJSON) > 0 {
+			if err := json.Unmarshal(mechInstanceRec.WeaponConfigJSON, &weaponConfig); err != nil {
+				return nil, fmt.Errorf("unmarshal weapon_config for mech instance >%s<: %w", mechInstanceRec.ID, err)
+			}
+		}
+		for _, entry := range weaponConfig {
+			if w := weaponByID[entry.WeaponID]; w != nil {
+				salvage[squadInstanceID] = AddMechaGameSalvage(salvage[squadInstanceID], w.Name, 1)
+			}
+		}
+	}
+
+	return salvage, nil
+}
+
+// mechaGameCatalog is a mecha game's chassis, weapons and equipment indexed
+// by ID and by name. Campaign rosters hold names so a mech can be carried
+// between games that share a catalog.
+type mechaGameCatalog struct {
+	chassisByID     map[string]*mecha_game_record.MechaGameChassis
+	chassisByName   map[string]*mecha_game_record.MechaGameChassis
+	weaponByID      map[string]*mecha_game_record.MechaGameWeapon
+	weaponByName    map[string]*mecha_game_record.MechaGameWeapon
+	equipmentByID   map[string]*mecha_game_record.MechaGameEquipment
+	equipmentByName map[string]*mecha_game_record.MechaGameEquipment
+}
+
+// newMechaGameCatalog indexes a game's chassis, weapons and equipment.
+func newMechaGameCatalog(
+	chassisRecs []*mecha_game_record.MechaGameChassis,
+	weaponRecs []*mecha_game_record.MechaGameWeapon,
+	equipmentRecs []*mecha_game_record.MechaGameEquipment,
+) *mechaGameCatalog {
+	c := &mechaGameCatalog{
+		chassisByID:     make(map[string]*mecha_game_record.MechaGameChassis, len(chassisRecs)),
+		chassisByName:   make(map[string]*mecha_game_record.MechaGameChassis, len(chassisRecs)),
+		weaponByID:      make(map[string]*mecha_game_record.MechaGameWeapon, len(weaponRecs)),
+		weaponByName:    make(map[string]*mecha_game_record.MechaGameWeapon, len(weaponRecs)),
+		equipmentByID:   make(map[string]*mecha_game_record.MechaGameEquipment, len(equipmentRecs)),
+		equipmentByName: make(map[string]*mecha_game_record.MechaGameEquipment, len(equipmentRecs)),
+	}
+	for _, rec := range chassisRecs {
+		c.chassisByID[rec.ID] = rec
+		c.chassisByName[rec.Name] = rec
+	}
+	for _, rec := range weaponRecs {
+		c.weaponByID[rec.ID] = rec
+		c.weaponByName[rec.Name] = rec
+	}
+	for _, rec := range equipmentRecs {
+		c.equipmentByID[rec.ID] = rec
+		c.equipmentByName[rec.Name] = rec
+	}
+	return c
+}
+
+// getMechaGameCatalog loads the chassis, weapons and equipment of a game.
+func (m *Domain) getMechaGameCatalog(gameID string) (*mechaGameCatalog, error) {
+	chassisRecs, err := m.GetManyMechaGameChassisRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameChassisGameID, Val: gameID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	weaponRecs, err := m.GetManyMechaGameWeaponRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameWeaponGameID, Val: gameID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	equipmentRecs, err := m.GetManyMechaGameEquipmentRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameEquipmentGameID, Val: gameID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newMechaGameCatalog(chassisRecs, weaponRecs, equipmentRecs), nil
+}
+
+// campaignLoadout converts a mech instance loadout to the by-name loadout held
+// on a campaign roster. Entries naming records missing from the catalog are
+// dropped.
+func (c *mechaGameCatalog) campaignLoadout(
+	weaponConfig []mecha_game_record.WeaponConfigEntry,
+	equipmentConfig []mecha_game_record.EquipmentConfigEntry,
+) ([]mecha_game_record.CampaignWeaponEntry, []mecha_game_record.CampaignEquipmentEntry) {
+	weapons := []mecha_game_record.CampaignWeaponEntry{}
+	for _, entry := range weaponConfig {
+		if w := c.weaponByID[entry.WeaponID]; w != nil {
+			weapons = append(weapons, mecha_game_record.CampaignWeaponEntry{WeaponName: w.Name, SlotLocation: entry.SlotLocation})
+		}
+	}
+
+	equipment := []mecha_game_record.CampaignEquipmentEntry{}
+	for _, entry := range equipmentConfig {
+		if eq := c.equipmentByID[entry.EquipmentID]; eq != nil {
+			equipment = append(equipment, mecha_game_record.CampaignEquipmentEntry{EquipmentName: eq.Name, SlotLocation: entry.SlotLocation})
+		}
+	}
+
+	return weapons, equipment
+}
+
+// instanceLoadout resolves a by-name campaign loadout against this catalog.
+// Weapons and equipment the game does not have are dropped and their names
+// returned.
+func (c *mechaGameCatalog) instanceLoadout(
+	weapons []mecha_game_record.CampaignWeaponEntry,
+	equipment []mecha_game_record.CampaignEquipmentEntry,
+) ([]mecha_game_record.WeaponConfigEntry, []mecha_game_record.EquipmentConfigEntry, []string) {
+	var dropped []string
+
+	weaponConfig := []mecha_game_record.WeaponConfigEntry{}
+	for _, entry := range weapons {
+		w := c.weaponByName[entry.WeaponName]
+		if w == nil {
+			dropped = append(dropped, entry.WeaponName)
+			continue
+		}
+		weaponConfig = append(weaponConfig, mecha_game_record.WeaponConfigEntry{WeaponID: w.ID, SlotLocation: entry.SlotLocation})
+	}
+
+	equipmentConfig := []mecha_game_record.EquipmentConfigEntry{}
+	for _, entry := range equipment {
+		eq := c.equipmentByName[entry.EquipmentName]
+		if eq == nil {
+			dropped = append(dropped, entry.EquipmentName)
+			continue
+		}
+		equipmentConfig = append(equipmentConfig, mecha_game_record.EquipmentConfigEntry{EquipmentID: eq.ID, SlotLocation: entry.SlotLocation})
+	}
+
+	return weaponConfig, equipmentConfig, dropped
+}
+
+// nextMechaGamePlayerCallsign returns the first unused callsign of the form
+// P<player>-<n> and marks it used.
+func nextMechaGamePlayerCallsign(used map[string]bool, playerNumber int) string {
+	for n := 1; ; n++ {
+		callsign := fmt.Sprintf("P%d-%d", playerNumber, n)
+		if !used[callsign] {
+			used[callsign] = true
+			return callsign
+		}
+	}
+}
+
+// GetMechaGameCampaignInstanceRecByGameInstance returns the campaign battle a
+// game instance is, or nil when the game instance is not part of a campaign.
+func (m *Domain) GetMechaGameCampaignInstanceRecByGameInstance(gameInstanceID string) (*mecha_game_record.MechaGameCampaignInstance, error) {
+	recs, err := m.GetManyMechaGameCampaignInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameCampaignInstanceGameInstanceID, Val: gameInstanceID},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(recs) == 0 {
+		return nil, nil
+	}
+	return recs[0], nil
+}
+
+// GetMechaGameCampaignInstanceRecsByCampaign returns the battles of a campaign
+// in sequence order.
+func (m *Domain) GetMechaGameCampaignInstanceRecsByCampaign(campaignID string) ([]*mecha_game_record.MechaGameCampaignInstance, error) {
+	return m.GetManyMechaGameCampaignInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameCampaignInstanceMechaGameCampaignID, Val: campaignID},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: mecha_game_record.FieldMechaGameCampaignInstanceSequenceNumber, Direction: coresql.OrderDirectionASC},
+		},
+	})
+}
+
+// GetMechaGameCampaignSquadRecByAccountUser returns a player's squad roster in
+// a campaign, or nil when the player has not yet fought a battle in it.
+func (m *Domain) GetMechaGameCampaignSquadRecByAccountUser(campaignID, accountUserID string) (*mecha_game_record.MechaGameCampaignSquad, error) {
+	recs, err := m.GetManyMechaGameCampaignSquadRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameCampaignSquadMechaGameCampaignID, Val: campaignID},
+			{Col: mecha_game_record.FieldMechaGameCampaignSquadAccountUserID, Val: accountUserID},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(recs) == 0 {
+		return nil, nil
+	}
+	return recs[0], nil
+}
+
+// GetMechaGameCampaignMechRecsBySquad returns the mechs on a campaign squad
+// roster in the order they joined it.
+func (m *Domain) GetMechaGameCampaignMechRecsBySquad(campaignSquadID string) ([]*mecha_game_record.MechaGameCampaignMech, error) {
+	return m.GetManyMechaGameCampaignMechRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameCampaignMechMechaGameCampaignSquadID, Val: campaignSquadID},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: mecha_game_record.FieldMechaGameCampaignMechCreatedAt, Direction: coresql.OrderDirectionASC},
+		},
+	})
+}
+
+// AddMechaGameCampaignInstance adds a game instance to the end of a campaign
+// as its next battle. The game instance must be a mecha game instance that has
+// not started and is not already part of a campaign.
+func (m *Domain) AddMechaGameCampaignInstance(campaignID, gameInstanceID string) (*mecha_game_record.MechaGameCampaignInstance, error) {
+	l := m.Logger("AddMechaGameCampaignInstance")
+
+	if _, err := m.GetMechaGameCampaignRec(campaignID, nil); err != nil {
+		return nil, err
+	}
+
+	gameInstanceRec, err := m.GetGameInstanceRec(gameInstanceID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	gameRec, err := m.GetGameRec(gameInstanceRec.GameID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if gameRec.GameType != game_record.GameTypeMecha {
+		return nil, coreerror.NewInvalidDataError("game instance >%s< is not a mecha game instance", gameInstanceID)
+	}
+
+	if gameInstanceRec.Status != game_record.GameInstanceStatusCreated {
+		return nil, coreerror.NewInvalidDataError("game instance >%s< has already started and cannot be added to a campaign", gameInstanceID)
+	}
+
+	existingRec, err := m.GetMechaGameCampaignInstanceRecByGameInstance(gameInstanceID)
+	if err != nil {
+		return nil, err
+	}
+	if existingRec != nil {
+		return nil, coreerror.NewInvalidDataError("game instance >%s< is already part of a campaign", gameInstanceID)
+	}
+
+	battleRecs, err := m.GetMechaGameCampaignInstanceRecsByCampaign(campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	sequenceNumber := 1
+	if len(battleRecs) > 0 {
+		sequenceNumber = battleRecs[len(battleRecs)-1].SequenceNumber + 1
+	}
+
+	rec, err := m.CreateMechaGameCampaignInstanceRec(&mecha_game_record.MechaGameCampaignInstance{
+		MechaGameCampaignID: campaignID,
+		GameInstanceID:      gameInstanceID,
+		SequenceNumber:      sequenceNumber,
+	})
+	if err != nil {
+		l.Warn("failed adding game instance >%s< to campaign >%s< >%v<", gameInstanceID, campaignID, err)
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+// mechaGameCampaignDeployment is what a player's campaign squad brings into a
+// battle: the roster mechs that can be deployed, and the squad's supply points
+// and salvage.
+type mechaGameCampaignDeployment struct {
+	Mechs        []*mechaGameCampaignDeployedMech
+	SupplyPoints int
+	Salvage      json.RawMessage
+}
+
+// mechaGameCampaignDeployedMech is a roster mech resolved against the game of
+// the battle it is deployed to.
+type mechaGameCampaignDeployedMech struct {
+	CampaignMech *mecha_game_record.MechaGameCampaignMech
+	Chassis      *mecha_game_record.MechaGameChassis
+	Loadout      *mechInitialLoadoutState
+}
+
+// resolveMechaGameCampaignDeployment returns the roster a player's campaign
+// squad deploys to a battle, up to squadSize mechs in roster order. Returns nil
+// when the player has no roster in the campaign yet. Mechs whose chassis the
+// battle's game does not have stay on the roster but are not deployed.
+func (m *Domain) resolveMechaGameCampaignDeployment(
+	campaignInstanceRec *mecha_game_record.MechaGameCampaignInstance,
+	catalog *mechaGameCatalog,
+	accountUserID string,
+	squadSize int,
+) (*mechaGameCampaignDeployment, error) {
+	l := m.Logger("resolveMechaGameCampaignDeployment")
+
+	campaignSquadRec, err := m.GetMechaGameCampaignSquadRecByAccountUser(campaignInstanceRec.MechaGameCampaignID, accountUserID)
+	if err != nil {
+		return nil, err
+	}
+	if campaignSquadRec == nil {
+		return nil, nil
+	}
+
+	campaignMechRecs, err := m.GetMechaGameCampaignMechRecsBySquad(campaignSquadRec.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment := &mechaGameCampaignDeployment{
+		SupplyPoints: campaignSquadRec.SupplyPoints,
+		Salvage:      campaignSquadRec.Salvage,
+	}
+
+	for _, campaignMechRec := range campaignMechRecs {
+		if len(deployment.Mechs) >= squadSize {
+			break
+		}
+
+		chassisRec := catalog.chassisByName[campaignMechRec.ChassisName]
+		if chassisRec == nil {
+			l.Info("campaign mech >%s< chassis >%s< is not in this game, leaving it in reserve", campaignMechRec.Callsign, campaignMechRec.ChassisName)
+			continue
+		}
+
+		var weapons []mecha_game_record.CampaignWeaponEntry
+		if len(campaignMechRec.WeaponConfig) > 0 {
+			if err := json.Unmarshal(campaignMechRec.WeaponConfig, &weapons); err != nil {
+				return nil, fmt.Errorf("unmarshal weapon_config for campaign mech >%s<: %w", campaignMechRec.ID, err)
+			}
+		}
+		var equipment []mecha_game_record.CampaignEquipmentEntry
+		if len(campaignMechRec.EquipmentConfig) > 0 {
+			if err := json.Unmarshal(campaignMechRec.EquipmentConfig, &equipment); err != nil {
+				return nil, fmt.Errorf("unmarshal equipment_config for campaign mech >%s<: %w", campaignMechRec.ID, err)
+			}
+		}
+
+		weaponConfig, equipmentConfig, dropped := catalog.instanceLoadout(weapons, equipment)
+		if len(dropped) > 0 {
+			l.Info("campaign mech >%s< deployed without >%v< which this game does not have", campaignMechRec.Callsign, dropped)
+		}
+
+		loadout := newMechInitialLoadoutState(weaponConfig, equipmentConfig, catalog.weaponByID, catalog.equipmentByID)
+		if loadout.WeaponConfigJSON, err = json.Marshal(weaponConfig); err != nil {
+			return nil, err
+		}
+		if loadout.EquipmentJSON, err = json.Marshal(equipmentConfig); err != nil {
+			return nil, err
+		}
+
+		deployment.Mechs = append(deployment.Mechs, &mechaGameCampaignDeployedMech{
+			CampaignMech: campaignMechRec,
+			Chassis:      chassisRec,
+			Loadout:      loadout,
+		})
+	}
+
+	return deployment, nil
+}
+
+// newMechaGameCampaignMechInstance returns the mech instance a deployed roster
+// mech starts a battle as. Damage, pilot skill and experience carry over; the
+// ammo pool is refilled.
+func newMechaGameCampaignMechInstance(deployed *mechaGameCampaignDeployedMech) *mecha_game_record.MechaGameMechInstance {
+	campaignMechRec := deployed.CampaignMech
+
+	armor := min(campaignMechRec.CurrentArmor, deployed.Chassis.ArmorPoints+deployed.Loadout.ArmorBonus)
+	structure := min(campaignMechRec.CurrentStructure, deployed.Chassis.StructurePoints)
+
+	status := mecha_game_record.MechInstanceStatusOperational
+	if structure < deployed.Chassis.StructurePoints {
+		status = mecha_game_record.MechInstanceStatusDamaged
+	}
+
+	return &mecha_game_record.MechaGameMechInstance{
+		MechaGameChassisID:      deployed.Chassis.ID,
+		Callsign:                campaignMechRec.Callsign,
+		CurrentArmor:            armor,
+		CurrentStructure:        structure,
+		PilotSkill:              campaignMechRec.PilotSkill,
+		ExperiencePoints:        campaignMechRec.ExperiencePoints,
+		Status:                  status,
+		WeaponConfig:            deployed.Loadout.WeaponConfig,
+		WeaponConfigJSON:        deployed.Loadout.WeaponConfigJSON,
+		EquipmentConfig:         deployed.Loadout.EquipmentConfig,
+		EquipmentConfigJSON:     deployed.Loadout.EquipmentJSON,
+		AmmoRemaining:           deployed.Loadout.AmmoRemaining,
+		MechaGameCampaignMechID: sql.NullString{String: campaignMechRec.ID, Valid: true},
+	}
+}
+
+// RecordMechaGameCampaignBattle writes the outcome of a completed battle back
+// to the campaign squad rosters. For each player squad, surviving mechs are
+// added to or updated on the roster, destroyed mechs are struck off, supply
+// points are kept and the weapons of enemy mechs the squad destroyed are added
+// to its salvage. Does nothing when the game instance is not part of a campaign
+// or the battle has already been recorded.
+func (m *Domain) RecordMechaGameCampaignBattle(gameInstanceRec *game_record.GameInstance) error {
+	l := m.Logger("RecordMechaGameCampaignBattle")
+
+	campaignInstanceRec, err := m.GetMechaGameCampaignInstanceRecByGameInstance(gameInstanceRec.ID)
+	if err != nil {
+		l.Warn("failed getting campaign battle for game instance >%s< >%v<", gameInstanceRec.ID, err)
+		return err
+	}
+	if campaignInstanceRec == nil || campaignInstanceRec.RecordedAt.Valid {
+		return nil
+	}
+
+	catalog, err := m.getMechaGameCatalog(gameInstanceRec.GameID)
+	if err != nil {
+		l.Warn("failed getting catalog for game >%s< >%v<", gameInstanceRec.GameID, err)
+		return err
+	}
+
+	squadInstanceRecs, err := m.GetManyMechaGameSquadInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameSquadInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed getting squad instances for game instance >%s< >%v<", gameInstanceRec.ID, err)
+		return err
+	}
+
+	mechInstanceRecs, err := m.GetManyMechaGameMechInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameMechInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: mecha_game_record.FieldMechaGameMechInstanceCreatedAt, Direction: coresql.OrderDirectionASC},
+		},
+	})
+	if err != nil {
+		l.Warn("failed getting mech instances for game instance >%s< >%v<", gameInstanceRec.ID, err)
+		return err
+	}
+
+	battleSalvage, err := MechaGameBattleSalvage(mechInstanceRecs, catalog.weaponByID)
+	if err != nil {
+		l.Warn("failed working out battle salvage for game instance >%s< >%v<", gameInstanceRec.ID, err)
+		return err
+	}
+
+	for _, squadInstanceRec := range squadInstanceRecs {
+		if !squadInstanceRec.GameSubscriptionInstanceID.Valid {
+			continue
+		}
+
+		var squadMechRecs []*mecha_game_record.MechaGameMechInstance
+		for _, mechInstanceRec := range mechInstanceRecs {
+			if mechInstanceRec.MechaGameSquadInstanceID == squadInstanceRec.ID {
+				squadMechRecs = append(squadMechRecs, mechInstanceRec)
+			}
+		}
+
+		if err := m.recordMechaGameCampaignSquad(campaignInstanceRec, catalog, squadInstanceRec, squadMechRecs, battleSalvage[squadInstanceRec.ID]); err != nil {
+			l.Warn("failed recording campaign squad for squad instance >%s< >%v<", squadInstanceRec.ID, err)
+			return err
+		}
+	}
+
+	campaignInstanceRec.RecordedAt = nulltime.FromTime(time.Now())
+	if _, err := m.UpdateMechaGameCampaignInstanceRec(campaignInstanceRec); err != nil {
+		l.Warn("failed updating campaign battle >%s< >%v<", campaignInstanceRec.ID, err)
+		return err
+	}
+
+	l.Info("recorded campaign battle >%d< of campaign >%s< from game instance >%s<",
+		campaignInstanceRec.SequenceNumber, campaignInstanceRec.MechaGameCampaignID, gameInstanceRec.ID)
+
+	return nil
+}
+
+// recordMechaGameCampaignSquad writes one player squad's battle outcome back
+// to its campaign roster, creating the roster on the player's first battle.
+func (m *Domain) recordMechaGameCampaignSquad(
+	campaignInstanceRec *mecha_game_record.MechaGameCampaignInstance,
+	catalog *mechaGameCatalog,
+	squadInstanceRec *mecha_game_record.MechaGameSquadInstance,
+	mechInstanceRecs []*mecha_game_record.MechaGameMechInstance,
+	earnedSalvage []mecha_game_record.MechaGameSalvageItem,
+) error {
+	subscriptionInstanceRec, err := m.GetGameSubscriptionInstanceRec(squadInstanceRec.GameSubscriptionInstanceID.String, nil)
+	if err != nil {
+		return err
+	}
+
+	campaignSquadRec, err := m.GetMechaGameCampaignSquadRecByAccountUser(campaignInstanceRec.MechaGameCampaignID, subscriptionInstanceRec.AccountUserID)
+	if err != nil {
+		return err
+	}
+	if campaignSquadRec == nil {
+		campaignSquadRec, err = m.CreateMechaGameCampaignSquadRec(&mecha_game_record.MechaGameCampaignSquad{
+			MechaGameCampaignID: campaignInstanceRec.MechaGameCampaignID,
+			AccountUserID:       subscriptionInstanceRec.AccountUserID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, mechInstanceRec := range mechInstanceRecs {
+		if mechInstanceRec.Status == mecha_game_record.MechInstanceStatusDestroyed {
+			if mechInstanceRec.MechaGameCampaignMechID.Valid {
+				if err := m.DeleteMechaGameCampaignMechRec(mechInstanceRec.MechaGameCampaignMechID.String); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := m.recordMechaGameCampaignMech(catalog, campaignSquadRec, mechInstanceRec); err != nil {
+			return err
+		}
+	}
+
+	salvage, err := DecodeMechaGameSalvage(squadInstanceRec.Salvage)
+	if err != nil {
+		return err
+	}
+	for _, item := range earnedSalvage {
+		salvage = AddMechaGameSalvage(salvage, item.WeaponName, item.Quantity)
+	}
+	if campaignSquadRec.Salvage, err = EncodeMechaGameSalvage(salvage); err != nil {
+		return err
+	}
+
+	campaignSquadRec.SupplyPoints = max(squadInstanceRec.SupplyPoints, 0)
+	campaignSquadRec.BattlesFought++
+
+	if _, err := m.UpdateMechaGameCampaignSquadRec(campaignSquadRec); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// recordMechaGameCampaignMech adds a surviving mech to its campaign squad
+// roster, or updates the roster mech it was deployed from.
+func (m *Domain) recordMechaGameCampaignMech(
+	catalog *mechaGameCatalog,
+	campaignSquadRec *mecha_game_record.MechaGameCampaignSquad,
+	mechInstanceRec *mecha_game_record.MechaGameMechInstance,
+) error {
+	chassisRec := catalog.chassisByID[mechInstanceRec.MechaGameChassisID]
+	if chassisRec == nil {
+		return fmt.Errorf("chassis >%s< of mech instance >%s< not found", mechInstanceRec.MechaGameChassisID, mechInstanceRec.ID)
+	}
+
+	var weaponConfig []mecha_game_record.WeaponConfigEntry
+	if len(mechInstanceRec.WeaponConfigJSON) > 0 {
+		if err := json.Unmarshal(mechInstanceRec.WeaponConfigJSON, &weaponConfig); err != nil {
+			return fmt.Errorf("unmarshal weapon_config for mech instance >%s<: %w", mechInstanceRec.ID, err)
+		}
+	}
+	var equipmentConfig []mecha_game_record.EquipmentConfigEntry
+	if len(mechInstanceRec.EquipmentConfigJSON) > 0 {
+		if err := json.Unmarshal(mechInstanceRec.EquipmentConfigJSON, &equipmentConfig); err != nil {
+			return fmt.Errorf("unmarshal equipment_config for mech instance >%s<: %w", mechInstanceRec.ID, err)
+		}
+	}
+
+	weapons, equipment := catalog.campaignLoadout(weaponConfig, equipmentConfig)
+	weaponJSON, err := json.Marshal(weapons)
+	if err != nil {
+		return err
+	}
+	equipmentJSON, err := json.Marshal(equipment)
+	if err != nil {
+		return err
+	}
+
+	campaignMechRec := &mecha_game_record.MechaGameCampaignMech{
+		MechaGameCampaignSquadID: campaignSquadRec.ID,
+	}
+	if mechInstanceRec.MechaGameCampaignMechID.Valid {
+		campaignMechRec, err = m.GetMechaGameCampaignMechRec(mechInstanceRec.MechaGameCampaignMechID.String, coresql.ForUpdateNoWait)
+		if err != nil {
+			return err
+		}
+	}
+
+	campaignMechRec.Callsign = mechInstanceRec.Callsign
+	campaignMechRec.ChassisName = chassisRec.Name
+	campaignMechRec.CurrentArmor = max(mechInstanceRec.CurrentArmor, 0)
+	campaignMechRec.CurrentStructure = max(mechInstanceRec.CurrentStructure, 1)
+	campaignMechRec.PilotSkill = mechInstanceRec.PilotSkill
+	campaignMechRec.ExperiencePoints = mechInstanceRec.ExperiencePoints
+	campaignMechRec.WeaponConfig = json.RawMessage(weaponJSON)
+	campaignMechRec.EquipmentConfig = json.RawMessage(equipmentJSON)
+	campaignMechRec.BattlesSurvived++
+
+	if campaignMechRec.ID == "" {
+		_, err = m.CreateMechaGameCampaignMechRec(campaignMechRec)
+	} else {
+		_, err = m.UpdateMechaGameCampaignMechRec(campaignMechRec)
+	}
+
+	return err
+}
@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+func TestMechaGameSalvage(t *testing.T) {
+	items := AddMechaGameSalvage(nil, "Rocket Pack", 1)
+	items = AddMechaGameSalvage(items, "Chaingun", 2)
+	items = AddMechaGameSalvage(items, "Rocket Pack", 1)
+	items = AddMechaGameSalvage(items, "", 1)
+	items = AddMechaGameSalvage(items, "Pulse Cannon", 0)
+
+	require.Equal(t, []mecha_game_record.MechaGameSalvageItem{
+		{WeaponName: "Chaingun", Quantity: 2},
+		{WeaponName: "Rocket Pack", Quantity: 2},
+	}, items, "salvage is merged by weapon name and ordered by name")
+
+	items, ok := TakeMechaGameSalvage(items, "Chaingun")
+	require.True(t, ok, "held weapon can be taken")
+	require.Equal(t, 1, items[0].Quantity, "taking a weapon reduces its quantity")
+
+	items, ok = TakeMechaGameSalvage(items, "Chaingun")
+	require.True(t, ok, "last held weapon can be taken")
+	require.Len(t, items, 1, "weapon is removed when its quantity reaches zero")
+
+	_, ok = TakeMechaGameSalvage(items, "Pulse Cannon")
+	require.False(t, ok, "weapon not held cannot be taken")
+
+	encoded, err := EncodeMechaGameSalvage(items)
+	require.NoError(t, err)
+	decoded, err := DecodeMechaGameSalvage(encoded)
+	require.NoError(t, err)
+	require.Equal(t, items, decoded, "salvage round trips through encoding")
+
+	decoded, err = DecodeMechaGameSalvage(nil)
+	require.NoError(t, err)
+	require.Empty(t, decoded, "empty salvage decodes to an empty list")
+
+	encoded, err = EncodeMechaGameSalvage(nil)
+	require.NoError(t, err)
+	require.JSONEq(t, "[]", string(encoded), "nil salvage encodes to an empty list")
+}
+
+func TestMechaGameBattleSalvage(t *testing.T) {
+	weaponByID := map[string]*mecha_game_record.MechaGameWeapon{
+		"wpn-rocket": {Record: record.Record{ID: "wpn-rocket"}, Name: "Rocket Pack"},
+		"wpn-laser":  {Record: record.Record{ID: "wpn-laser"}, Name: "Pulse Cannon"},
+	}
+
+	weaponsJSON := func(ids ...string) json.RawMessage {
+		var cfg []mecha_game_record.WeaponConfigEntry
+		for _, id := range ids {
+			cfg = append(cfg, mecha_game_record.WeaponConfigEntry{WeaponID: id, SlotLocation: "left-arm"})
+		}
+		data, err := json.Marshal(cfg)
+		require.NoError(t, err)
+		return data
+	}
+	destroyedBy := func(squadInstanceID string) sql.NullString {
+		return sql.NullString{String: squadInstanceID, Valid: true}
+	}
+
+	mechs := []*mecha_game_record.MechaGameMechInstance{
+		{
+			MechaGameSquadInstanceID:   "squad-2",
+			Status:                     mecha_game_record.MechInstanceStatusDestroyed,
+			DestroyedBySquadInstanceID: destroyedBy("squad-1"),
+			WeaponConfigJSON:           weaponsJSON("wpn-rocket", "wpn-laser", "wpn-unknown"),
+		},
+		{
+			MechaGameSquadInstanceID:   "squad-2",
+			Status:                     mecha_game_record.MechInstanceStatusDestroyed,
+			DestroyedBySquadInstanceID: destroyedBy("squad-1"),
+			WeaponConfigJSON:           weaponsJSON("wpn-rocket"),
+		},
+		{
+			MechaGameSquadInstanceID:   "squad-1",
+			Status:                     mecha_game_record.MechInstanceStatusDestroyed,
+			DestroyedBySquadInstanceID: destroyedBy("squad-1"),
+			WeaponConfigJSON:           weaponsJSON("wpn-laser"),
+		},
+		{
+			MechaGameSquadInstanceID:   "squad-1",
+			Status:                     mecha_game_record.MechInstanceStatusDamaged,
+			DestroyedBySquadInstanceID: destroyedBy("squad-2"),
+			WeaponConfigJSON:           weaponsJSON("wpn-laser"),
+		},
+		{
+			MechaGameSquadInstanceID: "squad-1",
+			Status:                   mecha_game_record.MechInstanceStatusDestroyed,
+			WeaponConfigJSON:         weaponsJSON("wpn-laser"),
+		},
+	}
+
+	salvage, err := MechaGameBattleSalvage(mechs, weaponByID)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]mecha_game_record.MechaGameSalvageItem{
+		"squad-1": {
+			{WeaponName: "Pulse Cannon", Quantity: 1},
+			{WeaponName: "Rocket Pack", Quantity: 2},
+		},
+	}, salvage, "only enemy mechs destroyed by a squad are salvaged, and unknown weapons are skipped")
+}
+
+func TestMechaGameCatalogLoadout(t *testing.T) {
+	catalog := newMechaGameCatalog(
+		nil,
+		[]*mecha_game_record.MechaGameWeapon{
+			{Record: record.Record{ID: "wpn-rocket"}, Name: "Rocket Pack"},
+		},
+		[]*mecha_game_record.MechaGameEquipment{
+			{Record: record.Record{ID: "eq-sink"}, Name: "Heat Sink"},
+		},
+	)
+
+	weapons, equipment := catalog.campaignLoadout(
+		[]mecha_game_record.WeaponConfigEntry{
+			{WeaponID: "wpn-rocket", SlotLocation: "left-arm"},
+			{WeaponID: "wpn-missing", SlotLocation: "right-arm"},
+		},
+		[]mecha_game_record.EquipmentConfigEntry{
+			{EquipmentID: "eq-sink", SlotLocation: "right-torso"},
+		},
+	)
+	require.Equal(t, []mecha_game_record.CampaignWeaponEntry{
+		{WeaponName: "Rocket Pack", SlotLocation: "left-arm"},
+	}, weapons, "campaign loadout holds weapons by name")
+	require.Equal(t, []mecha_game_record.CampaignEquipmentEntry{
+		{EquipmentName: "Heat Sink", SlotLocation: "right-torso"},
+	}, equipment, "campaign loadout holds equipment by name")
+
+	weaponConfig, equipmentConfig, dropped := catalog.instanceLoadout(
+		append(weapons, mecha_game_record.CampaignWeaponEntry{WeaponName: "Gauss Rifle", SlotLocation: "right-arm"}),
+		append(equipment, mecha_game_record.CampaignEquipmentEntry{EquipmentName: "Jump Jets", SlotLocation: "left-leg"}),
+	)
+	require.Equal(t, []mecha_game_record.WeaponConfigEntry{
+		{WeaponID: "wpn-rocket", SlotLocation: "left-arm"},
+	}, weaponConfig, "weapons are resolved by name against the catalog")
+	require.Equal(t, []mecha_game_record.EquipmentConfigEntry{
+		{EquipmentID: "eq-sink", SlotLocation: "right-torso"},
+	}, equipmentConfig, "equipment is resolved by name against the catalog")
+	require.Equal(t, []string{"Gauss Rifle", "Jump Jets"}, dropped, "names the catalog does not have are dropped")
+}
+
+func TestNextMechaGamePlayerCallsign(t *testing.T) {
+	used := map[string]bool{"P1-1": true, "P1-3": true}
+
+	require.Equal(t, "P1-2", nextMechaGamePlayerCallsign(used, 1), "first unused callsign is returned")
+	require.Equal(t, "P1-4", nextMechaGamePlayerCallsign(used, 1), "returned callsigns are marked used")
+	require.Equal(t, "P2-1", nextMechaGamePlayerCallsign(used, 2), "callsigns are numbered per player")
+}
+
+func TestNewMechaGameCampaignMechInstance(t *testing.T) {
+	chassis := &mecha_game_record.MechaGameChassis{
+		Record:          record.Record{ID: "chassis-1"},
+		ArmorPoints:     100,
+		StructurePoints: 50,
+	}
+
+	tests := []struct {
+		name          string
+		armor         int
+		structure     int
+		armorBonus    int
+		wantArmor     int
+		wantStructure int
+		wantStatus    string
+	}{
+		{
+			name:          "damaged mech then damage carries over",
+			armor:         60,
+			structure:     30,
+			wantArmor:     60,
+			wantStructure: 30,
+			wantStatus:    mecha_game_record.MechInstanceStatusDamaged,
+		},
+		{
+			name:          "mech over chassis maximum then capped",
+			armor:         130,
+			structure:     70,
+			armorBonus:    20,
+			wantArmor:     120,
+			wantStructure: 50,
+			wantStatus:    mecha_game_record.MechInstanceStatusOperational,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			inst := newMechaGameCampaignMechInstance(&mechaGameCampaignDeployedMech{
+				CampaignMech: &mecha_game_record.MechaGameCampaignMech{
+					Record:           record.Record{ID: "campaign-mech-1"},
+					Callsign:         "Hammer",
+					CurrentArmor:     tc.armor,
+					CurrentStructure: tc.structure,
+					PilotSkill:       5,
+					ExperiencePoints: 12,
+				},
+				Chassis: chassis,
+				Loadout: &mechInitialLoadoutState{ArmorBonus: tc.armorBonus, AmmoRemaining: 8},
+			})
+
+			require.Equal(t, tc.wantArmor, inst.CurrentArmor, "armor")
+			require.Equal(t, tc.wantStructure, inst.CurrentStructure, "structure")
+			require.Equal(t, tc.wantStatus, inst.Status, "status")
+			require.Equal(t, "Hammer", inst.Callsign, "callsign carries over")
+			require.Equal(t, 5, inst.PilotSkill, "pilot skill carries over")
+			require.Equal(t, 12, inst.ExperiencePoints, "experience carries over")
+			require.Equal(t, 8, inst.AmmoRemaining, "ammo is refilled")
+			require.Equal(t, "chassis-1", inst.MechaGameChassisID, "chassis is resolved against the battle's game")
+			require.Equal(t, "campaign-mech-1", inst.MechaGameCampaignMechID.String, "instance links back to the roster mech")
+		})
+	}
+}
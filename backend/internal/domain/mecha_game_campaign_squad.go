@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+func (m *Domain) GetManyMechaGameCampaignSquadRecs(opts *coresql.Options) ([]*mecha_game_record.MechaGameCampaignSquad, error) {
+	l := m.Logger("GetManyMechaGameCampaignSquadRecs")
+
+	l.Debug("getting many mecha_game_campaign_squad records opts >%#v<", opts)
+
+	r := m.MechaGameCampaignSquadRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+func (m *Domain) GetMechaGameCampaignSquadRec(recID string, lock *coresql.Lock) (*mecha_game_record.MechaGameCampaignSquad, error) {
+	l := m.Logger("GetMechaGameCampaignSquadRec")
+
+	l.Debug("getting mecha_game_campaign_squad record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.MechaGameCampaignSquadRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(mecha_game_record.TableMechaGameCampaignSquad, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) CreateMechaGameCampaignSquadRec(rec *mecha_game_record.MechaGameCampaignSquad) (*mecha_game_record.MechaGameCampaignSquad, error) {
+	l := m.Logger("CreateMechaGameCampaignSquadRec")
+
+	l.Debug("creating mecha_game_campaign_squad record >%#v<", rec)
+
+	if err := m.validateMechaGameCampaignSquadRecForCreate(rec); err != nil {
+		l.Warn("failed to validate mecha_game_campaign_squad record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaGameCampaignSquadRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) UpdateMechaGameCampaignSquadRec(rec *mecha_game_record.MechaGameCampaignSquad) (*mecha_game_record.MechaGameCampaignSquad, error) {
+	l := m.Logger("UpdateMechaGameCampaignSquadRec")
+
+	currRec, err := m.GetMechaGameCampaignSquadRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating mecha_game_campaign_squad record >%#v<", rec)
+
+	if err := m.validateMechaGameCampaignSquadRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate mecha_game_campaign_squad record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaGameCampaignSquadRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+func (m *Domain) DeleteMechaGameCampaignSquadRec(recID string) error {
+	l := m.Logger("DeleteMechaGameCampaignSquadRec")
+
+	l.Debug("deleting mecha_game_campaign_squad record ID >%s<", recID)
+
+	_, err := m.GetMechaGameCampaignSquadRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.MechaGameCampaignSquadRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+func (m *Domain) RemoveMechaGameCampaignSquadRec(recID string) error {
+	l := m.Logger("RemoveMechaGameCampaignSquadRec")
+
+	l.Debug("removing mecha_game_campaign_squad record ID >%s<", recID)
+
+	r := m.MechaGameCampaignSquadRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
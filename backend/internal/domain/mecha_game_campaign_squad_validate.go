@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+type validateMechaGameCampaignSquadArgs struct {
+	currRec *mecha_game_record.MechaGameCampaignSquad
+	nextRec *mecha_game_record.MechaGameCampaignSquad
+}
+
+func (m *Domain) validateMechaGameCampaignSquadRecForCreate(rec *mecha_game_record.MechaGameCampaignSquad) error {
+	args := &validateMechaGameCampaignSquadArgs{nextRec: rec}
+	return validateMechaGameCampaignSquadRec(args, false)
+}
+
+func (m *Domain) validateMechaGameCampaignSquadRecForUpdate(currRec, nextRec *mecha_game_record.MechaGameCampaignSquad) error {
+	args := &validateMechaGameCampaignSquadArgs{currRec: currRec, nextRec: nextRec}
+	return validateMechaGameCampaignSquadRec(args, true)
+}
+
+func validateMechaGameCampaignSquadRec(args *validateMechaGameCampaignSquadArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameCampaignSquadID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameCampaignSquadMechaGameCampaignID, rec.MechaGameCampaignID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameCampaignSquadAccountUserID, rec.AccountUserID); err != nil {
+		return err
+	}
+
+	if rec.SupplyPoints < 0 {
+		return coreerror.NewInvalidDataError("supply_points must not be negative, got %d", rec.SupplyPoints)
+	}
+
+	if rec.BattlesFought < 0 {
+		return coreerror.NewInvalidDataError("battles_fought must not be negative, got %d", rec.BattlesFought)
+	}
+
+	salvage, err := DecodeMechaGameSalvage(rec.Salvage)
+	if err != nil {
+		return coreerror.NewInvalidDataError("salvage is not valid: %v", err)
+	}
+	for _, item := range salvage {
+		if item.WeaponName == "" {
+			return coreerror.NewInvalidDataError("salvage weapon_name is required")
+		}
+		if item.Quantity < 1 {
+			return coreerror.NewInvalidDataError("salvage quantity for %s must be at least 1, got %d", item.WeaponName, item.Quantity)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+func TestValidateMechaGameCampaignSquadRec(t *testing.T) {
+	validRec := func() *mecha_game_record.MechaGameCampaignSquad {
+		return &mecha_game_record.MechaGameCampaignSquad{
+			MechaGameCampaignID: "00000000-0000-0000-0000-000000000001",
+			AccountUserID:       "00000000-0000-0000-0000-000000000002",
+			SupplyPoints:        3,
+			Salvage:             json.RawMessage(`[{"weapon_name":"Rocket Pack","quantity":2}]`),
+		}
+	}
+
+	cases := []struct {
+		name        string
+		mutate      func(rec *mecha_game_record.MechaGameCampaignSquad)
+		errContains string
+	}{
+		{
+			name:   "valid squad then passes",
+			mutate: func(rec *mecha_game_record.MechaGameCampaignSquad) {},
+		},
+		{
+			name:   "empty salvage then passes",
+			mutate: func(rec *mecha_game_record.MechaGameCampaignSquad) { rec.Salvage = nil },
+		},
+		{
+			name:        "negative supply points then fails",
+			mutate:      func(rec *mecha_game_record.MechaGameCampaignSquad) { rec.SupplyPoints = -1 },
+			errContains: "supply_points",
+		},
+		{
+			name: "salvage without weapon name then fails",
+			mutate: func(rec *mecha_game_record.MechaGameCampaignSquad) {
+				rec.Salvage = json.RawMessage(`[{"weapon_name":"","quantity":1}]`)
+			},
+			errContains: "weapon_name",
+		},
+		{
+			name: "salvage with zero quantity then fails",
+			mutate: func(rec *mecha_game_record.MechaGameCampaignSquad) {
+				rec.Salvage = json.RawMessage(`[{"weapon_name":"Rocket Pack","quantity":0}]`)
+			},
+			errContains: "quantity",
+		},
+		{
+			name: "malformed salvage then fails",
+			mutate: func(rec *mecha_game_record.MechaGameCampaignSquad) {
+				rec.Salvage = json.RawMessage(`{"weapon_name":"Rocket Pack"}`)
+			},
+			errContains: "salvage",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := validRec()
+			tc.mutate(rec)
+
+			err := validateMechaGameCampaignSquadRec(&validateMechaGameCampaignSquadArgs{nextRec: rec}, false)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errContains, "error names the invalid field")
+		})
+	}
+}
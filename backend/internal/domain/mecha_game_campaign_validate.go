@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+type validateMechaGameCampaignArgs struct {
+	currRec *mecha_game_record.MechaGameCampaign
+	nextRec *mecha_game_record.MechaGameCampaign
+}
+
+func (m *Domain) validateMechaGameCampaignRecForCreate(rec *mecha_game_record.MechaGameCampaign) error {
+	args := &validateMechaGameCampaignArgs{nextRec: rec}
+	return validateMechaGameCampaignRec(args, false)
+}
+
+func (m *Domain) validateMechaGameCampaignRecForUpdate(currRec, nextRec *mecha_game_record.MechaGameCampaign) error {
+	args := &validateMechaGameCampaignArgs{currRec: currRec, nextRec: nextRec}
+	return validateMechaGameCampaignRec(args, true)
+}
+
+func validateMechaGameCampaignRec(args *validateMechaGameCampaignArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameCampaignID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameCampaignAccountUserID, rec.AccountUserID); err != nil {
+		return err
+	}
+
+	if args.currRec != nil && args.currRec.AccountUserID != rec.AccountUserID {
+		return coreerror.NewInvalidDataError("account_user_id cannot be changed")
+	}
+
+	if err := domain.ValidateStringField(mecha_game_record.FieldMechaGameCampaignName, rec.Name); err != nil {
+		return err
+	}
+
+	if len(rec.Name) > 100 {
+		return coreerror.NewInvalidDataError("name must be at most 100 characters, got %d", len(rec.Name))
+	}
+
+	return nil
+}
@@ -80,6 +80,9 @@ func validateMechaGameChassisRec(args *validateMechaGameChassisArgs, requireID b
 	if rec.LargeSlots < 0 || rec.LargeSlots > maxChassisSlotsPerSize {
 		return InvalidField(mecha_game_record.FieldMechaGameChassisLargeSlots, "", "large_slots must be between 0 and 10")
 	}
+	if rec.SensorRange < 0 || rec.SensorRange > maxChassisSensorRange {
+		return InvalidField(mecha_game_record.FieldMechaGameChassisSensorRange, "", "sensor_range must be between 0 and 5")
+	}
 	if rec.SmallSlots+rec.MediumSlots+rec.LargeSlots == 0 {
 		return InvalidField(mecha_game_record.FieldMechaGameChassisSmallSlots, "", "chassis must have at least one slot")
 	}
@@ -97,6 +100,9 @@ const (
 	maxChassisStructurePoints = 1000
 	maxChassisHeatCapacity    = 200
 	maxChassisSpeed           = 10
+	// maxChassisSensorRange caps detection BFS depth. Kept in sync with the
+	// mecha_game_chassis_sensor_range_check SQL constraint.
+	maxChassisSensorRange = 5
 	// maxChassisSlotsPerSize caps each slot band (small/medium/large). Kept in
 	// sync with the mecha_game_chassis_slot_bounds_check SQL constraint so
 	// designer input is bounded both at the API and database layers.
@@ -85,6 +85,31 @@ func TestValidateMechaGameChassis_SingleSlotClassIsAllowed(t *testing.T) {
 	require.NoError(t, runCreateValidator(t, rec))
 }
 
+func TestValidateMechaGameChassis_SensorRangeBounds(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		sensorRange int
+		wantErr     bool
+	}{
+		{"zero sensor range is allowed", 0, false},
+		{"maximum sensor range is allowed", 5, false},
+		{"negative sensor range rejected", -1, true},
+		{"sensor range over max rejected", 6, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := validChassisBaseRec()
+			rec.SensorRange = tc.sensorRange
+			err := runCreateValidator(t, rec)
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "sensor_range")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestDefaultSlotsForChassisClass_ReturnsExpectedValues(t *testing.T) {
 	cases := []struct {
 		class                 string
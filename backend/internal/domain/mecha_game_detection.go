@@ -0,0 +1,309 @@
+package domain
+
+import (
+	"encoding/json"
+	"sort"
+
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+// MechaGameTerrainConcealment returns the number of sensor range hops an
+// enemy standing in the given terrain is hidden from. Forest canopy and urban
+// structures mask a mech; open ground, rough ground and water do not.
+func MechaGameTerrainConcealment(terrainType string) int {
+	switch terrainType {
+	case mecha_game_record.SectorTerrainTypeForest, mecha_game_record.SectorTerrainTypeUrban:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// MechaGameSensorMech is a mech as seen by detection.
+type MechaGameSensorMech struct {
+	MechInstanceID   string
+	Callsign         string
+	SquadInstanceID  string
+	SectorInstanceID string
+	// SensorRange is the chassis sensor range plus the sensor equipment bonus
+	SensorRange int
+	// Observing is false while the mech's sensors are offline (destroyed or
+	// shut down)
+	Observing bool
+	// Destroyed mechs are never detected
+	Destroyed bool
+}
+
+// MechaGameSensorGrid is the sector layout detection runs over.
+type MechaGameSensorGrid struct {
+	// Links maps a sector instance ID to the sector instance IDs it links to
+	Links map[string][]string
+	// Concealment maps a sector instance ID to its terrain concealment
+	Concealment map[string]int
+	// SectorNames maps a sector instance ID to its design name
+	SectorNames map[string]string
+}
+
+// MechaGameDetection records which enemy mechs each squad has detected.
+type MechaGameDetection struct {
+	mechs       map[string]MechaGameSensorMech
+	sectorNames map[string]string
+	// detected maps squad instance ID to the set of detected mech instance IDs
+	detected map[string]map[string]bool
+}
+
+// DetectMechaGameMechs works out the enemy mechs each squad has detected. An
+// enemy is detected when it stands in the same sector as one of the squad's
+// observing mechs, or when its distance in sector hops from that mech is no
+// more than the mech's sensor range less the concealment of the enemy's
+// sector.
+func DetectMechaGameMechs(mechs []MechaGameSensorMech, grid MechaGameSensorGrid) *MechaGameDetection {
+	d := &MechaGameDetection{
+		mechs:       make(map[string]MechaGameSensorMech, len(mechs)),
+		sectorNames: grid.SectorNames,
+		detected:    make(map[string]map[string]bool),
+	}
+
+	for _, mech := range mechs {
+		d.mechs[mech.MechInstanceID] = mech
+	}
+
+	for _, observer := range mechs {
+		if !observer.Observing || observer.Destroyed {
+			continue
+		}
+
+		distances := sectorDistancesWithin(observer.SectorInstanceID, observer.SensorRange, grid.Links)
+
+		for _, target := range mechs {
+			if target.Destroyed || target.SquadInstanceID == observer.SquadInstanceID {
+				continue
+			}
+			dist, ok := distances[target.SectorInstanceID]
+			if !ok {
+				continue
+			}
+			if dist > 0 && dist > observer.SensorRange-grid.Concealment[target.SectorInstanceID] {
+				continue
+			}
+			if d.detected[observer.SquadInstanceID] == nil {
+				d.detected[observer.SquadInstanceID] = make(map[string]bool)
+			}
+			d.detected[observer.SquadInstanceID][target.MechInstanceID] = true
+		}
+	}
+
+	return d
+}
+
+// sectorDistancesWithin returns the hop distance from a sector to every sector
+// reachable within maxHops over sector links, including the sector itself.
+func sectorDistancesWithin(fromSectorInstanceID string, maxHops int, links map[string][]string) map[string]int {
+	distances := map[string]int{fromSectorInstanceID: 0}
+	queue := []string{fromSectorInstanceID}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		if distances[cur] >= maxHops {
+			continue
+		}
+		for _, dest := range links[cur] {
+			if _, seen := distances[dest]; seen {
+				continue
+			}
+			distances[dest] = distances[cur] + 1
+			queue = append(queue, dest)
+		}
+	}
+
+	return distances
+}
+
+// IsDetected returns true when the squad has detected the mech.
+func (d *MechaGameDetection) IsDetected(squadInstanceID, mechInstanceID string) bool {
+	return d.detected[squadInstanceID][mechInstanceID]
+}
+
+// Contacts returns the enemy mechs the squad has detected as contacts seen on
+// the given turn, ordered by callsign.
+func (d *MechaGameDetection) Contacts(squadInstanceID string, turn int) []mecha_game_record.MechaGameContact {
+	contacts := []mecha_game_record.MechaGameContact{}
+	for mechInstanceID := range d.detected[squadInstanceID] {
+		mech := d.mechs[mechInstanceID]
+		contacts = append(contacts, mecha_game_record.MechaGameContact{
+			MechInstanceID:   mech.MechInstanceID,
+			Callsign:         mech.Callsign,
+			SectorInstanceID: mech.SectorInstanceID,
+			SectorName:       d.sectorNames[mech.SectorInstanceID],
+			LastSeenTurn:     turn,
+			Detected:         true,
+		})
+	}
+
+	sort.Slice(contacts, func(i, j int) bool {
+		return contacts[i].Callsign < contacts[j].Callsign
+	})
+
+	return contacts
+}
+
+// GetMechaGameDetection works out the enemy mechs each squad in a mecha game
+// instance has detected from where every mech currently stands.
+func (m *Domain) GetMechaGameDetection(gameInstanceID string) (*MechaGameDetection, error) {
+	l := m.Logger("GetMechaGameDetection")
+
+	mechInstanceRecs, err := m.GetManyMechaGameMechInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameMechInstanceGameInstanceID, Val: gameInstanceID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed getting mech instances for game instance >%s< >%v<", gameInstanceID, err)
+		return nil, err
+	}
+
+	sectorMap, err := m.GetMechaGameSectorMap(gameInstanceID)
+	if err != nil {
+		l.Warn("failed getting sector map for game instance >%s< >%v<", gameInstanceID, err)
+		return nil, err
+	}
+
+	grid := MechaGameSensorGrid{
+		Links:       sectorMap.Links,
+		Concealment: make(map[string]int, len(sectorMap.TerrainTypes)),
+		SectorNames: sectorMap.SectorNames,
+	}
+	for sectorInstanceID, terrainType := range sectorMap.TerrainTypes {
+		grid.Concealment[sectorInstanceID] = MechaGameTerrainConcealment(terrainType)
+	}
+
+	chassisByID := make(map[string]*mecha_game_record.MechaGameChassis)
+	mechs := make([]MechaGameSensorMech, 0, len(mechInstanceRecs))
+
+	for _, mechInstanceRec := range mechInstanceRecs {
+		chassisRec, ok := chassisByID[mechInstanceRec.MechaGameChassisID]
+		if !ok {
+			chassisRec, err = m.GetMechaGameChassisRec(mechInstanceRec.MechaGameChassisID, nil)
+			if err != nil {
+				l.Warn("failed getting chassis >%s< >%v<", mechInstanceRec.MechaGameChassisID, err)
+				return nil, err
+			}
+			chassisByID[mechInstanceRec.MechaGameChassisID] = chassisRec
+		}
+
+		var equipmentEntries []mecha_game_record.EquipmentConfigEntry
+		if len(mechInstanceRec.EquipmentConfigJSON) > 0 {
+			if err := json.Unmarshal(mechInstanceRec.EquipmentConfigJSON, &equipmentEntries); err != nil {
+				l.Warn("failed to unmarshal equipment config for mech >%s< >%v<", mechInstanceRec.ID, err)
+				equipmentEntries = nil
+			}
+		}
+		equipmentByID, err := m.LoadMechaGameEquipmentByID(equipmentEntries)
+		if err != nil {
+			l.Warn("failed loading equipment for mech >%s< >%v<", mechInstanceRec.ID, err)
+		}
+		effects := AggregateMechaGameEquipmentEffects(equipmentEntries, equipmentByID, mechInstanceRec.IsRefitting)
+
+		destroyed := mechInstanceRec.Status == mecha_game_record.MechInstanceStatusDestroyed
+		mechs = append(mechs, MechaGameSensorMech{
+			MechInstanceID:   mechInstanceRec.ID,
+			Callsign:         mechInstanceRec.Callsign,
+			SquadInstanceID:  mechInstanceRec.MechaGameSquadInstanceID,
+			SectorInstanceID: mechInstanceRec.MechaGameSectorInstanceID,
+			SensorRange:      EffectiveMechaGameSensorRange(chassisRec, effects),
+			Observing:        !destroyed && mechInstanceRec.Status != mecha_game_record.MechInstanceStatusShutdown,
+			Destroyed:        destroyed,
+		})
+	}
+
+	return DetectMechaGameMechs(mechs, grid), nil
+}
+
+// Contact changes reported when a squad's contacts are refreshed
+const (
+	MechaGameContactChangeNew   string = "new"
+	MechaGameContactChangeMoved string = "moved"
+	MechaGameContactChangeLost  string = "lost"
+)
+
+// MechaGameContactChange describes how one of a squad's contacts changed when
+// its contacts were refreshed.
+type MechaGameContactChange struct {
+	Kind    string
+	Contact mecha_game_record.MechaGameContact
+	// FromSectorName is where a moved contact was last seen
+	FromSectorName string
+}
+
+// RefreshMechaGameContacts merges the contacts a squad sees now into the
+// contacts it already knows about. Contacts no longer seen keep their last
+// known position; contacts for destroyed mechs are dropped.
+func RefreshMechaGameContacts(
+	known []mecha_game_record.MechaGameContact,
+	seen []mecha_game_record.MechaGameContact,
+	destroyed map[string]bool,
+) ([]mecha_game_record.MechaGameContact, []MechaGameContactChange) {
+	knownByID := make(map[string]mecha_game_record.MechaGameContact, len(known))
+	for _, contact := range known {
+		knownByID[contact.MechInstanceID] = contact
+	}
+
+	seenByID := make(map[string]bool, len(seen))
+	refreshed := make([]mecha_game_record.MechaGameContact, 0, len(known)+len(seen))
+	var changes []MechaGameContactChange
+
+	for _, contact := range seen {
+		seenByID[contact.MechInstanceID] = true
+		refreshed = append(refreshed, contact)
+
+		prev, ok := knownByID[contact.MechInstanceID]
+		switch {
+		case !ok || !prev.Detected:
+			changes = append(changes, MechaGameContactChange{Kind: MechaGameContactChangeNew, Contact: contact})
+		case prev.SectorInstanceID != contact.SectorInstanceID:
+			changes = append(changes, MechaGameContactChange{Kind: MechaGameContactChangeMoved, Contact: contact, FromSectorName: prev.SectorName})
+		}
+	}
+
+	for _, contact := range known {
+		if seenByID[contact.MechInstanceID] || destroyed[contact.MechInstanceID] {
+			continue
+		}
+		if contact.Detected {
+			contact.Detected = false
+			changes = append(changes, MechaGameContactChange{Kind: MechaGameContactChangeLost, Contact: contact})
+		}
+		refreshed = append(refreshed, contact)
+	}
+
+	return refreshed, changes
+}
+
+// GetMechaGameSquadContacts returns the contacts stored on a squad instance.
+func GetMechaGameSquadContacts(squadInstanceRec *mecha_game_record.MechaGameSquadInstance) ([]mecha_game_record.MechaGameContact, error) {
+	contacts := []mecha_game_record.MechaGameContact{}
+	if len(squadInstanceRec.Contacts) == 0 {
+		return contacts, nil
+	}
+	if err := json.Unmarshal(squadInstanceRec.Contacts, &contacts); err != nil {
+		return nil, err
+	}
+	return contacts, nil
+}
+
+// SetMechaGameSquadContacts stores contacts on a squad instance. The caller
+// persists the squad instance.
+func SetMechaGameSquadContacts(squadInstanceRec *mecha_game_record.MechaGameSquadInstance, contacts []mecha_game_record.MechaGameContact) error {
+	if contacts == nil {
+		contacts = []mecha_game_record.MechaGameContact{}
+	}
+	data, err := json.Marshal(contacts)
+	if err != nil {
+		return err
+	}
+	squadInstanceRec.Contacts = json.RawMessage(data)
+	return nil
+}
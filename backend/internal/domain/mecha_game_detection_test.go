@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+// testMechaGameSensorGrid returns a line of four sectors, a-b-c-d, linked both
+// ways. Sector c is forest.
+func testMechaGameSensorGrid() MechaGameSensorGrid {
+	return MechaGameSensorGrid{
+		Links: map[string][]string{
+			"a": {"b"},
+			"b": {"a", "c"},
+			"c": {"b", "d"},
+			"d": {"c"},
+		},
+		Concealment: map[string]int{
+			"c": MechaGameTerrainConcealment(mecha_game_record.SectorTerrainTypeForest),
+		},
+		SectorNames: map[string]string{"a": "Alpha", "b": "Bravo", "c": "Charlie", "d": "Delta"},
+	}
+}
+
+func TestDetectMechaGameMechs(t *testing.T) {
+	observer := func(sector string, sensorRange int) MechaGameSensorMech {
+		return MechaGameSensorMech{
+			MechInstanceID: "scout", Callsign: "Scout", SquadInstanceID: "player",
+			SectorInstanceID: sector, SensorRange: sensorRange, Observing: true,
+		}
+	}
+	enemy := func(sector string) MechaGameSensorMech {
+		return MechaGameSensorMech{
+			MechInstanceID: "raider", Callsign: "Raider", SquadInstanceID: "opponent",
+			SectorInstanceID: sector, SensorRange: 1, Observing: true,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		mechs        []MechaGameSensorMech
+		wantDetected bool
+	}{
+		{
+			name:         "enemy within sensor range then detected",
+			mechs:        []MechaGameSensorMech{observer("a", 1), enemy("b")},
+			wantDetected: true,
+		},
+		{
+			name:         "enemy beyond sensor range then not detected",
+			mechs:        []MechaGameSensorMech{observer("a", 1), enemy("d")},
+			wantDetected: false,
+		},
+		{
+			name:         "enemy in forest at the edge of sensor range then concealed",
+			mechs:        []MechaGameSensorMech{observer("a", 2), enemy("c")},
+			wantDetected: false,
+		},
+		{
+			name:         "enemy in forest inside sensor range then detected",
+			mechs:        []MechaGameSensorMech{observer("a", 3), enemy("c")},
+			wantDetected: true,
+		},
+		{
+			name:         "enemy in the same forest sector with no sensors then detected",
+			mechs:        []MechaGameSensorMech{observer("c", 0), enemy("c")},
+			wantDetected: true,
+		},
+		{
+			name: "observer shut down then not detected",
+			mechs: func() []MechaGameSensorMech {
+				o := observer("a", 3)
+				o.Observing = false
+				return []MechaGameSensorMech{o, enemy("b")}
+			}(),
+			wantDetected: false,
+		},
+		{
+			name: "enemy destroyed then not detected",
+			mechs: func() []MechaGameSensorMech {
+				e := enemy("b")
+				e.Destroyed = true
+				return []MechaGameSensorMech{observer("a", 3), e}
+			}(),
+			wantDetected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := DetectMechaGameMechs(tt.mechs, testMechaGameSensorGrid())
+			require.Equal(t, tt.wantDetected, d.IsDetected("player", "raider"), "player squad detection of raider")
+			require.False(t, d.IsDetected("player", "scout"), "squad never detects its own mechs")
+		})
+	}
+
+	t.Run("detected enemies become contacts", func(t *testing.T) {
+		d := DetectMechaGameMechs([]MechaGameSensorMech{observer("a", 1), enemy("b")}, testMechaGameSensorGrid())
+
+		require.Equal(t, []mecha_game_record.MechaGameContact{
+			{MechInstanceID: "raider", Callsign: "Raider", SectorInstanceID: "b", SectorName: "Bravo", LastSeenTurn: 4, Detected: true},
+		}, d.Contacts("player", 4), "player squad contacts")
+		require.Equal(t, []mecha_game_record.MechaGameContact{
+			{MechInstanceID: "scout", Callsign: "Scout", SectorInstanceID: "a", SectorName: "Alpha", LastSeenTurn: 4, Detected: true},
+		}, d.Contacts("opponent", 4), "opponent squad contacts")
+	})
+}
+
+func TestRefreshMechaGameContacts(t *testing.T) {
+	contact := func(id, sector string, turn int, detected bool) mecha_game_record.MechaGameContact {
+		return mecha_game_record.MechaGameContact{
+			MechInstanceID: id, Callsign: id, SectorInstanceID: sector, SectorName: sector,
+			LastSeenTurn: turn, Detected: detected,
+		}
+	}
+
+	known := []mecha_game_record.MechaGameContact{
+		contact("holding", "a", 1, true),
+		contact("moving", "a", 1, true),
+		contact("fading", "b", 1, true),
+		contact("returning", "c", 0, false),
+		contact("wrecked", "c", 1, true),
+	}
+	seen := []mecha_game_record.MechaGameContact{
+		contact("holding", "a", 2, true),
+		contact("moving", "b", 2, true),
+		contact("returning", "d", 2, true),
+		contact("fresh", "d", 2, true),
+	}
+
+	refreshed, changes := RefreshMechaGameContacts(known, seen, map[string]bool{"wrecked": true})
+
+	require.Equal(t, []mecha_game_record.MechaGameContact{
+		contact("holding", "a", 2, true),
+		contact("moving", "b", 2, true),
+		contact("returning", "d", 2, true),
+		contact("fresh", "d", 2, true),
+		contact("fading", "b", 1, false),
+	}, refreshed, "refreshed contacts keep last known positions and drop destroyed mechs")
+
+	got := map[string]string{}
+	for _, change := range changes {
+		got[change.Contact.MechInstanceID] = change.Kind
+	}
+	require.Equal(t, map[string]string{
+		"moving":    MechaGameContactChangeMoved,
+		"fading":    MechaGameContactChangeLost,
+		"returning": MechaGameContactChangeNew,
+		"fresh":     MechaGameContactChangeNew,
+	}, got, "contact changes")
+}
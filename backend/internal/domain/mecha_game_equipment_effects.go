@@ -44,7 +44,7 @@ type MechaGameEquipmentEffects struct {
 	HeatDissipationBonus int
 	// HitChanceBonus (targeting computer) is added to attacker hit chance
 	// after the chassis/pilot baseline. The combat resolver still clamps the
-	// final value to the [0, 95] playability band.
+	// final value to the ruleset's [0, MaxHitChance] playability band.
 	HitChanceBonus int
 	// ArmorBonus (armor upgrade) raises effective max armor used for
 	// initialization, auto-repair ceiling, and the 25% auto-repair base.
@@ -55,6 +55,9 @@ type MechaGameEquipmentEffects struct {
 	// CoverBonus (ECM) is added to the cover-modifier term applied to attacks
 	// *against* this mech. Combined with sector cover at the defender.
 	CoverBonus int
+	// SensorBonus (sensor) adds hops to the chassis's base sensor range used
+	// to detect enemy mechs.
+	SensorBonus int
 }
 
 // AggregateMechaGameEquipmentEffects sums the per-kind bonuses from the
@@ -88,6 +91,8 @@ func AggregateMechaGameEquipmentEffects(
 			e.SpeedBonus += eq.Magnitude
 		case mecha_game_record.EquipmentEffectKindECM:
 			e.CoverBonus += eq.Magnitude
+		case mecha_game_record.EquipmentEffectKindSensor:
+			e.SensorBonus += eq.Magnitude
 		case mecha_game_record.EquipmentEffectKindAmmoBin:
 			// ammo_bin contributes to AmmoRemaining at initial clone and on
 			// depot refill; it is not an aggregate effects bonus.
@@ -118,6 +123,16 @@ func EffectiveMechaGameSpeed(chassis *mecha_game_record.MechaGameChassis, effect
 	return chassis.Speed + effects.SpeedBonus
 }
 
+// EffectiveMechaGameSensorRange returns the chassis's base sensor range plus
+// the aggregated sensor bonus. Used to work out which enemy mechs a squad
+// has detected.
+func EffectiveMechaGameSensorRange(chassis *mecha_game_record.MechaGameChassis, effects MechaGameEquipmentEffects) int {
+	if chassis == nil {
+		return effects.SensorBonus
+	}
+	return chassis.SensorRange + effects.SensorBonus
+}
+
 // MaxMechaGameAmmoCapacity returns the mech's full ammo pool capacity: the
 // sum of ammo_capacity from all equipped weapons plus the magnitude of every
 // ammo_bin equipment entry. This is the value used to seed
@@ -228,6 +243,7 @@ func MechaGameEquipmentCombatHeatCost(
 //   - heat_sink     : always
 //   - armor_upgrade : always
 //   - ecm           : always
+//   - sensor        : always
 //
 // Returns zero when the mech is refitting. This helper is intended for
 // end_of_turn, which applies always-on heat once per turn to every mech
@@ -249,7 +265,8 @@ func MechaGameEquipmentAlwaysOnHeatCost(
 		switch eq.EffectKind {
 		case mecha_game_record.EquipmentEffectKindHeatSink,
 			mecha_game_record.EquipmentEffectKindArmorUpgrade,
-			mecha_game_record.EquipmentEffectKindECM:
+			mecha_game_record.EquipmentEffectKindECM,
+			mecha_game_record.EquipmentEffectKindSensor:
 			total += eq.HeatCost
 		}
 	}
@@ -27,6 +27,7 @@ func TestAggregateMechaGameEquipmentEffects(t *testing.T) {
 		"jj1":  eq(mecha_game_record.EquipmentEffectKindJumpJets, 2, 0),
 		"ecm1": eq(mecha_game_record.EquipmentEffectKindECM, 15, 0),
 		"ab1":  eq(mecha_game_record.EquipmentEffectKindAmmoBin, 100, 0),
+		"sn1":  eq(mecha_game_record.EquipmentEffectKindSensor, 2, 0),
 	}
 
 	cases := []struct {
@@ -45,6 +46,7 @@ func TestAggregateMechaGameEquipmentEffects(t *testing.T) {
 				{EquipmentID: "jj1"},
 				{EquipmentID: "ecm1"},
 				{EquipmentID: "ab1"},
+				{EquipmentID: "sn1"},
 			},
 			byID: catalog,
 			want: MechaGameEquipmentEffects{
@@ -53,6 +55,7 @@ func TestAggregateMechaGameEquipmentEffects(t *testing.T) {
 				ArmorBonus:           50,
 				SpeedBonus:           2,
 				CoverBonus:           15,
+				SensorBonus:          2,
 			},
 		},
 		{
@@ -139,6 +142,7 @@ func TestMechaGameEquipmentHeatCostSplit(t *testing.T) {
 	entries := []mecha_game_record.EquipmentConfigEntry{
 		{EquipmentID: "jj1"}, {EquipmentID: "tc1"}, {EquipmentID: "ab1"},
 		{EquipmentID: "hs1"}, {EquipmentID: "au1"}, {EquipmentID: "ecm1"},
+		{EquipmentID: "sn1"},
 	}
 	byID := map[string]*mecha_game_record.MechaGameEquipment{
 		"jj1":  eq(mecha_game_record.EquipmentEffectKindJumpJets, 2, 3),
@@ -147,6 +151,7 @@ func TestMechaGameEquipmentHeatCostSplit(t *testing.T) {
 		"hs1":  eq(mecha_game_record.EquipmentEffectKindHeatSink, 3, 4),
 		"au1":  eq(mecha_game_record.EquipmentEffectKindArmorUpgrade, 50, 2),
 		"ecm1": eq(mecha_game_record.EquipmentEffectKindECM, 15, 3),
+		"sn1":  eq(mecha_game_record.EquipmentEffectKindSensor, 1, 1),
 	}
 
 	t.Run("jump jet heat cost only counts jump jet entries", func(t *testing.T) {
@@ -189,13 +194,13 @@ func TestMechaGameEquipmentHeatCostSplit(t *testing.T) {
 		}
 	})
 
-	t.Run("always-on heat cost counts heat sink, armor upgrade and ECM", func(t *testing.T) {
+	t.Run("always-on heat cost counts heat sink, armor upgrade, ECM and sensor", func(t *testing.T) {
 		cases := []struct {
 			name      string
 			refitting bool
 			want      int
 		}{
-			{"powered on", false, 9}, // hs(4) + au(2) + ecm(3)
+			{"powered on", false, 10}, // hs(4) + au(2) + ecm(3) + sensor(1)
 			{"refitting zeros always-on heat", true, 0},
 		}
 		for _, tc := range cases {
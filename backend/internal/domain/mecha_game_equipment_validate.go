@@ -58,7 +58,7 @@ func validateMechaGameEquipmentRec(args *validateMechaGameEquipmentArgs, require
 
 	if !mecha_game_record.ValidEquipmentEffectKind(rec.EffectKind) {
 		return InvalidField(mecha_game_record.FieldMechaGameEquipmentEffectKind, rec.EffectKind,
-			"must be one of: heat_sink, targeting_computer, armor_upgrade, jump_jets, ecm, ammo_bin")
+			"must be one of: heat_sink, targeting_computer, armor_upgrade, jump_jets, ecm, ammo_bin, sensor")
 	}
 
 	maxMag := mecha_game_record.MagnitudeMaxForEffectKind(rec.EffectKind)
@@ -0,0 +1,228 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+// MechaGameRuleset holds the rule values a mecha game run is played with.
+// Each value is a game parameter so a designer can tune a run through
+// game instance parameters; values not overridden use the parameter default.
+type MechaGameRuleset struct {
+	// SupplyPointsPerTurn is added to every player squad at end of turn
+	SupplyPointsPerTurn int
+	// HeatDissipationDivisor divides a chassis heat capacity to give the heat
+	// a mech sheds at end of turn
+	HeatDissipationDivisor int
+	// AutoRepairPercent of effective max armor is restored at end of turn
+	AutoRepairPercent int
+	// BaseHitChance is the hit chance of a skill 0 pilot before equipment
+	// and cover modifiers
+	BaseHitChance int
+	// HitChancePerSkill is added to hit chance for each pilot skill level
+	HitChancePerSkill int
+	// MaxHitChance caps the final hit chance so there is always a chance to miss
+	MaxHitChance int
+	// PilotSkillThresholds maps pilot skill level (index) to the total XP
+	// required to reach that level
+	PilotSkillThresholds []int
+	// RoughMovementCost is the movement points spent entering a rough sector
+	RoughMovementCost int
+	// UrbanMovementCost is the movement points spent entering an urban sector
+	UrbanMovementCost int
+	// WaterHeatDissipationBonus is the extra heat a mech in a water sector
+	// sheds at end of turn
+	WaterHeatDissipationBonus int
+	// ObstructedFireRange is the furthest a weapon can fire into or out of a
+	// forest or urban sector
+	ObstructedFireRange int
+}
+
+// DefaultMechaGameRuleset returns the ruleset made up of the mecha game
+// parameter defaults.
+func DefaultMechaGameRuleset() (MechaGameRuleset, error) {
+	return resolveMechaGameRuleset(
+		func(key string) (int, error) {
+			return GetGameParameterIntegerDefault(game_record.GameTypeMecha, key)
+		},
+		func(key string) (string, error) {
+			return GetGameParameterStringDefault(game_record.GameTypeMecha, key)
+		},
+	)
+}
+
+// GetMechaGameRuleset returns the ruleset for a mecha game instance, applying
+// any game instance parameter overrides to the parameter defaults.
+func (m *Domain) GetMechaGameRuleset(gameInstanceID string) (MechaGameRuleset, error) {
+	l := m.Logger("GetMechaGameRuleset")
+
+	ruleset, err := resolveMechaGameRuleset(
+		func(key string) (int, error) {
+			return m.GetGameInstanceIntegerParameterValue(gameInstanceID, game_record.GameTypeMecha, key)
+		},
+		func(key string) (string, error) {
+			return m.GetGameInstanceStringParameterValue(gameInstanceID, game_record.GameTypeMecha, key)
+		},
+	)
+	if err != nil {
+		l.Warn("failed to resolve ruleset for game instance >%s< >%v<", gameInstanceID, err)
+		return MechaGameRuleset{}, err
+	}
+
+	return ruleset, nil
+}
+
+func resolveMechaGameRuleset(intValue func(key string) (int, error), stringValue func(key string) (string, error)) (MechaGameRuleset, error) {
+	var ruleset MechaGameRuleset
+
+	for _, param := range []struct {
+		key   string
+		value *int
+	}{
+		{key: MechaGameParameterSupplyPointsPerTurn, value: &ruleset.SupplyPointsPerTurn},
+		{key: MechaGameParameterHeatDissipationDivisor, value: &ruleset.HeatDissipationDivisor},
+		{key: MechaGameParameterAutoRepairPercent, value: &ruleset.AutoRepairPercent},
+		{key: MechaGameParameterBaseHitChance, value: &ruleset.BaseHitChance},
+		{key: MechaGameParameterHitChancePerSkill, value: &ruleset.HitChancePerSkill},
+		{key: MechaGameParameterMaxHitChance, value: &ruleset.MaxHitChance},
+		{key: MechaGameParameterRoughMovementCost, value: &ruleset.RoughMovementCost},
+		{key: MechaGameParameterUrbanMovementCost, value: &ruleset.UrbanMovementCost},
+		{key: MechaGameParameterWaterHeatDissipationBonus, value: &ruleset.WaterHeatDissipationBonus},
+		{key: MechaGameParameterObstructedFireRange, value: &ruleset.ObstructedFireRange},
+	} {
+		value, err := intValue(param.key)
+		if err != nil {
+			return MechaGameRuleset{}, err
+		}
+		*param.value = value
+	}
+
+	thresholds, err := stringValue(MechaGameParameterPilotSkillThresholds)
+	if err != nil {
+		return MechaGameRuleset{}, err
+	}
+
+	ruleset.PilotSkillThresholds, err = parseMechaGamePilotSkillThresholds(thresholds)
+	if err != nil {
+		return MechaGameRuleset{}, InvalidField(game_record.FieldGameInstanceParameterParameterValue, thresholds, err.Error())
+	}
+
+	// A divisor is validated on write; guard against division by zero for
+	// values that predate validation
+	if ruleset.HeatDissipationDivisor < 1 {
+		ruleset.HeatDissipationDivisor = 1
+	}
+
+	return ruleset, nil
+}
+
+// parseMechaGamePilotSkillThresholds parses a comma separated list of total XP
+// values, one per pilot skill level. Level 0 must require 0 XP and each
+// following level must require more XP than the one before.
+func parseMechaGamePilotSkillThresholds(value string) ([]int, error) {
+	parts := strings.Split(value, ",")
+
+	thresholds := make([]int, 0, len(parts))
+	for idx, part := range parts {
+		threshold, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("pilot skill threshold '%s' is not a valid integer", strings.TrimSpace(part))
+		}
+		if idx == 0 && threshold != 0 {
+			return nil, fmt.Errorf("pilot skill level 0 must require 0 experience")
+		}
+		if idx > 0 && threshold <= thresholds[idx-1] {
+			return nil, fmt.Errorf("pilot skill level %d must require more experience than level %d", idx, idx-1)
+		}
+		thresholds = append(thresholds, threshold)
+	}
+
+	return thresholds, nil
+}
+
+// HitChance returns the probability of a single weapon hit (0–100):
+// BaseHitChance + pilotSkill*HitChancePerSkill + attackerHitBonus + coverModifier,
+// capped to [0, MaxHitChance].
+func (r MechaGameRuleset) HitChance(pilotSkill, attackerHitBonus, coverModifier int) int {
+	chance := r.BaseHitChance + pilotSkill*r.HitChancePerSkill + attackerHitBonus + coverModifier
+	if chance > r.MaxHitChance {
+		chance = r.MaxHitChance
+	}
+	if chance < 0 {
+		chance = 0
+	}
+	return chance
+}
+
+// HeatDissipation returns the heat a chassis with the given heat capacity
+// sheds at end of turn before equipment bonuses.
+func (r MechaGameRuleset) HeatDissipation(heatCapacity int) int {
+	return heatCapacity / r.HeatDissipationDivisor
+}
+
+// AutoRepairAmount returns the armor field repairs restore to a mech with the
+// given effective max armor, rounded up.
+func (r MechaGameRuleset) AutoRepairAmount(maxArmor int) int {
+	return (maxArmor*r.AutoRepairPercent + 99) / 100
+}
+
+// PilotSkillLevel returns the skill level a pilot with the given total XP has
+// reached. Skill never drops below the pilot's current skill.
+func (r MechaGameRuleset) PilotSkillLevel(currentSkill, experiencePoints int) int {
+	skill := currentSkill
+	for nextSkill := currentSkill + 1; nextSkill < len(r.PilotSkillThresholds); nextSkill++ {
+		if experiencePoints < r.PilotSkillThresholds[nextSkill] {
+			break
+		}
+		skill = nextSkill
+	}
+	return skill
+}
+
+// MovementCost returns the movement points a mech spends entering a sector of
+// the given terrain. Entering any sector costs at least 1.
+func (r MechaGameRuleset) MovementCost(terrainType string) int {
+	cost := 1
+	switch terrainType {
+	case mecha_game_record.SectorTerrainTypeRough:
+		cost = r.RoughMovementCost
+	case mecha_game_record.SectorTerrainTypeUrban:
+		cost = r.UrbanMovementCost
+	}
+	if cost < 1 {
+		cost = 1
+	}
+	return cost
+}
+
+// TerrainHeatDissipation returns the extra heat a mech standing in a sector of
+// the given terrain sheds at end of turn.
+func (r MechaGameRuleset) TerrainHeatDissipation(terrainType string) int {
+	if terrainType == mecha_game_record.SectorTerrainTypeWater {
+		return r.WaterHeatDissipationBonus
+	}
+	return 0
+}
+
+// FireObstructed returns true when terrain blocks fire between two sectors the
+// given distance apart. Forest and urban sectors block fire beyond
+// ObstructedFireRange, whether the attacker or the target stands in them.
+func (r MechaGameRuleset) FireObstructed(attackerTerrainType, targetTerrainType string, distance int) bool {
+	if distance <= r.ObstructedFireRange {
+		return false
+	}
+	return mechaGameTerrainObstructsFire(attackerTerrainType) || mechaGameTerrainObstructsFire(targetTerrainType)
+}
+
+func mechaGameTerrainObstructsFire(terrainType string) bool {
+	return terrainType == mecha_game_record.SectorTerrainTypeForest || terrainType == mecha_game_record.SectorTerrainTypeUrban
+}
+
+// MaxPilotSkill returns the highest pilot skill level.
+func (r MechaGameRuleset) MaxPilotSkill() int {
+	return len(r.PilotSkillThresholds) - 1
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+func TestDefaultMechaGameRuleset(t *testing.T) {
+	ruleset, err := DefaultMechaGameRuleset()
+	require.NoError(t, err, "DefaultMechaGameRuleset returns without error")
+
+	require.Equal(t, MechaGameRuleset{
+		SupplyPointsPerTurn:    2,
+		HeatDissipationDivisor: 3,
+		AutoRepairPercent:      25,
+		BaseHitChance:          50,
+		HitChancePerSkill:      5,
+		MaxHitChance:           95,
+		PilotSkillThresholds:   []int{0, 3, 8, 15, 24, 35, 48, 63, 80, 99},
+
+		RoughMovementCost:         2,
+		UrbanMovementCost:         2,
+		WaterHeatDissipationBonus: 5,
+		ObstructedFireRange:       1,
+	}, ruleset, "default ruleset matches the mecha game parameter defaults")
+
+	require.Equal(t, 10, ruleset.HeatDissipation(30), "heat dissipation divides heat capacity")
+	require.Equal(t, 5, ruleset.AutoRepairAmount(17), "auto repair rounds up")
+}
+
+func TestMechaGameRulesetTerrain(t *testing.T) {
+	ruleset, err := DefaultMechaGameRuleset()
+	require.NoError(t, err, "DefaultMechaGameRuleset returns without error")
+
+	require.Equal(t, 1, ruleset.MovementCost(mecha_game_record.SectorTerrainTypeOpen), "open costs 1")
+	require.Equal(t, 1, ruleset.MovementCost(mecha_game_record.SectorTerrainTypeForest), "forest costs 1")
+	require.Equal(t, 2, ruleset.MovementCost(mecha_game_record.SectorTerrainTypeRough), "rough costs the rough movement cost")
+	require.Equal(t, 2, ruleset.MovementCost(mecha_game_record.SectorTerrainTypeUrban), "urban costs the urban movement cost")
+	require.Equal(t, 1, MechaGameRuleset{}.MovementCost(mecha_game_record.SectorTerrainTypeRough), "movement cost is never below 1")
+
+	require.Equal(t, 5, ruleset.TerrainHeatDissipation(mecha_game_record.SectorTerrainTypeWater), "water sheds extra heat")
+	require.Equal(t, 0, ruleset.TerrainHeatDissipation(mecha_game_record.SectorTerrainTypeOpen), "open sheds no extra heat")
+
+	tests := []struct {
+		name           string
+		attacker       string
+		target         string
+		distance       int
+		wantObstructed bool
+	}{
+		{name: "open to open at two hops then clear", attacker: "open", target: "open", distance: 2},
+		{name: "into forest at one hop then clear", attacker: "open", target: "forest", distance: 1},
+		{name: "into forest at two hops then obstructed", attacker: "open", target: "forest", distance: 2, wantObstructed: true},
+		{name: "out of urban at two hops then obstructed", attacker: "urban", target: "open", distance: 2, wantObstructed: true},
+		{name: "rough at two hops then clear", attacker: "rough", target: "rough", distance: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantObstructed, ruleset.FireObstructed(tt.attacker, tt.target, tt.distance), "fire obstruction")
+		})
+	}
+}
+
+func TestParseMechaGamePilotSkillThresholds(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		want   []int
+		errMsg string
+	}{
+		{
+			name:  "increasing thresholds then parsed",
+			value: "0, 5, 12,20",
+			want:  []int{0, 5, 12, 20},
+		},
+		{
+			name:  "single level then parsed",
+			value: "0",
+			want:  []int{0},
+		},
+		{
+			name:   "first level requires experience then error",
+			value:  "1,5",
+			errMsg: "level 0 must require 0 experience",
+		},
+		{
+			name:   "thresholds not increasing then error",
+			value:  "0,5,5",
+			errMsg: "level 2 must require more experience than level 1",
+		},
+		{
+			name:   "not a number then error",
+			value:  "0,five",
+			errMsg: "'five' is not a valid integer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseMechaGamePilotSkillThresholds(tt.value)
+			if tt.errMsg != "" {
+				require.Error(t, err, "parse returns an error")
+				require.Contains(t, err.Error(), tt.errMsg, "parse error describes the problem")
+				return
+			}
+			require.NoError(t, err, "parse returns without error")
+			require.Equal(t, tt.want, got, "parse returns thresholds")
+		})
+	}
+}
+
+func TestValidateParameterRange(t *testing.T) {
+	var hitChance *game_record.GameParameter
+	for _, param := range GetGameParametersByGameType(game_record.GameTypeMecha) {
+		if param.ConfigKey == MechaGameParameterMaxHitChance {
+			hitChance = param
+		}
+	}
+	require.NotNil(t, hitChance, "max hit chance parameter is registered")
+
+	tests := []struct {
+		name   string
+		value  string
+		errMsg string
+	}{
+		{name: "value within range then valid", value: "80"},
+		{name: "value at maximum then valid", value: "100"},
+		{name: "value below minimum then error", value: "0", errMsg: "less than the minimum of 1"},
+		{name: "value above maximum then error", value: "101", errMsg: "greater than the maximum of 100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateParameterRange(tt.value, hitChance)
+			if tt.errMsg == "" {
+				require.NoError(t, err, "validateParameterRange returns without error")
+				return
+			}
+			require.Error(t, err, "validateParameterRange returns an error")
+			require.Contains(t, err.Error(), tt.errMsg, "validateParameterRange error describes the problem")
+		})
+	}
+}
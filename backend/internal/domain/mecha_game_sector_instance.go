@@ -68,6 +68,31 @@ func (m *Domain) CreateMechaGameSectorInstanceRec(rec *mecha_game_record.MechaGa
 	return rec, nil
 }
 
+func (m *Domain) UpdateMechaGameSectorInstanceRec(rec *mecha_game_record.MechaGameSectorInstance) (*mecha_game_record.MechaGameSectorInstance, error) {
+	l := m.Logger("UpdateMechaGameSectorInstanceRec")
+
+	currRec, err := m.GetMechaGameSectorInstanceRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating mecha_game_sector_instance record >%#v<", rec)
+
+	if err := m.validateMechaGameSectorInstanceRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate mecha_game_sector_instance record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaGameSectorInstanceRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
 func (m *Domain) RemoveMechaGameSectorInstanceRec(recID string) error {
 	l := m.Logger("RemoveMechaGameSectorInstanceRec")
 
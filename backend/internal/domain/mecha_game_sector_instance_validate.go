@@ -7,21 +7,41 @@ import (
 )
 
 type validateMechaGameSectorInstanceArgs struct {
+	currRec *mecha_game_record.MechaGameSectorInstance
 	nextRec *mecha_game_record.MechaGameSectorInstance
 }
 
 func (m *Domain) validateMechaGameSectorInstanceRecForCreate(rec *mecha_game_record.MechaGameSectorInstance) error {
 	args := &validateMechaGameSectorInstanceArgs{nextRec: rec}
-	return validateMechaGameSectorInstanceRec(args)
+	return validateMechaGameSectorInstanceRec(args, false)
 }
 
-func validateMechaGameSectorInstanceRec(args *validateMechaGameSectorInstanceArgs) error {
+func (m *Domain) validateMechaGameSectorInstanceRecForUpdate(currRec, nextRec *mecha_game_record.MechaGameSectorInstance) error {
+	args := &validateMechaGameSectorInstanceArgs{currRec: currRec, nextRec: nextRec}
+	if err := validateMechaGameSectorInstanceRec(args, true); err != nil {
+		return err
+	}
+
+	if nextRec.MechaGameSectorID != currRec.MechaGameSectorID {
+		return InvalidField(mecha_game_record.FieldMechaGameSectorInstanceMechaGameSectorID, nextRec.MechaGameSectorID, "mecha_game_sector_id cannot be changed")
+	}
+
+	return nil
+}
+
+func validateMechaGameSectorInstanceRec(args *validateMechaGameSectorInstanceArgs, requireID bool) error {
 	rec := args.nextRec
 
 	if rec == nil {
 		return coreerror.NewInvalidDataError("record is nil")
 	}
 
+	if requireID {
+		if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameSectorInstanceID, rec.ID); err != nil {
+			return err
+		}
+	}
+
 	if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameSectorInstanceGameID, rec.GameID); err != nil {
 		return err
 	}
@@ -34,5 +54,22 @@ func validateMechaGameSectorInstanceRec(args *validateMechaGameSectorInstanceArg
 		return err
 	}
 
+	// ControllingSquadInstanceID is NULL while the sector is uncontrolled.
+	if rec.ControllingSquadInstanceID.Valid {
+		if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameSectorInstanceControllingSquadInstanceID, rec.ControllingSquadInstanceID.String); err != nil {
+			return err
+		}
+		if !rec.ControlledSinceTurn.Valid {
+			return InvalidField(mecha_game_record.FieldMechaGameSectorInstanceControlledSinceTurn, "", "controlled_since_turn is required when the sector is controlled")
+		}
+	}
+
+	// OwningSquadInstanceID is NULL until an objective sector is captured.
+	if rec.OwningSquadInstanceID.Valid {
+		if err := domain.ValidateUUIDField(mecha_game_record.FieldMechaGameSectorInstanceOwningSquadInstanceID, rec.OwningSquadInstanceID.String); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
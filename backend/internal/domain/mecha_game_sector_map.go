@@ -0,0 +1,175 @@
+package domain
+
+import (
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+// MechaGameSectorMap is the sector layout of a mecha game instance keyed by
+// sector instance ID.
+type MechaGameSectorMap struct {
+	// Links maps a sector instance ID to the sector instance IDs it links to
+	Links map[string][]string
+	// TerrainTypes maps a sector instance ID to its terrain type
+	TerrainTypes map[string]string
+	// SectorNames maps a sector instance ID to its design name
+	SectorNames map[string]string
+}
+
+// GetMechaGameSectorMap loads the sector layout of a mecha game instance.
+func (m *Domain) GetMechaGameSectorMap(gameInstanceID string) (*MechaGameSectorMap, error) {
+	l := m.Logger("GetMechaGameSectorMap")
+
+	sectorInstanceRecs, err := m.GetManyMechaGameSectorInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameSectorInstanceGameInstanceID, Val: gameInstanceID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed getting sector instances for game instance >%s< >%v<", gameInstanceID, err)
+		return nil, err
+	}
+
+	sectorMap := &MechaGameSectorMap{
+		Links:        make(map[string][]string, len(sectorInstanceRecs)),
+		TerrainTypes: make(map[string]string, len(sectorInstanceRecs)),
+		SectorNames:  make(map[string]string, len(sectorInstanceRecs)),
+	}
+
+	if len(sectorInstanceRecs) == 0 {
+		return sectorMap, nil
+	}
+
+	sectorInstanceIDBySectorID := make(map[string]string, len(sectorInstanceRecs))
+	for _, sectorInstanceRec := range sectorInstanceRecs {
+		sectorInstanceIDBySectorID[sectorInstanceRec.MechaGameSectorID] = sectorInstanceRec.ID
+
+		sectorRec, err := m.GetMechaGameSectorRec(sectorInstanceRec.MechaGameSectorID, nil)
+		if err != nil {
+			l.Warn("failed getting sector >%s< >%v<", sectorInstanceRec.MechaGameSectorID, err)
+			return nil, err
+		}
+		sectorMap.TerrainTypes[sectorInstanceRec.ID] = sectorRec.TerrainType
+		sectorMap.SectorNames[sectorInstanceRec.ID] = sectorRec.Name
+	}
+
+	linkRecs, err := m.GetManyMechaGameSectorLinkRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameSectorLinkGameID, Val: sectorInstanceRecs[0].GameID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed getting sector links for game >%s< >%v<", sectorInstanceRecs[0].GameID, err)
+		return nil, err
+	}
+	for _, linkRec := range linkRecs {
+		fromID, fromOK := sectorInstanceIDBySectorID[linkRec.FromMechaGameSectorID]
+		toID, toOK := sectorInstanceIDBySectorID[linkRec.ToMechaGameSectorID]
+		if !fromOK || !toOK {
+			continue
+		}
+		sectorMap.Links[fromID] = append(sectorMap.Links[fromID], toID)
+	}
+
+	return sectorMap, nil
+}
+
+// MovementCosts returns the fewest movement points needed to reach each sector
+// a mech can reach from the given sector with the given movement budget. Each
+// sector entered costs the ruleset movement cost of its terrain. The starting
+// sector is not included.
+func (s *MechaGameSectorMap) MovementCosts(fromSectorInstanceID string, budget int, ruleset MechaGameRuleset) map[string]int {
+	return MechaGameMovementCosts(fromSectorInstanceID, budget, s.Links, func(sectorInstanceID string) int {
+		return ruleset.MovementCost(s.TerrainTypes[sectorInstanceID])
+	})
+}
+
+// MovementPath returns the cheapest path a mech with the given movement budget
+// can take between two sectors, as the sectors entered in order ending at the
+// destination, along with its movement cost. Returns false when the
+// destination cannot be reached within budget.
+func (s *MechaGameSectorMap) MovementPath(fromSectorInstanceID, toSectorInstanceID string, budget int, ruleset MechaGameRuleset) ([]string, int, bool) {
+	return MechaGameMovementPath(fromSectorInstanceID, toSectorInstanceID, budget, s.Links, func(sectorInstanceID string) int {
+		return ruleset.MovementCost(s.TerrainTypes[sectorInstanceID])
+	})
+}
+
+// Distances returns the hop distance from a sector to every sector within
+// maxHops over sector links, including the sector itself.
+func (s *MechaGameSectorMap) Distances(fromSectorInstanceID string, maxHops int) map[string]int {
+	return sectorDistancesWithin(fromSectorInstanceID, maxHops, s.Links)
+}
+
+// MechaGameMovementCosts returns the fewest movement points needed to reach
+// each sector reachable from the given sector within budget over sector links,
+// where entering a sector costs entryCost. The starting sector is not included.
+func MechaGameMovementCosts(fromSectorInstanceID string, budget int, links map[string][]string, entryCost func(sectorInstanceID string) int) map[string]int {
+	costs, _ := mechaGameMovementSearch(fromSectorInstanceID, budget, links, entryCost)
+
+	delete(costs, fromSectorInstanceID)
+
+	return costs
+}
+
+// MechaGameMovementPath returns the cheapest path between two sectors within
+// budget over sector links, where entering a sector costs entryCost. The path
+// lists the sectors entered in order and is empty when both sectors are the
+// same.
+func MechaGameMovementPath(fromSectorInstanceID, toSectorInstanceID string, budget int, links map[string][]string, entryCost func(sectorInstanceID string) int) ([]string, int, bool) {
+	costs, prev := mechaGameMovementSearch(fromSectorInstanceID, budget, links, entryCost)
+
+	cost, ok := costs[toSectorInstanceID]
+	if !ok {
+		return nil, 0, false
+	}
+
+	path := []string{}
+	for cur := toSectorInstanceID; cur != fromSectorInstanceID; cur = prev[cur] {
+		path = append([]string{cur}, path...)
+	}
+
+	return path, cost, true
+}
+
+// mechaGameMovementSearch returns the fewest movement points needed to reach
+// each sector within budget, including the starting sector, and the sector
+// each one is entered from on its cheapest path.
+func mechaGameMovementSearch(fromSectorInstanceID string, budget int, links map[string][]string, entryCost func(sectorInstanceID string) int) (map[string]int, map[string]string) {
+	costs := map[string]int{fromSectorInstanceID: 0}
+	prev := map[string]string{}
+	settled := map[string]bool{}
+
+	// Sector maps are small, so the cheapest unsettled sector is found with a
+	// linear scan rather than a priority queue
+	for {
+		cur, curCost := "", -1
+		for id, cost := range costs {
+			if settled[id] {
+				continue
+			}
+			if curCost < 0 || cost < curCost || (cost == curCost && id < cur) {
+				cur, curCost = id, cost
+			}
+		}
+		if curCost < 0 {
+			break
+		}
+		settled[cur] = true
+
+		for _, dest := range links[cur] {
+			if settled[dest] {
+				continue
+			}
+			cost := curCost + entryCost(dest)
+			if cost > budget {
+				continue
+			}
+			if known, ok := costs[dest]; !ok || cost < known {
+				costs[dest] = cost
+				prev[dest] = cur
+			}
+		}
+	}
+
+	return costs, prev
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+// testMechaGameSectorMap returns a sector map where a links to rough b and
+// open c, both lead on to d, and d leads on to urban e.
+func testMechaGameSectorMap() *MechaGameSectorMap {
+	return &MechaGameSectorMap{
+		Links: map[string][]string{
+			"a": {"b", "c"},
+			"b": {"a", "d"},
+			"c": {"a", "d"},
+			"d": {"b", "c", "e"},
+			"e": {"d"},
+		},
+		TerrainTypes: map[string]string{
+			"a": mecha_game_record.SectorTerrainTypeOpen,
+			"b": mecha_game_record.SectorTerrainTypeRough,
+			"c": mecha_game_record.SectorTerrainTypeOpen,
+			"d": mecha_game_record.SectorTerrainTypeOpen,
+			"e": mecha_game_record.SectorTerrainTypeUrban,
+		},
+	}
+}
+
+func TestMechaGameSectorMapMovementCosts(t *testing.T) {
+	ruleset, err := DefaultMechaGameRuleset()
+	require.NoError(t, err, "DefaultMechaGameRuleset returns without error")
+
+	sectorMap := testMechaGameSectorMap()
+
+	tests := []struct {
+		name   string
+		budget int
+		want   map[string]int
+	}{
+		{
+			name:   "budget of one then rough sector out of reach",
+			budget: 1,
+			want:   map[string]int{"c": 1},
+		},
+		{
+			name:   "budget of two then cheapest route around rough ground",
+			budget: 2,
+			want:   map[string]int{"b": 2, "c": 1, "d": 2},
+		},
+		{
+			name:   "budget of four then urban sector reached",
+			budget: 4,
+			want:   map[string]int{"b": 2, "c": 1, "d": 2, "e": 4},
+		},
+		{
+			name:   "no budget then nothing reachable",
+			budget: 0,
+			want:   map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, sectorMap.MovementCosts("a", tt.budget, ruleset), "movement costs")
+		})
+	}
+}
+
+func TestMechaGameSectorMapMovementPath(t *testing.T) {
+	ruleset, err := DefaultMechaGameRuleset()
+	require.NoError(t, err, "DefaultMechaGameRuleset returns without error")
+
+	sectorMap := testMechaGameSectorMap()
+
+	tests := []struct {
+		name      string
+		to        string
+		budget    int
+		wantPath  []string
+		wantCost  int
+		wantFound bool
+	}{
+		{
+			name:      "destination beyond rough ground then path goes around it",
+			to:        "d",
+			budget:    2,
+			wantPath:  []string{"c", "d"},
+			wantCost:  2,
+			wantFound: true,
+		},
+		{
+			name:      "urban destination then path includes every sector entered",
+			to:        "e",
+			budget:    4,
+			wantPath:  []string{"c", "d", "e"},
+			wantCost:  4,
+			wantFound: true,
+		},
+		{
+			name:      "same sector then empty path",
+			to:        "a",
+			budget:    1,
+			wantPath:  []string{},
+			wantCost:  0,
+			wantFound: true,
+		},
+		{
+			name:   "destination beyond budget then not found",
+			to:     "e",
+			budget: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, cost, found := sectorMap.MovementPath("a", tt.to, tt.budget, ruleset)
+			require.Equal(t, tt.wantFound, found, "path found")
+			require.Equal(t, tt.wantPath, path, "path")
+			require.Equal(t, tt.wantCost, cost, "path cost")
+		})
+	}
+
+	require.Equal(t, map[string]int{"a": 0, "b": 1, "c": 1, "d": 2}, sectorMap.Distances("a", 2), "hop distances within two hops")
+}
@@ -64,6 +64,20 @@ func validateMechaGameSectorRec(args *validateMechaGameSectorArgs, requireID boo
 		return InvalidField(mecha_game_record.FieldMechaGameSectorCoverModifier, "", "cover_modifier must be between -50 and 50")
 	}
 
+	if rec.VictoryPoints < 0 || rec.VictoryPoints > maxSectorVictoryPoints {
+		return InvalidField(mecha_game_record.FieldMechaGameSectorVictoryPoints, "", "victory_points must be between 0 and 100")
+	}
+	if rec.VictoryPoints > 0 && !rec.IsObjectiveSector {
+		return InvalidField(mecha_game_record.FieldMechaGameSectorVictoryPoints, "", "only objective sectors can score victory points")
+	}
+
+	if rec.CaptureTurns == 0 {
+		rec.CaptureTurns = 1
+	}
+	if rec.CaptureTurns < 1 || rec.CaptureTurns > maxSectorCaptureTurns {
+		return InvalidField(mecha_game_record.FieldMechaGameSectorCaptureTurns, "", "capture_turns must be between 1 and 10")
+	}
+
 	return nil
 }
 
@@ -72,9 +86,14 @@ func validateMechaGameSectorRec(args *validateMechaGameSectorArgs, requireID boo
 // clamp at 0/95 without adding play value. Elevation is only used as a
 // tactical preference input by the AI (see computer_opponent_decision.go), so
 // a compact ±10 range is more than enough for meaningful differentiation.
+// Victory points are scored every turn an objective is owned, so a small
+// per-turn value adds up quickly; capture turns beyond 10 would outlast most
+// runs.
 const (
 	minSectorElevation     = -10
 	maxSectorElevation     = 10
 	minSectorCoverModifier = -50
 	maxSectorCoverModifier = 50
+	maxSectorVictoryPoints = 100
+	maxSectorCaptureTurns  = 10
 )
@@ -18,11 +18,20 @@ func (m *Domain) validateMechaGameSquadRecForCreate(rec *mecha_game_record.Mecha
 		return err
 	}
 
-	if rec.SquadType == mecha_game_record.SquadTypeStarter {
+	// A game has at most one player starter squad and one reserve squad
+	var existingReason string
+	switch rec.SquadType {
+	case mecha_game_record.SquadTypeStarter:
+		existingReason = "this game already has a player starter squad"
+	case mecha_game_record.SquadTypeReserve:
+		existingReason = "this game already has a reserve squad"
+	}
+
+	if existingReason != "" {
 		existing, err := m.GetManyMechaGameSquadRecs(&coresql.Options{
 			Params: []coresql.Param{
 				{Col: mecha_game_record.FieldMechaGameSquadGameID, Val: rec.GameID},
-				{Col: mecha_game_record.FieldMechaGameSquadSquadType, Val: mecha_game_record.SquadTypeStarter},
+				{Col: mecha_game_record.FieldMechaGameSquadSquadType, Val: rec.SquadType},
 			},
 			Limit: 1,
 		})
@@ -30,7 +39,7 @@ func (m *Domain) validateMechaGameSquadRecForCreate(rec *mecha_game_record.Mecha
 			return err
 		}
 		if len(existing) > 0 {
-			return coreerror.NewInvalidDataError("this game already has a player starter squad")
+			return InvalidField(mecha_game_record.FieldMechaGameSquadSquadType, rec.SquadType, existingReason)
 		}
 	}
 
@@ -59,8 +68,10 @@ func validateMechaGameSquadRec(args *validateMechaGameSquadArgs, requireID bool)
 		return err
 	}
 
-	if rec.SquadType != mecha_game_record.SquadTypeStarter && rec.SquadType != mecha_game_record.SquadTypeOpponent {
-		return coreerror.NewInvalidDataError("squad_type must be 'starter' or 'opponent'")
+	if rec.SquadType != mecha_game_record.SquadTypeStarter &&
+		rec.SquadType != mecha_game_record.SquadTypeOpponent &&
+		rec.SquadType != mecha_game_record.SquadTypeReserve {
+		return coreerror.NewInvalidDataError("squad_type must be 'starter', 'opponent' or 'reserve'")
 	}
 
 	if err := domain.ValidateStringField(mecha_game_record.FieldMechaGameSquadName, rec.Name); err != nil {
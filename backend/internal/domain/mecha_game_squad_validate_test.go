@@ -0,0 +1,85 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/harness"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+	"gitlab.com/alienspaces/playbymail/internal/utils/deps"
+)
+
+func TestDomain_CreateMechaGameSquadRec_OnePerGame(t *testing.T) {
+
+	cfg, err := config.Parse()
+	require.NoError(t, err, "Parse returns without error")
+
+	l, s, j, scanner, err := deps.NewDefaultDependencies(cfg)
+	require.NoError(t, err, "NewDefaultDependencies returns without error")
+
+	th, err := harness.NewTesting(cfg, l, s, j, scanner, harness.DefaultDataConfig())
+	require.NoError(t, err, "NewTesting returns without error")
+
+	// Keep transaction open so domain can query the data it creates
+	th.ShouldCommitData = false
+
+	_, err = th.Setup()
+	require.NoError(t, err, "Test data setup returns without error")
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err, "Test data teardown returns without error")
+	}()
+
+	// The default harness creates GameMechaGameRef with a starter squad and a
+	// reserve squad
+	gameRec, err := th.Data.GetGameRecByRef(harness.GameMechaGameRef)
+	require.NoError(t, err, "GetGameRecByRef returns without error")
+
+	testCases := []struct {
+		name        string
+		squadType   string
+		errContains string
+	}{
+		{
+			name:        "second starter squad returns an invalid squad_type error",
+			squadType:   mecha_game_record.SquadTypeStarter,
+			errContains: "player starter squad",
+		},
+		{
+			name:        "second reserve squad returns an invalid squad_type error",
+			squadType:   mecha_game_record.SquadTypeReserve,
+			errContains: "reserve squad",
+		},
+		{
+			name:      "additional opponent squad is created",
+			squadType: mecha_game_record.SquadTypeOpponent,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := th.Domain.(*domain.Domain)
+
+			rec, err := m.CreateMechaGameSquadRec(&mecha_game_record.MechaGameSquad{
+				GameID:    gameRec.ID,
+				SquadType: tc.squadType,
+				Name:      harness.UniqueName("Squad"),
+			})
+
+			if tc.errContains == "" {
+				require.NoError(t, err, "CreateMechaGameSquadRec returns without error")
+				require.NotEmpty(t, rec.ID, "Created squad has an ID")
+				return
+			}
+
+			require.Error(t, err, "CreateMechaGameSquadRec returns an error")
+			require.True(t, coreerror.HasErrorCode(err, coreerror.CreateErrorCode(coreerror.ValidationErrorInvalid, mecha_game_record.FieldMechaGameSquadSquadType)),
+				"error is an invalid squad_type error, not a database error >%v<", err)
+			require.Contains(t, err.Error(), tc.errContains, "error names the existing squad")
+		})
+	}
+}
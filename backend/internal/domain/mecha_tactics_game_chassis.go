@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+func (m *Domain) GetManyMechaTacticsGameChassisRecs(opts *coresql.Options) ([]*mecha_tactics_game_record.MechaTacticsGameChassis, error) {
+	l := m.Logger("GetManyMechaTacticsGameChassisRecs")
+
+	l.Debug("getting many mecha_tactics_game_chassis records opts >%#v<", opts)
+
+	r := m.MechaTacticsGameChassisRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+func (m *Domain) GetMechaTacticsGameChassisRec(recID string, lock *coresql.Lock) (*mecha_tactics_game_record.MechaTacticsGameChassis, error) {
+	l := m.Logger("GetMechaTacticsGameChassisRec")
+
+	l.Debug("getting mecha_tactics_game_chassis record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.MechaTacticsGameChassisRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(mecha_tactics_game_record.TableMechaTacticsGameChassis, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) CreateMechaTacticsGameChassisRec(rec *mecha_tactics_game_record.MechaTacticsGameChassis) (*mecha_tactics_game_record.MechaTacticsGameChassis, error) {
+	l := m.Logger("CreateMechaTacticsGameChassisRec")
+
+	l.Debug("creating mecha_tactics_game_chassis record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameChassisRecForCreate(rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_chassis record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameChassisRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) UpdateMechaTacticsGameChassisRec(rec *mecha_tactics_game_record.MechaTacticsGameChassis) (*mecha_tactics_game_record.MechaTacticsGameChassis, error) {
+	l := m.Logger("UpdateMechaTacticsGameChassisRec")
+
+	currRec, err := m.GetMechaTacticsGameChassisRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating mecha_tactics_game_chassis record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameChassisRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_chassis record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameChassisRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+func (m *Domain) DeleteMechaTacticsGameChassisRec(recID string) error {
+	l := m.Logger("DeleteMechaTacticsGameChassisRec")
+
+	l.Debug("deleting mecha_tactics_game_chassis record ID >%s<", recID)
+
+	_, err := m.GetMechaTacticsGameChassisRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.MechaTacticsGameChassisRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+func (m *Domain) RemoveMechaTacticsGameChassisRec(recID string) error {
+	l := m.Logger("RemoveMechaTacticsGameChassisRec")
+
+	l.Debug("removing mecha_tactics_game_chassis record ID >%s<", recID)
+
+	r := m.MechaTacticsGameChassisRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+type validateMechaTacticsGameChassisArgs struct {
+	currRec *mecha_tactics_game_record.MechaTacticsGameChassis
+	nextRec *mecha_tactics_game_record.MechaTacticsGameChassis
+}
+
+func (m *Domain) validateMechaTacticsGameChassisRecForCreate(rec *mecha_tactics_game_record.MechaTacticsGameChassis) error {
+	args := &validateMechaTacticsGameChassisArgs{nextRec: rec}
+	return validateMechaTacticsGameChassisRec(args, false)
+}
+
+func (m *Domain) validateMechaTacticsGameChassisRecForUpdate(currRec, nextRec *mecha_tactics_game_record.MechaTacticsGameChassis) error {
+	args := &validateMechaTacticsGameChassisArgs{currRec: currRec, nextRec: nextRec}
+	return validateMechaTacticsGameChassisRec(args, true)
+}
+
+func validateMechaTacticsGameChassisRec(args *validateMechaTacticsGameChassisArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameChassisID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameChassisGameID, rec.GameID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateStringField(mecha_tactics_game_record.FieldMechaTacticsGameChassisName, rec.Name); err != nil {
+		return err
+	}
+
+	validClasses := map[string]bool{
+		mecha_tactics_game_record.ChassisClassLight:   true,
+		mecha_tactics_game_record.ChassisClassMedium:  true,
+		mecha_tactics_game_record.ChassisClassHeavy:   true,
+		mecha_tactics_game_record.ChassisClassAssault: true,
+	}
+	if rec.ChassisClass == "" {
+		rec.ChassisClass = mecha_tactics_game_record.ChassisClassMedium
+	}
+	if !validClasses[rec.ChassisClass] {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameChassisChassisClass, rec.ChassisClass, "must be one of: light, medium, heavy, assault")
+	}
+
+	if rec.ArmorPoints <= 0 || rec.ArmorPoints > maxMechaTacticsChassisArmorPoints {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameChassisArmorPoints, "", "armor_points must be between 1 and 1000")
+	}
+
+	if rec.StructurePoints <= 0 || rec.StructurePoints > maxMechaTacticsChassisStructurePoints {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameChassisStructurePoints, "", "structure_points must be between 1 and 1000")
+	}
+
+	if rec.HeatCapacity <= 0 || rec.HeatCapacity > maxMechaTacticsChassisHeatCapacity {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameChassisHeatCapacity, "", "heat_capacity must be between 1 and 200")
+	}
+
+	if rec.Speed <= 0 || rec.Speed > maxMechaTacticsChassisSpeed {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameChassisSpeed, "", "speed must be between 1 and 20")
+	}
+
+	return nil
+}
+
+// Upper bounds on mecha tactics chassis stats. Speed is an MP budget spent
+// against terrain entry costs rather than a hop count, so it is allowed to
+// run higher than the mecha sector game; it still bounds the reachable-hex
+// search performed for every mech on every turn.
+const (
+	maxMechaTacticsChassisArmorPoints     = 1000
+	maxMechaTacticsChassisStructurePoints = 1000
+	maxMechaTacticsChassisHeatCapacity    = 200
+	maxMechaTacticsChassisSpeed           = 20
+)
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+func (m *Domain) GetManyMechaTacticsGameComputerOpponentRecs(opts *coresql.Options) ([]*mecha_tactics_game_record.MechaTacticsGameComputerOpponent, error) {
+	l := m.Logger("GetManyMechaTacticsGameComputerOpponentRecs")
+
+	l.Debug("getting many mecha_tactics_game_computer_opponent records opts >%#v<", opts)
+
+	r := m.MechaTacticsGameComputerOpponentRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+func (m *Domain) GetMechaTacticsGameComputerOpponentRec(recID string, lock *coresql.Lock) (*mecha_tactics_game_record.MechaTacticsGameComputerOpponent, error) {
+	l := m.Logger("GetMechaTacticsGameComputerOpponentRec")
+
+	l.Debug("getting mecha_tactics_game_computer_opponent record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.MechaTacticsGameComputerOpponentRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(mecha_tactics_game_record.TableMechaTacticsGameComputerOpponent, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) CreateMechaTacticsGameComputerOpponentRec(rec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent) (*mecha_tactics_game_record.MechaTacticsGameComputerOpponent, error) {
+	l := m.Logger("CreateMechaTacticsGameComputerOpponentRec")
+
+	l.Debug("creating mecha_tactics_game_computer_opponent record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameComputerOpponentRecForCreate(rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_computer_opponent record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameComputerOpponentRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) UpdateMechaTacticsGameComputerOpponentRec(rec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent) (*mecha_tactics_game_record.MechaTacticsGameComputerOpponent, error) {
+	l := m.Logger("UpdateMechaTacticsGameComputerOpponentRec")
+
+	currRec, err := m.GetMechaTacticsGameComputerOpponentRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating mecha_tactics_game_computer_opponent record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameComputerOpponentRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_computer_opponent record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameComputerOpponentRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+func (m *Domain) DeleteMechaTacticsGameComputerOpponentRec(recID string) error {
+	l := m.Logger("DeleteMechaTacticsGameComputerOpponentRec")
+
+	l.Debug("deleting mecha_tactics_game_computer_opponent record ID >%s<", recID)
+
+	_, err := m.GetMechaTacticsGameComputerOpponentRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.MechaTacticsGameComputerOpponentRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+func (m *Domain) RemoveMechaTacticsGameComputerOpponentRec(recID string) error {
+	l := m.Logger("RemoveMechaTacticsGameComputerOpponentRec")
+
+	l.Debug("removing mecha_tactics_game_computer_opponent record ID >%s<", recID)
+
+	r := m.MechaTacticsGameComputerOpponentRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
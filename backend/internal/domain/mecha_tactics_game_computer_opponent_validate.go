@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+type validateMechaTacticsGameComputerOpponentArgs struct {
+	currRec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent
+	nextRec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent
+}
+
+func (m *Domain) validateMechaTacticsGameComputerOpponentRecForCreate(rec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent) error {
+	args := &validateMechaTacticsGameComputerOpponentArgs{nextRec: rec}
+	return validateMechaTacticsGameComputerOpponentRec(args, false)
+}
+
+func (m *Domain) validateMechaTacticsGameComputerOpponentRecForUpdate(currRec, nextRec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent) error {
+	args := &validateMechaTacticsGameComputerOpponentArgs{currRec: currRec, nextRec: nextRec}
+	return validateMechaTacticsGameComputerOpponentRec(args, true)
+}
+
+func validateMechaTacticsGameComputerOpponentRec(args *validateMechaTacticsGameComputerOpponentArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameComputerOpponentID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameComputerOpponentGameID, rec.GameID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateStringField(mecha_tactics_game_record.FieldMechaTacticsGameComputerOpponentName, rec.Name); err != nil {
+		return err
+	}
+
+	if rec.Aggression < 1 || rec.Aggression > 10 {
+		return coreerror.NewInvalidDataError("aggression must be between 1 and 10, got %d", rec.Aggression)
+	}
+
+	if rec.IQ < 1 || rec.IQ > 10 {
+		return coreerror.NewInvalidDataError("iq must be between 1 and 10, got %d", rec.IQ)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+func (m *Domain) GetManyMechaTacticsGameHexRecs(opts *coresql.Options) ([]*mecha_tactics_game_record.MechaTacticsGameHex, error) {
+	l := m.Logger("GetManyMechaTacticsGameHexRecs")
+
+	l.Debug("getting many mecha_tactics_game_hex records opts >%#v<", opts)
+
+	r := m.MechaTacticsGameHexRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+func (m *Domain) GetMechaTacticsGameHexRec(recID string, lock *coresql.Lock) (*mecha_tactics_game_record.MechaTacticsGameHex, error) {
+	l := m.Logger("GetMechaTacticsGameHexRec")
+
+	l.Debug("getting mecha_tactics_game_hex record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.MechaTacticsGameHexRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(mecha_tactics_game_record.TableMechaTacticsGameHex, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) CreateMechaTacticsGameHexRec(rec *mecha_tactics_game_record.MechaTacticsGameHex) (*mecha_tactics_game_record.MechaTacticsGameHex, error) {
+	l := m.Logger("CreateMechaTacticsGameHexRec")
+
+	l.Debug("creating mecha_tactics_game_hex record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameHexRecForCreate(rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_hex record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameHexRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) UpdateMechaTacticsGameHexRec(rec *mecha_tactics_game_record.MechaTacticsGameHex) (*mecha_tactics_game_record.MechaTacticsGameHex, error) {
+	l := m.Logger("UpdateMechaTacticsGameHexRec")
+
+	currRec, err := m.GetMechaTacticsGameHexRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating mecha_tactics_game_hex record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameHexRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_hex record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameHexRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+func (m *Domain) DeleteMechaTacticsGameHexRec(recID string) error {
+	l := m.Logger("DeleteMechaTacticsGameHexRec")
+
+	l.Debug("deleting mecha_tactics_game_hex record ID >%s<", recID)
+
+	_, err := m.GetMechaTacticsGameHexRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.MechaTacticsGameHexRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+func (m *Domain) RemoveMechaTacticsGameHexRec(recID string) error {
+	l := m.Logger("RemoveMechaTacticsGameHexRec")
+
+	l.Debug("removing mecha_tactics_game_hex record ID >%s<", recID)
+
+	r := m.MechaTacticsGameHexRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,336 @@
+package domain
+
+import (
+	"container/heap"
+
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+// MechaTacticsHexCoord is an axial (column, row) coordinate on the flat-topped
+// mecha tactics hex grid.
+type MechaTacticsHexCoord struct {
+	Column int
+	Row    int
+}
+
+// mechaTacticsHexDirections are the axial offsets of the six neighbouring hexes,
+// indexed by facing (clockwise from north).
+var mechaTacticsHexDirections = [6]MechaTacticsHexCoord{
+	mecha_tactics_game_record.FacingNorth:     {Column: 0, Row: -1},
+	mecha_tactics_game_record.FacingNorthEast: {Column: 1, Row: -1},
+	mecha_tactics_game_record.FacingSouthEast: {Column: 1, Row: 0},
+	mecha_tactics_game_record.FacingSouth:     {Column: 0, Row: 1},
+	mecha_tactics_game_record.FacingSouthWest: {Column: -1, Row: 1},
+	mecha_tactics_game_record.FacingNorthWest: {Column: -1, Row: 0},
+}
+
+// mechaTacticsFacingLabels are the short labels printed on turn sheets for each facing.
+var mechaTacticsFacingLabels = [6]string{"N", "NE", "SE", "S", "SW", "NW"}
+
+// MechaTacticsFacingLabel returns the short compass label for a facing, or an
+// empty string when the facing is out of range.
+func MechaTacticsFacingLabel(facing int) string {
+	if facing < 0 || facing >= len(mechaTacticsFacingLabels) {
+		return ""
+	}
+	return mechaTacticsFacingLabels[facing]
+}
+
+// MechaTacticsFacingFromLabel resolves a compass label (case-sensitive, as
+// printed on the turn sheet) back to a facing. Returns false when unknown.
+func MechaTacticsFacingFromLabel(label string) (int, bool) {
+	for i, l := range mechaTacticsFacingLabels {
+		if l == label {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// MechaTacticsHexNeighbour returns the coordinate adjacent to c through the given facing.
+func MechaTacticsHexNeighbour(c MechaTacticsHexCoord, facing int) MechaTacticsHexCoord {
+	d := mechaTacticsHexDirections[((facing%6)+6)%6]
+	return MechaTacticsHexCoord{Column: c.Column + d.Column, Row: c.Row + d.Row}
+}
+
+// MechaTacticsHexDistance returns the minimum number of hex steps between a and b.
+func MechaTacticsHexDistance(a, b MechaTacticsHexCoord) int {
+	dq := a.Column - b.Column
+	dr := a.Row - b.Row
+	return (absInt(dq) + absInt(dq+dr) + absInt(dr)) / 2
+}
+
+// MechaTacticsHexDirection returns the facing that most closely points from
+// "from" towards "to". Returns -1 when both coordinates are the same hex.
+// Non-adjacent hexes are resolved by comparing the direction of travel in
+// pixel space with each of the six face directions.
+func MechaTacticsHexDirection(from, to MechaTacticsHexCoord) int {
+	if from == to {
+		return -1
+	}
+	dx, dy := mechaTacticsHexPixelOffset(to.Column-from.Column, to.Row-from.Row)
+
+	best := 0
+	bestDot := 0.0
+	for facing, d := range mechaTacticsHexDirections {
+		fx, fy := mechaTacticsHexPixelOffset(d.Column, d.Row)
+		dot := dx*fx + dy*fy
+		if facing == 0 || dot > bestDot {
+			best = facing
+			bestDot = dot
+		}
+	}
+	return best
+}
+
+// mechaTacticsHexPixelOffset converts an axial offset to a flat-topped pixel
+// offset with unit hex size. Only relative angles matter to callers.
+func mechaTacticsHexPixelOffset(dq, dr int) (float64, float64) {
+	const sqrt3 = 1.7320508075688772
+	return 1.5 * float64(dq), sqrt3 * (float64(dr) + float64(dq)/2)
+}
+
+// Firing arcs relative to a defender's facing.
+const (
+	MechaTacticsFiringArcFront string = "front"
+	MechaTacticsFiringArcLeft  string = "left"
+	MechaTacticsFiringArcRight string = "right"
+	MechaTacticsFiringArcRear  string = "rear"
+)
+
+// MechaTacticsFiringArc returns the defender arc an attack arrives through.
+// The front arc covers the three faces ahead of the defender, the left and
+// right arcs one face each, and the rear arc the face directly behind.
+// Attacks from within the defender's own hex are treated as frontal.
+func MechaTacticsFiringArc(defenderFacing int, defender, attacker MechaTacticsHexCoord) string {
+	dir := MechaTacticsHexDirection(defender, attacker)
+	if dir < 0 {
+		return MechaTacticsFiringArcFront
+	}
+	switch ((dir-defenderFacing)%6 + 6) % 6 {
+	case 2:
+		return MechaTacticsFiringArcRight
+	case 3:
+		return MechaTacticsFiringArcRear
+	case 4:
+		return MechaTacticsFiringArcLeft
+	default:
+		return MechaTacticsFiringArcFront
+	}
+}
+
+// MechaTacticsFiringArcModifier returns the hit chance bonus for attacking through the given arc.
+func MechaTacticsFiringArcModifier(arc string) int {
+	switch arc {
+	case MechaTacticsFiringArcLeft, MechaTacticsFiringArcRight:
+		return 10
+	case MechaTacticsFiringArcRear:
+		return 20
+	default:
+		return 0
+	}
+}
+
+// MechaTacticsWeaponCanFire returns whether a weapon with the given range band
+// can fire at a target the given number of hexes away.
+//
+//   - Short:  distance 0 only
+//   - Medium: distance 0–1
+//   - Long:   distance 1–2 (cannot fire into the same hex)
+func MechaTacticsWeaponCanFire(rangeBand string, distance int) bool {
+	switch rangeBand {
+	case mecha_tactics_game_record.WeaponRangeBandShort:
+		return distance == 0
+	case mecha_tactics_game_record.WeaponRangeBandMedium:
+		return distance == 0 || distance == 1
+	case mecha_tactics_game_record.WeaponRangeBandLong:
+		return distance == 1 || distance == 2
+	default:
+		return false
+	}
+}
+
+// MechaTacticsHitChance returns the percentage chance (0–95) of an attack hitting.
+// The base chance is 50 plus 5 per point of pilot skill, plus the firing arc
+// modifier, capped at 95. The target hex's terrain cover modifier is then
+// applied; negative cover makes the target harder to hit.
+func MechaTacticsHitChance(pilotSkill int, arc string, coverModifier int) int {
+	chance := 50 + pilotSkill*5 + MechaTacticsFiringArcModifier(arc)
+	if chance > 95 {
+		chance = 95
+	}
+	chance += coverModifier
+	if chance > 95 {
+		chance = 95
+	}
+	if chance < 0 {
+		chance = 0
+	}
+	return chance
+}
+
+// MechaTacticsBattlefield is an in-memory view of a game's hex map used for
+// movement, range and cover calculations.
+type MechaTacticsBattlefield struct {
+	HexByID     map[string]*mecha_tactics_game_record.MechaTacticsGameHex
+	HexByCoord  map[MechaTacticsHexCoord]*mecha_tactics_game_record.MechaTacticsGameHex
+	TerrainByID map[string]*mecha_tactics_game_record.MechaTacticsGameTerrainType
+}
+
+// NewMechaTacticsBattlefield indexes the given hexes and terrain types.
+func NewMechaTacticsBattlefield(
+	hexRecs []*mecha_tactics_game_record.MechaTacticsGameHex,
+	terrainRecs []*mecha_tactics_game_record.MechaTacticsGameTerrainType,
+) *MechaTacticsBattlefield {
+	b := &MechaTacticsBattlefield{
+		HexByID:     make(map[string]*mecha_tactics_game_record.MechaTacticsGameHex, len(hexRecs)),
+		HexByCoord:  make(map[MechaTacticsHexCoord]*mecha_tactics_game_record.MechaTacticsGameHex, len(hexRecs)),
+		TerrainByID: make(map[string]*mecha_tactics_game_record.MechaTacticsGameTerrainType, len(terrainRecs)),
+	}
+	for _, h := range hexRecs {
+		b.HexByID[h.ID] = h
+		b.HexByCoord[MechaTacticsHexCoord{Column: h.HexColumn, Row: h.HexRow}] = h
+	}
+	for _, t := range terrainRecs {
+		b.TerrainByID[t.ID] = t
+	}
+	return b
+}
+
+// LoadMechaTacticsBattlefield loads the hex map and terrain types for a game.
+func (m *Domain) LoadMechaTacticsBattlefield(gameID string) (*MechaTacticsBattlefield, error) {
+	hexRecs, err := m.GetManyMechaTacticsGameHexRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameHexGameID, Val: gameID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	terrainRecs, err := m.GetManyMechaTacticsGameTerrainTypeRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameTerrainTypeGameID, Val: gameID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMechaTacticsBattlefield(hexRecs, terrainRecs), nil
+}
+
+// Coord returns the axial coordinate of a hex, or false when the hex is not on the map.
+func (b *MechaTacticsBattlefield) Coord(hexID string) (MechaTacticsHexCoord, bool) {
+	h, ok := b.HexByID[hexID]
+	if !ok {
+		return MechaTacticsHexCoord{}, false
+	}
+	return MechaTacticsHexCoord{Column: h.HexColumn, Row: h.HexRow}, true
+}
+
+// Distance returns the hex distance between two hexes, or -1 when either is not on the map.
+func (b *MechaTacticsBattlefield) Distance(fromHexID, toHexID string) int {
+	from, ok := b.Coord(fromHexID)
+	if !ok {
+		return -1
+	}
+	to, ok := b.Coord(toHexID)
+	if !ok {
+		return -1
+	}
+	return MechaTacticsHexDistance(from, to)
+}
+
+// Terrain returns the terrain type of a hex, or nil when unknown.
+func (b *MechaTacticsBattlefield) Terrain(hexID string) *mecha_tactics_game_record.MechaTacticsGameTerrainType {
+	h, ok := b.HexByID[hexID]
+	if !ok {
+		return nil
+	}
+	return b.TerrainByID[h.MechaTacticsGameTerrainTypeID]
+}
+
+// MovementCost returns the movement points needed to enter a hex. Hexes with
+// unknown terrain cost 1.
+func (b *MechaTacticsBattlefield) MovementCost(hexID string) int {
+	t := b.Terrain(hexID)
+	if t == nil || t.MovementPointCost <= 0 {
+		return 1
+	}
+	return t.MovementPointCost
+}
+
+// CoverModifier returns the terrain cover modifier of a hex.
+func (b *MechaTacticsBattlefield) CoverModifier(hexID string) int {
+	t := b.Terrain(hexID)
+	if t == nil {
+		return 0
+	}
+	return t.CoverModifier
+}
+
+// ReachableHexes returns every hex reachable from the starting hex within the
+// movement point budget, mapped to the cheapest movement point cost of
+// reaching it. The starting hex is always included at cost 0.
+func (b *MechaTacticsBattlefield) ReachableHexes(fromHexID string, budget int) map[string]int {
+	costs := map[string]int{}
+	if _, ok := b.HexByID[fromHexID]; !ok {
+		return costs
+	}
+	costs[fromHexID] = 0
+
+	pq := &mechaTacticsHexQueue{{hexID: fromHexID, cost: 0}}
+	for pq.Len() > 0 {
+		cur := heap.Pop(pq).(mechaTacticsHexQueueItem)
+		if cur.cost > costs[cur.hexID] {
+			continue
+		}
+		coord, _ := b.Coord(cur.hexID)
+		for facing := range mechaTacticsHexDirections {
+			next, ok := b.HexByCoord[MechaTacticsHexNeighbour(coord, facing)]
+			if !ok {
+				continue
+			}
+			cost := cur.cost + b.MovementCost(next.ID)
+			if cost > budget {
+				continue
+			}
+			if prev, seen := costs[next.ID]; seen && prev <= cost {
+				continue
+			}
+			costs[next.ID] = cost
+			heap.Push(pq, mechaTacticsHexQueueItem{hexID: next.ID, cost: cost})
+		}
+	}
+
+	return costs
+}
+
+type mechaTacticsHexQueueItem struct {
+	hexID string
+	cost  int
+}
+
+// mechaTacticsHexQueue is a min-heap of hexes ordered by movement cost.
+type mechaTacticsHexQueue []mechaTacticsHexQueueItem
+
+func (q mechaTacticsHexQueue) Len() int           { return len(q) }
+func (q mechaTacticsHexQueue) Less(i, j int) bool { return q[i].cost < q[j].cost }
+func (q mechaTacticsHexQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *mechaTacticsHexQueue) Push(x any)        { *q = append(*q, x.(mechaTacticsHexQueueItem)) }
+func (q *mechaTacticsHexQueue) Pop() any {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+func TestMechaTacticsHexDistance(t *testing.T) {
+	origin := MechaTacticsHexCoord{Column: 0, Row: 0}
+
+	cases := []struct {
+		name string
+		to   MechaTacticsHexCoord
+		want int
+	}{
+		{name: "same hex", to: origin, want: 0},
+		{name: "north neighbour", to: MechaTacticsHexCoord{Column: 0, Row: -1}, want: 1},
+		{name: "north east neighbour", to: MechaTacticsHexCoord{Column: 1, Row: -1}, want: 1},
+		{name: "south west neighbour", to: MechaTacticsHexCoord{Column: -1, Row: 1}, want: 1},
+		{name: "two columns east", to: MechaTacticsHexCoord{Column: 2, Row: 0}, want: 2},
+		{name: "diagonal not adjacent", to: MechaTacticsHexCoord{Column: 1, Row: 1}, want: 2},
+		{name: "three away", to: MechaTacticsHexCoord{Column: -3, Row: 1}, want: 3},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, MechaTacticsHexDistance(origin, tc.to))
+			require.Equal(t, tc.want, MechaTacticsHexDistance(tc.to, origin))
+		})
+	}
+
+	for facing := 0; facing < 6; facing++ {
+		require.Equal(t, 1, MechaTacticsHexDistance(origin, MechaTacticsHexNeighbour(origin, facing)), "facing %d", facing)
+	}
+}
+
+func TestMechaTacticsFiringArc(t *testing.T) {
+	defender := MechaTacticsHexCoord{Column: 0, Row: 0}
+
+	cases := []struct {
+		name     string
+		facing   int
+		attacker MechaTacticsHexCoord
+		want     string
+	}{
+		{name: "same hex is frontal", facing: mecha_tactics_game_record.FacingNorth, attacker: defender, want: MechaTacticsFiringArcFront},
+		{name: "directly ahead", facing: mecha_tactics_game_record.FacingNorth, attacker: MechaTacticsHexCoord{Column: 0, Row: -1}, want: MechaTacticsFiringArcFront},
+		{name: "front right face", facing: mecha_tactics_game_record.FacingNorth, attacker: MechaTacticsHexCoord{Column: 1, Row: -1}, want: MechaTacticsFiringArcFront},
+		{name: "front left face", facing: mecha_tactics_game_record.FacingNorth, attacker: MechaTacticsHexCoord{Column: -1, Row: 0}, want: MechaTacticsFiringArcFront},
+		{name: "right side", facing: mecha_tactics_game_record.FacingNorth, attacker: MechaTacticsHexCoord{Column: 1, Row: 0}, want: MechaTacticsFiringArcRight},
+		{name: "left side", facing: mecha_tactics_game_record.FacingNorth, attacker: MechaTacticsHexCoord{Column: -1, Row: 1}, want: MechaTacticsFiringArcLeft},
+		{name: "directly behind", facing: mecha_tactics_game_record.FacingNorth, attacker: MechaTacticsHexCoord{Column: 0, Row: 1}, want: MechaTacticsFiringArcRear},
+		{name: "behind when facing south east", facing: mecha_tactics_game_record.FacingSouthEast, attacker: MechaTacticsHexCoord{Column: -1, Row: 0}, want: MechaTacticsFiringArcRear},
+		{name: "rear at range two", facing: mecha_tactics_game_record.FacingNorth, attacker: MechaTacticsHexCoord{Column: 0, Row: 2}, want: MechaTacticsFiringArcRear},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, MechaTacticsFiringArc(tc.facing, defender, tc.attacker))
+		})
+	}
+}
+
+func TestMechaTacticsWeaponCanFire(t *testing.T) {
+	cases := []struct {
+		band string
+		want [4]bool
+	}{
+		{band: mecha_tactics_game_record.WeaponRangeBandShort, want: [4]bool{true, false, false, false}},
+		{band: mecha_tactics_game_record.WeaponRangeBandMedium, want: [4]bool{true, true, false, false}},
+		{band: mecha_tactics_game_record.WeaponRangeBandLong, want: [4]bool{false, true, true, false}},
+	}
+
+	for _, tc := range cases {
+		for distance, want := range tc.want {
+			require.Equal(t, want, MechaTacticsWeaponCanFire(tc.band, distance), "band %s distance %d", tc.band, distance)
+		}
+	}
+}
+
+func TestMechaTacticsHitChance(t *testing.T) {
+	require.Equal(t, 70, MechaTacticsHitChance(4, MechaTacticsFiringArcFront, 0))
+	require.Equal(t, 80, MechaTacticsHitChance(4, MechaTacticsFiringArcLeft, 0))
+	require.Equal(t, 90, MechaTacticsHitChance(4, MechaTacticsFiringArcRear, 0))
+	require.Equal(t, 95, MechaTacticsHitChance(9, MechaTacticsFiringArcRear, 0), "capped before cover")
+	require.Equal(t, 75, MechaTacticsHitChance(9, MechaTacticsFiringArcRear, -20), "cover applies after cap")
+	require.Equal(t, 0, MechaTacticsHitChance(0, MechaTacticsFiringArcFront, -80), "never negative")
+}
+
+func TestMechaTacticsBattlefieldReachableHexes(t *testing.T) {
+	open := &mecha_tactics_game_record.MechaTacticsGameTerrainType{MovementPointCost: 1}
+	open.ID = "open"
+	forest := &mecha_tactics_game_record.MechaTacticsGameTerrainType{MovementPointCost: 3}
+	forest.ID = "forest"
+
+	hex := func(id string, col, row int, terrainID string) *mecha_tactics_game_record.MechaTacticsGameHex {
+		h := &mecha_tactics_game_record.MechaTacticsGameHex{
+			HexColumn:                     col,
+			HexRow:                        row,
+			MechaTacticsGameTerrainTypeID: terrainID,
+		}
+		h.ID = id
+		return h
+	}
+
+	// A straight north-south strip with forest in the middle and an open
+	// detour to the east.
+	b := NewMechaTacticsBattlefield(
+		[]*mecha_tactics_game_record.MechaTacticsGameHex{
+			hex("a", 0, 0, "open"),
+			hex("b", 0, 1, "forest"),
+			hex("c", 0, 2, "open"),
+			hex("d", 1, 0, "open"),
+			hex("e", 1, 1, "open"),
+		},
+		[]*mecha_tactics_game_record.MechaTacticsGameTerrainType{open, forest},
+	)
+
+	got := b.ReachableHexes("a", 3)
+	require.Equal(t, map[string]int{"a": 0, "b": 3, "c": 3, "d": 1, "e": 2}, got)
+
+	got = b.ReachableHexes("a", 1)
+	require.Equal(t, map[string]int{"a": 0, "d": 1}, got)
+
+	require.Empty(t, b.ReachableHexes("missing", 5))
+	require.Equal(t, 2, b.Distance("a", "c"))
+	require.Equal(t, -1, b.Distance("a", "missing"))
+}
@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+type validateMechaTacticsGameHexArgs struct {
+	currRec *mecha_tactics_game_record.MechaTacticsGameHex
+	nextRec *mecha_tactics_game_record.MechaTacticsGameHex
+}
+
+func (m *Domain) validateMechaTacticsGameHexRecForCreate(rec *mecha_tactics_game_record.MechaTacticsGameHex) error {
+	args := &validateMechaTacticsGameHexArgs{nextRec: rec}
+	if err := validateMechaTacticsGameHexRec(args, false); err != nil {
+		return err
+	}
+	return m.validateMechaTacticsGameHexPlacement(rec)
+}
+
+func (m *Domain) validateMechaTacticsGameHexRecForUpdate(currRec, nextRec *mecha_tactics_game_record.MechaTacticsGameHex) error {
+	args := &validateMechaTacticsGameHexArgs{currRec: currRec, nextRec: nextRec}
+	if err := validateMechaTacticsGameHexRec(args, true); err != nil {
+		return err
+	}
+	return m.validateMechaTacticsGameHexPlacement(nextRec)
+}
+
+func validateMechaTacticsGameHexRec(args *validateMechaTacticsGameHexArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameHexID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameHexGameID, rec.GameID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameHexMechaTacticsGameTerrainTypeID, rec.MechaTacticsGameTerrainTypeID); err != nil {
+		return err
+	}
+
+	if rec.HexColumn < -maxMechaTacticsHexCoordinate || rec.HexColumn > maxMechaTacticsHexCoordinate {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameHexHexColumn, "", "hex_column must be between -100 and 100")
+	}
+
+	if rec.HexRow < -maxMechaTacticsHexCoordinate || rec.HexRow > maxMechaTacticsHexCoordinate {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameHexHexRow, "", "hex_row must be between -100 and 100")
+	}
+
+	if rec.Elevation < -maxMechaTacticsHexElevation || rec.Elevation > maxMechaTacticsHexElevation {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameHexElevation, "", "elevation must be between -10 and 10")
+	}
+
+	return nil
+}
+
+// validateMechaTacticsGameHexPlacement checks the referenced terrain type
+// belongs to the same game and that no other hex already occupies the
+// coordinates.
+func (m *Domain) validateMechaTacticsGameHexPlacement(rec *mecha_tactics_game_record.MechaTacticsGameHex) error {
+	terrainRec, err := m.GetMechaTacticsGameTerrainTypeRec(rec.MechaTacticsGameTerrainTypeID, nil)
+	if err != nil {
+		return err
+	}
+	if terrainRec.GameID != rec.GameID {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameHexMechaTacticsGameTerrainTypeID, rec.MechaTacticsGameTerrainTypeID, "terrain type does not belong to this game")
+	}
+
+	existingRecs, err := m.GetManyMechaTacticsGameHexRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameHexGameID, Val: rec.GameID},
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameHexHexColumn, Val: rec.HexColumn},
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameHexHexRow, Val: rec.HexRow},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for _, existingRec := range existingRecs {
+		if existingRec.ID != rec.ID {
+			return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameHexHexColumn, fmt.Sprintf("%d,%d", rec.HexColumn, rec.HexRow), "a hex already exists at these coordinates")
+		}
+	}
+
+	return nil
+}
+
+// Bounds on hex placement. Coordinates are capped so the whole map remains
+// printable on a turn sheet; elevation is a relative height used for AI
+// positioning and display only.
+const (
+	maxMechaTacticsHexCoordinate = 100
+	maxMechaTacticsHexElevation  = 10
+)
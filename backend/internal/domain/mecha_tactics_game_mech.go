@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+func (m *Domain) GetManyMechaTacticsGameMechRecs(opts *coresql.Options) ([]*mecha_tactics_game_record.MechaTacticsGameMech, error) {
+	l := m.Logger("GetManyMechaTacticsGameMechRecs")
+
+	l.Debug("getting many mecha_tactics_game_mech records opts >%#v<", opts)
+
+	r := m.MechaTacticsGameMechRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+func (m *Domain) GetMechaTacticsGameMechRec(recID string, lock *coresql.Lock) (*mecha_tactics_game_record.MechaTacticsGameMech, error) {
+	l := m.Logger("GetMechaTacticsGameMechRec")
+
+	l.Debug("getting mecha_tactics_game_mech record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.MechaTacticsGameMechRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(mecha_tactics_game_record.TableMechaTacticsGameMech, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) CreateMechaTacticsGameMechRec(rec *mecha_tactics_game_record.MechaTacticsGameMech) (*mecha_tactics_game_record.MechaTacticsGameMech, error) {
+	l := m.Logger("CreateMechaTacticsGameMechRec")
+
+	l.Debug("creating mecha_tactics_game_mech record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameMechRecForCreate(rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_mech record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameMechRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) UpdateMechaTacticsGameMechRec(rec *mecha_tactics_game_record.MechaTacticsGameMech) (*mecha_tactics_game_record.MechaTacticsGameMech, error) {
+	l := m.Logger("UpdateMechaTacticsGameMechRec")
+
+	currRec, err := m.GetMechaTacticsGameMechRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating mecha_tactics_game_mech record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameMechRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_mech record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameMechRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+func (m *Domain) DeleteMechaTacticsGameMechRec(recID string) error {
+	l := m.Logger("DeleteMechaTacticsGameMechRec")
+
+	l.Debug("deleting mecha_tactics_game_mech record ID >%s<", recID)
+
+	_, err := m.GetMechaTacticsGameMechRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.MechaTacticsGameMechRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+func (m *Domain) RemoveMechaTacticsGameMechRec(recID string) error {
+	l := m.Logger("RemoveMechaTacticsGameMechRec")
+
+	l.Debug("removing mecha_tactics_game_mech record ID >%s<", recID)
+
+	r := m.MechaTacticsGameMechRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+func (m *Domain) GetManyMechaTacticsGameMechInstanceRecs(opts *coresql.Options) ([]*mecha_tactics_game_record.MechaTacticsGameMechInstance, error) {
+	l := m.Logger("GetManyMechaTacticsGameMechInstanceRecs")
+
+	l.Debug("getting many mecha_tactics_game_mech_instance records opts >%#v<", opts)
+
+	r := m.MechaTacticsGameMechInstanceRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+func (m *Domain) GetMechaTacticsGameMechInstanceRec(recID string, lock *coresql.Lock) (*mecha_tactics_game_record.MechaTacticsGameMechInstance, error) {
+	l := m.Logger("GetMechaTacticsGameMechInstanceRec")
+
+	l.Debug("getting mecha_tactics_game_mech_instance record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.MechaTacticsGameMechInstanceRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(mecha_tactics_game_record.TableMechaTacticsGameMechInstance, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) CreateMechaTacticsGameMechInstanceRec(rec *mecha_tactics_game_record.MechaTacticsGameMechInstance) (*mecha_tactics_game_record.MechaTacticsGameMechInstance, error) {
+	l := m.Logger("CreateMechaTacticsGameMechInstanceRec")
+
+	l.Debug("creating mecha_tactics_game_mech_instance record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameMechInstanceRecForCreate(rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_mech_instance record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameMechInstanceRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) UpdateMechaTacticsGameMechInstanceRec(rec *mecha_tactics_game_record.MechaTacticsGameMechInstance) (*mecha_tactics_game_record.MechaTacticsGameMechInstance, error) {
+	l := m.Logger("UpdateMechaTacticsGameMechInstanceRec")
+
+	currRec, err := m.GetMechaTacticsGameMechInstanceRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating mecha_tactics_game_mech_instance record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameMechInstanceRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_mech_instance record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameMechInstanceRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+func (m *Domain) RemoveMechaTacticsGameMechInstanceRec(recID string) error {
+	l := m.Logger("RemoveMechaTacticsGameMechInstanceRec")
+
+	l.Debug("removing mecha_tactics_game_mech_instance record ID >%s<", recID)
+
+	r := m.MechaTacticsGameMechInstanceRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+type validateMechaTacticsGameMechInstanceArgs struct {
+	currRec *mecha_tactics_game_record.MechaTacticsGameMechInstance
+	nextRec *mecha_tactics_game_record.MechaTacticsGameMechInstance
+}
+
+func (m *Domain) validateMechaTacticsGameMechInstanceRecForCreate(rec *mecha_tactics_game_record.MechaTacticsGameMechInstance) error {
+	args := &validateMechaTacticsGameMechInstanceArgs{nextRec: rec}
+	return validateMechaTacticsGameMechInstanceRec(args, false)
+}
+
+func (m *Domain) validateMechaTacticsGameMechInstanceRecForUpdate(currRec, nextRec *mecha_tactics_game_record.MechaTacticsGameMechInstance) error {
+	args := &validateMechaTacticsGameMechInstanceArgs{currRec: currRec, nextRec: nextRec}
+	return validateMechaTacticsGameMechInstanceRec(args, true)
+}
+
+func validateMechaTacticsGameMechInstanceRec(args *validateMechaTacticsGameMechInstanceArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceGameID, rec.GameID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceGameInstanceID, rec.GameInstanceID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceMechaTacticsGameMechID, rec.MechaTacticsGameMechID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceMechaTacticsGameChassisID, rec.MechaTacticsGameChassisID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceMechaTacticsGameHexID, rec.MechaTacticsGameHexID); err != nil {
+		return err
+	}
+
+	if rec.GameSubscriptionInstanceID.Valid == rec.MechaTacticsGameComputerOpponentID.Valid {
+		return coreerror.NewInvalidDataError("mech instance must be owned by exactly one of a game subscription instance or a computer opponent")
+	}
+
+	if err := domain.ValidateStringField(mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceCallsign, rec.Callsign); err != nil {
+		return err
+	}
+
+	if rec.Facing < mecha_tactics_game_record.FacingNorth || rec.Facing > mecha_tactics_game_record.FacingNorthWest {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceFacing, "", "facing must be between 0 and 5")
+	}
+
+	if rec.SupplyPoints < 0 {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceSupplyPoints, "", "supply_points cannot be negative")
+	}
+
+	validStatuses := map[string]bool{
+		mecha_tactics_game_record.MechInstanceStatusOperational: true,
+		mecha_tactics_game_record.MechInstanceStatusDamaged:     true,
+		mecha_tactics_game_record.MechInstanceStatusDestroyed:   true,
+		mecha_tactics_game_record.MechInstanceStatusShutdown:    true,
+	}
+	if rec.Status == "" {
+		rec.Status = mecha_tactics_game_record.MechInstanceStatusOperational
+	}
+	if !validStatuses[rec.Status] {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceStatus, rec.Status, "must be one of: operational, damaged, destroyed, shutdown")
+	}
+
+	return nil
+}
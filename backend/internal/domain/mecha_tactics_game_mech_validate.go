@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+type validateMechaTacticsGameMechArgs struct {
+	currRec *mecha_tactics_game_record.MechaTacticsGameMech
+	nextRec *mecha_tactics_game_record.MechaTacticsGameMech
+}
+
+func (m *Domain) validateMechaTacticsGameMechRecForCreate(rec *mecha_tactics_game_record.MechaTacticsGameMech) error {
+	args := &validateMechaTacticsGameMechArgs{nextRec: rec}
+	if err := validateMechaTacticsGameMechRec(args, false); err != nil {
+		return err
+	}
+	return m.validateMechaTacticsGameMechLoadout(rec)
+}
+
+func (m *Domain) validateMechaTacticsGameMechRecForUpdate(currRec, nextRec *mecha_tactics_game_record.MechaTacticsGameMech) error {
+	args := &validateMechaTacticsGameMechArgs{currRec: currRec, nextRec: nextRec}
+	if err := validateMechaTacticsGameMechRec(args, true); err != nil {
+		return err
+	}
+	return m.validateMechaTacticsGameMechLoadout(nextRec)
+}
+
+func validateMechaTacticsGameMechRec(args *validateMechaTacticsGameMechArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameMechID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameMechGameID, rec.GameID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameMechMechaTacticsGameChassisID, rec.MechaTacticsGameChassisID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateStringField(mecha_tactics_game_record.FieldMechaTacticsGameMechCallsign, rec.Callsign); err != nil {
+		return err
+	}
+
+	if rec.MechType == "" {
+		rec.MechType = mecha_tactics_game_record.MechTypeStarter
+	}
+	if rec.MechType != mecha_tactics_game_record.MechTypeStarter && rec.MechType != mecha_tactics_game_record.MechTypeOpponent {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameMechMechType, rec.MechType, "must be one of: starter, opponent")
+	}
+
+	return validateMechaTacticsWeaponConfigSlots(rec.WeaponConfig)
+}
+
+// validateMechaTacticsWeaponConfigSlots checks every loadout entry names a
+// weapon and a slot, and that no slot is used twice. Slot names are free-form
+// designer labels; weapon swaps on the repair sheet address them by name.
+func validateMechaTacticsWeaponConfigSlots(cfg []mecha_tactics_game_record.WeaponConfigEntry) error {
+	seen := make(map[string]bool, len(cfg))
+	for _, entry := range cfg {
+		if err := domain.ValidateUUIDField("weapon_id", entry.WeaponID); err != nil {
+			return err
+		}
+		if entry.SlotLocation == "" {
+			return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameMechWeaponConfig, "", "slot_location is required for every weapon")
+		}
+		if seen[entry.SlotLocation] {
+			return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameMechWeaponConfig, entry.SlotLocation, "slot_location is used by more than one weapon")
+		}
+		seen[entry.SlotLocation] = true
+	}
+	return nil
+}
+
+// validateMechaTacticsGameMechLoadout ensures the chassis and every weapon in
+// the loadout belong to the same game as the mech.
+func (m *Domain) validateMechaTacticsGameMechLoadout(rec *mecha_tactics_game_record.MechaTacticsGameMech) error {
+	chassisRec, err := m.GetMechaTacticsGameChassisRec(rec.MechaTacticsGameChassisID, nil)
+	if err != nil {
+		return err
+	}
+	if chassisRec.GameID != rec.GameID {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameMechMechaTacticsGameChassisID, rec.MechaTacticsGameChassisID, "chassis does not belong to this game")
+	}
+
+	for _, entry := range rec.WeaponConfig {
+		weaponRec, err := m.GetMechaTacticsGameWeaponRec(entry.WeaponID, nil)
+		if err != nil {
+			return err
+		}
+		if weaponRec.GameID != rec.GameID {
+			return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameMechWeaponConfig, entry.WeaponID, "weapon does not belong to this game")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+func (m *Domain) GetManyMechaTacticsGameTerrainTypeRecs(opts *coresql.Options) ([]*mecha_tactics_game_record.MechaTacticsGameTerrainType, error) {
+	l := m.Logger("GetManyMechaTacticsGameTerrainTypeRecs")
+
+	l.Debug("getting many mecha_tactics_game_terrain_type records opts >%#v<", opts)
+
+	r := m.MechaTacticsGameTerrainTypeRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+func (m *Domain) GetMechaTacticsGameTerrainTypeRec(recID string, lock *coresql.Lock) (*mecha_tactics_game_record.MechaTacticsGameTerrainType, error) {
+	l := m.Logger("GetMechaTacticsGameTerrainTypeRec")
+
+	l.Debug("getting mecha_tactics_game_terrain_type record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.MechaTacticsGameTerrainTypeRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(mecha_tactics_game_record.TableMechaTacticsGameTerrainType, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) CreateMechaTacticsGameTerrainTypeRec(rec *mecha_tactics_game_record.MechaTacticsGameTerrainType) (*mecha_tactics_game_record.MechaTacticsGameTerrainType, error) {
+	l := m.Logger("CreateMechaTacticsGameTerrainTypeRec")
+
+	l.Debug("creating mecha_tactics_game_terrain_type record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameTerrainTypeRecForCreate(rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_terrain_type record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameTerrainTypeRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) UpdateMechaTacticsGameTerrainTypeRec(rec *mecha_tactics_game_record.MechaTacticsGameTerrainType) (*mecha_tactics_game_record.MechaTacticsGameTerrainType, error) {
+	l := m.Logger("UpdateMechaTacticsGameTerrainTypeRec")
+
+	currRec, err := m.GetMechaTacticsGameTerrainTypeRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating mecha_tactics_game_terrain_type record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameTerrainTypeRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_terrain_type record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameTerrainTypeRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+func (m *Domain) DeleteMechaTacticsGameTerrainTypeRec(recID string) error {
+	l := m.Logger("DeleteMechaTacticsGameTerrainTypeRec")
+
+	l.Debug("deleting mecha_tactics_game_terrain_type record ID >%s<", recID)
+
+	_, err := m.GetMechaTacticsGameTerrainTypeRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.MechaTacticsGameTerrainTypeRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+func (m *Domain) RemoveMechaTacticsGameTerrainTypeRec(recID string) error {
+	l := m.Logger("RemoveMechaTacticsGameTerrainTypeRec")
+
+	l.Debug("removing mecha_tactics_game_terrain_type record ID >%s<", recID)
+
+	r := m.MechaTacticsGameTerrainTypeRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
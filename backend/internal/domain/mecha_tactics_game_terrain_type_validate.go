@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+type validateMechaTacticsGameTerrainTypeArgs struct {
+	currRec *mecha_tactics_game_record.MechaTacticsGameTerrainType
+	nextRec *mecha_tactics_game_record.MechaTacticsGameTerrainType
+}
+
+func (m *Domain) validateMechaTacticsGameTerrainTypeRecForCreate(rec *mecha_tactics_game_record.MechaTacticsGameTerrainType) error {
+	args := &validateMechaTacticsGameTerrainTypeArgs{nextRec: rec}
+	return validateMechaTacticsGameTerrainTypeRec(args, false)
+}
+
+func (m *Domain) validateMechaTacticsGameTerrainTypeRecForUpdate(currRec, nextRec *mecha_tactics_game_record.MechaTacticsGameTerrainType) error {
+	args := &validateMechaTacticsGameTerrainTypeArgs{currRec: currRec, nextRec: nextRec}
+	return validateMechaTacticsGameTerrainTypeRec(args, true)
+}
+
+func validateMechaTacticsGameTerrainTypeRec(args *validateMechaTacticsGameTerrainTypeArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameTerrainTypeID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameTerrainTypeGameID, rec.GameID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateStringField(mecha_tactics_game_record.FieldMechaTacticsGameTerrainTypeName, rec.Name); err != nil {
+		return err
+	}
+
+	if rec.MovementPointCost <= 0 || rec.MovementPointCost > maxMechaTacticsTerrainMovementPointCost {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameTerrainTypeMovementPointCost, "", "movement_point_cost must be between 1 and 20")
+	}
+
+	if rec.CoverModifier < -maxMechaTacticsTerrainCoverModifier || rec.CoverModifier > maxMechaTacticsTerrainCoverModifier {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameTerrainTypeCoverModifier, "", "cover_modifier must be between -50 and 50")
+	}
+
+	return nil
+}
+
+// Bounds on terrain properties. A movement point cost above the largest
+// chassis speed would make the terrain impassable, and cover beyond +/-50
+// would swamp the 50% base hit chance.
+const (
+	maxMechaTacticsTerrainMovementPointCost = maxMechaTacticsChassisSpeed
+	maxMechaTacticsTerrainCoverModifier     = 50
+)
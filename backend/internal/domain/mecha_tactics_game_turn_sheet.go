@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+func (m *Domain) GetManyMechaTacticsGameTurnSheetRecs(opts *coresql.Options) ([]*mecha_tactics_game_record.MechaTacticsGameTurnSheet, error) {
+	l := m.Logger("GetManyMechaTacticsGameTurnSheetRecs")
+
+	l.Debug("getting many mecha_tactics_game_turn_sheet records opts >%#v<", opts)
+
+	r := m.MechaTacticsGameTurnSheetRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+func (m *Domain) GetMechaTacticsGameTurnSheetRec(recID string, lock *coresql.Lock) (*mecha_tactics_game_record.MechaTacticsGameTurnSheet, error) {
+	l := m.Logger("GetMechaTacticsGameTurnSheetRec")
+
+	l.Debug("getting mecha_tactics_game_turn_sheet record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.MechaTacticsGameTurnSheetRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(mecha_tactics_game_record.TableMechaTacticsGameTurnSheet, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) CreateMechaTacticsGameTurnSheetRec(rec *mecha_tactics_game_record.MechaTacticsGameTurnSheet) (*mecha_tactics_game_record.MechaTacticsGameTurnSheet, error) {
+	l := m.Logger("CreateMechaTacticsGameTurnSheetRec")
+
+	l.Debug("creating mecha_tactics_game_turn_sheet record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameTurnSheetRecForCreate(rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_turn_sheet record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameTurnSheetRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) RemoveMechaTacticsGameTurnSheetRec(recID string) error {
+	l := m.Logger("RemoveMechaTacticsGameTurnSheetRec")
+
+	l.Debug("removing mecha_tactics_game_turn_sheet record ID >%s<", recID)
+
+	r := m.MechaTacticsGameTurnSheetRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
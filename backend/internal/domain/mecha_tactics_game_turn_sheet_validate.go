@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+type validateMechaTacticsGameTurnSheetArgs struct {
+	nextRec *mecha_tactics_game_record.MechaTacticsGameTurnSheet
+}
+
+func (m *Domain) validateMechaTacticsGameTurnSheetRecForCreate(rec *mecha_tactics_game_record.MechaTacticsGameTurnSheet) error {
+	args := &validateMechaTacticsGameTurnSheetArgs{nextRec: rec}
+	return validateMechaTacticsGameTurnSheetRec(args)
+}
+
+func validateMechaTacticsGameTurnSheetRec(args *validateMechaTacticsGameTurnSheetArgs) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameTurnSheetGameID, rec.GameID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameTurnSheetMechaTacticsGameMechInstanceID, rec.MechaTacticsGameMechInstanceID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameTurnSheetGameTurnSheetID, rec.GameTurnSheetID); err != nil {
+		return err
+	}
+
+	return nil
+}
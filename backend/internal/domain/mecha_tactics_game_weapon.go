@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+func (m *Domain) GetManyMechaTacticsGameWeaponRecs(opts *coresql.Options) ([]*mecha_tactics_game_record.MechaTacticsGameWeapon, error) {
+	l := m.Logger("GetManyMechaTacticsGameWeaponRecs")
+
+	l.Debug("getting many mecha_tactics_game_weapon records opts >%#v<", opts)
+
+	r := m.MechaTacticsGameWeaponRepository()
+
+	recs, err := r.GetMany(opts)
+	if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return recs, nil
+}
+
+func (m *Domain) GetMechaTacticsGameWeaponRec(recID string, lock *coresql.Lock) (*mecha_tactics_game_record.MechaTacticsGameWeapon, error) {
+	l := m.Logger("GetMechaTacticsGameWeaponRec")
+
+	l.Debug("getting mecha_tactics_game_weapon record ID >%s<", recID)
+
+	if err := domain.ValidateUUIDField("id", recID); err != nil {
+		return nil, err
+	}
+
+	r := m.MechaTacticsGameWeaponRepository()
+
+	rec, err := r.GetOne(recID, lock)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, coreerror.NewNotFoundError(mecha_tactics_game_record.TableMechaTacticsGameWeapon, recID)
+	} else if err != nil {
+		return nil, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) CreateMechaTacticsGameWeaponRec(rec *mecha_tactics_game_record.MechaTacticsGameWeapon) (*mecha_tactics_game_record.MechaTacticsGameWeapon, error) {
+	l := m.Logger("CreateMechaTacticsGameWeaponRec")
+
+	l.Debug("creating mecha_tactics_game_weapon record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameWeaponRecForCreate(rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_weapon record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameWeaponRepository()
+
+	var err error
+	rec, err = r.CreateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return rec, nil
+}
+
+func (m *Domain) UpdateMechaTacticsGameWeaponRec(rec *mecha_tactics_game_record.MechaTacticsGameWeapon) (*mecha_tactics_game_record.MechaTacticsGameWeapon, error) {
+	l := m.Logger("UpdateMechaTacticsGameWeaponRec")
+
+	currRec, err := m.GetMechaTacticsGameWeaponRec(rec.ID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return rec, err
+	}
+
+	l.Debug("updating mecha_tactics_game_weapon record >%#v<", rec)
+
+	if err := m.validateMechaTacticsGameWeaponRecForUpdate(currRec, rec); err != nil {
+		l.Warn("failed to validate mecha_tactics_game_weapon record >%v<", err)
+		return rec, err
+	}
+
+	r := m.MechaTacticsGameWeaponRepository()
+
+	updatedRec, err := r.UpdateOne(rec)
+	if err != nil {
+		return rec, databaseError(err)
+	}
+
+	return updatedRec, nil
+}
+
+func (m *Domain) DeleteMechaTacticsGameWeaponRec(recID string) error {
+	l := m.Logger("DeleteMechaTacticsGameWeaponRec")
+
+	l.Debug("deleting mecha_tactics_game_weapon record ID >%s<", recID)
+
+	_, err := m.GetMechaTacticsGameWeaponRec(recID, coresql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	r := m.MechaTacticsGameWeaponRepository()
+
+	if err := r.DeleteOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
+
+func (m *Domain) RemoveMechaTacticsGameWeaponRec(recID string) error {
+	l := m.Logger("RemoveMechaTacticsGameWeaponRec")
+
+	l.Debug("removing mecha_tactics_game_weapon record ID >%s<", recID)
+
+	r := m.MechaTacticsGameWeaponRepository()
+
+	if err := r.RemoveOne(recID); err != nil {
+		return databaseError(err)
+	}
+
+	return nil
+}
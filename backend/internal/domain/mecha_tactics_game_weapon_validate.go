@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/domain"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+type validateMechaTacticsGameWeaponArgs struct {
+	currRec *mecha_tactics_game_record.MechaTacticsGameWeapon
+	nextRec *mecha_tactics_game_record.MechaTacticsGameWeapon
+}
+
+func (m *Domain) validateMechaTacticsGameWeaponRecForCreate(rec *mecha_tactics_game_record.MechaTacticsGameWeapon) error {
+	args := &validateMechaTacticsGameWeaponArgs{nextRec: rec}
+	return validateMechaTacticsGameWeaponRec(args, false)
+}
+
+func (m *Domain) validateMechaTacticsGameWeaponRecForUpdate(currRec, nextRec *mecha_tactics_game_record.MechaTacticsGameWeapon) error {
+	args := &validateMechaTacticsGameWeaponArgs{currRec: currRec, nextRec: nextRec}
+	return validateMechaTacticsGameWeaponRec(args, true)
+}
+
+func validateMechaTacticsGameWeaponRec(args *validateMechaTacticsGameWeaponArgs, requireID bool) error {
+	rec := args.nextRec
+
+	if rec == nil {
+		return coreerror.NewInvalidDataError("record is nil")
+	}
+
+	if requireID {
+		if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameWeaponID, rec.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := domain.ValidateUUIDField(mecha_tactics_game_record.FieldMechaTacticsGameWeaponGameID, rec.GameID); err != nil {
+		return err
+	}
+
+	if err := domain.ValidateStringField(mecha_tactics_game_record.FieldMechaTacticsGameWeaponName, rec.Name); err != nil {
+		return err
+	}
+
+	validRangeBands := map[string]bool{
+		mecha_tactics_game_record.WeaponRangeBandShort:  true,
+		mecha_tactics_game_record.WeaponRangeBandMedium: true,
+		mecha_tactics_game_record.WeaponRangeBandLong:   true,
+	}
+	if rec.RangeBand == "" {
+		rec.RangeBand = mecha_tactics_game_record.WeaponRangeBandMedium
+	}
+	if !validRangeBands[rec.RangeBand] {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameWeaponRangeBand, rec.RangeBand, "must be one of: short, medium, long")
+	}
+
+	validMountSizes := map[string]bool{
+		mecha_tactics_game_record.WeaponMountSizeSmall:  true,
+		mecha_tactics_game_record.WeaponMountSizeMedium: true,
+		mecha_tactics_game_record.WeaponMountSizeLarge:  true,
+	}
+	if rec.MountSize == "" {
+		rec.MountSize = mecha_tactics_game_record.WeaponMountSizeMedium
+	}
+	if !validMountSizes[rec.MountSize] {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameWeaponMountSize, rec.MountSize, "must be one of: small, medium, large")
+	}
+
+	if rec.Damage <= 0 || rec.Damage > maxWeaponDamage {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameWeaponDamage, "", "damage must be between 1 and 20")
+	}
+
+	if rec.HeatCost < 0 || rec.HeatCost > maxWeaponHeatCost {
+		return InvalidField(mecha_tactics_game_record.FieldMechaTacticsGameWeaponHeatCost, "", "heat_cost must be between 0 and 20")
+	}
+
+	return nil
+}
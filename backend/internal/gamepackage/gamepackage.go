@@ -0,0 +1,569 @@
+// Package gamepackage reads and writes portable game packages.
+//
+// A game package is a ZIP archive holding a complete game definition so a
+// design can be shared, backed up, kept under version control or moved between
+// environments. The archive contains a single game.json manifest and the game
+// images under images/.
+//
+// Packages never contain database identifiers. Every entity is given a ref that
+// is unique within the package and relationships between entities are expressed
+// with those refs, so a package can be imported any number of times, into any
+// environment, as a new game. Exporting the same game twice produces the same
+// archive, which keeps packages friendly to version control.
+//
+// Packages carry a format version. Readers reject packages with a newer format
+// version than they understand.
+package gamepackage
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+// FormatVersion is the current package format version
+const FormatVersion = 1
+
+// ManifestName is the name of the game definition entry within a package
+const ManifestName = "game.json"
+
+// ImageDir is the directory within a package holding game images
+const ImageDir = "images"
+
+// GameRef is the ref of the packaged game itself, used by translations of the
+// game's own name and description and by game level images
+const GameRef = "game"
+
+// Package limits, checked against decompressed sizes when reading a package
+const (
+	MaxSize          int = 52428800 // 50MB in bytes, the compressed package size
+	MaxEntries       int = 1000
+	MaxManifestSize  int = 10485760  // 10MB in bytes
+	MaxImageSize     int = 1048576   // 1MB in bytes
+	MaxTotalReadSize int = 209715200 // 200MB in bytes
+)
+
+// ErrNotPackage is returned when data is not a ZIP archive
+var ErrNotPackage = errors.New("game package must be a ZIP archive")
+
+// Package is a complete, ID independent game definition
+type Package struct {
+	FormatVersion int           `json:"format_version"`
+	Game          Game          `json:"game"`
+	Translations  []Translation `json:"translations,omitempty"`
+	Images        []Image       `json:"images,omitempty"`
+	Adventure     *Adventure    `json:"adventure,omitempty"`
+	Mecha         *Mecha        `json:"mecha,omitempty"`
+	MechaTactics  *MechaTactics `json:"mecha_tactics,omitempty"`
+}
+
+// Game is the packaged game
+type Game struct {
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	GameType          string `json:"game_type"`
+	TurnDurationHours int    `json:"turn_duration_hours"`
+}
+
+// Translation is a designer supplied translation of a text field of the game
+// or one of its entities
+type Translation struct {
+	RecordRef string `json:"record_ref"`
+	Locale    string `json:"locale"`
+	FieldName string `json:"field_name"`
+	Text      string `json:"text"`
+}
+
+// Image is a game image. RecordRef is empty for game level images. Data holds
+// the image file contents, stored in the package at File. The image format and
+// dimensions are read from the image itself when the package is imported.
+type Image struct {
+	RecordRef     string `json:"record_ref,omitempty"`
+	Type          string `json:"type"`
+	TurnSheetType string `json:"turn_sheet_type,omitempty"`
+	File          string `json:"file"`
+	Data          []byte `json:"-"`
+}
+
+// Adventure is the packaged definition of an adventure game
+type Adventure struct {
+	Locations                []AdventureLocation                `json:"locations,omitempty"`
+	Items                    []AdventureItem                    `json:"items,omitempty"`
+	Creatures                []AdventureCreature                `json:"creatures,omitempty"`
+	LocationLinks            []AdventureLocationLink            `json:"location_links,omitempty"`
+	LocationLinkRequirements []AdventureLocationLinkRequirement `json:"location_link_requirements,omitempty"`
+	LocationObjects          []AdventureLocationObject          `json:"location_objects,omitempty"`
+	LocationObjectStates     []AdventureLocationObjectState     `json:"location_object_states,omitempty"`
+	LocationObjectEffects    []AdventureLocationObjectEffect    `json:"location_object_effects,omitempty"`
+	ItemEffects              []AdventureItemEffect              `json:"item_effects,omitempty"`
+	ItemPlacements           []AdventurePlacement               `json:"item_placements,omitempty"`
+	CreaturePlacements       []AdventurePlacement               `json:"creature_placements,omitempty"`
+}
+
+type AdventureLocation struct {
+	Ref                string `json:"ref"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	IsStartingLocation bool   `json:"is_starting_location"`
+	IsGoalLocation     bool   `json:"is_goal_location"`
+}
+
+type AdventureItem struct {
+	Ref            string  `json:"ref"`
+	Name           string  `json:"name"`
+	Description    string  `json:"description"`
+	CanBeEquipped  bool    `json:"can_be_equipped"`
+	ItemCategory   *string `json:"item_category,omitempty"`
+	EquipmentSlot  *string `json:"equipment_slot,omitempty"`
+	IsStartingItem bool    `json:"is_starting_item"`
+	IsGoalItem     bool    `json:"is_goal_item"`
+}
+
+type AdventureCreature struct {
+	Ref               string `json:"ref"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	AttackDamage      int    `json:"attack_damage"`
+	Defense           int    `json:"defense"`
+	Disposition       string `json:"disposition"`
+	MaxHealth         int    `json:"max_health"`
+	AttackMethod      string `json:"attack_method"`
+	AttackDescription string `json:"attack_description"`
+	BodyDecayTurns    int    `json:"body_decay_turns"`
+	RespawnTurns      int    `json:"respawn_turns"`
+}
+
+type AdventureLocationLink struct {
+	Ref                  string  `json:"ref"`
+	FromLocationRef      string  `json:"from_location_ref"`
+	ToLocationRef        string  `json:"to_location_ref"`
+	Name                 string  `json:"name"`
+	Description          string  `json:"description"`
+	LockedDescription    *string `json:"locked_description,omitempty"`
+	TraversalDescription *string `json:"traversal_description,omitempty"`
+}
+
+type AdventureLocationLinkRequirement struct {
+	Ref             string `json:"ref"`
+	LocationLinkRef string `json:"location_link_ref"`
+	ItemRef         string `json:"item_ref,omitempty"`
+	CreatureRef     string `json:"creature_ref,omitempty"`
+	Purpose         string `json:"purpose"`
+	Condition       string `json:"condition"`
+	Quantity        int    `json:"quantity"`
+}
+
+type AdventureLocationObject struct {
+	Ref             string `json:"ref"`
+	LocationRef     string `json:"location_ref"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	InitialStateRef string `json:"initial_state_ref,omitempty"`
+	IsHidden        bool   `json:"is_hidden"`
+}
+
+type AdventureLocationObjectState struct {
+	Ref               string `json:"ref"`
+	LocationObjectRef string `json:"location_object_ref"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	SortOrder         int    `json:"sort_order"`
+}
+
+type AdventureLocationObjectEffect struct {
+	Ref                     string `json:"ref"`
+	LocationObjectRef       string `json:"location_object_ref"`
+	ActionType              string `json:"action_type"`
+	RequiredStateRef        string `json:"required_state_ref,omitempty"`
+	RequiredItemRef         string `json:"required_item_ref,omitempty"`
+	ResultDescription       string `json:"result_description"`
+	EffectType              string `json:"effect_type"`
+	ResultStateRef          string `json:"result_state_ref,omitempty"`
+	ResultItemRef           string `json:"result_item_ref,omitempty"`
+	ResultLocationLinkRef   string `json:"result_location_link_ref,omitempty"`
+	ResultCreatureRef       string `json:"result_creature_ref,omitempty"`
+	ResultLocationObjectRef string `json:"result_location_object_ref,omitempty"`
+	ResultLocationRef       string `json:"result_location_ref,omitempty"`
+	ResultValueMin          *int32 `json:"result_value_min,omitempty"`
+	ResultValueMax          *int32 `json:"result_value_max,omitempty"`
+	IsRepeatable            bool   `json:"is_repeatable"`
+}
+
+type AdventureItemEffect struct {
+	Ref                   string `json:"ref"`
+	ItemRef               string `json:"item_ref"`
+	ActionType            string `json:"action_type"`
+	RequiredItemRef       string `json:"required_item_ref,omitempty"`
+	RequiredLocationRef   string `json:"required_location_ref,omitempty"`
+	ResultDescription     string `json:"result_description"`
+	EffectType            string `json:"effect_type"`
+	ResultItemRef         string `json:"result_item_ref,omitempty"`
+	ResultLocationLinkRef string `json:"result_location_link_ref,omitempty"`
+	ResultCreatureRef     string `json:"result_creature_ref,omitempty"`
+	ResultLocationRef     string `json:"result_location_ref,omitempty"`
+	ResultValueMin        *int32 `json:"result_value_min,omitempty"`
+	ResultValueMax        *int32 `json:"result_value_max,omitempty"`
+	IsRepeatable          bool   `json:"is_repeatable"`
+}
+
+// AdventurePlacement places an initial count of an item or creature at a
+// location. EntityRef is an item ref for item placements and a creature ref for
+// creature placements.
+type AdventurePlacement struct {
+	Ref          string `json:"ref"`
+	EntityRef    string `json:"entity_ref"`
+	LocationRef  string `json:"location_ref"`
+	InitialCount int    `json:"initial_count"`
+}
+
+// Mecha is the packaged definition of a mecha game
+type Mecha struct {
+	Chassis           []MechaChassis     `json:"chassis,omitempty"`
+	Weapons           []MechaWeapon      `json:"weapons,omitempty"`
+	Equipment         []MechaEquipment   `json:"equipment,omitempty"`
+	Sectors           []MechaSector      `json:"sectors,omitempty"`
+	SectorLinks       []MechaSectorLink  `json:"sector_links,omitempty"`
+	ComputerOpponents []ComputerOpponent `json:"computer_opponents,omitempty"`
+	Squads            []MechaSquad       `json:"squads,omitempty"`
+	SquadMechs        []MechaSquadMech   `json:"squad_mechs,omitempty"`
+}
+
+type MechaChassis struct {
+	Ref             string `json:"ref"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	ChassisClass    string `json:"chassis_class"`
+	ArmorPoints     int    `json:"armor_points"`
+	StructurePoints int    `json:"structure_points"`
+	HeatCapacity    int    `json:"heat_capacity"`
+	Speed           int    `json:"speed"`
+	SmallSlots      int    `json:"small_slots"`
+	MediumSlots     int    `json:"medium_slots"`
+	LargeSlots      int    `json:"large_slots"`
+	// SensorRange is absent from packages exported before chassis had
+	// sensors; those import with the default sensor range
+	SensorRange *int `json:"sensor_range,omitempty"`
+}
+
+type MechaWeapon struct {
+	Ref          string `json:"ref"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Damage       int    `json:"damage"`
+	HeatCost     int    `json:"heat_cost"`
+	RangeBand    string `json:"range_band"`
+	MountSize    string `json:"mount_size"`
+	AmmoCapacity int    `json:"ammo_capacity"`
+}
+
+type MechaEquipment struct {
+	Ref         string `json:"ref"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	MountSize   string `json:"mount_size"`
+	EffectKind  string `json:"effect_kind"`
+	Magnitude   int    `json:"magnitude"`
+	HeatCost    int    `json:"heat_cost"`
+}
+
+type MechaSector struct {
+	Ref               string `json:"ref"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	TerrainType       string `json:"terrain_type"`
+	Elevation         int    `json:"elevation"`
+	CoverModifier     int    `json:"cover_modifier"`
+	IsStartingSector  bool   `json:"is_starting_sector"`
+	IsObjectiveSector bool   `json:"is_objective_sector"`
+	VictoryPoints     int    `json:"victory_points"`
+	CaptureTurns      int    `json:"capture_turns"`
+}
+
+type MechaSectorLink struct {
+	Ref           string `json:"ref"`
+	FromSectorRef string `json:"from_sector_ref"`
+	ToSectorRef   string `json:"to_sector_ref"`
+}
+
+type MechaSquad struct {
+	Ref         string `json:"ref"`
+	SquadType   string `json:"squad_type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type MechaSquadMech struct {
+	Ref        string           `json:"ref"`
+	SquadRef   string           `json:"squad_ref"`
+	ChassisRef string           `json:"chassis_ref"`
+	Callsign   string           `json:"callsign"`
+	Weapons    []WeaponMount    `json:"weapons,omitempty"`
+	Equipment  []EquipmentMount `json:"equipment,omitempty"`
+}
+
+// MechaTactics is the packaged definition of a mecha tactics game
+type MechaTactics struct {
+	Chassis           []MechaTacticsChassis     `json:"chassis,omitempty"`
+	Weapons           []MechaTacticsWeapon      `json:"weapons,omitempty"`
+	TerrainTypes      []MechaTacticsTerrainType `json:"terrain_types,omitempty"`
+	Hexes             []MechaTacticsHex         `json:"hexes,omitempty"`
+	Mechs             []MechaTacticsMech        `json:"mechs,omitempty"`
+	ComputerOpponents []ComputerOpponent        `json:"computer_opponents,omitempty"`
+}
+
+type MechaTacticsChassis struct {
+	Ref             string `json:"ref"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	ChassisClass    string `json:"chassis_class"`
+	ArmorPoints     int    `json:"armor_points"`
+	StructurePoints int    `json:"structure_points"`
+	HeatCapacity    int    `json:"heat_capacity"`
+	Speed           int    `json:"speed"`
+}
+
+type MechaTacticsWeapon struct {
+	Ref         string `json:"ref"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Damage      int    `json:"damage"`
+	HeatCost    int    `json:"heat_cost"`
+	RangeBand   string `json:"range_band"`
+	MountSize   string `json:"mount_size"`
+}
+
+type MechaTacticsTerrainType struct {
+	Ref               string `json:"ref"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	MovementPointCost int    `json:"movement_point_cost"`
+	CoverModifier     int    `json:"cover_modifier"`
+}
+
+type MechaTacticsHex struct {
+	Ref            string `json:"ref"`
+	TerrainTypeRef string `json:"terrain_type_ref"`
+	HexColumn      int    `json:"hex_column"`
+	HexRow         int    `json:"hex_row"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Elevation      int    `json:"elevation"`
+	IsStartingHex  bool   `json:"is_starting_hex"`
+}
+
+type MechaTacticsMech struct {
+	Ref        string        `json:"ref"`
+	ChassisRef string        `json:"chassis_ref"`
+	MechType   string        `json:"mech_type"`
+	Callsign   string        `json:"callsign"`
+	Weapons    []WeaponMount `json:"weapons,omitempty"`
+}
+
+// ComputerOpponent is a computer controlled opponent of a mecha or mecha
+// tactics game
+type ComputerOpponent struct {
+	Ref         string `json:"ref"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Aggression  int    `json:"aggression"`
+	IQ          int    `json:"iq"`
+}
+
+// WeaponMount mounts a weapon in a mech slot
+type WeaponMount struct {
+	WeaponRef    string `json:"weapon_ref"`
+	SlotLocation string `json:"slot_location"`
+}
+
+// EquipmentMount mounts equipment in a mech slot
+type EquipmentMount struct {
+	EquipmentRef string `json:"equipment_ref"`
+	SlotLocation string `json:"slot_location"`
+}
+
+// Write validates a package and writes it as a ZIP archive
+func Write(pkg *Package) ([]byte, error) {
+	if err := Validate(pkg); err != nil {
+		return nil, err
+	}
+
+	manifest, err := json.MarshalIndent(pkg, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal game package manifest: %w", err)
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	// Entry headers carry no modification time so exporting the same game
+	// always produces the same archive
+	if err := writeEntry(zw, ManifestName, manifest); err != nil {
+		return nil, err
+	}
+	for _, img := range pkg.Images {
+		if err := writeEntry(zw, img.File, img.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close game package archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeEntry(zw *zip.Writer, name string, data []byte) error {
+	w, err := zw.CreateHeader(&zip.FileHeader{
+		Name:   name,
+		Method: zip.Deflate,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create game package entry >%s<: %w", name, err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("failed to write game package entry >%s<: %w", name, err)
+	}
+	return nil
+}
+
+// Read reads and validates a package from a ZIP archive
+func Read(data []byte) (*Package, error) {
+	if len(data) > MaxSize {
+		return nil, fmt.Errorf("game package is larger than %d bytes", MaxSize)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, ErrNotPackage
+	}
+
+	if len(zr.File) > MaxEntries {
+		return nil, fmt.Errorf("game package has more than %d entries", MaxEntries)
+	}
+
+	entries := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		entries[f.Name] = f
+	}
+
+	r := &reader{}
+
+	manifestFile, ok := entries[ManifestName]
+	if !ok {
+		return nil, fmt.Errorf("game package does not contain %s", ManifestName)
+	}
+
+	manifest, err := r.readEntry(manifestFile, MaxManifestSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the format version before decoding the rest of the manifest so
+	// newer packages report a clear error rather than a decoding failure
+	var version struct {
+		FormatVersion int `json:"format_version"`
+	}
+	if err := json.Unmarshal(manifest, &version); err != nil {
+		return nil, fmt.Errorf("game package %s is not valid JSON: %w", ManifestName, err)
+	}
+	if err := validateFormatVersion(version.FormatVersion); err != nil {
+		return nil, err
+	}
+
+	pkg := &Package{}
+	dec := json.NewDecoder(bytes.NewReader(manifest))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(pkg); err != nil {
+		return nil, fmt.Errorf("game package %s is invalid: %w", ManifestName, err)
+	}
+
+	for idx := range pkg.Images {
+		img := &pkg.Images[idx]
+		if err := validateImageFile(img.File); err != nil {
+			return nil, err
+		}
+		f, ok := entries[img.File]
+		if !ok {
+			return nil, fmt.Errorf("game package does not contain image file >%s<", img.File)
+		}
+		img.Data, err = r.readEntry(f, MaxImageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := Validate(pkg); err != nil {
+		return nil, err
+	}
+
+	return pkg, nil
+}
+
+// reader tracks the total decompressed size of the entries read from a package
+type reader struct {
+	total int
+}
+
+func (r *reader) readEntry(f *zip.File, maxSize int) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open game package entry >%s<: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	// Never trust the sizes recorded in the archive, read at most one byte
+	// over the limit to detect oversized entries
+	data, err := io.ReadAll(io.LimitReader(rc, int64(maxSize)+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read game package entry >%s<: %w", f.Name, err)
+	}
+	if len(data) > maxSize {
+		return nil, fmt.Errorf("game package entry >%s< is larger than %d bytes", f.Name, maxSize)
+	}
+
+	r.total += len(data)
+	if r.total > MaxTotalReadSize {
+		return nil, fmt.Errorf("game package contents are larger than %d bytes", MaxTotalReadSize)
+	}
+
+	return data, nil
+}
+
+// ImageFile returns the package file name for an image
+func ImageFile(name, mimeType string) string {
+	ext := ".bin"
+	switch mimeType {
+	case game_record.GameImageMimeTypeWebP:
+		ext = ".webp"
+	case game_record.GameImageMimeTypePNG:
+		ext = ".png"
+	case game_record.GameImageMimeTypeJPEG:
+		ext = ".jpg"
+	}
+	return path.Join(ImageDir, name+ext)
+}
+
+func validateImageFile(name string) error {
+	if name == "" || path.Clean(name) != name || path.Dir(name) != ImageDir || strings.HasPrefix(path.Base(name), ".") {
+		return fmt.Errorf("game package image file >%s< must be a file in %s/", name, ImageDir)
+	}
+	return nil
+}
+
+func validateFormatVersion(version int) error {
+	if version < 1 {
+		return errors.New("game package format_version is required")
+	}
+	if version > FormatVersion {
+		return fmt.Errorf("game package format version %d is newer than the supported version %d", version, FormatVersion)
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package gamepackage
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+func testAdventurePackage() *Package {
+	return &Package{
+		FormatVersion: FormatVersion,
+		Game: Game{
+			Name:              "The Lost Caves",
+			Description:       "Find the way out of the caves",
+			GameType:          game_record.GameTypeAdventure,
+			TurnDurationHours: 168,
+		},
+		Adventure: &Adventure{
+			Locations: []AdventureLocation{
+				{Ref: "location-1", Name: "Cave Mouth", IsStartingLocation: true},
+				{Ref: "location-2", Name: "Deep Cave", IsGoalLocation: true},
+			},
+			Items: []AdventureItem{
+				{Ref: "item-1", Name: "Rusty Key"},
+			},
+			LocationLinks: []AdventureLocationLink{
+				{Ref: "location-link-1", FromLocationRef: "location-1", ToLocationRef: "location-2", Name: "Narrow Passage"},
+			},
+			LocationLinkRequirements: []AdventureLocationLinkRequirement{
+				{Ref: "location-link-requirement-1", LocationLinkRef: "location-link-1", ItemRef: "item-1", Purpose: "traverse", Condition: "in_inventory", Quantity: 1},
+			},
+			LocationObjects: []AdventureLocationObject{
+				{Ref: "location-object-1", LocationRef: "location-1", Name: "Chest", InitialStateRef: "location-object-state-1"},
+			},
+			LocationObjectStates: []AdventureLocationObjectState{
+				{Ref: "location-object-state-1", LocationObjectRef: "location-object-1", Name: "closed"},
+				{Ref: "location-object-state-2", LocationObjectRef: "location-object-1", Name: "open", SortOrder: 1},
+			},
+			LocationObjectEffects: []AdventureLocationObjectEffect{
+				{
+					Ref:               "location-object-effect-1",
+					LocationObjectRef: "location-object-1",
+					ActionType:        "open",
+					RequiredStateRef:  "location-object-state-1",
+					EffectType:        "change_state",
+					ResultStateRef:    "location-object-state-2",
+				},
+			},
+			ItemPlacements: []AdventurePlacement{
+				{Ref: "item-placement-1", EntityRef: "item-1", LocationRef: "location-1", InitialCount: 1},
+			},
+		},
+		Translations: []Translation{
+			{RecordRef: GameRef, Locale: "de", FieldName: "name", Text: "Die verlorenen Höhlen"},
+			{RecordRef: "location-1", Locale: "de", FieldName: "name", Text: "Höhleneingang"},
+		},
+		Images: []Image{
+			{RecordRef: "location-1", Type: game_record.GameImageTypeTurnSheetBackground, TurnSheetType: "adventure_game_location_choice", File: "images/image-1.png", Data: []byte("image data")},
+		},
+	}
+}
+
+func Test_WriteRead(t *testing.T) {
+	pkg := testAdventurePackage()
+
+	data, err := Write(pkg)
+	require.NoError(t, err, "Write returns without error")
+
+	again, err := Write(testAdventurePackage())
+	require.NoError(t, err, "Write returns without error")
+	require.Equal(t, data, again, "writing the same package twice produces the same archive")
+
+	got, err := Read(data)
+	require.NoError(t, err, "Read returns without error")
+	require.Equal(t, pkg, got, "package read matches package written")
+}
+
+func Test_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(pkg *Package)
+		errMsg string
+	}{
+		{
+			name:   "valid package then valid",
+			modify: func(pkg *Package) {},
+		},
+		{
+			name:   "newer format version then invalid",
+			modify: func(pkg *Package) { pkg.FormatVersion = FormatVersion + 1 },
+			errMsg: "newer than the supported version",
+		},
+		{
+			name:   "missing game name then invalid",
+			modify: func(pkg *Package) { pkg.Game.Name = "" },
+			errMsg: "game name is required",
+		},
+		{
+			name:   "definition for another game type then invalid",
+			modify: func(pkg *Package) { pkg.Mecha = &Mecha{} },
+			errMsg: "cannot contain a mecha definition",
+		},
+		{
+			name: "duplicate ref then invalid",
+			modify: func(pkg *Package) {
+				pkg.Adventure.Items[0].Ref = "location-1"
+			},
+			errMsg: "used more than once",
+		},
+		{
+			name: "link to unknown location then invalid",
+			modify: func(pkg *Package) {
+				pkg.Adventure.LocationLinks[0].ToLocationRef = "location-9"
+			},
+			errMsg: "to_location_ref >location-9<",
+		},
+		{
+			name: "ref to the wrong kind of entity then invalid",
+			modify: func(pkg *Package) {
+				pkg.Adventure.LocationLinkRequirements[0].ItemRef = "location-2"
+			},
+			errMsg: "does not reference a known adventure item",
+		},
+		{
+			name: "initial state of another object then invalid",
+			modify: func(pkg *Package) {
+				pkg.Adventure.LocationObjects = append(pkg.Adventure.LocationObjects, AdventureLocationObject{
+					Ref: "location-object-2", LocationRef: "location-2", Name: "Crate", InitialStateRef: "location-object-state-1",
+				})
+			},
+			errMsg: "does not reference a state of location object >location-object-2<",
+		},
+		{
+			name:   "translation of unknown entity then invalid",
+			modify: func(pkg *Package) { pkg.Translations[1].RecordRef = "location-9" },
+			errMsg: "translation record_ref",
+		},
+		{
+			name:   "image outside the image directory then invalid",
+			modify: func(pkg *Package) { pkg.Images[0].File = "../image-1.png" },
+			errMsg: "must be a file in images/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := testAdventurePackage()
+			tt.modify(pkg)
+
+			err := Validate(pkg)
+			if tt.errMsg == "" {
+				require.NoError(t, err, "Validate returns without error")
+				return
+			}
+			require.Error(t, err, "Validate returns an error")
+			require.Contains(t, err.Error(), tt.errMsg, "Validate error describes the problem")
+		})
+	}
+}
+
+func Test_Read(t *testing.T) {
+	manifest := func(t *testing.T, pkg *Package) []byte {
+		t.Helper()
+		data, err := json.Marshal(pkg)
+		require.NoError(t, err, "Marshal returns without error")
+		return data
+	}
+
+	archive := func(t *testing.T, entries map[string][]byte) []byte {
+		t.Helper()
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		for name, data := range entries {
+			w, err := zw.Create(name)
+			require.NoError(t, err, "Create returns without error")
+			_, err = w.Write(data)
+			require.NoError(t, err, "Write returns without error")
+		}
+		require.NoError(t, zw.Close(), "Close returns without error")
+		return buf.Bytes()
+	}
+
+	tests := []struct {
+		name   string
+		data   func(t *testing.T) []byte
+		errMsg string
+	}{
+		{
+			name:   "not a ZIP archive then error",
+			data:   func(t *testing.T) []byte { return []byte("not a zip") },
+			errMsg: ErrNotPackage.Error(),
+		},
+		{
+			name: "missing manifest then error",
+			data: func(t *testing.T) []byte {
+				return archive(t, map[string][]byte{"readme.txt": []byte("hello")})
+			},
+			errMsg: "does not contain game.json",
+		},
+		{
+			name: "newer format version then error",
+			data: func(t *testing.T) []byte {
+				return archive(t, map[string][]byte{ManifestName: []byte(`{"format_version": 99, "game": {"unknown": true}}`)})
+			},
+			errMsg: "newer than the supported version",
+		},
+		{
+			name: "unknown manifest field then error",
+			data: func(t *testing.T) []byte {
+				return archive(t, map[string][]byte{ManifestName: []byte(`{"format_version": 1, "unknown": true}`)})
+			},
+			errMsg: "unknown field",
+		},
+		{
+			name: "missing image file then error",
+			data: func(t *testing.T) []byte {
+				return archive(t, map[string][]byte{ManifestName: manifest(t, testAdventurePackage())})
+			},
+			errMsg: "does not contain image file >images/image-1.png<",
+		},
+		{
+			name: "oversized image file then error",
+			data: func(t *testing.T) []byte {
+				return archive(t, map[string][]byte{
+					ManifestName:         manifest(t, testAdventurePackage()),
+					"images/image-1.png": make([]byte, MaxImageSize+1),
+				})
+			},
+			errMsg: "is larger than",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(tt.data(t))
+			require.Error(t, err, "Read returns an error")
+			require.Contains(t, err.Error(), tt.errMsg, "Read error describes the problem")
+		})
+	}
+}
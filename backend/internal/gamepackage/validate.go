@@ -0,0 +1,449 @@
+package gamepackage
+
+import (
+	"errors"
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+// Entity kinds, used to check refs resolve to the right kind of entity
+const (
+	kindGame                    = "game"
+	kindAdventureLocation       = "adventure location"
+	kindAdventureItem           = "adventure item"
+	kindAdventureCreature       = "adventure creature"
+	kindAdventureLocationLink   = "adventure location link"
+	kindAdventureLocationObject = "adventure location object"
+	kindAdventureObjectState    = "adventure location object state"
+	kindMechaChassis            = "mecha chassis"
+	kindMechaWeapon             = "mecha weapon"
+	kindMechaEquipment          = "mecha equipment"
+	kindMechaSector             = "mecha sector"
+	kindMechaSquad              = "mecha squad"
+	kindMechaTacticsChassis     = "mecha tactics chassis"
+	kindMechaTacticsWeapon      = "mecha tactics weapon"
+	kindMechaTacticsTerrainType = "mecha tactics terrain type"
+	kindOther                   = "entity"
+)
+
+// refs maps each ref in a package to the kind of entity it identifies
+type refs map[string]string
+
+func (r refs) add(kind, ref string) error {
+	if ref == "" {
+		return fmt.Errorf("%s ref is required", kind)
+	}
+	if _, ok := r[ref]; ok {
+		return fmt.Errorf("ref >%s< is used more than once", ref)
+	}
+	r[ref] = kind
+	return nil
+}
+
+// require checks a required ref resolves to an entity of the given kind
+func (r refs) require(field, ref, kind string) error {
+	if ref == "" {
+		return fmt.Errorf("%s is required", field)
+	}
+	return r.optional(field, ref, kind)
+}
+
+// optional checks an optional ref, when set, resolves to an entity of the
+// given kind
+func (r refs) optional(field, ref, kind string) error {
+	if ref == "" {
+		return nil
+	}
+	if got, ok := r[ref]; !ok || got != kind {
+		return fmt.Errorf("%s >%s< does not reference a known %s", field, ref, kind)
+	}
+	return nil
+}
+
+// Validate checks a package is structurally complete. Every ref must be unique
+// and every reference between entities must resolve to an entity of the right
+// kind. Field values are validated when the package is imported.
+func Validate(pkg *Package) error {
+	if pkg == nil {
+		return errors.New("game package is empty")
+	}
+
+	if err := validateFormatVersion(pkg.FormatVersion); err != nil {
+		return err
+	}
+
+	if pkg.Game.Name == "" {
+		return errors.New("game name is required")
+	}
+
+	sections := map[string]bool{
+		game_record.GameTypeAdventure:    pkg.Adventure != nil,
+		game_record.GameTypeMecha:        pkg.Mecha != nil,
+		game_record.GameTypeMechaTactics: pkg.MechaTactics != nil,
+	}
+	if _, ok := sections[pkg.Game.GameType]; !ok {
+		return fmt.Errorf("game type >%s< is not supported", pkg.Game.GameType)
+	}
+	for gameType, present := range sections {
+		if present && gameType != pkg.Game.GameType {
+			return fmt.Errorf("game package for a %s game cannot contain a %s definition", pkg.Game.GameType, gameType)
+		}
+	}
+
+	r := refs{GameRef: kindGame}
+
+	var err error
+	switch {
+	case pkg.Adventure != nil:
+		err = validateAdventure(r, pkg.Adventure)
+	case pkg.Mecha != nil:
+		err = validateMecha(r, pkg.Mecha)
+	case pkg.MechaTactics != nil:
+		err = validateMechaTactics(r, pkg.MechaTactics)
+	}
+	if err != nil {
+		return err
+	}
+
+	for idx := range pkg.Translations {
+		t := &pkg.Translations[idx]
+		if _, ok := r[t.RecordRef]; !ok {
+			return fmt.Errorf("translation record_ref >%s< does not reference an entity", t.RecordRef)
+		}
+	}
+
+	files := make(map[string]bool, len(pkg.Images))
+	for idx := range pkg.Images {
+		img := &pkg.Images[idx]
+		if img.RecordRef != "" {
+			if _, ok := r[img.RecordRef]; !ok {
+				return fmt.Errorf("image record_ref >%s< does not reference an entity", img.RecordRef)
+			}
+		}
+		if err := validateImageFile(img.File); err != nil {
+			return err
+		}
+		if files[img.File] {
+			return fmt.Errorf("image file >%s< is used more than once", img.File)
+		}
+		files[img.File] = true
+		if len(img.Data) == 0 {
+			return fmt.Errorf("image file >%s< is empty", img.File)
+		}
+	}
+
+	return nil
+}
+
+func validateAdventure(r refs, a *Adventure) error {
+	for idx := range a.Locations {
+		if err := r.add(kindAdventureLocation, a.Locations[idx].Ref); err != nil {
+			return err
+		}
+	}
+	for idx := range a.Items {
+		if err := r.add(kindAdventureItem, a.Items[idx].Ref); err != nil {
+			return err
+		}
+	}
+	for idx := range a.Creatures {
+		if err := r.add(kindAdventureCreature, a.Creatures[idx].Ref); err != nil {
+			return err
+		}
+	}
+
+	for idx := range a.LocationLinks {
+		ll := &a.LocationLinks[idx]
+		if err := r.add(kindAdventureLocationLink, ll.Ref); err != nil {
+			return err
+		}
+		if err := r.require("location link from_location_ref", ll.FromLocationRef, kindAdventureLocation); err != nil {
+			return err
+		}
+		if err := r.require("location link to_location_ref", ll.ToLocationRef, kindAdventureLocation); err != nil {
+			return err
+		}
+	}
+
+	for idx := range a.LocationLinkRequirements {
+		llr := &a.LocationLinkRequirements[idx]
+		if err := r.add(kindOther, llr.Ref); err != nil {
+			return err
+		}
+		if err := r.require("location link requirement location_link_ref", llr.LocationLinkRef, kindAdventureLocationLink); err != nil {
+			return err
+		}
+		if err := r.optional("location link requirement item_ref", llr.ItemRef, kindAdventureItem); err != nil {
+			return err
+		}
+		if err := r.optional("location link requirement creature_ref", llr.CreatureRef, kindAdventureCreature); err != nil {
+			return err
+		}
+	}
+
+	for idx := range a.LocationObjects {
+		lo := &a.LocationObjects[idx]
+		if err := r.add(kindAdventureLocationObject, lo.Ref); err != nil {
+			return err
+		}
+		if err := r.require("location object location_ref", lo.LocationRef, kindAdventureLocation); err != nil {
+			return err
+		}
+	}
+
+	// Object states are added before checking object initial states as each
+	// state references its object
+	stateObjects := make(map[string]string, len(a.LocationObjectStates))
+	for idx := range a.LocationObjectStates {
+		los := &a.LocationObjectStates[idx]
+		if err := r.add(kindAdventureObjectState, los.Ref); err != nil {
+			return err
+		}
+		if err := r.require("location object state location_object_ref", los.LocationObjectRef, kindAdventureLocationObject); err != nil {
+			return err
+		}
+		stateObjects[los.Ref] = los.LocationObjectRef
+	}
+
+	for idx := range a.LocationObjects {
+		lo := &a.LocationObjects[idx]
+		if lo.InitialStateRef == "" {
+			continue
+		}
+		if stateObjects[lo.InitialStateRef] != lo.Ref {
+			return fmt.Errorf("location object initial_state_ref >%s< does not reference a state of location object >%s<", lo.InitialStateRef, lo.Ref)
+		}
+	}
+
+	for idx := range a.LocationObjectEffects {
+		if err := validateAdventureLocationObjectEffect(r, &a.LocationObjectEffects[idx]); err != nil {
+			return err
+		}
+	}
+
+	for idx := range a.ItemEffects {
+		if err := validateAdventureItemEffect(r, &a.ItemEffects[idx]); err != nil {
+			return err
+		}
+	}
+
+	for idx := range a.ItemPlacements {
+		p := &a.ItemPlacements[idx]
+		if err := r.add(kindOther, p.Ref); err != nil {
+			return err
+		}
+		if err := r.require("item placement entity_ref", p.EntityRef, kindAdventureItem); err != nil {
+			return err
+		}
+		if err := r.require("item placement location_ref", p.LocationRef, kindAdventureLocation); err != nil {
+			return err
+		}
+	}
+
+	for idx := range a.CreaturePlacements {
+		p := &a.CreaturePlacements[idx]
+		if err := r.add(kindOther, p.Ref); err != nil {
+			return err
+		}
+		if err := r.require("creature placement entity_ref", p.EntityRef, kindAdventureCreature); err != nil {
+			return err
+		}
+		if err := r.require("creature placement location_ref", p.LocationRef, kindAdventureLocation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateAdventureLocationObjectEffect(r refs, e *AdventureLocationObjectEffect) error {
+	if err := r.add(kindOther, e.Ref); err != nil {
+		return err
+	}
+
+	checks := []struct {
+		field    string
+		ref      string
+		kind     string
+		required bool
+	}{
+		{"location object effect location_object_ref", e.LocationObjectRef, kindAdventureLocationObject, true},
+		{"location object effect required_state_ref", e.RequiredStateRef, kindAdventureObjectState, false},
+		{"location object effect required_item_ref", e.RequiredItemRef, kindAdventureItem, false},
+		{"location object effect result_state_ref", e.ResultStateRef, kindAdventureObjectState, false},
+		{"location object effect result_item_ref", e.ResultItemRef, kindAdventureItem, false},
+		{"location object effect result_location_link_ref", e.ResultLocationLinkRef, kindAdventureLocationLink, false},
+		{"location object effect result_creature_ref", e.ResultCreatureRef, kindAdventureCreature, false},
+		{"location object effect result_location_object_ref", e.ResultLocationObjectRef, kindAdventureLocationObject, false},
+		{"location object effect result_location_ref", e.ResultLocationRef, kindAdventureLocation, false},
+	}
+
+	for _, c := range checks {
+		check := r.optional
+		if c.required {
+			check = r.require
+		}
+		if err := check(c.field, c.ref, c.kind); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateAdventureItemEffect(r refs, e *AdventureItemEffect) error {
+	if err := r.add(kindOther, e.Ref); err != nil {
+		return err
+	}
+
+	checks := []struct {
+		field    string
+		ref      string
+		kind     string
+		required bool
+	}{
+		{"item effect item_ref", e.ItemRef, kindAdventureItem, true},
+		{"item effect required_item_ref", e.RequiredItemRef, kindAdventureItem, false},
+		{"item effect required_location_ref", e.RequiredLocationRef, kindAdventureLocation, false},
+		{"item effect result_item_ref", e.ResultItemRef, kindAdventureItem, false},
+		{"item effect result_location_link_ref", e.ResultLocationLinkRef, kindAdventureLocationLink, false},
+		{"item effect result_creature_ref", e.ResultCreatureRef, kindAdventureCreature, false},
+		{"item effect result_location_ref", e.ResultLocationRef, kindAdventureLocation, false},
+	}
+
+	for _, c := range checks {
+		check := r.optional
+		if c.required {
+			check = r.require
+		}
+		if err := check(c.field, c.ref, c.kind); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateMecha(r refs, mc *Mecha) error {
+	for idx := range mc.Chassis {
+		if err := r.add(kindMechaChassis, mc.Chassis[idx].Ref); err != nil {
+			return err
+		}
+	}
+	for idx := range mc.Weapons {
+		if err := r.add(kindMechaWeapon, mc.Weapons[idx].Ref); err != nil {
+			return err
+		}
+	}
+	for idx := range mc.Equipment {
+		if err := r.add(kindMechaEquipment, mc.Equipment[idx].Ref); err != nil {
+			return err
+		}
+	}
+	for idx := range mc.Sectors {
+		if err := r.add(kindMechaSector, mc.Sectors[idx].Ref); err != nil {
+			return err
+		}
+	}
+
+	for idx := range mc.SectorLinks {
+		sl := &mc.SectorLinks[idx]
+		if err := r.add(kindOther, sl.Ref); err != nil {
+			return err
+		}
+		if err := r.require("sector link from_sector_ref", sl.FromSectorRef, kindMechaSector); err != nil {
+			return err
+		}
+		if err := r.require("sector link to_sector_ref", sl.ToSectorRef, kindMechaSector); err != nil {
+			return err
+		}
+	}
+
+	for idx := range mc.ComputerOpponents {
+		if err := r.add(kindOther, mc.ComputerOpponents[idx].Ref); err != nil {
+			return err
+		}
+	}
+	for idx := range mc.Squads {
+		if err := r.add(kindMechaSquad, mc.Squads[idx].Ref); err != nil {
+			return err
+		}
+	}
+
+	for idx := range mc.SquadMechs {
+		sm := &mc.SquadMechs[idx]
+		if err := r.add(kindOther, sm.Ref); err != nil {
+			return err
+		}
+		if err := r.require("squad mech squad_ref", sm.SquadRef, kindMechaSquad); err != nil {
+			return err
+		}
+		if err := r.require("squad mech chassis_ref", sm.ChassisRef, kindMechaChassis); err != nil {
+			return err
+		}
+		for _, w := range sm.Weapons {
+			if err := r.require("squad mech weapon_ref", w.WeaponRef, kindMechaWeapon); err != nil {
+				return err
+			}
+		}
+		for _, e := range sm.Equipment {
+			if err := r.require("squad mech equipment_ref", e.EquipmentRef, kindMechaEquipment); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateMechaTactics(r refs, mt *MechaTactics) error {
+	for idx := range mt.Chassis {
+		if err := r.add(kindMechaTacticsChassis, mt.Chassis[idx].Ref); err != nil {
+			return err
+		}
+	}
+	for idx := range mt.Weapons {
+		if err := r.add(kindMechaTacticsWeapon, mt.Weapons[idx].Ref); err != nil {
+			return err
+		}
+	}
+	for idx := range mt.TerrainTypes {
+		if err := r.add(kindMechaTacticsTerrainType, mt.TerrainTypes[idx].Ref); err != nil {
+			return err
+		}
+	}
+
+	for idx := range mt.Hexes {
+		h := &mt.Hexes[idx]
+		if err := r.add(kindOther, h.Ref); err != nil {
+			return err
+		}
+		if err := r.require("hex terrain_type_ref", h.TerrainTypeRef, kindMechaTacticsTerrainType); err != nil {
+			return err
+		}
+	}
+
+	for idx := range mt.Mechs {
+		mech := &mt.Mechs[idx]
+		if err := r.add(kindOther, mech.Ref); err != nil {
+			return err
+		}
+		if err := r.require("mech chassis_ref", mech.ChassisRef, kindMechaTacticsChassis); err != nil {
+			return err
+		}
+		for _, w := range mech.Weapons {
+			if err := r.require("mech weapon_ref", w.WeaponRef, kindMechaTacticsWeapon); err != nil {
+				return err
+			}
+		}
+	}
+
+	for idx := range mt.ComputerOpponents {
+		if err := r.add(kindOther, mt.ComputerOpponents[idx].Ref); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,321 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/chromedp/cdproto/browser"
+	"github.com/chromedp/cdproto/cdp"
+	"github.com/chromedp/chromedp"
+
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// Browser pool defaults
+// - DefaultBrowserPoolMaxTabs: tabs open at once when no limit is configured
+// - browserPoolTabTimeout: longest a single document may hold a tab
+// - browserPoolHealthInterval: how often the browser is checked before opening a tab
+// - browserPoolHealthTimeout: how long a health check waits for the browser to respond
+const (
+	DefaultBrowserPoolMaxTabs = 4
+	browserPoolTabTimeout     = 2 * time.Minute
+	browserPoolHealthInterval = 30 * time.Second
+	browserPoolHealthTimeout  = 5 * time.Second
+)
+
+// ErrChromeNotFound is returned when no Chrome executable can be found
+var ErrChromeNotFound = errors.New("chrome not found. Please install Chrome or set GOOGLE_CHROME_SHIM environment variable")
+
+// pooledBrowser is a running browser that documents are rendered in
+type pooledBrowser interface {
+	// RunTab opens a tab, runs the actions in it and closes the tab
+	RunTab(ctx context.Context, actions ...chromedp.Action) error
+	// Healthy returns true when the browser is running and responding
+	Healthy(ctx context.Context) bool
+	// Close shuts down the browser
+	Close()
+}
+
+// browserAllocator starts a browser
+type browserAllocator func(l logger.Logger) (pooledBrowser, error)
+
+// BrowserPool is a long-lived headless Chrome browser that renders documents in
+// tabs. At most maxTabs tabs are open at once, and callers wait for a free tab.
+// The browser is started on first use, checked before tabs are opened, and
+// restarted when it has crashed or stops responding.
+type BrowserPool struct {
+	logger   logger.Logger
+	maxTabs  int
+	tabs     chan struct{}
+	allocate browserAllocator
+
+	mu        sync.Mutex
+	browser   pooledBrowser
+	checkedAt time.Time
+	restarts  int
+}
+
+// NewBrowserPool creates a browser pool. The browser is not started until the
+// first tab is opened.
+func NewBrowserPool(l logger.Logger, maxTabs int) *BrowserPool {
+	return newBrowserPool(l, maxTabs, startChromeBrowser)
+}
+
+func newBrowserPool(l logger.Logger, maxTabs int, allocate browserAllocator) *BrowserPool {
+	if maxTabs <= 0 {
+		maxTabs = DefaultBrowserPoolMaxTabs
+	}
+
+	return &BrowserPool{
+		logger:   l,
+		maxTabs:  maxTabs,
+		tabs:     make(chan struct{}, maxTabs),
+		allocate: allocate,
+	}
+}
+
+var (
+	sharedBrowserPoolsMu sync.Mutex
+	sharedBrowserPools   = map[int]*BrowserPool{}
+)
+
+// SharedBrowserPool returns the browser pool shared by every renderer in the
+// process that is configured with the same RendererMaxTabs, creating it on first use
+func SharedBrowserPool(l logger.Logger, cfg config.Config) *BrowserPool {
+	maxTabs := cfg.RendererMaxTabs
+	if maxTabs <= 0 {
+		maxTabs = DefaultBrowserPoolMaxTabs
+	}
+
+	sharedBrowserPoolsMu.Lock()
+	defer sharedBrowserPoolsMu.Unlock()
+
+	pool, ok := sharedBrowserPools[maxTabs]
+	if !ok {
+		pool = NewBrowserPool(l.WithFunctionContext("BrowserPool"), maxTabs)
+		sharedBrowserPools[maxTabs] = pool
+	}
+
+	return pool
+}
+
+// MaxTabs returns the number of tabs the pool opens at once
+func (p *BrowserPool) MaxTabs() int {
+	return p.maxTabs
+}
+
+// Run opens a tab, runs the actions in it and closes the tab. The tab is closed
+// when ctx is cancelled. When the actions fail because the browser has gone away
+// the browser is restarted and the actions are run once more in a new tab.
+func (p *BrowserPool) Run(ctx context.Context, actions ...chromedp.Action) error {
+	l := p.logger.WithFunctionContext("BrowserPool/Run")
+
+	select {
+	case p.tabs <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-p.tabs }()
+
+	b, err := p.getBrowser(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = b.RunTab(ctx, actions...)
+	if err == nil || ctx.Err() != nil || b.Healthy(ctx) {
+		return err
+	}
+
+	l.Warn("browser stopped responding while rendering, restarting >%v<", err)
+	p.restart(b)
+
+	b, err = p.getBrowser(ctx)
+	if err != nil {
+		return err
+	}
+
+	return b.RunTab(ctx, actions...)
+}
+
+// getBrowser returns the running browser, starting the browser when it is not
+// running and restarting it when it fails a health check
+func (p *BrowserPool) getBrowser(ctx context.Context) (pooledBrowser, error) {
+	l := p.logger.WithFunctionContext("BrowserPool/getBrowser")
+
+	p.mu.Lock()
+	b := p.browser
+	checkDue := time.Since(p.checkedAt) > browserPoolHealthInterval
+	p.mu.Unlock()
+
+	if b != nil {
+		if !checkDue {
+			return b, nil
+		}
+		if b.Healthy(ctx) {
+			p.mu.Lock()
+			p.checkedAt = time.Now()
+			p.mu.Unlock()
+			return b, nil
+		}
+		l.Warn("browser failed health check, restarting")
+		p.restart(b)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Another tab may have started the browser while the lock was released
+	if p.browser != nil {
+		return p.browser, nil
+	}
+
+	b, err := p.allocate(p.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	l.Info("started browser max_tabs=%d restarts=%d", p.maxTabs, p.restarts)
+
+	p.browser = b
+	p.checkedAt = time.Now()
+
+	return b, nil
+}
+
+// restart stops a failed browser so the next tab starts a new one. Tabs still
+// open in the failed browser fail and are retried by Run. Nothing is stopped
+// when the failed browser has already been replaced.
+func (p *BrowserPool) restart(failed pooledBrowser) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.browser == nil || p.browser != failed {
+		return
+	}
+
+	p.browser.Close()
+	p.browser = nil
+	p.restarts++
+}
+
+// Restarts returns the number of times a failed browser has been restarted
+func (p *BrowserPool) Restarts() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.restarts
+}
+
+// Close shuts down the browser. The pool starts a new browser if it is used again.
+func (p *BrowserPool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.browser != nil {
+		p.browser.Close()
+		p.browser = nil
+	}
+}
+
+// chromeBrowser is a headless Chrome browser started with chromedp
+type chromeBrowser struct {
+	browserCtx    context.Context
+	cancelAlloc   context.CancelFunc
+	cancelBrowser context.CancelFunc
+}
+
+// startChromeBrowser launches headless Chrome
+func startChromeBrowser(l logger.Logger) (pooledBrowser, error) {
+	l = l.WithFunctionContext("startChromeBrowser")
+
+	chromePath := findChromePath(l)
+	if chromePath == "" {
+		l.Warn("chrome not found in any common locations")
+		return nil, ErrChromeNotFound
+	}
+
+	opts := []chromedp.ExecAllocatorOption{
+		chromedp.ExecPath(chromePath),
+		chromedp.Flag("headless", true),
+		chromedp.Flag("no-sandbox", true),
+		chromedp.Flag("disable-dev-shm-usage", true),
+		chromedp.Flag("disable-gpu", true),
+		chromedp.Flag("disable-extensions", true),
+		chromedp.Flag("disable-plugins", true),
+		chromedp.Flag("disable-background-timer-throttling", true),
+		chromedp.Flag("disable-backgrounding-occluded-windows", true),
+		chromedp.Flag("disable-renderer-backgrounding", true),
+		chromedp.Flag("disable-features", "TranslateUI"),
+		chromedp.Flag("disable-ipc-flooding-protection", true),
+	}
+
+	// The browser outlives any single request so it is not started from a
+	// request context
+	allocCtx, cancelAlloc := chromedp.NewExecAllocator(context.Background(), opts...)
+	browserCtx, cancelBrowser := chromedp.NewContext(allocCtx)
+
+	// Running without actions starts the browser
+	if err := chromedp.Run(browserCtx); err != nil {
+		cancelBrowser()
+		cancelAlloc()
+		l.Warn("failed to start browser chrome_path=%s error=%v", chromePath, err)
+		return nil, fmt.Errorf("failed to start browser: %w", err)
+	}
+
+	l.Info("started chrome chrome_path=%s", chromePath)
+
+	return &chromeBrowser{
+		browserCtx:    browserCtx,
+		cancelAlloc:   cancelAlloc,
+		cancelBrowser: cancelBrowser,
+	}, nil
+}
+
+// RunTab runs the actions in a new tab
+func (b *chromeBrowser) RunTab(ctx context.Context, actions ...chromedp.Action) error {
+	// Tabs belong to the browser rather than the caller, so the caller's
+	// cancellation is passed on to the tab
+	tabCtx, cancelTab := chromedp.NewContext(b.browserCtx)
+	defer cancelTab()
+
+	tabCtx, cancelTimeout := context.WithTimeout(tabCtx, browserPoolTabTimeout)
+	defer cancelTimeout()
+
+	stop := context.AfterFunc(ctx, cancelTab)
+	defer stop()
+
+	return chromedp.Run(tabCtx, actions...)
+}
+
+// Healthy returns true when the browser is running and responds to a version request
+func (b *chromeBrowser) Healthy(ctx context.Context) bool {
+	if b.browserCtx.Err() != nil {
+		return false
+	}
+
+	c := chromedp.FromContext(b.browserCtx)
+	if c == nil || c.Browser == nil {
+		return false
+	}
+
+	checkCtx, cancel := context.WithTimeout(b.browserCtx, browserPoolHealthTimeout)
+	defer cancel()
+
+	stop := context.AfterFunc(ctx, cancel)
+	defer stop()
+
+	_, _, _, _, _, err := browser.GetVersion().Do(cdp.WithExecutor(checkCtx, c.Browser))
+
+	return err == nil
+}
+
+// Close shuts down the browser
+func (b *chromeBrowser) Close() {
+	b.cancelBrowser()
+	b.cancelAlloc()
+}
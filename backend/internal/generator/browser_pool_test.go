@@ -0,0 +1,234 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/chromedp/chromedp"
+	"github.com/stretchr/testify/require"
+
+	coreconfig "gitlab.com/alienspaces/playbymail/core/config"
+	corelog "gitlab.com/alienspaces/playbymail/core/log"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// fakeBrowser is a browser that runs no actions
+type fakeBrowser struct {
+	mu      sync.Mutex
+	runErr  error
+	healthy bool
+	closed  bool
+
+	// running and maxRunning count tabs open at once
+	running    *atomic.Int32
+	maxRunning *atomic.Int32
+	hold       chan struct{}
+}
+
+func (b *fakeBrowser) RunTab(ctx context.Context, actions ...chromedp.Action) error {
+	if b.running != nil {
+		n := b.running.Add(1)
+		defer b.running.Add(-1)
+		for {
+			m := b.maxRunning.Load()
+			if n <= m || b.maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+	}
+	if b.hold != nil {
+		select {
+		case <-b.hold:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.runErr
+}
+
+func (b *fakeBrowser) Healthy(ctx context.Context) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.healthy && !b.closed
+}
+
+func (b *fakeBrowser) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+}
+
+// fakeAllocator returns the browsers in order, one for each browser started
+type fakeAllocator struct {
+	mu       sync.Mutex
+	browsers []*fakeBrowser
+	started  int
+}
+
+func (a *fakeAllocator) allocate(l logger.Logger) (pooledBrowser, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.started >= len(a.browsers) {
+		return nil, ErrChromeNotFound
+	}
+	b := a.browsers[a.started]
+	a.started++
+
+	return b, nil
+}
+
+func newTestLogger(t *testing.T) logger.Logger {
+	l, err := corelog.NewLogger(coreconfig.Config{})
+	require.NoError(t, err, "NewLogger returns without error")
+	return l
+}
+
+func TestBrowserPoolRun(t *testing.T) {
+	tests := []struct {
+		name         string
+		browsers     []*fakeBrowser
+		wantErr      error
+		wantStarted  int
+		wantRestarts int
+	}{
+		{
+			name:        "runs actions in a started browser",
+			browsers:    []*fakeBrowser{{healthy: true}},
+			wantStarted: 1,
+		},
+		{
+			name: "restarts a crashed browser and retries once",
+			browsers: []*fakeBrowser{
+				{healthy: false, runErr: errors.New("websocket closed")},
+				{healthy: true},
+			},
+			wantStarted:  2,
+			wantRestarts: 1,
+		},
+		{
+			name: "returns the error when the retry fails",
+			browsers: []*fakeBrowser{
+				{healthy: false, runErr: errors.New("websocket closed")},
+				{healthy: false, runErr: errors.New("websocket closed again")},
+			},
+			wantErr:      errors.New("websocket closed again"),
+			wantStarted:  2,
+			wantRestarts: 1,
+		},
+		{
+			name:        "does not restart a healthy browser when the actions fail",
+			browsers:    []*fakeBrowser{{healthy: true, runErr: errors.New("bad template")}},
+			wantErr:     errors.New("bad template"),
+			wantStarted: 1,
+		},
+		{
+			name:    "fails when chrome is absent",
+			wantErr: ErrChromeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alloc := &fakeAllocator{browsers: tt.browsers}
+			pool := newBrowserPool(newTestLogger(t), 2, alloc.allocate)
+			defer pool.Close()
+
+			err := pool.Run(context.Background())
+			if tt.wantErr != nil {
+				require.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantStarted, alloc.started, "browsers started")
+			require.Equal(t, tt.wantRestarts, pool.Restarts(), "browser restarts")
+		})
+	}
+}
+
+func TestBrowserPoolHealthCheck(t *testing.T) {
+	failed := &fakeBrowser{healthy: true}
+	replacement := &fakeBrowser{healthy: true}
+
+	alloc := &fakeAllocator{browsers: []*fakeBrowser{failed, replacement}}
+	pool := newBrowserPool(newTestLogger(t), 1, alloc.allocate)
+	defer pool.Close()
+
+	require.NoError(t, pool.Run(context.Background()))
+	require.Equal(t, 1, alloc.started)
+
+	// The browser stops responding between renders and the next check is due
+	failed.mu.Lock()
+	failed.healthy = false
+	failed.mu.Unlock()
+	pool.mu.Lock()
+	pool.checkedAt = time.Now().Add(-2 * browserPoolHealthInterval)
+	pool.mu.Unlock()
+
+	require.NoError(t, pool.Run(context.Background()))
+	require.Equal(t, 2, alloc.started, "failed browser is replaced")
+	require.Equal(t, 1, pool.Restarts())
+	require.True(t, failed.closed, "failed browser is closed")
+}
+
+func TestBrowserPoolTabLimit(t *testing.T) {
+	running := &atomic.Int32{}
+	maxRunning := &atomic.Int32{}
+	hold := make(chan struct{})
+
+	alloc := &fakeAllocator{browsers: []*fakeBrowser{{healthy: true, running: running, maxRunning: maxRunning, hold: hold}}}
+	pool := newBrowserPool(newTestLogger(t), 2, alloc.allocate)
+	defer pool.Close()
+
+	wg := sync.WaitGroup{}
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, pool.Run(context.Background()))
+		}()
+	}
+
+	require.Eventually(t, func() bool { return running.Load() == 2 }, time.Second, 5*time.Millisecond)
+	close(hold)
+	wg.Wait()
+
+	require.Equal(t, int32(2), maxRunning.Load(), "no more than max tabs open at once")
+
+	// Callers waiting for a tab give up when their context is cancelled
+	blocked := make(chan struct{})
+	alloc.browsers[0].hold = blocked
+	defer close(blocked)
+
+	for range 2 {
+		go func() { _ = pool.Run(context.Background()) }()
+	}
+	require.Eventually(t, func() bool { return running.Load() == 2 }, time.Second, 5*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, pool.Run(ctx), context.DeadlineExceeded)
+}
+
+func TestSharedBrowserPool(t *testing.T) {
+	l := newTestLogger(t)
+
+	small := SharedBrowserPool(l, config.Config{RendererMaxTabs: 1})
+	large := SharedBrowserPool(l, config.Config{RendererMaxTabs: 8})
+
+	require.Equal(t, 1, small.MaxTabs())
+	require.Equal(t, 8, large.MaxTabs())
+	require.Same(t, small, SharedBrowserPool(l, config.Config{RendererMaxTabs: 1}))
+	require.Equal(t, DefaultBrowserPoolMaxTabs, SharedBrowserPool(l, config.Config{}).MaxTabs())
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"html/template"
 	"os"
@@ -14,13 +15,20 @@ import (
 	"github.com/chromedp/cdproto/page"
 	"github.com/chromedp/chromedp"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/omr"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
 )
 
-// DocumentRenderer renders turn sheet templates to HTML, PDF, and PNG.
+// DocumentRenderer renders turn sheet templates to HTML, PDF, and PNG. PDFs and
+// PNGs are rendered in tabs of a shared browser pool, and rendered PDFs are kept
+// in a PDF cache so the same document is not rendered twice.
 type DocumentRenderer struct {
 	logger       logger.Logger
 	templatePath string
 	outputDir    string
+	browserPool  *BrowserPool
+	pdfCache     *PDFCache
 }
 
 // NewDocumentRenderer creates a new document renderer.
@@ -36,6 +44,39 @@ func NewDocumentRenderer(l logger.Logger) (*DocumentRenderer, error) {
 	return renderer, nil
 }
 
+// LocalizedData is implemented by template data rendered in a locale. Data that
+// does not implement it is rendered in the default locale.
+type LocalizedData interface {
+	TemplateLocale() string
+}
+
+// SetBrowserPool sets the browser pool documents are rendered in
+func (g *DocumentRenderer) SetBrowserPool(pool *BrowserPool) {
+	g.browserPool = pool
+}
+
+// SetPDFCache sets the cache rendered PDFs are kept in
+func (g *DocumentRenderer) SetPDFCache(cache *PDFCache) {
+	g.pdfCache = cache
+}
+
+// pool returns the browser pool, using a shared pool with the default tab limit
+// when none has been set
+func (g *DocumentRenderer) pool() *BrowserPool {
+	if g.browserPool == nil {
+		return SharedBrowserPool(g.logger, config.Config{})
+	}
+	return g.browserPool
+}
+
+// cache returns the PDF cache, using a disabled cache when none has been set
+func (g *DocumentRenderer) cache() *PDFCache {
+	if g.pdfCache == nil {
+		return SharedPDFCache(config.Config{})
+	}
+	return g.pdfCache
+}
+
 // SetTemplatePath sets the template path
 func (g *DocumentRenderer) SetTemplatePath(path string) {
 	g.templatePath = path
@@ -52,8 +93,13 @@ func (g *DocumentRenderer) GenerateHTML(ctx context.Context, templatePath string
 
 	l.Info("generating HTML template=%s", templatePath)
 
+	locale := i18n.DefaultLocale
+	if localized, ok := data.(LocalizedData); ok {
+		locale = localized.TemplateLocale()
+	}
+
 	// Load and parse HTML template
-	tmpl, err := g.loadTemplate(templatePath)
+	tmpl, err := g.loadTemplate(templatePath, locale)
 	if err != nil {
 		l.Warn("failed to load template template=%s error=%v", templatePath, err)
 		return "", fmt.Errorf("failed to load template: %w", err)
@@ -145,8 +191,9 @@ func (g *DocumentRenderer) GeneratePDFToFile(ctx context.Context, templatePath s
 	return nil
 }
 
-// loadTemplate loads and parses an HTML template
-func (g *DocumentRenderer) loadTemplate(templatePath string) (*template.Template, error) {
+// loadTemplate loads and parses an HTML template with the message catalogue and
+// formatting functions of a locale
+func (g *DocumentRenderer) loadTemplate(templatePath, locale string) (*template.Template, error) {
 	l := g.logger.WithFunctionContext("DocumentRenderer/loadTemplate")
 
 	// If templatePath is empty, assume we're running from backend/ directory
@@ -183,7 +230,20 @@ func (g *DocumentRenderer) loadTemplate(templatePath string) (*template.Template
 			}
 			return strings.ToUpper(s[:1]) + s[1:]
 		},
-	})
+		// codeMarkRows returns the printed code mark cells for a turn sheet code,
+		// or nothing when the code cannot be encoded
+		"codeMarkRows": func(code *string) [][]bool {
+			if code == nil {
+				return nil
+			}
+			rows, err := omr.EncodeCode(*code)
+			if err != nil {
+				l.Warn("failed to encode turn sheet code mark >%v<", err)
+				return nil
+			}
+			return rows
+		},
+	}).Funcs(i18n.NewLocalizer(locale).FuncMap())
 
 	// Parse base template first (located in turnsheet/)
 	// Make path absolute to avoid issues with working directory
@@ -226,50 +286,13 @@ func (g *DocumentRenderer) htmlToPDF(ctx context.Context, html string) ([]byte,
 
 	l.Info("starting HTML to PDF conversion html_size=%d", len(html))
 
-	// Create a new context with Chrome options - minimal for reliability
-	opts := []chromedp.ExecAllocatorOption{
-		chromedp.Flag("headless", true),
-		chromedp.Flag("no-sandbox", true),
-		chromedp.Flag("disable-dev-shm-usage", true),
-		chromedp.Flag("disable-gpu", true),
-		chromedp.Flag("disable-extensions", true),
-		chromedp.Flag("disable-plugins", true),
-		chromedp.Flag("disable-background-timer-throttling", true),
-		chromedp.Flag("disable-backgrounding-occluded-windows", true),
-		chromedp.Flag("disable-renderer-backgrounding", true),
-		chromedp.Flag("disable-features", "TranslateUI"),
-		chromedp.Flag("disable-ipc-flooding-protection", true),
+	// Re-downloads and resends of a turn sheet render the same HTML
+	cacheKey := PDFCacheKey(html)
+	if pdfData, ok := g.cache().Get(cacheKey); ok {
+		l.Info("using cached PDF pdf_size=%d", len(pdfData))
+		return pdfData, nil
 	}
 
-	l.Info("chrome options configured options_count=%d", len(opts))
-
-	// Find Chrome executable path
-	chromePath := findChromePath(l)
-	if chromePath == "" {
-		// In test environment, return a mock PDF instead of failing
-		if os.Getenv("TESTING") == "true" {
-			l.Warn("chrome not found, returning mock PDF for testing")
-			return []byte("mock-pdf-data-for-testing"), nil
-		}
-		l.Warn("chrome not found in any common locations")
-		return nil, fmt.Errorf("chrome not found. Please install Chrome or set GOOGLE_CHROME_SHIM environment variable")
-	}
-
-	// Set Chrome executable path
-	opts = append(opts, chromedp.ExecPath(chromePath))
-
-	l.Info("chrome executable path set chrome_path=%s", chromePath)
-
-	// Create allocator without timeout (browser needs time to start)
-	allocCtx, cancel := chromedp.NewExecAllocator(ctx, opts...)
-	defer cancel()
-
-	// Create a new context for the run (no timeout on first Run call)
-	runCtx, runCancel := chromedp.NewContext(allocCtx)
-	defer runCancel()
-
-	l.Debug("chrome context created")
-
 	// Use data URL to avoid file system access issues in CI environments
 	// Base64 encode the HTML and use data URL
 	htmlB64 := base64.StdEncoding.EncodeToString([]byte(html))
@@ -281,9 +304,9 @@ func (g *DocumentRenderer) htmlToPDF(ctx context.Context, html string) ([]byte,
 
 	var pdfData []byte
 
-	err := chromedp.Run(runCtx,
+	err := g.pool().Run(ctx,
 		chromedp.ActionFunc(func(ctx context.Context) error {
-			l.Debug("chrome browser started, navigating to data URL")
+			l.Debug("chrome tab opened, navigating to data URL")
 			return chromedp.Navigate(dataURL).Do(ctx)
 		}),
 		chromedp.ActionFunc(func(ctx context.Context) error {
@@ -365,10 +388,17 @@ func (g *DocumentRenderer) htmlToPDF(ctx context.Context, html string) ([]byte,
 	)
 
 	if err != nil {
+		// In test environment, return a mock PDF instead of failing
+		if errors.Is(err, ErrChromeNotFound) && os.Getenv("TESTING") == "true" {
+			l.Warn("chrome not found, returning mock PDF for testing")
+			return []byte("mock-pdf-data-for-testing"), nil
+		}
 		l.Warn("failed to generate PDF with Chrome error=%v", err)
 		return nil, fmt.Errorf("failed to generate PDF: %w", err)
 	}
 
+	g.cache().Put(cacheKey, pdfData)
+
 	l.Info("PDF generation completed successfully pdf_size=%d", len(pdfData))
 
 	return pdfData, nil
@@ -38,36 +38,6 @@ func (g *DocumentRenderer) htmlToPNG(ctx context.Context, html string) ([]byte,
 
 	l.Info("starting HTML to PNG conversion html_size=%d", len(html))
 
-	opts := []chromedp.ExecAllocatorOption{
-		chromedp.Flag("headless", true),
-		chromedp.Flag("no-sandbox", true),
-		chromedp.Flag("disable-dev-shm-usage", true),
-		chromedp.Flag("disable-gpu", true),
-		chromedp.Flag("disable-extensions", true),
-		chromedp.Flag("disable-plugins", true),
-		chromedp.Flag("disable-background-timer-throttling", true),
-		chromedp.Flag("disable-backgrounding-occluded-windows", true),
-		chromedp.Flag("disable-renderer-backgrounding", true),
-		chromedp.Flag("disable-features", "TranslateUI"),
-		chromedp.Flag("disable-ipc-flooding-protection", true),
-	}
-
-	// Find Chrome executable path
-	chromePath := findChromePath(l)
-
-	if chromePath == "" {
-		l.Warn("chrome not found. Please install Chrome or set GOOGLE_CHROME_SHIM environment variable")
-		return nil, fmt.Errorf("chrome not found. Please install Chrome or set GOOGLE_CHROME_SHIM environment variable")
-	}
-
-	opts = append(opts, chromedp.ExecPath(chromePath))
-
-	allocCtx, cancelAlloc := chromedp.NewExecAllocator(ctx, opts...)
-	defer cancelAlloc()
-
-	runCtx, cancelRun := chromedp.NewContext(allocCtx)
-	defer cancelRun()
-
 	var pngData []byte
 
 	// Use data URL to avoid file system access issues in CI environments
@@ -77,7 +47,7 @@ func (g *DocumentRenderer) htmlToPNG(ctx context.Context, html string) ([]byte,
 
 	l.Info("using data URL for HTML content html_size=%d data_url_size=%d", len(html), len(dataURL))
 
-	err := chromedp.Run(runCtx,
+	err := g.pool().Run(ctx,
 		chromedp.Navigate(dataURL),
 		chromedp.ActionFunc(func(ctx context.Context) error {
 			return emulation.SetDeviceMetricsOverride(1240, 1754, 1.0, false).
@@ -0,0 +1,80 @@
+package generator
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/chromedp/cdproto/emulation"
+	"github.com/chromedp/chromedp"
+
+	"gitlab.com/alienspaces/playbymail/internal/omr"
+)
+
+// markLayoutScript lays the page out for print and returns the position in
+// millimetres of every printed checkbox and radio button, along with the
+// names of printed fields that are written in by hand. Templates mark
+// handwriting areas that replace form inputs on paper with data-omr-written.
+const markLayoutScript = `(() => {
+	const mm = 25.4 / 96;
+	const wrapper = document.querySelector('.page-wrapper');
+	const origin = wrapper ? wrapper.getBoundingClientRect() : { left: 0, top: 0 };
+	const printed = (el) => {
+		const r = el.getBoundingClientRect();
+		const s = getComputedStyle(el);
+		return (r.width > 0 || r.height > 0) && s.visibility !== 'hidden' && (r.top - origin.top) * mm < 297;
+	};
+	const box = (el) => {
+		const r = el.getBoundingClientRect();
+		return { x: (r.left - origin.left) * mm, y: (r.top - origin.top) * mm, width: r.width * mm, height: r.height * mm };
+	};
+	const fields = [];
+	const written = [];
+	document.querySelectorAll('input, select, textarea').forEach((el) => {
+		if (el.type === 'hidden' || el.disabled || !printed(el)) return;
+		if (el.type === 'radio' || el.type === 'checkbox') {
+			fields.push({ name: el.name, value: el.value, type: el.type, checked: el.checked, box: box(el) });
+		} else if (!el.readOnly) {
+			written.push(el.name || el.id);
+		}
+	});
+	document.querySelectorAll('[data-omr-written]').forEach((el) => {
+		if (printed(el)) written.push(el.dataset.omrWritten);
+	});
+	return { fields: fields, written_fields: written };
+})()`
+
+// GenerateMarkLayout lays out turn sheet HTML as it is printed on an A4 page and
+// returns the positions of the checkboxes and radio buttons a player marks, for
+// reading scanned sheets with the local optical mark reader.
+func (g *DocumentRenderer) GenerateMarkLayout(ctx context.Context, html string) (*omr.Layout, error) {
+	l := g.logger.WithFunctionContext("DocumentRenderer/GenerateMarkLayout")
+
+	l.Info("starting mark layout html_size=%d", len(html))
+
+	htmlB64 := base64.StdEncoding.EncodeToString([]byte(html))
+	dataURL := fmt.Sprintf("data:text/html;base64,%s", htmlB64)
+
+	var layout omr.Layout
+
+	err := g.pool().Run(ctx,
+		// A4 at 96 CSS pixels per inch
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			return emulation.SetDeviceMetricsOverride(794, 1123, 1.0, false).Do(ctx)
+		}),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			return emulation.SetEmulatedMedia().WithMedia("print").Do(ctx)
+		}),
+		chromedp.Navigate(dataURL),
+		chromedp.WaitReady("body"),
+		chromedp.Evaluate(markLayoutScript, &layout),
+	)
+	if err != nil {
+		l.Warn("failed to lay out marks error=%v", err)
+		return nil, fmt.Errorf("failed to lay out marks: %w", err)
+	}
+
+	l.Info("mark layout completed fields=%d written_fields=%d", len(layout.Fields), len(layout.WrittenFields))
+
+	return &layout, nil
+}
@@ -0,0 +1,119 @@
+package generator
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// PDFCache holds recently rendered PDFs keyed by a hash of the HTML they were
+// rendered from, so rendering the same document again returns the earlier PDF.
+// The least recently used PDFs are dropped once the cache holds more than
+// maxBytes. A cache with maxBytes of zero or less holds nothing.
+type PDFCache struct {
+	mu       sync.Mutex
+	maxBytes int64
+	size     int64
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+type pdfCacheEntry struct {
+	key  string
+	data []byte
+}
+
+// NewPDFCache creates a PDF cache holding at most maxBytes of PDF data
+func NewPDFCache(maxBytes int64) *PDFCache {
+	return &PDFCache{
+		maxBytes: maxBytes,
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+	}
+}
+
+var (
+	sharedPDFCachesMu sync.Mutex
+	sharedPDFCaches   = map[int64]*PDFCache{}
+)
+
+// SharedPDFCache returns the PDF cache shared by every renderer in the process
+// that is configured with the same RendererPDFCacheMB, creating it on first use
+func SharedPDFCache(cfg config.Config) *PDFCache {
+	maxBytes := int64(cfg.RendererPDFCacheMB) << 20
+
+	sharedPDFCachesMu.Lock()
+	defer sharedPDFCachesMu.Unlock()
+
+	cache, ok := sharedPDFCaches[maxBytes]
+	if !ok {
+		cache = NewPDFCache(maxBytes)
+		sharedPDFCaches[maxBytes] = cache
+	}
+
+	return cache
+}
+
+// PDFCacheKey returns the cache key for a document's HTML
+func PDFCacheKey(html string) string {
+	sum := sha256.Sum256([]byte(html))
+	return hex.EncodeToString(sum[:])
+}
+
+// Get returns a copy of the cached PDF for a key
+func (c *PDFCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+
+	return bytes.Clone(el.Value.(*pdfCacheEntry).data), true
+}
+
+// Put adds a copy of a PDF to the cache, dropping the least recently used PDFs
+// to make room. PDFs larger than the cache are not added.
+func (c *PDFCache) Put(key string, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	size := int64(len(data))
+	if size > c.maxBytes {
+		return
+	}
+
+	data = bytes.Clone(data)
+
+	if el, ok := c.entries[key]; ok {
+		c.size -= int64(len(el.Value.(*pdfCacheEntry).data))
+		el.Value.(*pdfCacheEntry).data = data
+		c.size += size
+		c.order.MoveToFront(el)
+	} else {
+		c.entries[key] = c.order.PushFront(&pdfCacheEntry{key: key, data: data})
+		c.size += size
+	}
+
+	for c.size > c.maxBytes {
+		el := c.order.Back()
+		entry := el.Value.(*pdfCacheEntry)
+		c.order.Remove(el)
+		delete(c.entries, entry.key)
+		c.size -= int64(len(entry.data))
+	}
+}
+
+// Len returns the number of cached PDFs
+func (c *PDFCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
@@ -0,0 +1,81 @@
+package generator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/internal/generator"
+)
+
+func TestPDFCache(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxBytes   int64
+		puts       []string
+		gets       []string
+		wantCached []string
+		wantMissed []string
+	}{
+		{
+			name:       "returns cached PDFs",
+			maxBytes:   64,
+			puts:       []string{"a", "b"},
+			wantCached: []string{"a", "b"},
+			wantMissed: []string{"c"},
+		},
+		{
+			name:       "drops least recently used PDFs when full",
+			maxBytes:   20,
+			puts:       []string{"a", "b", "c"},
+			wantCached: []string{"b", "c"},
+			wantMissed: []string{"a"},
+		},
+		{
+			name:       "reading a PDF keeps it in the cache",
+			maxBytes:   20,
+			puts:       []string{"a", "b"},
+			gets:       []string{"a"},
+			wantCached: []string{"a"},
+			wantMissed: []string{"b"},
+		},
+		{
+			name:       "disabled cache holds nothing",
+			maxBytes:   0,
+			puts:       []string{"a"},
+			wantMissed: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := generator.NewPDFCache(tt.maxBytes)
+
+			// Each PDF is 10 bytes
+			pdf := func(html string) []byte {
+				return []byte(html + "-pdf-data")[:10]
+			}
+
+			for _, html := range tt.puts {
+				cache.Put(generator.PDFCacheKey(html), pdf(html))
+			}
+			for _, html := range tt.gets {
+				_, ok := cache.Get(generator.PDFCacheKey(html))
+				require.True(t, ok, "expected PDF >%s< to be cached", html)
+			}
+			if len(tt.gets) > 0 {
+				cache.Put(generator.PDFCacheKey("c"), pdf("c"))
+			}
+
+			for _, html := range tt.wantCached {
+				data, ok := cache.Get(generator.PDFCacheKey(html))
+				require.True(t, ok, "expected PDF >%s< to be cached", html)
+				require.Equal(t, pdf(html), data)
+			}
+			for _, html := range tt.wantMissed {
+				_, ok := cache.Get(generator.PDFCacheKey(html))
+				require.False(t, ok, "expected PDF >%s< not to be cached", html)
+			}
+		})
+	}
+}
@@ -81,7 +81,7 @@ func (t *Testing) processAccountConfig(accountConfig AccountConfig) (
 
 		// Generate a session token for all account users and store in harness data
 		// so that it may be used in API handler tests.
-		sessionToken, err := t.Domain.(*domain.Domain).GenerateAccountUserSessionToken(createdRec)
+		sessionToken, err := t.Domain.(*domain.Domain).GenerateAccountUserSessionToken(createdRec, "")
 		if err != nil {
 			l.Warn("failed to generate session token for account user >%s< >%v<", createdRec.ID, err)
 			return nil, nil, nil, nil, err
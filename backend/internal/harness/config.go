@@ -11,6 +11,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
 )
 
 const (
@@ -20,6 +21,7 @@ const (
 	GameFourRef  = "game-four"
 	GameDraftRef = "game-draft"
 	GameMechaGameRef = "game-mecha"
+	GameMechaTacticsGameRef = "game-mecha-tactics"
 
 	AccountStandardRef    = "account-standard"
 	AccountProPlayerRef   = "account-pro-player"
@@ -138,9 +140,21 @@ const (
 	MechaGameSectorTwoRef     = "mecha-sector-two"
 	MechaGameSectorLinkOneRef = "mecha-sector-link-one"
 	MechaGameSquadStarterRef  = "mecha-squad-starter"
+	MechaGameSquadReserveRef  = "mecha-squad-reserve"
 	MechaGameSquadOneRef      = "mecha-squad-one"
 	MechaGameSquadTwoRef      = "mecha-squad-two"
 	MechaGameSquadMechOneRef  = "mecha-squad-mech-one"
+
+	// MechaTacticsGame specific references
+	MechaTacticsGameChassisOneRef          = "mecha-tactics-chassis-one"
+	MechaTacticsGameWeaponOneRef           = "mecha-tactics-weapon-one"
+	MechaTacticsGameTerrainTypeOneRef      = "mecha-tactics-terrain-type-one"
+	MechaTacticsGameHexOneRef              = "mecha-tactics-hex-one"
+	MechaTacticsGameHexTwoRef              = "mecha-tactics-hex-two"
+	MechaTacticsGameHexThreeRef            = "mecha-tactics-hex-three"
+	MechaTacticsGameComputerOpponentOneRef = "mecha-tactics-computer-opponent-one"
+	MechaTacticsGameMechStarterRef         = "mecha-tactics-mech-starter"
+	MechaTacticsGameMechOpponentRef        = "mecha-tactics-mech-opponent"
 )
 
 // DataConfig -
@@ -179,6 +193,14 @@ type GameConfig struct {
 	MechaGameSectorLinkConfigs       []MechaGameSectorLinkConfig
 	MechaGameComputerOpponentConfigs []MechaGameComputerOpponentConfig
 	MechaGameSquadConfigs            []MechaGameSquadConfig
+
+	// MechaTacticsGame specific configurations
+	MechaTacticsGameChassisConfigs          []MechaTacticsGameChassisConfig
+	MechaTacticsGameWeaponConfigs           []MechaTacticsGameWeaponConfig
+	MechaTacticsGameTerrainTypeConfigs      []MechaTacticsGameTerrainTypeConfig
+	MechaTacticsGameHexConfigs              []MechaTacticsGameHexConfig
+	MechaTacticsGameComputerOpponentConfigs []MechaTacticsGameComputerOpponentConfig
+	MechaTacticsGameMechConfigs             []MechaTacticsGameMechConfig
 }
 
 type GameImageConfig struct {
@@ -429,11 +451,55 @@ type MechaGameComputerOpponentConfig struct {
 
 type MechaGameSquadConfig struct {
 	Reference        string
-	SquadType       string // mecha_game_record.SquadTypeStarter or mecha_game_record.SquadTypeOpponent
+	SquadType       string // mecha_game_record.SquadTypeStarter, SquadTypeOpponent or SquadTypeReserve
 	Record           *mecha_game_record.MechaGameSquad
 	SquadMechConfigs []MechaGameSquadMechConfig
 }
 
+// ------------------------------------------------------------
+// MechaTacticsGame specific configuration
+// ------------------------------------------------------------
+
+type MechaTacticsGameChassisConfig struct {
+	Reference string
+	Record    *mecha_tactics_game_record.MechaTacticsGameChassis
+}
+
+type MechaTacticsGameWeaponConfig struct {
+	Reference string
+	Record    *mecha_tactics_game_record.MechaTacticsGameWeapon
+}
+
+type MechaTacticsGameTerrainTypeConfig struct {
+	Reference string
+	Record    *mecha_tactics_game_record.MechaTacticsGameTerrainType
+}
+
+type MechaTacticsGameHexConfig struct {
+	Reference      string
+	TerrainTypeRef string
+	Record         *mecha_tactics_game_record.MechaTacticsGameHex
+}
+
+type MechaTacticsGameComputerOpponentConfig struct {
+	Reference string
+	Record    *mecha_tactics_game_record.MechaTacticsGameComputerOpponent
+}
+
+// MechaTacticsGameMechWeaponRef maps a weapon ref to a slot location for
+// use in MechaTacticsGameMechConfig.WeaponConfigRefs.
+type MechaTacticsGameMechWeaponRef struct {
+	WeaponRef    string
+	SlotLocation string
+}
+
+type MechaTacticsGameMechConfig struct {
+	Reference        string
+	ChassisRef       string
+	WeaponConfigRefs []MechaTacticsGameMechWeaponRef
+	Record           *mecha_tactics_game_record.MechaTacticsGameMech
+}
+
 // Helper methods for modifying DataConfig
 
 // FindGameInstanceConfig finds a game instance config by reference.
@@ -939,6 +1005,37 @@ func DefaultDataConfig() DataConfig {
 						},
 					},
 				},
+				{
+					Reference: MechaGameSquadReserveRef,
+					SquadType: mecha_game_record.SquadTypeReserve,
+					Record: &mecha_game_record.MechaGameSquad{
+						Name:        UniqueName("Reserve Squad"),
+						Description: "Reserve mechs used to fill player squads up to the squad size.",
+					},
+					SquadMechConfigs: []MechaGameSquadMechConfig{
+						{
+							Reference:  "mecha-squad-reserve-mech-1",
+							ChassisRef: MechaGameChassisOneRef,
+							Record: &mecha_game_record.MechaGameSquadMech{
+								Callsign: "Reserve-1",
+							},
+						},
+						{
+							Reference:  "mecha-squad-reserve-mech-2",
+							ChassisRef: MechaGameChassisOneRef,
+							Record: &mecha_game_record.MechaGameSquadMech{
+								Callsign: "Reserve-2",
+							},
+						},
+						{
+							Reference:  "mecha-squad-reserve-mech-3",
+							ChassisRef: MechaGameChassisOneRef,
+							Record: &mecha_game_record.MechaGameSquadMech{
+								Callsign: "Reserve-3",
+							},
+						},
+					},
+				},
 				{
 					Reference: MechaGameSquadOneRef,
 					SquadType: mecha_game_record.SquadTypeOpponent,
@@ -958,6 +1055,114 @@ func DefaultDataConfig() DataConfig {
 				},
 			},
 			},
+			// MechaTacticsGame game for testing mecha tactics specific resources
+			{
+				Reference: GameMechaTacticsGameRef,
+				Record: &game_record.Game{
+					Name:              UniqueName("Default MechaTacticsGame"),
+					GameType:          game_record.GameTypeMechaTactics,
+					TurnDurationHours: 168,
+				},
+				MechaTacticsGameChassisConfigs: []MechaTacticsGameChassisConfig{
+					{
+						Reference: MechaTacticsGameChassisOneRef,
+						Record: &mecha_tactics_game_record.MechaTacticsGameChassis{
+							Name:            UniqueName("Hunchback"),
+							Description:     "A medium brawler mech.",
+							ChassisClass:    mecha_tactics_game_record.ChassisClassMedium,
+							ArmorPoints:     120,
+							StructurePoints: 60,
+							HeatCapacity:    30,
+							Speed:           4,
+						},
+					},
+				},
+				MechaTacticsGameWeaponConfigs: []MechaTacticsGameWeaponConfig{
+					{
+						Reference: MechaTacticsGameWeaponOneRef,
+						Record: &mecha_tactics_game_record.MechaTacticsGameWeapon{
+							Name:        UniqueName("Medium Laser"),
+							Description: "A reliable energy weapon.",
+							Damage:      5,
+							HeatCost:    3,
+							RangeBand:   mecha_tactics_game_record.WeaponRangeBandMedium,
+							MountSize:   mecha_tactics_game_record.WeaponMountSizeMedium,
+						},
+					},
+				},
+				MechaTacticsGameTerrainTypeConfigs: []MechaTacticsGameTerrainTypeConfig{
+					{
+						Reference: MechaTacticsGameTerrainTypeOneRef,
+						Record: &mecha_tactics_game_record.MechaTacticsGameTerrainType{
+							Name:              UniqueName("Clear"),
+							Description:       "Open ground with no cover.",
+							MovementPointCost: 1,
+						},
+					},
+				},
+				MechaTacticsGameHexConfigs: []MechaTacticsGameHexConfig{
+					{
+						Reference:      MechaTacticsGameHexOneRef,
+						TerrainTypeRef: MechaTacticsGameTerrainTypeOneRef,
+						Record: &mecha_tactics_game_record.MechaTacticsGameHex{
+							HexColumn:     0,
+							HexRow:        0,
+							IsStartingHex: true,
+						},
+					},
+					{
+						Reference:      MechaTacticsGameHexTwoRef,
+						TerrainTypeRef: MechaTacticsGameTerrainTypeOneRef,
+						Record: &mecha_tactics_game_record.MechaTacticsGameHex{
+							HexColumn: 1,
+							HexRow:    0,
+						},
+					},
+					{
+						Reference:      MechaTacticsGameHexThreeRef,
+						TerrainTypeRef: MechaTacticsGameTerrainTypeOneRef,
+						Record: &mecha_tactics_game_record.MechaTacticsGameHex{
+							HexColumn:     2,
+							HexRow:        0,
+							IsStartingHex: true,
+						},
+					},
+				},
+				MechaTacticsGameComputerOpponentConfigs: []MechaTacticsGameComputerOpponentConfig{
+					{
+						Reference: MechaTacticsGameComputerOpponentOneRef,
+						Record: &mecha_tactics_game_record.MechaTacticsGameComputerOpponent{
+							Name:       UniqueName("Iron Legion"),
+							Aggression: 5,
+							IQ:         5,
+						},
+					},
+				},
+				MechaTacticsGameMechConfigs: []MechaTacticsGameMechConfig{
+					{
+						Reference:  MechaTacticsGameMechStarterRef,
+						ChassisRef: MechaTacticsGameChassisOneRef,
+						WeaponConfigRefs: []MechaTacticsGameMechWeaponRef{
+							{WeaponRef: MechaTacticsGameWeaponOneRef, SlotLocation: "right-arm"},
+						},
+						Record: &mecha_tactics_game_record.MechaTacticsGameMech{
+							MechType: mecha_tactics_game_record.MechTypeStarter,
+							Callsign: "Starter",
+						},
+					},
+					{
+						Reference:  MechaTacticsGameMechOpponentRef,
+						ChassisRef: MechaTacticsGameChassisOneRef,
+						WeaponConfigRefs: []MechaTacticsGameMechWeaponRef{
+							{WeaponRef: MechaTacticsGameWeaponOneRef, SlotLocation: "right-arm"},
+						},
+						Record: &mecha_tactics_game_record.MechaTacticsGameMech{
+							MechType: mecha_tactics_game_record.MechTypeOpponent,
+							Callsign: "Legion",
+						},
+					},
+				},
+			},
 		},
 		// Account user game subscription configurations may only be be resolved
 		// once both accounts and games have been created.
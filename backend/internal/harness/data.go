@@ -7,6 +7,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
 )
 
 // Data -
@@ -49,6 +50,13 @@ type Data struct {
 	MechaGameComputerOpponentRecs  []*mecha_game_record.MechaGameComputerOpponent
 	MechaGameSquadRecs             []*mecha_game_record.MechaGameSquad
 	MechaGameSquadMechRecs         []*mecha_game_record.MechaGameSquadMech
+	// MechaTacticsGame specific resources
+	MechaTacticsGameChassisRecs          []*mecha_tactics_game_record.MechaTacticsGameChassis
+	MechaTacticsGameWeaponRecs           []*mecha_tactics_game_record.MechaTacticsGameWeapon
+	MechaTacticsGameTerrainTypeRecs      []*mecha_tactics_game_record.MechaTacticsGameTerrainType
+	MechaTacticsGameHexRecs              []*mecha_tactics_game_record.MechaTacticsGameHex
+	MechaTacticsGameComputerOpponentRecs []*mecha_tactics_game_record.MechaTacticsGameComputerOpponent
+	MechaTacticsGameMechRecs             []*mecha_tactics_game_record.MechaTacticsGameMech
 	// Session tokens by account ID
 	AccountSessionTokens map[string]string
 	// Data references
@@ -76,6 +84,13 @@ type DataRefs struct {
 	MechaGameComputerOpponentRefs  map[string]string
 	MechaGameSquadRefs             map[string]string
 	MechaGameSquadMechRefs         map[string]string
+	// MechaTacticsGame specific resources
+	MechaTacticsGameChassisRefs          map[string]string
+	MechaTacticsGameWeaponRefs           map[string]string
+	MechaTacticsGameTerrainTypeRefs      map[string]string
+	MechaTacticsGameHexRefs              map[string]string
+	MechaTacticsGameComputerOpponentRefs map[string]string
+	MechaTacticsGameMechRefs             map[string]string
 	// Adventure game specific resources
 	AdventureGameLocationRefs                map[string]string // Map of adventure game location refs to adventure game location records
 	AdventureGameLocationLinkRefs            map[string]string // Map of adventure game location link refs to adventure game location link records
@@ -119,6 +134,13 @@ func initialiseDataStores() Data {
 		MechaGameComputerOpponentRefs: map[string]string{},
 		MechaGameSquadRefs:            map[string]string{},
 		MechaGameSquadMechRefs:        map[string]string{},
+		// MechaTacticsGame specific resources
+		MechaTacticsGameChassisRefs:          map[string]string{},
+		MechaTacticsGameWeaponRefs:           map[string]string{},
+		MechaTacticsGameTerrainTypeRefs:      map[string]string{},
+		MechaTacticsGameHexRefs:              map[string]string{},
+		MechaTacticsGameComputerOpponentRefs: map[string]string{},
+		MechaTacticsGameMechRefs:             map[string]string{},
 		// Adventure game specific resources
 		AdventureGameLocationRefs:                map[string]string{},
 			AdventureGameLocationLinkRefs:            map[string]string{},
@@ -1189,6 +1211,154 @@ func (d *Data) GetMechaGameSquadMechRecByRef(ref string) (*mecha_game_record.Mec
 	return nil, fmt.Errorf("failed getting mecha squad mech with id >%s< for ref >%s<", id, ref)
 }
 
+// ------------------------------------------------------------
+// MechaTacticsGame specific resources
+// ------------------------------------------------------------
+
+// MechaTacticsGameChassis
+func (d *Data) AddMechaTacticsGameChassisRec(rec *mecha_tactics_game_record.MechaTacticsGameChassis) {
+	for idx := range d.MechaTacticsGameChassisRecs {
+		if d.MechaTacticsGameChassisRecs[idx].ID == rec.ID {
+			d.MechaTacticsGameChassisRecs[idx] = rec
+			return
+		}
+	}
+	d.MechaTacticsGameChassisRecs = append(d.MechaTacticsGameChassisRecs, rec)
+}
+
+func (d *Data) GetMechaTacticsGameChassisRecByRef(ref string) (*mecha_tactics_game_record.MechaTacticsGameChassis, error) {
+	id, ok := d.Refs.MechaTacticsGameChassisRefs[ref]
+	if !ok {
+		return nil, fmt.Errorf("failed getting mecha tactics chassis with ref >%s<", ref)
+	}
+	for _, rec := range d.MechaTacticsGameChassisRecs {
+		if rec.ID == id {
+			return rec, nil
+		}
+	}
+	return nil, fmt.Errorf("failed getting mecha tactics chassis with id >%s< for ref >%s<", id, ref)
+}
+
+// MechaTacticsGameWeapon
+func (d *Data) AddMechaTacticsGameWeaponRec(rec *mecha_tactics_game_record.MechaTacticsGameWeapon) {
+	for idx := range d.MechaTacticsGameWeaponRecs {
+		if d.MechaTacticsGameWeaponRecs[idx].ID == rec.ID {
+			d.MechaTacticsGameWeaponRecs[idx] = rec
+			return
+		}
+	}
+	d.MechaTacticsGameWeaponRecs = append(d.MechaTacticsGameWeaponRecs, rec)
+}
+
+func (d *Data) GetMechaTacticsGameWeaponRecByRef(ref string) (*mecha_tactics_game_record.MechaTacticsGameWeapon, error) {
+	id, ok := d.Refs.MechaTacticsGameWeaponRefs[ref]
+	if !ok {
+		return nil, fmt.Errorf("failed getting mecha tactics weapon with ref >%s<", ref)
+	}
+	for _, rec := range d.MechaTacticsGameWeaponRecs {
+		if rec.ID == id {
+			return rec, nil
+		}
+	}
+	return nil, fmt.Errorf("failed getting mecha tactics weapon with id >%s< for ref >%s<", id, ref)
+}
+
+// MechaTacticsGameTerrainType
+func (d *Data) AddMechaTacticsGameTerrainTypeRec(rec *mecha_tactics_game_record.MechaTacticsGameTerrainType) {
+	for idx := range d.MechaTacticsGameTerrainTypeRecs {
+		if d.MechaTacticsGameTerrainTypeRecs[idx].ID == rec.ID {
+			d.MechaTacticsGameTerrainTypeRecs[idx] = rec
+			return
+		}
+	}
+	d.MechaTacticsGameTerrainTypeRecs = append(d.MechaTacticsGameTerrainTypeRecs, rec)
+}
+
+func (d *Data) GetMechaTacticsGameTerrainTypeRecByRef(ref string) (*mecha_tactics_game_record.MechaTacticsGameTerrainType, error) {
+	id, ok := d.Refs.MechaTacticsGameTerrainTypeRefs[ref]
+	if !ok {
+		return nil, fmt.Errorf("failed getting mecha tactics terrain type with ref >%s<", ref)
+	}
+	for _, rec := range d.MechaTacticsGameTerrainTypeRecs {
+		if rec.ID == id {
+			return rec, nil
+		}
+	}
+	return nil, fmt.Errorf("failed getting mecha tactics terrain type with id >%s< for ref >%s<", id, ref)
+}
+
+// MechaTacticsGameHex
+func (d *Data) AddMechaTacticsGameHexRec(rec *mecha_tactics_game_record.MechaTacticsGameHex) {
+	for idx := range d.MechaTacticsGameHexRecs {
+		if d.MechaTacticsGameHexRecs[idx].ID == rec.ID {
+			d.MechaTacticsGameHexRecs[idx] = rec
+			return
+		}
+	}
+	d.MechaTacticsGameHexRecs = append(d.MechaTacticsGameHexRecs, rec)
+}
+
+func (d *Data) GetMechaTacticsGameHexRecByRef(ref string) (*mecha_tactics_game_record.MechaTacticsGameHex, error) {
+	id, ok := d.Refs.MechaTacticsGameHexRefs[ref]
+	if !ok {
+		return nil, fmt.Errorf("failed getting mecha tactics hex with ref >%s<", ref)
+	}
+	for _, rec := range d.MechaTacticsGameHexRecs {
+		if rec.ID == id {
+			return rec, nil
+		}
+	}
+	return nil, fmt.Errorf("failed getting mecha tactics hex with id >%s< for ref >%s<", id, ref)
+}
+
+// MechaTacticsGameComputerOpponent
+func (d *Data) AddMechaTacticsGameComputerOpponentRec(rec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent) {
+	for idx := range d.MechaTacticsGameComputerOpponentRecs {
+		if d.MechaTacticsGameComputerOpponentRecs[idx].ID == rec.ID {
+			d.MechaTacticsGameComputerOpponentRecs[idx] = rec
+			return
+		}
+	}
+	d.MechaTacticsGameComputerOpponentRecs = append(d.MechaTacticsGameComputerOpponentRecs, rec)
+}
+
+func (d *Data) GetMechaTacticsGameComputerOpponentRecByRef(ref string) (*mecha_tactics_game_record.MechaTacticsGameComputerOpponent, error) {
+	id, ok := d.Refs.MechaTacticsGameComputerOpponentRefs[ref]
+	if !ok {
+		return nil, fmt.Errorf("failed getting mecha tactics computer opponent with ref >%s<", ref)
+	}
+	for _, rec := range d.MechaTacticsGameComputerOpponentRecs {
+		if rec.ID == id {
+			return rec, nil
+		}
+	}
+	return nil, fmt.Errorf("failed getting mecha tactics computer opponent with id >%s< for ref >%s<", id, ref)
+}
+
+// MechaTacticsGameMech
+func (d *Data) AddMechaTacticsGameMechRec(rec *mecha_tactics_game_record.MechaTacticsGameMech) {
+	for idx := range d.MechaTacticsGameMechRecs {
+		if d.MechaTacticsGameMechRecs[idx].ID == rec.ID {
+			d.MechaTacticsGameMechRecs[idx] = rec
+			return
+		}
+	}
+	d.MechaTacticsGameMechRecs = append(d.MechaTacticsGameMechRecs, rec)
+}
+
+func (d *Data) GetMechaTacticsGameMechRecByRef(ref string) (*mecha_tactics_game_record.MechaTacticsGameMech, error) {
+	id, ok := d.Refs.MechaTacticsGameMechRefs[ref]
+	if !ok {
+		return nil, fmt.Errorf("failed getting mecha tactics mech with ref >%s<", ref)
+	}
+	for _, rec := range d.MechaTacticsGameMechRecs {
+		if rec.ID == id {
+			return rec, nil
+		}
+	}
+	return nil, fmt.Errorf("failed getting mecha tactics mech with id >%s< for ref >%s<", id, ref)
+}
+
 // AdventureGameTurnSheet
 func (d *Data) AddAdventureGameTurnSheetRec(rec *adventure_game_record.AdventureGameTurnSheet) {
 	for idx := range d.AdventureGameTurnSheetRecs {
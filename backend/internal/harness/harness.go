@@ -175,6 +175,12 @@ func (t *Testing) createGames() error {
 			l.Warn("failed processing mecha config >%v<", err)
 			return err
 		}
+
+		// Process mecha tactics config
+		if err := t.processMechaTacticsGameConfig(t.DataConfig.GameConfigs[i], gameRec); err != nil {
+			l.Warn("failed processing mecha tactics config >%v<", err)
+			return err
+		}
 	}
 
 	l.Debug("created >%d< game records", len(allGameRecs))
@@ -330,6 +336,16 @@ func (t *Testing) RemoveData() error {
 	}
 	l.Debug("removed mecha records")
 
+	// ------------------------------------------------------------
+	// MechaTacticsGame specific records
+	// ------------------------------------------------------------
+
+	if err := t.removeMechaTacticsGameRecords(); err != nil {
+		l.Warn("failed removing mecha tactics records >%v<", err)
+		return err
+	}
+	l.Debug("removed mecha tactics records")
+
 	// Remove game image records
 	l.Debug("removing >%d< game image records", len(t.teardownData.GameImageRecs))
 	for _, imageRec := range t.teardownData.GameImageRecs {
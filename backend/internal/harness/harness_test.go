@@ -39,8 +39,8 @@ func TestHarnessSetupTeardown_DefaultDataConfig(t *testing.T) {
 	require.Len(t, h.Data.AccountUserRecs, 4, "Should have exactly 4 account user records")
 	// AccountUserContacts: 4 (one per account user)
 	require.Len(t, h.Data.AccountUserContactRecs, 4, "Should have exactly 4 account user contact records")
-	// Games: 3 (GameOneRef, GameDraftRef, GameMechaGameRef)
-	require.Len(t, h.Data.GameRecs, 3, "Should have exactly 3 game records")
+	// Games: 4 (GameOneRef, GameDraftRef, GameMechaGameRef, GameMechaTacticsGameRef)
+	require.Len(t, h.Data.GameRecs, 4, "Should have exactly 4 game records")
 
 	// Adventure game specific resources
 
@@ -75,10 +75,25 @@ func TestHarnessSetupTeardown_DefaultDataConfig(t *testing.T) {
 	require.Len(t, h.Data.MechaGameSectorRecs, 2, "Should have exactly 2 mecha sector records")
 	// Sector links: 1 (MechaGameSectorLinkOneRef)
 	require.Len(t, h.Data.MechaGameSectorLinkRecs, 1, "Should have exactly 1 mecha sector link record")
-	// Squads: 2 (MechaGameSquadStarterRef, MechaGameSquadOneRef)
-	require.Len(t, h.Data.MechaGameSquadRecs, 2, "Should have exactly 2 mecha squad records")
-	// Squad mechs: 2
-	require.Len(t, h.Data.MechaGameSquadMechRecs, 2, "Should have exactly 2 mecha squad mech records")
+	// Squads: 3 (MechaGameSquadStarterRef, MechaGameSquadReserveRef, MechaGameSquadOneRef)
+	require.Len(t, h.Data.MechaGameSquadRecs, 3, "Should have exactly 3 mecha squad records")
+	// Squad mechs: 5 (1 starter, 3 reserve, 1 opponent)
+	require.Len(t, h.Data.MechaGameSquadMechRecs, 5, "Should have exactly 5 mecha squad mech records")
+
+	// MechaTacticsGame game specific resources
+
+	// Chassis: 1 (MechaTacticsGameChassisOneRef)
+	require.Len(t, h.Data.MechaTacticsGameChassisRecs, 1, "Should have exactly 1 mecha tactics chassis record")
+	// Weapons: 1 (MechaTacticsGameWeaponOneRef)
+	require.Len(t, h.Data.MechaTacticsGameWeaponRecs, 1, "Should have exactly 1 mecha tactics weapon record")
+	// Terrain types: 1 (MechaTacticsGameTerrainTypeOneRef)
+	require.Len(t, h.Data.MechaTacticsGameTerrainTypeRecs, 1, "Should have exactly 1 mecha tactics terrain type record")
+	// Hexes: 3 (MechaTacticsGameHexOneRef, MechaTacticsGameHexTwoRef, MechaTacticsGameHexThreeRef)
+	require.Len(t, h.Data.MechaTacticsGameHexRecs, 3, "Should have exactly 3 mecha tactics hex records")
+	// Computer opponents: 1 (MechaTacticsGameComputerOpponentOneRef)
+	require.Len(t, h.Data.MechaTacticsGameComputerOpponentRecs, 1, "Should have exactly 1 mecha tactics computer opponent record")
+	// Mechs: 2 (MechaTacticsGameMechStarterRef, MechaTacticsGameMechOpponentRef)
+	require.Len(t, h.Data.MechaTacticsGameMechRecs, 2, "Should have exactly 2 mecha tactics mech records")
 
 	// All harness account users should be active by default
 	for _, rec := range h.Data.AccountUserRecs {
@@ -48,8 +48,10 @@ func (t *Testing) processMechaGameConfig(gameConfig GameConfig, gameRec *game_re
 	}
 
 	for _, cfg := range gameConfig.MechaGameSquadConfigs {
-		if cfg.SquadType != mecha_game_record.SquadTypeStarter && cfg.SquadType != mecha_game_record.SquadTypeOpponent {
-			return fmt.Errorf("mecha squad config >%s< must have SquadType set to 'starter' or 'opponent'", cfg.Reference)
+		if cfg.SquadType != mecha_game_record.SquadTypeStarter &&
+			cfg.SquadType != mecha_game_record.SquadTypeOpponent &&
+			cfg.SquadType != mecha_game_record.SquadTypeReserve {
+			return fmt.Errorf("mecha squad config >%s< must have SquadType set to 'starter', 'opponent' or 'reserve'", cfg.Reference)
 		}
 		if _, err := t.createMechaGameSquadRec(cfg, gameRec); err != nil {
 			l.Warn("failed creating mecha squad record >%v<", err)
@@ -208,6 +210,9 @@ func applyMechaGameChassisDefaults(rec *mecha_game_record.MechaGameChassis) *mec
 	if rec.Speed == 0 {
 		rec.Speed = 3
 	}
+	if rec.SensorRange == 0 {
+		rec.SensorRange = mecha_game_record.DefaultChassisSensorRange
+	}
 	if rec.SmallSlots == 0 && rec.MediumSlots == 0 && rec.LargeSlots == 0 {
 		rec.SmallSlots, rec.MediumSlots, rec.LargeSlots = mecha_game_record.DefaultSlotsForChassisClass(rec.ChassisClass)
 	}
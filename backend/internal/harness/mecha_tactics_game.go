@@ -0,0 +1,437 @@
+package harness
+
+import (
+	"fmt"
+
+	"github.com/brianvoe/gofakeit"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+func (t *Testing) processMechaTacticsGameConfig(gameConfig GameConfig, gameRec *game_record.Game) error {
+	l := t.Logger("processMechaTacticsGameConfig")
+
+	for _, cfg := range gameConfig.MechaTacticsGameChassisConfigs {
+		if _, err := t.createMechaTacticsGameChassisRec(cfg, gameRec); err != nil {
+			l.Warn("failed creating mecha tactics chassis record >%v<", err)
+			return err
+		}
+	}
+
+	for _, cfg := range gameConfig.MechaTacticsGameWeaponConfigs {
+		if _, err := t.createMechaTacticsGameWeaponRec(cfg, gameRec); err != nil {
+			l.Warn("failed creating mecha tactics weapon record >%v<", err)
+			return err
+		}
+	}
+
+	for _, cfg := range gameConfig.MechaTacticsGameTerrainTypeConfigs {
+		if _, err := t.createMechaTacticsGameTerrainTypeRec(cfg, gameRec); err != nil {
+			l.Warn("failed creating mecha tactics terrain type record >%v<", err)
+			return err
+		}
+	}
+
+	for _, cfg := range gameConfig.MechaTacticsGameHexConfigs {
+		if _, err := t.createMechaTacticsGameHexRec(cfg, gameRec); err != nil {
+			l.Warn("failed creating mecha tactics hex record >%v<", err)
+			return err
+		}
+	}
+
+	for _, cfg := range gameConfig.MechaTacticsGameComputerOpponentConfigs {
+		if _, err := t.createMechaTacticsGameComputerOpponentRec(cfg, gameRec); err != nil {
+			l.Warn("failed creating mecha tactics computer opponent record >%v<", err)
+			return err
+		}
+	}
+
+	for _, cfg := range gameConfig.MechaTacticsGameMechConfigs {
+		if _, err := t.createMechaTacticsGameMechRec(cfg, gameRec); err != nil {
+			l.Warn("failed creating mecha tactics mech record >%v<", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *Testing) removeMechaTacticsGameRecords() error {
+	l := t.Logger("removeMechaTacticsGameRecords")
+
+	// Mechs must be removed before chassis and weapons (FK dependency)
+	l.Debug("removing >%d< mecha tactics mech records", len(t.teardownData.MechaTacticsGameMechRecs))
+	for _, rec := range t.teardownData.MechaTacticsGameMechRecs {
+		if rec.ID == "" {
+			continue
+		}
+		if err := t.Domain.(*domain.Domain).RemoveMechaTacticsGameMechRec(rec.ID); err != nil {
+			l.Warn("failed removing mecha tactics mech record >%v<", err)
+			return err
+		}
+	}
+
+	l.Debug("removing >%d< mecha tactics computer opponent records", len(t.teardownData.MechaTacticsGameComputerOpponentRecs))
+	for _, rec := range t.teardownData.MechaTacticsGameComputerOpponentRecs {
+		if rec.ID == "" {
+			continue
+		}
+		if err := t.Domain.(*domain.Domain).RemoveMechaTacticsGameComputerOpponentRec(rec.ID); err != nil {
+			l.Warn("failed removing mecha tactics computer opponent record >%v<", err)
+			return err
+		}
+	}
+
+	// Hexes must be removed before terrain types
+	l.Debug("removing >%d< mecha tactics hex records", len(t.teardownData.MechaTacticsGameHexRecs))
+	for _, rec := range t.teardownData.MechaTacticsGameHexRecs {
+		if rec.ID == "" {
+			continue
+		}
+		if err := t.Domain.(*domain.Domain).RemoveMechaTacticsGameHexRec(rec.ID); err != nil {
+			l.Warn("failed removing mecha tactics hex record >%v<", err)
+			return err
+		}
+	}
+
+	l.Debug("removing >%d< mecha tactics terrain type records", len(t.teardownData.MechaTacticsGameTerrainTypeRecs))
+	for _, rec := range t.teardownData.MechaTacticsGameTerrainTypeRecs {
+		if rec.ID == "" {
+			continue
+		}
+		if err := t.Domain.(*domain.Domain).RemoveMechaTacticsGameTerrainTypeRec(rec.ID); err != nil {
+			l.Warn("failed removing mecha tactics terrain type record >%v<", err)
+			return err
+		}
+	}
+
+	l.Debug("removing >%d< mecha tactics weapon records", len(t.teardownData.MechaTacticsGameWeaponRecs))
+	for _, rec := range t.teardownData.MechaTacticsGameWeaponRecs {
+		if rec.ID == "" {
+			continue
+		}
+		if err := t.Domain.(*domain.Domain).RemoveMechaTacticsGameWeaponRec(rec.ID); err != nil {
+			l.Warn("failed removing mecha tactics weapon record >%v<", err)
+			return err
+		}
+	}
+
+	l.Debug("removing >%d< mecha tactics chassis records", len(t.teardownData.MechaTacticsGameChassisRecs))
+	for _, rec := range t.teardownData.MechaTacticsGameChassisRecs {
+		if rec.ID == "" {
+			continue
+		}
+		if err := t.Domain.(*domain.Domain).RemoveMechaTacticsGameChassisRec(rec.ID); err != nil {
+			l.Warn("failed removing mecha tactics chassis record >%v<", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *Testing) createMechaTacticsGameChassisRec(cfg MechaTacticsGameChassisConfig, gameRec *game_record.Game) (*mecha_tactics_game_record.MechaTacticsGameChassis, error) {
+	l := t.Logger("createMechaTacticsGameChassisRec")
+
+	if gameRec == nil {
+		return nil, fmt.Errorf("game record is nil for mecha tactics chassis config >%#v<", cfg)
+	}
+
+	var rec *mecha_tactics_game_record.MechaTacticsGameChassis
+	if cfg.Record != nil {
+		recCopy := *cfg.Record
+		rec = &recCopy
+	} else {
+		rec = &mecha_tactics_game_record.MechaTacticsGameChassis{}
+	}
+
+	if rec.Name == "" {
+		rec.Name = UniqueName(gofakeit.Name())
+	}
+	if rec.Description == "" {
+		rec.Description = gofakeit.Sentence(8)
+	}
+	if rec.ChassisClass == "" {
+		rec.ChassisClass = mecha_tactics_game_record.ChassisClassMedium
+	}
+	if rec.ArmorPoints == 0 {
+		rec.ArmorPoints = 100
+	}
+	if rec.StructurePoints == 0 {
+		rec.StructurePoints = 50
+	}
+	if rec.HeatCapacity == 0 {
+		rec.HeatCapacity = 30
+	}
+	if rec.Speed == 0 {
+		rec.Speed = 4
+	}
+	rec.GameID = gameRec.ID
+
+	l.Debug("creating mecha tactics chassis record >%#v<", rec)
+
+	rec, err := t.Domain.(*domain.Domain).CreateMechaTacticsGameChassisRec(rec)
+	if err != nil {
+		l.Warn("failed creating mecha tactics chassis record >%v<", err)
+		return nil, err
+	}
+
+	t.Data.AddMechaTacticsGameChassisRec(rec)
+	t.teardownData.AddMechaTacticsGameChassisRec(rec)
+
+	if cfg.Reference != "" {
+		t.Data.Refs.MechaTacticsGameChassisRefs[cfg.Reference] = rec.ID
+	}
+
+	return rec, nil
+}
+
+func (t *Testing) createMechaTacticsGameWeaponRec(cfg MechaTacticsGameWeaponConfig, gameRec *game_record.Game) (*mecha_tactics_game_record.MechaTacticsGameWeapon, error) {
+	l := t.Logger("createMechaTacticsGameWeaponRec")
+
+	if gameRec == nil {
+		return nil, fmt.Errorf("game record is nil for mecha tactics weapon config >%#v<", cfg)
+	}
+
+	var rec *mecha_tactics_game_record.MechaTacticsGameWeapon
+	if cfg.Record != nil {
+		recCopy := *cfg.Record
+		rec = &recCopy
+	} else {
+		rec = &mecha_tactics_game_record.MechaTacticsGameWeapon{}
+	}
+
+	if rec.Name == "" {
+		rec.Name = UniqueName(gofakeit.Name())
+	}
+	if rec.Description == "" {
+		rec.Description = gofakeit.Sentence(8)
+	}
+	if rec.Damage == 0 {
+		rec.Damage = 5
+	}
+	if rec.RangeBand == "" {
+		rec.RangeBand = mecha_tactics_game_record.WeaponRangeBandMedium
+	}
+	if rec.MountSize == "" {
+		rec.MountSize = mecha_tactics_game_record.WeaponMountSizeMedium
+	}
+	rec.GameID = gameRec.ID
+
+	l.Debug("creating mecha tactics weapon record >%#v<", rec)
+
+	rec, err := t.Domain.(*domain.Domain).CreateMechaTacticsGameWeaponRec(rec)
+	if err != nil {
+		l.Warn("failed creating mecha tactics weapon record >%v<", err)
+		return nil, err
+	}
+
+	t.Data.AddMechaTacticsGameWeaponRec(rec)
+	t.teardownData.AddMechaTacticsGameWeaponRec(rec)
+
+	if cfg.Reference != "" {
+		t.Data.Refs.MechaTacticsGameWeaponRefs[cfg.Reference] = rec.ID
+	}
+
+	return rec, nil
+}
+
+func (t *Testing) createMechaTacticsGameTerrainTypeRec(cfg MechaTacticsGameTerrainTypeConfig, gameRec *game_record.Game) (*mecha_tactics_game_record.MechaTacticsGameTerrainType, error) {
+	l := t.Logger("createMechaTacticsGameTerrainTypeRec")
+
+	if gameRec == nil {
+		return nil, fmt.Errorf("game record is nil for mecha tactics terrain type config >%#v<", cfg)
+	}
+
+	var rec *mecha_tactics_game_record.MechaTacticsGameTerrainType
+	if cfg.Record != nil {
+		recCopy := *cfg.Record
+		rec = &recCopy
+	} else {
+		rec = &mecha_tactics_game_record.MechaTacticsGameTerrainType{}
+	}
+
+	if rec.Name == "" {
+		rec.Name = UniqueName(gofakeit.Name())
+	}
+	if rec.Description == "" {
+		rec.Description = gofakeit.Sentence(8)
+	}
+	if rec.MovementPointCost == 0 {
+		rec.MovementPointCost = 1
+	}
+	rec.GameID = gameRec.ID
+
+	l.Debug("creating mecha tactics terrain type record >%#v<", rec)
+
+	rec, err := t.Domain.(*domain.Domain).CreateMechaTacticsGameTerrainTypeRec(rec)
+	if err != nil {
+		l.Warn("failed creating mecha tactics terrain type record >%v<", err)
+		return nil, err
+	}
+
+	t.Data.AddMechaTacticsGameTerrainTypeRec(rec)
+	t.teardownData.AddMechaTacticsGameTerrainTypeRec(rec)
+
+	if cfg.Reference != "" {
+		t.Data.Refs.MechaTacticsGameTerrainTypeRefs[cfg.Reference] = rec.ID
+	}
+
+	return rec, nil
+}
+
+func (t *Testing) createMechaTacticsGameHexRec(cfg MechaTacticsGameHexConfig, gameRec *game_record.Game) (*mecha_tactics_game_record.MechaTacticsGameHex, error) {
+	l := t.Logger("createMechaTacticsGameHexRec")
+
+	if gameRec == nil {
+		return nil, fmt.Errorf("game record is nil for mecha tactics hex config >%#v<", cfg)
+	}
+
+	var rec *mecha_tactics_game_record.MechaTacticsGameHex
+	if cfg.Record != nil {
+		recCopy := *cfg.Record
+		rec = &recCopy
+	} else {
+		rec = &mecha_tactics_game_record.MechaTacticsGameHex{}
+	}
+
+	rec.GameID = gameRec.ID
+
+	if cfg.TerrainTypeRef != "" {
+		terrainTypeID, ok := t.Data.Refs.MechaTacticsGameTerrainTypeRefs[cfg.TerrainTypeRef]
+		if !ok {
+			return nil, fmt.Errorf("failed resolving terrain type ref >%s<", cfg.TerrainTypeRef)
+		}
+		rec.MechaTacticsGameTerrainTypeID = terrainTypeID
+	}
+
+	l.Debug("creating mecha tactics hex record >%#v<", rec)
+
+	rec, err := t.Domain.(*domain.Domain).CreateMechaTacticsGameHexRec(rec)
+	if err != nil {
+		l.Warn("failed creating mecha tactics hex record >%v<", err)
+		return nil, err
+	}
+
+	t.Data.AddMechaTacticsGameHexRec(rec)
+	t.teardownData.AddMechaTacticsGameHexRec(rec)
+
+	if cfg.Reference != "" {
+		t.Data.Refs.MechaTacticsGameHexRefs[cfg.Reference] = rec.ID
+	}
+
+	return rec, nil
+}
+
+func (t *Testing) createMechaTacticsGameComputerOpponentRec(cfg MechaTacticsGameComputerOpponentConfig, gameRec *game_record.Game) (*mecha_tactics_game_record.MechaTacticsGameComputerOpponent, error) {
+	l := t.Logger("createMechaTacticsGameComputerOpponentRec")
+
+	if gameRec == nil {
+		return nil, fmt.Errorf("game record is nil for mecha tactics computer opponent config >%#v<", cfg)
+	}
+
+	var rec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent
+	if cfg.Record != nil {
+		recCopy := *cfg.Record
+		rec = &recCopy
+	} else {
+		rec = &mecha_tactics_game_record.MechaTacticsGameComputerOpponent{}
+	}
+
+	if rec.Name == "" {
+		rec.Name = UniqueName(gofakeit.Name())
+	}
+	if rec.Description == "" {
+		rec.Description = gofakeit.Sentence(8)
+	}
+	if rec.Aggression == 0 {
+		rec.Aggression = 5
+	}
+	if rec.IQ == 0 {
+		rec.IQ = 5
+	}
+	rec.GameID = gameRec.ID
+
+	l.Debug("creating mecha tactics computer opponent record >%#v<", rec)
+
+	rec, err := t.Domain.(*domain.Domain).CreateMechaTacticsGameComputerOpponentRec(rec)
+	if err != nil {
+		l.Warn("failed creating mecha tactics computer opponent record >%v<", err)
+		return nil, err
+	}
+
+	t.Data.AddMechaTacticsGameComputerOpponentRec(rec)
+	t.teardownData.AddMechaTacticsGameComputerOpponentRec(rec)
+
+	if cfg.Reference != "" {
+		t.Data.Refs.MechaTacticsGameComputerOpponentRefs[cfg.Reference] = rec.ID
+	}
+
+	return rec, nil
+}
+
+func (t *Testing) createMechaTacticsGameMechRec(cfg MechaTacticsGameMechConfig, gameRec *game_record.Game) (*mecha_tactics_game_record.MechaTacticsGameMech, error) {
+	l := t.Logger("createMechaTacticsGameMechRec")
+
+	if gameRec == nil {
+		return nil, fmt.Errorf("game record is nil for mecha tactics mech config >%#v<", cfg)
+	}
+
+	var rec *mecha_tactics_game_record.MechaTacticsGameMech
+	if cfg.Record != nil {
+		recCopy := *cfg.Record
+		rec = &recCopy
+	} else {
+		rec = &mecha_tactics_game_record.MechaTacticsGameMech{}
+	}
+
+	rec.GameID = gameRec.ID
+
+	if cfg.ChassisRef != "" {
+		chassisID, ok := t.Data.Refs.MechaTacticsGameChassisRefs[cfg.ChassisRef]
+		if !ok {
+			return nil, fmt.Errorf("failed resolving chassis ref >%s<", cfg.ChassisRef)
+		}
+		rec.MechaTacticsGameChassisID = chassisID
+	}
+
+	if len(cfg.WeaponConfigRefs) > 0 {
+		entries := make([]mecha_tactics_game_record.WeaponConfigEntry, 0, len(cfg.WeaponConfigRefs))
+		for _, wRef := range cfg.WeaponConfigRefs {
+			weaponID, ok := t.Data.Refs.MechaTacticsGameWeaponRefs[wRef.WeaponRef]
+			if !ok {
+				return nil, fmt.Errorf("failed resolving weapon ref >%s<", wRef.WeaponRef)
+			}
+			entries = append(entries, mecha_tactics_game_record.WeaponConfigEntry{
+				WeaponID:     weaponID,
+				SlotLocation: wRef.SlotLocation,
+			})
+		}
+		rec.WeaponConfig = entries
+	}
+
+	if rec.MechType == "" {
+		rec.MechType = mecha_tactics_game_record.MechTypeStarter
+	}
+	if rec.Callsign == "" {
+		rec.Callsign = UniqueName("Mech")
+	}
+
+	l.Debug("creating mecha tactics mech record >%#v<", rec)
+
+	rec, err := t.Domain.(*domain.Domain).CreateMechaTacticsGameMechRec(rec)
+	if err != nil {
+		l.Warn("failed creating mecha tactics mech record >%v<", err)
+		return nil, err
+	}
+
+	t.Data.AddMechaTacticsGameMechRec(rec)
+	t.teardownData.AddMechaTacticsGameMechRec(rec)
+
+	if cfg.Reference != "" {
+		t.Data.Refs.MechaTacticsGameMechRefs[cfg.Reference] = rec.ID
+	}
+
+	return rec, nil
+}
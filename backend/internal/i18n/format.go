@@ -0,0 +1,133 @@
+package i18n
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatDate formats the date part of a time in the locale, for example
+// "January 2, 2006" in English and "2 de enero de 2006" in Spanish
+func (z *Localizer) FormatDate(t time.Time) string {
+	month := z.T(fmt.Sprintf("format.month.%d", int(t.Month())))
+	return z.T("format.date", "day", t.Day(), "month", month, "year", t.Year())
+}
+
+// FormatTime formats the time of day part of a time in the locale, including
+// the time zone abbreviation
+func (z *Localizer) FormatTime(t time.Time) string {
+	return t.Format(z.T("format.time_layout"))
+}
+
+// FormatDateTime formats a time as its date followed by its time of day
+func (z *Localizer) FormatDateTime(t time.Time) string {
+	return z.T("format.date_time", "date", z.FormatDate(t), "time", z.FormatTime(t))
+}
+
+// FormatNumber formats a number with the locale's digit grouping and decimal
+// separators. Integers are formatted without decimals and other numbers with
+// up to two decimal places.
+func (z *Localizer) FormatNumber(n any) string {
+	var value float64
+	switch v := n.(type) {
+	case int:
+		value = float64(v)
+	case int32:
+		value = float64(v)
+	case int64:
+		value = float64(v)
+	case float32:
+		value = float64(v)
+	case float64:
+		value = v
+	case *int:
+		if v == nil {
+			return ""
+		}
+		value = float64(*v)
+	default:
+		return fmt.Sprint(n)
+	}
+
+	negative := value < 0
+	value = math.Abs(value)
+
+	formatted := strconv.FormatFloat(value, 'f', 2, 64)
+	whole, fraction, _ := strings.Cut(formatted, ".")
+	fraction = strings.TrimRight(fraction, "0")
+
+	groupSeparator := z.T("format.group_separator")
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteString(groupSeparator)
+		}
+		grouped.WriteRune(digit)
+	}
+
+	result := grouped.String()
+	if fraction != "" {
+		result += z.T("format.decimal_separator") + fraction
+	}
+	if negative && result != "0" {
+		result = "-" + result
+	}
+
+	return result
+}
+
+// FormatOrdinal formats a position such as a game placing, for example "2nd"
+// in English and "2.º" in Spanish
+func (z *Localizer) FormatOrdinal(n int) string {
+	key := "format.ordinal.other"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			key = "format.ordinal.one"
+		case 2:
+			key = "format.ordinal.two"
+		case 3:
+			key = "format.ordinal.few"
+		}
+	}
+	return z.T(key, "n", n)
+}
+
+// FuncMap returns the template functions that format messages, dates and
+// numbers in the locale
+//   - t: message for a key, with placeholder name and value argument pairs
+//   - tArgs: message for a key, with placeholder values from a map
+//   - formatDate, formatTime, formatDateTime: format a time or time pointer
+//   - formatNumber: format an integer or decimal number
+//   - formatOrdinal: format a position such as 1st or 2nd
+//   - locale: the locale templates are rendered in
+func (z *Localizer) FuncMap() map[string]any {
+	timeFunc := func(format func(time.Time) string) func(any) string {
+		return func(v any) string {
+			switch t := v.(type) {
+			case time.Time:
+				return format(t)
+			case *time.Time:
+				if t == nil {
+					return ""
+				}
+				return format(*t)
+			default:
+				return fmt.Sprint(v)
+			}
+		}
+	}
+
+	return map[string]any{
+		"t":              z.T,
+		"tArgs":          z.TArgs,
+		"formatDate":     timeFunc(z.FormatDate),
+		"formatTime":     timeFunc(z.FormatTime),
+		"formatDateTime": timeFunc(z.FormatDateTime),
+		"formatNumber":   z.FormatNumber,
+		"formatOrdinal":  z.FormatOrdinal,
+		"locale":         z.Locale,
+	}
+}
@@ -0,0 +1,185 @@
+// Package i18n provides the message catalogue turn sheets, emails and
+// validation messages are written from, and locale aware date and number
+// formatting.
+//
+// Messages are kept in a JSON catalogue per locale under locales/, keyed by a
+// dotted message key. Message text may contain named placeholders such as
+// {turn} that are replaced by the arguments a message is formatted with.
+// Messages missing from a locale's catalogue fall back to English, and keys
+// missing from the English catalogue are returned as they are so a missing
+// message is easy to spot.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+
+	"gitlab.com/alienspaces/playbymail/core/collection/set"
+)
+
+// Supported locales
+const (
+	LocaleEnglish = "en"
+	LocaleSpanish = "es"
+)
+
+// DefaultLocale is used when an account has no locale or an unsupported locale
+const DefaultLocale = LocaleEnglish
+
+// SupportedLocales are the locales a catalogue is provided for
+var SupportedLocales = set.New(
+	LocaleEnglish,
+	LocaleSpanish,
+)
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+var (
+	cataloguesOnce sync.Once
+	catalogues     map[string]map[string]string
+	cataloguesErr  error
+)
+
+// loadCatalogues reads the embedded catalogue of every supported locale
+func loadCatalogues() (map[string]map[string]string, error) {
+	cataloguesOnce.Do(func() {
+		catalogues = map[string]map[string]string{}
+		for locale := range SupportedLocales {
+			data, err := localeFS.ReadFile(path.Join("locales", locale+".json"))
+			if err != nil {
+				cataloguesErr = fmt.Errorf("failed to read %s catalogue: %w", locale, err)
+				return
+			}
+			messages := map[string]string{}
+			if err := json.Unmarshal(data, &messages); err != nil {
+				cataloguesErr = fmt.Errorf("failed to parse %s catalogue: %w", locale, err)
+				return
+			}
+			catalogues[locale] = messages
+		}
+	})
+	return catalogues, cataloguesErr
+}
+
+// NormalizeLocale returns the supported locale for a locale such as "es-MX" or
+// "es_ES", or the default locale when the language is not supported
+func NormalizeLocale(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if locale == "" {
+		return DefaultLocale
+	}
+	if SupportedLocales.Has(locale) {
+		return locale
+	}
+	if language, _, ok := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-"); ok && SupportedLocales.Has(language) {
+		return language
+	}
+	return DefaultLocale
+}
+
+// IsSupportedLocale returns true when a catalogue is provided for the locale
+func IsSupportedLocale(locale string) bool {
+	return SupportedLocales.Has(locale)
+}
+
+// Localizer formats messages, dates and numbers for a single locale
+type Localizer struct {
+	locale string
+}
+
+// NewLocalizer returns a localizer for a locale, using the default locale when
+// the locale is not supported
+func NewLocalizer(locale string) *Localizer {
+	return &Localizer{locale: NormalizeLocale(locale)}
+}
+
+// Locale returns the locale messages are formatted in
+func (z *Localizer) Locale() string {
+	return z.locale
+}
+
+// T returns the message for a key with its placeholders replaced. Arguments are
+// given as pairs of placeholder name and value, for example
+// T("email.turn_sheet_notification.subject", "turn", 3, "game", "The Shattered Reach").
+func (z *Localizer) T(key string, args ...any) string {
+	return Format(z.message(key), args...)
+}
+
+// TArgs returns the message for a key with its placeholders replaced by the
+// values of a map, as stored with turn events and validation issues
+func (z *Localizer) TArgs(key string, args map[string]string) string {
+	pairs := make([]any, 0, len(args)*2)
+	for name, value := range args {
+		pairs = append(pairs, name, value)
+	}
+	return z.T(key, pairs...)
+}
+
+// Has returns true when the locale or English catalogue has a message for a key
+func (z *Localizer) Has(key string) bool {
+	cats, err := loadCatalogues()
+	if err != nil {
+		return false
+	}
+	if _, ok := cats[z.locale][key]; ok {
+		return true
+	}
+	_, ok := cats[DefaultLocale][key]
+	return ok
+}
+
+func (z *Localizer) message(key string) string {
+	cats, err := loadCatalogues()
+	if err != nil {
+		return key
+	}
+	if msg, ok := cats[z.locale][key]; ok {
+		return msg
+	}
+	if msg, ok := cats[DefaultLocale][key]; ok {
+		return msg
+	}
+	return key
+}
+
+// Format replaces the named placeholders in a message with the values of the
+// name and value argument pairs
+func Format(msg string, args ...any) string {
+	if len(args) < 2 || !strings.Contains(msg, "{") {
+		return msg
+	}
+
+	pairs := make([]string, 0, len(args))
+	for i := 0; i+1 < len(args); i += 2 {
+		pairs = append(pairs, fmt.Sprintf("{%v}", args[i]), formatArg(args[i+1]))
+	}
+
+	return strings.NewReplacer(pairs...).Replace(msg)
+}
+
+// formatArg formats a placeholder value, dereferencing pointers such as the
+// optional turn numbers templates pass so a nil pointer formats as empty text
+func formatArg(arg any) string {
+	v := reflect.ValueOf(arg)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return ""
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// T returns the message for a key in a locale, see Localizer.T
+func T(locale, key string, args ...any) string {
+	return NewLocalizer(locale).T(key, args...)
+}
@@ -0,0 +1,115 @@
+package i18n
+
+import (
+	"encoding/json"
+	"path"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{locale: "", want: LocaleEnglish},
+		{locale: "en", want: LocaleEnglish},
+		{locale: "es", want: LocaleSpanish},
+		{locale: "ES", want: LocaleSpanish},
+		{locale: "es-MX", want: LocaleSpanish},
+		{locale: "es_ES", want: LocaleSpanish},
+		{locale: "fr", want: LocaleEnglish},
+		{locale: "fr-CA", want: LocaleEnglish},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			require.Equal(t, tt.want, NormalizeLocale(tt.locale))
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	require.Equal(t, "Turn 3", T(LocaleEnglish, "turnsheet.turn", "turn", 3))
+	require.Equal(t, "Turno 3", T(LocaleSpanish, "turnsheet.turn", "turn", 3))
+
+	turn := 4
+	require.Equal(t, "Turn 4", T(LocaleEnglish, "turnsheet.turn", "turn", &turn), "pointer arguments are dereferenced")
+	require.Equal(t, "Turn ", T(LocaleEnglish, "turnsheet.turn", "turn", (*int)(nil)), "nil pointer arguments are empty")
+
+	require.Equal(t, "no.such.key", T(LocaleSpanish, "no.such.key"), "missing keys are returned as they are")
+	require.Equal(t, "Turn 3", T("fr", "turnsheet.turn", "turn", 3), "unsupported locales use English")
+}
+
+func TestTArgs(t *testing.T) {
+	z := NewLocalizer(LocaleEnglish)
+	require.Equal(t, "You dropped Rusty Sword.", z.TArgs("event.inventory.dropped", map[string]string{"item": "Rusty Sword"}))
+}
+
+func TestFormatDate(t *testing.T) {
+	tm := time.Date(2026, time.March, 2, 9, 30, 0, 0, time.UTC)
+
+	require.Equal(t, "March 2, 2026", NewLocalizer(LocaleEnglish).FormatDate(tm))
+	require.Equal(t, "2 de marzo de 2026", NewLocalizer(LocaleSpanish).FormatDate(tm))
+	require.Equal(t, "9:30 AM UTC", NewLocalizer(LocaleEnglish).FormatTime(tm))
+	require.Equal(t, "09:30 UTC", NewLocalizer(LocaleSpanish).FormatTime(tm))
+}
+
+func TestFormatNumber(t *testing.T) {
+	en := NewLocalizer(LocaleEnglish)
+	es := NewLocalizer(LocaleSpanish)
+
+	require.Equal(t, "0", en.FormatNumber(0))
+	require.Equal(t, "999", en.FormatNumber(999))
+	require.Equal(t, "1,234,567", en.FormatNumber(1234567))
+	require.Equal(t, "1.234.567", es.FormatNumber(1234567))
+	require.Equal(t, "-1,234.5", en.FormatNumber(-1234.5))
+	require.Equal(t, "1.234,5", es.FormatNumber(1234.5))
+}
+
+func TestFormatOrdinal(t *testing.T) {
+	en := NewLocalizer(LocaleEnglish)
+
+	for n, want := range map[int]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 13: "13th", 21: "21st", 112: "112th"} {
+		require.Equal(t, want, en.FormatOrdinal(n))
+	}
+	require.Equal(t, "2.º", NewLocalizer(LocaleSpanish).FormatOrdinal(2))
+}
+
+// TestCatalogues checks every supported locale translates every English message
+// and uses the same placeholders as the English message
+func TestCatalogues(t *testing.T) {
+	placeholder := regexp.MustCompile(`\{[a-z_]+\}`)
+
+	read := func(locale string) map[string]string {
+		data, err := localeFS.ReadFile(path.Join("locales", locale+".json"))
+		require.NoError(t, err)
+		messages := map[string]string{}
+		require.NoError(t, json.Unmarshal(data, &messages))
+		return messages
+	}
+
+	english := read(LocaleEnglish)
+	require.NotEmpty(t, english)
+
+	for locale := range SupportedLocales {
+		if locale == LocaleEnglish {
+			continue
+		}
+		t.Run(locale, func(t *testing.T) {
+			messages := read(locale)
+			for key, msg := range english {
+				translated, ok := messages[key]
+				require.True(t, ok, "locale %s is missing key %s", locale, key)
+				require.ElementsMatch(t, placeholder.FindAllString(msg, -1), placeholder.FindAllString(translated, -1), "locale %s key %s placeholders", locale, key)
+			}
+			for key := range messages {
+				_, ok := english[key]
+				require.True(t, ok, "locale %s has key %s missing from English", locale, key)
+			}
+		})
+	}
+}
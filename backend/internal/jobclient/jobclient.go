@@ -106,6 +106,21 @@ func addDefaultPeriodicJobs(l logger.Logger, cfg config.Config, s storer.Storer,
 		nil,
 	))
 
+	if cfg.InboundMailProvider != "" {
+		inboundMailInterval := time.Duration(cfg.InboundMailPollIntervalSeconds) * time.Second
+		l.Info("adding inbound mail polling periodic job with interval >%s<", inboundMailInterval)
+
+		p = append(p, river.NewPeriodicJob(
+			river.PeriodicInterval(inboundMailInterval),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return jobworker.InboundMailPollingWorkerArgs{}, &river.InsertOpts{
+					Queue: jobqueue.QueueDefault,
+				}
+			},
+			&river.PeriodicJobOpts{RunOnStart: true},
+		))
+	}
+
 	return p, nil
 }
 
@@ -125,6 +140,32 @@ func addGamePeriodicJobs(l logger.Logger, cfg config.Config, s storer.Storer, p
 		&river.PeriodicJobOpts{RunOnStart: true},
 	))
 
+	turnReminderInterval := time.Duration(cfg.TurnReminderIntervalSeconds) * time.Second
+	l.Info("adding game turn reminder periodic job with interval >%s<", turnReminderInterval)
+
+	p = append(p, river.NewPeriodicJob(
+		river.PeriodicInterval(turnReminderInterval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return jobworker.GameTurnReminderWorkerArgs{}, &river.InsertOpts{
+				Queue: jobqueue.QueueGame,
+			}
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	))
+
+	printBatchStatusInterval := time.Duration(cfg.PrintBatchStatusIntervalSeconds) * time.Second
+	l.Info("adding game print batch status periodic job with interval >%s<", printBatchStatusInterval)
+
+	p = append(p, river.NewPeriodicJob(
+		river.PeriodicInterval(printBatchStatusInterval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return jobworker.GamePrintBatchStatusWorkerArgs{}, &river.InsertOpts{
+				Queue: jobqueue.QueueGame,
+			}
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	))
+
 	return p, nil
 }
 
@@ -188,6 +229,17 @@ func getWorkers(l logger.Logger, cfg config.Config, s storer.Storer, e emailer.E
 		return nil, fmt.Errorf("failed to add NewSendTurnSheetNotificationEmailWorker worker: %w", err)
 	}
 
+	// Add game results email worker
+	// Sends each player their final placing with a link to the final results turn sheet when a game instance completes.
+	sendGameResultsEmailWorker, err := jobworker.NewSendGameResultsEmailWorker(l, cfg, s, e)
+	if err != nil {
+		return nil, fmt.Errorf("failed NewSendGameResultsEmailWorker worker: %w", err)
+	}
+
+	if err := river.AddWorkerSafely(w, sendGameResultsEmailWorker); err != nil {
+		return nil, fmt.Errorf("failed to add NewSendGameResultsEmailWorker worker: %w", err)
+	}
+
 	// Add game turn processing execution worker
 	// Executes game turn processing by running game logic, updating game state, and handling player actions.
 	// Manages turn progression, game rules enforcement, and state transitions.
@@ -212,6 +264,65 @@ func getWorkers(l logger.Logger, cfg config.Config, s storer.Storer, e emailer.E
 		return nil, fmt.Errorf("failed to add NewGameTurnQueueingWorker worker: %w", err)
 	}
 
+	// Add game turn reminder queue worker
+	// Queues turn deadline reminder emails for players with turn sheets still to submit
+	// at each configured number of hours before the turn deadline.
+	gameTurnReminderWorker, err := jobworker.NewGameTurnReminderWorker(l, cfg, s)
+	if err != nil {
+		return nil, fmt.Errorf("failed NewGameTurnReminderWorker worker: %w", err)
+	}
+
+	if err := river.AddWorkerSafely(w, gameTurnReminderWorker); err != nil {
+		return nil, fmt.Errorf("failed to add NewGameTurnReminderWorker worker: %w", err)
+	}
+
+	// Add send turn reminder email worker
+	// Sends a turn deadline reminder email with a link to the turn sheet viewer.
+	sendTurnReminderEmailWorker, err := jobworker.NewSendTurnReminderEmailWorker(l, cfg, s, e)
+	if err != nil {
+		return nil, fmt.Errorf("failed NewSendTurnReminderEmailWorker worker: %w", err)
+	}
+
+	if err := river.AddWorkerSafely(w, sendTurnReminderEmailWorker); err != nil {
+		return nil, fmt.Errorf("failed to add NewSendTurnReminderEmailWorker worker: %w", err)
+	}
+
+	// Add game print batch worker
+	// Renders turn sheets for physical post and local delivery players, collated behind a
+	// cover sheet per player, and submits the batch to the configured print device.
+	gamePrintBatchWorker, err := jobworker.NewGamePrintBatchWorker(l, cfg, s)
+	if err != nil {
+		return nil, fmt.Errorf("failed NewGamePrintBatchWorker worker: %w", err)
+	}
+
+	if err := river.AddWorkerSafely(w, gamePrintBatchWorker); err != nil {
+		return nil, fmt.Errorf("failed to add NewGamePrintBatchWorker worker: %w", err)
+	}
+
+	// Add game print batch status worker
+	// Periodically polls the print device for submitted print batch job state and
+	// completes or fails batches once their printer job finishes.
+	gamePrintBatchStatusWorker, err := jobworker.NewGamePrintBatchStatusWorker(l, cfg, s)
+	if err != nil {
+		return nil, fmt.Errorf("failed NewGamePrintBatchStatusWorker worker: %w", err)
+	}
+
+	if err := river.AddWorkerSafely(w, gamePrintBatchStatusWorker); err != nil {
+		return nil, fmt.Errorf("failed to add NewGamePrintBatchStatusWorker worker: %w", err)
+	}
+
+	// Add game scan batch worker
+	// Splits uploaded scan batch documents into pages and routes each page to its
+	// turn sheet, processing one page per job so progress is visible to managers.
+	gameScanBatchWorker, err := jobworker.NewGameScanBatchWorker(l, cfg, s)
+	if err != nil {
+		return nil, fmt.Errorf("failed NewGameScanBatchWorker worker: %w", err)
+	}
+
+	if err := river.AddWorkerSafely(w, gameScanBatchWorker); err != nil {
+		return nil, fmt.Errorf("failed to add NewGameScanBatchWorker worker: %w", err)
+	}
+
 	// Add join game turn sheet worker
 	// Processes join game turn sheets when a game subscription is approved,
 	// creating the necessary game entities (game instance, character, character instance, etc.)
@@ -235,5 +346,27 @@ func getWorkers(l logger.Logger, cfg config.Config, s storer.Storer, e emailer.E
 		return nil, fmt.Errorf("failed to add NewExpirePendingSubscriptionsWorker worker: %w", err)
 	}
 
+	// Periodically claims messages that have arrived in the inbound mailbox
+	// and queues a process inbound mail job for each.
+	inboundMailPollingWorker, err := jobworker.NewInboundMailPollingWorker(l, cfg, s)
+	if err != nil {
+		return nil, fmt.Errorf("failed NewInboundMailPollingWorker worker: %w", err)
+	}
+
+	if err := river.AddWorkerSafely(w, inboundMailPollingWorker); err != nil {
+		return nil, fmt.Errorf("failed to add NewInboundMailPollingWorker worker: %w", err)
+	}
+
+	// Submits turn sheets from player emails, reading attached turn sheet
+	// images and plain-text orders, and replies with a receipt.
+	processInboundMailWorker, err := jobworker.NewProcessInboundMailWorker(l, cfg, s, e)
+	if err != nil {
+		return nil, fmt.Errorf("failed NewProcessInboundMailWorker worker: %w", err)
+	}
+
+	if err := river.AddWorkerSafely(w, processInboundMailWorker); err != nil {
+		return nil, fmt.Errorf("failed to add NewProcessInboundMailWorker worker: %w", err)
+	}
+
 	return w, nil
 }
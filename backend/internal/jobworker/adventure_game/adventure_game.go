@@ -106,6 +106,14 @@ func (p *AdventureGame) initializeTurnSheetProcessors() (map[string]TurnSheetPro
 	}
 	processors[adventure_game_record.AdventureGameTurnSheetTypeCreatureEncounter] = creatureEncounterProcessor
 
+	// Register adventure ended processor
+	adventureEndedProcessor, err := turn_sheet_processor.NewAdventureGameAdventureEndedProcessor(l, p.Domain)
+	if err != nil {
+		l.Warn("failed to initialize adventure ended processor >%v<", err)
+		return nil, err
+	}
+	processors[adventure_game_record.AdventureGameTurnSheetTypeAdventureEnded] = adventureEndedProcessor
+
 	return processors, nil
 }
 
@@ -35,10 +35,30 @@ func (p *AdventureGame) CreateTurnSheets(ctx context.Context, gameInstanceRec *g
 		return nil, nil
 	}
 
+	droppedAccountUserIDs, err := p.getDroppedAccountUserIDs(gameInstanceRec)
+	if err != nil {
+		l.Error("failed to get dropped players for game instance >%s< error >%v<", gameInstanceRec.ID, err)
+		return nil, err
+	}
+
 	// Process turn sheets for each character
 	var errs []error
 	var createdTurnSheets []*game_record.GameTurnSheet
 	for _, characterInstanceRec := range characterInstanceRecs {
+		// Players dropped for missing too many turns receive no further turn sheets.
+		if len(droppedAccountUserIDs) > 0 {
+			characterRec, err := p.Domain.GetAdventureGameCharacterRec(characterInstanceRec.AdventureGameCharacterID, nil)
+			if err != nil {
+				l.Warn("failed to get character >%s< error >%v<", characterInstanceRec.AdventureGameCharacterID, err)
+				errs = append(errs, err)
+				continue
+			}
+			if droppedAccountUserIDs[characterRec.AccountUserID] {
+				l.Info("skipping turn sheet creation for dropped player character instance >%s<", characterInstanceRec.ID)
+				continue
+			}
+		}
+
 		characterTurnSheets, err := p.createCharacterTurnSheets(ctx, gameInstanceRec, characterInstanceRec)
 		if err != nil {
 			l.Warn("failed to process turn sheets for character >%s< error >%v<", characterInstanceRec.ID, err)
@@ -67,6 +87,28 @@ func (p *AdventureGame) createCharacterTurnSheets(ctx context.Context, gameInsta
 
 	var createdTurnSheets []*game_record.GameTurnSheet
 
+	// Retired characters receive a single adventure ended sheet on the turn after they
+	// ran out of lives and no turn sheets after that.
+	if characterInstance.IsRetired() {
+		if int(characterInstance.RetiredAtTurn.Int64) < gameInstanceRec.CurrentTurn-1 {
+			l.Debug("character instance ID >%s< retired at turn >%d< — no turn sheets", characterInstance.ID, characterInstance.RetiredAtTurn.Int64)
+			return nil, nil
+		}
+
+		turnSheetRec, err := p.createTurnSheet(ctx, gameInstanceRec, characterInstance, adventure_game_record.AdventureGameTurnSheetTypeAdventureEnded)
+		if err != nil {
+			l.Warn("failed to create adventure ended turn sheet for character >%s< error >%v<", characterInstance.ID, err)
+			return nil, err
+		}
+		if turnSheetRec != nil {
+			createdTurnSheets = append(createdTurnSheets, turnSheetRec)
+		}
+
+		turn_sheet_processor.ClearTurnEvents(l, p.Domain, characterInstance)
+
+		return createdTurnSheets, nil
+	}
+
 	// For each turn sheet type that has a processor, create a turn sheet for this character
 	// This includes location_choice and inventory_management turn sheets
 	// Inventory turn sheets are always generated (characters always have items or items are always available to pick up)
@@ -76,6 +118,11 @@ func (p *AdventureGame) createCharacterTurnSheets(ctx context.Context, gameInsta
 			continue
 		}
 
+		// Adventure ended turn sheets are only created for retired characters (see above).
+		if turnSheetType == adventure_game_record.AdventureGameTurnSheetTypeAdventureEnded {
+			continue
+		}
+
 		// Create a turn sheet for this character
 		turnSheetRec, err := p.createTurnSheet(ctx, gameInstanceRec, characterInstance, turnSheetType)
 		if err != nil {
@@ -87,11 +87,11 @@ func (p *AdventureGame) respawnCreature(_ context.Context, gameInstanceRec *game
 	}
 
 	for _, charInst := range characterInstances {
-		event := turnsheet.TurnEvent{
-			Category: turnsheet.TurnEventCategoryWorld,
-			Icon:     turnsheet.TurnEventIconWorld,
-			Message:  fmt.Sprintf("A %s emerges from the shadows.", creatureDef.Name),
+		if charInst.IsRetired() {
+			continue
 		}
+		event := turnsheet.NewTurnEvent(turnsheet.TurnEventCategoryWorld, turnsheet.TurnEventIconWorld,
+			"event.world.creature_respawned", "creature", creatureDef.Name)
 		if err := turnsheet.AppendTurnEvent(charInst, event); err != nil {
 			l.Warn("failed to append respawn world event for character >%s< >%v<", charInst.ID, err)
 			continue
@@ -0,0 +1,128 @@
+package adventure_game
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+// StandingOrderScannedData returns the scanned data that carries out a player's standing
+// order for a turn sheet they did not submit. Nil is returned when the standing order
+// calls for no action this turn.
+func (p *AdventureGame) StandingOrderScannedData(ctx context.Context, gameInstanceRec *game_record.GameInstance, turnSheetRec *game_record.GameTurnSheet, standingOrder string) (json.RawMessage, error) {
+	l := p.Logger.WithFunctionContext("AdventureGame/StandingOrderScannedData")
+
+	switch standingOrder {
+	case adventure_game_record.AdventureGameStandingOrderFleeAggressiveCreatures:
+		if turnSheetRec.SheetType != adventure_game_record.AdventureGameTurnSheetTypeLocationChoice {
+			return nil, nil
+		}
+		return fleeScannedData(turnSheetRec)
+	default:
+		l.Warn("unsupported standing order >%s< for turn sheet type >%s<", standingOrder, turnSheetRec.SheetType)
+		return nil, nil
+	}
+}
+
+// ComputerTakeoverScannedData returns the scanned data for a turn sheet when the computer
+// has taken over for a player. Adventure games have no computer opponent so the computer
+// keeps the character out of harm's way, fleeing from aggressive creatures and otherwise
+// staying put.
+func (p *AdventureGame) ComputerTakeoverScannedData(ctx context.Context, gameInstanceRec *game_record.GameInstance, turnSheetRec *game_record.GameTurnSheet) (json.RawMessage, error) {
+	if turnSheetRec.SheetType != adventure_game_record.AdventureGameTurnSheetTypeLocationChoice {
+		return nil, nil
+	}
+	return fleeScannedData(turnSheetRec)
+}
+
+// AppendMissedTurnEvent appends a turn event to the characters the turn sheets belong to
+// so the outcome of a missed turn is reported on the next turn's sheets.
+func (p *AdventureGame) AppendMissedTurnEvent(ctx context.Context, gameInstanceRec *game_record.GameInstance, turnSheetRecs []*game_record.GameTurnSheet, evt turnsheet.TurnEvent) error {
+	l := p.Logger.WithFunctionContext("AdventureGame/AppendMissedTurnEvent")
+
+	characterInstanceIDs := map[string]bool{}
+	for _, turnSheetRec := range turnSheetRecs {
+		adventureTurnSheetRecs, err := p.Domain.GetManyAdventureGameTurnSheetRecs(&coresql.Options{
+			Params: []coresql.Param{
+				{Col: adventure_game_record.FieldAdventureGameTurnSheetGameTurnSheetID, Val: turnSheetRec.ID},
+			},
+		})
+		if err != nil {
+			l.Warn("failed to get adventure game turn sheets for turn sheet >%s< error >%v<", turnSheetRec.ID, err)
+			return err
+		}
+		for _, adventureTurnSheetRec := range adventureTurnSheetRecs {
+			characterInstanceIDs[adventureTurnSheetRec.AdventureGameCharacterInstanceID] = true
+		}
+	}
+
+	for characterInstanceID := range characterInstanceIDs {
+		characterInstanceRec, err := p.Domain.GetAdventureGameCharacterInstanceRec(characterInstanceID, nil)
+		if err != nil {
+			l.Warn("failed to get character instance >%s< error >%v<", characterInstanceID, err)
+			return err
+		}
+		if err := turnsheet.AppendTurnEvent(characterInstanceRec, evt); err != nil {
+			return fmt.Errorf("failed to append missed turn event: %w", err)
+		}
+		if _, err := p.Domain.UpdateAdventureGameCharacterInstanceRec(characterInstanceRec); err != nil {
+			l.Warn("failed to update character instance >%s< error >%v<", characterInstanceID, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getDroppedAccountUserIDs returns the account users dropped from a game instance for
+// missing too many turns.
+func (p *AdventureGame) getDroppedAccountUserIDs(gameInstanceRec *game_record.GameInstance) (map[string]bool, error) {
+	droppedRecs, err := p.Domain.GetDroppedGameSubscriptionInstanceRecsByInstance(gameInstanceRec.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountUserIDs := make(map[string]bool, len(droppedRecs))
+	for _, droppedRec := range droppedRecs {
+		accountUserIDs[droppedRec.AccountUserID] = true
+	}
+
+	return accountUserIDs, nil
+}
+
+// fleeScannedData returns location choice scanned data that moves the character away
+// from aggressive creatures, or nil when there is nothing to flee from.
+func fleeScannedData(turnSheetRec *game_record.GameTurnSheet) (json.RawMessage, error) {
+	var sheetData turnsheet.LocationChoiceData
+	if err := json.Unmarshal(turnSheetRec.SheetData, &sheetData); err != nil {
+		return nil, fmt.Errorf("failed to parse location choice sheet data: %w", err)
+	}
+
+	locationID := fleeLocationID(&sheetData)
+	if locationID == "" {
+		return nil, nil
+	}
+
+	return json.Marshal(turnsheet.LocationChoiceScanData{
+		Choices: []string{locationID},
+	})
+}
+
+// fleeLocationID returns the first open exit when aggressive creatures are present at
+// the character's location.
+func fleeLocationID(sheetData *turnsheet.LocationChoiceData) string {
+	if !sheetData.HasAggressiveCreatures {
+		return ""
+	}
+	for _, option := range sheetData.LocationOptions {
+		if !option.IsLocked {
+			return option.LocationID
+		}
+	}
+	return ""
+}
@@ -6,8 +6,10 @@ import (
 	"slices"
 
 	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/jobworker/adventure_game/turn_sheet_processor"
 	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
 )
 
 // ProcessTurnSheets processes all turn sheet records for the current turn of an adventure game instance
@@ -58,6 +60,12 @@ func (p *AdventureGame) processCharacterTurnSheets(ctx context.Context, gameInst
 
 	l.Debug("processing turn sheets for character >%s< turn >%d<", characterInstance.ID, gameInstanceRec.CurrentTurn)
 
+	// Retired characters have run out of lives and take no further part in the game.
+	if characterInstance.IsRetired() {
+		l.Info("character >%s< retired at turn >%d< — skipping", characterInstance.ID, characterInstance.RetiredAtTurn.Int64)
+		return nil
+	}
+
 	// Get turn sheets for this character and turn
 	turnSheetRecs, err := p.getTurnSheetsForCharacter(characterInstance, gameInstanceRec.CurrentTurn)
 	if err != nil {
@@ -85,6 +93,43 @@ func (p *AdventureGame) processCharacterTurnSheets(ctx context.Context, gameInst
 			l.Warn("failed to process turn sheet >%s< for character >%s< error >%v<", turnSheet.ID, characterInstance.ID, err)
 			return err
 		}
+
+		// A character that ran out of lives part way through the turn takes no further actions.
+		if characterInstance.IsRetired() {
+			l.Info("character >%s< retired during turn >%d< — skipping remaining turn sheets", characterInstance.ID, gameInstanceRec.CurrentTurn)
+			break
+		}
+	}
+
+	return p.resolveCharacterDeath(gameInstanceRec, characterInstance)
+}
+
+// resolveCharacterDeath applies the character_lives game parameter to a character killed
+// this turn by anything other than combat (e.g. flee penalties, item or object effects).
+// Combat deaths are resolved immediately by the creature encounter processor.
+func (p *AdventureGame) resolveCharacterDeath(gameInstanceRec *game_record.GameInstance, characterInstance *adventure_game_record.AdventureGameCharacterInstance) error {
+	l := p.Logger.WithFunctionContext("AdventureGame/resolveCharacterDeath")
+
+	// Processors persist their own changes so reload the character for current health.
+	characterInstanceRec, err := p.Domain.GetAdventureGameCharacterInstanceRec(characterInstance.ID, nil)
+	if err != nil {
+		l.Warn("failed to get character instance >%s< error >%v<", characterInstance.ID, err)
+		return err
+	}
+
+	killed, err := turn_sheet_processor.ResolveCharacterDeath(l, p.Domain, gameInstanceRec, characterInstanceRec, turnsheet.TurnEventCategoryMovement)
+	if err != nil {
+		l.Warn("failed to resolve death for character >%s< error >%v<", characterInstanceRec.ID, err)
+		return err
+	}
+	if !killed {
+		return nil
+	}
+
+	_, err = p.Domain.UpdateAdventureGameCharacterInstanceRec(characterInstanceRec)
+	if err != nil {
+		l.Warn("failed to update character instance >%s< error >%v<", characterInstanceRec.ID, err)
+		return err
 	}
 
 	return nil
@@ -0,0 +1,183 @@
+package turn_sheet_processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/core/convert"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/record"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/turnsheetutil"
+)
+
+// AdventureGameAdventureEndedProcessor implements the TurnSheetProcessor interface
+// (defined in the parent adventure_game package)
+
+// AdventureGameAdventureEndedProcessor creates the final turn sheet for a character
+// that has run out of lives. The sheet is informational and has no player input to process.
+type AdventureGameAdventureEndedProcessor struct {
+	Logger logger.Logger
+	Domain *domain.Domain
+}
+
+// NewAdventureGameAdventureEndedProcessor creates a new adventure game adventure ended processor
+func NewAdventureGameAdventureEndedProcessor(l logger.Logger, d *domain.Domain) (*AdventureGameAdventureEndedProcessor, error) {
+	l = l.WithFunctionContext("NewAdventureGameAdventureEndedProcessor")
+
+	p := &AdventureGameAdventureEndedProcessor{
+		Logger: l,
+		Domain: d,
+	}
+	return p, nil
+}
+
+// GetSheetType returns the sheet type this processor handles (implements TurnSheetProcessor interface)
+func (p *AdventureGameAdventureEndedProcessor) GetSheetType() string {
+	return adventure_game_record.AdventureGameTurnSheetTypeAdventureEnded
+}
+
+// ProcessTurnSheetResponse does nothing; adventure ended sheets do not accept player input
+// (implements TurnSheetProcessor interface)
+func (p *AdventureGameAdventureEndedProcessor) ProcessTurnSheetResponse(ctx context.Context, gameInstanceRec *game_record.GameInstance, characterInstanceRec *adventure_game_record.AdventureGameCharacterInstance, turnSheet *game_record.GameTurnSheet) error {
+	l := p.Logger.WithFunctionContext("AdventureGameAdventureEndedProcessor/ProcessTurnSheetResponse")
+
+	l.Info("ignoring adventure ended turn sheet >%s< for character >%s<", turnSheet.ID, characterInstanceRec.ID)
+
+	return nil
+}
+
+// CreateNextTurnSheet creates the adventure ended turn sheet for a retired character.
+// Returns nil, nil when the character has not been retired (no sheet needed).
+func (p *AdventureGameAdventureEndedProcessor) CreateNextTurnSheet(ctx context.Context, gameInstanceRec *game_record.GameInstance, characterInstanceRec *adventure_game_record.AdventureGameCharacterInstance) (*game_record.GameTurnSheet, error) {
+	l := p.Logger.WithFunctionContext("AdventureGameAdventureEndedProcessor/CreateNextTurnSheet")
+
+	if !characterInstanceRec.IsRetired() {
+		return nil, nil
+	}
+
+	l.Info("creating adventure ended turn sheet for character >%s<", characterInstanceRec.ID)
+
+	gameRec, err := p.Domain.GetGameRec(gameInstanceRec.GameID, nil)
+	if err != nil {
+		l.Warn("failed to get game >%v<", err)
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	characterRec, err := p.Domain.GetAdventureGameCharacterRec(characterInstanceRec.AdventureGameCharacterID, nil)
+	if err != nil {
+		l.Warn("failed to get character >%v<", err)
+		return nil, fmt.Errorf("failed to get character: %w", err)
+	}
+
+	accountUserRec, err := p.Domain.GetAccountUserRec(characterRec.AccountUserID, nil)
+	if err != nil {
+		l.Warn("failed to get account >%v<", err)
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	characterLives, err := p.Domain.GetGameInstanceIntegerParameterValue(
+		gameInstanceRec.ID,
+		game_record.GameTypeAdventure,
+		domain.AdventureGameParameterCharacterLives,
+	)
+	if err != nil {
+		l.Warn("failed to get character lives parameter >%v<", err)
+		return nil, fmt.Errorf("failed to get character lives parameter: %w", err)
+	}
+
+	translator := GetTurnSheetTranslator(l, p.Domain, gameRec.ID, accountUserRec.AccountID)
+
+	locationName := ""
+	if characterInstanceRec.AdventureGameLocationInstanceID != "" {
+		locationInstanceRec, err := p.Domain.GetAdventureGameLocationInstanceRec(characterInstanceRec.AdventureGameLocationInstanceID, nil)
+		if err != nil {
+			l.Warn("failed to get character's final location >%v<", err)
+		} else if locationRec, err := p.Domain.GetAdventureGameLocationRec(locationInstanceRec.AdventureGameLocationID, nil); err != nil {
+			l.Warn("failed to get final location definition >%v<", err)
+		} else {
+			locationName = translator.Text(locationRec.ID, game_record.GameTranslationFieldNameName, locationRec.Name)
+		}
+	}
+
+	turnSheetCode, err := turnsheetutil.GeneratePlayGameTurnSheetCode(record.NewRecordID())
+	if err != nil {
+		l.Warn("failed to generate turn sheet code >%v<", err)
+		return nil, fmt.Errorf("failed to generate turn sheet code: %w", err)
+	}
+
+	// All remaining turn events are shown; this is the last sheet the player receives.
+	displayEvents, err := turnsheet.ReadTurnEvents(characterInstanceRec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read turn events: %w", err)
+	}
+
+	sheetData := turnsheet.AdventureEndedData{
+		TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
+			GameName:              convert.Ptr(translator.Text(gameRec.ID, game_record.GameTranslationFieldNameName, gameRec.Name)),
+			GameType:              convert.Ptr("adventure"),
+			TurnNumber:            convert.Ptr(gameInstanceRec.CurrentTurn),
+			AccountName:           convert.Ptr(accountUserRec.Email),
+			Locale:                convert.Ptr(translator.Locale()),
+			TurnSheetTitle:        convert.Ptr(i18n.T(translator.Locale(), "turnsheet.adventure_ended.title")),
+			TurnSheetDescription:  convert.Ptr(translator.Text(gameRec.ID, game_record.GameTranslationFieldNameDescription, gameRec.Description)),
+			TurnSheetInstructions: convert.Ptr(turnsheet.AdventureEndedInstructions(translator.Locale())),
+			TurnSheetCode:         convert.Ptr(turnSheetCode),
+			TurnEvents:            displayEvents,
+		},
+		CharacterName:  characterRec.Name,
+		LivesLost:      characterInstanceRec.LivesLost,
+		RetiredAtTurn:  int(characterInstanceRec.RetiredAtTurn.Int64),
+		LocationName:   locationName,
+		CharacterLives: characterLives,
+	}
+
+	sheetDataBytes, err := json.Marshal(sheetData)
+	if err != nil {
+		l.Warn("failed to marshal sheet data >%v<", err)
+		return nil, fmt.Errorf("failed to marshal sheet data: %w", err)
+	}
+
+	// The sheet has nothing for the player to submit so it is created completed and
+	// does not hold up early turn processing for the remaining players.
+	turnSheet := &game_record.GameTurnSheet{
+		GameID:           gameInstanceRec.GameID,
+		AccountID:        accountUserRec.AccountID,
+		AccountUserID:    characterRec.AccountUserID,
+		TurnNumber:       gameInstanceRec.CurrentTurn,
+		SheetType:        adventure_game_record.AdventureGameTurnSheetTypeAdventureEnded,
+		SheetOrder:       adventure_game_record.AdventureGameSheetOrderForType(adventure_game_record.AdventureGameTurnSheetTypeAdventureEnded),
+		SheetData:        json.RawMessage(sheetDataBytes),
+		IsCompleted:      true,
+		ProcessingStatus: game_record.TurnSheetProcessingStatusProcessed,
+	}
+	turnSheet.GameInstanceID = nullstring.FromString(gameInstanceRec.ID)
+
+	createdTurnSheetRec, err := p.Domain.CreateGameTurnSheetRec(turnSheet)
+	if err != nil {
+		l.Warn("failed to create turn sheet record >%v<", err)
+		return nil, fmt.Errorf("failed to create turn sheet record: %w", err)
+	}
+
+	adventureTurnSheet := &adventure_game_record.AdventureGameTurnSheet{
+		GameID:                           gameInstanceRec.GameID,
+		AdventureGameCharacterInstanceID: characterInstanceRec.ID,
+		GameTurnSheetID:                  createdTurnSheetRec.ID,
+	}
+
+	_, err = p.Domain.CreateAdventureGameTurnSheetRec(adventureTurnSheet)
+	if err != nil {
+		l.Warn("failed to create adventure game turn sheet record >%v<", err)
+		return nil, fmt.Errorf("failed to create adventure game turn sheet record: %w", err)
+	}
+
+	l.Info("created adventure ended turn sheet >%s< for character >%s<", createdTurnSheetRec.ID, characterInstanceRec.ID)
+
+	return createdTurnSheetRec, nil
+}
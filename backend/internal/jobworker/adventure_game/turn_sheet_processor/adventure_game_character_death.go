@@ -0,0 +1,106 @@
+package turn_sheet_processor
+
+import (
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/core/nullint64"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+// ResolveCharacterDeath applies the character_lives game parameter to a character
+// whose health has reached zero. The character loses a life and either respawns
+// at a starting location or, when no lives remain, is retired from the game.
+// The respawn event is recorded under the given category so it appears on the
+// turn sheet that reports the cause of death.
+// The caller is responsible for persisting the updated character instance.
+// Returns true when the character was killed this turn.
+func ResolveCharacterDeath(l logger.Logger, d *domain.Domain, gameInstanceRec *game_record.GameInstance, characterInstanceRec *adventure_game_record.AdventureGameCharacterInstance, category string) (bool, error) {
+	if characterInstanceRec.Health > 0 || characterInstanceRec.IsRetired() {
+		return false, nil
+	}
+
+	characterLives, err := d.GetGameInstanceIntegerParameterValue(
+		gameInstanceRec.ID,
+		game_record.GameTypeAdventure,
+		domain.AdventureGameParameterCharacterLives,
+	)
+	if err != nil {
+		l.Warn("failed to get character lives parameter >%v<", err)
+		return false, fmt.Errorf("failed to get character lives parameter: %w", err)
+	}
+
+	characterInstanceRec.Health = 0
+	characterInstanceRec.LivesLost++
+
+	livesRemaining := characterLives - characterInstanceRec.LivesLost
+	if livesRemaining <= 0 {
+		l.Info("character >%s< has lost all >%d< lives — retiring at turn >%d<",
+			characterInstanceRec.ID, characterLives, gameInstanceRec.CurrentTurn)
+
+		characterInstanceRec.RetiredAtTurn = nullint64.FromInt64(int64(gameInstanceRec.CurrentTurn))
+
+		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+			turnsheet.TurnEventCategorySystem, turnsheet.TurnEventIconDeath, "event.death.adventure_ended",
+		))
+		return true, nil
+	}
+
+	startingLocationName := "your starting location"
+	if err := respawnCharacter(l, d, gameInstanceRec, characterInstanceRec, &startingLocationName); err != nil {
+		l.Warn("failed to respawn character >%v<", err)
+		return true, err
+	}
+
+	respawnKey := "event.death.respawned"
+	if livesRemaining == 1 {
+		respawnKey = "event.death.respawned_one"
+	}
+
+	_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+		category, turnsheet.TurnEventIconDeath, respawnKey,
+		"location", startingLocationName, "lives", livesRemaining,
+	))
+
+	return true, nil
+}
+
+// respawnCharacter moves a dead character to the starting location and restores their health.
+// If startingLocationName is non-nil, it is set to the name of the starting location for narrative purposes.
+func respawnCharacter(l logger.Logger, d *domain.Domain, gameInstanceRec *game_record.GameInstance, characterInstanceRec *adventure_game_record.AdventureGameCharacterInstance, startingLocationName *string) error {
+	// Find the starting location instance for this game instance.
+	locationInstances, err := d.GetManyAdventureGameLocationInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: adventure_game_record.FieldAdventureGameLocationInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get location instances: %w", err)
+	}
+
+	for _, li := range locationInstances {
+		locationRec, err := d.GetAdventureGameLocationRec(li.AdventureGameLocationID, nil)
+		if err != nil {
+			continue
+		}
+		if locationRec.IsStartingLocation {
+			characterInstanceRec.AdventureGameLocationInstanceID = li.ID
+			characterInstanceRec.Health = CharacterRespawnHealth
+			if startingLocationName != nil {
+				*startingLocationName = locationRec.Name
+			}
+			l.Info("respawning dead character at starting location >%s< with health >%d<",
+				li.ID, CharacterRespawnHealth)
+			return nil
+		}
+	}
+
+	// No starting location found — just restore health without moving.
+	characterInstanceRec.Health = CharacterRespawnHealth
+	l.Warn("no starting location found for game instance >%s< — restored health only", gameInstanceRec.ID)
+	return nil
+}
@@ -0,0 +1,120 @@
+package turn_sheet_processor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/harness"
+	"gitlab.com/alienspaces/playbymail/internal/jobworker/adventure_game/turn_sheet_processor"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+func TestResolveCharacterDeath(t *testing.T) {
+	tests := []struct {
+		name             string
+		health           int
+		livesLost        int
+		characterLives   string
+		wantKilled       bool
+		wantRetired      bool
+		wantHealth       int
+		wantLivesLost    int
+		wantEventMessage string
+		wantCategory     string
+	}{
+		{
+			name:          "alive character is unaffected",
+			health:        40,
+			livesLost:     0,
+			wantKilled:    false,
+			wantRetired:   false,
+			wantHealth:    40,
+			wantLivesLost: 0,
+		},
+		{
+			name:             "dead character with lives remaining respawns",
+			health:           0,
+			livesLost:        0,
+			wantKilled:       true,
+			wantRetired:      false,
+			wantHealth:       turn_sheet_processor.CharacterRespawnHealth,
+			wantLivesLost:    1,
+			wantEventMessage: "You have 2 lives remaining.",
+			wantCategory:     turnsheet.TurnEventCategoryCombat,
+		},
+		{
+			name:             "dead character losing their last life is retired",
+			health:           0,
+			livesLost:        2,
+			wantKilled:       true,
+			wantRetired:      true,
+			wantHealth:       0,
+			wantLivesLost:    3,
+			wantEventMessage: "Your adventure has ended.",
+			wantCategory:     turnsheet.TurnEventCategorySystem,
+		},
+		{
+			name:             "game instance parameter override is honoured",
+			health:           0,
+			livesLost:        0,
+			characterLives:   "1",
+			wantKilled:       true,
+			wantRetired:      true,
+			wantHealth:       0,
+			wantLivesLost:    1,
+			wantEventMessage: "Your adventure has ended.",
+			wantCategory:     turnsheet.TurnEventCategorySystem,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th := combatHarness(t)
+			m := th.Domain.(*domain.Domain)
+
+			gameInstanceRec, err := th.Data.GetGameInstanceRecByRef(harness.GameInstanceOneRef)
+			require.NoError(t, err)
+
+			if tt.characterLives != "" {
+				paramRec, err := th.Data.GetGameInstanceParameterRecByRef(harness.GameInstanceParameterOneRef)
+				require.NoError(t, err)
+				paramRec.ParameterValue = nullstring.FromString(tt.characterLives)
+				_, err = m.UpdateGameInstanceParameterRec(paramRec)
+				require.NoError(t, err)
+			}
+
+			charInstanceRec, err := th.Data.GetAdventureGameCharacterInstanceRecByRef(harness.GameCharacterInstanceOneRef)
+			require.NoError(t, err)
+
+			charInstanceRec.Health = tt.health
+			charInstanceRec.LivesLost = tt.livesLost
+			charInstanceRec.LastTurnEvents = []byte("[]")
+
+			killed, err := turn_sheet_processor.ResolveCharacterDeath(m.Log, m, gameInstanceRec, charInstanceRec, turnsheet.TurnEventCategoryCombat)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.wantKilled, killed, "killed equals expected")
+			require.Equal(t, tt.wantRetired, charInstanceRec.IsRetired(), "retired equals expected")
+			require.Equal(t, tt.wantHealth, charInstanceRec.Health, "health equals expected")
+			require.Equal(t, tt.wantLivesLost, charInstanceRec.LivesLost, "lives lost equals expected")
+
+			if tt.wantRetired {
+				require.Equal(t, int64(gameInstanceRec.CurrentTurn), charInstanceRec.RetiredAtTurn.Int64, "retired at current turn")
+			}
+
+			events, err := turnsheet.ReadTurnEvents(charInstanceRec)
+			require.NoError(t, err)
+			if tt.wantEventMessage == "" {
+				require.Empty(t, events, "no turn events for an alive character")
+				return
+			}
+			require.Len(t, events, 1, "one turn event for a dead character")
+			require.Contains(t, events[0].Message, tt.wantEventMessage, "turn event message contains expected text")
+			require.Equal(t, turnsheet.TurnEventIconDeath, events[0].Icon, "turn event icon is death")
+			require.Equal(t, tt.wantCategory, events[0].Category, "turn event category equals expected")
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	coresql "gitlab.com/alienspaces/playbymail/core/sql"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
@@ -62,11 +63,9 @@ func (p *AdventureGameCreatureEncounterProcessor) ProcessTurnSheetResponse(
 	}
 	if tookInventoryActions {
 		l.Info("inventory actions taken this turn — forfeiting all combat actions for character >%s<", characterInstanceRec.ID)
-		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-			Category: turnsheet.TurnEventCategoryCombat,
-			Icon:     turnsheet.TurnEventIconInventory,
-			Message:  "You managed your inventory — your combat actions were forfeited.",
-		})
+		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+			turnsheet.TurnEventCategoryCombat, turnsheet.TurnEventIconInventory, "event.combat.forfeited",
+		))
 		_, saveErr := p.Domain.UpdateAdventureGameCharacterInstanceRec(characterInstanceRec)
 		if saveErr != nil {
 			l.Warn("failed to save character instance events >%v<", saveErr)
@@ -145,15 +144,14 @@ func (p *AdventureGameCreatureEncounterProcessor) ProcessTurnSheetResponse(
 			creatureInstance.Health -= playerDamage
 			l.Info("player deals %d damage to creature >%s< (health now %d)", playerDamage, creatureDef.Name, creatureInstance.Health)
 
-			attackWith := "your fists"
+			attackKey := "event.combat.attack_unarmed"
 			if weaponName != "" {
-				attackWith = "your " + weaponName
+				attackKey = "event.combat.attack_weapon"
 			}
-			_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-				Category: turnsheet.TurnEventCategoryCombat,
-				Icon:     turnsheet.TurnEventIconCombat,
-				Message:  fmt.Sprintf("You attack the %s with %s for %d damage.", creatureDef.Name, attackWith, playerDamage),
-			})
+			_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+				turnsheet.TurnEventCategoryCombat, turnsheet.TurnEventIconCombat, attackKey,
+				"creature", creatureDef.Name, "weapon", weaponName, "damage", playerDamage,
+			))
 
 			if creatureInstance.Health <= 0 {
 				creatureInstance.Health = 0
@@ -164,11 +162,10 @@ func (p *AdventureGameCreatureEncounterProcessor) ProcessTurnSheetResponse(
 				}
 				l.Info("creature >%s< has been killed", creatureDef.Name)
 
-				_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-					Category: turnsheet.TurnEventCategoryCombat,
-					Icon:     turnsheet.TurnEventIconDeath,
-					Message:  fmt.Sprintf("The %s has been slain!", creatureDef.Name),
-				})
+				_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+					turnsheet.TurnEventCategoryCombat, turnsheet.TurnEventIconDeath, "event.combat.creature_slain",
+					"creature", creatureDef.Name,
+				))
 
 				// Move creature's item instances to the location, collecting names for narrative.
 				droppedItems, err := p.moveCreatureItemsToLocation(l, creatureInstance, characterInstanceRec.AdventureGameLocationInstanceID)
@@ -176,11 +173,10 @@ func (p *AdventureGameCreatureEncounterProcessor) ProcessTurnSheetResponse(
 					l.Warn("failed to move creature items to location >%v<", err)
 				}
 				for _, itemName := range droppedItems {
-					_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-						Category: turnsheet.TurnEventCategoryCombat,
-						Icon:     turnsheet.TurnEventIconInventory,
-						Message:  fmt.Sprintf("The %s drops a %s.", creatureDef.Name, itemName),
-					})
+					_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+						turnsheet.TurnEventCategoryCombat, turnsheet.TurnEventIconInventory, "event.combat.creature_dropped",
+						"creature", creatureDef.Name, "item", itemName,
+					))
 				}
 				// Creature is dead — no retaliation this action.
 				continue
@@ -200,15 +196,14 @@ func (p *AdventureGameCreatureEncounterProcessor) ProcessTurnSheetResponse(
 				l.Info("creature >%s< retaliates for %d damage (character health now %d)",
 					creatureDef.Name, creatureDamage, characterInstanceRec.Health)
 
-				attackDesc := creatureDef.AttackDescription
-				if attackDesc == "" {
-					attackDesc = "attacks you"
+				attackKey := "event.combat.creature_attack"
+				if creatureDef.AttackDescription == "" {
+					attackKey = "event.combat.creature_attack_default"
 				}
-				_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-					Category: turnsheet.TurnEventCategoryCombat,
-					Icon:     turnsheet.TurnEventIconCombat,
-					Message:  fmt.Sprintf("The %s %s for %d damage.", creatureDef.Name, attackDesc, creatureDamage),
-				})
+				_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+					turnsheet.TurnEventCategoryCombat, turnsheet.TurnEventIconCombat, attackKey,
+					"creature", creatureDef.Name, "attack", creatureDef.AttackDescription, "damage", creatureDamage,
+				))
 			}
 
 			// Save updated creature.
@@ -219,21 +214,10 @@ func (p *AdventureGameCreatureEncounterProcessor) ProcessTurnSheetResponse(
 		}
 	}
 
-	// Step 6: Handle character death.
-	if characterInstanceRec.Health <= 0 {
-		l.Info("character >%s< has been killed — resetting to starting location", characterInstanceRec.ID)
-		characterInstanceRec.Health = 0
-
-		startingLocationName := "your starting location"
-		if err := p.resetDeadCharacter(l, gameInstanceRec, characterInstanceRec, &startingLocationName); err != nil {
-			l.Warn("failed to reset dead character >%v<", err)
-		}
-
-		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-			Category: turnsheet.TurnEventCategoryCombat,
-			Icon:     turnsheet.TurnEventIconDeath,
-			Message:  fmt.Sprintf("You were overwhelmed. You awaken at %s, battered but breathing.", startingLocationName),
-		})
+	// Step 6: Handle character death — lose a life and respawn, or retire
+	// the character when no lives remain.
+	if _, err := ResolveCharacterDeath(l, p.Domain, gameInstanceRec, characterInstanceRec, turnsheet.TurnEventCategoryCombat); err != nil {
+		l.Warn("failed to resolve character death >%v<", err)
 	}
 
 	// Step 7: Persist updated character health and events.
@@ -310,6 +294,10 @@ func (p *AdventureGameCreatureEncounterProcessor) CreateNextTurnSheet(
 		return nil, fmt.Errorf("failed to get game: %w", err)
 	}
 
+	// Step 2a: Translate game content into the account's locale. Creature names are
+	// left untranslated because players write them as action targets.
+	translator := GetTurnSheetTranslator(l, p.Domain, gameRec.ID, accountUserRec.AccountID)
+
 	// Step 3: Resolve equipped weapon and armor.
 	_, _, _, err = ResolveEquipmentStats(l, p.Domain, characterInstanceRec.ID)
 	if err != nil {
@@ -361,7 +349,7 @@ func (p *AdventureGameCreatureEncounterProcessor) CreateNextTurnSheet(
 		creatures = append(creatures, turnsheet.EncounterCreature{
 			CreatureInstanceID: ci.ID,
 			Name:               creatureDef.Name,
-			Description:        creatureDef.Description,
+			Description:        translator.Text(creatureDef.ID, game_record.GameTranslationFieldNameDescription, creatureDef.Description),
 			Health:             health,
 			MaxHealth:          maxHealth,
 			AttackDamage:       creatureDef.AttackDamage,
@@ -405,18 +393,19 @@ func (p *AdventureGameCreatureEncounterProcessor) CreateNextTurnSheet(
 
 	// Step 8: Build sheet data.
 	maxActions := 3
-	sheetTitle := "Creature Encounter"
+	sheetTitle := i18n.T(translator.Locale(), "turnsheet.encounter.title")
 	if isReadOnly {
 		maxActions = 0
-		sheetTitle = "Encounter Record"
+		sheetTitle = i18n.T(translator.Locale(), "turnsheet.encounter.record_title")
 	}
 
 	sheetData := turnsheet.MonsterEncounterData{
 		TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
-			GameName:        convert.Ptr(gameRec.Name),
+			GameName:        convert.Ptr(translator.Text(gameRec.ID, game_record.GameTranslationFieldNameName, gameRec.Name)),
 			GameType:        convert.Ptr("adventure"),
 			TurnNumber:      convert.Ptr(gameInstanceRec.CurrentTurn),
 			AccountName:     convert.Ptr(accountUserRec.Email),
+			Locale:          convert.Ptr(translator.Locale()),
 			TurnSheetTitle:  convert.Ptr(sheetTitle),
 			TurnSheetCode:   convert.Ptr(turnSheetCode),
 			BackgroundImage: backgroundImage,
@@ -703,54 +692,16 @@ func (p *AdventureGameCreatureEncounterProcessor) detectMultiPlayerCreatureChang
 
 		if c.IsDead || c.Health <= 0 {
 			// Creature was alive last sheet but is now dead.
-			_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-				Category: turnsheet.TurnEventCategoryWorld,
-				Icon:     turnsheet.TurnEventIconWorld,
-				Message:  fmt.Sprintf("Another adventurer has slain the %s.", c.Name),
-			})
+			_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+				turnsheet.TurnEventCategoryWorld, turnsheet.TurnEventIconWorld, "event.world.creature_slain_by_other",
+				"creature", c.Name,
+			))
 		} else if c.Health < prev {
 			// Creature took damage not from this player.
-			_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-				Category: turnsheet.TurnEventCategoryWorld,
-				Icon:     turnsheet.TurnEventIconWorld,
-				Message:  fmt.Sprintf("The %s looks wounded — another adventurer has been here.", c.Name),
-			})
-		}
-	}
-}
-
-// resetDeadCharacter moves a dead character to the starting location and restores their health.
-// If startingLocationName is non-nil, it is set to the name of the starting location for narrative purposes.
-func (p *AdventureGameCreatureEncounterProcessor) resetDeadCharacter(l logger.Logger, gameInstanceRec *game_record.GameInstance, characterInstanceRec *adventure_game_record.AdventureGameCharacterInstance, startingLocationName *string) error {
-	// Find the starting location instance for this game instance.
-	locationInstances, err := p.Domain.GetManyAdventureGameLocationInstanceRecs(&coresql.Options{
-		Params: []coresql.Param{
-			{Col: adventure_game_record.FieldAdventureGameLocationInstanceGameInstanceID, Val: gameInstanceRec.ID},
-		},
-	})
-	if err != nil {
-		return fmt.Errorf("failed to get location instances: %w", err)
-	}
-
-	for _, li := range locationInstances {
-		locationRec, err := p.Domain.GetAdventureGameLocationRec(li.AdventureGameLocationID, nil)
-		if err != nil {
-			continue
-		}
-		if locationRec.IsStartingLocation {
-			characterInstanceRec.AdventureGameLocationInstanceID = li.ID
-			characterInstanceRec.Health = CharacterRespawnHealth
-			if startingLocationName != nil {
-				*startingLocationName = locationRec.Name
-			}
-			l.Info("resetting dead character to starting location >%s< with health >%d<",
-				li.ID, CharacterRespawnHealth)
-			return nil
+			_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+				turnsheet.TurnEventCategoryWorld, turnsheet.TurnEventIconWorld, "event.world.creature_wounded_by_other",
+				"creature", c.Name,
+			))
 		}
 	}
-
-	// No starting location found — just restore health without moving.
-	characterInstanceRec.Health = CharacterRespawnHealth
-	l.Warn("no starting location found for game instance >%s< — restored health only", gameInstanceRec.ID)
-	return nil
 }
@@ -11,6 +11,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/record"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
@@ -80,11 +81,9 @@ func (p *AdventureGameInventoryManagementProcessor) ProcessTurnSheetResponse(ctx
 			l.Warn("failed to unequip item >%s< >%v<", itemInstanceID, err)
 			return fmt.Errorf("failed to unequip item %s: %w", itemInstanceID, err)
 		}
-		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-			Category: turnsheet.TurnEventCategoryInventory,
-			Icon:     turnsheet.TurnEventIconInventory,
-			Message:  fmt.Sprintf("You moved %s to your backpack.", name),
-		})
+		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+			turnsheet.TurnEventCategoryInventory, turnsheet.TurnEventIconInventory, "event.inventory.unequipped", "item", name,
+		))
 
 		descriptions, charMutated, effectErr := applyItemEffectsForAction(
 			l, p.Domain, gameInstanceRec, characterInstanceRec, itemInstance,
@@ -115,11 +114,9 @@ func (p *AdventureGameInventoryManagementProcessor) ProcessTurnSheetResponse(ctx
 			l.Warn("failed to drop item >%s< >%v<", itemInstanceID, err)
 			return fmt.Errorf("failed to drop item %s: %w", itemInstanceID, err)
 		}
-		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-			Category: turnsheet.TurnEventCategoryInventory,
-			Icon:     turnsheet.TurnEventIconInventory,
-			Message:  fmt.Sprintf("You dropped %s.", name),
-		})
+		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+			turnsheet.TurnEventCategoryInventory, turnsheet.TurnEventIconInventory, "event.inventory.dropped", "item", name,
+		))
 
 		descriptions, charMutated, effectErr := applyItemEffectsForAction(
 			l, p.Domain, gameInstanceRec, characterInstanceRec, itemInstance,
@@ -150,11 +147,9 @@ func (p *AdventureGameInventoryManagementProcessor) ProcessTurnSheetResponse(ctx
 			l.Warn("failed to pick up item >%s< >%v<", itemInstanceID, err)
 			return fmt.Errorf("failed to pick up item %s: %w", itemInstanceID, err)
 		}
-		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-			Category: turnsheet.TurnEventCategoryInventory,
-			Icon:     turnsheet.TurnEventIconInventory,
-			Message:  fmt.Sprintf("You picked up %s.", name),
-		})
+		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+			turnsheet.TurnEventCategoryInventory, turnsheet.TurnEventIconInventory, "event.inventory.picked_up", "item", name,
+		))
 
 		descriptions, charMutated, effectErr := applyItemEffectsForAction(
 			l, p.Domain, gameInstanceRec, characterInstanceRec, itemInstance,
@@ -208,11 +203,9 @@ func (p *AdventureGameInventoryManagementProcessor) ProcessTurnSheetResponse(ctx
 			l.Warn("failed to equip item >%s< >%v<", action.ItemInstanceID, err)
 			return fmt.Errorf("failed to equip item %s: %w", action.ItemInstanceID, err)
 		}
-		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-			Category: turnsheet.TurnEventCategoryInventory,
-			Icon:     turnsheet.TurnEventIconInventory,
-			Message:  fmt.Sprintf("You equipped %s.", itemDef.Name),
-		})
+		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+			turnsheet.TurnEventCategoryInventory, turnsheet.TurnEventIconInventory, "event.inventory.equipped", "item", itemDef.Name,
+		))
 
 		descriptions, charMutated, effectErr := applyItemEffectsForAction(
 			l, p.Domain, gameInstanceRec, characterInstanceRec, equippedItemInstance,
@@ -260,11 +253,9 @@ func (p *AdventureGameInventoryManagementProcessor) ProcessTurnSheetResponse(ctx
 
 		_ = charMutated
 		buildItemEffectTurnEvents(characterInstanceRec, descriptions, nil)
-		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-			Category: turnsheet.TurnEventCategoryInventory,
-			Icon:     turnsheet.TurnEventIconInventory,
-			Message:  fmt.Sprintf("You used %s.", itemName),
-		})
+		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+			turnsheet.TurnEventCategoryInventory, turnsheet.TurnEventIconInventory, "event.inventory.used", "item", itemName,
+		))
 
 		// Decrement uses and mark as used when exhausted.
 		itemInstance.UsesRemaining--
@@ -347,6 +338,9 @@ func (p *AdventureGameInventoryManagementProcessor) CreateNextTurnSheet(ctx cont
 		return nil, fmt.Errorf("failed to get game: %w", err)
 	}
 
+	// Step 5a: Translate game content into the account's locale
+	translator := GetTurnSheetTranslator(l, p.Domain, gameRec.ID, accountUserRec.AccountID)
+
 	// Step 6: Get character's inventory
 	inventoryItems, err := p.Domain.GetAdventureGameItemInstanceRecsByCharacterInstance(characterInstanceRec.ID)
 	if err != nil {
@@ -405,8 +399,8 @@ func (p *AdventureGameInventoryManagementProcessor) CreateNextTurnSheet(ctx cont
 
 		inventoryItem := turnsheet.InventoryItem{
 			ItemInstanceID:  itemInstance.ID,
-			ItemName:        itemDef.Name,
-			ItemDescription: itemDef.Description,
+			ItemName:        translator.Text(itemDef.ID, game_record.GameTranslationFieldNameName, itemDef.Name),
+			ItemDescription: translator.Text(itemDef.ID, game_record.GameTranslationFieldNameDescription, itemDef.Description),
 			IsEquipped:      itemInstance.IsEquipped,
 			EquipmentSlot:   displaySlot,
 			CanEquip:        itemDef.CanBeEquipped,
@@ -470,8 +464,8 @@ func (p *AdventureGameInventoryManagementProcessor) CreateNextTurnSheet(ctx cont
 
 		locationItem := turnsheet.LocationItem{
 			ItemInstanceID:  itemInstance.ID,
-			ItemName:        itemDef.Name,
-			ItemDescription: itemDef.Description,
+			ItemName:        translator.Text(itemDef.ID, game_record.GameTranslationFieldNameName, itemDef.Name),
+			ItemDescription: translator.Text(itemDef.ID, game_record.GameTranslationFieldNameDescription, itemDef.Description),
 			CanEquip:        itemDef.CanBeEquipped,
 		}
 		locationItemList = append(locationItemList, locationItem)
@@ -505,19 +499,20 @@ func (p *AdventureGameInventoryManagementProcessor) CreateNextTurnSheet(ctx cont
 	// Step 12: Create sheet data
 	sheetData := turnsheet.InventoryManagementData{
 		TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
-			GameName:              convert.Ptr(gameRec.Name),
+			GameName:              convert.Ptr(translator.Text(gameRec.ID, game_record.GameTranslationFieldNameName, gameRec.Name)),
 			GameType:              convert.Ptr("adventure"),
 			TurnNumber:            convert.Ptr(gameInstanceRec.CurrentTurn),
 			AccountName:           convert.Ptr(accountUserRec.Email),
-			TurnSheetTitle:        convert.Ptr("Inventory Management"),
-			TurnSheetDescription:  convert.Ptr(fmt.Sprintf("Manage your inventory and equipment. Carrying %d/%d items.", len(inventoryItemList), characterInstanceRec.InventoryCapacity)),
-			TurnSheetInstructions: convert.Ptr(turnsheet.DefaultInventoryManagementInstructions()),
+			Locale:                convert.Ptr(translator.Locale()),
+			TurnSheetTitle:        convert.Ptr(i18n.T(translator.Locale(), "turnsheet.inventory.title")),
+			TurnSheetDescription:  convert.Ptr(i18n.T(translator.Locale(), "turnsheet.inventory.description", "count", len(inventoryItemList), "capacity", characterInstanceRec.InventoryCapacity)),
+			TurnSheetInstructions: convert.Ptr(turnsheet.InventoryManagementInstructions(translator.Locale())),
 			TurnSheetCode:         convert.Ptr(turnSheetCode),
 			BackgroundImage:       backgroundImage,
 			TurnEvents:            displayEvents,
 		},
 		CharacterName:          characterRec.Name,
-		CurrentLocationName:    locationRec.Name,
+		CurrentLocationName:    translator.Text(locationRec.ID, game_record.GameTranslationFieldNameName, locationRec.Name),
 		InventoryCapacity:      characterInstanceRec.InventoryCapacity,
 		InventoryCount:         len(inventoryItemList),
 		CurrentInventory:       inventoryItemList,
@@ -162,18 +162,14 @@ func (p *AdventureGameLocationChoiceProcessor) ProcessTurnSheetResponse(ctx cont
 			}
 		}
 
-		linkName := chosenLocationOption.LocationLinkName
-		var movementMsg string
+		movementKey := "event.movement.moved"
 		if traversalDescription != "" {
-			movementMsg = fmt.Sprintf("You took %s to %s. %s", linkName, destLocationName, traversalDescription)
-		} else {
-			movementMsg = fmt.Sprintf("You took %s to %s.", linkName, destLocationName)
+			movementKey = "event.movement.moved_traversal"
 		}
-		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-			Category: turnsheet.TurnEventCategoryMovement,
-			Icon:     turnsheet.TurnEventIconMovement,
-			Message:  movementMsg,
-		})
+		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+			turnsheet.TurnEventCategoryMovement, turnsheet.TurnEventIconMovement, movementKey,
+			"link", chosenLocationOption.LocationLinkName, "location", destLocationName, "traversal", traversalDescription,
+		))
 
 		// Persist events.
 		if _, saveErr := p.Domain.UpdateAdventureGameCharacterInstanceRec(characterInstanceRec); saveErr != nil {
@@ -238,15 +234,14 @@ func (p *AdventureGameLocationChoiceProcessor) applyFleePenalty(
 		totalFleeDamage += damage
 
 		// Generate flee narrative event per creature.
-		attackDesc := creatureDef.AttackDescription
-		if attackDesc == "" {
-			attackDesc = "attacks you"
+		fleeKey := "event.flee.creature_attack"
+		if creatureDef.AttackDescription == "" {
+			fleeKey = "event.flee.creature_attack_default"
 		}
-		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.TurnEvent{
-			Category: turnsheet.TurnEventCategoryFlee,
-			Icon:     turnsheet.TurnEventIconFlee,
-			Message:  fmt.Sprintf("As you fled, the %s %s for %d damage.", creatureDef.Name, attackDesc, damage),
-		})
+		_ = turnsheet.AppendTurnEvent(characterInstanceRec, turnsheet.NewTurnEvent(
+			turnsheet.TurnEventCategoryFlee, turnsheet.TurnEventIconFlee, fleeKey,
+			"creature", creatureDef.Name, "attack", creatureDef.AttackDescription, "damage", damage,
+		))
 
 		l.Info("aggressive creature >%s< attacks fleeing character for %d damage", creatureDef.Name, damage)
 	}
@@ -318,6 +313,9 @@ func (p *AdventureGameLocationChoiceProcessor) CreateNextTurnSheet(ctx context.C
 		return nil, fmt.Errorf("failed to get account: %w", err)
 	}
 
+	// Step 6a: Translate game content into the account's locale
+	translator := GetTurnSheetTranslator(l, p.Domain, gameRec.ID, accountUserRec.AccountID)
+
 	// Step 7: Build location options from links, evaluating requirements for each link
 	locationOptions := make([]turnsheet.LocationOption, 0, len(locationLinkRecs))
 	for _, locationLinkRec := range locationLinkRecs {
@@ -350,11 +348,11 @@ func (p *AdventureGameLocationChoiceProcessor) CreateNextTurnSheet(ctx context.C
 
 		option := turnsheet.LocationOption{
 			LocationID:       toLocationInstanceRec.ID,
-			LocationLinkName: locationLinkRec.Name,
+			LocationLinkName: translator.Text(locationLinkRec.ID, game_record.GameTranslationFieldNameName, locationLinkRec.Name),
 		}
 
 		if isTraversable {
-			option.LocationLinkDescription = locationLinkRec.Description
+			option.LocationLinkDescription = translator.Text(locationLinkRec.ID, game_record.GameTranslationFieldNameDescription, locationLinkRec.Description)
 			l.Info("added accessible location option: >%s< via >%s<", toLocationRec.Name, locationLinkRec.Name)
 		} else {
 			option.IsLocked = true
@@ -389,7 +387,7 @@ func (p *AdventureGameLocationChoiceProcessor) CreateNextTurnSheet(ctx context.C
 	}
 
 	// Step 8b: Load creatures present at this location
-	creatures, err := GetAliveCreaturesAtLocation(l, p.Domain, gameInstanceRec.ID, locationInstanceRec.ID)
+	creatures, err := GetAliveCreaturesAtLocation(l, p.Domain, translator, gameInstanceRec.ID, locationInstanceRec.ID)
 	if err != nil {
 		l.Warn("failed to get creatures at location >%v<", err)
 		creatures = nil
@@ -410,32 +408,36 @@ func (p *AdventureGameLocationChoiceProcessor) CreateNextTurnSheet(ctx context.C
 		locationObjects = nil
 	}
 
-	// Step 9: Read movement, flee, and world events for this sheet. Events are cleared after all processors run.
+	// Step 9: Read movement, flee, world and system events for this sheet. Events are cleared after all processors run.
 	displayEvents, err := ReadTurnEventsForCategories(l, p.Domain, characterInstanceRec,
 		turnsheet.TurnEventCategoryMovement,
 		turnsheet.TurnEventCategoryFlee,
 		turnsheet.TurnEventCategoryWorld,
+		turnsheet.TurnEventCategorySystem,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read location events: %w", err)
 	}
 
 	// Step 10: Create sheet data with REAL game data
+	locationName := translator.Text(locationRec.ID, game_record.GameTranslationFieldNameName, locationRec.Name)
+	locationDescription := translator.Text(locationRec.ID, game_record.GameTranslationFieldNameDescription, locationRec.Description)
 	sheetData := turnsheet.LocationChoiceData{
 		TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
-			GameName:              convert.Ptr(gameRec.Name),
+			GameName:              convert.Ptr(translator.Text(gameRec.ID, game_record.GameTranslationFieldNameName, gameRec.Name)),
 			GameType:              convert.Ptr("adventure"),
 			TurnNumber:            convert.Ptr(gameInstanceRec.CurrentTurn),
 			AccountName:           convert.Ptr(accountUserRec.Email),
-			TurnSheetTitle:        convert.Ptr(locationRec.Name),
-			TurnSheetDescription:  convert.Ptr(locationRec.Description),
-			TurnSheetInstructions: convert.Ptr(turnsheet.DefaultLocationChoiceInstructions()),
+			Locale:                convert.Ptr(translator.Locale()),
+			TurnSheetTitle:        convert.Ptr(locationName),
+			TurnSheetDescription:  convert.Ptr(locationDescription),
+			TurnSheetInstructions: convert.Ptr(turnsheet.LocationChoiceInstructions(translator.Locale())),
 			TurnSheetCode:         convert.Ptr(turnSheetCode),
 			BackgroundImage:       backgroundImage,
 			TurnEvents:            displayEvents,
 		},
-		LocationName:           locationRec.Name,
-		LocationDescription:    locationRec.Description,
+		LocationName:           locationName,
+		LocationDescription:    locationDescription,
 		Creatures:              creatures,
 		HasAggressiveCreatures: hasAggressiveCreatures,
 		LocationOptions:        locationOptions,
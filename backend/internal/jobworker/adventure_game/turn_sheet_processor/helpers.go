@@ -8,6 +8,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
 	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
 )
 
@@ -102,9 +103,28 @@ func HasAggressiveCreaturesAtLocation(l logger.Logger, d *domain.Domain, gameIns
 	return false, nil
 }
 
+// GetTurnSheetTranslator returns a translator for a game's content in the locale
+// of the account a turn sheet is created for. When the locale or translations
+// cannot be loaded the turn sheet is created in the game's original text.
+func GetTurnSheetTranslator(l logger.Logger, d *domain.Domain, gameID, accountID string) *domain.GameTranslator {
+	locale, err := d.GetAccountLocale(accountID)
+	if err != nil {
+		l.Warn("failed to get account >%s< locale >%v<", accountID, err)
+		return nil
+	}
+
+	translator, err := d.GetGameTranslator(gameID, locale)
+	if err != nil {
+		l.Warn("failed to get game >%s< translator >%v<", gameID, err)
+		return nil
+	}
+
+	return translator
+}
+
 // GetAliveCreaturesAtLocation returns turnsheet creature entries for all alive
 // creature instances at the given location.
-func GetAliveCreaturesAtLocation(l logger.Logger, d *domain.Domain, gameInstanceID, locationInstanceID string) ([]turnsheet.LocationCreature, error) {
+func GetAliveCreaturesAtLocation(l logger.Logger, d *domain.Domain, translator *domain.GameTranslator, gameInstanceID, locationInstanceID string) ([]turnsheet.LocationCreature, error) {
 	creatureInstances, err := d.GetManyAdventureGameCreatureInstanceRecs(&coresql.Options{
 		Params: []coresql.Param{
 			{Col: adventure_game_record.FieldAdventureGameCreatureInstanceGameInstanceID, Val: gameInstanceID},
@@ -127,8 +147,8 @@ func GetAliveCreaturesAtLocation(l logger.Logger, d *domain.Domain, gameInstance
 		}
 
 		creatures = append(creatures, turnsheet.LocationCreature{
-			Name:        creatureRec.Name,
-			Description: creatureRec.Description,
+			Name:        translator.Text(creatureRec.ID, game_record.GameTranslationFieldNameName, creatureRec.Name),
+			Description: translator.Text(creatureRec.ID, game_record.GameTranslationFieldNameDescription, creatureRec.Description),
 			Disposition: creatureRec.Disposition,
 		})
 	}
@@ -0,0 +1,20 @@
+package jobworker
+
+import (
+	"html/template"
+	"path/filepath"
+
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+)
+
+// ParseEmailTemplate parses the base email template and the named email templates
+// with the message catalogue and formatting functions of a locale. Emails to
+// recipients without an account are rendered in the default locale.
+func ParseEmailTemplate(templatesPath, locale string, names ...string) (*template.Template, error) {
+	paths := []string{filepath.Join(templatesPath, "email", "base.email.html")}
+	for _, name := range names {
+		paths = append(paths, filepath.Join(templatesPath, "email", name))
+	}
+
+	return template.New("base.email.html").Funcs(i18n.NewLocalizer(locale).FuncMap()).ParseFiles(paths...)
+}
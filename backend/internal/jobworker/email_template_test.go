@@ -2,13 +2,12 @@ package jobworker_test
 
 import (
 	"bytes"
-	"html/template"
-	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"gitlab.com/alienspaces/playbymail/internal/jobworker"
 	"gitlab.com/alienspaces/playbymail/internal/utils/testutil"
 )
 
@@ -24,8 +23,7 @@ func renderBaseWithInlineContent(t *testing.T, data any) string {
 
 	cfg, _, _, _, _ := testutil.NewDefaultDependencies(t)
 
-	baseTmplPath := filepath.Join(cfg.TemplatesPath, "email", "base.email.html")
-	tmpl, err := template.ParseFiles(baseTmplPath)
+	tmpl, err := jobworker.ParseEmailTemplate(cfg.TemplatesPath, "en")
 	require.NoError(t, err, "base template parses without error")
 
 	tmpl, err = tmpl.Parse(minimalContentTmpl)
@@ -83,10 +81,7 @@ func TestBaseEmailTemplate_ConditionalAccountLink(t *testing.T) {
 	t.Run("turn sheet notification template carries AccountURL to base footer", func(t *testing.T) {
 		cfg, _, _, _, _ := testutil.NewDefaultDependencies(t)
 
-		baseTmplPath := filepath.Join(cfg.TemplatesPath, "email", "base.email.html")
-		specificTmplPath := filepath.Join(cfg.TemplatesPath, "email", "turn_sheet_notification.email.html")
-
-		tmpl, err := template.ParseFiles(baseTmplPath, specificTmplPath)
+		tmpl, err := jobworker.ParseEmailTemplate(cfg.TemplatesPath, "en", "turn_sheet_notification.email.html")
 		require.NoError(t, err)
 
 		data := struct {
@@ -97,6 +92,7 @@ func TestBaseEmailTemplate_ConditionalAccountLink(t *testing.T) {
 			ExpirationTime string
 			SupportEmail   string
 			AccountURL     string
+			ReplyByEmail   bool
 			Year           int
 		}{
 			GameName:       "Test Game",
@@ -106,6 +102,7 @@ func TestBaseEmailTemplate_ConditionalAccountLink(t *testing.T) {
 			ExpirationTime: "23:59",
 			SupportEmail:   "support@example.com",
 			AccountURL:     "http://example.com/account",
+			ReplyByEmail:   true,
 			Year:           2026,
 		}
 
@@ -116,5 +113,84 @@ func TestBaseEmailTemplate_ConditionalAccountLink(t *testing.T) {
 		require.Contains(t, html, "Manage your account",
 			"turn sheet notification should include account link")
 		require.Contains(t, html, "http://example.com/account")
+		require.Contains(t, html, "Reply by email",
+			"turn sheet notification should explain email replies when inbound mail is enabled")
+	})
+
+	t.Run("turn reminder template includes the deadline and turn sheet link", func(t *testing.T) {
+		cfg, _, _, _, _ := testutil.NewDefaultDependencies(t)
+
+		tmpl, err := jobworker.ParseEmailTemplate(cfg.TemplatesPath, "en", "turn_reminder.email.html")
+		require.NoError(t, err)
+
+		data := struct {
+			GameName       string
+			TurnNumber     int
+			TurnSheetCount int
+			TurnSheetURL   string
+			DeadlineDate   string
+			DeadlineTime   string
+			SupportEmail   string
+			AccountURL     string
+			ReplyByEmail   bool
+			Year           int
+		}{
+			GameName:       "Test Game",
+			TurnNumber:     2,
+			TurnSheetCount: 2,
+			TurnSheetURL:   "http://example.com/turn-sheets",
+			DeadlineDate:   "March 2, 2026",
+			DeadlineTime:   "9:30 AM AEDT",
+			SupportEmail:   "support@example.com",
+			AccountURL:     "http://example.com/account",
+			ReplyByEmail:   false,
+			Year:           2026,
+		}
+
+		var buf bytes.Buffer
+		require.NoError(t, tmpl.ExecuteTemplate(&buf, "base", data))
+
+		html := buf.String()
+		require.Contains(t, html, "Turn 2 for Test Game is due soon")
+		require.Contains(t, html, "2 of your turn")
+		require.Contains(t, html, "March 2, 2026")
+		require.Contains(t, html, "9:30 AM AEDT")
+		require.Contains(t, html, "http://example.com/turn-sheets")
+		require.Contains(t, html, "Manage your account")
+		require.NotContains(t, html, "Reply by email",
+			"turn reminder should not explain email replies when inbound mail is disabled")
+	})
+
+	t.Run("inbound mail receipt template lists accepted sheets and problems", func(t *testing.T) {
+		cfg, _, _, _, _ := testutil.NewDefaultDependencies(t)
+
+		tmpl, err := jobworker.ParseEmailTemplate(cfg.TemplatesPath, "en", "inbound_mail_receipt.email.html")
+		require.NoError(t, err)
+
+		data := struct {
+			Accepted     []string
+			Outstanding  []string
+			Problems     []string
+			SupportEmail string
+			AccountURL   string
+			Year         int
+		}{
+			Accepted:     []string{"Test Game turn 2: Location Choice"},
+			Outstanding:  []string{"Test Game turn 2: Inventory Management"},
+			Problems:     []string{"Line 3 \"DANCE\" was not understood and has been ignored."},
+			SupportEmail: "support@example.com",
+			AccountURL:   "http://example.com/account",
+			Year:         2026,
+		}
+
+		var buf bytes.Buffer
+		require.NoError(t, tmpl.ExecuteTemplate(&buf, "base", data))
+
+		html := buf.String()
+		require.Contains(t, html, "Your turn has been received")
+		require.Contains(t, html, "Test Game turn 2: Location Choice")
+		require.Contains(t, html, "Test Game turn 2: Inventory Management")
+		require.Contains(t, html, "was not understood")
+		require.Contains(t, html, "Manage your account")
 	})
 }
@@ -0,0 +1,316 @@
+package jobworker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+
+	"gitlab.com/alienspaces/playbymail/core/convert"
+	corejobworker "gitlab.com/alienspaces/playbymail/core/jobworker"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/core/type/storer"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/jobqueue"
+	"gitlab.com/alienspaces/playbymail/internal/printing"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// GamePrintBatchWorkerArgs defines the arguments for rendering and submitting a print batch
+type GamePrintBatchWorkerArgs struct {
+	GamePrintBatchID string `json:"game_print_batch_id"`
+}
+
+func (GamePrintBatchWorkerArgs) Kind() string { return "game_print_batch" }
+
+func (GamePrintBatchWorkerArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue: jobqueue.QueueGame,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs: true,
+		},
+	}
+}
+
+// GamePrintBatchWorker renders every turn sheet in a print batch, collated per
+// player behind a cover sheet, and submits the result to the configured print
+// device as a single printer job.
+type GamePrintBatchWorker struct {
+	river.WorkerDefaults[GamePrintBatchWorkerArgs]
+	JobWorker
+}
+
+func NewGamePrintBatchWorker(l logger.Logger, cfg config.Config, s storer.Storer) (*GamePrintBatchWorker, error) {
+	jw, err := NewJobWorker(l, cfg, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GamePrintBatchWorker{
+		JobWorker: *jw,
+	}, nil
+}
+
+func (w *GamePrintBatchWorker) Work(ctx context.Context, j *river.Job[GamePrintBatchWorkerArgs]) error {
+	l := w.Log.WithFunctionContext("GamePrintBatchWorker/Work")
+
+	l.Info("running job ID >%s< Args >%#v<", strconv.FormatInt(j.ID, 10), j.Args)
+
+	c, m, err := w.beginJob(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		m.Tx.Rollback(context.Background())
+	}()
+
+	_, err = w.DoWork(ctx, m, c, j)
+	if err != nil {
+		l.Error("GamePrintBatchWorker job ID >%s< Args >%#v< failed >%v<", strconv.FormatInt(j.ID, 10), j.Args, err)
+		return err
+	}
+
+	return corejobworker.CompleteJob(ctx, m.Tx, j)
+}
+
+type GamePrintBatchDoWorkResult struct {
+	GamePrintBatchID string
+	Status           string
+	PlayerCount      int
+	DocumentCount    int
+}
+
+// DoWork renders and submits the print batch. Rendering and submission failures
+// are recorded on the batch as failed rather than retried so a manager can
+// inspect the error and request a reprint.
+func (w *GamePrintBatchWorker) DoWork(ctx context.Context, m *domain.Domain, c *river.Client[pgx.Tx], j *river.Job[GamePrintBatchWorkerArgs]) (*GamePrintBatchDoWorkResult, error) {
+	l := w.Log.WithFunctionContext("GamePrintBatchWorker/DoWork")
+
+	batchRec, err := m.GetGamePrintBatchRec(j.Args.GamePrintBatchID, nil)
+	if err != nil {
+		l.Warn("failed to get print batch >%s< >%v<", j.Args.GamePrintBatchID, err)
+		return nil, err
+	}
+
+	if batchRec.Status != game_record.GamePrintBatchStatusPending {
+		l.Info("print batch >%s< has status >%s<, skipping", batchRec.ID, batchRec.Status)
+		return newGamePrintBatchDoWorkResult(batchRec), nil
+	}
+
+	recipients, err := m.GetGamePrintBatchRecipients(batchRec)
+	if err != nil {
+		l.Warn("failed to get print batch >%s< recipients >%v<", batchRec.ID, err)
+		return nil, err
+	}
+
+	if len(recipients) == 0 {
+		l.Info("print batch >%s< has no recipients, marking completed", batchRec.ID)
+		batchRec, err = m.MarkGamePrintBatchAsCompleted(batchRec)
+		if err != nil {
+			return nil, err
+		}
+		return newGamePrintBatchDoWorkResult(batchRec), nil
+	}
+
+	documents, err := w.renderGamePrintBatchDocuments(ctx, l, m, batchRec, recipients)
+	if err != nil {
+		l.Warn("failed to render print batch >%s< >%v<", batchRec.ID, err)
+		return w.markGamePrintBatchFailed(m, batchRec, fmt.Sprintf("failed to render print batch: %v", err))
+	}
+
+	batchRec.PlayerCount = len(recipients)
+	batchRec.DocumentCount = len(documents)
+
+	if w.Config.PrintDeviceHost == "" {
+		l.Warn("print device host is not configured, print batch >%s< not submitted", batchRec.ID)
+		return w.markGamePrintBatchFailed(m, batchRec, "print device is not configured")
+	}
+
+	device, err := printing.NewDevice(newGamePrintBatchDevice(w.Config))
+	if err != nil {
+		l.Warn("failed to create print device >%v<", err)
+		return w.markGamePrintBatchFailed(m, batchRec, fmt.Sprintf("failed to create print device: %v", err))
+	}
+
+	options := printing.PrintOptions{
+		Copies:    1,
+		PaperSize: w.Config.PrintPaperSize,
+	}
+
+	tracker, ok := device.(printing.JobTracker)
+	if !ok {
+		// Devices that cannot report job state receive each document as its own
+		// job and the batch is considered complete once every document is sent.
+		if err := device.Connect(ctx, newGamePrintBatchDevice(w.Config)); err != nil {
+			l.Warn("failed to connect to print device >%v<", err)
+			return w.markGamePrintBatchFailed(m, batchRec, fmt.Sprintf("failed to connect to print device: %v", err))
+		}
+		defer device.Disconnect()
+
+		for idx, document := range documents {
+			if err := device.Print(ctx, document, options); err != nil {
+				l.Warn("failed to print document >%d< of print batch >%s< >%v<", idx, batchRec.ID, err)
+				return w.markGamePrintBatchFailed(m, batchRec, fmt.Sprintf("failed to print document %d of %d: %v", idx+1, len(documents), err))
+			}
+		}
+
+		batchRec, err = m.MarkGamePrintBatchAsCompleted(batchRec)
+		if err != nil {
+			return nil, err
+		}
+		return newGamePrintBatchDoWorkResult(batchRec), nil
+	}
+
+	jobName := fmt.Sprintf("game-instance-%s-turn-%d", batchRec.GameInstanceID, batchRec.TurnNumber)
+
+	printJob, err := tracker.SubmitJob(ctx, jobName, documents, options)
+	if err != nil {
+		l.Warn("failed to submit print batch >%s< >%v<", batchRec.ID, err)
+		return w.markGamePrintBatchFailed(m, batchRec, fmt.Sprintf("failed to submit print job: %v", err))
+	}
+
+	l.Info("submitted print batch >%s< as printer job >%s< state >%s<", batchRec.ID, printJob.ID, printJob.Status)
+
+	batchRec, err = m.MarkGamePrintBatchAsSubmitted(batchRec, printJob.ID, printJob.Status, printJob.StateMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	// Some devices finish small jobs before the submission response is returned
+	batchRec, err = applyGamePrintBatchJobState(m, batchRec, printJob)
+	if err != nil {
+		return nil, err
+	}
+
+	return newGamePrintBatchDoWorkResult(batchRec), nil
+}
+
+// renderGamePrintBatchDocuments renders a cover sheet followed by each turn sheet,
+// in sheet order, for every recipient. The turn sheets of every recipient are
+// rendered in parallel.
+func (w *GamePrintBatchWorker) renderGamePrintBatchDocuments(ctx context.Context, l logger.Logger, m *domain.Domain, batchRec *game_record.GamePrintBatch, recipients []*domain.GamePrintBatchRecipient) ([][]byte, error) {
+	gameRec, err := m.GetGameRec(batchRec.GameID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var turnSheetRecs []*game_record.GameTurnSheet
+	for _, recipient := range recipients {
+		turnSheetRecs = append(turnSheetRecs, recipient.TurnSheets...)
+	}
+
+	sheets, err := turnsheet.RenderTurnSheetPDFs(ctx, l, w.Config, turnSheetRecs)
+	if err != nil {
+		return nil, err
+	}
+
+	var documents [][]byte
+	for _, recipient := range recipients {
+		coverData, err := w.newGamePrintBatchCoverSheetData(m, gameRec, batchRec, recipient)
+		if err != nil {
+			return nil, err
+		}
+
+		cover, err := turnsheet.GeneratePrintCoverSheet(ctx, l, w.Config, turnsheet.DocumentFormatPDF, coverData)
+		if err != nil {
+			return nil, fmt.Errorf("cover sheet for subscription instance %s: %w", recipient.SubscriptionInstance.ID, err)
+		}
+		documents = append(documents, cover)
+
+		documents = append(documents, sheets[:len(recipient.TurnSheets)]...)
+		sheets = sheets[len(recipient.TurnSheets):]
+	}
+
+	return documents, nil
+}
+
+func (w *GamePrintBatchWorker) newGamePrintBatchCoverSheetData(m *domain.Domain, gameRec *game_record.Game, batchRec *game_record.GamePrintBatch, recipient *domain.GamePrintBatchRecipient) (*turnsheet.PrintCoverSheetData, error) {
+	accountUserRec, err := m.GetAccountUserRec(recipient.SubscriptionInstance.AccountUserID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &turnsheet.PrintCoverSheetData{
+		TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
+			GameName:       convert.Ptr(gameRec.Name),
+			GameType:       convert.Ptr(gameRec.GameType),
+			TurnSheetTitle: convert.Ptr("Turn Sheets"),
+			TurnNumber:     convert.Ptr(batchRec.TurnNumber),
+			AccountName:    convert.Ptr(accountUserRec.Email),
+		},
+		DeliveryMethod: recipient.DeliveryMethod,
+		SheetCount:     len(recipient.TurnSheets),
+	}
+
+	if recipient.Contact != nil {
+		data.RecipientName = nullstring.ToString(recipient.Contact.Name)
+		data.PostalAddressLine1 = nullstring.ToString(recipient.Contact.PostalAddressLine1)
+		data.PostalAddressLine2 = nullstring.ToString(recipient.Contact.PostalAddressLine2)
+		data.StateProvince = nullstring.ToString(recipient.Contact.StateProvince)
+		data.PostalCode = nullstring.ToString(recipient.Contact.PostalCode)
+		data.Country = nullstring.ToString(recipient.Contact.Country)
+	}
+
+	return data, nil
+}
+
+func (w *GamePrintBatchWorker) markGamePrintBatchFailed(m *domain.Domain, batchRec *game_record.GamePrintBatch, errorMessage string) (*GamePrintBatchDoWorkResult, error) {
+	batchRec, err := m.MarkGamePrintBatchAsFailed(batchRec, errorMessage)
+	if err != nil {
+		return nil, err
+	}
+	return newGamePrintBatchDoWorkResult(batchRec), nil
+}
+
+// applyGamePrintBatchJobState records the latest printer job state on a submitted
+// batch, completing or failing the batch when the job has reached a final state.
+func applyGamePrintBatchJobState(m *domain.Domain, batchRec *game_record.GamePrintBatch, printJob *printing.PrintJob) (*game_record.GamePrintBatch, error) {
+	switch printJob.Status {
+	case printing.JobStateCompleted:
+		batchRec.PrinterJobState = nullstring.FromString(printJob.Status)
+		batchRec.PrinterJobStateMessage = nullstring.FromString(printJob.StateMessage)
+		return m.MarkGamePrintBatchAsCompleted(batchRec)
+	case printing.JobStateAborted, printing.JobStateCanceled:
+		batchRec.PrinterJobState = nullstring.FromString(printJob.Status)
+		batchRec.PrinterJobStateMessage = nullstring.FromString(printJob.StateMessage)
+		errorMessage := fmt.Sprintf("printer job %s", printJob.Status)
+		if printJob.StateMessage != "" {
+			errorMessage = fmt.Sprintf("%s: %s", errorMessage, printJob.StateMessage)
+		}
+		return m.MarkGamePrintBatchAsFailed(batchRec, errorMessage)
+	}
+
+	if nullstring.ToString(batchRec.PrinterJobState) == printJob.Status &&
+		nullstring.ToString(batchRec.PrinterJobStateMessage) == printJob.StateMessage {
+		return batchRec, nil
+	}
+
+	batchRec.PrinterJobState = nullstring.FromString(printJob.Status)
+	batchRec.PrinterJobStateMessage = nullstring.FromString(printJob.StateMessage)
+	return m.UpdateGamePrintBatchRec(batchRec)
+}
+
+func newGamePrintBatchDevice(cfg config.Config) printing.Device {
+	return printing.Device{
+		Host:     cfg.PrintDeviceHost,
+		Port:     cfg.PrintDevicePort,
+		Protocol: cfg.PrintDeviceProtocol,
+		Queue:    cfg.PrintDeviceQueue,
+		Timeout:  30 * time.Second,
+	}
+}
+
+func newGamePrintBatchDoWorkResult(batchRec *game_record.GamePrintBatch) *GamePrintBatchDoWorkResult {
+	return &GamePrintBatchDoWorkResult{
+		GamePrintBatchID: batchRec.ID,
+		Status:           batchRec.Status,
+		PlayerCount:      batchRec.PlayerCount,
+		DocumentCount:    batchRec.DocumentCount,
+	}
+}
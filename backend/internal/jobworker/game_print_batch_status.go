@@ -0,0 +1,143 @@
+package jobworker
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+
+	corejobworker "gitlab.com/alienspaces/playbymail/core/jobworker"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/core/type/storer"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/printing"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// GamePrintBatchStatusWorkerArgs defines the arguments for polling submitted print batch job state
+type GamePrintBatchStatusWorkerArgs struct {
+	// No arguments needed - this is a periodic job
+}
+
+func (GamePrintBatchStatusWorkerArgs) Kind() string { return "game_print_batch_status" }
+
+// GamePrintBatchStatusWorker polls the print device for the state of every
+// submitted print batch job and completes or fails the batch once the printer
+// job reaches a final state.
+type GamePrintBatchStatusWorker struct {
+	river.WorkerDefaults[GamePrintBatchStatusWorkerArgs]
+	JobWorker
+}
+
+func NewGamePrintBatchStatusWorker(l logger.Logger, cfg config.Config, s storer.Storer) (*GamePrintBatchStatusWorker, error) {
+	jw, err := NewJobWorker(l, cfg, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GamePrintBatchStatusWorker{
+		JobWorker: *jw,
+	}, nil
+}
+
+func (w *GamePrintBatchStatusWorker) Work(ctx context.Context, j *river.Job[GamePrintBatchStatusWorkerArgs]) error {
+	l := w.Log.WithFunctionContext("GamePrintBatchStatusWorker/Work")
+
+	l.Info("running job ID >%s<", strconv.FormatInt(j.ID, 10))
+
+	c, m, err := w.beginJob(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		m.Tx.Rollback(context.Background())
+	}()
+
+	_, err = w.DoWork(ctx, m, c, j)
+	if err != nil {
+		l.Error("GamePrintBatchStatusWorker job ID >%s< failed >%v<", strconv.FormatInt(j.ID, 10), err)
+		return err
+	}
+
+	return corejobworker.CompleteJob(ctx, m.Tx, j)
+}
+
+type GamePrintBatchStatusDoWorkResult struct {
+	BatchesChecked   int
+	BatchesCompleted int
+	BatchesFailed    int
+	ProcessedAt      time.Time
+}
+
+func (w *GamePrintBatchStatusWorker) DoWork(ctx context.Context, m *domain.Domain, c *river.Client[pgx.Tx], j *river.Job[GamePrintBatchStatusWorkerArgs]) (*GamePrintBatchStatusDoWorkResult, error) {
+	l := w.Log.WithFunctionContext("GamePrintBatchStatusWorker/DoWork")
+
+	result := &GamePrintBatchStatusDoWorkResult{
+		ProcessedAt: time.Now(),
+	}
+
+	if w.Config.PrintDeviceHost == "" {
+		l.Debug("print device host is not configured, skipping print batch status check")
+		return result, nil
+	}
+
+	batchRecs, err := m.GetSubmittedGamePrintBatchRecs()
+	if err != nil {
+		l.Warn("failed to get submitted print batches >%v<", err)
+		return nil, err
+	}
+
+	if len(batchRecs) == 0 {
+		return result, nil
+	}
+
+	device, err := printing.NewDevice(newGamePrintBatchDevice(w.Config))
+	if err != nil {
+		l.Warn("failed to create print device >%v<", err)
+		return nil, err
+	}
+
+	tracker, ok := device.(printing.JobTracker)
+	if !ok {
+		l.Warn("print device protocol >%s< does not support job tracking", w.Config.PrintDeviceProtocol)
+		return result, nil
+	}
+
+	for _, batchRec := range batchRecs {
+		result.BatchesChecked++
+
+		printerJobID := nullstring.ToString(batchRec.PrinterJobID)
+		if printerJobID == "" {
+			l.Warn("print batch >%s< is submitted without a printer job ID", batchRec.ID)
+			continue
+		}
+
+		printJob, err := tracker.GetJob(ctx, printerJobID)
+		if err != nil {
+			// The device may be temporarily unreachable, try again next poll
+			l.Warn("failed to get printer job >%s< for print batch >%s< >%v<", printerJobID, batchRec.ID, err)
+			continue
+		}
+
+		batchRec, err = applyGamePrintBatchJobState(m, batchRec, printJob)
+		if err != nil {
+			l.Warn("failed to update print batch >%s< >%v<", batchRec.ID, err)
+			return nil, err
+		}
+
+		switch batchRec.Status {
+		case game_record.GamePrintBatchStatusCompleted:
+			result.BatchesCompleted++
+		case game_record.GamePrintBatchStatusFailed:
+			result.BatchesFailed++
+		}
+	}
+
+	l.Info("checked >%d< print batches, completed >%d< failed >%d<", result.BatchesChecked, result.BatchesCompleted, result.BatchesFailed)
+
+	return result, nil
+}
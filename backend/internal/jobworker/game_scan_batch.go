@@ -0,0 +1,371 @@
+package jobworker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	corejobworker "gitlab.com/alienspaces/playbymail/core/jobworker"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/core/type/storer"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/jobqueue"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/scanbatch"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+	"gitlab.com/alienspaces/playbymail/internal/utils/turnsheetutil"
+)
+
+// GameScanBatchWorkerArgs defines the arguments for processing a scan batch
+type GameScanBatchWorkerArgs struct {
+	GameScanBatchID string `json:"game_scan_batch_id"`
+}
+
+func (GameScanBatchWorkerArgs) Kind() string { return "game_scan_batch" }
+
+func (GameScanBatchWorkerArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{Queue: jobqueue.QueueGame}
+}
+
+// GameScanBatchWorker splits an uploaded scan batch document into pages and routes
+// each page to its turn sheet. The first job splits the document, then each job
+// processes a single page and queues the next so progress is committed page by
+// page and is visible to the manager while the batch is processed.
+type GameScanBatchWorker struct {
+	river.WorkerDefaults[GameScanBatchWorkerArgs]
+	scanner turnsheet.TurnSheetScanner
+	JobWorker
+}
+
+func NewGameScanBatchWorker(l logger.Logger, cfg config.Config, s storer.Storer) (*GameScanBatchWorker, error) {
+	jw, err := NewJobWorker(l, cfg, s)
+	if err != nil {
+		return nil, err
+	}
+
+	scanner, err := turnsheet.NewScanner(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GameScanBatchWorker{
+		JobWorker: *jw,
+		scanner:   scanner,
+	}, nil
+}
+
+func (w *GameScanBatchWorker) Work(ctx context.Context, j *river.Job[GameScanBatchWorkerArgs]) error {
+	l := w.Log.WithFunctionContext("GameScanBatchWorker/Work")
+
+	l.Info("running job ID >%s< Args >%#v<", strconv.FormatInt(j.ID, 10), j.Args)
+
+	c, m, err := w.beginJob(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		m.Tx.Rollback(context.Background())
+	}()
+
+	_, err = w.DoWork(ctx, m, c, j)
+	if err != nil {
+		l.Error("GameScanBatchWorker job ID >%s< Args >%#v< failed >%v<", strconv.FormatInt(j.ID, 10), j.Args, err)
+		return err
+	}
+
+	return corejobworker.CompleteJob(ctx, m.Tx, j)
+}
+
+type GameScanBatchDoWorkResult struct {
+	GameScanBatchID string
+	Status          string
+	PageCount       int
+	PagesProcessed  int
+}
+
+func newGameScanBatchDoWorkResult(rec *game_record.GameScanBatch) *GameScanBatchDoWorkResult {
+	return &GameScanBatchDoWorkResult{
+		GameScanBatchID: rec.ID,
+		Status:          rec.Status,
+		PageCount:       rec.PageCount,
+		PagesProcessed:  rec.PagesProcessed,
+	}
+}
+
+// DoWork splits a pending batch into pages, or processes the next page of a batch
+// being processed. A document that cannot be split is recorded on the batch as
+// failed rather than retried so the manager can see why.
+func (w *GameScanBatchWorker) DoWork(ctx context.Context, m *domain.Domain, c *river.Client[pgx.Tx], j *river.Job[GameScanBatchWorkerArgs]) (*GameScanBatchDoWorkResult, error) {
+	l := w.Log.WithFunctionContext("GameScanBatchWorker/DoWork")
+
+	batchRec, err := m.GetGameScanBatchRec(j.Args.GameScanBatchID, nil)
+	if err != nil {
+		l.Warn("failed to get scan batch >%s< >%v<", j.Args.GameScanBatchID, err)
+		return nil, err
+	}
+
+	switch batchRec.Status {
+	case game_record.GameScanBatchStatusPending:
+		batchRec, err = w.splitGameScanBatch(l, m, batchRec)
+	case game_record.GameScanBatchStatusProcessing:
+		batchRec, err = w.processNextGameScanBatchPage(ctx, l, m, c, batchRec)
+	default:
+		l.Info("scan batch >%s< has status >%s<, skipping", batchRec.ID, batchRec.Status)
+		return newGameScanBatchDoWorkResult(batchRec), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if batchRec.Status == game_record.GameScanBatchStatusProcessing {
+		if _, err := c.InsertTx(ctx, m.Tx, GameScanBatchWorkerArgs{GameScanBatchID: batchRec.ID}, nil); err != nil {
+			l.Warn("failed to queue next page of scan batch >%s< >%v<", batchRec.ID, err)
+			return nil, err
+		}
+	}
+
+	return newGameScanBatchDoWorkResult(batchRec), nil
+}
+
+// splitGameScanBatch splits the batch document into page records
+func (w *GameScanBatchWorker) splitGameScanBatch(l logger.Logger, m *domain.Domain, batchRec *game_record.GameScanBatch) (*game_record.GameScanBatch, error) {
+	pages, err := scanbatch.SplitPages(batchRec.DocumentData)
+	if err != nil {
+		l.Warn("failed to split scan batch >%s< >%v<", batchRec.ID, err)
+		return m.MarkGameScanBatchAsFailed(batchRec, fmt.Sprintf("failed to split document into pages: %v", err))
+	}
+
+	for _, page := range pages {
+		pageRec := &game_record.GameScanBatchPage{
+			GameScanBatchID: batchRec.ID,
+			PageNumber:      page.Number,
+			Source:          page.Source,
+			ImageData:       page.Image,
+		}
+
+		// Pages that could not be extracted are recorded as unreadable
+		// once the batch starts processing pages
+		if page.Err != nil {
+			pageRec.Message = nullstring.FromString(page.Err.Error())
+		}
+
+		if _, err := m.CreateGameScanBatchPageRec(pageRec); err != nil {
+			l.Warn("failed to create scan batch >%s< page >%d< >%v<", batchRec.ID, page.Number, err)
+			return nil, err
+		}
+	}
+
+	l.Info("split scan batch >%s< into >%d< pages", batchRec.ID, len(pages))
+
+	return m.MarkGameScanBatchAsProcessing(batchRec, len(pages))
+}
+
+// processNextGameScanBatchPage routes the next pending page to its turn sheet and
+// completes the batch once every page has been processed
+func (w *GameScanBatchWorker) processNextGameScanBatchPage(ctx context.Context, l logger.Logger, m *domain.Domain, c *river.Client[pgx.Tx], batchRec *game_record.GameScanBatch) (*game_record.GameScanBatch, error) {
+	pageRec, err := m.GetNextPendingGameScanBatchPageRec(batchRec.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageRec != nil {
+		status, message, err := w.processGameScanBatchPage(ctx, l, m, batchRec, pageRec)
+		if err != nil {
+			return nil, err
+		}
+
+		l.Info("scan batch >%s< page >%d< status >%s< message >%s<", batchRec.ID, pageRec.PageNumber, status, message)
+
+		batchRec, err = m.RecordGameScanBatchPageOutcome(batchRec, pageRec, status, message)
+		if err != nil {
+			return nil, err
+		}
+
+		if batchRec.PagesProcessed < batchRec.PageCount {
+			return batchRec, nil
+		}
+	}
+
+	batchRec, err = m.MarkGameScanBatchAsCompleted(batchRec)
+	if err != nil {
+		return nil, err
+	}
+
+	l.Info("completed scan batch >%s< matched >%d< of >%d< pages", batchRec.ID, batchRec.MatchedCount, batchRec.PageCount)
+
+	if batchRec.MatchedCount > 0 {
+		if err := w.queueGameScanBatchTurnProcessing(ctx, l, m, c, batchRec); err != nil {
+			return nil, err
+		}
+	}
+
+	return batchRec, nil
+}
+
+// processGameScanBatchPage reads the turn sheet code from a page, checks the turn
+// sheet can accept the page, then scans the page and submits the turn sheet. The
+// outcome is returned as a page status and a message for the manager. An error is
+// only returned when the page could not be processed and should be retried.
+func (w *GameScanBatchWorker) processGameScanBatchPage(ctx context.Context, l logger.Logger, m *domain.Domain, batchRec *game_record.GameScanBatch, pageRec *game_record.GameScanBatchPage) (string, string, error) {
+	if len(pageRec.ImageData) == 0 {
+		message := "the page image could not be extracted"
+		if nullstring.IsValid(pageRec.Message) {
+			message = pageRec.Message.String
+		}
+		return game_record.GameScanBatchPageStatusUnreadable, message, nil
+	}
+
+	turnSheetCode, err := w.scanner.GetTurnSheetCodeFromImage(ctx, l, pageRec.ImageData)
+	if err != nil {
+		l.Warn("failed to extract turn sheet code from page >%d< >%v<", pageRec.PageNumber, err)
+		return game_record.GameScanBatchPageStatusUnreadable, "the turn sheet code could not be found", nil
+	}
+
+	codeType, err := turnsheetutil.ParseTurnSheetCodeTypeFromCode(turnSheetCode)
+	if err != nil {
+		l.Warn("failed to parse turn sheet code type from page >%d< >%v<", pageRec.PageNumber, err)
+		return game_record.GameScanBatchPageStatusUnreadable, "the turn sheet code could not be read", nil
+	}
+
+	if codeType == turnsheetutil.TurnSheetCodeTypeJoiningGame {
+		return game_record.GameScanBatchPageStatusUnreadable, "join game turn sheets must be uploaded individually", nil
+	}
+
+	codeData, err := turnsheetutil.ParsePlayGameTurnSheetCodeData(turnSheetCode)
+	if err != nil {
+		l.Warn("failed to parse play game turn sheet code from page >%d< >%v<", pageRec.PageNumber, err)
+		return game_record.GameScanBatchPageStatusUnreadable, "the turn sheet code could not be read", nil
+	}
+
+	turnSheetRec, err := m.GetGameTurnSheetRec(codeData.GameTurnSheetID, nil)
+	if err != nil {
+		if coreerror.IsNotFoundError(err) {
+			return game_record.GameScanBatchPageStatusWrongGame, "the turn sheet code does not match a turn sheet", nil
+		}
+		return "", "", err
+	}
+
+	pageRec.GameTurnSheetID = nullstring.FromString(turnSheetRec.ID)
+	pageRec.SheetType = nullstring.FromString(turnSheetRec.SheetType)
+
+	gameInstanceRec, err := m.GetGameInstanceRec(batchRec.GameInstanceID, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	if status, message := gameScanBatchTurnSheetStatus(gameInstanceRec, turnSheetRec); status != "" {
+		return status, message, nil
+	}
+
+	scanData, err := w.scanner.GetTurnSheetScanData(ctx, l, turnSheetRec.SheetType, turnSheetRec.SheetData, pageRec.ImageData)
+	if err != nil {
+		l.Warn("failed to scan turn sheet >%s< from page >%d< >%v<", turnSheetRec.ID, pageRec.PageNumber, err)
+		return game_record.GameScanBatchPageStatusUnreadable, "the orders on the turn sheet could not be read", nil
+	}
+
+	review, err := turnsheet.ReviewScanData(l, m.Config(), turnSheetRec.SheetType, turnSheetRec.SheetData, scanData, turnSheetCode)
+	if err != nil {
+		l.Warn("failed to review scan of turn sheet >%s< >%v<", turnSheetRec.ID, err)
+		return "", "", err
+	}
+
+	// Turn sheets with issues are held for a manager to review rather than completed
+	now := time.Now()
+	turnSheetRec.ScannedData = json.RawMessage(scanData)
+	turnSheetRec.ScannedAt = sql.NullTime{Time: now, Valid: true}
+	turnSheetRec.ProcessingStatus = review.ProcessingStatus()
+	if !review.NeedsReview() {
+		turnSheetRec.IsCompleted = true
+		turnSheetRec.CompletedAt = sql.NullTime{Time: now, Valid: true}
+	}
+
+	if _, err := m.UpdateGameTurnSheetRec(turnSheetRec); err != nil {
+		l.Warn("failed to update turn sheet >%s< >%v<", turnSheetRec.ID, err)
+		return "", "", err
+	}
+
+	issues, err := review.IssuesJSON()
+	if err != nil {
+		return "", "", err
+	}
+
+	if _, err := m.UpsertGameTurnSheetScanRec(turnSheetRec.ID, pageRec.ImageData, review.Confidence, issues); err != nil {
+		l.Warn("failed to record scan of turn sheet >%s< >%v<", turnSheetRec.ID, err)
+		return "", "", err
+	}
+
+	if review.NeedsReview() {
+		return game_record.GameScanBatchPageStatusMatched, "the turn sheet is waiting on review: " + review.Issues[0].Message, nil
+	}
+
+	return game_record.GameScanBatchPageStatusMatched, "", nil
+}
+
+// gameScanBatchTurnSheetStatus returns the page status and message when a turn sheet
+// cannot accept a scanned page, or an empty status when the page can be scanned
+func gameScanBatchTurnSheetStatus(gameInstanceRec *game_record.GameInstance, turnSheetRec *game_record.GameTurnSheet) (string, string) {
+	if turnSheetRec.GameInstanceID.String != gameInstanceRec.ID {
+		return game_record.GameScanBatchPageStatusWrongGame, "the turn sheet belongs to a different game"
+	}
+
+	if gameInstanceRec.Status != game_record.GameInstanceStatusStarted {
+		return game_record.GameScanBatchPageStatusWrongTurn, "the game is not waiting on turn sheets"
+	}
+
+	if turnSheetRec.TurnNumber != gameInstanceRec.CurrentTurn {
+		return game_record.GameScanBatchPageStatusWrongTurn,
+			fmt.Sprintf("the turn sheet is for turn %d, the game is waiting on turn %d", turnSheetRec.TurnNumber, gameInstanceRec.CurrentTurn)
+	}
+
+	if turnSheetRec.IsCompleted || len(turnSheetRec.ScannedData) > 0 {
+		return game_record.GameScanBatchPageStatusDuplicate, "the turn sheet has already been submitted"
+	}
+
+	return "", ""
+}
+
+// queueGameScanBatchTurnProcessing queues early turn processing when the batch has
+// completed the turn and the game instance is configured to process when all
+// turn sheets are submitted
+func (w *GameScanBatchWorker) queueGameScanBatchTurnProcessing(ctx context.Context, l logger.Logger, m *domain.Domain, c *river.Client[pgx.Tx], batchRec *game_record.GameScanBatch) error {
+	gameInstanceRec, err := m.GetGameInstanceRec(batchRec.GameInstanceID, nil)
+	if err != nil {
+		return err
+	}
+
+	if !gameInstanceRec.ProcessWhenAllSubmitted || gameInstanceRec.Status != game_record.GameInstanceStatusStarted {
+		return nil
+	}
+
+	allSubmitted, err := m.IsGameInstanceTurnSubmitted(gameInstanceRec)
+	if err != nil {
+		l.Warn("failed to check all turn sheets submitted >%v<", err)
+		return nil
+	}
+
+	if !allSubmitted {
+		return nil
+	}
+
+	l.Info("all players submitted for game instance >%s< turn >%d<, enqueueing early turn processing", gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
+
+	_, err = c.InsertTx(ctx, m.Tx, GameTurnProcessingWorkerArgs{
+		GameInstanceID: gameInstanceRec.ID,
+		TurnNumber:     gameInstanceRec.CurrentTurn,
+	}, nil)
+	if err != nil {
+		l.Warn("failed to enqueue early turn processing >%v<", err)
+		return err
+	}
+
+	return nil
+}
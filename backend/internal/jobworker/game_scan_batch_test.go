@@ -0,0 +1,91 @@
+package jobworker
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+func TestGameScanBatchTurnSheetStatus(t *testing.T) {
+	gameInstanceRec := &game_record.GameInstance{
+		Status:      game_record.GameInstanceStatusStarted,
+		CurrentTurn: 3,
+	}
+	gameInstanceRec.ID = "instance-1"
+
+	tests := []struct {
+		name         string
+		instance     func() *game_record.GameInstance
+		turnSheet    func(rec *game_record.GameTurnSheet)
+		expectStatus string
+	}{
+		{
+			name:         "open turn sheet for the current turn can be scanned",
+			expectStatus: "",
+		},
+		{
+			name: "turn sheet from another game instance",
+			turnSheet: func(rec *game_record.GameTurnSheet) {
+				rec.GameInstanceID = nullstring.FromString("instance-2")
+			},
+			expectStatus: game_record.GameScanBatchPageStatusWrongGame,
+		},
+		{
+			name: "turn sheet from an earlier turn",
+			turnSheet: func(rec *game_record.GameTurnSheet) {
+				rec.TurnNumber = 2
+			},
+			expectStatus: game_record.GameScanBatchPageStatusWrongTurn,
+		},
+		{
+			name: "game instance is not started",
+			instance: func() *game_record.GameInstance {
+				rec := *gameInstanceRec
+				rec.Status = game_record.GameInstanceStatusCompleted
+				return &rec
+			},
+			expectStatus: game_record.GameScanBatchPageStatusWrongTurn,
+		},
+		{
+			name: "turn sheet already completed",
+			turnSheet: func(rec *game_record.GameTurnSheet) {
+				rec.IsCompleted = true
+			},
+			expectStatus: game_record.GameScanBatchPageStatusDuplicate,
+		},
+		{
+			name: "turn sheet already scanned",
+			turnSheet: func(rec *game_record.GameTurnSheet) {
+				rec.ScannedData = json.RawMessage(`{"choice":"north"}`)
+			},
+			expectStatus: game_record.GameScanBatchPageStatusDuplicate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instanceRec := gameInstanceRec
+			if tt.instance != nil {
+				instanceRec = tt.instance()
+			}
+
+			turnSheetRec := &game_record.GameTurnSheet{
+				GameInstanceID: nullstring.FromString("instance-1"),
+				TurnNumber:     3,
+			}
+			if tt.turnSheet != nil {
+				tt.turnSheet(turnSheetRec)
+			}
+
+			status, message := gameScanBatchTurnSheetStatus(instanceRec, turnSheetRec)
+			require.Equal(t, tt.expectStatus, status)
+			if tt.expectStatus != "" {
+				require.NotEmpty(t, message)
+			}
+		})
+	}
+}
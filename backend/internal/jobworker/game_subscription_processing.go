@@ -14,9 +14,11 @@ import (
 	"gitlab.com/alienspaces/playbymail/internal/jobqueue"
 	"gitlab.com/alienspaces/playbymail/internal/jobworker/adventure_game"
 	"gitlab.com/alienspaces/playbymail/internal/jobworker/mecha_game"
+	"gitlab.com/alienspaces/playbymail/internal/jobworker/mecha_tactics_game"
 	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
 )
 
@@ -161,6 +163,8 @@ func joinGameTurnSheetTypeByGameType(gameType string) string {
 	switch gameType {
 	case game_record.GameTypeMecha:
 		return mecha_game_record.MechaGameTurnSheetTypeJoinGame
+	case game_record.GameTypeMechaTactics:
+		return mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeJoinGame
 	default:
 		return adventure_game_record.AdventureGameTurnSheetTypeJoinGame
 	}
@@ -201,5 +205,11 @@ func (w *GameSubscriptionProcessingWorker) initializeProcessors(l logger.Logger,
 	}
 	processors[game_record.GameTypeMecha] = mechaGameProcessor
 
+	mechaTacticsGameProcessor, err := mecha_tactics_game.NewMechaTacticsGameJoinGameProcessor(l, d)
+	if err != nil {
+		return nil, err
+	}
+	processors[game_record.GameTypeMechaTactics] = mechaTacticsGameProcessor
+
 	return processors, nil
 }
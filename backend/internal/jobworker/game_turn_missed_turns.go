@@ -0,0 +1,248 @@
+package jobworker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+// Missed turn actions taken for a player that has not submitted any turn sheets
+const (
+	missedTurnActionIdle           = "idle"
+	missedTurnActionStandingOrders = "standing_orders"
+	missedTurnActionTakeover       = "takeover"
+	missedTurnActionDrop           = "drop"
+)
+
+// processMissedTurns applies the game instance missed turn policy before turn sheets are
+// processed. Players that submitted turn sheets have their missed turn count reset, while
+// players that submitted nothing have their turn idled, their standing orders carried out,
+// the computer take over or are dropped from the game instance.
+func (w *GameTurnProcessingWorker) processMissedTurns(ctx context.Context, m *domain.Domain, processor GameTurnProcessor, gameInstanceRec *game_record.GameInstance) error {
+	l := w.Log.WithFunctionContext("GameTurnProcessingWorker/processMissedTurns")
+
+	subscriptionInstanceRecs, err := m.GetGameSubscriptionInstanceRecsByInstance(gameInstanceRec.ID)
+	if err != nil {
+		l.Warn("failed to get game subscription instances for game instance >%s< >%v<", gameInstanceRec.ID, err)
+		return err
+	}
+
+	turnSheetRecs, err := m.GetGameTurnSheetRecsByGameInstance(gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
+	if err != nil {
+		l.Warn("failed to get turn sheets for game instance >%s< turn >%d< >%v<", gameInstanceRec.ID, gameInstanceRec.CurrentTurn, err)
+		return err
+	}
+
+	turnSheetRecsByAccountUserID := map[string][]*game_record.GameTurnSheet{}
+	for _, turnSheetRec := range turnSheetRecs {
+		turnSheetRecsByAccountUserID[turnSheetRec.AccountUserID] = append(turnSheetRecsByAccountUserID[turnSheetRec.AccountUserID], turnSheetRec)
+	}
+
+	for _, subscriptionInstanceRec := range subscriptionInstanceRecs {
+		if subscriptionInstanceRec.IsDropped() {
+			continue
+		}
+
+		playerTurnSheetRecs := turnSheetRecsByAccountUserID[subscriptionInstanceRec.AccountUserID]
+		if len(playerTurnSheetRecs) == 0 {
+			continue
+		}
+
+		err := w.processPlayerMissedTurn(ctx, m, processor, gameInstanceRec, subscriptionInstanceRec, playerTurnSheetRecs)
+		if err != nil {
+			l.Warn("failed to process missed turn for game subscription instance >%s< >%v<", subscriptionInstanceRec.ID, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// processPlayerMissedTurn applies the missed turn policy to a single player's turn sheets
+func (w *GameTurnProcessingWorker) processPlayerMissedTurn(ctx context.Context, m *domain.Domain, processor GameTurnProcessor, gameInstanceRec *game_record.GameInstance, subscriptionInstanceRec *game_record.GameSubscriptionInstance, turnSheetRecs []*game_record.GameTurnSheet) error {
+	l := w.Log.WithFunctionContext("GameTurnProcessingWorker/processPlayerMissedTurn")
+
+	var pendingTurnSheetRecs []*game_record.GameTurnSheet
+	for _, turnSheetRec := range turnSheetRecs {
+		if !turnSheetRec.IsCompleted && len(turnSheetRec.ScannedData) == 0 {
+			pendingTurnSheetRecs = append(pendingTurnSheetRecs, turnSheetRec)
+		}
+	}
+
+	// The player submitted at least one turn sheet so this turn was not missed. Standing
+	// orders still cover any turn sheets the player left out.
+	if len(pendingTurnSheetRecs) < len(turnSheetRecs) {
+		if len(pendingTurnSheetRecs) > 0 && gameInstanceRec.MissedTurnPolicy == game_record.GameInstanceMissedTurnPolicyStandingOrders {
+			if _, err := w.applyStandingOrders(ctx, m, processor, gameInstanceRec, subscriptionInstanceRec, pendingTurnSheetRecs); err != nil {
+				return err
+			}
+		}
+		if subscriptionInstanceRec.MissedTurns > 0 {
+			subscriptionInstanceRec.MissedTurns = 0
+			if _, err := m.UpdateGameSubscriptionInstanceRec(subscriptionInstanceRec); err != nil {
+				l.Warn("failed to reset missed turns for game subscription instance >%s< >%v<", subscriptionInstanceRec.ID, err)
+				return err
+			}
+		}
+		return nil
+	}
+
+	subscriptionInstanceRec.MissedTurns++
+
+	action := missedTurnAction(gameInstanceRec, subscriptionInstanceRec.MissedTurns)
+
+	l.Info("game subscription instance >%s< missed turn >%d< (%d in a row) action >%s<",
+		subscriptionInstanceRec.ID, gameInstanceRec.CurrentTurn, subscriptionInstanceRec.MissedTurns, action)
+
+	message := fmt.Sprintf("No turn sheets were received for turn %d.", gameInstanceRec.CurrentTurn)
+
+	switch action {
+	case missedTurnActionDrop:
+		subscriptionInstanceRec.DroppedAt = nulltime.FromTime(time.Now())
+		message += fmt.Sprintf(" You have missed %d turns in a row and have been dropped from the game.", subscriptionInstanceRec.MissedTurns)
+	case missedTurnActionTakeover:
+		if _, err := w.applyComputerTakeover(ctx, m, processor, gameInstanceRec, pendingTurnSheetRecs); err != nil {
+			return err
+		}
+		message += " The computer took command on your behalf."
+	case missedTurnActionStandingOrders:
+		applied, err := w.applyStandingOrders(ctx, m, processor, gameInstanceRec, subscriptionInstanceRec, pendingTurnSheetRecs)
+		if err != nil {
+			return err
+		}
+		if applied > 0 {
+			message += " Your standing orders were carried out."
+		} else {
+			message += " You had no standing orders to carry out and your turn was skipped."
+		}
+	default:
+		message += " Your turn was skipped."
+	}
+
+	message += missedTurnWarning(gameInstanceRec, subscriptionInstanceRec.MissedTurns)
+
+	if _, err := m.UpdateGameSubscriptionInstanceRec(subscriptionInstanceRec); err != nil {
+		l.Warn("failed to update missed turns for game subscription instance >%s< >%v<", subscriptionInstanceRec.ID, err)
+		return err
+	}
+
+	err := processor.AppendMissedTurnEvent(ctx, gameInstanceRec, turnSheetRecs, turnsheet.TurnEvent{
+		Category: turnsheet.TurnEventCategorySystem,
+		Icon:     turnsheet.TurnEventIconSystem,
+		Message:  message,
+	})
+	if err != nil {
+		l.Warn("failed to append missed turn event for game subscription instance >%s< >%v<", subscriptionInstanceRec.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+// applyStandingOrders fills in the player's turn sheets from their standing orders and
+// returns the number of turn sheets filled in
+func (w *GameTurnProcessingWorker) applyStandingOrders(ctx context.Context, m *domain.Domain, processor GameTurnProcessor, gameInstanceRec *game_record.GameInstance, subscriptionInstanceRec *game_record.GameSubscriptionInstance, turnSheetRecs []*game_record.GameTurnSheet) (int, error) {
+	l := w.Log.WithFunctionContext("GameTurnProcessingWorker/applyStandingOrders")
+
+	standingOrders, err := subscriptionInstanceRec.GetStandingOrders()
+	if err != nil {
+		// Invalid standing orders are treated as no standing orders rather than failing the turn
+		l.Warn("failed to read standing orders for game subscription instance >%s< >%v<", subscriptionInstanceRec.ID, err)
+		return 0, nil
+	}
+
+	applied := 0
+	for _, turnSheetRec := range turnSheetRecs {
+		standingOrder, ok := standingOrders[turnSheetRec.SheetType]
+		if !ok {
+			continue
+		}
+
+		scannedData, err := processor.StandingOrderScannedData(ctx, gameInstanceRec, turnSheetRec, standingOrder)
+		if err != nil {
+			l.Warn("failed to carry out standing order >%s< for turn sheet >%s< >%v<", standingOrder, turnSheetRec.ID, err)
+			continue
+		}
+		if len(scannedData) == 0 {
+			continue
+		}
+
+		turnSheetRec.ScannedData = scannedData
+		if _, err := m.UpdateGameTurnSheetRec(turnSheetRec); err != nil {
+			l.Warn("failed to update turn sheet >%s< with standing order >%s< >%v<", turnSheetRec.ID, standingOrder, err)
+			return applied, err
+		}
+		applied++
+	}
+
+	return applied, nil
+}
+
+// applyComputerTakeover fills in the player's turn sheets with computer generated orders
+// and returns the number of turn sheets filled in
+func (w *GameTurnProcessingWorker) applyComputerTakeover(ctx context.Context, m *domain.Domain, processor GameTurnProcessor, gameInstanceRec *game_record.GameInstance, turnSheetRecs []*game_record.GameTurnSheet) (int, error) {
+	l := w.Log.WithFunctionContext("GameTurnProcessingWorker/applyComputerTakeover")
+
+	applied := 0
+	for _, turnSheetRec := range turnSheetRecs {
+		scannedData, err := processor.ComputerTakeoverScannedData(ctx, gameInstanceRec, turnSheetRec)
+		if err != nil {
+			l.Warn("failed to generate computer orders for turn sheet >%s< >%v<", turnSheetRec.ID, err)
+			continue
+		}
+		if len(scannedData) == 0 {
+			continue
+		}
+
+		turnSheetRec.ScannedData = scannedData
+		if _, err := m.UpdateGameTurnSheetRec(turnSheetRec); err != nil {
+			l.Warn("failed to update turn sheet >%s< with computer orders >%v<", turnSheetRec.ID, err)
+			return applied, err
+		}
+		applied++
+	}
+
+	return applied, nil
+}
+
+// missedTurnAction returns the action taken for a player that has missed the given
+// number of consecutive turns. Dropping a player takes precedence over a computer
+// takeover, which takes precedence over standing orders.
+func missedTurnAction(gameInstanceRec *game_record.GameInstance, missedTurns int) string {
+	if gameInstanceRec.MissedTurnDropAfter.Valid && int64(missedTurns) >= gameInstanceRec.MissedTurnDropAfter.Int64 {
+		return missedTurnActionDrop
+	}
+	if gameInstanceRec.MissedTurnTakeoverAfter.Valid && int64(missedTurns) >= gameInstanceRec.MissedTurnTakeoverAfter.Int64 {
+		return missedTurnActionTakeover
+	}
+	if gameInstanceRec.MissedTurnPolicy == game_record.GameInstanceMissedTurnPolicyStandingOrders {
+		return missedTurnActionStandingOrders
+	}
+	return missedTurnActionIdle
+}
+
+// missedTurnWarning returns a warning of the next missed turn threshold the player will
+// reach, or an empty string when there is none
+func missedTurnWarning(gameInstanceRec *game_record.GameInstance, missedTurns int) string {
+	if gameInstanceRec.MissedTurnTakeoverAfter.Valid && int64(missedTurns) < gameInstanceRec.MissedTurnTakeoverAfter.Int64 {
+		remaining := gameInstanceRec.MissedTurnTakeoverAfter.Int64 - int64(missedTurns)
+		return fmt.Sprintf(" The computer will take command if you miss %d more %s.", remaining, pluralTurns(remaining))
+	}
+	if gameInstanceRec.MissedTurnDropAfter.Valid && int64(missedTurns) < gameInstanceRec.MissedTurnDropAfter.Int64 {
+		remaining := gameInstanceRec.MissedTurnDropAfter.Int64 - int64(missedTurns)
+		return fmt.Sprintf(" You will be dropped from the game if you miss %d more %s.", remaining, pluralTurns(remaining))
+	}
+	return ""
+}
+
+func pluralTurns(n int64) string {
+	if n == 1 {
+		return "turn"
+	}
+	return "turns"
+}
@@ -0,0 +1,95 @@
+package jobworker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/nullint64"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+func TestMissedTurnAction(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       string
+		takeover     int64
+		drop         int64
+		missedTurns  int
+		expectAction string
+	}{
+		{
+			name:         "idle policy",
+			policy:       game_record.GameInstanceMissedTurnPolicyIdle,
+			missedTurns:  3,
+			expectAction: missedTurnActionIdle,
+		},
+		{
+			name:         "standing orders policy",
+			policy:       game_record.GameInstanceMissedTurnPolicyStandingOrders,
+			missedTurns:  1,
+			expectAction: missedTurnActionStandingOrders,
+		},
+		{
+			name:         "standing orders before takeover",
+			policy:       game_record.GameInstanceMissedTurnPolicyStandingOrders,
+			takeover:     2,
+			missedTurns:  1,
+			expectAction: missedTurnActionStandingOrders,
+		},
+		{
+			name:         "takeover after missed turns",
+			policy:       game_record.GameInstanceMissedTurnPolicyIdle,
+			takeover:     2,
+			drop:         4,
+			missedTurns:  2,
+			expectAction: missedTurnActionTakeover,
+		},
+		{
+			name:         "drop after missed turns",
+			policy:       game_record.GameInstanceMissedTurnPolicyStandingOrders,
+			takeover:     2,
+			drop:         4,
+			missedTurns:  4,
+			expectAction: missedTurnActionDrop,
+		},
+		{
+			name:         "drop takes precedence over takeover",
+			policy:       game_record.GameInstanceMissedTurnPolicyIdle,
+			takeover:     3,
+			drop:         3,
+			missedTurns:  3,
+			expectAction: missedTurnActionDrop,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameInstanceRec := &game_record.GameInstance{
+				MissedTurnPolicy: tt.policy,
+			}
+			if tt.takeover > 0 {
+				gameInstanceRec.MissedTurnTakeoverAfter = nullint64.FromInt64(tt.takeover)
+			}
+			if tt.drop > 0 {
+				gameInstanceRec.MissedTurnDropAfter = nullint64.FromInt64(tt.drop)
+			}
+
+			require.Equal(t, tt.expectAction, missedTurnAction(gameInstanceRec, tt.missedTurns))
+		})
+	}
+}
+
+func TestMissedTurnWarning(t *testing.T) {
+	gameInstanceRec := &game_record.GameInstance{
+		MissedTurnPolicy:        game_record.GameInstanceMissedTurnPolicyIdle,
+		MissedTurnTakeoverAfter: nullint64.FromInt64(2),
+		MissedTurnDropAfter:     nullint64.FromInt64(4),
+	}
+
+	require.Equal(t, " The computer will take command if you miss 1 more turn.", missedTurnWarning(gameInstanceRec, 1))
+	require.Equal(t, " You will be dropped from the game if you miss 2 more turns.", missedTurnWarning(gameInstanceRec, 2))
+	require.Equal(t, "", missedTurnWarning(gameInstanceRec, 4))
+
+	require.Equal(t, "", missedTurnWarning(&game_record.GameInstance{}, 1))
+}
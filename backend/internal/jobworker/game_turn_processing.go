@@ -14,6 +14,7 @@ package jobworker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
@@ -22,15 +23,22 @@ import (
 	"github.com/riverqueue/river"
 
 	"gitlab.com/alienspaces/playbymail/core/collection/set"
+	"gitlab.com/alienspaces/playbymail/core/convert"
 	corejobworker "gitlab.com/alienspaces/playbymail/core/jobworker"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/record"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/core/type/storer"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/jobqueue"
 	"gitlab.com/alienspaces/playbymail/internal/jobworker/adventure_game"
 	"gitlab.com/alienspaces/playbymail/internal/jobworker/mecha_game"
+	"gitlab.com/alienspaces/playbymail/internal/jobworker/mecha_tactics_game"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+	"gitlab.com/alienspaces/playbymail/internal/utils/turnsheetutil"
 )
 
 // GameTurnProcessingWorker processes a game instance turn
@@ -63,6 +71,17 @@ type GameTurnProcessor interface {
 
 	// CreateTurnSheets generates all turn sheets for a game instance
 	CreateTurnSheets(ctx context.Context, gameInstanceRec *game_record.GameInstance) ([]*game_record.GameTurnSheet, error)
+
+	// StandingOrderScannedData returns the scanned data that carries out a standing order
+	// for a turn sheet the player did not submit, or nil when there is nothing to do
+	StandingOrderScannedData(ctx context.Context, gameInstanceRec *game_record.GameInstance, turnSheetRec *game_record.GameTurnSheet, standingOrder string) (json.RawMessage, error)
+
+	// ComputerTakeoverScannedData returns the scanned data for a turn sheet the computer
+	// completes on behalf of a player, or nil when there is nothing to do
+	ComputerTakeoverScannedData(ctx context.Context, gameInstanceRec *game_record.GameInstance, turnSheetRec *game_record.GameTurnSheet) (json.RawMessage, error)
+
+	// AppendMissedTurnEvent reports the outcome of a missed turn to the player that owns the turn sheets
+	AppendMissedTurnEvent(ctx context.Context, gameInstanceRec *game_record.GameInstance, turnSheetRecs []*game_record.GameTurnSheet, evt turnsheet.TurnEvent) error
 }
 
 // GameTurnProcessingWorker processes a game instance turn
@@ -159,6 +178,13 @@ func (w *GameTurnProcessingWorker) DoWork(ctx context.Context, m *domain.Domain,
 		return nil, fmt.Errorf("unsupported game type: %s for game instance ID >%s<", gameRec.GameType, j.Args.GameInstanceID)
 	}
 
+	// Apply the missed turn policy to players that have not submitted their turn sheets
+	err = w.processMissedTurns(ctx, m, processor, gameInstanceRec)
+	if err != nil {
+		l.Warn("failed to process missed turns for game instance ID >%s< turn >%d<; cannot process game turn >%v<", j.Args.GameInstanceID, j.Args.TurnNumber, err)
+		return nil, err
+	}
+
 	// Process turn sheets using the game-specific processor
 	err = processor.ProcessTurnSheets(ctx, gameInstanceRec)
 	if err != nil {
@@ -175,22 +201,51 @@ func (w *GameTurnProcessingWorker) DoWork(ctx context.Context, m *domain.Domain,
 
 	l.Info("completed turn processing for game instance >%s< turn >%d<", gameInstanceRec.ID, j.Args.TurnNumber)
 
-	// Generate new turn sheets for the next turn
-	l.Info("generating new turn sheets for game instance >%s< turn >%d<", gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
+	var createdTurnSheets []*game_record.GameTurnSheet
+	if gameInstanceRec.Status == game_record.GameInstanceStatusCompleted {
+		// The game has ended; every player receives their final results instead of new turn sheets
+		l.Info("generating game results turn sheets for game instance >%s< turn >%d<", gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
 
-	// Generate turn sheets using the same processor
-	createdTurnSheets, err := processor.CreateTurnSheets(ctx, gameInstanceRec)
-	if err != nil {
-		l.Warn("failed to generate new turn sheets for game instance ID >%s< turn >%d<; cannot process game turn >%v<", j.Args.GameInstanceID, j.Args.TurnNumber, err)
-		return nil, err
+		createdTurnSheets, err = w.createGameResultsTurnSheets(m, gameRec, gameInstanceRec)
+		if err != nil {
+			l.Warn("failed to generate game results turn sheets for game instance ID >%s< turn >%d<; cannot process game turn >%v<", j.Args.GameInstanceID, j.Args.TurnNumber, err)
+			return nil, err
+		}
+
+		if gameInstanceRec.DeliveryEmail {
+			err = w.queueGameResultsEmails(ctx, c, m, gameInstanceRec)
+			if err != nil {
+				l.Warn("failed to queue game results emails for game instance ID >%s< >%v<", j.Args.GameInstanceID, err)
+				// Don't fail the turn processing if email queuing fails
+			}
+		}
+	} else {
+		// Generate new turn sheets for the next turn
+		l.Info("generating new turn sheets for game instance >%s< turn >%d<", gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
+
+		// Generate turn sheets using the same processor
+		createdTurnSheets, err = processor.CreateTurnSheets(ctx, gameInstanceRec)
+		if err != nil {
+			l.Warn("failed to generate new turn sheets for game instance ID >%s< turn >%d<; cannot process game turn >%v<", j.Args.GameInstanceID, j.Args.TurnNumber, err)
+			return nil, err
+		}
+
+		// Queue email notifications if email delivery is enabled
+		if gameInstanceRec.DeliveryEmail && len(createdTurnSheets) > 0 {
+			err = w.queueTurnSheetNotificationEmails(ctx, c, m, gameInstanceRec, createdTurnSheets)
+			if err != nil {
+				l.Warn("failed to queue turn sheet notification emails for game instance ID >%s< turn >%d< >%v<", j.Args.GameInstanceID, j.Args.TurnNumber, err)
+				// Don't fail the turn processing if email queuing fails
+			}
+		}
 	}
 
-	// Queue email notifications if email delivery is enabled
-	if gameInstanceRec.DeliveryEmail && len(createdTurnSheets) > 0 {
-		err = w.queueTurnSheetNotificationEmails(ctx, c, m, gameInstanceRec, createdTurnSheets)
+	// Queue a print batch if physical post or local delivery is enabled
+	if (gameInstanceRec.DeliveryPhysicalPost || gameInstanceRec.DeliveryPhysicalLocal) && len(createdTurnSheets) > 0 {
+		err = w.queueTurnSheetPrintBatch(ctx, c, m, gameInstanceRec)
 		if err != nil {
-			l.Warn("failed to queue turn sheet notification emails for game instance ID >%s< turn >%d< >%v<", j.Args.GameInstanceID, j.Args.TurnNumber, err)
-			// Don't fail the turn processing if email queuing fails
+			l.Warn("failed to queue turn sheet print batch for game instance ID >%s< turn >%d< >%v<", j.Args.GameInstanceID, j.Args.TurnNumber, err)
+			// Don't fail the turn processing if print batch queuing fails
 		}
 	}
 
@@ -219,6 +274,13 @@ func (w *GameTurnProcessingWorker) initializeProcessors(l logger.Logger, d *doma
 	}
 	processors[game_record.GameTypeMecha] = mechaGameProcessor
 
+	// Register mecha tactics processor
+	mechaTacticsGameProcessor, err := mecha_tactics_game.NewMechaTacticsGame(l, d, w.Config)
+	if err != nil {
+		return nil, err
+	}
+	processors[game_record.GameTypeMechaTactics] = mechaTacticsGameProcessor
+
 	return processors, nil
 }
 
@@ -300,3 +362,169 @@ func (w *GameTurnProcessingWorker) queueTurnSheetNotificationEmails(ctx context.
 
 	return nil
 }
+
+// queueTurnSheetPrintBatch records a print batch for the new turn and queues the job that
+// renders and submits it. The job is inserted in the turn processing transaction so it
+// only runs once the batch record and turn sheets are committed.
+func (w *GameTurnProcessingWorker) queueTurnSheetPrintBatch(ctx context.Context, c *river.Client[pgx.Tx], m *domain.Domain, gameInstanceRec *game_record.GameInstance) error {
+	l := w.Log.WithFunctionContext("GameTurnProcessingWorker/queueTurnSheetPrintBatch")
+
+	batchRec, err := m.CreateGamePrintBatchRec(&game_record.GamePrintBatch{
+		GameID:         gameInstanceRec.GameID,
+		GameInstanceID: gameInstanceRec.ID,
+		TurnNumber:     gameInstanceRec.CurrentTurn,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.InsertTx(ctx, m.Tx, GamePrintBatchWorkerArgs{
+		GamePrintBatchID: batchRec.ID,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	l.Info("queued print batch >%s< for game instance >%s< turn >%d<", batchRec.ID, gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
+
+	return nil
+}
+
+// createGameResultsTurnSheets creates the final results turn sheet for every player with a
+// recorded result. The sheets have nothing for the player to submit so they are created
+// completed and processed.
+func (w *GameTurnProcessingWorker) createGameResultsTurnSheets(m *domain.Domain, gameRec *game_record.Game, gameInstanceRec *game_record.GameInstance) ([]*game_record.GameTurnSheet, error) {
+	l := w.Log.WithFunctionContext("GameTurnProcessingWorker/createGameResultsTurnSheets")
+
+	resultRecs, err := m.GetGameInstanceResultRecsByGameInstance(gameInstanceRec.ID)
+	if err != nil {
+		l.Warn("failed to get game instance results >%v<", err)
+		return nil, err
+	}
+
+	links := make(map[string]*game_record.GameSubscriptionInstance, len(resultRecs))
+	standings := make([]turnsheet.GameResultsStanding, 0, len(resultRecs))
+	for _, resultRec := range resultRecs {
+		link, err := m.GetGameSubscriptionInstanceRec(resultRec.GameSubscriptionInstanceID, nil)
+		if err != nil {
+			l.Warn("failed to get game subscription instance >%s< >%v<", resultRec.GameSubscriptionInstanceID, err)
+			return nil, err
+		}
+		links[resultRec.ID] = link
+
+		accountRec, err := m.GetAccountRec(link.AccountID, nil)
+		if err != nil {
+			l.Warn("failed to get account >%s< >%v<", link.AccountID, err)
+			return nil, err
+		}
+
+		standings = append(standings, turnsheet.GameResultsStanding{
+			Placing:    resultRec.Placing,
+			PlayerName: accountRec.Name,
+			IsWinner:   resultRec.IsWinner,
+			Summary:    resultRec.Summary,
+		})
+	}
+
+	var turnSheetRecs []*game_record.GameTurnSheet
+	for i, resultRec := range resultRecs {
+		link := links[resultRec.ID]
+
+		turnSheetCode, err := turnsheetutil.GeneratePlayGameTurnSheetCode(record.NewRecordID())
+		if err != nil {
+			l.Warn("failed to generate turn sheet code >%v<", err)
+			return nil, err
+		}
+
+		playerStandings := make([]turnsheet.GameResultsStanding, len(standings))
+		copy(playerStandings, standings)
+		playerStandings[i].IsPlayer = true
+
+		locale, err := m.GetAccountLocale(link.AccountID)
+		if err != nil {
+			l.Warn("failed to get account >%s< locale >%v<", link.AccountID, err)
+			locale = i18n.DefaultLocale
+		}
+
+		gameName, gameDescription := gameRec.Name, gameRec.Description
+		translator, err := m.GetGameTranslator(gameRec.ID, locale)
+		if err != nil {
+			l.Warn("failed to get game >%s< translator >%v<", gameRec.ID, err)
+		} else {
+			gameName = translator.Text(gameRec.ID, game_record.GameTranslationFieldNameName, gameRec.Name)
+			gameDescription = translator.Text(gameRec.ID, game_record.GameTranslationFieldNameDescription, gameRec.Description)
+		}
+
+		sheetData := turnsheet.GameResultsData{
+			TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
+				GameName:              convert.Ptr(gameName),
+				GameType:              convert.Ptr(gameRec.GameType),
+				TurnNumber:            convert.Ptr(gameInstanceRec.CurrentTurn),
+				Locale:                convert.Ptr(locale),
+				TurnSheetTitle:        convert.Ptr(i18n.T(locale, "turnsheet.results.title")),
+				TurnSheetDescription:  convert.Ptr(gameDescription),
+				TurnSheetInstructions: convert.Ptr(turnsheet.GameResultsInstructions(locale)),
+				TurnSheetCode:         convert.Ptr(turnSheetCode),
+			},
+			EndCondition:            resultRec.EndCondition,
+			EndConditionDescription: turnsheet.GameEndConditionDescription(locale, resultRec.EndCondition),
+			Placing:                 resultRec.Placing,
+			IsWinner:                resultRec.IsWinner,
+			Standings:               playerStandings,
+		}
+
+		sheetDataBytes, err := json.Marshal(sheetData)
+		if err != nil {
+			l.Warn("failed to marshal sheet data >%v<", err)
+			return nil, err
+		}
+
+		turnSheetRec := &game_record.GameTurnSheet{
+			GameID:           gameInstanceRec.GameID,
+			AccountID:        link.AccountID,
+			AccountUserID:    link.AccountUserID,
+			TurnNumber:       gameInstanceRec.CurrentTurn,
+			SheetType:        game_record.GameTurnSheetTypeGameResults,
+			SheetOrder:       1,
+			SheetData:        json.RawMessage(sheetDataBytes),
+			IsCompleted:      true,
+			ProcessingStatus: game_record.TurnSheetProcessingStatusProcessed,
+		}
+		turnSheetRec.GameInstanceID = nullstring.FromString(gameInstanceRec.ID)
+
+		turnSheetRec, err = m.CreateGameTurnSheetRec(turnSheetRec)
+		if err != nil {
+			l.Warn("failed to create game results turn sheet for subscription instance >%s< >%v<", link.ID, err)
+			return nil, err
+		}
+
+		turnSheetRecs = append(turnSheetRecs, turnSheetRec)
+	}
+
+	l.Info("created >%d< game results turn sheets for game instance >%s<", len(turnSheetRecs), gameInstanceRec.ID)
+
+	return turnSheetRecs, nil
+}
+
+// queueGameResultsEmails queues a game results email job for every player with a recorded result
+func (w *GameTurnProcessingWorker) queueGameResultsEmails(ctx context.Context, c *river.Client[pgx.Tx], m *domain.Domain, gameInstanceRec *game_record.GameInstance) error {
+	l := w.Log.WithFunctionContext("GameTurnProcessingWorker/queueGameResultsEmails")
+
+	resultRecs, err := m.GetGameInstanceResultRecsByGameInstance(gameInstanceRec.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, resultRec := range resultRecs {
+		_, err = c.InsertTx(ctx, m.Tx, SendGameResultsEmailWorkerArgs{
+			GameSubscriptionInstanceID: resultRec.GameSubscriptionInstanceID,
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	l.Info("queued >%d< game results emails for game instance >%s<", len(resultRecs), gameInstanceRec.ID)
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package jobworker
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+
+	corejobworker "gitlab.com/alienspaces/playbymail/core/jobworker"
+	nulltime "gitlab.com/alienspaces/playbymail/core/nulltime"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/core/type/storer"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// GameTurnReminderWorkerArgs defines the arguments for queuing turn deadline reminder emails
+type GameTurnReminderWorkerArgs struct {
+	// No arguments needed - this is a periodic job
+}
+
+func (GameTurnReminderWorkerArgs) Kind() string { return "queue_game_turn_reminders" }
+
+// GameTurnReminderWorker queues turn deadline reminder emails for players that have
+// turn sheets still to submit as the turn deadline approaches
+type GameTurnReminderWorker struct {
+	river.WorkerDefaults[GameTurnReminderWorkerArgs]
+	JobWorker
+}
+
+func NewGameTurnReminderWorker(l logger.Logger, cfg config.Config, s storer.Storer) (*GameTurnReminderWorker, error) {
+	jw, err := NewJobWorker(l, cfg, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GameTurnReminderWorker{
+		JobWorker: *jw,
+	}, nil
+}
+
+func (w *GameTurnReminderWorker) Work(ctx context.Context, j *river.Job[GameTurnReminderWorkerArgs]) error {
+	l := w.Log.WithFunctionContext("GameTurnReminderWorker/Work")
+
+	l.Info("running job ID >%s<", strconv.FormatInt(j.ID, 10))
+
+	c, m, err := w.beginJob(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		m.Tx.Rollback(context.Background())
+	}()
+
+	_, err = w.DoWork(ctx, m, c, j)
+	if err != nil {
+		l.Error("GameTurnReminderWorker job ID >%s< failed >%v<", strconv.FormatInt(j.ID, 10), err)
+		return err
+	}
+
+	return corejobworker.CompleteJob(ctx, m.Tx, j)
+}
+
+type GameTurnReminderDoWorkResult struct {
+	GamesChecked    int
+	RemindersQueued int
+	ProcessedAt     time.Time
+}
+
+func (w *GameTurnReminderWorker) DoWork(ctx context.Context, m *domain.Domain, c *river.Client[pgx.Tx], j *river.Job[GameTurnReminderWorkerArgs]) (*GameTurnReminderDoWorkResult, error) {
+	l := w.Log.WithFunctionContext("GameTurnReminderWorker/DoWork")
+
+	result := &GameTurnReminderDoWorkResult{
+		ProcessedAt: time.Now(),
+	}
+
+	reminderHours := turnReminderHours(w.Config.TurnReminderHours)
+	if len(reminderHours) == 0 {
+		l.Debug("turn reminder hours are not configured, skipping turn reminders")
+		return result, nil
+	}
+
+	now := time.Now().UTC()
+
+	// Reminder hours are sorted in descending order so the first is the earliest reminder
+	instanceRecs, err := w.getGameInstanceRecsNeedingTurnReminders(m, now, now.Add(time.Duration(reminderHours[0])*time.Hour))
+	if err != nil {
+		l.Warn("failed to get game instances needing turn reminders >%v<", err)
+		return nil, err
+	}
+
+	result.GamesChecked = len(instanceRecs)
+
+	for _, instanceRec := range instanceRecs {
+		if !instanceRec.DeliveryEmail {
+			continue
+		}
+
+		hours, ok := turnReminderDue(reminderHours, instanceRec.NextTurnDueAt.Time.Sub(now))
+		if !ok {
+			continue
+		}
+
+		queued, err := w.queueGameInstanceTurnReminders(ctx, m, c, instanceRec, hours)
+		if err != nil {
+			l.Warn("failed to queue turn reminders for game instance >%s< >%v<", instanceRec.ID, err)
+			return nil, err
+		}
+
+		result.RemindersQueued += queued
+	}
+
+	l.Info("completed turn reminder check: checked >%d< games, queued >%d< reminders", result.GamesChecked, result.RemindersQueued)
+
+	return result, nil
+}
+
+// getGameInstanceRecsNeedingTurnReminders gets started game instances with a turn
+// deadline between now and the latest time a reminder would be sent
+func (w *GameTurnReminderWorker) getGameInstanceRecsNeedingTurnReminders(m *domain.Domain, from, to time.Time) ([]*game_record.GameInstance, error) {
+	l := w.Log.WithFunctionContext("GameTurnReminderWorker/getGameInstanceRecsNeedingTurnReminders")
+
+	opts := &coresql.Options{
+		Params: []coresql.Param{
+			{
+				Col: game_record.FieldGameInstanceStatus,
+				Val: game_record.GameInstanceStatusStarted,
+			},
+			{
+				Col:  game_record.FieldGameInstanceNextTurnDueAt,
+				Val:  nulltime.FromTime(from),
+				ValB: nulltime.FromTime(to),
+				Op:   coresql.OpBetween,
+			},
+		},
+	}
+
+	instanceRecs, err := m.GetManyGameInstanceRecs(opts)
+	if err != nil {
+		l.Warn("failed to get game instances needing turn reminders >%v<", err)
+		return nil, err
+	}
+
+	return instanceRecs, nil
+}
+
+// queueGameInstanceTurnReminders queues a turn reminder email for each player in the game
+// instance with turn sheets still to submit that has not already been sent this reminder
+func (w *GameTurnReminderWorker) queueGameInstanceTurnReminders(ctx context.Context, m *domain.Domain, c *river.Client[pgx.Tx], instanceRec *game_record.GameInstance, hours int) (int, error) {
+	l := w.Log.WithFunctionContext("GameTurnReminderWorker/queueGameInstanceTurnReminders")
+
+	subscriptionInstanceRecs, err := m.GetGameSubscriptionInstanceRecsByInstance(instanceRec.ID)
+	if err != nil {
+		l.Warn("failed to get game subscription instances for game instance >%s< >%v<", instanceRec.ID, err)
+		return 0, err
+	}
+
+	turnSheetRecs, err := m.GetGameTurnSheetRecsByGameInstance(instanceRec.ID, instanceRec.CurrentTurn)
+	if err != nil {
+		l.Warn("failed to get turn sheets for game instance >%s< turn >%d< >%v<", instanceRec.ID, instanceRec.CurrentTurn, err)
+		return 0, err
+	}
+
+	incompleteAccountUserIDs := map[string]bool{}
+	for _, turnSheetRec := range turnSheetRecs {
+		if !turnSheetRec.IsCompleted {
+			incompleteAccountUserIDs[turnSheetRec.AccountUserID] = true
+		}
+	}
+
+	queued := 0
+	for _, subscriptionInstanceRec := range subscriptionInstanceRecs {
+		if subscriptionInstanceRec.IsDropped() || !incompleteAccountUserIDs[subscriptionInstanceRec.AccountUserID] {
+			continue
+		}
+
+		if turnReminderSent(subscriptionInstanceRec, instanceRec.CurrentTurn, hours) {
+			continue
+		}
+
+		accountRec, err := m.GetAccountRec(subscriptionInstanceRec.AccountID, nil)
+		if err != nil {
+			l.Warn("failed to get account >%s< >%v<", subscriptionInstanceRec.AccountID, err)
+			return queued, err
+		}
+
+		if accountRec.TurnReminderOptOut {
+			l.Debug("account >%s< has opted out of turn reminders", accountRec.ID)
+			continue
+		}
+
+		// Record the reminder before queueing so it is only ever sent once
+		subscriptionInstanceRec.TurnReminderTurn = instanceRec.CurrentTurn
+		subscriptionInstanceRec.TurnReminderHours = hours
+		if _, err := m.UpdateGameSubscriptionInstanceRec(subscriptionInstanceRec); err != nil {
+			l.Warn("failed to record turn reminder for game subscription instance >%s< >%v<", subscriptionInstanceRec.ID, err)
+			return queued, err
+		}
+
+		_, err = c.InsertTx(ctx, m.Tx, SendTurnReminderEmailWorkerArgs{
+			GameSubscriptionInstanceID: subscriptionInstanceRec.ID,
+			TurnNumber:                 instanceRec.CurrentTurn,
+		}, nil)
+		if err != nil {
+			l.Warn("failed to queue turn reminder email for game subscription instance >%s< >%v<", subscriptionInstanceRec.ID, err)
+			return queued, err
+		}
+
+		queued++
+		l.Info("queued turn reminder email for game subscription instance >%s< turn >%d< hours >%d<",
+			subscriptionInstanceRec.ID, instanceRec.CurrentTurn, hours)
+	}
+
+	return queued, nil
+}
+
+// turnReminderHours returns the configured reminder hours that are greater than zero,
+// without duplicates and sorted in descending order
+func turnReminderHours(configured []int) []int {
+	seen := map[int]bool{}
+	hours := []int{}
+	for _, h := range configured {
+		if h <= 0 || seen[h] {
+			continue
+		}
+		seen[h] = true
+		hours = append(hours, h)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(hours)))
+	return hours
+}
+
+// turnReminderDue returns the reminder that is due with the given time remaining until
+// the turn deadline. When the time remaining has passed more than one reminder only the
+// closest to the deadline is due, so a player is never sent several reminders at once.
+func turnReminderDue(reminderHours []int, remaining time.Duration) (int, bool) {
+	if remaining <= 0 {
+		return 0, false
+	}
+	due := 0
+	for _, h := range reminderHours {
+		if remaining <= time.Duration(h)*time.Hour {
+			due = h
+		}
+	}
+	return due, due > 0
+}
+
+// turnReminderSent returns true when the player has already been sent the reminder, or a
+// reminder closer to the deadline, for the turn
+func turnReminderSent(rec *game_record.GameSubscriptionInstance, turn, hours int) bool {
+	return rec.TurnReminderTurn == turn && rec.TurnReminderHours > 0 && rec.TurnReminderHours <= hours
+}
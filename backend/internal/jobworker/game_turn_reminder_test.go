@@ -0,0 +1,97 @@
+package jobworker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+func TestTurnReminderHours(t *testing.T) {
+	require.Equal(t, []int{24, 2}, turnReminderHours([]int{2, 24}))
+	require.Equal(t, []int{48, 24, 2}, turnReminderHours([]int{24, 2, 48, 24}))
+	require.Equal(t, []int{}, turnReminderHours([]int{0}))
+	require.Equal(t, []int{}, turnReminderHours(nil))
+}
+
+func TestTurnReminderDue(t *testing.T) {
+	reminderHours := []int{24, 2}
+
+	tests := []struct {
+		name        string
+		remaining   time.Duration
+		expectHours int
+		expectDue   bool
+	}{
+		{
+			name:      "before the first reminder",
+			remaining: 30 * time.Hour,
+		},
+		{
+			name:        "first reminder",
+			remaining:   23 * time.Hour,
+			expectHours: 24,
+			expectDue:   true,
+		},
+		{
+			name:        "exactly at the first reminder",
+			remaining:   24 * time.Hour,
+			expectHours: 24,
+			expectDue:   true,
+		},
+		{
+			name:        "second reminder",
+			remaining:   90 * time.Minute,
+			expectHours: 2,
+			expectDue:   true,
+		},
+		{
+			name:      "deadline passed",
+			remaining: -time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hours, due := turnReminderDue(reminderHours, tt.remaining)
+			require.Equal(t, tt.expectDue, due)
+			require.Equal(t, tt.expectHours, hours)
+		})
+	}
+}
+
+func TestTurnReminderSent(t *testing.T) {
+	rec := &game_record.GameSubscriptionInstance{}
+	require.False(t, turnReminderSent(rec, 3, 24), "no reminder sent yet")
+
+	rec.TurnReminderTurn = 3
+	rec.TurnReminderHours = 24
+	require.True(t, turnReminderSent(rec, 3, 24), "same reminder already sent")
+	require.False(t, turnReminderSent(rec, 3, 2), "closer reminder not yet sent")
+	require.False(t, turnReminderSent(rec, 4, 24), "reminder sent for an earlier turn")
+
+	rec.TurnReminderHours = 2
+	require.True(t, turnReminderSent(rec, 3, 24), "closer reminder already sent")
+}
+
+func TestFormatTurnDeadline(t *testing.T) {
+	deadline := time.Date(2026, time.March, 1, 22, 30, 0, 0, time.UTC)
+
+	date, tm := formatTurnDeadline(deadline, "", "en")
+	require.Equal(t, "March 1, 2026", date)
+	require.Equal(t, "10:30 PM UTC", tm)
+
+	date, tm = formatTurnDeadline(deadline, "Australia/Sydney", "en")
+	require.Equal(t, "March 2, 2026", date)
+	require.Equal(t, "9:30 AM AEDT", tm)
+
+	date, tm = formatTurnDeadline(deadline, "Not/AZone", "en")
+	require.Equal(t, "March 1, 2026", date)
+	require.Equal(t, "10:30 PM UTC", tm)
+
+	date, tm = formatTurnDeadline(deadline, "Australia/Sydney", "es")
+	require.Equal(t, "2 de marzo de 2026", date)
+	require.Equal(t, "09:30 AEDT", tm)
+}
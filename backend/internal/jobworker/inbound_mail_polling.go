@@ -0,0 +1,131 @@
+package jobworker
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+
+	corejobworker "gitlab.com/alienspaces/playbymail/core/jobworker"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/core/type/storer"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/jobqueue"
+	"gitlab.com/alienspaces/playbymail/internal/mailbox"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// InboundMailPollingWorkerArgs defines the arguments for polling the inbound mailbox
+type InboundMailPollingWorkerArgs struct {
+	// No arguments needed - this is a periodic job
+}
+
+func (InboundMailPollingWorkerArgs) Kind() string { return "inbound_mail_polling" }
+
+// InboundMailPollingWorker claims each message that has arrived in the inbound
+// mailbox and queues a job to process it.
+type InboundMailPollingWorker struct {
+	river.WorkerDefaults[InboundMailPollingWorkerArgs]
+	JobWorker
+}
+
+func NewInboundMailPollingWorker(l logger.Logger, cfg config.Config, s storer.Storer) (*InboundMailPollingWorker, error) {
+	jw, err := NewJobWorker(l, cfg, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InboundMailPollingWorker{
+		JobWorker: *jw,
+	}, nil
+}
+
+func (w *InboundMailPollingWorker) Work(ctx context.Context, j *river.Job[InboundMailPollingWorkerArgs]) error {
+	l := w.Log.WithFunctionContext("InboundMailPollingWorker/Work")
+
+	l.Info("running job ID >%s<", strconv.FormatInt(j.ID, 10))
+
+	c, m, err := w.beginJob(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		m.Tx.Rollback(context.Background())
+	}()
+
+	_, err = w.DoWork(ctx, m, c, j)
+	if err != nil {
+		l.Error("InboundMailPollingWorker job ID >%s< failed >%v<", strconv.FormatInt(j.ID, 10), err)
+		return err
+	}
+
+	return corejobworker.CompleteJob(ctx, m.Tx, j)
+}
+
+type InboundMailPollingDoWorkResult struct {
+	MessagesFound  int
+	MessagesQueued int
+	ProcessedAt    time.Time
+}
+
+func (w *InboundMailPollingWorker) DoWork(ctx context.Context, m *domain.Domain, c *river.Client[pgx.Tx], j *river.Job[InboundMailPollingWorkerArgs]) (*InboundMailPollingDoWorkResult, error) {
+	l := w.Log.WithFunctionContext("InboundMailPollingWorker/DoWork")
+
+	result := &InboundMailPollingDoWorkResult{
+		ProcessedAt: time.Now(),
+	}
+
+	mb, err := newInboundMailbox(w.Config)
+	if err != nil {
+		l.Warn("failed to create inbound mailbox >%v<", err)
+		return nil, err
+	}
+
+	if mb == nil {
+		l.Debug("inbound mail provider is not configured, skipping inbound mail polling")
+		return result, nil
+	}
+
+	ids, err := mb.List(ctx)
+	if err != nil {
+		l.Warn("failed to list inbound mail >%v<", err)
+		return nil, err
+	}
+
+	result.MessagesFound = len(ids)
+
+	for _, id := range ids {
+		// Claim the message first so a slow processing job never sees it listed twice
+		if err := mb.Claim(ctx, id); err != nil {
+			l.Warn("failed to claim inbound mail message >%s< >%v<", id, err)
+			continue
+		}
+
+		_, err := c.InsertTx(ctx, m.Tx, ProcessInboundMailWorkerArgs{
+			MessageID: id,
+		}, &river.InsertOpts{
+			Queue: jobqueue.QueueDefault,
+		})
+		if err != nil {
+			l.Warn("failed to queue inbound mail message >%s< >%v<", id, err)
+			return nil, err
+		}
+
+		result.MessagesQueued++
+	}
+
+	if result.MessagesFound > 0 {
+		l.Info("found >%d< inbound mail messages, queued >%d<", result.MessagesFound, result.MessagesQueued)
+	}
+
+	return result, nil
+}
+
+func newInboundMailbox(cfg config.Config) (mailbox.Mailbox, error) {
+	return mailbox.NewMailbox(mailbox.Config{
+		Provider:    cfg.InboundMailProvider,
+		MaildirPath: cfg.InboundMailMaildirPath,
+	})
+}
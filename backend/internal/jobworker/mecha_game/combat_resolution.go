@@ -2,12 +2,14 @@ package mecha_game
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"math/rand"
 
 	coresql "gitlab.com/alienspaces/playbymail/core/sql"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
 	"gitlab.com/alienspaces/playbymail/internal/jobworker/mecha_game/turn_sheet_processor"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
@@ -74,6 +76,17 @@ func rangeDistance(fromID, toID string, sectors []*sectorState) int {
 	return 999
 }
 
+// sectorTerrainType returns the terrain type of a sector, or an empty string
+// when the sector is not in the graph.
+func sectorTerrainType(sectorInstanceID string, sectors []*sectorState) string {
+	for _, sec := range sectors {
+		if sec.Instance.ID == sectorInstanceID && sec.Design != nil {
+			return sec.Design.TerrainType
+		}
+	}
+	return ""
+}
+
 // weaponCanFire returns whether a weapon's range band is valid for the given
 // sector distance. Range band rules:
 //
@@ -93,26 +106,6 @@ func weaponCanFire(rangeBand string, distance int) bool {
 	}
 }
 
-// hitChance returns the probability of a single weapon hit (0–100).
-// Formula: 50 + pilotSkill*5 + attackerHitBonus + coverModifier, capped to [0, 95].
-//
-// attackerHitBonus comes from the attacker's targeting-computer equipment
-// (zero when absent or the attacker is refitting). coverModifier is the sum
-// of the target sector's cover modifier and the target's ECM cover bonus
-// (zero when absent or the target is refitting). Negative final values are
-// clamped at 0; extreme positive values cap at 95 so there is always some
-// miss chance.
-func hitChance(pilotSkill int, attackerHitBonus int, coverModifier int) int {
-	chance := 50 + pilotSkill*5 + attackerHitBonus + coverModifier
-	if chance > 95 {
-		chance = 95
-	}
-	if chance < 0 {
-		chance = 0
-	}
-	return chance
-}
-
 // pendingDamage accumulates raw total damage from all attacks before applying (simultaneous
 // resolution). Armor/structure split is performed once when all damage is applied so that
 // focus-fire from multiple attackers cannot armor-absorb more total damage than the target has.
@@ -128,6 +121,7 @@ func (p *MechaGame) resolveCombat(
 	_ context.Context,
 	l logger.Logger,
 	gameInstanceRec *game_record.GameInstance,
+	ruleset domain.MechaGameRuleset,
 	attacks []AttackDeclaration,
 ) (map[string]int, error) {
 	if len(attacks) == 0 {
@@ -157,6 +151,14 @@ func (p *MechaGame) resolveCombat(
 		return nil, err
 	}
 
+	// Attacks resolve against the positions mechs moved to this turn, so
+	// detection is worked out after movement.
+	detection, err := p.Domain.GetMechaGameDetection(gameInstanceRec.ID)
+	if err != nil {
+		l.Warn("failed to get detection: %v", err)
+		return nil, err
+	}
+
 	seed := int64(gameInstanceRec.CurrentTurn)
 	for _, b := range []byte(gameInstanceRec.ID) {
 		seed += int64(b)
@@ -168,7 +170,8 @@ func (p *MechaGame) resolveCombat(
 	xpMap := make(map[string]int, len(allMechInsts))
 	eventsBySquad := make(map[string][]turnsheet.TurnEvent)
 
-	p.resolveAttacks(l, attacks, snapshots, sectors, rng, damageMap, heatMap, xpMap, eventsBySquad)
+	attacks = filterAttacksOnUndetectedTargets(l, attacks, snapshots, detection, eventsBySquad)
+	p.resolveAttacks(l, ruleset, attacks, snapshots, sectors, rng, damageMap, heatMap, xpMap, eventsBySquad)
 	p.applyPendingDamage(l, damageMap, snapshots, attacks, eventsBySquad)
 	p.applyPendingHeat(l, heatMap, snapshots, eventsBySquad)
 	p.persistMechChanges(l, snapshots, damageMap, heatMap)
@@ -280,6 +283,38 @@ func (p *MechaGame) buildSectorGraph(l logger.Logger, gameInstanceID string) ([]
 	return sectors, nil
 }
 
+// filterAttacksOnUndetectedTargets drops attacks on targets the attacker's
+// squad has no sensor contact with. A squad can only fire on what it sees, so
+// an enemy that slipped out of sensor range or into concealing terrain during
+// movement cannot be attacked this turn.
+func filterAttacksOnUndetectedTargets(
+	l logger.Logger,
+	attacks []AttackDeclaration,
+	snapshots map[string]*mechSnapshot,
+	detection *domain.MechaGameDetection,
+	eventsBySquad map[string][]turnsheet.TurnEvent,
+) []AttackDeclaration {
+	filtered := make([]AttackDeclaration, 0, len(attacks))
+	for _, atk := range attacks {
+		attacker, attackerOK := snapshots[atk.AttackerMechInstanceID]
+		target, targetOK := snapshots[atk.TargetMechInstanceID]
+		// Missing and destroyed mechs are reported by resolveAttacks
+		if !attackerOK || !targetOK || target.Instance.Status == mecha_game_record.MechInstanceStatusDestroyed {
+			filtered = append(filtered, atk)
+			continue
+		}
+		if !detection.IsDetected(attacker.SquadInstanceID, target.Instance.ID) {
+			l.Info("%s has no sensor contact with %s — skipping attack", attacker.Instance.Callsign, target.Instance.Callsign)
+			appendCombatEvent(eventsBySquad, attacker.SquadInstanceID,
+				fmt.Sprintf("%s could not attack %s — no sensor contact.",
+					attacker.Instance.Callsign, target.Instance.Callsign))
+			continue
+		}
+		filtered = append(filtered, atk)
+	}
+	return filtered
+}
+
 const (
 	xpPerCombatParticipation = 1
 	xpPerKill                = 2
@@ -287,6 +322,7 @@ const (
 
 func (p *MechaGame) resolveAttacks(
 	l logger.Logger,
+	ruleset domain.MechaGameRuleset,
 	attacks []AttackDeclaration,
 	snapshots map[string]*mechSnapshot,
 	sectors []*sectorState,
@@ -349,6 +385,26 @@ func (p *MechaGame) resolveAttacks(
 			continue
 		}
 
+		// Forest and urban terrain blocks fire beyond the run's obstructed
+		// fire range, whichever end of the line of fire it is at.
+		attackerTerrain := sectorTerrainType(attacker.SectorInstanceID, sectors)
+		targetTerrain := sectorTerrainType(target.SectorInstanceID, sectors)
+		if ruleset.FireObstructed(attackerTerrain, targetTerrain, dist) {
+			blockingTerrain := targetTerrain
+			if ruleset.FireObstructed(attackerTerrain, "", dist) {
+				blockingTerrain = attackerTerrain
+			}
+			l.Info("%s fired at %s but the line of fire is blocked by %s terrain (distance %d)",
+				attacker.Instance.Callsign, target.Instance.Callsign, blockingTerrain, dist)
+			appendCombatEvent(eventsBySquad, attacker.SquadInstanceID,
+				fmt.Sprintf("%s could not fire at %s — line of fire blocked by %s terrain (distance %d).",
+					attacker.Instance.Callsign, target.Instance.Callsign, blockingTerrain, dist))
+			appendCombatEvent(eventsBySquad, target.SquadInstanceID,
+				fmt.Sprintf("%s was targeted by %s — %s terrain blocked the shot (distance %d).",
+					target.Instance.Callsign, attacker.Instance.Callsign, blockingTerrain, dist))
+			continue
+		}
+
 		if len(attacker.Weapons) == 0 {
 			l.Info("attacker >%s< has no weapons — skipping attack on >%s<", attacker.Instance.Callsign, target.Instance.Callsign)
 			appendCombatEvent(eventsBySquad, attacker.SquadInstanceID,
@@ -365,7 +421,7 @@ func (p *MechaGame) resolveAttacks(
 
 		attacker.DidAttack = true
 
-		totalDmg := p.fireWeapons(l, atk, attacker, target, dist, sectors, rng, heatMap, eventsBySquad)
+		totalDmg := p.fireWeapons(l, ruleset, atk, attacker, target, dist, sectors, rng, heatMap, eventsBySquad)
 		if totalDmg > 0 {
 			l.Info("total damage: %d", totalDmg)
 			accumulateDamage(atk.TargetMechInstanceID, totalDmg, snapshots, damageMap)
@@ -377,7 +433,7 @@ func (p *MechaGame) resolveAttacks(
 	// when they actually pulled the trigger on a weapon with
 	// ammo_capacity > 0. Always-on equipment heat (heat sink, armor
 	// upgrade, ECM) is applied in end_of_turn so it runs even on turns
-	// with no combat. Jump-jets heat is applied in the orders processor
+	// with no combat. Jump-jets heat is applied in the movement phase
 	// when movement exceeds chassis base speed.
 	for mechID, snap := range snapshots {
 		inst := snap.Instance
@@ -433,6 +489,7 @@ func (p *MechaGame) resolveAttacks(
 
 func (p *MechaGame) fireWeapons(
 	l logger.Logger,
+	ruleset domain.MechaGameRuleset,
 	atk AttackDeclaration,
 	attacker, target *mechSnapshot,
 	dist int,
@@ -460,7 +517,7 @@ func (p *MechaGame) fireWeapons(
 	attackerHitBonus := attacker.Effects.HitChanceBonus
 	effectiveCover := coverModifier + target.Effects.CoverBonus
 
-	chance := hitChance(attacker.Instance.PilotSkill, attackerHitBonus, effectiveCover)
+	chance := ruleset.HitChance(attacker.Instance.PilotSkill, attackerHitBonus, effectiveCover)
 
 	totalDmg := 0
 	for _, slot := range attacker.Weapons {
@@ -610,6 +667,7 @@ func (p *MechaGame) applyPendingDamage(
 			for _, atk := range attacks {
 				if atk.TargetMechInstanceID == mechID {
 					if attSnap, ok := snapshots[atk.AttackerMechInstanceID]; ok {
+						inst.DestroyedBySquadInstanceID = sql.NullString{String: attSnap.SquadInstanceID, Valid: true}
 						appendCombatEvent(eventsBySquad, attSnap.SquadInstanceID,
 							fmt.Sprintf("%s has been DESTROYED by your fire!", inst.Callsign))
 					}
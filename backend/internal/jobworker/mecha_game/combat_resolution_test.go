@@ -8,6 +8,7 @@ import (
 
 	corerecord "gitlab.com/alienspaces/playbymail/core/record"
 	corelog "gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
 )
@@ -128,13 +129,27 @@ func TestHitChance(t *testing.T) {
 		{name: "targeting computer offsets ecm cover", pilotSkill: 5, attackerHitBonus: 15, coverModifier: -20, expected: 70},
 	}
 
+	ruleset, err := domain.DefaultMechaGameRuleset()
+	require.NoError(t, err)
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			chance := hitChance(tt.pilotSkill, tt.attackerHitBonus, tt.coverModifier)
+			chance := ruleset.HitChance(tt.pilotSkill, tt.attackerHitBonus, tt.coverModifier)
 			require.Equal(t, tt.expected, chance)
 		})
 	}
+
+	t.Run("designer ruleset changes base, per skill and cap", func(t *testing.T) {
+		t.Parallel()
+		custom := ruleset
+		custom.BaseHitChance = 40
+		custom.HitChancePerSkill = 10
+		custom.MaxHitChance = 80
+		require.Equal(t, 40, custom.HitChance(0, 0, 0))
+		require.Equal(t, 70, custom.HitChance(3, 0, 0))
+		require.Equal(t, 80, custom.HitChance(9, 0, 0))
+	})
 }
 
 func TestAccumulateDamage(t *testing.T) {
@@ -275,12 +290,168 @@ func TestApplyPendingDamage(t *testing.T) {
 
 		assert.Equal(t, 0, inst.CurrentStructure)
 		assert.Equal(t, mecha_game_record.MechInstanceStatusDestroyed, inst.Status)
+		assert.Equal(t, "squad2", inst.DestroyedBySquadInstanceID.String, "kill credited to the attacking squad")
+	})
+}
+
+func TestFilterAttacksOnUndetectedTargets(t *testing.T) {
+	t.Parallel()
+
+	makeSnap := func(id, callsign, squadID, sectorID string) *mechSnapshot {
+		return &mechSnapshot{
+			Instance: &mecha_game_record.MechaGameMechInstance{
+				Record:   corerecord.Record{ID: id},
+				Callsign: callsign,
+				Status:   mecha_game_record.MechInstanceStatusOperational,
+			},
+			SquadInstanceID:  squadID,
+			SectorInstanceID: sectorID,
+		}
+	}
+
+	snapshots := map[string]*mechSnapshot{
+		"scout":  makeSnap("scout", "Scout", "player", "A"),
+		"near":   makeSnap("near", "Near", "opponent", "B"),
+		"hidden": makeSnap("hidden", "Hidden", "opponent", "C"),
+	}
+
+	// Scout sees one hop; Hidden stands two hops away
+	detection := domain.DetectMechaGameMechs([]domain.MechaGameSensorMech{
+		{MechInstanceID: "scout", SquadInstanceID: "player", SectorInstanceID: "A", SensorRange: 1, Observing: true},
+		{MechInstanceID: "near", SquadInstanceID: "opponent", SectorInstanceID: "B", SensorRange: 1, Observing: true},
+		{MechInstanceID: "hidden", SquadInstanceID: "opponent", SectorInstanceID: "C", SensorRange: 1, Observing: true},
+	}, domain.MechaGameSensorGrid{
+		Links: map[string][]string{"A": {"B"}, "B": {"A", "C"}, "C": {"B"}},
 	})
+
+	attacks := []AttackDeclaration{
+		{AttackerMechInstanceID: "scout", TargetMechInstanceID: "near"},
+		{AttackerMechInstanceID: "scout", TargetMechInstanceID: "hidden"},
+		{AttackerMechInstanceID: "near", TargetMechInstanceID: "scout"},
+	}
+	eventsBySquad := map[string][]turnsheet.TurnEvent{}
+
+	got := filterAttacksOnUndetectedTargets(testLogger, attacks, snapshots, detection, eventsBySquad)
+
+	require.Equal(t, []AttackDeclaration{
+		{AttackerMechInstanceID: "scout", TargetMechInstanceID: "near"},
+		{AttackerMechInstanceID: "near", TargetMechInstanceID: "scout"},
+	}, got, "only attacks on detected targets remain")
+	require.Len(t, eventsBySquad["player"], 1, "attacker squad is told the attack was called off")
+	require.Contains(t, eventsBySquad["player"][0].Message, "no sensor contact")
+	require.Empty(t, eventsBySquad["opponent"], "undetected target is not told about the attack")
+}
+
+func TestResolveAttacksTerrainObstructsFire(t *testing.T) {
+	t.Parallel()
+
+	ruleset, err := domain.DefaultMechaGameRuleset()
+	require.NoError(t, err, "DefaultMechaGameRuleset returns without error")
+
+	// A line of sectors: open A - open B - C, where C's terrain varies
+	makeSectors := func(terrainC string) []*sectorState {
+		makeSector := func(id, terrain string, links ...string) *sectorState {
+			return &sectorState{
+				Instance:            &mecha_game_record.MechaGameSectorInstance{Record: corerecord.Record{ID: id}},
+				Design:              &mecha_game_record.MechaGameSector{TerrainType: terrain},
+				LinkDestInstanceIDs: links,
+			}
+		}
+		return []*sectorState{
+			makeSector("A", mecha_game_record.SectorTerrainTypeOpen, "B"),
+			makeSector("B", mecha_game_record.SectorTerrainTypeOpen, "A", "C"),
+			makeSector("C", terrainC, "B"),
+		}
+	}
+
+	makeSnap := func(id, callsign, squadID, sectorID string, weapons []mecha_game_record.WeaponConfigEntry) *mechSnapshot {
+		return &mechSnapshot{
+			Instance: &mecha_game_record.MechaGameMechInstance{
+				Record:   corerecord.Record{ID: id},
+				Callsign: callsign,
+				Status:   mecha_game_record.MechInstanceStatusOperational,
+			},
+			SquadInstanceID:  squadID,
+			SectorInstanceID: sectorID,
+			Weapons:          weapons,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		terrainC       string
+		targetSector   string
+		attackerSector string
+		wantMessage    string
+	}{
+		{
+			name:           "target in forest two hops away then line of fire blocked",
+			terrainC:       mecha_game_record.SectorTerrainTypeForest,
+			attackerSector: "A",
+			targetSector:   "C",
+			wantMessage:    "line of fire blocked by forest terrain",
+		},
+		{
+			name:           "attacker in urban two hops away then line of fire blocked",
+			terrainC:       mecha_game_record.SectorTerrainTypeUrban,
+			attackerSector: "C",
+			targetSector:   "A",
+			wantMessage:    "line of fire blocked by urban terrain",
+		},
+		{
+			name:           "target in forest one hop away then not blocked",
+			terrainC:       mecha_game_record.SectorTerrainTypeForest,
+			attackerSector: "B",
+			targetSector:   "C",
+			wantMessage:    "has no weapons fitted",
+		},
+		{
+			name:           "target in open ground two hops away then not blocked",
+			terrainC:       mecha_game_record.SectorTerrainTypeOpen,
+			attackerSector: "A",
+			targetSector:   "C",
+			wantMessage:    "has no weapons fitted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Blocked attackers carry a weapon so the terrain check is what
+			// stops them; unblocked attackers carry none so resolution stops
+			// before any weapon is loaded
+			var weapons []mecha_game_record.WeaponConfigEntry
+			if tt.wantMessage != "has no weapons fitted" {
+				weapons = []mecha_game_record.WeaponConfigEntry{{WeaponID: "lrm"}}
+			}
+
+			snapshots := map[string]*mechSnapshot{
+				"hunter": makeSnap("hunter", "Hunter", "player", tt.attackerSector, weapons),
+				"prey":   makeSnap("prey", "Prey", "opponent", tt.targetSector, nil),
+			}
+			attacks := []AttackDeclaration{{AttackerMechInstanceID: "hunter", TargetMechInstanceID: "prey"}}
+			xpMap := map[string]int{}
+			eventsBySquad := map[string][]turnsheet.TurnEvent{}
+
+			mechaGame := &MechaGame{}
+			mechaGame.resolveAttacks(testLogger, ruleset, attacks, snapshots, makeSectors(tt.terrainC),
+				nil, map[string]*pendingDamage{}, map[string]int{}, xpMap, eventsBySquad)
+
+			require.Len(t, eventsBySquad["player"], 1, "attacker squad receives one event")
+			require.Contains(t, eventsBySquad["player"][0].Message, tt.wantMessage, "attacker event explains the outcome")
+			require.Empty(t, xpMap, "no experience is earned for an attack that is not made")
+		})
+	}
 }
 
 func TestPilotSkillThresholds(t *testing.T) {
 	t.Parallel()
 
+	ruleset, err := domain.DefaultMechaGameRuleset()
+	require.NoError(t, err)
+	pilotSkillThresholds := ruleset.PilotSkillThresholds
+
 	t.Run("thresholds are strictly increasing", func(t *testing.T) {
 		t.Parallel()
 		for i := 1; i < len(pilotSkillThresholds); i++ {
@@ -297,33 +468,26 @@ func TestPilotSkillThresholds(t *testing.T) {
 	t.Run("has exactly 10 levels (0-9)", func(t *testing.T) {
 		t.Parallel()
 		assert.Len(t, pilotSkillThresholds, 10)
+		assert.Equal(t, 9, ruleset.MaxPilotSkill())
 	})
 
 	t.Run("pilot advances skill from 0 to 1 at threshold", func(t *testing.T) {
 		t.Parallel()
-		xp := pilotSkillThresholds[1]
-		skill := 0
-		for nextSkill := skill + 1; nextSkill < len(pilotSkillThresholds); nextSkill++ {
-			if xp >= pilotSkillThresholds[nextSkill] {
-				skill = nextSkill
-			} else {
-				break
-			}
-		}
-		assert.Equal(t, 1, skill)
+		assert.Equal(t, 1, ruleset.PilotSkillLevel(0, pilotSkillThresholds[1]))
 	})
 
 	t.Run("pilot does not advance skill with XP below threshold", func(t *testing.T) {
 		t.Parallel()
-		xp := pilotSkillThresholds[1] - 1
-		skill := 0
-		for nextSkill := skill + 1; nextSkill < len(pilotSkillThresholds); nextSkill++ {
-			if xp >= pilotSkillThresholds[nextSkill] {
-				skill = nextSkill
-			} else {
-				break
-			}
-		}
-		assert.Equal(t, 0, skill)
+		assert.Equal(t, 0, ruleset.PilotSkillLevel(0, pilotSkillThresholds[1]-1))
+	})
+
+	t.Run("pilot advances several levels at once", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, 3, ruleset.PilotSkillLevel(0, pilotSkillThresholds[3]))
+	})
+
+	t.Run("pilot skill caps at the last level", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, 9, ruleset.PilotSkillLevel(0, 1000))
 	})
 }
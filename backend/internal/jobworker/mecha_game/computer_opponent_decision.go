@@ -20,7 +20,11 @@ type GameStateContext struct {
 	Opponent      *mecha_game_record.MechaGameComputerOpponent
 	SquadInstance *mecha_game_record.MechaGameSquadInstance
 	OwnMechs      []*mecha_game_record.MechaGameMechInstance
-	EnemyMechs    []*mechState
+	// EnemyMechs are the enemy mechs the squad has detected this turn
+	EnemyMechs []*mechState
+	// EnemyContacts are enemy mechs the squad has lost sensor contact with,
+	// at the sector each was last seen in
+	EnemyContacts []mecha_game_record.MechaGameContact
 	Sectors       []*sectorState
 	// ChassisCache maps chassis ID to chassis design record for speed lookups.
 	ChassisCache map[string]*mecha_game_record.MechaGameChassis
@@ -29,7 +33,10 @@ type GameStateContext struct {
 	// and hit-chance bonus without re-resolving equipment records each
 	// decision step. Refitting mechs resolve to a zero value here already.
 	EffectsByMechID map[string]domain.MechaGameEquipmentEffects
-	TurnNumber      int
+	// Ruleset is the run's ruleset so strategies estimate hit chances with
+	// the same values combat resolution uses.
+	Ruleset    domain.MechaGameRuleset
+	TurnNumber int
 }
 
 type mechState struct {
@@ -57,9 +64,9 @@ type ComputerOpponentDecisionEngine struct {
 	fallbackStrategy ComputerOpponentStrategy
 }
 
-// NewComputerOpponentDecisionEngine creates the decision engine. If an OpenAI API
-// key is configured the engine uses LLM-based orders as the primary strategy with
-// a rule-based fallback; otherwise only the rule-based strategy is used.
+// NewComputerOpponentDecisionEngine creates the decision engine. If an API key is
+// configured for the agent provider the engine uses LLM-based orders as the primary
+// strategy with a rule-based fallback; otherwise only the rule-based strategy is used.
 func NewComputerOpponentDecisionEngine(l logger.Logger, d *domain.Domain, cfg config.Config) *ComputerOpponentDecisionEngine {
 	l = l.WithFunctionContext("NewComputerOpponentDecisionEngine")
 
@@ -68,13 +75,12 @@ func NewComputerOpponentDecisionEngine(l logger.Logger, d *domain.Domain, cfg co
 	var primary ComputerOpponentStrategy = rbStrategy
 	var fallback ComputerOpponentStrategy = rbStrategy
 
-	if cfg.OpenAIAPIKey != "" {
-		l.Info("OpenAI API key configured — using LLM strategy with rule-based fallback")
-		textAgent := agent.NewOpenAITextAgent(l, cfg)
+	if textAgent := agent.NewTextAgent(l, cfg); textAgent != nil {
+		l.Info("agent API key configured — using LLM strategy with rule-based fallback")
 		primary = &llmStrategy{textAgent: textAgent}
 		fallback = rbStrategy
 	} else {
-		l.Info("no OpenAI API key — using rule-based strategy only")
+		l.Info("no agent API key — using rule-based strategy only")
 	}
 
 	return &ComputerOpponentDecisionEngine{
@@ -149,6 +155,13 @@ func (e *ComputerOpponentDecisionEngine) buildGameStateContext(
 		ownMechIDs[m.ID] = true
 	}
 
+	// The squad only knows about the enemy mechs its own sensors detect
+	detection, err := e.domain.GetMechaGameDetection(gameInstanceID)
+	if err != nil {
+		l.Warn("failed to get detection: %v", err)
+		return nil, fmt.Errorf("failed to get detection: %w", err)
+	}
+
 	var enemyMechs []*mechState
 	for _, m := range allMechs {
 		if ownMechIDs[m.ID] {
@@ -157,6 +170,9 @@ func (e *ComputerOpponentDecisionEngine) buildGameStateContext(
 		if m.Status == mecha_game_record.MechInstanceStatusDestroyed {
 			continue
 		}
+		if !detection.IsDetected(squadInstance.ID, m.ID) {
+			continue
+		}
 		sectorInst, err := e.domain.GetMechaGameSectorInstanceRec(m.MechaGameSectorInstanceID, nil)
 		if err != nil {
 			continue
@@ -164,6 +180,20 @@ func (e *ComputerOpponentDecisionEngine) buildGameStateContext(
 		enemyMechs = append(enemyMechs, &mechState{Instance: m, Sector: sectorInst})
 	}
 
+	contacts, err := domain.GetMechaGameSquadContacts(squadInstance)
+	if err != nil {
+		l.Warn("failed to read contacts for squad instance >%s<: %v", squadInstance.ID, err)
+		contacts = nil
+	}
+
+	var enemyContacts []mecha_game_record.MechaGameContact
+	for _, contact := range contacts {
+		if detection.IsDetected(squadInstance.ID, contact.MechInstanceID) {
+			continue
+		}
+		enemyContacts = append(enemyContacts, contact)
+	}
+
 	// Sector graph
 	sectorInstances, err := e.domain.GetManyMechaGameSectorInstanceRecs(&coresql.Options{
 		Params: []coresql.Param{
@@ -246,14 +276,22 @@ func (e *ComputerOpponentDecisionEngine) buildGameStateContext(
 		)
 	}
 
+	ruleset, err := e.domain.GetMechaGameRuleset(gameInstanceID)
+	if err != nil {
+		l.Warn("failed to get ruleset for game instance >%s< >%v<", gameInstanceID, err)
+		return nil, fmt.Errorf("failed to get ruleset: %w", err)
+	}
+
 	return &GameStateContext{
 		Opponent:        opponentRec,
 		SquadInstance:   squadInstance,
 		OwnMechs:        ownMechs,
 		EnemyMechs:      enemyMechs,
+		EnemyContacts:   enemyContacts,
 		Sectors:         sectors,
 		ChassisCache:    chassisCache,
 		EffectsByMechID: effectsByMechID,
+		Ruleset:         ruleset,
 		TurnNumber:      turnNumber,
 	}, nil
 }
@@ -103,6 +103,14 @@ func (s *llmStrategy) buildGameStatePrompt(state *GameStateContext) string {
 		}
 	}
 
+	if len(state.EnemyContacts) > 0 {
+		sb.WriteString("\nEnemy mechs out of sensor range (last known positions, cannot be attacked):\n")
+		for _, contact := range state.EnemyContacts {
+			fmt.Fprintf(&sb, "  - Callsign: %s, Last seen: %s (sector_instance_id: %s) on turn %d\n",
+				contact.Callsign, contact.SectorName, contact.SectorInstanceID, contact.LastSeenTurn)
+		}
+	}
+
 	sb.WriteString("\nAvailable sectors (movement graph):\n")
 	for _, sec := range state.Sectors {
 		var linkNames []string
@@ -114,11 +122,13 @@ func (s *llmStrategy) buildGameStatePrompt(state *GameStateContext) string {
 				}
 			}
 		}
-		fmt.Fprintf(&sb, "  - %s (id: %s, terrain: %s, elevation: %d) → [%s]\n",
-			sec.Design.Name, sec.Instance.ID, sec.Design.TerrainType, sec.Design.Elevation,
-			strings.Join(linkNames, ", "))
+		fmt.Fprintf(&sb, "  - %s (id: %s, terrain: %s, movement cost: %d, elevation: %d) → [%s]\n",
+			sec.Design.Name, sec.Instance.ID, sec.Design.TerrainType, state.Ruleset.MovementCost(sec.Design.TerrainType),
+			sec.Design.Elevation, strings.Join(linkNames, ", "))
 	}
 
+	writeObjectivesPrompt(&sb, state)
+
 	sb.WriteString(`
 Return your orders as JSON with this exact structure:
 {
@@ -126,14 +136,24 @@ Return your orders as JSON with this exact structure:
     {
       "mech_instance_id": "<exact mech ID from above>",
       "move_to_sector_instance_id": "<exact sector_instance_id to move to, or empty string to stay>",
-      "attack_target_mech_instance_id": "<exact enemy mech ID to attack, or empty string for no attack>"
+      "attack_target_mech_instance_id": "<exact enemy mech ID to attack, or empty string for no attack>",
+      "hold_after_move": <true to hold the destination on overwatch after moving, otherwise false>,
+      "overwatch_sector_instance_id": "<exact sector_instance_id of the mech's own sector or a linked sector to cover instead of moving, or empty string>",
+      "intercept_target_mech_instance_id": "<exact enemy mech ID to move towards instead of a chosen sector, or empty string>"
     }
   ]
 }
 Include one entry per mech. Use exact IDs from above.
-Each mech can move up to its Speed number of connected sector hops per turn (follow the movement graph).
+Each mech has its Speed in movement points per turn; entering a sector costs that sector's movement cost (follow the movement graph).
+Only enemy mechs listed as targets can be attacked.
 Attack targets must be within weapon range after movement (short-range: same sector, medium-range: same or adjacent, long-range: adjacent or 2 sectors away).
-Respond with JSON only — no markdown, no commentary.`)
+A mech on overwatch fires at the first enemy to enter the sector it covers during movement instead of making its declared attack.
+Overwatch cannot be combined with a move; use hold_after_move to cover the destination instead.
+An intercepting mech moves towards wherever its target ends its move and attacks it; intercept cannot be combined with a move or overwatch.
+`)
+	fmt.Fprintf(&sb, "Forest and urban sectors block fire beyond %d sector hops, whether the attacker or the target stands in them.\n", state.Ruleset.ObstructedFireRange)
+	fmt.Fprintf(&sb, "Mechs standing in water shed %d extra heat at end of turn.\n", state.Ruleset.WaterHeatDissipationBonus)
+	sb.WriteString(`Respond with JSON only — no markdown, no commentary.`)
 
 	return sb.String()
 }
@@ -166,3 +186,32 @@ func extractJSONFromResponse(resp string) string {
 
 	return resp[start : end+1]
 }
+
+// writeObjectivesPrompt lists the objective sectors, who owns each, and the
+// squad's victory points so the model can weigh capturing objectives.
+func writeObjectivesPrompt(sb *strings.Builder, state *GameStateContext) {
+	var lines []string
+	for _, sec := range state.Sectors {
+		if sec.Design == nil || !sec.Design.IsObjectiveSector {
+			continue
+		}
+		owner := "uncaptured"
+		if sec.Instance.OwningSquadInstanceID.Valid {
+			owner = "owned by an enemy squad"
+			if sec.Instance.OwningSquadInstanceID.String == state.SquadInstance.ID {
+				owner = "owned by your squad"
+			}
+		}
+		lines = append(lines, fmt.Sprintf("  - %s (id: %s, %d victory points per turn, captured after %d turns alone in the sector): %s\n",
+			sec.Design.Name, sec.Instance.ID, sec.Design.VictoryPoints, max(sec.Design.CaptureTurns, 1), owner))
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	fmt.Fprintf(sb, "\nObjective sectors (your squad has %d victory points; the owner scores each objective every turn):\n",
+		state.SquadInstance.VictoryPoints)
+	for _, line := range lines {
+		sb.WriteString(line)
+	}
+}
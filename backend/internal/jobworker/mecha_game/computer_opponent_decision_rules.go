@@ -3,8 +3,10 @@ package mecha_game
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
 )
@@ -45,11 +47,30 @@ func (s *ruleBasedStrategy) GenerateOrders(ctx context.Context, l logger.Logger,
 			postMoveSectorID = mech.MechaGameSectorInstanceID
 		}
 		attackTargetID := s.pickAttackTarget(opp, postMoveSectorID, mech, state)
-		orders = append(orders, turnsheet.ScannedMechOrder{
+		order := turnsheet.ScannedMechOrder{
 			MechInstanceID:             mech.ID,
 			MoveToSectorInstanceID:     targetSectorInstanceID,
 			AttackTargetMechInstanceID: attackTargetID,
-		})
+		}
+
+		switch {
+		case attackTargetID == "" && opp.Aggression >= 7 && opp.IQ >= 5:
+			// An aggressive, clever opponent with nothing to shoot at runs
+			// down the nearest detected enemy wherever it moves to
+			if interceptTargetID := s.pickInterceptTarget(mech.MechaGameSectorInstanceID, state); interceptTargetID != "" {
+				order.MoveToSectorInstanceID = ""
+				order.InterceptTargetMechInstanceID = interceptTargetID
+			}
+		case targetSectorInstanceID != "" && opp.Aggression <= 3 && opp.IQ >= 5:
+			// A cautious, clever opponent falling back to cover holds it
+			order.HoldAfterMove = true
+		case targetSectorInstanceID == "" && attackTargetID == "":
+			// A mech holding position with nothing to shoot at covers the
+			// approach from the nearest enemy
+			order.OverwatchSectorInstanceID = s.pickOverwatchSector(mech.MechaGameSectorInstanceID, state)
+		}
+
+		orders = append(orders, order)
 	}
 
 	l.Info("rule-based strategy generated %d mech orders for opponent %s", len(orders), opp.Name)
@@ -62,8 +83,9 @@ func (s *ruleBasedStrategy) GenerateOrders(ctx context.Context, l logger.Logger,
 // Uses max range 2 (long-range weapon reach) as the engagement envelope.
 // Combat resolution will only fire weapons that can actually reach the target.
 //
-// The attacker's targeting-computer HitChanceBonus and the defender's ECM
-// CoverBonus are used purely as tie-breakers between otherwise equally
+// The attacker's estimated hit chance, from the run's ruleset, the pilot's
+// skill, the targeting-computer HitChanceBonus and the defender's ECM
+// CoverBonus, is used purely as a tie-breaker between otherwise equally
 // attractive targets (same CurrentStructure bucket). This keeps the AI
 // honest about the same modifiers the combat resolver applies, without
 // overhauling the aggression-driven primary ranking.
@@ -90,20 +112,22 @@ func (s *ruleBasedStrategy) pickAttackTarget(
 		return ""
 	}
 
+	attackerSkill := 0
 	attackerHitBonus := 0
 	if attacker != nil {
+		attackerSkill = attacker.PilotSkill
 		attackerHitBonus = state.EffectsByMechID[attacker.ID].HitChanceBonus
 	}
 
 	// Primary ranking: aggression-driven structure bucket
 	// (high aggression → weakest; low aggression → strongest).
-	// Tie-break: prefer targets with the smaller *effective* cover
+	// Tie-break: prefer targets with the higher estimated hit chance
 	// (chassis-level cover is not tracked per mech; we use ECM CoverBonus
 	// as the per-mech cover signal, adjusted by the attacker's hit bonus).
 	best := candidates[0]
-	bestScore := targetScore(opp, best, attackerHitBonus, state)
+	bestScore := targetScore(opp, best, attackerSkill, attackerHitBonus, state)
 	for _, em := range candidates[1:] {
-		score := targetScore(opp, em, attackerHitBonus, state)
+		score := targetScore(opp, em, attackerSkill, attackerHitBonus, state)
 		if score > bestScore {
 			best = em
 			bestScore = score
@@ -115,30 +139,30 @@ func (s *ruleBasedStrategy) pickAttackTarget(
 
 // targetScore produces a single comparable score that orders candidates by
 // the primary aggression criterion (structure) and breaks ties using the
-// defender's ECM cover bonus net of the attacker's targeting-computer hit
-// bonus. Higher score == more attractive target.
+// attacker's estimated hit chance against the defender. Higher score == more
+// attractive target.
 func targetScore(
 	opp *mecha_game_record.MechaGameComputerOpponent,
 	em *mechState,
+	attackerSkill int,
 	attackerHitBonus int,
 	state *GameStateContext,
 ) int {
 	defenderCover := state.EffectsByMechID[em.Instance.ID].CoverBonus
-	// netCover is how hard the defender is to hit after accounting for
-	// the attacker's targeting advantage. Larger positive values mean a
-	// harder-to-hit target (less attractive); larger negative values mean
-	// an easier target (more attractive). We flip the sign so the score
-	// increases as the target becomes easier.
-	netCover := attackerHitBonus - defenderCover
+	// hitEstimate is how likely the attacker is to hit after accounting for
+	// its pilot skill and targeting advantage and the defender's ECM cover.
+	// The ruleset caps the estimate so bonuses beyond the maximum hit chance
+	// do not make a target more attractive.
+	hitEstimate := state.Ruleset.HitChance(attackerSkill, attackerHitBonus, -defenderCover)
 	structure := em.Instance.CurrentStructure
 	// High aggression: prefer low structure (bigger primary term = more
 	// attractive when structure is low). Encode as -structure * 1000 so
 	// the primary signal dominates the tie-breaker.
 	// Low aggression: prefer high structure (opposite sign).
 	if opp.Aggression >= 6 {
-		return -structure*1000 + netCover
+		return -structure*1000 + hitEstimate
 	}
-	return structure*1000 + netCover
+	return structure*1000 + hitEstimate
 }
 
 // sectorDistance returns the BFS distance between two sector instance IDs
@@ -177,6 +201,55 @@ func (s *ruleBasedStrategy) sectorDistance(fromID, toID string, state *GameState
 	return 999
 }
 
+// pickInterceptTarget returns the ID of the detected enemy mech nearest the
+// given sector, or empty string when no enemy is detected.
+func (s *ruleBasedStrategy) pickInterceptTarget(fromSectorID string, state *GameStateContext) string {
+	bestID := ""
+	bestDist := 0
+	for _, em := range state.EnemyMechs {
+		if em.Instance.Status == mecha_game_record.MechInstanceStatusDestroyed {
+			continue
+		}
+		dist := s.sectorDistance(fromSectorID, em.Instance.MechaGameSectorInstanceID, state)
+		if bestID == "" || dist < bestDist || (dist == bestDist && em.Instance.ID < bestID) {
+			bestID = em.Instance.ID
+			bestDist = dist
+		}
+	}
+	return bestID
+}
+
+// pickOverwatchSector returns the sector a mech holding position should cover:
+// of its own sector and the sectors linked to it, the one closest to the
+// nearest detected enemy or last known contact. Returns empty string when
+// there is no enemy to watch for.
+func (s *ruleBasedStrategy) pickOverwatchSector(fromSectorID string, state *GameStateContext) string {
+	enemySectorID := s.findNearestEnemy(fromSectorID, state)
+	if enemySectorID == "" {
+		return ""
+	}
+
+	candidates := []string{fromSectorID}
+	for _, sec := range state.Sectors {
+		if sec.Instance.ID == fromSectorID {
+			candidates = append(candidates, sec.LinkDestInstanceIDs...)
+			break
+		}
+	}
+	sort.Strings(candidates[1:])
+
+	best := ""
+	bestDist := 0
+	for _, candID := range candidates {
+		dist := s.sectorDistance(candID, enemySectorID, state)
+		if best == "" || dist < bestDist {
+			best = candID
+			bestDist = dist
+		}
+	}
+	return best
+}
+
 // pickMovementTarget returns the sector instance ID the mech should move to, or
 // empty string to stay in place. Considers the mech's speed for multi-hop movement.
 func (s *ruleBasedStrategy) pickMovementTarget(_ logger.Logger, opp *mecha_game_record.MechaGameComputerOpponent, mech *mecha_game_record.MechaGameMechInstance, mechSpeed int, state *GameStateContext) string {
@@ -185,8 +258,9 @@ func (s *ruleBasedStrategy) pickMovementTarget(_ logger.Logger, opp *mecha_game_
 		return ""
 	}
 
-	if opp.Aggression >= 7 && len(state.EnemyMechs) > 0 {
-		// Advance toward nearest enemy
+	if opp.Aggression >= 7 && (len(state.EnemyMechs) > 0 || len(state.EnemyContacts) > 0) {
+		// Advance toward nearest enemy, hunting last known positions when
+		// no enemy is in sensor range
 		nearestEnemySectorID := s.findNearestEnemy(mech.MechaGameSectorInstanceID, state)
 		if nearestEnemySectorID != "" {
 			best := s.pickBestAdvanceStep(mech.MechaGameSectorInstanceID, nearestEnemySectorID, reachableIDs, opp.IQ, state)
@@ -195,61 +269,111 @@ func (s *ruleBasedStrategy) pickMovementTarget(_ logger.Logger, opp *mecha_game_
 			}
 		}
 	} else if opp.Aggression <= 3 {
-		// Retreat to starting / best-covered sector
-		best := s.pickBestDefensiveStep(reachableIDs, opp.IQ, state)
-		if best != "" {
-			return best
+		// Hold an objective being captured rather than fall back
+		if s.isUncapturedObjective(mech.MechaGameSectorInstanceID, state) {
+			return ""
 		}
+		// Retreat to starting / best-covered sector
+		return s.pickBestDefensiveStep(reachableIDs, opp.IQ, state)
+	}
+
+	// Stay on an objective the squad does not own yet to capture it,
+	// otherwise advance toward the most valuable one
+	if s.isUncapturedObjective(mech.MechaGameSectorInstanceID, state) {
+		return ""
+	}
+	if objectiveID := s.pickObjectiveTarget(mech.MechaGameSectorInstanceID, opp.IQ, state); objectiveID != "" {
+		return s.pickBestAdvanceStep(mech.MechaGameSectorInstanceID, objectiveID, reachableIDs, opp.IQ, state)
 	}
 
 	// Hold — no movement
 	return ""
 }
 
+// isUncapturedObjective reports whether the sector is an objective the squad
+// does not own.
+func (s *ruleBasedStrategy) isUncapturedObjective(sectorID string, state *GameStateContext) bool {
+	for _, sec := range state.Sectors {
+		if sec.Instance.ID == sectorID {
+			return sec.Design != nil && sec.Design.IsObjectiveSector &&
+				sec.Instance.OwningSquadInstanceID.String != state.SquadInstance.ID
+		}
+	}
+	return false
+}
+
+// pickObjectiveTarget returns the objective sector the squad does not own
+// that is worth the most victory points for the hops needed to reach it.
+// Low-IQ opponents ignore victory points and head for the nearest objective.
+// Returns empty string when there is no such objective within reach of the
+// sector links.
+func (s *ruleBasedStrategy) pickObjectiveTarget(fromSectorID string, iq int, state *GameStateContext) string {
+	links := make(map[string][]string, len(state.Sectors))
+	for _, sec := range state.Sectors {
+		links[sec.Instance.ID] = sec.LinkDestInstanceIDs
+	}
+	hops := domain.MechaGameMovementCosts(fromSectorID, len(state.Sectors), links, func(string) int { return 1 })
+
+	bestID := ""
+	bestValue, bestHops := 0, 0
+	for _, sec := range state.Sectors {
+		dist, ok := hops[sec.Instance.ID]
+		if !ok || !s.isUncapturedObjective(sec.Instance.ID, state) {
+			continue
+		}
+		// Objectives without victory points still count towards holding an
+		// objective to win the game
+		value := 1
+		if iq >= 5 {
+			value = max(sec.Design.VictoryPoints, 1)
+		}
+		// Compare value per hop without dividing: value/dist > bestValue/bestHops
+		better := value*bestHops - bestValue*dist
+		if bestID == "" || better > 0 || (better == 0 && sec.Instance.ID < bestID) {
+			bestID = sec.Instance.ID
+			bestValue = value
+			bestHops = dist
+		}
+	}
+
+	return bestID
+}
+
 // getReachableSectorIDs returns all sector instance IDs reachable within the given
-// number of hops from the given sector, using BFS over the sector graph.
+// speed in movement points from the given sector, where entering a sector costs
+// the run's movement cost for its terrain.
 func (s *ruleBasedStrategy) getReachableSectorIDs(fromSectorID string, speed int, state *GameStateContext) []string {
 	if speed <= 0 {
 		return nil
 	}
 
-	type bfsNode struct {
-		id    string
-		depth int
+	links := make(map[string][]string, len(state.Sectors))
+	terrainTypes := make(map[string]string, len(state.Sectors))
+	for _, sec := range state.Sectors {
+		links[sec.Instance.ID] = sec.LinkDestInstanceIDs
+		if sec.Design != nil {
+			terrainTypes[sec.Instance.ID] = sec.Design.TerrainType
+		}
 	}
 
-	seen := map[string]bool{fromSectorID: true}
-	queue := []bfsNode{{id: fromSectorID, depth: 0}}
-	var reachable []string
+	costs := domain.MechaGameMovementCosts(fromSectorID, speed, links, func(sectorInstanceID string) int {
+		return state.Ruleset.MovementCost(terrainTypes[sectorInstanceID])
+	})
 
-	for len(queue) > 0 {
-		cur := queue[0]
-		queue = queue[1:]
-
-		if cur.depth >= speed {
-			continue
-		}
-
-		for _, sec := range state.Sectors {
-			if sec.Instance.ID != cur.id {
-				continue
-			}
-			for _, destID := range sec.LinkDestInstanceIDs {
-				if seen[destID] {
-					continue
-				}
-				seen[destID] = true
-				reachable = append(reachable, destID)
-				queue = append(queue, bfsNode{id: destID, depth: cur.depth + 1})
-			}
-		}
+	reachable := make([]string, 0, len(costs))
+	for sectorInstanceID := range costs {
+		reachable = append(reachable, sectorInstanceID)
 	}
+	// Candidates are scored in order, so keep ties deterministic
+	sort.Strings(reachable)
 
 	return reachable
 }
 
-// findNearestEnemy returns the sector instance ID of the nearest enemy mech using
-// actual BFS distance through the sector graph. Returns empty string if no enemies.
+// findNearestEnemy returns the sector instance ID of the nearest detected enemy
+// mech, or of the nearest last known contact position when no enemy is
+// detected, using actual BFS distance through the sector graph. Returns empty
+// string if no enemies.
 func (s *ruleBasedStrategy) findNearestEnemy(fromSectorID string, state *GameStateContext) string {
 	enemySectorIDs := make(map[string]bool)
 	for _, em := range state.EnemyMechs {
@@ -257,6 +381,14 @@ func (s *ruleBasedStrategy) findNearestEnemy(fromSectorID string, state *GameSta
 			enemySectorIDs[em.Sector.ID] = true
 		}
 	}
+	if len(enemySectorIDs) == 0 {
+		for _, contact := range state.EnemyContacts {
+			// A contact last seen where the mech stands has moved on
+			if contact.SectorInstanceID != fromSectorID {
+				enemySectorIDs[contact.SectorInstanceID] = true
+			}
+		}
+	}
 	if len(enemySectorIDs) == 0 {
 		return ""
 	}
@@ -0,0 +1,191 @@
+package mecha_game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+// Tests for the objective rules of the rule-based strategy. Test sectors are
+// open terrain linked in a line in the order given.
+
+type objectiveTestSector struct {
+	id            string
+	objective     bool
+	victoryPoints int
+	owner         string
+}
+
+func newObjectiveTestState(t *testing.T, sectors []objectiveTestSector) *GameStateContext {
+	t.Helper()
+
+	ruleset, err := domain.DefaultMechaGameRuleset()
+	require.NoError(t, err, "DefaultMechaGameRuleset returns without error")
+
+	state := &GameStateContext{
+		SquadInstance: &mecha_game_record.MechaGameSquadInstance{},
+		Ruleset:       ruleset,
+	}
+	state.SquadInstance.ID = "own"
+
+	for i, sec := range sectors {
+		inst := &mecha_game_record.MechaGameSectorInstance{OwningSquadInstanceID: nullstring.FromString(sec.owner)}
+		inst.ID = sec.id
+		var links []string
+		if i > 0 {
+			links = append(links, sectors[i-1].id)
+		}
+		if i < len(sectors)-1 {
+			links = append(links, sectors[i+1].id)
+		}
+		state.Sectors = append(state.Sectors, &sectorState{
+			Instance: inst,
+			Design: &mecha_game_record.MechaGameSector{
+				Name:              sec.id,
+				TerrainType:       mecha_game_record.SectorTerrainTypeOpen,
+				IsObjectiveSector: sec.objective,
+				VictoryPoints:     sec.victoryPoints,
+				CaptureTurns:      1,
+			},
+			LinkDestInstanceIDs: links,
+		})
+	}
+
+	return state
+}
+
+func TestPickObjectiveTarget(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		sectors []objectiveTestSector
+		iq      int
+		want    string
+	}{
+		{
+			name: "high IQ with a richer objective further away then picks the better value per hop",
+			sectors: []objectiveTestSector{
+				{id: "A"},
+				{id: "B", objective: true, victoryPoints: 1},
+				{id: "C"},
+				{id: "D", objective: true, victoryPoints: 5},
+			},
+			iq:   7,
+			want: "D",
+		},
+		{
+			name: "low IQ with a richer objective further away then picks the nearest objective",
+			sectors: []objectiveTestSector{
+				{id: "A"},
+				{id: "B", objective: true, victoryPoints: 1},
+				{id: "C"},
+				{id: "D", objective: true, victoryPoints: 5},
+			},
+			iq:   3,
+			want: "B",
+		},
+		{
+			name: "nearest objective already owned by the squad then picks the next one",
+			sectors: []objectiveTestSector{
+				{id: "A"},
+				{id: "B", objective: true, victoryPoints: 3, owner: "own"},
+				{id: "C", objective: true, victoryPoints: 1, owner: "enemy"},
+			},
+			iq:   7,
+			want: "C",
+		},
+		{
+			name: "every objective owned by the squad then picks nothing",
+			sectors: []objectiveTestSector{
+				{id: "A"},
+				{id: "B", objective: true, victoryPoints: 3, owner: "own"},
+			},
+			iq:   7,
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &ruleBasedStrategy{}
+			state := newObjectiveTestState(t, tt.sectors)
+			require.Equal(t, tt.want, s.pickObjectiveTarget("A", tt.iq, state), "objective target should match")
+		})
+	}
+}
+
+func TestPickMovementTarget_Objectives(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		sectors    []objectiveTestSector
+		aggression int
+		mechSector string
+		want       string
+	}{
+		{
+			name: "balanced opponent with an uncaptured objective in reach then advances on it",
+			sectors: []objectiveTestSector{
+				{id: "A"},
+				{id: "B"},
+				{id: "C", objective: true, victoryPoints: 2},
+			},
+			aggression: 5,
+			mechSector: "A",
+			want:       "C",
+		},
+		{
+			name: "balanced opponent standing on an uncaptured objective then holds to capture it",
+			sectors: []objectiveTestSector{
+				{id: "A", objective: true, victoryPoints: 2},
+				{id: "B"},
+				{id: "C", objective: true, victoryPoints: 5},
+			},
+			aggression: 5,
+			mechSector: "A",
+			want:       "",
+		},
+		{
+			name: "cautious opponent standing on an uncaptured objective then holds instead of falling back",
+			sectors: []objectiveTestSector{
+				{id: "A", objective: true, victoryPoints: 2},
+				{id: "B"},
+			},
+			aggression: 2,
+			mechSector: "A",
+			want:       "",
+		},
+		{
+			name: "balanced opponent with every objective owned then holds",
+			sectors: []objectiveTestSector{
+				{id: "A"},
+				{id: "B", objective: true, victoryPoints: 2, owner: "own"},
+			},
+			aggression: 5,
+			mechSector: "A",
+			want:       "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &ruleBasedStrategy{}
+			state := newObjectiveTestState(t, tt.sectors)
+			opp := &mecha_game_record.MechaGameComputerOpponent{Aggression: tt.aggression, IQ: 3}
+			mech := &mecha_game_record.MechaGameMechInstance{MechaGameSectorInstanceID: tt.mechSector}
+
+			got := s.pickMovementTarget(nil, opp, mech, 4, state)
+			require.Equal(t, tt.want, got, "movement target should match")
+		})
+	}
+}
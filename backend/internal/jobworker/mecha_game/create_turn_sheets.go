@@ -27,9 +27,21 @@ func (p *MechaGame) CreateTurnSheets(ctx context.Context, gameInstanceRec *game_
 		return nil, nil
 	}
 
+	droppedIDs, err := p.getDroppedGameSubscriptionInstanceIDs(gameInstanceRec)
+	if err != nil {
+		l.Warn("failed to get dropped players for game instance >%s< error >%v<", gameInstanceRec.ID, err)
+		return nil, err
+	}
+
 	var errs []error
 	var createdTurnSheets []*game_record.GameTurnSheet
 	for _, squadInstanceRec := range squadInstanceRecs {
+		// Players dropped for missing too many turns receive no further turn sheets.
+		if squadInstanceRec.GameSubscriptionInstanceID.Valid && droppedIDs[squadInstanceRec.GameSubscriptionInstanceID.String] {
+			l.Info("skipping turn sheet creation for dropped player squad instance >%s<", squadInstanceRec.ID)
+			continue
+		}
+
 		squadTurnSheets, err := p.createSquadTurnSheets(ctx, gameInstanceRec, squadInstanceRec)
 		if err != nil {
 			l.Warn("failed to create turn sheets for squad >%s< error >%v<", squadInstanceRec.ID, err)
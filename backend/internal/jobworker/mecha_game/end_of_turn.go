@@ -2,40 +2,37 @@ package mecha_game
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
-	"math"
 
+	"gitlab.com/alienspaces/playbymail/core/nullint64"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
 	coresql "gitlab.com/alienspaces/playbymail/core/sql"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
 )
 
-const (
-	supplyPointsPerTurn  = 2
-	heatDissipationDenom = 3 // dissipate HeatCapacity / 3 per turn
-	autoRepairPercent    = 25
-)
-
-// pilotSkillThresholds maps pilot skill level (index) to the total XP required.
-// pilotSkillThresholds[skill] = minimum total XP to reach that skill level.
-var pilotSkillThresholds = []int{0, 3, 8, 15, 24, 35, 48, 63, 80, 99}
-
 // runEndOfTurn runs the end-of-turn lifecycle for all squads in a game instance:
-//  1. Heat dissipation per mech
+//  1. Heat dissipation per mech, including terrain cooling
 //  2. Auto-repair armor (field repairs)
 //  3. XP application and pilot skill level-up
 //  4. Complete refits (apply queued changes, clear is_refitting)
-//  5. Supply point accrual for player squads
-//  6. Append lifecycle TurnEvents to each squad instance
+//  5. Objective sector control, capture and victory points
+//  6. Sensor contact refresh for each squad
+//  7. Supply point accrual for player squads
+//  8. Append lifecycle TurnEvents to each squad instance
 //
+// Supply, heat, repair and pilot skill values come from the run's ruleset.
 // xpMap is the XP earned by each mech this turn (mech instance ID → XP). May be nil.
 func (p *MechaGame) runEndOfTurn(
 	_ context.Context,
 	l logger.Logger,
 	gameInstanceRec *game_record.GameInstance,
+	ruleset domain.MechaGameRuleset,
 	xpMap map[string]int,
 ) error {
 	l = l.WithFunctionContext("MechaGame/runEndOfTurn")
@@ -58,6 +55,12 @@ func (p *MechaGame) runEndOfTurn(
 		return fmt.Errorf("failed to load squad instances: %w", err)
 	}
 
+	// Terrain where each mech stands affects heat dissipation
+	sectorMap, err := p.Domain.GetMechaGameSectorMap(gameInstanceRec.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load sector map: %w", err)
+	}
+
 	// Events keyed by squad instance ID
 	eventsBySquad := make(map[string][]turnsheet.TurnEvent)
 
@@ -106,16 +109,18 @@ func (p *MechaGame) runEndOfTurn(
 		}
 
 		// 1. Heat dissipation — chassis baseline plus heat-sink bonus
-		// (zeroed for refitting mechs by AggregateEffects). Applied after
-		// the always-on heat accumulation above so the same turn's upkeep
-		// and dissipation net out as a single movement.
-		dissipate := chassisRec.HeatCapacity/heatDissipationDenom + effects.HeatDissipationBonus
+		// (zeroed for refitting mechs by AggregateEffects) plus any terrain
+		// cooling where the mech stands. Applied after the always-on heat
+		// accumulation above so the same turn's upkeep and dissipation net
+		// out as a single movement.
+		dissipate := ruleset.HeatDissipation(chassisRec.HeatCapacity) + effects.HeatDissipationBonus +
+			ruleset.TerrainHeatDissipation(sectorMap.TerrainTypes[inst.MechaGameSectorInstanceID])
 		if inst.Status == mecha_game_record.MechInstanceStatusShutdown {
 			// Shutdown resets heat and brings mech back online
 			inst.CurrentHeat = 0
 			inst.Status = mecha_game_record.MechInstanceStatusOperational
 			appendLifecycleEvent(eventsBySquad, inst.MechaGameSquadInstanceID,
-				fmt.Sprintf("%s emergency shutdown complete — back online.", inst.Callsign))
+				"event.mecha.shutdown_complete", "mech", inst.Callsign)
 		} else {
 			prev := inst.CurrentHeat
 			inst.CurrentHeat -= dissipate
@@ -124,15 +129,15 @@ func (p *MechaGame) runEndOfTurn(
 			}
 			if prev > 0 && inst.CurrentHeat < prev {
 				appendLifecycleEvent(eventsBySquad, inst.MechaGameSquadInstanceID,
-					fmt.Sprintf("%s heat dissipated from %d to %d.", inst.Callsign, prev, inst.CurrentHeat))
+					"event.mecha.heat_dissipated", "mech", inst.Callsign, "from", prev, "to", inst.CurrentHeat)
 			}
 		}
 
 		// 2. Field auto-repair (armor only, no structure). Ceiling and the
-		// 25% repair base both use effectiveMaxArmor so armor-upgrade
+		// auto-repair percentage base both use effectiveMaxArmor so armor-upgrade
 		// magnitude is honored consistently.
 		if !inst.IsRefitting && inst.CurrentArmor < effectiveMaxArmor {
-			repairAmt := int(math.Ceil(float64(effectiveMaxArmor) * autoRepairPercent / 100))
+			repairAmt := ruleset.AutoRepairAmount(effectiveMaxArmor)
 			prevArmor := inst.CurrentArmor
 			inst.CurrentArmor += repairAmt
 			if inst.CurrentArmor > effectiveMaxArmor {
@@ -143,8 +148,8 @@ func (p *MechaGame) runEndOfTurn(
 					inst.Status = mecha_game_record.MechInstanceStatusOperational
 				}
 				appendLifecycleEvent(eventsBySquad, inst.MechaGameSquadInstanceID,
-					fmt.Sprintf("%s field repairs restored %d armor (%d/%d).",
-						inst.Callsign, inst.CurrentArmor-prevArmor, inst.CurrentArmor, effectiveMaxArmor))
+					"event.mecha.field_repairs", "mech", inst.Callsign, "armor", inst.CurrentArmor-prevArmor,
+					"current", inst.CurrentArmor, "max", effectiveMaxArmor)
 			}
 		}
 
@@ -159,14 +164,11 @@ func (p *MechaGame) runEndOfTurn(
 		if xpMap != nil {
 			if earned := xpMap[inst.ID]; earned > 0 {
 				inst.ExperiencePoints += earned
-				for nextSkill := inst.PilotSkill + 1; nextSkill < len(pilotSkillThresholds); nextSkill++ {
-					if inst.ExperiencePoints >= pilotSkillThresholds[nextSkill] {
-						inst.PilotSkill = nextSkill
-						appendLifecycleEvent(eventsBySquad, inst.MechaGameSquadInstanceID,
-							fmt.Sprintf("%s pilot skill increased to %d!", inst.Callsign, inst.PilotSkill))
-					} else {
-						break
-					}
+				newSkill := ruleset.PilotSkillLevel(inst.PilotSkill, inst.ExperiencePoints)
+				for inst.PilotSkill < newSkill {
+					inst.PilotSkill++
+					appendLifecycleEvent(eventsBySquad, inst.MechaGameSquadInstanceID,
+						"event.mecha.pilot_skill_increased", "mech", inst.Callsign, "skill", inst.PilotSkill)
 				}
 			}
 		}
@@ -176,7 +178,7 @@ func (p *MechaGame) runEndOfTurn(
 		if inst.IsRefitting {
 			inst.IsRefitting = false
 			appendLifecycleEvent(eventsBySquad, inst.MechaGameSquadInstanceID,
-				fmt.Sprintf("%s refit complete.", inst.Callsign))
+				"event.mecha.refit_complete", "mech", inst.Callsign)
 		}
 
 		if _, err := p.Domain.UpdateMechaGameMechInstanceRec(inst); err != nil {
@@ -184,14 +186,23 @@ func (p *MechaGame) runEndOfTurn(
 		}
 	}
 
-	// 5. Supply point accrual for player squads + persist events
+	// 5. Objective sector control, capture and victory points
+	if err := p.updateObjectiveControl(l, gameInstanceRec, allSquadInsts, allMechInsts, eventsBySquad); err != nil {
+		l.Warn("failed to update objective sector control: %v", err)
+	}
+
+	// 6. Sensor contacts, refreshed after heat so shutdown mechs see nothing
+	if err := p.updateSquadContacts(l, gameInstanceRec, allSquadInsts, allMechInsts, eventsBySquad); err != nil {
+		l.Warn("failed to update squad contacts: %v", err)
+	}
+
+	// 7. Supply point accrual for player squads + persist events
 	for _, squadInst := range allSquadInsts {
 		// Only player-owned squads accrue supply points
 		if squadInst.GameSubscriptionInstanceID.Valid {
-			squadInst.SupplyPoints += supplyPointsPerTurn
+			squadInst.SupplyPoints += ruleset.SupplyPointsPerTurn
 			appendLifecycleEvent(eventsBySquad, squadInst.ID,
-				fmt.Sprintf("Squad received %d supply points (%d total).",
-					supplyPointsPerTurn, squadInst.SupplyPoints))
+				"event.mecha.supply_points", "points", ruleset.SupplyPointsPerTurn, "total", squadInst.SupplyPoints)
 		}
 
 		for _, evt := range eventsBySquad[squadInst.ID] {
@@ -208,6 +219,242 @@ func (p *MechaGame) runEndOfTurn(
 	return nil
 }
 
+// updateObjectiveControl records which squad controls each objective sector. A
+// squad controls an objective while it is the only squad with mechs standing in
+// the sector; control is kept from the turn it was taken until another squad
+// contests the sector or the controlling squad leaves it. A squad that controls
+// an objective for the sector's capture turns captures it, and the owning squad
+// scores the sector's victory points every turn until another squad captures
+// it. Victory points are added to the squad instance records, which the caller
+// persists.
+func (p *MechaGame) updateObjectiveControl(
+	l logger.Logger,
+	gameInstanceRec *game_record.GameInstance,
+	allSquadInsts []*mecha_game_record.MechaGameSquadInstance,
+	allMechInsts []*mecha_game_record.MechaGameMechInstance,
+	eventsBySquad map[string][]turnsheet.TurnEvent,
+) error {
+	sectorInsts, err := p.Domain.GetManyMechaGameSectorInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameSectorInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load sector instances: %w", err)
+	}
+
+	squadInstsByID := make(map[string]*mecha_game_record.MechaGameSquadInstance, len(allSquadInsts))
+	for _, squadInst := range allSquadInsts {
+		squadInstsByID[squadInst.ID] = squadInst
+	}
+
+	// Squads with mechs standing, keyed by sector instance ID
+	squadsBySector := make(map[string]map[string]struct{})
+	for _, inst := range allMechInsts {
+		if inst.Status == mecha_game_record.MechInstanceStatusDestroyed {
+			continue
+		}
+		if squadsBySector[inst.MechaGameSectorInstanceID] == nil {
+			squadsBySector[inst.MechaGameSectorInstanceID] = make(map[string]struct{})
+		}
+		squadsBySector[inst.MechaGameSectorInstanceID][inst.MechaGameSquadInstanceID] = struct{}{}
+	}
+
+	for _, sectorInst := range sectorInsts {
+		sectorDesign, err := p.Domain.GetMechaGameSectorRec(sectorInst.MechaGameSectorID, nil)
+		if err != nil {
+			l.Warn("failed to get sector design >%s<: %v", sectorInst.MechaGameSectorID, err)
+			continue
+		}
+		if !sectorDesign.IsObjectiveSector {
+			continue
+		}
+
+		controllingSquadID := ""
+		if squads := squadsBySector[sectorInst.ID]; len(squads) == 1 {
+			for squadID := range squads {
+				controllingSquadID = squadID
+			}
+		}
+
+		change := resolveObjectiveControl(sectorInst, sectorDesign, controllingSquadID, gameInstanceRec.CurrentTurn)
+		appendObjectiveControlEvents(eventsBySquad, sectorDesign, change)
+
+		if change.changed {
+			if _, err := p.Domain.UpdateMechaGameSectorInstanceRec(sectorInst); err != nil {
+				l.Warn("failed to update sector instance >%s< control: %v", sectorInst.ID, err)
+			}
+		}
+
+		// The owning squad scores the objective's victory points every turn
+		if !sectorInst.OwningSquadInstanceID.Valid || sectorDesign.VictoryPoints <= 0 {
+			continue
+		}
+		ownerInst := squadInstsByID[sectorInst.OwningSquadInstanceID.String]
+		if ownerInst == nil {
+			continue
+		}
+		ownerInst.VictoryPoints += sectorDesign.VictoryPoints
+		appendLifecycleEvent(eventsBySquad, ownerInst.ID,
+			"event.mecha.objective_scored", "objective", sectorDesign.Name,
+			"points", sectorDesign.VictoryPoints, "total", ownerInst.VictoryPoints)
+	}
+
+	return nil
+}
+
+// objectiveControlChange is how control and ownership of an objective sector
+// changed at the end of a turn. Squad instance IDs are empty when the change
+// did not happen.
+type objectiveControlChange struct {
+	// changed is true when the sector instance record needs saving
+	changed bool
+	// lostControl is the squad that no longer holds the sector uncontested
+	lostControl string
+	// tookControl is the squad that began holding the sector uncontested
+	tookControl string
+	// controlling is the squad holding the sector uncontested, if any
+	controlling string
+	// heldTurns is how many turns the controlling squad has held the sector
+	heldTurns int
+	// captured is the squad that captured the sector this turn
+	captured string
+	// lostOwnership is the squad that owned the sector before it was captured
+	lostOwnership string
+}
+
+// resolveObjectiveControl applies this turn's controlling squad to an objective
+// sector instance. controllingSquadID is the only squad with mechs standing in
+// the sector, or empty when the sector is empty or contested. Ownership passes
+// to the controlling squad once it has held the sector for the design's
+// capture turns and is kept when the sector is left or contested.
+func resolveObjectiveControl(
+	sectorInst *mecha_game_record.MechaGameSectorInstance,
+	sectorDesign *mecha_game_record.MechaGameSector,
+	controllingSquadID string,
+	currentTurn int,
+) objectiveControlChange {
+	change := objectiveControlChange{controlling: controllingSquadID}
+
+	previousSquadID := nullstring.ToString(sectorInst.ControllingSquadInstanceID)
+	if controllingSquadID != previousSquadID {
+		change.changed = true
+		change.lostControl = previousSquadID
+		if controllingSquadID != "" {
+			sectorInst.ControllingSquadInstanceID = nullstring.FromString(controllingSquadID)
+			sectorInst.ControlledSinceTurn = nullint64.FromInt64(int64(currentTurn))
+			change.tookControl = controllingSquadID
+		} else {
+			sectorInst.ControllingSquadInstanceID = sql.NullString{}
+			sectorInst.ControlledSinceTurn = sql.NullInt64{}
+		}
+	}
+
+	if controllingSquadID == "" {
+		return change
+	}
+
+	change.heldTurns = currentTurn - int(sectorInst.ControlledSinceTurn.Int64) + 1
+
+	owningSquadID := nullstring.ToString(sectorInst.OwningSquadInstanceID)
+	if owningSquadID != controllingSquadID && change.heldTurns >= max(sectorDesign.CaptureTurns, 1) {
+		change.changed = true
+		change.lostOwnership = owningSquadID
+		change.captured = controllingSquadID
+		sectorInst.OwningSquadInstanceID = nullstring.FromString(controllingSquadID)
+	}
+
+	return change
+}
+
+// appendObjectiveControlEvents reports an objective control change to the
+// squads involved.
+func appendObjectiveControlEvents(
+	eventsBySquad map[string][]turnsheet.TurnEvent,
+	sectorDesign *mecha_game_record.MechaGameSector,
+	change objectiveControlChange,
+) {
+	if change.lostControl != "" {
+		appendLifecycleEvent(eventsBySquad, change.lostControl,
+			"event.mecha.objective_control_lost", "objective", sectorDesign.Name)
+	}
+	if change.tookControl != "" {
+		appendLifecycleEvent(eventsBySquad, change.tookControl,
+			"event.mecha.objective_control_taken", "objective", sectorDesign.Name)
+	} else if change.controlling != "" {
+		appendLifecycleEvent(eventsBySquad, change.controlling,
+			"event.mecha.objective_held", "objective", sectorDesign.Name, "turns", change.heldTurns)
+	}
+	if change.lostOwnership != "" {
+		appendLifecycleEvent(eventsBySquad, change.lostOwnership,
+			"event.mecha.objective_lost", "objective", sectorDesign.Name)
+	}
+	if change.captured != "" {
+		appendLifecycleEvent(eventsBySquad, change.captured,
+			"event.mecha.objective_captured", "objective", sectorDesign.Name)
+	}
+}
+
+// updateSquadContacts refreshes each squad's sensor contacts from what its mechs
+// detect at the end of the turn. Detected enemy mechs update their last known
+// position, contacts that slip out of sensor range are kept at the position they
+// were last seen in, and destroyed mechs are dropped. New, moved and lost
+// contacts are reported as movement events. Contacts are set on the squad
+// instance records, which the caller persists.
+func (p *MechaGame) updateSquadContacts(
+	l logger.Logger,
+	gameInstanceRec *game_record.GameInstance,
+	allSquadInsts []*mecha_game_record.MechaGameSquadInstance,
+	allMechInsts []*mecha_game_record.MechaGameMechInstance,
+	eventsBySquad map[string][]turnsheet.TurnEvent,
+) error {
+	detection, err := p.Domain.GetMechaGameDetection(gameInstanceRec.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get detection: %w", err)
+	}
+
+	destroyed := make(map[string]bool)
+	for _, inst := range allMechInsts {
+		if inst.Status == mecha_game_record.MechInstanceStatusDestroyed {
+			destroyed[inst.ID] = true
+		}
+	}
+
+	for _, squadInst := range allSquadInsts {
+		known, err := domain.GetMechaGameSquadContacts(squadInst)
+		if err != nil {
+			l.Warn("failed to read contacts for squad >%s<: %v", squadInst.ID, err)
+			known = nil
+		}
+
+		contacts, changes := domain.RefreshMechaGameContacts(known, detection.Contacts(squadInst.ID, gameInstanceRec.CurrentTurn), destroyed)
+		if err := domain.SetMechaGameSquadContacts(squadInst, contacts); err != nil {
+			l.Warn("failed to set contacts for squad >%s<: %v", squadInst.ID, err)
+			continue
+		}
+
+		for _, change := range changes {
+			var event turnsheet.TurnEvent
+			switch change.Kind {
+			case domain.MechaGameContactChangeNew:
+				event = turnsheet.NewTurnEvent(turnsheet.TurnEventCategoryMovement, turnsheet.TurnEventIconMovement,
+					"event.mecha.contact_new", "mech", change.Contact.Callsign, "sector", change.Contact.SectorName)
+			case domain.MechaGameContactChangeMoved:
+				event = turnsheet.NewTurnEvent(turnsheet.TurnEventCategoryMovement, turnsheet.TurnEventIconMovement,
+					"event.mecha.contact_moved", "mech", change.Contact.Callsign, "from", change.FromSectorName, "to", change.Contact.SectorName)
+			case domain.MechaGameContactChangeLost:
+				event = turnsheet.NewTurnEvent(turnsheet.TurnEventCategoryMovement, turnsheet.TurnEventIconMovement,
+					"event.mecha.contact_lost", "mech", change.Contact.Callsign, "sector", change.Contact.SectorName)
+			default:
+				continue
+			}
+			eventsBySquad[squadInst.ID] = append(eventsBySquad[squadInst.ID], event)
+		}
+	}
+
+	return nil
+}
+
 // refillAmmoAtDepot tops a mech's AmmoRemaining back up to its configured
 // MaxAmmoCapacity when the mech is parked on a starting (depot) sector.
 // The refill is a crew action, so it applies even when the mech is
@@ -262,22 +509,18 @@ func (p *MechaGame) refillAmmoAtDepot(
 	delta := maxAmmo - inst.AmmoRemaining
 	inst.AmmoRemaining = maxAmmo
 	appendLifecycleEvent(eventsBySquad, inst.MechaGameSquadInstanceID,
-		fmt.Sprintf("%s rearmed at depot (+%d ammo, %d total).",
-			inst.Callsign, delta, inst.AmmoRemaining))
+		"event.mecha.rearmed", "mech", inst.Callsign, "ammo", delta, "total", inst.AmmoRemaining)
 	return nil
 }
 
 func appendLifecycleEvent(
 	eventsBySquad map[string][]turnsheet.TurnEvent,
 	squadInstanceID string,
-	message string,
+	key string,
+	args ...any,
 ) {
 	eventsBySquad[squadInstanceID] = append(
 		eventsBySquad[squadInstanceID],
-		turnsheet.TurnEvent{
-			Category: turnsheet.TurnEventCategorySystem,
-			Icon:     turnsheet.TurnEventIconSystem,
-			Message:  message,
-		},
+		turnsheet.NewTurnEvent(turnsheet.TurnEventCategorySystem, turnsheet.TurnEventIconSystem, key, args...),
 	)
 }
@@ -0,0 +1,120 @@
+package mecha_game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/nullint64"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+// Tests for pure helper functions in end_of_turn.go.
+// Integration tests for runEndOfTurn require a full DB harness and are omitted here.
+
+func TestResolveObjectiveControl(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		// controlling and controlledSince are the sector's control before the turn
+		controlling     string
+		controlledSince int
+		owner           string
+		captureTurns    int
+		// present is the only squad standing in the sector this turn
+		present         string
+		currentTurn     int
+		wantChange      objectiveControlChange
+		wantControlling string
+		wantOwner       string
+	}{
+		{
+			name:            "squad enters empty objective with capture turns 2 then takes control without capturing",
+			captureTurns:    2,
+			present:         "red",
+			currentTurn:     3,
+			wantChange:      objectiveControlChange{changed: true, tookControl: "red", controlling: "red", heldTurns: 1},
+			wantControlling: "red",
+		},
+		{
+			name:            "squad enters empty objective with capture turns 1 then captures it the same turn",
+			captureTurns:    1,
+			present:         "red",
+			currentTurn:     3,
+			wantChange:      objectiveControlChange{changed: true, tookControl: "red", controlling: "red", heldTurns: 1, captured: "red"},
+			wantControlling: "red",
+			wantOwner:       "red",
+		},
+		{
+			name:            "squad holds objective for capture turns then captures it from the previous owner",
+			controlling:     "red",
+			controlledSince: 2,
+			owner:           "blue",
+			captureTurns:    3,
+			present:         "red",
+			currentTurn:     4,
+			wantChange:      objectiveControlChange{changed: true, controlling: "red", heldTurns: 3, captured: "red", lostOwnership: "blue"},
+			wantControlling: "red",
+			wantOwner:       "red",
+		},
+		{
+			name:            "owner keeps holding its objective then nothing changes",
+			controlling:     "red",
+			controlledSince: 1,
+			owner:           "red",
+			captureTurns:    1,
+			present:         "red",
+			currentTurn:     5,
+			wantChange:      objectiveControlChange{controlling: "red", heldTurns: 5},
+			wantControlling: "red",
+			wantOwner:       "red",
+		},
+		{
+			name:            "owner leaves or is contested then loses control but keeps ownership",
+			controlling:     "red",
+			controlledSince: 1,
+			owner:           "red",
+			captureTurns:    1,
+			currentTurn:     5,
+			wantChange:      objectiveControlChange{changed: true, lostControl: "red"},
+			wantOwner:       "red",
+		},
+		{
+			name:            "legacy sector without capture turns then captured after one turn",
+			captureTurns:    0,
+			present:         "red",
+			currentTurn:     1,
+			wantChange:      objectiveControlChange{changed: true, tookControl: "red", controlling: "red", heldTurns: 1, captured: "red"},
+			wantControlling: "red",
+			wantOwner:       "red",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sectorInst := &mecha_game_record.MechaGameSectorInstance{
+				ControllingSquadInstanceID: nullstring.FromString(tt.controlling),
+				OwningSquadInstanceID:      nullstring.FromString(tt.owner),
+			}
+			if tt.controlling != "" {
+				sectorInst.ControlledSinceTurn = nullint64.FromInt64(int64(tt.controlledSince))
+			}
+			sectorDesign := &mecha_game_record.MechaGameSector{
+				Name:              "Citadel",
+				IsObjectiveSector: true,
+				VictoryPoints:     3,
+				CaptureTurns:      tt.captureTurns,
+			}
+
+			change := resolveObjectiveControl(sectorInst, sectorDesign, tt.present, tt.currentTurn)
+
+			require.Equal(t, tt.wantChange, change, "objective control change should match")
+			require.Equal(t, tt.wantControlling, nullstring.ToString(sectorInst.ControllingSquadInstanceID), "controlling squad should match")
+			require.Equal(t, tt.wantOwner, nullstring.ToString(sectorInst.OwningSquadInstanceID), "owning squad should match")
+		})
+	}
+}
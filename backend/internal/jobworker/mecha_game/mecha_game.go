@@ -36,6 +36,10 @@ type MechaGame struct {
 	// within a single ProcessTurnSheets call and is reset at the start of each
 	// ProcessTurnSheets call.
 	pendingAttacks []turn_sheet_processor.AttackDeclaration
+	// pendingMovements accumulates movement declarations from all order
+	// processing within a single ProcessTurnSheets call and is reset at the
+	// start of each ProcessTurnSheets call.
+	pendingMovements []turn_sheet_processor.MovementDeclaration
 }
 
 // TurnSheetProcessor defines the interface for processing turn sheet business logic in mecha
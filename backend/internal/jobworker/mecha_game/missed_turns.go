@@ -0,0 +1,277 @@
+package mecha_game
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+// takeoverOpponent returns the computer opponent profile used to command a player's
+// squad when the player has missed turns: a balanced commander of average aggression
+// and intelligence.
+func takeoverOpponent(gameInstanceRec *game_record.GameInstance) *mecha_game_record.MechaGameComputerOpponent {
+	return &mecha_game_record.MechaGameComputerOpponent{
+		GameID:      gameInstanceRec.GameID,
+		Name:        "Autopilot",
+		Description: "Commands the squad while its commander is absent.",
+		Aggression:  5,
+		IQ:          5,
+	}
+}
+
+// StandingOrderScannedData returns the scanned data that carries out a player's standing
+// order for a turn sheet they did not submit. Nil is returned when the standing order
+// calls for no action this turn.
+func (p *MechaGame) StandingOrderScannedData(ctx context.Context, gameInstanceRec *game_record.GameInstance, turnSheetRec *game_record.GameTurnSheet, standingOrder string) (json.RawMessage, error) {
+	l := p.Logger.WithFunctionContext("MechaGame/StandingOrderScannedData")
+
+	squadInstance, err := p.getSquadInstanceForTurnSheet(turnSheetRec)
+	if err != nil {
+		return nil, err
+	}
+
+	switch standingOrder {
+	case mecha_game_record.MechaGameStandingOrderHoldPosition:
+		if turnSheetRec.SheetType != mecha_game_record.MechaGameTurnSheetTypeOrders {
+			return nil, nil
+		}
+		return p.holdPositionScannedData(ctx, gameInstanceRec, squadInstance)
+	case mecha_game_record.MechaGameStandingOrderRepeatLastOrders:
+		return p.lastTurnScannedData(squadInstance, turnSheetRec)
+	case mecha_game_record.MechaGameStandingOrderAutoRepair:
+		if turnSheetRec.SheetType != mecha_game_record.MechaGameTurnSheetTypeSquadManagement {
+			return nil, nil
+		}
+		return p.autoRepairScannedData(squadInstance)
+	default:
+		l.Warn("unsupported standing order >%s< for turn sheet type >%s<", standingOrder, turnSheetRec.SheetType)
+		return nil, nil
+	}
+}
+
+// ComputerTakeoverScannedData returns the scanned data for a turn sheet when the computer
+// has taken command of a player's squad. Orders come from the computer opponent decision
+// engine and squad management repairs any damaged mechs at a depot.
+func (p *MechaGame) ComputerTakeoverScannedData(ctx context.Context, gameInstanceRec *game_record.GameInstance, turnSheetRec *game_record.GameTurnSheet) (json.RawMessage, error) {
+	l := p.Logger.WithFunctionContext("MechaGame/ComputerTakeoverScannedData")
+
+	squadInstance, err := p.getSquadInstanceForTurnSheet(turnSheetRec)
+	if err != nil {
+		return nil, err
+	}
+
+	switch turnSheetRec.SheetType {
+	case mecha_game_record.MechaGameTurnSheetTypeOrders:
+		opponentRec := takeoverOpponent(gameInstanceRec)
+
+		orders, err := p.DecisionEngine.GenerateOrdersForSquad(ctx, gameInstanceRec.ID, squadInstance, opponentRec, gameInstanceRec.CurrentTurn)
+		if err != nil {
+			l.Warn("decision engine failed for squad instance >%s< error >%v<", squadInstance.ID, err)
+			return nil, err
+		}
+		orders = p.resolveAIOrderIDs(l, gameInstanceRec.ID, opponentRec.Name, orders)
+
+		ownMechIDs, err := p.getSquadMechInstanceIDs(squadInstance)
+		if err != nil {
+			return nil, err
+		}
+
+		// Only the squad's own mechs may be ordered on the player's behalf.
+		scanData := turnsheet.OrdersScanData{}
+		for _, order := range orders {
+			if !ownMechIDs[order.MechInstanceID] {
+				l.Warn("dropping takeover order for mech >%s< not in squad instance >%s<", order.MechInstanceID, squadInstance.ID)
+				continue
+			}
+			scanData.MechOrders = append(scanData.MechOrders, order)
+		}
+
+		return json.Marshal(scanData)
+	case mecha_game_record.MechaGameTurnSheetTypeSquadManagement:
+		return p.autoRepairScannedData(squadInstance)
+	default:
+		return nil, nil
+	}
+}
+
+// AppendMissedTurnEvent appends a turn event to the squads the turn sheets belong to so
+// the outcome of a missed turn is reported on the next turn's sheets.
+func (p *MechaGame) AppendMissedTurnEvent(ctx context.Context, gameInstanceRec *game_record.GameInstance, turnSheetRecs []*game_record.GameTurnSheet, evt turnsheet.TurnEvent) error {
+	l := p.Logger.WithFunctionContext("MechaGame/AppendMissedTurnEvent")
+
+	squadInstances := map[string]*mecha_game_record.MechaGameSquadInstance{}
+	for _, turnSheetRec := range turnSheetRecs {
+		squadInstance, err := p.getSquadInstanceForTurnSheet(turnSheetRec)
+		if err != nil {
+			return err
+		}
+		squadInstances[squadInstance.ID] = squadInstance
+	}
+
+	for _, squadInstance := range squadInstances {
+		if err := turnsheet.AppendMechaGameTurnEvent(squadInstance, evt); err != nil {
+			return fmt.Errorf("failed to append missed turn event: %w", err)
+		}
+		if _, err := p.Domain.UpdateMechaGameSquadInstanceRec(squadInstance); err != nil {
+			l.Warn("failed to update squad instance >%s< error >%v<", squadInstance.ID, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// holdPositionScannedData returns orders that keep every operational mech of the squad
+// in its sector, firing on the best enemy target in range or, with no target in range,
+// covering the approach from the nearest enemy on overwatch.
+func (p *MechaGame) holdPositionScannedData(ctx context.Context, gameInstanceRec *game_record.GameInstance, squadInstance *mecha_game_record.MechaGameSquadInstance) (json.RawMessage, error) {
+	opponentRec := takeoverOpponent(gameInstanceRec)
+
+	state, err := p.DecisionEngine.buildGameStateContext(ctx, gameInstanceRec.ID, squadInstance, opponentRec, gameInstanceRec.CurrentTurn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build game state context: %w", err)
+	}
+
+	strategy := &ruleBasedStrategy{}
+
+	scanData := turnsheet.OrdersScanData{}
+	for _, mech := range state.OwnMechs {
+		if mech.Status == mecha_game_record.MechInstanceStatusDestroyed ||
+			mech.Status == mecha_game_record.MechInstanceStatusShutdown {
+			continue
+		}
+		order := turnsheet.ScannedMechOrder{
+			MechInstanceID:             mech.ID,
+			AttackTargetMechInstanceID: strategy.pickAttackTarget(opponentRec, mech.MechaGameSectorInstanceID, mech, state),
+		}
+		if order.AttackTargetMechInstanceID == "" {
+			order.OverwatchSectorInstanceID = strategy.pickOverwatchSector(mech.MechaGameSectorInstanceID, state)
+		}
+		scanData.MechOrders = append(scanData.MechOrders, order)
+	}
+
+	return json.Marshal(scanData)
+}
+
+// autoRepairScannedData returns squad management orders that repair the structure of
+// every damaged mech. Repairs are only carried out for mechs at a depot.
+func (p *MechaGame) autoRepairScannedData(squadInstance *mecha_game_record.MechaGameSquadInstance) (json.RawMessage, error) {
+	l := p.Logger.WithFunctionContext("MechaGame/autoRepairScannedData")
+
+	mechInstanceRecs, err := p.Domain.GetManyMechaGameMechInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameMechInstanceMechaGameSquadInstanceID, Val: squadInstance.ID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get mech instances for squad instance >%s< error >%v<", squadInstance.ID, err)
+		return nil, err
+	}
+
+	scanData := turnsheet.SquadManagementScanData{}
+	for _, mechInstanceRec := range mechInstanceRecs {
+		if mechInstanceRec.Status == mecha_game_record.MechInstanceStatusDestroyed {
+			continue
+		}
+		chassisRec, err := p.Domain.GetMechaGameChassisRec(mechInstanceRec.MechaGameChassisID, nil)
+		if err != nil {
+			l.Warn("failed to get chassis >%s< error >%v<", mechInstanceRec.MechaGameChassisID, err)
+			continue
+		}
+		if mechInstanceRec.CurrentStructure >= chassisRec.StructurePoints {
+			continue
+		}
+		scanData.MechManagementOrders = append(scanData.MechManagementOrders, turnsheet.ScannedMechManagementOrder{
+			MechInstanceID:  mechInstanceRec.ID,
+			RepairStructure: true,
+		})
+	}
+
+	if len(scanData.MechManagementOrders) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(scanData)
+}
+
+// lastTurnScannedData returns the scanned data of the squad's turn sheet of the same type
+// from the previous turn.
+func (p *MechaGame) lastTurnScannedData(squadInstance *mecha_game_record.MechaGameSquadInstance, turnSheetRec *game_record.GameTurnSheet) (json.RawMessage, error) {
+	if turnSheetRec.TurnNumber <= 1 {
+		return nil, nil
+	}
+
+	lastTurnSheetRecs, err := p.getTurnSheetsForSquad(squadInstance, turnSheetRec.TurnNumber-1)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lastTurnSheetRec := range lastTurnSheetRecs {
+		if lastTurnSheetRec.SheetType == turnSheetRec.SheetType && len(lastTurnSheetRec.ScannedData) > 0 {
+			return lastTurnSheetRec.ScannedData, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getSquadInstanceForTurnSheet returns the squad instance a turn sheet was created for.
+func (p *MechaGame) getSquadInstanceForTurnSheet(turnSheetRec *game_record.GameTurnSheet) (*mecha_game_record.MechaGameSquadInstance, error) {
+	l := p.Logger.WithFunctionContext("MechaGame/getSquadInstanceForTurnSheet")
+
+	mechaGameTurnSheetRecs, err := p.Domain.GetManyMechaGameTurnSheetRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameTurnSheetGameTurnSheetID, Val: turnSheetRec.ID},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		l.Warn("failed to get mecha turn sheet for turn sheet >%s< error >%v<", turnSheetRec.ID, err)
+		return nil, err
+	}
+	if len(mechaGameTurnSheetRecs) == 0 {
+		return nil, fmt.Errorf("no squad instance found for turn sheet >%s<", turnSheetRec.ID)
+	}
+
+	return p.Domain.GetMechaGameSquadInstanceRec(mechaGameTurnSheetRecs[0].MechaGameSquadInstanceID, nil)
+}
+
+// getSquadMechInstanceIDs returns the IDs of the mech instances in a squad instance.
+func (p *MechaGame) getSquadMechInstanceIDs(squadInstance *mecha_game_record.MechaGameSquadInstance) (map[string]bool, error) {
+	mechInstanceRecs, err := p.Domain.GetManyMechaGameMechInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameMechInstanceMechaGameSquadInstanceID, Val: squadInstance.ID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mechInstanceIDs := make(map[string]bool, len(mechInstanceRecs))
+	for _, mechInstanceRec := range mechInstanceRecs {
+		mechInstanceIDs[mechInstanceRec.ID] = true
+	}
+
+	return mechInstanceIDs, nil
+}
+
+// getDroppedGameSubscriptionInstanceIDs returns the game subscription instances of players
+// dropped from a game instance for missing too many turns.
+func (p *MechaGame) getDroppedGameSubscriptionInstanceIDs(gameInstanceRec *game_record.GameInstance) (map[string]bool, error) {
+	droppedRecs, err := p.Domain.GetDroppedGameSubscriptionInstanceRecsByInstance(gameInstanceRec.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	droppedIDs := make(map[string]bool, len(droppedRecs))
+	for _, droppedRec := range droppedRecs {
+		droppedIDs[droppedRec.ID] = true
+	}
+
+	return droppedIDs, nil
+}
@@ -0,0 +1,444 @@
+package mecha_game
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/jobworker/mecha_game/turn_sheet_processor"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+// MovementDeclaration is an alias for the type defined in turn_sheet_processor.
+type MovementDeclaration = turn_sheet_processor.MovementDeclaration
+
+// mechMovement tracks a single mech through the movement phase.
+type mechMovement struct {
+	MechInstanceID  string
+	SquadInstanceID string
+	// SectorInstanceID is the sector the mech currently stands in
+	SectorInstanceID string
+	// Path lists the sectors the mech has still to enter, in order
+	Path []string
+	// OverwatchSectorInstanceID is the sector the mech covers with
+	// opportunity fire once it has finished moving
+	OverwatchSectorInstanceID string
+	// Fired is set once the mech has taken its opportunity shot
+	Fired bool
+	// Stopped is set when the mech is destroyed part way along its path
+	Stopped bool
+}
+
+// stepMechMovements moves every mech along its path one sector per step, all
+// mechs stepping together. After each step every mech on overwatch that has
+// finished moving and has not yet fired takes an opportunity shot at the first
+// enemy mech to enter the sector it covers. Movements are stepped in the order
+// given. fire resolves a shot, returning whether it was taken and whether it
+// destroyed the moving mech, which ends that mech's movement where it stands.
+func stepMechMovements(movements []*mechMovement, fire func(overwatcher, mover *mechMovement) (fired, destroyed bool)) {
+	for {
+		var entered []*mechMovement
+		for _, m := range movements {
+			if m.Stopped || len(m.Path) == 0 {
+				continue
+			}
+			m.SectorInstanceID = m.Path[0]
+			m.Path = m.Path[1:]
+			entered = append(entered, m)
+		}
+		if len(entered) == 0 {
+			return
+		}
+
+		for _, mover := range entered {
+			for _, overwatcher := range movements {
+				if overwatcher.Fired || overwatcher.Stopped || len(overwatcher.Path) > 0 ||
+					overwatcher.OverwatchSectorInstanceID != mover.SectorInstanceID ||
+					overwatcher.SquadInstanceID == mover.SquadInstanceID {
+					continue
+				}
+				fired, destroyed := fire(overwatcher, mover)
+				if fired {
+					overwatcher.Fired = true
+				}
+				if destroyed {
+					mover.Stopped = true
+					mover.Path = nil
+					break
+				}
+			}
+		}
+	}
+}
+
+// interceptDestination returns the sector a mech intercepting an enemy moves
+// to: of the sectors within its movement budget, including the one it stands
+// in, the one closest to where the enemy ends its movement, then the cheapest
+// to reach.
+func interceptDestination(
+	sectorMap *domain.MechaGameSectorMap,
+	ruleset domain.MechaGameRuleset,
+	fromSectorInstanceID string,
+	speed int,
+	targetSectorInstanceID string,
+) string {
+	costs := sectorMap.MovementCosts(fromSectorInstanceID, speed, ruleset)
+	costs[fromSectorInstanceID] = 0
+
+	distances := sectorMap.Distances(targetSectorInstanceID, len(sectorMap.Links))
+
+	candidates := make([]string, 0, len(costs))
+	for sectorInstanceID := range costs {
+		if _, ok := distances[sectorInstanceID]; ok {
+			candidates = append(candidates, sectorInstanceID)
+		}
+	}
+	if len(candidates) == 0 {
+		return fromSectorInstanceID
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if distances[a] != distances[b] {
+			return distances[a] < distances[b]
+		}
+		if costs[a] != costs[b] {
+			return costs[a] < costs[b]
+		}
+		return a < b
+	})
+
+	return candidates[0]
+}
+
+// resolveMovement runs the movement phase for a game instance. Every declared
+// move is walked one sector at a time so mechs on overwatch can take
+// opportunity fire at enemies entering the sector they cover, and mechs
+// intercepting an enemy move towards wherever that enemy ends up. Opportunity
+// fire damage is applied as it happens, so a mech destroyed part way along its
+// path stops there.
+//
+// Returns the attack declarations combat resolves, with attacks by mechs that
+// took an opportunity shot removed and attacks on intercepted mechs added for
+// interceptors that declared no other attack, and the XP earned from
+// opportunity fire.
+func (p *MechaGame) resolveMovement(
+	l logger.Logger,
+	gameInstanceRec *game_record.GameInstance,
+	ruleset domain.MechaGameRuleset,
+	declarations []MovementDeclaration,
+	attacks []AttackDeclaration,
+) ([]AttackDeclaration, map[string]int, error) {
+	l = l.WithFunctionContext("MechaGame/resolveMovement")
+
+	if len(declarations) == 0 {
+		l.Info("no movement declarations for game instance >%s< — skipping movement", gameInstanceRec.ID)
+		return attacks, nil, nil
+	}
+
+	allMechInsts, err := p.Domain.GetManyMechaGameMechInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameMechInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to load mech instances: %v", err)
+		return attacks, nil, err
+	}
+
+	snapshots, err := p.buildMechSnapshots(l, allMechInsts)
+	if err != nil {
+		l.Warn("failed to build mech snapshots: %v", err)
+		return attacks, nil, err
+	}
+
+	sectorMap, err := p.Domain.GetMechaGameSectorMap(gameInstanceRec.ID)
+	if err != nil {
+		l.Warn("failed to get sector map: %v", err)
+		return attacks, nil, err
+	}
+
+	sectors, err := p.buildSectorGraph(l, gameInstanceRec.ID)
+	if err != nil {
+		l.Warn("failed to build sector graph: %v", err)
+		return attacks, nil, err
+	}
+
+	// Where each mech means to end its movement, so interceptors know where
+	// to head for
+	declared := make(map[string]MovementDeclaration, len(declarations))
+	for _, decl := range declarations {
+		if _, ok := declared[decl.MechInstanceID]; ok {
+			l.Warn("mech >%s< has more than one movement declaration — using the first", decl.MechInstanceID)
+			continue
+		}
+		declared[decl.MechInstanceID] = decl
+	}
+
+	attacking := make(map[string]bool, len(attacks))
+	for _, atk := range attacks {
+		attacking[atk.AttackerMechInstanceID] = true
+	}
+
+	heatMap := make(map[string]int)
+	xpMap := make(map[string]int)
+	eventsBySquad := make(map[string][]turnsheet.TurnEvent)
+
+	mechIDs := make([]string, 0, len(declared))
+	for mechID := range declared {
+		mechIDs = append(mechIDs, mechID)
+	}
+	sort.Strings(mechIDs)
+
+	movements := make([]*mechMovement, 0, len(mechIDs))
+	startSectors := make(map[string]string, len(mechIDs))
+	for _, mechID := range mechIDs {
+		decl := declared[mechID]
+		snap, ok := snapshots[mechID]
+		if !ok {
+			l.Warn("mech >%s< not found in snapshot — skipping movement", mechID)
+			continue
+		}
+		if snap.Instance.Status == mecha_game_record.MechInstanceStatusDestroyed {
+			continue
+		}
+
+		m, target, jumpHeat := planMechMovement(l, ruleset, sectorMap, decl, snap, declared, snapshots)
+		startSectors[mechID] = snap.SectorInstanceID
+		if jumpHeat > 0 {
+			heatMap[mechID] += jumpHeat
+		}
+		if target != nil {
+			if !attacking[mechID] {
+				attacks = append(attacks, AttackDeclaration{
+					AttackerMechInstanceID: mechID,
+					TargetMechInstanceID:   target.Instance.ID,
+				})
+				attacking[mechID] = true
+			}
+			appendMovementEvent(eventsBySquad, snap.SquadInstanceID,
+				fmt.Sprintf("%s moved to intercept %s.", snap.Instance.Callsign, target.Instance.Callsign))
+		}
+
+		movements = append(movements, m)
+	}
+
+	seed := int64(gameInstanceRec.CurrentTurn)
+	for _, b := range []byte(gameInstanceRec.ID + "movement") {
+		seed += int64(b)
+	}
+	rng := rand.New(rand.NewSource(seed)) //nolint:gosec
+
+	fire := func(overwatcher, mover *mechMovement) (bool, bool) {
+		attacker := snapshots[overwatcher.MechInstanceID]
+		target := snapshots[mover.MechInstanceID]
+		return p.resolveOpportunityFire(l, ruleset, attacker, target, overwatcher.SectorInstanceID, mover.SectorInstanceID,
+			sectorMap.SectorNames[mover.SectorInstanceID], snapshots, sectors, rng, heatMap, xpMap, eventsBySquad)
+	}
+
+	stepMechMovements(movements, fire)
+
+	// Mechs that took an opportunity shot have used their attack for the turn
+	fired := make(map[string]bool)
+	for _, m := range movements {
+		if m.Fired {
+			fired[m.MechInstanceID] = true
+		}
+	}
+	remaining := make([]AttackDeclaration, 0, len(attacks))
+	for _, atk := range attacks {
+		if fired[atk.AttackerMechInstanceID] {
+			l.Info("mech >%s< fired on overwatch — skipping declared attack on >%s<", atk.AttackerMechInstanceID, atk.TargetMechInstanceID)
+			continue
+		}
+		remaining = append(remaining, atk)
+	}
+
+	for _, m := range movements {
+		snap := snapshots[m.MechInstanceID]
+		snap.SectorInstanceID = m.SectorInstanceID
+		snap.Instance.MechaGameSectorInstanceID = m.SectorInstanceID
+
+		if m.SectorInstanceID != startSectors[m.MechInstanceID] {
+			appendMovementEvent(eventsBySquad, m.SquadInstanceID,
+				fmt.Sprintf("%s moved to %s.", snap.Instance.Callsign, sectorMap.SectorNames[m.SectorInstanceID]))
+		}
+		if m.OverwatchSectorInstanceID != "" && !m.Fired && !m.Stopped &&
+			snap.Instance.Status != mecha_game_record.MechInstanceStatusDestroyed &&
+			snap.Instance.Status != mecha_game_record.MechInstanceStatusShutdown {
+			appendMovementEvent(eventsBySquad, m.SquadInstanceID,
+				fmt.Sprintf("%s held %s on overwatch — no enemy entered.", snap.Instance.Callsign, sectorMap.SectorNames[m.OverwatchSectorInstanceID]))
+		}
+	}
+
+	// Equipment heat predicated on attacking (targeting computer, ammo bin)
+	// applies to opportunity fire too; combat only counts the declared
+	// attacks these mechs no longer make.
+	for mechID := range fired {
+		snap := snapshots[mechID]
+		if heat := CombatHeatCost(snap.Equipment, snap.EquipmentByID, snap.Instance.IsRefitting, snap.DidAttack, snap.DidFireAmmoWeapon); heat > 0 {
+			heatMap[mechID] += heat
+		}
+	}
+
+	p.applyPendingHeat(l, heatMap, snapshots, eventsBySquad)
+
+	// Only mechs with movement declarations move, fire or take opportunity fire
+	for _, m := range movements {
+		snap := snapshots[m.MechInstanceID]
+		if _, err := p.Domain.UpdateMechaGameMechInstanceRec(snap.Instance); err != nil {
+			l.Warn("failed to update mech instance >%s< after movement: %v", snap.Instance.ID, err)
+		}
+	}
+
+	if err := p.appendCombatEventsToSquads(eventsBySquad); err != nil {
+		l.Warn("failed to persist movement events: %v", err)
+		return remaining, xpMap, err
+	}
+
+	return remaining, xpMap, nil
+}
+
+// planMechMovement works out the path a mech takes through the movement phase
+// from its declaration. Returns the mech's movement, the enemy it is
+// intercepting when it has a live intercept target and the jump jet heat the
+// move generates.
+func planMechMovement(
+	l logger.Logger,
+	ruleset domain.MechaGameRuleset,
+	sectorMap *domain.MechaGameSectorMap,
+	decl MovementDeclaration,
+	snap *mechSnapshot,
+	declared map[string]MovementDeclaration,
+	snapshots map[string]*mechSnapshot,
+) (*mechMovement, *mechSnapshot, int) {
+	m := &mechMovement{
+		MechInstanceID:            snap.Instance.ID,
+		SquadInstanceID:           snap.SquadInstanceID,
+		SectorInstanceID:          snap.SectorInstanceID,
+		OverwatchSectorInstanceID: decl.OverwatchSectorInstanceID,
+	}
+
+	var interceptTarget *mechSnapshot
+	destination := decl.ToSectorInstanceID
+	if decl.InterceptTargetMechInstanceID != "" {
+		target, ok := snapshots[decl.InterceptTargetMechInstanceID]
+		if !ok || target.Instance.Status == mecha_game_record.MechInstanceStatusDestroyed {
+			l.Info("intercept target >%s< of mech >%s< is gone — holding position", decl.InterceptTargetMechInstanceID, m.MechInstanceID)
+		} else {
+			// An interceptor heads for where its target means to end up; a
+			// target that is not moving to a chosen sector is met where it
+			// stands
+			targetSector := target.SectorInstanceID
+			if targetDecl := declared[target.Instance.ID]; targetDecl.ToSectorInstanceID != "" {
+				targetSector = targetDecl.ToSectorInstanceID
+			}
+			destination = interceptDestination(sectorMap, ruleset, snap.SectorInstanceID, decl.Speed, targetSector)
+			interceptTarget = target
+		}
+	}
+
+	jumpHeat := 0
+	if destination != "" && destination != snap.SectorInstanceID {
+		path, cost, ok := sectorMap.MovementPath(snap.SectorInstanceID, destination, decl.Speed, ruleset)
+		if !ok {
+			l.Warn("mech >%s< cannot reach sector >%s< within speed budget %d — holding position", m.MechInstanceID, destination, decl.Speed)
+		} else {
+			m.Path = path
+			// Jump jets fire when the move costs more than the chassis speed
+			if cost > decl.BaseSpeed {
+				jumpHeat = decl.JumpJetHeat
+			}
+		}
+	}
+
+	if decl.HoldAfterMove && decl.ToSectorInstanceID != "" {
+		m.OverwatchSectorInstanceID = decl.ToSectorInstanceID
+	}
+
+	return m, interceptTarget, jumpHeat
+}
+
+// resolveOpportunityFire resolves a shot by a mech on overwatch at an enemy
+// mech entering the sector it covers, applying any damage straight away.
+// Returns whether the shot was taken and whether it destroyed the target. A
+// shot is not taken when the overwatching mech is shut down, has no weapons or
+// terrain blocks the line of fire, so the mech keeps watching.
+func (p *MechaGame) resolveOpportunityFire(
+	l logger.Logger,
+	ruleset domain.MechaGameRuleset,
+	attacker, target *mechSnapshot,
+	attackerSectorInstanceID, targetSectorInstanceID, targetSectorName string,
+	snapshots map[string]*mechSnapshot,
+	sectors []*sectorState,
+	rng *rand.Rand,
+	heatMap map[string]int,
+	xpMap map[string]int,
+	eventsBySquad map[string][]turnsheet.TurnEvent,
+) (bool, bool) {
+	if attacker.Instance.Status == mecha_game_record.MechInstanceStatusDestroyed ||
+		attacker.Instance.Status == mecha_game_record.MechInstanceStatusShutdown ||
+		len(attacker.Weapons) == 0 {
+		return false, false
+	}
+
+	attacker.SectorInstanceID = attackerSectorInstanceID
+	target.SectorInstanceID = targetSectorInstanceID
+
+	dist := rangeDistance(attacker.SectorInstanceID, target.SectorInstanceID, sectors)
+	if ruleset.FireObstructed(sectorTerrainType(attacker.SectorInstanceID, sectors), sectorTerrainType(target.SectorInstanceID, sectors), dist) {
+		l.Info("%s on overwatch could not fire at %s — line of fire blocked", attacker.Instance.Callsign, target.Instance.Callsign)
+		return false, false
+	}
+
+	l.Info("%s on overwatch fires at %s entering sector >%s<", attacker.Instance.Callsign, target.Instance.Callsign, targetSectorInstanceID)
+
+	appendCombatEvent(eventsBySquad, attacker.SquadInstanceID,
+		fmt.Sprintf("%s opened overwatch fire on %s entering %s.",
+			attacker.Instance.Callsign, target.Instance.Callsign, targetSectorName))
+	appendCombatEvent(eventsBySquad, target.SquadInstanceID,
+		fmt.Sprintf("%s came under overwatch fire from %s entering %s.",
+			target.Instance.Callsign, attacker.Instance.Callsign, targetSectorName))
+
+	attacker.DidAttack = true
+	xpMap[attacker.Instance.ID] += xpPerCombatParticipation
+
+	atk := AttackDeclaration{
+		AttackerMechInstanceID: attacker.Instance.ID,
+		TargetMechInstanceID:   target.Instance.ID,
+	}
+	totalDmg := p.fireWeapons(l, ruleset, atk, attacker, target, dist, sectors, rng, heatMap, eventsBySquad)
+	if totalDmg == 0 {
+		return true, false
+	}
+
+	p.applyPendingDamage(l, map[string]*pendingDamage{target.Instance.ID: {rawTotal: totalDmg}}, snapshots, []AttackDeclaration{atk}, eventsBySquad)
+
+	if target.Instance.Status != mecha_game_record.MechInstanceStatusDestroyed {
+		return true, false
+	}
+
+	xpMap[attacker.Instance.ID] += xpPerKill
+
+	return true, true
+}
+
+func appendMovementEvent(
+	eventsBySquad map[string][]turnsheet.TurnEvent,
+	squadInstanceID string,
+	message string,
+) {
+	eventsBySquad[squadInstanceID] = append(
+		eventsBySquad[squadInstanceID],
+		turnsheet.TurnEvent{
+			Category: turnsheet.TurnEventCategoryMovement,
+			Icon:     turnsheet.TurnEventIconMovement,
+			Message:  message,
+		},
+	)
+}
@@ -0,0 +1,160 @@
+package mecha_game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+)
+
+// Tests for pure helper functions in movement_resolution.go.
+// Integration tests for resolveMovement require a full DB harness and are omitted here.
+
+func TestStepMechMovements(t *testing.T) {
+	t.Parallel()
+
+	type shot struct {
+		overwatcher string
+		mover       string
+		sector      string
+	}
+
+	tests := []struct {
+		name      string
+		movements []*mechMovement
+		// blocked lists movers overwatchers cannot get a shot at
+		blocked map[string]bool
+		// destroys lists movers destroyed by the first shot at them
+		destroys      map[string]bool
+		wantShots     []shot
+		wantPositions map[string]string
+	}{
+		{
+			name: "mover walks through overwatched chokepoint then fired on and keeps moving",
+			movements: []*mechMovement{
+				{MechInstanceID: "mover", SquadInstanceID: "red", SectorInstanceID: "A", Path: []string{"B", "C"}},
+				{MechInstanceID: "watcher", SquadInstanceID: "blue", SectorInstanceID: "C", OverwatchSectorInstanceID: "B"},
+			},
+			wantShots:     []shot{{overwatcher: "watcher", mover: "mover", sector: "B"}},
+			wantPositions: map[string]string{"mover": "C", "watcher": "C"},
+		},
+		{
+			name: "mover destroyed by overwatch fire then stops where it was hit",
+			movements: []*mechMovement{
+				{MechInstanceID: "mover", SquadInstanceID: "red", SectorInstanceID: "A", Path: []string{"B", "C"}},
+				{MechInstanceID: "watcher", SquadInstanceID: "blue", SectorInstanceID: "C", OverwatchSectorInstanceID: "B"},
+			},
+			destroys:      map[string]bool{"mover": true},
+			wantShots:     []shot{{overwatcher: "watcher", mover: "mover", sector: "B"}},
+			wantPositions: map[string]string{"mover": "B", "watcher": "C"},
+		},
+		{
+			name: "watcher holding after move then only fires once it has arrived",
+			movements: []*mechMovement{
+				{MechInstanceID: "early", SquadInstanceID: "red", SectorInstanceID: "A", Path: []string{"B"}},
+				{MechInstanceID: "late", SquadInstanceID: "red", SectorInstanceID: "E", Path: []string{"F", "G", "B"}},
+				{MechInstanceID: "watcher", SquadInstanceID: "blue", SectorInstanceID: "D", Path: []string{"C", "B"}, OverwatchSectorInstanceID: "B"},
+			},
+			wantShots:     []shot{{overwatcher: "watcher", mover: "late", sector: "B"}},
+			wantPositions: map[string]string{"early": "B", "late": "B", "watcher": "B"},
+		},
+		{
+			name: "mover from the same squad then ignored",
+			movements: []*mechMovement{
+				{MechInstanceID: "friend", SquadInstanceID: "blue", SectorInstanceID: "A", Path: []string{"B"}},
+				{MechInstanceID: "watcher", SquadInstanceID: "blue", SectorInstanceID: "C", OverwatchSectorInstanceID: "B"},
+			},
+			wantPositions: map[string]string{"friend": "B", "watcher": "C"},
+		},
+		{
+			name: "two enemies enter covered sector then only one shot taken",
+			movements: []*mechMovement{
+				{MechInstanceID: "first", SquadInstanceID: "red", SectorInstanceID: "A", Path: []string{"B"}},
+				{MechInstanceID: "second", SquadInstanceID: "red", SectorInstanceID: "A", Path: []string{"D", "B"}},
+				{MechInstanceID: "watcher", SquadInstanceID: "blue", SectorInstanceID: "C", OverwatchSectorInstanceID: "B"},
+			},
+			wantShots:     []shot{{overwatcher: "watcher", mover: "first", sector: "B"}},
+			wantPositions: map[string]string{"first": "B", "second": "B", "watcher": "C"},
+		},
+		{
+			name: "shot blocked then watcher keeps watching for the next enemy",
+			movements: []*mechMovement{
+				{MechInstanceID: "first", SquadInstanceID: "red", SectorInstanceID: "A", Path: []string{"B"}},
+				{MechInstanceID: "second", SquadInstanceID: "red", SectorInstanceID: "A", Path: []string{"D", "B"}},
+				{MechInstanceID: "watcher", SquadInstanceID: "blue", SectorInstanceID: "C", OverwatchSectorInstanceID: "B"},
+			},
+			blocked:       map[string]bool{"first": true},
+			wantShots:     []shot{{overwatcher: "watcher", mover: "second", sector: "B"}},
+			wantPositions: map[string]string{"first": "B", "second": "B", "watcher": "C"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var shots []shot
+			stepMechMovements(tt.movements, func(overwatcher, mover *mechMovement) (bool, bool) {
+				if tt.blocked[mover.MechInstanceID] {
+					return false, false
+				}
+				shots = append(shots, shot{overwatcher: overwatcher.MechInstanceID, mover: mover.MechInstanceID, sector: mover.SectorInstanceID})
+				return true, tt.destroys[mover.MechInstanceID]
+			})
+
+			require.Equal(t, tt.wantShots, shots, "opportunity shots taken")
+			for _, m := range tt.movements {
+				require.Equal(t, tt.wantPositions[m.MechInstanceID], m.SectorInstanceID, "final sector of mech %s", m.MechInstanceID)
+			}
+		})
+	}
+}
+
+func TestInterceptDestination(t *testing.T) {
+	t.Parallel()
+
+	ruleset, err := domain.DefaultMechaGameRuleset()
+	require.NoError(t, err, "DefaultMechaGameRuleset returns without error")
+
+	// A links to open B and rough C, both of which lead to D, which leads
+	// to E
+	sectorMap := &domain.MechaGameSectorMap{
+		Links: map[string][]string{
+			"A": {"B", "C"},
+			"B": {"A", "D"},
+			"C": {"A", "D"},
+			"D": {"B", "C", "E"},
+			"E": {"D"},
+		},
+		TerrainTypes: map[string]string{
+			"A": mecha_game_record.SectorTerrainTypeOpen,
+			"B": mecha_game_record.SectorTerrainTypeOpen,
+			"C": mecha_game_record.SectorTerrainTypeRough,
+			"D": mecha_game_record.SectorTerrainTypeOpen,
+			"E": mecha_game_record.SectorTerrainTypeOpen,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		from   string
+		speed  int
+		target string
+		want   string
+	}{
+		{name: "target within reach then move onto it", from: "A", speed: 2, target: "D", want: "D"},
+		{name: "target beyond reach then move as close as possible", from: "A", speed: 2, target: "E", want: "D"},
+		{name: "rough neighbour out of reach then open neighbour chosen", from: "A", speed: 1, target: "D", want: "B"},
+		{name: "target in own sector then stay", from: "D", speed: 2, target: "D", want: "D"},
+		{name: "no movement budget then stay", from: "A", speed: 0, target: "E", want: "A"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, interceptDestination(sectorMap, ruleset, tt.from, tt.speed, tt.target), "intercept destination")
+		})
+	}
+}
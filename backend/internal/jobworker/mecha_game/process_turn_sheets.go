@@ -20,8 +20,9 @@ func (p *MechaGame) ProcessTurnSheets(ctx context.Context, gameInstanceRec *game
 
 	l.Info("processing mecha turn for instance >%s< turn >%d<", gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
 
-	// Reset accumulated attacks for this processing run
+	// Reset accumulated attacks and movements for this processing run
 	p.pendingAttacks = nil
+	p.pendingMovements = nil
 
 	squadInstanceRecs, err := p.getSquadInstancesForGameInstance(ctx, gameInstanceRec)
 	if err != nil {
@@ -52,16 +53,40 @@ func (p *MechaGame) ProcessTurnSheets(ctx context.Context, gameInstanceRec *game
 		l.Warn("failed to process computer opponent orders >%v< — continuing (non-fatal)", err)
 	}
 
+	// Movement, combat and end-of-turn all play by the run's ruleset
+	ruleset, err := p.Domain.GetMechaGameRuleset(gameInstanceRec.ID)
+	if err != nil {
+		l.Warn("failed to get ruleset for game instance >%s< error >%v<", gameInstanceRec.ID, err)
+		return err
+	}
+
+	// Resolve movement from all collected movement declarations, including
+	// overwatch fire at mechs moving into covered sectors
+	attacks, movementXPMap, err := p.resolveMovement(l, gameInstanceRec, ruleset, p.pendingMovements, p.pendingAttacks)
+	if err != nil {
+		l.Warn("failed to resolve movement >%v< — continuing (non-fatal)", err)
+	}
+	p.pendingMovements = nil
+
 	// Resolve combat from all collected attack declarations
-	xpMap, err := p.resolveCombat(ctx, l, gameInstanceRec, p.pendingAttacks)
+	xpMap, err := p.resolveCombat(ctx, l, gameInstanceRec, ruleset, attacks)
 	if err != nil {
 		l.Warn("failed to resolve combat >%v< — continuing (non-fatal)", err)
 		xpMap = nil
 	}
 	p.pendingAttacks = nil
 
+	if len(movementXPMap) > 0 {
+		if xpMap == nil {
+			xpMap = make(map[string]int, len(movementXPMap))
+		}
+		for mechID, xp := range movementXPMap {
+			xpMap[mechID] += xp
+		}
+	}
+
 	// Run end-of-turn lifecycle (heat dissipation, auto-repair, XP/level-up, supply accrual)
-	if err := p.runEndOfTurn(ctx, l, gameInstanceRec, xpMap); err != nil {
+	if err := p.runEndOfTurn(ctx, l, gameInstanceRec, ruleset, xpMap); err != nil {
 		l.Warn("failed to run end-of-turn lifecycle >%v< — continuing (non-fatal)", err)
 	}
 
@@ -146,16 +171,20 @@ func (p *MechaGame) processComputerOpponentOrders(ctx context.Context, gameInsta
 	return nil
 }
 
-// applyComputerOpponentOrder applies a single mech movement order generated by
-// the decision engine, enforcing the same rules as the human orders processor:
-// destroyed/shutdown/refitting mechs cannot move, and the destination must be
-// within the mech's speed budget. When the move succeeds, a sighting-report
-// movement event is appended to every player squad in the game so the turn
-// sheet's "What Happened" panel surfaces enemy positioning changes.
+// applyComputerOpponentOrder declares a single mech movement, overwatch or
+// intercept order generated by the decision engine for the movement phase,
+// enforcing the same rules as the human orders processor: destroyed, shutdown
+// and refitting mechs cannot move, and the destination must be within the
+// mech's speed budget. Players learn of the move at end of turn, and only when
+// one of their mechs has the moved mech in sensor contact.
 func (p *MechaGame) applyComputerOpponentOrder(gameInstanceRec *game_record.GameInstance, order turnsheet.ScannedMechOrder) error {
 	l := p.Logger.WithFunctionContext("MechaGame/applyComputerOpponentOrder")
 
-	if order.MechInstanceID == "" || order.MoveToSectorInstanceID == "" {
+	if order.MechInstanceID == "" {
+		return nil
+	}
+	if order.MoveToSectorInstanceID == "" && order.OverwatchSectorInstanceID == "" &&
+		order.InterceptTargetMechInstanceID == "" && !order.HoldAfterMove {
 		return nil
 	}
 
@@ -168,129 +197,32 @@ func (p *MechaGame) applyComputerOpponentOrder(gameInstanceRec *game_record.Game
 		return fmt.Errorf("mech instance >%s< does not belong to game instance >%s<", order.MechInstanceID, gameInstanceRec.ID)
 	}
 
-	if mechInstanceRec.Status == mecha_game_record.MechInstanceStatusDestroyed ||
-		mechInstanceRec.Status == mecha_game_record.MechInstanceStatusShutdown {
+	if mechInstanceRec.Status == mecha_game_record.MechInstanceStatusShutdown {
 		l.Info("mech >%s< is %s — ignoring movement order", order.MechInstanceID, mechInstanceRec.Status)
 		return nil
 	}
 
-	if mechInstanceRec.IsRefitting {
-		l.Info("mech >%s< is refitting — ignoring movement order", order.MechInstanceID)
-		return nil
-	}
-
-	sectorInstanceRec, err := p.Domain.GetMechaGameSectorInstanceRec(order.MoveToSectorInstanceID, nil)
-	if err != nil {
-		return fmt.Errorf("failed to get sector instance >%s<: %w", order.MoveToSectorInstanceID, err)
-	}
-
-	if sectorInstanceRec.GameInstanceID != gameInstanceRec.ID {
-		return fmt.Errorf("sector instance >%s< does not belong to game instance >%s<", order.MoveToSectorInstanceID, gameInstanceRec.ID)
-	}
-
-	// Validate destination is within the mech's speed budget.
-	chassisRec, err := p.Domain.GetMechaGameChassisRec(mechInstanceRec.MechaGameChassisID, nil)
-	if err != nil {
-		return fmt.Errorf("failed to get chassis >%s< for movement validation: %w", mechInstanceRec.MechaGameChassisID, err)
-	}
-
 	ordersProc, ok := p.Processors[mecha_game_record.MechaGameTurnSheetTypeOrders].(*turn_sheet_processor.MechaGameOrdersProcessor)
 	if !ok {
 		return fmt.Errorf("orders processor unavailable — cannot validate movement for mech >%s<", order.MechInstanceID)
 	}
 
-	_, reachable := ordersProc.IsSectorReachableWithinSpeed(l, gameInstanceRec.ID, mechInstanceRec.MechaGameSectorInstanceID, order.MoveToSectorInstanceID, chassisRec.Speed)
-	if !reachable {
-		l.Warn("mech >%s< cannot reach sector >%s< within speed budget %d", order.MechInstanceID, order.MoveToSectorInstanceID, chassisRec.Speed)
-		return nil
+	decl, err := ordersProc.DeclareMechMovement(l, gameInstanceRec, order)
+	if err != nil {
+		return err
 	}
-
-	fromSectorInstanceID := mechInstanceRec.MechaGameSectorInstanceID
-	mechInstanceRec.MechaGameSectorInstanceID = order.MoveToSectorInstanceID
-	if _, err := p.Domain.UpdateMechaGameMechInstanceRec(mechInstanceRec); err != nil {
-		return fmt.Errorf("failed to update mech instance >%s<: %w", order.MechInstanceID, err)
+	if decl == nil {
+		return nil
 	}
 
-	l.Info("opponent mech >%s< moved from sector >%s< to sector >%s<",
-		mechInstanceRec.Callsign, fromSectorInstanceID, order.MoveToSectorInstanceID)
+	p.pendingMovements = append(p.pendingMovements, *decl)
 
-	p.recordOpponentMovement(l, gameInstanceRec, mechInstanceRec, fromSectorInstanceID, order.MoveToSectorInstanceID)
+	l.Info("opponent mech >%s< declared movement to >%s< overwatch >%s< intercept >%s<",
+		mechInstanceRec.Callsign, decl.ToSectorInstanceID, decl.OverwatchSectorInstanceID, decl.InterceptTargetMechInstanceID)
 
 	return nil
 }
 
-// recordOpponentMovement appends a movement event to every player-owned squad
-// instance in the game so the player's "What Happened" panel surfaces enemy
-// positioning changes. Failure to write events is logged but not fatal — the
-// movement itself has already been persisted.
-func (p *MechaGame) recordOpponentMovement(
-	l logger.Logger,
-	gameInstanceRec *game_record.GameInstance,
-	mechInstanceRec *mecha_game_record.MechaGameMechInstance,
-	fromSectorInstanceID, toSectorInstanceID string,
-) {
-	fromName := sectorDisplayName(p, fromSectorInstanceID)
-	toName := sectorDisplayName(p, toSectorInstanceID)
-
-	var message string
-	switch {
-	case fromName != "" && toName != "" && fromName != toName:
-		message = fmt.Sprintf("Enemy mech %s moved from %s to %s.", mechInstanceRec.Callsign, fromName, toName)
-	case toName != "":
-		message = fmt.Sprintf("Enemy mech %s moved to %s.", mechInstanceRec.Callsign, toName)
-	default:
-		message = fmt.Sprintf("Enemy mech %s changed position.", mechInstanceRec.Callsign)
-	}
-
-	squads, err := p.Domain.GetManyMechaGameSquadInstanceRecs(&coresql.Options{
-		Params: []coresql.Param{
-			{Col: mecha_game_record.FieldMechaGameSquadInstanceGameInstanceID, Val: gameInstanceRec.ID},
-		},
-	})
-	if err != nil {
-		l.Warn("failed to load squad instances for opponent movement event: %v", err)
-		return
-	}
-
-	evt := turnsheet.TurnEvent{
-		Category: turnsheet.TurnEventCategoryMovement,
-		Icon:     turnsheet.TurnEventIconMovement,
-		Message:  message,
-	}
-
-	for _, squad := range squads {
-		// Skip AI-owned squads — events only surface on player turn sheets.
-		if squad.MechaGameComputerOpponentID.Valid {
-			continue
-		}
-		if err := turnsheet.AppendMechaGameTurnEvent(squad, evt); err != nil {
-			l.Warn("failed to append opponent movement event to squad >%s<: %v", squad.ID, err)
-			continue
-		}
-		if _, err := p.Domain.UpdateMechaGameSquadInstanceRec(squad); err != nil {
-			l.Warn("failed to persist opponent movement event on squad >%s<: %v", squad.ID, err)
-		}
-	}
-}
-
-// sectorDisplayName resolves a sector instance ID to its design name for
-// player-facing events. Returns an empty string on any lookup failure so
-// the caller can fall back to a generic phrasing instead of crashing.
-func sectorDisplayName(p *MechaGame, sectorInstanceID string) string {
-	if sectorInstanceID == "" {
-		return ""
-	}
-	inst, err := p.Domain.GetMechaGameSectorInstanceRec(sectorInstanceID, nil)
-	if err != nil || inst == nil {
-		return ""
-	}
-	design, err := p.Domain.GetMechaGameSectorRec(inst.MechaGameSectorID, nil)
-	if err != nil || design == nil {
-		return ""
-	}
-	return design.Name
-}
-
 // resolveAIOrderIDs inspects each AI-generated order and, when a sector or
 // mech reference looks like a display name rather than a UUID, resolves it
 // back to the correct instance ID. LLM-driven strategies occasionally emit
@@ -308,7 +240,9 @@ func (p *MechaGame) resolveAIOrderIDs(l logger.Logger, gameInstanceID, opponentN
 	for _, o := range orders {
 		if !looksLikeUUID(o.MechInstanceID) ||
 			(o.MoveToSectorInstanceID != "" && !looksLikeUUID(o.MoveToSectorInstanceID)) ||
-			(o.AttackTargetMechInstanceID != "" && !looksLikeUUID(o.AttackTargetMechInstanceID)) {
+			(o.AttackTargetMechInstanceID != "" && !looksLikeUUID(o.AttackTargetMechInstanceID)) ||
+			(o.OverwatchSectorInstanceID != "" && !looksLikeUUID(o.OverwatchSectorInstanceID)) ||
+			(o.InterceptTargetMechInstanceID != "" && !looksLikeUUID(o.InterceptTargetMechInstanceID)) {
 			needsResolve = true
 			break
 		}
@@ -354,6 +288,24 @@ func (p *MechaGame) resolveAIOrderIDs(l logger.Logger, gameInstanceID, opponentN
 				o.AttackTargetMechInstanceID = ""
 			}
 		}
+		if o.OverwatchSectorInstanceID != "" && !looksLikeUUID(o.OverwatchSectorInstanceID) {
+			if id, ok := sectorByName[strings.ToLower(strings.TrimSpace(o.OverwatchSectorInstanceID))]; ok {
+				l.Info("resolved opponent >%s< overwatch reference >%s< to >%s<", opponentName, o.OverwatchSectorInstanceID, id)
+				o.OverwatchSectorInstanceID = id
+			} else {
+				l.Warn("opponent >%s< returned unresolvable overwatch reference >%s< — dropping overwatch", opponentName, o.OverwatchSectorInstanceID)
+				o.OverwatchSectorInstanceID = ""
+			}
+		}
+		if o.InterceptTargetMechInstanceID != "" && !looksLikeUUID(o.InterceptTargetMechInstanceID) {
+			if id, ok := mechByCallsign[strings.ToLower(strings.TrimSpace(o.InterceptTargetMechInstanceID))]; ok {
+				l.Info("resolved opponent >%s< intercept reference >%s< to >%s<", opponentName, o.InterceptTargetMechInstanceID, id)
+				o.InterceptTargetMechInstanceID = id
+			} else {
+				l.Warn("opponent >%s< returned unresolvable intercept reference >%s< — dropping intercept", opponentName, o.InterceptTargetMechInstanceID)
+				o.InterceptTargetMechInstanceID = ""
+			}
+		}
 	}
 	return orders
 }
@@ -458,6 +410,28 @@ func (p *MechaGame) collectAttacksFromOrdersSheet(l logger.Logger, turnSheet *ga
 	p.pendingAttacks = append(p.pendingAttacks, attacks...)
 }
 
+// collectMovementsFromOrdersSheet extracts movement declarations from an
+// orders turn sheet and appends them to p.pendingMovements.
+func (p *MechaGame) collectMovementsFromOrdersSheet(l logger.Logger, gameInstanceRec *game_record.GameInstance, turnSheet *game_record.GameTurnSheet) {
+	if turnSheet.SheetType != mecha_game_record.MechaGameTurnSheetTypeOrders {
+		return
+	}
+	ordersProc, ok := p.Processors[mecha_game_record.MechaGameTurnSheetTypeOrders]
+	if !ok {
+		return
+	}
+	op, ok := ordersProc.(*turn_sheet_processor.MechaGameOrdersProcessor)
+	if !ok {
+		return
+	}
+	movements, err := op.ExtractMovementDeclarations(l, gameInstanceRec, turnSheet)
+	if err != nil {
+		l.Warn("failed to extract movement declarations: %v", err)
+		return
+	}
+	p.pendingMovements = append(p.pendingMovements, movements...)
+}
+
 // processTurnSheet processes a single turn sheet for a squad instance.
 func (p *MechaGame) processTurnSheet(ctx context.Context, gameInstanceRec *game_record.GameInstance, squadInstance *mecha_game_record.MechaGameSquadInstance, turnSheet *game_record.GameTurnSheet) error {
 	l := p.Logger.WithFunctionContext("MechaGame/processTurnSheet")
@@ -479,7 +453,9 @@ func (p *MechaGame) processTurnSheet(ctx context.Context, gameInstanceRec *game_
 		return err
 	}
 
-	// Collect attack declarations from orders sheets for combat resolution
+	// Collect movement and attack declarations from orders sheets for the
+	// movement phase and combat resolution
+	p.collectMovementsFromOrdersSheet(l, gameInstanceRec, turnSheet)
 	p.collectAttacksFromOrdersSheet(l, turnSheet)
 
 	return nil
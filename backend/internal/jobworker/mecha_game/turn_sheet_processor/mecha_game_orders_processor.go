@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"gitlab.com/alienspaces/playbymail/core/convert"
 	"gitlab.com/alienspaces/playbymail/core/nullstring"
@@ -11,6 +12,7 @@ import (
 	coresql "gitlab.com/alienspaces/playbymail/core/sql"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
@@ -180,6 +182,10 @@ func (p *MechaGameOrdersProcessor) GetSheetType() string {
 }
 
 // ProcessTurnSheetResponse processes a single orders turn sheet response (implements TurnSheetProcessor interface).
+// Orders are not applied here: movement, overwatch and intercept orders are
+// collected with ExtractMovementDeclarations and resolved in the movement phase
+// once every squad's orders are in, and attacks are collected with
+// ExtractAttackDeclarations for combat resolution.
 func (p *MechaGameOrdersProcessor) ProcessTurnSheetResponse(ctx context.Context, gameInstanceRec *game_record.GameInstance, squadInstance *mecha_game_record.MechaGameSquadInstance, turnSheet *game_record.GameTurnSheet) error {
 	l := p.Logger.WithFunctionContext("MechaGameOrdersProcessor/ProcessTurnSheetResponse")
 
@@ -200,82 +206,133 @@ func (p *MechaGameOrdersProcessor) ProcessTurnSheetResponse(ctx context.Context,
 		return nil
 	}
 
-	// Reload squadInstance to get latest state for event appending
-	freshSquad, err := p.Domain.GetMechaGameSquadInstanceRec(squadInstance.ID, nil)
-	if err != nil {
-		l.Warn("failed to reload squad instance for events >%v<", err)
-		freshSquad = squadInstance
+	l.Info("accepted >%d< mech orders for squad instance >%s<", len(scanData.MechOrders), squadInstance.ID)
+
+	return nil
+}
+
+// MovementDeclaration is a mech's validated movement, overwatch and intercept
+// orders for the turn, resolved in the movement phase.
+type MovementDeclaration struct {
+	MechInstanceID string
+	// ToSectorInstanceID is the destination, empty when the mech does not move
+	// to a chosen sector
+	ToSectorInstanceID string
+	// Speed is the movement points the mech can spend this turn
+	Speed int
+	// BaseSpeed is the chassis speed; a move costing more fires the jump jets
+	BaseSpeed int
+	// JumpJetHeat is the heat the mech's jump jets generate when they fire
+	JumpJetHeat int
+	// HoldAfterMove puts the mech on overwatch over its destination once it
+	// has finished moving
+	HoldAfterMove bool
+	// OverwatchSectorInstanceID is the sector the mech stays in place to cover
+	OverwatchSectorInstanceID string
+	// InterceptTargetMechInstanceID is the enemy mech the mech moves to engage
+	InterceptTargetMechInstanceID string
+}
+
+// ExtractMovementDeclarations reads scanned data from an orders turn sheet and
+// returns the validated movement, overwatch and intercept orders. Invalid
+// orders are logged and dropped so the rest of the squad's orders still
+// resolve.
+func (p *MechaGameOrdersProcessor) ExtractMovementDeclarations(
+	l logger.Logger,
+	gameInstanceRec *game_record.GameInstance,
+	turnSheet *game_record.GameTurnSheet,
+) ([]MovementDeclaration, error) {
+	if len(turnSheet.ScannedData) == 0 {
+		return nil, nil
 	}
 
-	for _, order := range scanData.MechOrders {
-		if moveEvent := p.processMechOrderWithEvent(l, gameInstanceRec, order); moveEvent != nil {
-			if err := turnsheet.AppendMechaGameTurnEvent(freshSquad, *moveEvent); err != nil {
-				l.Warn("failed to append movement event for mech >%s<: %v", order.MechInstanceID, err)
-			}
-		}
+	var scanData turnsheet.OrdersScanData
+	if err := json.Unmarshal(turnSheet.ScannedData, &scanData); err != nil {
+		return nil, fmt.Errorf("failed to parse scanned data: %w", err)
 	}
 
-	if _, err := p.Domain.UpdateMechaGameSquadInstanceRec(freshSquad); err != nil {
-		l.Warn("failed to persist movement events for squad >%s<: %v", squadInstance.ID, err)
+	var movements []MovementDeclaration
+	for _, order := range scanData.MechOrders {
+		movement, err := p.DeclareMechMovement(l, gameInstanceRec, order)
+		if err != nil {
+			l.Warn("failed to declare movement for mech >%s< >%v<", order.MechInstanceID, err)
+			continue
+		}
+		if movement != nil {
+			movements = append(movements, *movement)
+		}
 	}
 
-	return nil
+	return movements, nil
 }
 
-// processMechOrderWithEvent applies movement and returns a TurnEvent if movement
-// occurred, or nil if no event should be generated.
-func (p *MechaGameOrdersProcessor) processMechOrderWithEvent(
+// DeclareMechMovement validates a mech's movement, overwatch and intercept
+// orders and returns them as a movement declaration, or nil when the mech has
+// no such orders or cannot carry them out. Overwatch keeps the mech in place
+// and takes precedence over intercept, which in turn replaces a move order;
+// holding without a move order overwatches the mech's own sector.
+// Destroyed and refitting mechs cannot move, the destination must be within
+// the mech's effective speed (chassis speed plus jump jets) and an overwatched
+// sector must be the mech's own sector or one linked to it.
+func (p *MechaGameOrdersProcessor) DeclareMechMovement(
 	l logger.Logger,
 	gameInstanceRec *game_record.GameInstance,
 	order turnsheet.ScannedMechOrder,
-) *turnsheet.TurnEvent {
+) (*MovementDeclaration, error) {
 	if order.MechInstanceID == "" {
-		return nil
+		return nil, nil
 	}
 
-	if order.MoveToSectorInstanceID == "" {
+	if order.MoveToSectorInstanceID == "" && order.OverwatchSectorInstanceID == "" && order.InterceptTargetMechInstanceID == "" && !order.HoldAfterMove {
 		l.Info("no movement order for mech >%s< — staying in place", order.MechInstanceID)
-		return nil
+		return nil, nil
 	}
 
 	mechInstanceRec, err := p.Domain.GetMechaGameMechInstanceRec(order.MechInstanceID, nil)
 	if err != nil {
-		l.Warn("failed to get mech instance >%s< >%v<", order.MechInstanceID, err)
-		return nil
+		return nil, fmt.Errorf("failed to get mech instance >%s<: %w", order.MechInstanceID, err)
 	}
 
 	if mechInstanceRec.GameInstanceID != gameInstanceRec.ID {
-		l.Warn("mech instance >%s< does not belong to game instance >%s<", order.MechInstanceID, gameInstanceRec.ID)
-		return nil
+		return nil, fmt.Errorf("mech instance >%s< does not belong to game instance >%s<", order.MechInstanceID, gameInstanceRec.ID)
 	}
 
 	if mechInstanceRec.Status == mecha_game_record.MechInstanceStatusDestroyed {
 		l.Info("mech >%s< is destroyed — ignoring movement order", order.MechInstanceID)
-		return nil
+		return nil, nil
 	}
 
 	if mechInstanceRec.IsRefitting {
 		l.Info("mech >%s< is refitting — ignoring movement order", order.MechInstanceID)
-		return nil
+		return nil, nil
 	}
 
-	sectorInstanceRec, err := p.Domain.GetMechaGameSectorInstanceRec(order.MoveToSectorInstanceID, nil)
-	if err != nil {
-		l.Warn("failed to get sector instance >%s< >%v<", order.MoveToSectorInstanceID, err)
-		return nil
+	// Holding without a move order overwatches the mech's own sector
+	if order.OverwatchSectorInstanceID == "" && order.HoldAfterMove &&
+		order.MoveToSectorInstanceID == "" && order.InterceptTargetMechInstanceID == "" {
+		order.OverwatchSectorInstanceID = mechInstanceRec.MechaGameSectorInstanceID
 	}
 
-	if sectorInstanceRec.GameInstanceID != gameInstanceRec.ID {
-		l.Warn("sector instance >%s< does not belong to game instance >%s<", order.MoveToSectorInstanceID, gameInstanceRec.ID)
-		return nil
+	if order.OverwatchSectorInstanceID != "" {
+		sectorMap, err := p.Domain.GetMechaGameSectorMap(gameInstanceRec.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sector map: %w", err)
+		}
+		if _, ok := sectorMap.Distances(mechInstanceRec.MechaGameSectorInstanceID, 1)[order.OverwatchSectorInstanceID]; !ok {
+			l.Warn("mech >%s< cannot overwatch sector >%s< — not its own sector or a linked one", order.MechInstanceID, order.OverwatchSectorInstanceID)
+			return nil, nil
+		}
+		return &MovementDeclaration{
+			MechInstanceID:            mechInstanceRec.ID,
+			OverwatchSectorInstanceID: order.OverwatchSectorInstanceID,
+		}, nil
 	}
 
-	// Validate that the destination is within the mech's *effective* speed
-	// budget (chassis.Speed + jump-jets SpeedBonus, zeroed while refitting).
+	// Movement budget is the mech's *effective* speed (chassis.Speed +
+	// jump-jets SpeedBonus, zeroed while refitting).
 	chassisRec, err := p.Domain.GetMechaGameChassisRec(mechInstanceRec.MechaGameChassisID, nil)
 	if err != nil {
-		l.Warn("failed to get chassis >%s< for movement validation: %v", mechInstanceRec.MechaGameChassisID, err)
-		return nil
+		return nil, fmt.Errorf("failed to get chassis >%s< for movement validation: %w", mechInstanceRec.MechaGameChassisID, err)
 	}
 
 	var equipmentEntries []mecha_game_record.EquipmentConfigEntry
@@ -289,51 +346,50 @@ func (p *MechaGameOrdersProcessor) processMechOrderWithEvent(
 		l.Warn("failed to load equipment for mech >%s< >%v<", mechInstanceRec.ID, err)
 	}
 	effects := domain.AggregateMechaGameEquipmentEffects(equipmentEntries, equipmentByID, mechInstanceRec.IsRefitting)
-	effectiveSpeed := domain.EffectiveMechaGameSpeed(chassisRec, effects)
 
-	hops, reachable := p.IsSectorReachableWithinSpeed(l, gameInstanceRec.ID, mechInstanceRec.MechaGameSectorInstanceID, order.MoveToSectorInstanceID, effectiveSpeed)
-	if !reachable {
-		l.Warn("mech >%s< cannot reach sector >%s< within speed budget %d (distance > %d hops)",
-			order.MechInstanceID, order.MoveToSectorInstanceID, effectiveSpeed, effectiveSpeed)
-		return nil
+	// Jump-jets heat predicate: if the mech spends more movement points than
+	// its base chassis speed, any jump_jets equipment with heat_cost > 0
+	// fires this turn. The movement phase applies the heat once the path is
+	// known.
+	movement := &MovementDeclaration{
+		MechInstanceID: mechInstanceRec.ID,
+		Speed:          domain.EffectiveMechaGameSpeed(chassisRec, effects),
+		BaseSpeed:      chassisRec.Speed,
+		JumpJetHeat:    domain.MechaGameEquipmentJumpJetHeatCost(equipmentEntries, equipmentByID, mechInstanceRec.IsRefitting),
+	}
+
+	if order.InterceptTargetMechInstanceID != "" {
+		targetRec, err := p.Domain.GetMechaGameMechInstanceRec(order.InterceptTargetMechInstanceID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get intercept target >%s<: %w", order.InterceptTargetMechInstanceID, err)
+		}
+		if targetRec.GameInstanceID != gameInstanceRec.ID || targetRec.MechaGameSquadInstanceID == mechInstanceRec.MechaGameSquadInstanceID {
+			l.Warn("mech >%s< cannot intercept >%s< — not an enemy mech in this game", order.MechInstanceID, order.InterceptTargetMechInstanceID)
+			return nil, nil
+		}
+		movement.InterceptTargetMechInstanceID = targetRec.ID
+		return movement, nil
 	}
 
-	sectorRec, err := p.Domain.GetMechaGameSectorRec(sectorInstanceRec.MechaGameSectorID, nil)
+	sectorInstanceRec, err := p.Domain.GetMechaGameSectorInstanceRec(order.MoveToSectorInstanceID, nil)
 	if err != nil {
-		l.Warn("failed to get sector design >%s<: %v", sectorInstanceRec.MechaGameSectorID, err)
+		return nil, fmt.Errorf("failed to get sector instance >%s<: %w", order.MoveToSectorInstanceID, err)
 	}
 
-	// Jump-jets heat predicate: if the mech traveled more hops than its
-	// base chassis speed, any jump_jets equipment with heat_cost > 0 fires
-	// this turn. Apply the heat immediately to the mech's running heat so
-	// combat resolution and end-of-turn both see the updated value.
-	if hops > chassisRec.Speed {
-		jumpHeat := domain.MechaGameEquipmentJumpJetHeatCost(
-			equipmentEntries, equipmentByID, mechInstanceRec.IsRefitting,
-		)
-		if jumpHeat > 0 {
-			mechInstanceRec.CurrentHeat += jumpHeat
-		}
+	if sectorInstanceRec.GameInstanceID != gameInstanceRec.ID {
+		return nil, fmt.Errorf("sector instance >%s< does not belong to game instance >%s<", order.MoveToSectorInstanceID, gameInstanceRec.ID)
 	}
 
-	mechInstanceRec.MechaGameSectorInstanceID = order.MoveToSectorInstanceID
-	if _, err := p.Domain.UpdateMechaGameMechInstanceRec(mechInstanceRec); err != nil {
-		l.Warn("failed to update mech instance >%s< >%v<", order.MechInstanceID, err)
-		return nil
+	if _, reachable := p.IsSectorReachableWithinSpeed(l, gameInstanceRec.ID, mechInstanceRec.MechaGameSectorInstanceID, order.MoveToSectorInstanceID, movement.Speed); !reachable {
+		l.Warn("mech >%s< cannot reach sector >%s< within speed budget %d (movement cost > %d)",
+			order.MechInstanceID, order.MoveToSectorInstanceID, movement.Speed, movement.Speed)
+		return nil, nil
 	}
 
-	l.Info("moved mech >%s< to sector instance >%s<", order.MechInstanceID, order.MoveToSectorInstanceID)
+	movement.ToSectorInstanceID = order.MoveToSectorInstanceID
+	movement.HoldAfterMove = order.HoldAfterMove
 
-	sectorName := order.MoveToSectorInstanceID
-	if sectorRec != nil {
-		sectorName = sectorRec.Name
-	}
-	evt := turnsheet.TurnEvent{
-		Category: turnsheet.TurnEventCategoryMovement,
-		Icon:     turnsheet.TurnEventIconMovement,
-		Message:  fmt.Sprintf("%s moved to %s.", mechInstanceRec.Callsign, sectorName),
-	}
-	return &evt
+	return movement, nil
 }
 
 // AttackDeclaration represents a declared attack from one mech to another.
@@ -455,23 +511,37 @@ func (p *MechaGameOrdersProcessor) CreateNextTurnSheet(ctx context.Context, game
 			l.Warn("failed to get reachable sectors for mech >%s< >%v<", mech.MechInstanceID, err)
 		}
 		mech.ReachableSectors = reachable
+		overwatch, err := p.getOverwatchSectorOptions(gameInstanceRec.ID, mechInst.MechaGameSectorInstanceID)
+		if err != nil {
+			l.Warn("failed to get overwatch sectors for mech >%s< >%v<", mech.MechInstanceID, err)
+		}
+		mech.OverwatchSectors = overwatch
 		for _, opt := range reachable {
 			if !availableSectorsSeen[opt.SectorInstanceID] {
 				availableSectorsSeen[opt.SectorInstanceID] = true
+				// Movement cost depends on where each mech starts
+				opt.MovementCost = 0
 				availableSectors = append(availableSectors, opt)
 			}
 		}
 	}
 	_ = sectorInstanceIDs
 
-	// Step 8: Get enemy mech instances visible to this squad
-	enemyMechs, err := p.getEnemyMechOptions(l, gameInstanceRec, squadInstance)
+	// Step 8: Get enemy mechs detected by this squad and its last known contacts
+	enemyMechs, lastKnownContacts, err := p.getEnemyMechOptions(l, gameInstanceRec, squadInstance)
 	if err != nil {
 		l.Warn("failed to get enemy mechs >%v<", err)
 		// Non-fatal: continue with no attack options
 	}
 
-	// Step 9: Generate turn sheet code
+	// Step 9: Get objective owners and victory point standings
+	objectives, victoryPointStandings, err := p.getObjectiveStandings(l, gameInstanceRec, squadInstance)
+	if err != nil {
+		l.Warn("failed to get objective standings >%v<", err)
+		// Non-fatal: continue without standings
+	}
+
+	// Step 10: Generate turn sheet code
 	turnSheetCode, err := turnsheetutil.GeneratePlayGameTurnSheetCode(record.NewRecordID())
 	if err != nil {
 		l.Warn("failed to generate turn sheet code >%v<", err)
@@ -479,8 +549,9 @@ func (p *MechaGameOrdersProcessor) CreateNextTurnSheet(ctx context.Context, game
 	}
 
 	turnNumber := gameInstanceRec.CurrentTurn
-	title := "Mech Orders"
-	instructions := turnsheet.DefaultOrdersInstructions()
+	locale := accountLocale(l, p.Domain, accountUserRec.AccountID)
+	title := i18n.T(locale, "turnsheet.mecha.orders.title")
+	instructions := turnsheet.OrdersInstructions(locale)
 
 	// Read and clear turn events accumulated during previous turn processing
 	turnEvents, err := turnsheet.ReadAndClearMechaGameTurnEvents(squadInstance)
@@ -499,6 +570,7 @@ func (p *MechaGameOrdersProcessor) CreateNextTurnSheet(ctx context.Context, game
 			GameType:              convert.Ptr(gameRec.GameType),
 			TurnNumber:            &turnNumber,
 			AccountName:           convert.Ptr(accountUserRec.Email),
+			Locale:                &locale,
 			TurnSheetTitle:        &title,
 			TurnSheetDescription:  convert.Ptr(gameRec.Description),
 			TurnSheetInstructions: &instructions,
@@ -506,10 +578,13 @@ func (p *MechaGameOrdersProcessor) CreateNextTurnSheet(ctx context.Context, game
 			BackgroundImage:       backgroundImage,
 			TurnEvents:            turnEvents,
 		},
-		SquadName:        squadRec.Name,
-		SquadMechs:       squadMechs,
-		AvailableSectors: availableSectors,
-		EnemyMechs:       enemyMechs,
+		SquadName:             squadRec.Name,
+		SquadMechs:            squadMechs,
+		AvailableSectors:      availableSectors,
+		EnemyMechs:            enemyMechs,
+		LastKnownContacts:     lastKnownContacts,
+		Objectives:            objectives,
+		VictoryPointStandings: victoryPointStandings,
 	}
 
 	sheetDataBytes, err := json.Marshal(sheetData)
@@ -517,7 +592,7 @@ func (p *MechaGameOrdersProcessor) CreateNextTurnSheet(ctx context.Context, game
 		return nil, fmt.Errorf("failed to marshal sheet data: %w", err)
 	}
 
-	// Step 10: Create the game_turn_sheet record
+	// Step 11: Create the game_turn_sheet record
 	gameTurnSheet := &game_record.GameTurnSheet{
 		GameID:           gameInstanceRec.GameID,
 		AccountID:        accountUserRec.AccountID,
@@ -553,180 +628,216 @@ func (p *MechaGameOrdersProcessor) CreateNextTurnSheet(ctx context.Context, game
 }
 
 // getReachableSectorOptions returns all sector instances reachable from the given
-// starting sector within the given speed (number of hops), using BFS over sector links.
-func (p *MechaGameOrdersProcessor) getReachableSectorOptions(l logger.Logger, gameInstanceID string, startSectorInstanceID string, speed int) ([]turnsheet.SectorOption, error) {
+// starting sector within the given speed in movement points, where entering a
+// sector costs the run's movement cost for its terrain.
+func (p *MechaGameOrdersProcessor) getReachableSectorOptions(_ logger.Logger, gameInstanceID string, startSectorInstanceID string, speed int) ([]turnsheet.SectorOption, error) {
 	if speed <= 0 {
 		return nil, nil
 	}
 
-	type bfsNode struct {
-		sectorInstanceID string
-		depth            int
+	ruleset, err := p.Domain.GetMechaGameRuleset(gameInstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ruleset: %w", err)
 	}
 
-	seen := map[string]bool{startSectorInstanceID: true}
-	queue := []bfsNode{{sectorInstanceID: startSectorInstanceID, depth: 0}}
-	var options []turnsheet.SectorOption
-
-	for len(queue) > 0 {
-		cur := queue[0]
-		queue = queue[1:]
-
-		if cur.depth >= speed {
-			continue
-		}
+	sectorMap, err := p.Domain.GetMechaGameSectorMap(gameInstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sector map: %w", err)
+	}
 
-		sectorInstRec, err := p.Domain.GetMechaGameSectorInstanceRec(cur.sectorInstanceID, nil)
-		if err != nil {
-			l.Warn("failed to get sector instance >%s< >%v<", cur.sectorInstanceID, err)
-			continue
-		}
+	costs := sectorMap.MovementCosts(startSectorInstanceID, speed, ruleset)
 
-		links, err := p.Domain.GetManyMechaGameSectorLinkRecs(&coresql.Options{
-			Params: []coresql.Param{
-				{Col: mecha_game_record.FieldMechaGameSectorLinkFromMechaGameSectorID, Val: sectorInstRec.MechaGameSectorID},
-			},
+	options := make([]turnsheet.SectorOption, 0, len(costs))
+	for sectorInstanceID, cost := range costs {
+		options = append(options, turnsheet.SectorOption{
+			SectorInstanceID: sectorInstanceID,
+			SectorName:       sectorMap.SectorNames[sectorInstanceID],
+			TerrainType:      sectorMap.TerrainTypes[sectorInstanceID],
+			MovementCost:     cost,
 		})
-		if err != nil {
-			l.Warn("failed to get sector links for sector >%s< >%v<", sectorInstRec.MechaGameSectorID, err)
-			continue
+	}
+
+	// Cheapest moves first, then by name so the sheet lists sectors in a
+	// stable order
+	sort.Slice(options, func(i, j int) bool {
+		if options[i].MovementCost != options[j].MovementCost {
+			return options[i].MovementCost < options[j].MovementCost
 		}
+		return options[i].SectorName < options[j].SectorName
+	})
 
-		for _, link := range links {
-			linkedInstances, err := p.Domain.GetManyMechaGameSectorInstanceRecs(&coresql.Options{
-				Params: []coresql.Param{
-					{Col: mecha_game_record.FieldMechaGameSectorInstanceGameInstanceID, Val: gameInstanceID},
-					{Col: mecha_game_record.FieldMechaGameSectorInstanceMechaGameSectorID, Val: link.ToMechaGameSectorID},
-				},
-				Limit: 1,
-			})
-			if err != nil || len(linkedInstances) == 0 {
-				continue
-			}
+	return options, nil
+}
 
-			destID := linkedInstances[0].ID
-			if seen[destID] {
-				continue
-			}
-			seen[destID] = true
+// getOverwatchSectorOptions returns the sectors a mech standing in the given
+// sector can cover on overwatch: its own sector first, then the sectors linked
+// to it by name.
+func (p *MechaGameOrdersProcessor) getOverwatchSectorOptions(gameInstanceID string, sectorInstanceID string) ([]turnsheet.SectorOption, error) {
+	sectorMap, err := p.Domain.GetMechaGameSectorMap(gameInstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sector map: %w", err)
+	}
 
-			sectorRec, err := p.Domain.GetMechaGameSectorRec(link.ToMechaGameSectorID, nil)
-			if err != nil {
-				l.Warn("failed to get sector design >%s< >%v<", link.ToMechaGameSectorID, err)
-				continue
-			}
+	options := make([]turnsheet.SectorOption, 0, len(sectorMap.Links[sectorInstanceID])+1)
+	for id := range sectorMap.Distances(sectorInstanceID, 1) {
+		options = append(options, turnsheet.SectorOption{
+			SectorInstanceID: id,
+			SectorName:       sectorMap.SectorNames[id],
+			TerrainType:      sectorMap.TerrainTypes[id],
+		})
+	}
 
-			options = append(options, turnsheet.SectorOption{
-				SectorInstanceID: destID,
-				SectorName:       sectorRec.Name,
-			})
-			queue = append(queue, bfsNode{sectorInstanceID: destID, depth: cur.depth + 1})
+	sort.Slice(options, func(i, j int) bool {
+		if (options[i].SectorInstanceID == sectorInstanceID) != (options[j].SectorInstanceID == sectorInstanceID) {
+			return options[i].SectorInstanceID == sectorInstanceID
 		}
-	}
+		return options[i].SectorName < options[j].SectorName
+	})
 
 	return options, nil
 }
 
-// IsSectorReachableWithinSpeed returns (hops, true) if destID is reachable from fromID
-// within the given number of hops using BFS, or (0, false) if not reachable.
+// IsSectorReachableWithinSpeed returns (movementCost, true) if destID is
+// reachable from fromID within the given speed in movement points, where
+// entering a sector costs the run's movement cost for its terrain, or
+// (0, false) if not reachable.
 func (p *MechaGameOrdersProcessor) IsSectorReachableWithinSpeed(l logger.Logger, gameInstanceID, fromSectorInstanceID, destSectorInstanceID string, speed int) (int, bool) {
 	if fromSectorInstanceID == destSectorInstanceID {
 		return 0, true
 	}
 
-	type bfsNode struct {
-		sectorInstanceID string
-		depth            int
+	ruleset, err := p.Domain.GetMechaGameRuleset(gameInstanceID)
+	if err != nil {
+		l.Warn("failed to get ruleset for speed check >%v<", err)
+		return 0, false
 	}
 
-	seen := map[string]bool{fromSectorInstanceID: true}
-	queue := []bfsNode{{sectorInstanceID: fromSectorInstanceID, depth: 0}}
+	sectorMap, err := p.Domain.GetMechaGameSectorMap(gameInstanceID)
+	if err != nil {
+		l.Warn("failed to get sector map for speed check >%v<", err)
+		return 0, false
+	}
 
-	for len(queue) > 0 {
-		cur := queue[0]
-		queue = queue[1:]
+	cost, ok := sectorMap.MovementCosts(fromSectorInstanceID, speed, ruleset)[destSectorInstanceID]
+	if !ok {
+		return 0, false
+	}
 
-		if cur.depth >= speed {
-			continue
-		}
+	return cost, true
+}
 
-		sectorInstRec, err := p.Domain.GetMechaGameSectorInstanceRec(cur.sectorInstanceID, nil)
-		if err != nil {
-			l.Warn("failed to get sector instance >%s< for speed check: %v", cur.sectorInstanceID, err)
-			continue
-		}
+// getEnemyMechOptions returns the enemy mechs the given squad's sensors detect,
+// which can be attacked, and the squad's last known contacts for enemy mechs it
+// can no longer detect.
+func (p *MechaGameOrdersProcessor) getEnemyMechOptions(_ logger.Logger, gameInstanceRec *game_record.GameInstance, squadInstance *mecha_game_record.MechaGameSquadInstance) ([]turnsheet.EnemyMechOption, []turnsheet.ContactOption, error) {
+	detection, err := p.Domain.GetMechaGameDetection(gameInstanceRec.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get detection: %w", err)
+	}
 
-		links, err := p.Domain.GetManyMechaGameSectorLinkRecs(&coresql.Options{
-			Params: []coresql.Param{
-				{Col: mecha_game_record.FieldMechaGameSectorLinkFromMechaGameSectorID, Val: sectorInstRec.MechaGameSectorID},
-			},
+	var enemies []turnsheet.EnemyMechOption
+	for _, contact := range detection.Contacts(squadInstance.ID, gameInstanceRec.CurrentTurn) {
+		enemies = append(enemies, turnsheet.EnemyMechOption{
+			MechInstanceID: contact.MechInstanceID,
+			Callsign:       contact.Callsign,
+			SectorName:     contact.SectorName,
 		})
-		if err != nil {
-			continue
-		}
+	}
 
-		for _, link := range links {
-			linkedInstances, err := p.Domain.GetManyMechaGameSectorInstanceRecs(&coresql.Options{
-				Params: []coresql.Param{
-					{Col: mecha_game_record.FieldMechaGameSectorInstanceGameInstanceID, Val: gameInstanceID},
-					{Col: mecha_game_record.FieldMechaGameSectorInstanceMechaGameSectorID, Val: link.ToMechaGameSectorID},
-				},
-				Limit: 1,
-			})
-			if err != nil || len(linkedInstances) == 0 {
-				continue
-			}
+	contacts, err := domain.GetMechaGameSquadContacts(squadInstance)
+	if err != nil {
+		return enemies, nil, fmt.Errorf("failed to read squad contacts: %w", err)
+	}
 
-			destID := linkedInstances[0].ID
-			if destID == destSectorInstanceID {
-				return cur.depth + 1, true
-			}
-			if !seen[destID] {
-				seen[destID] = true
-				queue = append(queue, bfsNode{sectorInstanceID: destID, depth: cur.depth + 1})
-			}
+	var lastKnown []turnsheet.ContactOption
+	for _, contact := range contacts {
+		if detection.IsDetected(squadInstance.ID, contact.MechInstanceID) {
+			continue
 		}
+		lastKnown = append(lastKnown, turnsheet.ContactOption{
+			Callsign:     contact.Callsign,
+			SectorName:   contact.SectorName,
+			LastSeenTurn: contact.LastSeenTurn,
+		})
 	}
 
-	return 0, false
+	return enemies, lastKnown, nil
 }
 
-// getEnemyMechOptions collects all enemy mech instances visible to the given squad.
-func (p *MechaGameOrdersProcessor) getEnemyMechOptions(_ logger.Logger, gameInstanceRec *game_record.GameInstance, squadInstance *mecha_game_record.MechaGameSquadInstance) ([]turnsheet.EnemyMechOption, error) {
-	// Get all mech instances for this game instance
-	allMechInstances, err := p.Domain.GetManyMechaGameMechInstanceRecs(&coresql.Options{
+// getObjectiveStandings lists the objective sectors of the game instance with
+// the squad owning each, and the victory point totals of every squad, highest
+// first. Both are empty when the game has no objective sectors. Capture
+// progress is only shown for the player's own squad so objectives do not
+// reveal where enemy mechs are standing.
+func (p *MechaGameOrdersProcessor) getObjectiveStandings(l logger.Logger, gameInstanceRec *game_record.GameInstance, squadInstance *mecha_game_record.MechaGameSquadInstance) ([]turnsheet.ObjectiveStanding, []turnsheet.VictoryPointStanding, error) {
+	sectorInsts, err := p.Domain.GetManyMechaGameSectorInstanceRecs(&coresql.Options{
 		Params: []coresql.Param{
-			{Col: mecha_game_record.FieldMechaGameMechInstanceGameInstanceID, Val: gameInstanceRec.ID},
+			{Col: mecha_game_record.FieldMechaGameSectorInstanceGameInstanceID, Val: gameInstanceRec.ID},
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get mech instances: %w", err)
+		return nil, nil, fmt.Errorf("failed to get sector instances: %w", err)
 	}
 
-	var enemies []turnsheet.EnemyMechOption
-	for _, mechInst := range allMechInstances {
-		if mechInst.MechaGameSquadInstanceID == squadInstance.ID {
+	squadInsts, err := p.Domain.GetManyMechaGameSquadInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_game_record.FieldMechaGameSquadInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get squad instances: %w", err)
+	}
+
+	squadNames := make(map[string]string, len(squadInsts))
+	for _, squadInst := range squadInsts {
+		squadNames[squadInst.ID] = squadInstanceDisplayName(l, p.Domain, squadInst)
+	}
+
+	var objectives []turnsheet.ObjectiveStanding
+	for _, sectorInst := range sectorInsts {
+		sectorRec, err := p.Domain.GetMechaGameSectorRec(sectorInst.MechaGameSectorID, nil)
+		if err != nil {
+			l.Warn("failed to get sector >%s< >%v<", sectorInst.MechaGameSectorID, err)
 			continue
 		}
-		if mechInst.Status == mecha_game_record.MechInstanceStatusDestroyed {
+		if !sectorRec.IsObjectiveSector {
 			continue
 		}
 
-		sectorName := ""
-		sectorInstRec, err := p.Domain.GetMechaGameSectorInstanceRec(mechInst.MechaGameSectorInstanceID, nil)
-		if err == nil {
-			sectorRec, err2 := p.Domain.GetMechaGameSectorRec(sectorInstRec.MechaGameSectorID, nil)
-			if err2 == nil {
-				sectorName = sectorRec.Name
-			}
+		objective := turnsheet.ObjectiveStanding{
+			SectorName:    sectorRec.Name,
+			VictoryPoints: sectorRec.VictoryPoints,
+			CaptureTurns:  max(sectorRec.CaptureTurns, 1),
+			OwnerName:     squadNames[sectorInst.OwningSquadInstanceID.String],
+			IsOwnSquad:    sectorInst.OwningSquadInstanceID.String == squadInstance.ID,
 		}
+		if !objective.IsOwnSquad && sectorInst.ControllingSquadInstanceID.String == squadInstance.ID {
+			objective.HeldTurns = gameInstanceRec.CurrentTurn - int(sectorInst.ControlledSinceTurn.Int64)
+		}
+		objectives = append(objectives, objective)
+	}
+	if len(objectives) == 0 {
+		return nil, nil, nil
+	}
 
-		enemies = append(enemies, turnsheet.EnemyMechOption{
-			MechInstanceID: mechInst.ID,
-			Callsign:       mechInst.Callsign,
-			SectorName:     sectorName,
+	sort.Slice(objectives, func(i, j int) bool {
+		return objectives[i].SectorName < objectives[j].SectorName
+	})
+
+	standings := make([]turnsheet.VictoryPointStanding, 0, len(squadInsts))
+	for _, squadInst := range squadInsts {
+		standings = append(standings, turnsheet.VictoryPointStanding{
+			SquadName:     squadNames[squadInst.ID],
+			VictoryPoints: squadInst.VictoryPoints,
+			IsOwnSquad:    squadInst.ID == squadInstance.ID,
 		})
 	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].VictoryPoints != standings[j].VictoryPoints {
+			return standings[i].VictoryPoints > standings[j].VictoryPoints
+		}
+		return standings[i].SquadName < standings[j].SquadName
+	})
 
-	return enemies, nil
+	return objectives, standings, nil
 }
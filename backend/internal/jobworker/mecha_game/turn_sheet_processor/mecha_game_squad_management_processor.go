@@ -10,6 +10,7 @@ import (
 	coresql "gitlab.com/alienspaces/playbymail/core/sql"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
@@ -69,6 +70,14 @@ func (p *MechaGameSquadManagementProcessor) ProcessTurnSheetResponse(
 		return fmt.Errorf("failed to reload squad instance: %w", err)
 	}
 
+	// Weapons held as campaign salvage are installed without spending supply
+	// points.
+	salvage, err := domain.DecodeMechaGameSalvage(freshSquad.Salvage)
+	if err != nil {
+		l.Warn("failed to decode salvage for squad >%s<: %v", freshSquad.ID, err)
+	}
+	salvageChanged := false
+
 	var spCost int
 	var events []turnsheet.TurnEvent
 
@@ -117,12 +126,10 @@ func (p *MechaGameSquadManagementProcessor) ProcessTurnSheetResponse(
 				mechInst.CurrentStructure = chassisRec.StructurePoints
 				mechInst.IsRefitting = true
 				mechChanged = true
-				events = append(events, turnsheet.TurnEvent{
-					Category: turnsheet.TurnEventCategorySystem,
-					Icon:     turnsheet.TurnEventIconSystem,
-					Message: fmt.Sprintf("%s entering depot for structure repairs (%d SP).",
-						mechInst.Callsign, cost),
-				})
+				events = append(events, turnsheet.NewTurnEvent(
+					turnsheet.TurnEventCategorySystem, turnsheet.TurnEventIconSystem,
+					"event.mecha.structure_repairs", "mech", mechInst.Callsign, "cost", cost,
+				))
 			}
 		}
 
@@ -190,26 +197,30 @@ func (p *MechaGameSquadManagementProcessor) ProcessTurnSheetResponse(
 				if swapChassis != nil {
 					if err := domain.ValidateWeaponLoadoutFits(swapChassis, proposed, weaponCache); err != nil {
 						l.Info("rejecting weapon swap on mech >%s<: %v", mechInst.Callsign, err)
-						events = append(events, turnsheet.TurnEvent{
-							Category: turnsheet.TurnEventCategorySystem,
-							Icon:     turnsheet.TurnEventIconSystem,
-							Message: fmt.Sprintf("%s: cannot install %s in %s slot — %v.",
-								mechInst.Callsign, newWeapon.Name, swap.SlotLocation, err),
-						})
+						events = append(events, turnsheet.NewTurnEvent(
+							turnsheet.TurnEventCategorySystem, turnsheet.TurnEventIconSystem,
+							"event.mecha.weapon_install_rejected", "mech", mechInst.Callsign,
+							"weapon", newWeapon.Name, "slot", swap.SlotLocation, "error", err.Error(),
+						))
 						continue
 					}
 				}
 
 				weaponConfig = proposed
-				spCost++
+				eventKey := "event.mecha.weapon_installing"
+				if remaining, ok := domain.TakeMechaGameSalvage(salvage, newWeapon.Name); ok {
+					salvage = remaining
+					salvageChanged = true
+					eventKey = "event.mecha.weapon_installing_salvage"
+				} else {
+					spCost++
+				}
 				mechInst.IsRefitting = true
 				mechChanged = true
-				events = append(events, turnsheet.TurnEvent{
-					Category: turnsheet.TurnEventCategorySystem,
-					Icon:     turnsheet.TurnEventIconSystem,
-					Message: fmt.Sprintf("%s installing %s in %s slot (1 SP).",
-						mechInst.Callsign, newWeapon.Name, swap.SlotLocation),
-				})
+				events = append(events, turnsheet.NewTurnEvent(
+					turnsheet.TurnEventCategorySystem, turnsheet.TurnEventIconSystem,
+					eventKey, "mech", mechInst.Callsign, "weapon", newWeapon.Name, "slot", swap.SlotLocation,
+				))
 			}
 
 			if mechChanged {
@@ -233,11 +244,19 @@ func (p *MechaGameSquadManagementProcessor) ProcessTurnSheetResponse(
 		if freshSquad.SupplyPoints < 0 {
 			freshSquad.SupplyPoints = 0
 		}
-		events = append(events, turnsheet.TurnEvent{
-			Category: turnsheet.TurnEventCategorySystem,
-			Icon:     turnsheet.TurnEventIconSystem,
-			Message:  fmt.Sprintf("Spent %d supply points on management orders.", spCost),
-		})
+		events = append(events, turnsheet.NewTurnEvent(
+			turnsheet.TurnEventCategorySystem, turnsheet.TurnEventIconSystem,
+			"event.mecha.supply_points_spent", "points", spCost,
+		))
+	}
+
+	if salvageChanged {
+		encoded, err := domain.EncodeMechaGameSalvage(salvage)
+		if err != nil {
+			l.Warn("failed to encode salvage for squad >%s<: %v", freshSquad.ID, err)
+		} else {
+			freshSquad.Salvage = encoded
+		}
 	}
 
 	for _, evt := range events {
@@ -361,6 +380,9 @@ func (p *MechaGameSquadManagementProcessor) buildManagementSheet(
 		})
 	}
 
+	campaignName, campaignBattle := p.campaignBattle(l, gameInstanceRec)
+	salvage := managementSalvage(l, squadInstance, allWeapons)
+
 	var mechEntries []turnsheet.ManagementMechEntry
 	for _, mech := range mechInstances {
 		entry := turnsheet.ManagementMechEntry{
@@ -446,8 +468,9 @@ func (p *MechaGameSquadManagementProcessor) buildManagementSheet(
 	}
 
 	turnNumber := gameInstanceRec.CurrentTurn
-	title := "Squad Management"
-	instructions := turnsheet.DefaultManagementInstructions()
+	locale := accountLocale(l, p.Domain, accountUserRec.AccountID)
+	title := i18n.T(locale, "turnsheet.mecha.squad_management.title")
+	instructions := turnsheet.ManagementInstructions(locale)
 
 	turnSheetCode, err := turnsheetutil.GeneratePlayGameTurnSheetCode(record.NewRecordID())
 	if err != nil {
@@ -466,16 +489,20 @@ func (p *MechaGameSquadManagementProcessor) buildManagementSheet(
 			GameType:              &gameRec.GameType,
 			TurnNumber:            &turnNumber,
 			AccountName:           &accountUserRec.Email,
+			Locale:                &locale,
 			TurnSheetTitle:        &title,
 			TurnSheetDescription:  &gameRec.Description,
 			TurnSheetInstructions: &instructions,
 			TurnSheetCode:         &turnSheetCode,
 			BackgroundImage:       backgroundImage,
 		},
-		SquadName:     squadRec.Name,
-		SupplyPoints:  squadInstance.SupplyPoints,
-		Mechs:         mechEntries,
-		WeaponCatalog: catalog,
+		SquadName:      squadRec.Name,
+		SupplyPoints:   squadInstance.SupplyPoints,
+		Mechs:          mechEntries,
+		WeaponCatalog:  catalog,
+		CampaignName:   campaignName,
+		CampaignBattle: campaignBattle,
+		Salvage:        salvage,
 	}
 
 	sheetJSON, err := json.Marshal(sheetData)
@@ -517,6 +544,60 @@ func (p *MechaGameSquadManagementProcessor) buildManagementSheet(
 	return sheetRec, nil
 }
 
+// campaignBattle returns the campaign name and battle number when the game
+// instance is a battle in a campaign.
+func (p *MechaGameSquadManagementProcessor) campaignBattle(
+	l logger.Logger,
+	gameInstanceRec *game_record.GameInstance,
+) (string, int) {
+	campaignInstanceRec, err := p.Domain.GetMechaGameCampaignInstanceRecByGameInstance(gameInstanceRec.ID)
+	if err != nil {
+		l.Warn("failed to get campaign battle for game instance >%s<: %v", gameInstanceRec.ID, err)
+		return "", 0
+	}
+	if campaignInstanceRec == nil {
+		return "", 0
+	}
+	campaignRec, err := p.Domain.GetMechaGameCampaignRec(campaignInstanceRec.MechaGameCampaignID, nil)
+	if err != nil {
+		l.Warn("failed to get campaign >%s<: %v", campaignInstanceRec.MechaGameCampaignID, err)
+		return "", 0
+	}
+	return campaignRec.Name, campaignInstanceRec.SequenceNumber
+}
+
+// managementSalvage lists the squad's salvaged weapons that this game's weapon
+// catalog has. Salvage the catalog does not have cannot be installed and is
+// left off the sheet.
+func managementSalvage(
+	l logger.Logger,
+	squadInstance *mecha_game_record.MechaGameSquadInstance,
+	allWeapons []*mecha_game_record.MechaGameWeapon,
+) []turnsheet.SalvageWeapon {
+	items, err := domain.DecodeMechaGameSalvage(squadInstance.Salvage)
+	if err != nil {
+		l.Warn("failed to decode salvage for squad >%s<: %v", squadInstance.ID, err)
+		return nil
+	}
+	weaponByName := make(map[string]*mecha_game_record.MechaGameWeapon, len(allWeapons))
+	for _, w := range allWeapons {
+		weaponByName[w.Name] = w
+	}
+	var salvage []turnsheet.SalvageWeapon
+	for _, item := range items {
+		w := weaponByName[item.WeaponName]
+		if w == nil {
+			continue
+		}
+		salvage = append(salvage, turnsheet.SalvageWeapon{
+			WeaponID: w.ID,
+			Name:     w.Name,
+			Quantity: item.Quantity,
+		})
+	}
+	return salvage
+}
+
 func intMax(a, b int) int {
 	if a > b {
 		return a
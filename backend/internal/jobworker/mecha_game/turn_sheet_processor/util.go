@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
@@ -43,3 +45,57 @@ func squadInstanceAccountUser(d *domain.Domain, squadInstance *mecha_game_record
 
 	return accountUserRec, nil
 }
+
+// squadInstanceDisplayName names a squad instance for other players: the
+// account name of a player-owned squad or the name of a computer opponent.
+// Falls back to the squad design name when neither can be resolved.
+func squadInstanceDisplayName(l logger.Logger, d *domain.Domain, squadInstance *mecha_game_record.MechaGameSquadInstance) string {
+	if squadInstance.GameSubscriptionInstanceID.Valid {
+		if name, err := squadInstancePlayerName(d, squadInstance); err != nil {
+			l.Warn("failed to resolve player for squad instance >%s< >%v<", squadInstance.ID, err)
+		} else if name != "" {
+			return name
+		}
+	}
+
+	if squadInstance.MechaGameComputerOpponentID.Valid {
+		opponentRec, err := d.GetMechaGameComputerOpponentRec(squadInstance.MechaGameComputerOpponentID.String, nil)
+		if err == nil {
+			return opponentRec.Name
+		}
+		l.Warn("failed to get computer opponent for squad instance >%s< >%v<", squadInstance.ID, err)
+	}
+
+	squadRec, err := d.GetMechaGameSquadRec(squadInstance.MechaGameSquadID, nil)
+	if err != nil {
+		l.Warn("failed to get squad for squad instance >%s< >%v<", squadInstance.ID, err)
+		return ""
+	}
+	return squadRec.Name
+}
+
+// squadInstancePlayerName returns the account name of the player owning a squad instance.
+func squadInstancePlayerName(d *domain.Domain, squadInstance *mecha_game_record.MechaGameSquadInstance) (string, error) {
+	subInstRec, err := d.GetGameSubscriptionInstanceRec(squadInstance.GameSubscriptionInstanceID.String, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get game subscription instance: %w", err)
+	}
+
+	accountRec, err := d.GetAccountRec(subInstRec.AccountID, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get account >%s<: %w", subInstRec.AccountID, err)
+	}
+
+	return accountRec.Name, nil
+}
+
+// accountLocale returns the locale turn sheets for an account are rendered in,
+// falling back to the default locale when the account locale cannot be resolved.
+func accountLocale(l logger.Logger, d *domain.Domain, accountID string) string {
+	locale, err := d.GetAccountLocale(accountID)
+	if err != nil {
+		l.Warn("failed to get account >%s< locale >%v<", accountID, err)
+		return i18n.DefaultLocale
+	}
+	return locale
+}
@@ -0,0 +1,312 @@
+package mecha_tactics_game
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/jobworker/mecha_tactics_game/turn_sheet_processor"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+// AttackDeclaration is an alias for the type defined in turn_sheet_processor.
+type AttackDeclaration = turn_sheet_processor.AttackDeclaration
+
+// mechSnapshot captures a mech's state before combat begins, so all attacks
+// resolve against the same positions, facings and hit points (simultaneous
+// resolution).
+type mechSnapshot struct {
+	Instance     *mecha_tactics_game_record.MechaTacticsGameMechInstance
+	Weapons      []*mecha_tactics_game_record.MechaTacticsGameWeapon
+	HeatCapacity int
+}
+
+// combatResult accumulates the outcome of all attacks before anything is applied.
+type combatResult struct {
+	// damage is the raw total damage dealt to each mech, keyed by mech instance ID.
+	damage map[string]int
+	// heat is the heat generated by each attacking mech, keyed by mech instance ID.
+	heat map[string]int
+	// events are the player-facing combat events, keyed by mech instance ID.
+	events map[string][]turnsheet.TurnEvent
+}
+
+func newCombatResult() *combatResult {
+	return &combatResult{
+		damage: make(map[string]int),
+		heat:   make(map[string]int),
+		events: make(map[string][]turnsheet.TurnEvent),
+	}
+}
+
+func (r *combatResult) addEvent(mechInstanceID, message string) {
+	r.events[mechInstanceID] = append(r.events[mechInstanceID], turnsheet.TurnEvent{
+		Category: turnsheet.TurnEventCategoryCombat,
+		Icon:     turnsheet.TurnEventIconCombat,
+		Message:  message,
+	})
+}
+
+// resolveCombat runs combat resolution for a game instance. It must be called
+// after all movement (player and AI) has been applied.
+func (p *MechaTacticsGame) resolveCombat(
+	ctx context.Context,
+	l logger.Logger,
+	gameInstanceRec *game_record.GameInstance,
+	attacks []AttackDeclaration,
+) error {
+	if len(attacks) == 0 {
+		l.Info("no attack declarations for game instance >%s< — skipping combat", gameInstanceRec.ID)
+		return nil
+	}
+
+	allMechInsts, err := p.getMechInstancesForGameInstance(ctx, gameInstanceRec)
+	if err != nil {
+		l.Warn("failed to load mech instances: %v", err)
+		return err
+	}
+
+	snapshots, err := p.buildMechSnapshots(l, allMechInsts)
+	if err != nil {
+		l.Warn("failed to build mech snapshots: %v", err)
+		return err
+	}
+
+	battlefield, err := p.Domain.LoadMechaTacticsBattlefield(gameInstanceRec.GameID)
+	if err != nil {
+		l.Warn("failed to load battlefield: %v", err)
+		return err
+	}
+
+	seed := int64(gameInstanceRec.CurrentTurn)
+	for _, b := range []byte(gameInstanceRec.ID) {
+		seed += int64(b)
+	}
+	rng := rand.New(rand.NewSource(seed)) //nolint:gosec
+
+	result := resolveAttacks(l, attacks, snapshots, battlefield, rng)
+	applyCombatResult(l, result, snapshots)
+
+	for _, snap := range snapshots {
+		inst := snap.Instance
+		_, damaged := result.damage[inst.ID]
+		_, heated := result.heat[inst.ID]
+		events := result.events[inst.ID]
+		if !damaged && !heated && len(events) == 0 {
+			continue
+		}
+		if isPlayerMech(inst) {
+			for _, evt := range events {
+				if err := turnsheet.AppendMechaTacticsGameTurnEvent(inst, evt); err != nil {
+					l.Warn("failed to append combat event for mech >%s<: %v", inst.ID, err)
+				}
+			}
+		}
+		if _, err := p.Domain.UpdateMechaTacticsGameMechInstanceRec(inst); err != nil {
+			l.Warn("failed to update mech instance >%s< after combat: %v", inst.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *MechaTacticsGame) buildMechSnapshots(l logger.Logger, insts []*mecha_tactics_game_record.MechaTacticsGameMechInstance) (map[string]*mechSnapshot, error) {
+	weaponCache := make(map[string]*mecha_tactics_game_record.MechaTacticsGameWeapon)
+	snapshots := make(map[string]*mechSnapshot, len(insts))
+
+	for _, inst := range insts {
+		snap := &mechSnapshot{Instance: inst}
+
+		chassisRec, err := p.Domain.GetMechaTacticsGameChassisRec(inst.MechaTacticsGameChassisID, nil)
+		if err != nil {
+			l.Warn("failed to load chassis >%s< for mech >%s<: %v", inst.MechaTacticsGameChassisID, inst.ID, err)
+			return nil, err
+		}
+		snap.HeatCapacity = chassisRec.HeatCapacity
+
+		weaponConfig, err := loadWeaponConfig(inst)
+		if err != nil {
+			l.Warn("failed to read weapon config for mech >%s<: %v", inst.ID, err)
+			return nil, err
+		}
+		for _, slot := range weaponConfig {
+			if slot.WeaponID == "" {
+				continue
+			}
+			weaponRec, ok := weaponCache[slot.WeaponID]
+			if !ok {
+				weaponRec, err = p.Domain.GetMechaTacticsGameWeaponRec(slot.WeaponID, nil)
+				if err != nil {
+					l.Warn("failed to load weapon >%s< for mech >%s<: %v", slot.WeaponID, inst.ID, err)
+					continue
+				}
+				weaponCache[slot.WeaponID] = weaponRec
+			}
+			snap.Weapons = append(snap.Weapons, weaponRec)
+		}
+
+		snapshots[inst.ID] = snap
+	}
+
+	return snapshots, nil
+}
+
+// resolveAttacks resolves every attack declaration against the pre-combat
+// snapshots. Nothing is applied to the snapshots; damage, heat and events are
+// accumulated in the returned result.
+func resolveAttacks(
+	l logger.Logger,
+	attacks []AttackDeclaration,
+	snapshots map[string]*mechSnapshot,
+	battlefield *domain.MechaTacticsBattlefield,
+	rng *rand.Rand,
+) *combatResult {
+	l = l.WithFunctionContext("resolveAttacks")
+
+	result := newCombatResult()
+	attacked := make(map[string]bool)
+
+	for _, atk := range attacks {
+		attacker, ok := snapshots[atk.AttackerMechInstanceID]
+		if !ok {
+			l.Warn("attacker mech >%s< not found in snapshot — skipping", atk.AttackerMechInstanceID)
+			continue
+		}
+
+		// One attack per mech per turn.
+		if attacked[atk.AttackerMechInstanceID] {
+			l.Warn("attacker mech >%s< already attacked this turn — skipping", atk.AttackerMechInstanceID)
+			continue
+		}
+
+		target, ok := snapshots[atk.TargetMechInstanceID]
+		if !ok {
+			l.Warn("target mech >%s< not found in snapshot — skipping", atk.TargetMechInstanceID)
+			continue
+		}
+
+		a := attacker.Instance
+		t := target.Instance
+
+		if a.Status == mecha_tactics_game_record.MechInstanceStatusDestroyed ||
+			a.Status == mecha_tactics_game_record.MechInstanceStatusShutdown {
+			result.addEvent(a.ID, fmt.Sprintf("%s was unable to attack %s — %s.", a.Callsign, t.Callsign, a.Status))
+			continue
+		}
+
+		if a.IsRepairing {
+			result.addEvent(a.ID, fmt.Sprintf("%s is under repair and did not attack %s.", a.Callsign, t.Callsign))
+			continue
+		}
+
+		if turn_sheet_processor.SameOwner(a, t) {
+			l.Warn("attacker mech >%s< targeted friendly mech >%s< — skipping", a.ID, t.ID)
+			continue
+		}
+
+		if t.Status == mecha_tactics_game_record.MechInstanceStatusDestroyed {
+			result.addEvent(a.ID, fmt.Sprintf("%s targeted %s but the target was already destroyed.", a.Callsign, t.Callsign))
+			continue
+		}
+
+		attacked[a.ID] = true
+
+		attackerCoord, aok := battlefield.Coord(a.MechaTacticsGameHexID)
+		targetCoord, tok := battlefield.Coord(t.MechaTacticsGameHexID)
+		if !aok || !tok {
+			l.Warn("attacker >%s< or target >%s< is not on the map — skipping", a.ID, t.ID)
+			continue
+		}
+
+		dist := domain.MechaTacticsHexDistance(attackerCoord, targetCoord)
+		arc := domain.MechaTacticsFiringArc(t.Facing, targetCoord, attackerCoord)
+		chance := domain.MechaTacticsHitChance(a.PilotSkill, arc, battlefield.CoverModifier(t.MechaTacticsGameHexID))
+
+		fired := 0
+		for _, weaponRec := range attacker.Weapons {
+			if !domain.MechaTacticsWeaponCanFire(weaponRec.RangeBand, dist) {
+				continue
+			}
+			fired++
+
+			// Heat is generated whether or not the weapon hits.
+			result.heat[a.ID] += weaponRec.HeatCost
+
+			roll := rng.Intn(100)
+			if roll < chance {
+				result.damage[t.ID] += weaponRec.Damage
+				l.Info("%s hit %s with %s for %d damage (roll %d < %d%%, %s arc)",
+					a.Callsign, t.Callsign, weaponRec.Name, weaponRec.Damage, roll, chance, arc)
+				result.addEvent(a.ID, fmt.Sprintf("%s fired %s at %s (%s arc) — HIT for %d damage.",
+					a.Callsign, weaponRec.Name, t.Callsign, arc, weaponRec.Damage))
+				result.addEvent(t.ID, fmt.Sprintf("%s hit by %s from %s (%s arc) — %d damage.",
+					t.Callsign, weaponRec.Name, a.Callsign, arc, weaponRec.Damage))
+			} else {
+				l.Info("%s missed %s with %s (roll %d >= %d%%)", a.Callsign, t.Callsign, weaponRec.Name, roll, chance)
+				result.addEvent(a.ID, fmt.Sprintf("%s fired %s at %s — missed.", a.Callsign, weaponRec.Name, t.Callsign))
+				result.addEvent(t.ID, fmt.Sprintf("%s was fired on by %s's %s — missed.", t.Callsign, a.Callsign, weaponRec.Name))
+			}
+		}
+
+		if fired == 0 {
+			result.addEvent(a.ID, fmt.Sprintf("%s could not fire at %s — no weapon in range (distance %d).", a.Callsign, t.Callsign, dist))
+			result.addEvent(t.ID, fmt.Sprintf("%s was targeted by %s but was out of range (distance %d).", t.Callsign, a.Callsign, dist))
+		}
+	}
+
+	return result
+}
+
+// applyCombatResult applies pooled damage and heat to the snapshots. Armor
+// absorbs damage first and the excess carries into structure. Heat is applied
+// after damage; a mech pushed over its heat capacity shuts down unless it was
+// destroyed.
+func applyCombatResult(l logger.Logger, result *combatResult, snapshots map[string]*mechSnapshot) {
+	for mechID, dmg := range result.damage {
+		snap, ok := snapshots[mechID]
+		if !ok {
+			continue
+		}
+		inst := snap.Instance
+
+		armorAbsorbed := dmg
+		structureDmg := 0
+		if armorAbsorbed > inst.CurrentArmor {
+			armorAbsorbed = inst.CurrentArmor
+			structureDmg = dmg - armorAbsorbed
+		}
+
+		inst.CurrentArmor -= armorAbsorbed
+		inst.CurrentStructure -= structureDmg
+		if inst.CurrentStructure < 0 {
+			inst.CurrentStructure = 0
+		}
+
+		if inst.CurrentStructure == 0 {
+			inst.Status = mecha_tactics_game_record.MechInstanceStatusDestroyed
+			l.Info("mech >%s< destroyed", inst.Callsign)
+			result.addEvent(inst.ID, fmt.Sprintf("%s has been DESTROYED!", inst.Callsign))
+		} else if structureDmg > 0 && inst.Status == mecha_tactics_game_record.MechInstanceStatusOperational {
+			inst.Status = mecha_tactics_game_record.MechInstanceStatusDamaged
+		}
+	}
+
+	for mechID, heat := range result.heat {
+		snap, ok := snapshots[mechID]
+		if !ok {
+			continue
+		}
+		inst := snap.Instance
+		inst.CurrentHeat += heat
+		if inst.CurrentHeat > snap.HeatCapacity && inst.Status != mecha_tactics_game_record.MechInstanceStatusDestroyed {
+			inst.Status = mecha_tactics_game_record.MechInstanceStatusShutdown
+			l.Info("mech >%s< overheated and shut down", inst.Callsign)
+			result.addEvent(inst.ID, fmt.Sprintf("%s has SHUT DOWN from overheating (%d/%d heat)!",
+				inst.Callsign, inst.CurrentHeat, snap.HeatCapacity))
+		}
+	}
+}
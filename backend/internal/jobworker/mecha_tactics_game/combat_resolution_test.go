@@ -0,0 +1,308 @@
+package mecha_tactics_game
+
+import (
+	"database/sql"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corerecord "gitlab.com/alienspaces/playbymail/core/record"
+	corelog "gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+// noopLogger satisfies corelog.Logger for unit tests that exercise logging paths.
+type noopLogger struct{}
+
+func (n *noopLogger) NewInstance() (corelog.Logger, error)           { return n, nil }
+func (n *noopLogger) Context(_, _ string)                            {}
+func (n *noopLogger) WithApplicationContext(_ string) corelog.Logger { return n }
+func (n *noopLogger) WithDurationContext(_ string) corelog.Logger    { return n }
+func (n *noopLogger) WithPackageContext(_ string) corelog.Logger     { return n }
+func (n *noopLogger) WithFunctionContext(_ string) corelog.Logger    { return n }
+func (n *noopLogger) Debug(_ string, _ ...any)                       {}
+func (n *noopLogger) Info(_ string, _ ...any)                        {}
+func (n *noopLogger) Warn(_ string, _ ...any)                        {}
+func (n *noopLogger) Error(_ string, _ ...any)                       {}
+
+var testLogger corelog.Logger = &noopLogger{}
+
+// Tests for pure helper functions in combat_resolution.go.
+// Integration tests for resolveCombat require a full DB harness and are omitted here.
+
+// testBattlefield builds a single column of hexes (0,0) to (0,3). Hex "H2" is
+// heavy cover that makes any attack on it miss.
+func testBattlefield() *domain.MechaTacticsBattlefield {
+	open := &mecha_tactics_game_record.MechaTacticsGameTerrainType{
+		Record:            corerecord.Record{ID: "open"},
+		Name:              "Open",
+		MovementPointCost: 1,
+	}
+	bunker := &mecha_tactics_game_record.MechaTacticsGameTerrainType{
+		Record:            corerecord.Record{ID: "bunker"},
+		Name:              "Bunker",
+		MovementPointCost: 1,
+		CoverModifier:     -100,
+	}
+	hexes := []*mecha_tactics_game_record.MechaTacticsGameHex{
+		{Record: corerecord.Record{ID: "H0"}, MechaTacticsGameTerrainTypeID: "open", HexColumn: 0, HexRow: 0},
+		{Record: corerecord.Record{ID: "H1"}, MechaTacticsGameTerrainTypeID: "open", HexColumn: 0, HexRow: 1},
+		{Record: corerecord.Record{ID: "H2"}, MechaTacticsGameTerrainTypeID: "bunker", HexColumn: 0, HexRow: 2},
+		{Record: corerecord.Record{ID: "H3"}, MechaTacticsGameTerrainTypeID: "open", HexColumn: 0, HexRow: 3},
+	}
+	return domain.NewMechaTacticsBattlefield(hexes, []*mecha_tactics_game_record.MechaTacticsGameTerrainType{open, bunker})
+}
+
+func testWeapon(id, rangeBand string, damage, heat int) *mecha_tactics_game_record.MechaTacticsGameWeapon {
+	return &mecha_tactics_game_record.MechaTacticsGameWeapon{
+		Record:    corerecord.Record{ID: id},
+		Name:      id,
+		Damage:    damage,
+		HeatCost:  heat,
+		RangeBand: rangeBand,
+	}
+}
+
+func testSnapshot(id, hexID, owner string, facing int, weapons ...*mecha_tactics_game_record.MechaTacticsGameWeapon) *mechSnapshot {
+	return &mechSnapshot{
+		Instance: &mecha_tactics_game_record.MechaTacticsGameMechInstance{
+			Record:                     corerecord.Record{ID: id},
+			GameSubscriptionInstanceID: sql.NullString{String: owner, Valid: true},
+			MechaTacticsGameHexID:      hexID,
+			Facing:                     facing,
+			Callsign:                   id,
+			CurrentArmor:               10,
+			CurrentStructure:           10,
+			PilotSkill:                 9,
+			Status:                     mecha_tactics_game_record.MechInstanceStatusOperational,
+		},
+		Weapons:      weapons,
+		HeatCapacity: 10,
+	}
+}
+
+func TestResolveAttacks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		snapshots    func() map[string]*mechSnapshot
+		attacks      []AttackDeclaration
+		expectDamage map[string]int
+		expectHeat   map[string]int
+	}{
+		{
+			name: "medium weapon hits adjacent target",
+			snapshots: func() map[string]*mechSnapshot {
+				return map[string]*mechSnapshot{
+					"A": testSnapshot("A", "H0", "p1", mecha_tactics_game_record.FacingSouth, testWeapon("laser", mecha_tactics_game_record.WeaponRangeBandMedium, 5, 3)),
+					"B": testSnapshot("B", "H1", "p2", mecha_tactics_game_record.FacingSouth),
+				}
+			},
+			attacks:      []AttackDeclaration{{AttackerMechInstanceID: "A", TargetMechInstanceID: "B"}},
+			expectDamage: map[string]int{"B": 5},
+			expectHeat:   map[string]int{"A": 3},
+		},
+		{
+			name: "long range weapon cannot fire into the same hex",
+			snapshots: func() map[string]*mechSnapshot {
+				return map[string]*mechSnapshot{
+					"A": testSnapshot("A", "H0", "p1", mecha_tactics_game_record.FacingSouth, testWeapon("missile", mecha_tactics_game_record.WeaponRangeBandLong, 8, 4)),
+					"B": testSnapshot("B", "H0", "p2", mecha_tactics_game_record.FacingNorth),
+				}
+			},
+			attacks:      []AttackDeclaration{{AttackerMechInstanceID: "A", TargetMechInstanceID: "B"}},
+			expectDamage: map[string]int{},
+			expectHeat:   map[string]int{},
+		},
+		{
+			name: "target out of range of every weapon",
+			snapshots: func() map[string]*mechSnapshot {
+				return map[string]*mechSnapshot{
+					"A": testSnapshot("A", "H0", "p1", mecha_tactics_game_record.FacingSouth, testWeapon("autocannon", mecha_tactics_game_record.WeaponRangeBandShort, 6, 2)),
+					"B": testSnapshot("B", "H3", "p2", mecha_tactics_game_record.FacingNorth),
+				}
+			},
+			attacks:      []AttackDeclaration{{AttackerMechInstanceID: "A", TargetMechInstanceID: "B"}},
+			expectDamage: map[string]int{},
+			expectHeat:   map[string]int{},
+		},
+		{
+			name: "heat is generated on a miss",
+			snapshots: func() map[string]*mechSnapshot {
+				return map[string]*mechSnapshot{
+					"A": testSnapshot("A", "H1", "p1", mecha_tactics_game_record.FacingSouth, testWeapon("laser", mecha_tactics_game_record.WeaponRangeBandMedium, 5, 3)),
+					"B": testSnapshot("B", "H2", "p2", mecha_tactics_game_record.FacingNorth),
+				}
+			},
+			attacks:      []AttackDeclaration{{AttackerMechInstanceID: "A", TargetMechInstanceID: "B"}},
+			expectDamage: map[string]int{},
+			expectHeat:   map[string]int{"A": 3},
+		},
+		{
+			name: "friendly fire is skipped",
+			snapshots: func() map[string]*mechSnapshot {
+				return map[string]*mechSnapshot{
+					"A": testSnapshot("A", "H0", "p1", mecha_tactics_game_record.FacingSouth, testWeapon("laser", mecha_tactics_game_record.WeaponRangeBandMedium, 5, 3)),
+					"B": testSnapshot("B", "H1", "p1", mecha_tactics_game_record.FacingSouth),
+				}
+			},
+			attacks:      []AttackDeclaration{{AttackerMechInstanceID: "A", TargetMechInstanceID: "B"}},
+			expectDamage: map[string]int{},
+			expectHeat:   map[string]int{},
+		},
+		{
+			name: "a mech attacks only once per turn",
+			snapshots: func() map[string]*mechSnapshot {
+				return map[string]*mechSnapshot{
+					"A": testSnapshot("A", "H0", "p1", mecha_tactics_game_record.FacingSouth, testWeapon("laser", mecha_tactics_game_record.WeaponRangeBandMedium, 5, 3)),
+					"B": testSnapshot("B", "H1", "p2", mecha_tactics_game_record.FacingSouth),
+				}
+			},
+			attacks: []AttackDeclaration{
+				{AttackerMechInstanceID: "A", TargetMechInstanceID: "B"},
+				{AttackerMechInstanceID: "A", TargetMechInstanceID: "B"},
+			},
+			expectDamage: map[string]int{"B": 5},
+			expectHeat:   map[string]int{"A": 3},
+		},
+		{
+			name: "repairing mech does not attack",
+			snapshots: func() map[string]*mechSnapshot {
+				a := testSnapshot("A", "H0", "p1", mecha_tactics_game_record.FacingSouth, testWeapon("laser", mecha_tactics_game_record.WeaponRangeBandMedium, 5, 3))
+				a.Instance.IsRepairing = true
+				return map[string]*mechSnapshot{
+					"A": a,
+					"B": testSnapshot("B", "H1", "p2", mecha_tactics_game_record.FacingSouth),
+				}
+			},
+			attacks:      []AttackDeclaration{{AttackerMechInstanceID: "A", TargetMechInstanceID: "B"}},
+			expectDamage: map[string]int{},
+			expectHeat:   map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rng := rand.New(rand.NewSource(1))
+			result := resolveAttacks(testLogger, tt.attacks, tt.snapshots(), testBattlefield(), rng)
+			require.Equal(t, tt.expectDamage, result.damage)
+			require.Equal(t, tt.expectHeat, result.heat)
+		})
+	}
+}
+
+func TestApplyCombatResult(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		damage          map[string]int
+		heat            map[string]int
+		expectArmor     int
+		expectStructure int
+		expectHeat      int
+		expectStatus    string
+	}{
+		{
+			name:            "armor absorbs damage first",
+			damage:          map[string]int{"A": 6},
+			expectArmor:     4,
+			expectStructure: 10,
+			expectStatus:    mecha_tactics_game_record.MechInstanceStatusOperational,
+		},
+		{
+			name:            "excess damage carries into structure",
+			damage:          map[string]int{"A": 13},
+			expectArmor:     0,
+			expectStructure: 7,
+			expectStatus:    mecha_tactics_game_record.MechInstanceStatusDamaged,
+		},
+		{
+			name:            "structure reduced to zero destroys the mech",
+			damage:          map[string]int{"A": 25},
+			expectArmor:     0,
+			expectStructure: 0,
+			expectStatus:    mecha_tactics_game_record.MechInstanceStatusDestroyed,
+		},
+		{
+			name:            "heat over capacity shuts the mech down",
+			heat:            map[string]int{"A": 11},
+			expectArmor:     10,
+			expectStructure: 10,
+			expectHeat:      11,
+			expectStatus:    mecha_tactics_game_record.MechInstanceStatusShutdown,
+		},
+		{
+			name:            "destroyed mech does not shut down",
+			damage:          map[string]int{"A": 20},
+			heat:            map[string]int{"A": 11},
+			expectArmor:     0,
+			expectStructure: 0,
+			expectHeat:      11,
+			expectStatus:    mecha_tactics_game_record.MechInstanceStatusDestroyed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			snapshots := map[string]*mechSnapshot{
+				"A": testSnapshot("A", "H0", "p1", mecha_tactics_game_record.FacingNorth),
+			}
+			result := newCombatResult()
+			if tt.damage != nil {
+				result.damage = tt.damage
+			}
+			if tt.heat != nil {
+				result.heat = tt.heat
+			}
+
+			applyCombatResult(testLogger, result, snapshots)
+
+			inst := snapshots["A"].Instance
+			require.Equal(t, tt.expectArmor, inst.CurrentArmor)
+			require.Equal(t, tt.expectStructure, inst.CurrentStructure)
+			require.Equal(t, tt.expectHeat, inst.CurrentHeat)
+			require.Equal(t, tt.expectStatus, inst.Status)
+		})
+	}
+}
+
+func TestRuleBasedPickAttackTarget(t *testing.T) {
+	t.Parallel()
+
+	bf := testBattlefield()
+	laser := testWeapon("laser", mecha_tactics_game_record.WeaponRangeBandMedium, 5, 3)
+
+	attacker := testSnapshot("A", "H1", "", mecha_tactics_game_record.FacingNorth, laser).Instance
+	// Enemy facing away from the attacker exposes its rear arc.
+	rear := testSnapshot("R", "H0", "p1", mecha_tactics_game_record.FacingNorth).Instance
+	rear.CurrentStructure = 10
+	// Enemy facing the attacker presents its front arc but is weaker.
+	front := testSnapshot("F", "H0", "p2", mecha_tactics_game_record.FacingSouth).Instance
+	front.CurrentStructure = 2
+	// Enemy out of range of every weapon.
+	far := testSnapshot("X", "H3", "p3", mecha_tactics_game_record.FacingNorth).Instance
+	far.CurrentStructure = 1
+
+	state := &GameStateContext{
+		Opponent:    &mecha_tactics_game_record.MechaTacticsGameComputerOpponent{Aggression: 8, IQ: 5},
+		OwnMechs:    []*mecha_tactics_game_record.MechaTacticsGameMechInstance{attacker},
+		EnemyMechs:  []*mecha_tactics_game_record.MechaTacticsGameMechInstance{front, far, rear},
+		Battlefield: bf,
+		WeaponsByMechID: map[string][]*mecha_tactics_game_record.MechaTacticsGameWeapon{
+			attacker.ID: {laser},
+		},
+	}
+
+	s := &ruleBasedStrategy{}
+	require.Equal(t, "R", s.pickAttackTarget(state.Opponent, attacker, "H1", state), "rear arc target preferred")
+
+	state.EnemyMechs = []*mecha_tactics_game_record.MechaTacticsGameMechInstance{far}
+	require.Equal(t, "", s.pickAttackTarget(state.Opponent, attacker, "H1", state), "no target in range")
+}
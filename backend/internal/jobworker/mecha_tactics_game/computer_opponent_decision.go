@@ -0,0 +1,222 @@
+package mecha_tactics_game
+
+import (
+	"context"
+	"fmt"
+
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/agent"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// GameStateContext bundles all information both decision strategies need for a
+// single computer opponent's turn.
+type GameStateContext struct {
+	Opponent *mecha_tactics_game_record.MechaTacticsGameComputerOpponent
+	// OwnMechs are the opponent's mechs that are not destroyed.
+	OwnMechs []*mecha_tactics_game_record.MechaTacticsGameMechInstance
+	// EnemyMechs are all other mechs in the game instance that are not destroyed.
+	EnemyMechs  []*mecha_tactics_game_record.MechaTacticsGameMechInstance
+	Battlefield *domain.MechaTacticsBattlefield
+	// ChassisByID maps chassis ID to chassis design record for speed lookups.
+	ChassisByID map[string]*mecha_tactics_game_record.MechaTacticsGameChassis
+	// WeaponsByMechID maps mech instance ID to its currently mounted weapons.
+	WeaponsByMechID map[string][]*mecha_tactics_game_record.MechaTacticsGameWeapon
+	TurnNumber      int
+}
+
+// ComputerOpponentStrategy is the interface both strategies implement.
+type ComputerOpponentStrategy interface {
+	GenerateOrders(ctx context.Context, l logger.Logger, state *GameStateContext) ([]turnsheet.MechaTacticsOrdersScanData, error)
+}
+
+// ComputerOpponentDecisionEngine selects a strategy and generates orders for
+// each computer opponent during turn processing.
+type ComputerOpponentDecisionEngine struct {
+	logger           logger.Logger
+	domain           *domain.Domain
+	primaryStrategy  ComputerOpponentStrategy
+	fallbackStrategy ComputerOpponentStrategy
+}
+
+// NewComputerOpponentDecisionEngine creates the decision engine. If an API key is
+// configured for the agent provider the engine uses LLM-based orders as the primary
+// strategy with a rule-based fallback; otherwise only the rule-based strategy is used.
+func NewComputerOpponentDecisionEngine(l logger.Logger, d *domain.Domain, cfg config.Config) *ComputerOpponentDecisionEngine {
+	l = l.WithFunctionContext("NewComputerOpponentDecisionEngine")
+
+	rbStrategy := &ruleBasedStrategy{}
+
+	var primary ComputerOpponentStrategy = rbStrategy
+	var fallback ComputerOpponentStrategy = rbStrategy
+
+	if textAgent := agent.NewTextAgent(l, cfg); textAgent != nil {
+		l.Info("agent API key configured — using LLM strategy with rule-based fallback")
+		primary = &llmStrategy{textAgent: textAgent}
+		fallback = rbStrategy
+	} else {
+		l.Info("no agent API key — using rule-based strategy only")
+	}
+
+	return &ComputerOpponentDecisionEngine{
+		logger:           l,
+		domain:           d,
+		primaryStrategy:  primary,
+		fallbackStrategy: fallback,
+	}
+}
+
+// GenerateOrdersForOpponent builds the GameStateContext for the given computer
+// opponent and generates orders for its mechs using the configured strategy.
+func (e *ComputerOpponentDecisionEngine) GenerateOrdersForOpponent(
+	ctx context.Context,
+	gameInstanceRec *game_record.GameInstance,
+	opponentRec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent,
+) ([]turnsheet.MechaTacticsOrdersScanData, error) {
+	l := e.logger.WithFunctionContext("ComputerOpponentDecisionEngine/GenerateOrdersForOpponent")
+
+	state, err := e.buildGameStateContext(ctx, gameInstanceRec, opponentRec, func(m *mecha_tactics_game_record.MechaTacticsGameMechInstance) bool {
+		return m.MechaTacticsGameComputerOpponentID.Valid && m.MechaTacticsGameComputerOpponentID.String == opponentRec.ID
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build game state context: %w", err)
+	}
+
+	if len(state.OwnMechs) == 0 {
+		l.Info("computer opponent >%s< has no active mechs", opponentRec.Name)
+		return nil, nil
+	}
+
+	return e.generateOrders(ctx, l, state)
+}
+
+// GenerateOrdersForPlayer generates orders for the mechs of a player's game subscription
+// instance, commanded by the given computer opponent profile. This is used when the
+// computer takes over for a player who has missed turns.
+func (e *ComputerOpponentDecisionEngine) GenerateOrdersForPlayer(
+	ctx context.Context,
+	gameInstanceRec *game_record.GameInstance,
+	opponentRec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent,
+	gameSubscriptionInstanceID string,
+) ([]turnsheet.MechaTacticsOrdersScanData, error) {
+	l := e.logger.WithFunctionContext("ComputerOpponentDecisionEngine/GenerateOrdersForPlayer")
+
+	state, err := e.buildGameStateContext(ctx, gameInstanceRec, opponentRec, func(m *mecha_tactics_game_record.MechaTacticsGameMechInstance) bool {
+		return m.GameSubscriptionInstanceID.Valid && m.GameSubscriptionInstanceID.String == gameSubscriptionInstanceID
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build game state context: %w", err)
+	}
+
+	if len(state.OwnMechs) == 0 {
+		l.Info("player >%s< has no active mechs", gameSubscriptionInstanceID)
+		return nil, nil
+	}
+
+	return e.generateOrders(ctx, l, state)
+}
+
+// generateOrders generates orders with the primary strategy, falling back to the
+// rule-based strategy when the primary strategy fails.
+func (e *ComputerOpponentDecisionEngine) generateOrders(ctx context.Context, l logger.Logger, state *GameStateContext) ([]turnsheet.MechaTacticsOrdersScanData, error) {
+	orders, err := e.primaryStrategy.GenerateOrders(ctx, l, state)
+	if err != nil {
+		l.Warn("primary strategy failed, falling back to rule-based: %v", err)
+		orders, err = e.fallbackStrategy.GenerateOrders(ctx, l, state)
+		if err != nil {
+			return nil, fmt.Errorf("fallback strategy also failed: %w", err)
+		}
+	}
+
+	return orders, nil
+}
+
+// buildGameStateContext queries the domain for all data needed by both strategies.
+// Mechs for which isOwn returns true are commanded by the opponent, all others
+// are enemies.
+func (e *ComputerOpponentDecisionEngine) buildGameStateContext(
+	_ context.Context,
+	gameInstanceRec *game_record.GameInstance,
+	opponentRec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent,
+	isOwn func(m *mecha_tactics_game_record.MechaTacticsGameMechInstance) bool,
+) (*GameStateContext, error) {
+	l := e.logger.WithFunctionContext("buildGameStateContext")
+
+	allMechs, err := e.domain.GetManyMechaTacticsGameMechInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceCreatedAt, Direction: coresql.OrderDirectionASC},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get mech instances: %v", err)
+		return nil, fmt.Errorf("failed to get mech instances: %w", err)
+	}
+
+	var ownMechs, enemyMechs []*mecha_tactics_game_record.MechaTacticsGameMechInstance
+	for _, m := range allMechs {
+		if m.Status == mecha_tactics_game_record.MechInstanceStatusDestroyed {
+			continue
+		}
+		if isOwn(m) {
+			ownMechs = append(ownMechs, m)
+			continue
+		}
+		enemyMechs = append(enemyMechs, m)
+	}
+
+	battlefield, err := e.domain.LoadMechaTacticsBattlefield(gameInstanceRec.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load battlefield: %w", err)
+	}
+
+	chassisByID := make(map[string]*mecha_tactics_game_record.MechaTacticsGameChassis)
+	weaponCache := make(map[string]*mecha_tactics_game_record.MechaTacticsGameWeapon)
+	weaponsByMechID := make(map[string][]*mecha_tactics_game_record.MechaTacticsGameWeapon, len(ownMechs)+len(enemyMechs))
+
+	for _, m := range append(append([]*mecha_tactics_game_record.MechaTacticsGameMechInstance{}, ownMechs...), enemyMechs...) {
+		if m.MechaTacticsGameChassisID != "" && chassisByID[m.MechaTacticsGameChassisID] == nil {
+			if cr, err := e.domain.GetMechaTacticsGameChassisRec(m.MechaTacticsGameChassisID, nil); err == nil {
+				chassisByID[m.MechaTacticsGameChassisID] = cr
+			}
+		}
+
+		weaponConfig, err := loadWeaponConfig(m)
+		if err != nil {
+			l.Warn("failed to decode weapon config for mech >%s< >%v<", m.ID, err)
+			continue
+		}
+		for _, slot := range weaponConfig {
+			if slot.WeaponID == "" {
+				continue
+			}
+			weaponRec, ok := weaponCache[slot.WeaponID]
+			if !ok {
+				weaponRec, err = e.domain.GetMechaTacticsGameWeaponRec(slot.WeaponID, nil)
+				if err != nil {
+					l.Warn("failed to load weapon >%s< for mech >%s< >%v<", slot.WeaponID, m.ID, err)
+					continue
+				}
+				weaponCache[slot.WeaponID] = weaponRec
+			}
+			weaponsByMechID[m.ID] = append(weaponsByMechID[m.ID], weaponRec)
+		}
+	}
+
+	return &GameStateContext{
+		Opponent:        opponentRec,
+		OwnMechs:        ownMechs,
+		EnemyMechs:      enemyMechs,
+		Battlefield:     battlefield,
+		ChassisByID:     chassisByID,
+		WeaponsByMechID: weaponsByMechID,
+		TurnNumber:      gameInstanceRec.CurrentTurn,
+	}, nil
+}
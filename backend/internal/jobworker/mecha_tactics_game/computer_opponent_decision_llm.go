@@ -0,0 +1,185 @@
+package mecha_tactics_game
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/agent"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+type llmStrategy struct {
+	textAgent agent.TextAgent
+}
+
+// llmOrdersResponse is the JSON envelope the model is asked to return.
+type llmOrdersResponse struct {
+	MechOrders []turnsheet.MechaTacticsOrdersScanData `json:"mech_orders"`
+}
+
+func (s *llmStrategy) GenerateOrders(ctx context.Context, l logger.Logger, state *GameStateContext) ([]turnsheet.MechaTacticsOrdersScanData, error) {
+	l = l.WithFunctionContext("llmStrategy/GenerateOrders")
+
+	opp := state.Opponent
+
+	// Temperature: low IQ → higher temperature (more random), high IQ → lower (more precise).
+	temperature := 1.0 - float64(opp.IQ-1)/9.0*0.8 // Range: 0.2 (IQ=10) to 1.0 (IQ=1)
+
+	systemPrompt := fmt.Sprintf(
+		"You are a mecha combat commander on a hex-grid battlefield. You control the %s force. "+
+			"Your aggression level is %d/10 (1=purely defensive, 10=all-out attack). "+
+			"Your tactical IQ is %d/10 (1=predictable moves, 10=expert use of facing, firing arcs and terrain). "+
+			"Generate movement, facing and attack orders for your mechs based on the current battlefield.",
+		opp.Name, opp.Aggression, opp.IQ,
+	)
+
+	userPrompt := s.buildGameStatePrompt(state)
+
+	resp, err := s.textAgent.GenerateContent(ctx, agent.ContentGenerationRequest{
+		SystemPrompt: systemPrompt,
+		UserPrompt:   userPrompt,
+		Temperature:  temperature,
+		MaxTokens:    512,
+	})
+	if err != nil {
+		l.Warn("LLM text generation failed: %v", err)
+		return nil, fmt.Errorf("LLM text generation failed: %w", err)
+	}
+
+	// Extract JSON from the response (the model may wrap it in markdown)
+	jsonStr := extractJSONFromResponse(resp)
+	if jsonStr == "" {
+		l.Warn("LLM response contained no JSON: %s", resp)
+		return nil, fmt.Errorf("LLM response contained no JSON: %s", resp)
+	}
+
+	var ordersResp llmOrdersResponse
+	if err := json.Unmarshal([]byte(jsonStr), &ordersResp); err != nil {
+		l.Warn("failed to parse LLM orders JSON: %v", err)
+		return nil, fmt.Errorf("failed to parse LLM orders JSON: %w", err)
+	}
+
+	l.Info("LLM strategy generated %d mech orders for opponent %s", len(ordersResp.MechOrders), opp.Name)
+
+	return ordersResp.MechOrders, nil
+}
+
+func (s *llmStrategy) buildGameStatePrompt(state *GameStateContext) string {
+	var sb strings.Builder
+	bf := state.Battlefield
+
+	hexLabel := func(hexID string) string {
+		hex, ok := bf.HexByID[hexID]
+		if !ok {
+			return "unknown"
+		}
+		return fmt.Sprintf("%d,%d", hex.HexColumn, hex.HexRow)
+	}
+
+	fmt.Fprintf(&sb, "Turn %d. You command the following mechs:\n", state.TurnNumber)
+
+	for _, m := range state.OwnMechs {
+		speed := 1
+		if cr, ok := state.ChassisByID[m.MechaTacticsGameChassisID]; ok {
+			speed = cr.Speed
+		}
+		var weapons []string
+		for _, w := range state.WeaponsByMechID[m.ID] {
+			weapons = append(weapons, fmt.Sprintf("%s (%s range, %d damage)", w.Name, w.RangeBand, w.Damage))
+		}
+		fmt.Fprintf(&sb, "  - Mech ID: %s, Callsign: %s, Status: %s, Hex: %s (hex_id: %s), Facing: %s, Armor: %d, Structure: %d, Heat: %d, Movement points: %d, Weapons: [%s]\n",
+			m.ID, m.Callsign, m.Status, hexLabel(m.MechaTacticsGameHexID), m.MechaTacticsGameHexID,
+			domain.MechaTacticsFacingLabel(m.Facing), m.CurrentArmor, m.CurrentStructure, m.CurrentHeat,
+			speed, strings.Join(weapons, ", "))
+	}
+
+	if len(state.EnemyMechs) > 0 {
+		sb.WriteString("\nEnemy mechs (targets):\n")
+		for _, em := range state.EnemyMechs {
+			fmt.Fprintf(&sb, "  - Mech ID: %s, Callsign: %s, Hex: %s (hex_id: %s), Facing: %s, Structure: %d\n",
+				em.ID, em.Callsign, hexLabel(em.MechaTacticsGameHexID), em.MechaTacticsGameHexID,
+				domain.MechaTacticsFacingLabel(em.Facing), em.CurrentStructure)
+		}
+	}
+
+	hexIDs := make([]string, 0, len(bf.HexByID))
+	for id := range bf.HexByID {
+		hexIDs = append(hexIDs, id)
+	}
+	sort.Slice(hexIDs, func(i, j int) bool {
+		a, b := bf.HexByID[hexIDs[i]], bf.HexByID[hexIDs[j]]
+		if a.HexRow != b.HexRow {
+			return a.HexRow < b.HexRow
+		}
+		return a.HexColumn < b.HexColumn
+	})
+
+	sb.WriteString("\nBattlefield hexes (axial column,row):\n")
+	for _, id := range hexIDs {
+		hex := bf.HexByID[id]
+		terrainName := "unknown"
+		if t := bf.Terrain(id); t != nil {
+			terrainName = t.Name
+		}
+		depot := ""
+		if hex.IsStartingHex {
+			depot = ", depot"
+		}
+		fmt.Fprintf(&sb, "  - %d,%d (hex_id: %s, terrain: %s, movement cost: %d, cover: %d, elevation: %d%s)\n",
+			hex.HexColumn, hex.HexRow, id, terrainName, bf.MovementCost(id), bf.CoverModifier(id), hex.Elevation, depot)
+	}
+
+	sb.WriteString(`
+Return your orders as JSON with this exact structure:
+{
+  "mech_orders": [
+    {
+      "mech_instance_id": "<exact mech ID from above>",
+      "move_to_hex_id": "<exact hex_id to move to, or empty string to stay>",
+      "facing": "<one of N, NE, SE, S, SW, NW, or empty string to keep facing>",
+      "attack_target_mech_instance_id": "<exact enemy mech ID to attack, or empty string for no attack>"
+    }
+  ]
+}
+Include one entry per mech. Use exact IDs from above.
+Each mech can spend up to its movement points entering hexes; each hex costs its terrain movement cost.
+Attack targets must be within weapon range after movement (short: same hex, medium: same or adjacent hex, long: 1-2 hexes away).
+Attacks into an enemy's side arc gain +10% to hit and into its rear arc +20%; negative cover makes a target harder to hit.
+Respond with JSON only — no markdown, no commentary.`)
+
+	return sb.String()
+}
+
+// extractJSONFromResponse strips markdown code fences if present.
+func extractJSONFromResponse(resp string) string {
+	resp = strings.TrimSpace(resp)
+
+	// Strip markdown code fences
+	if idx := strings.Index(resp, "```json"); idx != -1 {
+		resp = resp[idx+7:]
+		if end := strings.Index(resp, "```"); end != -1 {
+			resp = resp[:end]
+		}
+	} else if idx := strings.Index(resp, "```"); idx != -1 {
+		resp = resp[idx+3:]
+		if end := strings.Index(resp, "```"); end != -1 {
+			resp = resp[:end]
+		}
+	}
+
+	resp = strings.TrimSpace(resp)
+
+	// Find the JSON object bounds
+	start := strings.Index(resp, "{")
+	end := strings.LastIndex(resp, "}")
+	if start == -1 || end == -1 || end <= start {
+		return ""
+	}
+
+	return resp[start : end+1]
+}
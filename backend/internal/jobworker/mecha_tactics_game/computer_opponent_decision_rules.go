@@ -0,0 +1,317 @@
+package mecha_tactics_game
+
+import (
+	"context"
+	"sort"
+
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+const (
+	// aggressiveThreshold is the aggression at or above which an opponent advances.
+	aggressiveThreshold = 7
+	// defensiveThreshold is the aggression at or below which an opponent falls back.
+	defensiveThreshold = 3
+	// tacticalIQThreshold is the IQ at or above which an opponent weighs cover
+	// and elevation when choosing between otherwise equal hexes.
+	tacticalIQThreshold = 5
+)
+
+type ruleBasedStrategy struct{}
+
+func (s *ruleBasedStrategy) GenerateOrders(ctx context.Context, l logger.Logger, state *GameStateContext) ([]turnsheet.MechaTacticsOrdersScanData, error) {
+	l = l.WithFunctionContext("ruleBasedStrategy/GenerateOrders")
+
+	opp := state.Opponent
+	orders := make([]turnsheet.MechaTacticsOrdersScanData, 0, len(state.OwnMechs))
+
+	for _, mech := range state.OwnMechs {
+		if mech.Status == mecha_tactics_game_record.MechInstanceStatusDestroyed ||
+			mech.Status == mecha_tactics_game_record.MechInstanceStatusShutdown {
+			orders = append(orders, turnsheet.MechaTacticsOrdersScanData{
+				MechInstanceID: mech.ID,
+			})
+			continue
+		}
+
+		speed := 1
+		if chassisRec, ok := state.ChassisByID[mech.MechaTacticsGameChassisID]; ok {
+			speed = chassisRec.Speed
+		}
+
+		moveToHexID := s.pickMovementTarget(opp, mech, speed, state)
+
+		postMoveHexID := moveToHexID
+		if postMoveHexID == "" {
+			postMoveHexID = mech.MechaTacticsGameHexID
+		}
+
+		order := turnsheet.MechaTacticsOrdersScanData{
+			MechInstanceID:             mech.ID,
+			MoveToHexID:                moveToHexID,
+			AttackTargetMechInstanceID: s.pickAttackTarget(opp, mech, postMoveHexID, state),
+		}
+
+		if facing, ok := s.pickFacing(postMoveHexID, state); ok {
+			order.Facing = domain.MechaTacticsFacingLabel(facing)
+		}
+
+		orders = append(orders, order)
+	}
+
+	l.Info("rule-based strategy generated %d mech orders for opponent %s", len(orders), opp.Name)
+
+	return orders, nil
+}
+
+// pickMovementTarget returns the hex ID the mech should move to, or an empty
+// string to hold position.
+//
+//   - Aggressive opponents advance on the nearest enemy, closing to the
+//     distance their shortest-ranged weapon prefers.
+//   - Defensive opponents fall back toward a depot, favouring high ground
+//     and cover.
+//   - Everyone else holds position.
+func (s *ruleBasedStrategy) pickMovementTarget(
+	opp *mecha_tactics_game_record.MechaTacticsGameComputerOpponent,
+	mech *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+	speed int,
+	state *GameStateContext,
+) string {
+	bf := state.Battlefield
+	reachable := bf.ReachableHexes(mech.MechaTacticsGameHexID, speed)
+	if len(reachable) <= 1 {
+		return ""
+	}
+
+	hexIDs := make([]string, 0, len(reachable))
+	for hexID := range reachable {
+		hexIDs = append(hexIDs, hexID)
+	}
+	sort.Strings(hexIDs)
+
+	var score func(hexID string) int
+	switch {
+	case opp.Aggression >= aggressiveThreshold:
+		nearest := s.nearestEnemy(mech.MechaTacticsGameHexID, state)
+		if nearest == nil {
+			return ""
+		}
+		preferred := preferredEngagementDistance(state.WeaponsByMechID[mech.ID])
+		score = func(hexID string) int {
+			dist := bf.Distance(hexID, nearest.MechaTacticsGameHexID)
+			if dist < 0 {
+				return -1000
+			}
+			gap := dist - preferred
+			if gap < 0 {
+				gap = -gap
+			}
+			return -gap * 100
+		}
+	case opp.Aggression <= defensiveThreshold:
+		depotIDs := s.depotHexIDs(state)
+		score = func(hexID string) int {
+			nearestDepot := -1
+			for _, depotID := range depotIDs {
+				dist := bf.Distance(hexID, depotID)
+				if dist >= 0 && (nearestDepot < 0 || dist < nearestDepot) {
+					nearestDepot = dist
+				}
+			}
+			hexScore := 0
+			if nearestDepot >= 0 {
+				hexScore -= nearestDepot * 100
+			}
+			if hex := bf.HexByID[hexID]; hex != nil {
+				hexScore += hex.Elevation * 10
+			}
+			return hexScore - bf.CoverModifier(hexID)
+		}
+	default:
+		return ""
+	}
+
+	bestHexID := mech.MechaTacticsGameHexID
+	bestScore := s.adjustScore(opp, bestHexID, score(bestHexID), state)
+	for _, hexID := range hexIDs {
+		hexScore := s.adjustScore(opp, hexID, score(hexID), state)
+		if hexScore > bestScore {
+			bestHexID = hexID
+			bestScore = hexScore
+		}
+	}
+
+	if bestHexID == mech.MechaTacticsGameHexID {
+		return ""
+	}
+
+	return bestHexID
+}
+
+// adjustScore applies the IQ tie-breakers to a hex score. Smart opponents
+// prefer hexes with better cover (a more negative cover modifier) and more
+// elevation when the primary score is otherwise equal.
+func (s *ruleBasedStrategy) adjustScore(
+	opp *mecha_tactics_game_record.MechaTacticsGameComputerOpponent,
+	hexID string,
+	base int,
+	state *GameStateContext,
+) int {
+	if opp.IQ < tacticalIQThreshold {
+		return base
+	}
+	adjusted := base - state.Battlefield.CoverModifier(hexID)
+	if hex := state.Battlefield.HexByID[hexID]; hex != nil {
+		adjusted += hex.Elevation
+	}
+	return adjusted
+}
+
+// pickAttackTarget selects an enemy mech to attack from the given hex.
+// Returns empty string if no enemy is within range of any mounted weapon.
+// Targets exposing a flank or rear arc are preferred, then by aggression:
+// aggressive and balanced opponents finish off the weakest enemy while
+// defensive opponents engage the strongest threat.
+func (s *ruleBasedStrategy) pickAttackTarget(
+	opp *mecha_tactics_game_record.MechaTacticsGameComputerOpponent,
+	attacker *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+	fromHexID string,
+	state *GameStateContext,
+) string {
+	bf := state.Battlefield
+	weapons := state.WeaponsByMechID[attacker.ID]
+
+	attackerCoord, ok := bf.Coord(fromHexID)
+	if !ok {
+		return ""
+	}
+
+	var best *mecha_tactics_game_record.MechaTacticsGameMechInstance
+	bestArc := 0
+	for _, enemy := range state.EnemyMechs {
+		dist := bf.Distance(fromHexID, enemy.MechaTacticsGameHexID)
+		if dist < 0 || !anyWeaponCanFire(weapons, dist) {
+			continue
+		}
+
+		enemyCoord, _ := bf.Coord(enemy.MechaTacticsGameHexID)
+		arc := domain.MechaTacticsFiringArcModifier(
+			domain.MechaTacticsFiringArc(enemy.Facing, enemyCoord, attackerCoord),
+		)
+
+		if best == nil || s.betterTarget(opp, enemy, arc, best, bestArc) {
+			best = enemy
+			bestArc = arc
+		}
+	}
+
+	if best == nil {
+		return ""
+	}
+
+	return best.ID
+}
+
+// betterTarget reports whether candidate is a more attractive target than current.
+func (s *ruleBasedStrategy) betterTarget(
+	opp *mecha_tactics_game_record.MechaTacticsGameComputerOpponent,
+	candidate *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+	candidateArc int,
+	current *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+	currentArc int,
+) bool {
+	if candidateArc != currentArc {
+		return candidateArc > currentArc
+	}
+	if candidate.CurrentStructure != current.CurrentStructure {
+		if opp.Aggression <= defensiveThreshold {
+			return candidate.CurrentStructure > current.CurrentStructure
+		}
+		return candidate.CurrentStructure < current.CurrentStructure
+	}
+	return candidate.ID < current.ID
+}
+
+// pickFacing turns the mech toward the nearest enemy. Returns false when
+// there is no enemy or the nearest enemy shares the mech's hex.
+func (s *ruleBasedStrategy) pickFacing(fromHexID string, state *GameStateContext) (int, bool) {
+	nearest := s.nearestEnemy(fromHexID, state)
+	if nearest == nil {
+		return 0, false
+	}
+
+	from, ok := state.Battlefield.Coord(fromHexID)
+	if !ok {
+		return 0, false
+	}
+	to, ok := state.Battlefield.Coord(nearest.MechaTacticsGameHexID)
+	if !ok {
+		return 0, false
+	}
+
+	dir := domain.MechaTacticsHexDirection(from, to)
+	if dir < 0 {
+		return 0, false
+	}
+
+	return dir, true
+}
+
+// nearestEnemy returns the closest enemy mech to the given hex, breaking ties by ID.
+func (s *ruleBasedStrategy) nearestEnemy(fromHexID string, state *GameStateContext) *mecha_tactics_game_record.MechaTacticsGameMechInstance {
+	var nearest *mecha_tactics_game_record.MechaTacticsGameMechInstance
+	nearestDist := -1
+	for _, enemy := range state.EnemyMechs {
+		dist := state.Battlefield.Distance(fromHexID, enemy.MechaTacticsGameHexID)
+		if dist < 0 {
+			continue
+		}
+		if nearest == nil || dist < nearestDist || (dist == nearestDist && enemy.ID < nearest.ID) {
+			nearest = enemy
+			nearestDist = dist
+		}
+	}
+	return nearest
+}
+
+// depotHexIDs returns the IDs of all starting hexes, sorted for determinism.
+func (s *ruleBasedStrategy) depotHexIDs(state *GameStateContext) []string {
+	var ids []string
+	for id, hex := range state.Battlefield.HexByID {
+		if hex.IsStartingHex {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// preferredEngagementDistance returns the distance an advancing mech tries to
+// close to: the same hex when it carries any short or medium range weapon,
+// otherwise one hex away so long range weapons can fire.
+func preferredEngagementDistance(weapons []*mecha_tactics_game_record.MechaTacticsGameWeapon) int {
+	for _, w := range weapons {
+		if w.RangeBand == mecha_tactics_game_record.WeaponRangeBandShort ||
+			w.RangeBand == mecha_tactics_game_record.WeaponRangeBandMedium {
+			return 0
+		}
+	}
+	if len(weapons) == 0 {
+		return 0
+	}
+	return 1
+}
+
+// anyWeaponCanFire reports whether at least one of the weapons reaches the given distance.
+func anyWeaponCanFire(weapons []*mecha_tactics_game_record.MechaTacticsGameWeapon, distance int) bool {
+	for _, w := range weapons {
+		if domain.MechaTacticsWeaponCanFire(w.RangeBand, distance) {
+			return true
+		}
+	}
+	return false
+}
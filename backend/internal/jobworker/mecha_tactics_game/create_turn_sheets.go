@@ -0,0 +1,106 @@
+package mecha_tactics_game
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+// CreateTurnSheets creates all turn sheet records for the current turn of a mecha tactics instance.
+func (p *MechaTacticsGame) CreateTurnSheets(ctx context.Context, gameInstanceRec *game_record.GameInstance) ([]*game_record.GameTurnSheet, error) {
+	l := p.Logger.WithFunctionContext("MechaTacticsGame/CreateTurnSheets")
+
+	l.Info("creating mecha tactics turn sheets for instance >%s< turn >%d<", gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
+
+	mechInstanceRecs, err := p.getMechInstancesForGameInstance(ctx, gameInstanceRec)
+	if err != nil {
+		l.Warn("failed to get mech instances for game instance >%s< error >%v<", gameInstanceRec.ID, err)
+		return nil, err
+	}
+
+	l.Info("found >%d< mech instances for game instance >%s<", len(mechInstanceRecs), gameInstanceRec.ID)
+
+	if len(mechInstanceRecs) == 0 {
+		l.Info("no mech instances found for game instance >%s<", gameInstanceRec.ID)
+		return nil, nil
+	}
+
+	droppedIDs, err := p.getDroppedGameSubscriptionInstanceIDs(gameInstanceRec)
+	if err != nil {
+		l.Warn("failed to get dropped players for game instance >%s< error >%v<", gameInstanceRec.ID, err)
+		return nil, err
+	}
+
+	var errs []error
+	var createdTurnSheets []*game_record.GameTurnSheet
+	for _, mechInstanceRec := range mechInstanceRecs {
+		// Players dropped for missing too many turns receive no further turn sheets.
+		if isPlayerMech(mechInstanceRec) && droppedIDs[mechInstanceRec.GameSubscriptionInstanceID.String] {
+			l.Info("skipping turn sheet creation for dropped player mech instance >%s<", mechInstanceRec.ID)
+			continue
+		}
+
+		mechTurnSheets, err := p.createMechTurnSheets(ctx, gameInstanceRec, mechInstanceRec)
+		if err != nil {
+			l.Warn("failed to create turn sheets for mech >%s< error >%v<", mechInstanceRec.ID, err)
+			errs = append(errs, err)
+			continue
+		}
+		createdTurnSheets = append(createdTurnSheets, mechTurnSheets...)
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("failed to create turn sheets for some mechs: %v", errs)
+	}
+
+	return createdTurnSheets, nil
+}
+
+// createMechTurnSheets creates all of the current game turn's turn sheets for a mech.
+// Computer-opponent mechs produce no turn sheets; their orders are generated by the
+// decision engine during turn processing instead. Destroyed mechs receive no sheets.
+func (p *MechaTacticsGame) createMechTurnSheets(ctx context.Context, gameInstanceRec *game_record.GameInstance, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) ([]*game_record.GameTurnSheet, error) {
+	l := p.Logger.WithFunctionContext("MechaTacticsGame/createMechTurnSheets")
+
+	if !isPlayerMech(mechInstance) {
+		l.Info("skipping turn sheet creation for computer-opponent mech instance >%s<", mechInstance.ID)
+		return nil, nil
+	}
+
+	if mechInstance.Status == mecha_tactics_game_record.MechInstanceStatusDestroyed {
+		l.Info("skipping turn sheet creation for destroyed mech instance >%s<", mechInstance.ID)
+		return nil, nil
+	}
+
+	l.Info("creating turn sheets for mech instance >%s< turn number >%d<", mechInstance.ID, gameInstanceRec.CurrentTurn)
+
+	var createdTurnSheets []*game_record.GameTurnSheet
+
+	// Orders sheets read and clear the mech's turn events, so sheets are
+	// created in presentation order to keep events on the primary sheet.
+	for _, turnSheetType := range mecha_tactics_game_record.MechaTacticsGameTurnSheetPresentationOrder {
+		processor, exists := p.Processors[turnSheetType]
+		if !exists {
+			l.Warn("unsupported sheet type >%s< for mech instance >%s<", turnSheetType, mechInstance.ID)
+			return nil, fmt.Errorf("unsupported sheet type: %s", turnSheetType)
+		}
+
+		turnSheetRec, err := processor.CreateNextTurnSheet(ctx, gameInstanceRec, mechInstance)
+		if err != nil {
+			l.Warn("failed to create turn sheet >%s< for mech instance >%s< error >%v<", turnSheetType, mechInstance.ID, err)
+			return nil, err
+		}
+
+		if turnSheetRec == nil {
+			l.Info("no turn sheet generated for type >%s< mech instance ID >%s< — skipping", turnSheetType, mechInstance.ID)
+			continue
+		}
+
+		l.Info("created turn sheet >%s< for mech instance ID >%s< turn sheet type >%s< turn number >%d<", turnSheetRec.ID, mechInstance.ID, turnSheetType, gameInstanceRec.CurrentTurn)
+		createdTurnSheets = append(createdTurnSheets, turnSheetRec)
+	}
+
+	return createdTurnSheets, nil
+}
@@ -0,0 +1,109 @@
+package mecha_tactics_game
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+const (
+	supplyPointsPerTurn  = 2
+	heatDissipationDenom = 3 // dissipate HeatCapacity / 3 per turn
+)
+
+// runEndOfTurn runs the end-of-turn lifecycle for all mechs in a game instance:
+//  1. Heat dissipation per mech
+//  2. Shutdown recovery for mechs that sat out the whole turn shut down
+//  3. Repair completion for mechs that spent the whole turn repairing
+//  4. Supply point accrual for player mechs
+//  5. Append lifecycle TurnEvents to each player mech
+//
+// startState holds the repairing and shutdown mechs as they were before this
+// turn's orders were processed; states entered during this turn carry over
+// into the next turn.
+func (p *MechaTacticsGame) runEndOfTurn(
+	ctx context.Context,
+	l logger.Logger,
+	gameInstanceRec *game_record.GameInstance,
+	startState turnStartState,
+) error {
+	l = l.WithFunctionContext("MechaTacticsGame/runEndOfTurn")
+
+	allMechInsts, err := p.getMechInstancesForGameInstance(ctx, gameInstanceRec)
+	if err != nil {
+		return fmt.Errorf("failed to load mech instances: %w", err)
+	}
+
+	for _, inst := range allMechInsts {
+		if inst.Status == mecha_tactics_game_record.MechInstanceStatusDestroyed {
+			continue
+		}
+
+		chassisRec, err := p.Domain.GetMechaTacticsGameChassisRec(inst.MechaTacticsGameChassisID, nil)
+		if err != nil {
+			l.Warn("failed to get chassis for mech >%s<: %v", inst.ID, err)
+			continue
+		}
+
+		var events []string
+
+		// 1. Heat dissipation
+		if inst.CurrentHeat > 0 {
+			prev := inst.CurrentHeat
+			inst.CurrentHeat -= chassisRec.HeatCapacity / heatDissipationDenom
+			if inst.CurrentHeat < 0 {
+				inst.CurrentHeat = 0
+			}
+			if inst.CurrentHeat < prev {
+				events = append(events, fmt.Sprintf("%s heat dissipated from %d to %d.", inst.Callsign, prev, inst.CurrentHeat))
+			}
+		}
+
+		// 2. Shutdown recovery. A mech that shut down this turn stays down
+		// for the whole of the next turn.
+		if inst.Status == mecha_tactics_game_record.MechInstanceStatusShutdown && startState.Shutdown[inst.ID] {
+			inst.CurrentHeat = 0
+			inst.Status = mecha_tactics_game_record.MechInstanceStatusOperational
+			if inst.CurrentStructure < chassisRec.StructurePoints {
+				inst.Status = mecha_tactics_game_record.MechInstanceStatusDamaged
+			}
+			events = append(events, fmt.Sprintf("%s emergency shutdown complete — back online.", inst.Callsign))
+		}
+
+		// 3. Repair completion
+		if inst.IsRepairing && startState.Repairing[inst.ID] {
+			inst.IsRepairing = false
+			events = append(events, fmt.Sprintf("%s repairs complete — ready for orders.", inst.Callsign))
+		}
+
+		// 4. Supply point accrual
+		if isPlayerMech(inst) {
+			inst.SupplyPoints += supplyPointsPerTurn
+			events = append(events, fmt.Sprintf("%s received %d supply points (%d total).",
+				inst.Callsign, supplyPointsPerTurn, inst.SupplyPoints))
+		}
+
+		// 5. Lifecycle events are only surfaced on player turn sheets
+		if isPlayerMech(inst) {
+			for _, message := range events {
+				if err := turnsheet.AppendMechaTacticsGameTurnEvent(inst, turnsheet.TurnEvent{
+					Category: turnsheet.TurnEventCategorySystem,
+					Icon:     turnsheet.TurnEventIconSystem,
+					Message:  message,
+				}); err != nil {
+					l.Warn("failed to append end-of-turn event for mech >%s<: %v", inst.ID, err)
+				}
+			}
+		}
+
+		if _, err := p.Domain.UpdateMechaTacticsGameMechInstanceRec(inst); err != nil {
+			l.Warn("failed to update mech instance >%s< after end-of-turn: %v", inst.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Package mecha_tactics_game provides turn sheet processing for the mecha_tactics game type.
+//
+// File layout:
+//   - mecha_tactics_game.go  — MechaTacticsGame struct, processor registry, mech instance helpers
+//   - process_turn_sheets.go — ProcessTurnSheets entry point (implements GameTurnProcessor)
+//   - create_turn_sheets.go  — CreateTurnSheets entry point (implements GameTurnProcessor)
+//   - combat_resolution.go   — simultaneous attack resolution on the hex grid
+//   - end_of_turn.go         — heat, shutdown recovery, repair completion and supply
+//   - turn_sheet_processor/  — per-sheet-type business logic processors
+package mecha_tactics_game
+
+import (
+	"context"
+	"encoding/json"
+
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/jobworker/mecha_tactics_game/turn_sheet_processor"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// MechaTacticsGame is the turn sheet processor for mecha tactics games.
+// Mechs are owned individually, so every turn sheet is issued for a single
+// mech instance rather than a squad.
+//
+// Function/method argument order (enforced throughout this package):
+//  1. context.Context  — only on interface method boundaries
+//  2. logger.Logger    — all package-level helpers and unexported sub-functions
+//  3. *domain.Domain   — all package-level helpers and unexported sub-functions
+//  4. remaining domain arguments
+type MechaTacticsGame struct {
+	Logger         logger.Logger
+	Domain         *domain.Domain
+	Processors     map[string]TurnSheetProcessor
+	DecisionEngine *ComputerOpponentDecisionEngine
+	// pendingAttacks accumulates attack declarations from all order processing
+	// within a single ProcessTurnSheets call and is reset at the start of each
+	// ProcessTurnSheets call.
+	pendingAttacks []turn_sheet_processor.AttackDeclaration
+}
+
+// TurnSheetProcessor defines the interface for processing turn sheet business logic in mecha tactics
+type TurnSheetProcessor interface {
+	// GetSheetType returns the sheet type this processor handles
+	GetSheetType() string
+
+	// ProcessTurnSheetResponse processes a single turn sheet response and updates game state
+	ProcessTurnSheetResponse(ctx context.Context, gameInstanceRec *game_record.GameInstance, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance, turnSheet *game_record.GameTurnSheet) error
+
+	// CreateNextTurnSheet creates a new turn sheet record for the next turn.
+	// A nil turn sheet and nil error means no sheet applies to the mech this turn.
+	CreateNextTurnSheet(ctx context.Context, gameInstanceRec *game_record.GameInstance, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) (*game_record.GameTurnSheet, error)
+}
+
+// NewMechaTacticsGame creates a new mecha tactics turn processor.
+func NewMechaTacticsGame(l logger.Logger, d *domain.Domain, cfg config.Config) (*MechaTacticsGame, error) {
+	l = l.WithFunctionContext("NewMechaTacticsGame")
+
+	g := &MechaTacticsGame{
+		Logger:         l,
+		Domain:         d,
+		DecisionEngine: NewComputerOpponentDecisionEngine(l, d, cfg),
+	}
+
+	processors, err := g.initializeTurnSheetProcessors()
+	if err != nil {
+		l.Warn("failed to initialize turn sheet processors >%v<", err)
+		return nil, err
+	}
+	g.Processors = processors
+
+	return g, nil
+}
+
+// initializeTurnSheetProcessors creates and registers all available mecha tactics turn sheet business processors.
+// To add new turn sheet types: 1) Create processor in turn_sheet_processor/ 2) Register here
+func (p *MechaTacticsGame) initializeTurnSheetProcessors() (map[string]TurnSheetProcessor, error) {
+	l := p.Logger.WithFunctionContext("MechaTacticsGame/initializeTurnSheetProcessors")
+
+	processors := make(map[string]TurnSheetProcessor)
+
+	ordersProcessor, err := turn_sheet_processor.NewMechaTacticsGameOrdersProcessor(l, p.Domain)
+	if err != nil {
+		l.Warn("failed to initialize orders processor >%v<", err)
+		return nil, err
+	}
+	processors[mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders] = ordersProcessor
+
+	repairProcessor, err := turn_sheet_processor.NewMechaTacticsGameRepairProcessor(l, p.Domain)
+	if err != nil {
+		l.Warn("failed to initialize repair processor >%v<", err)
+		return nil, err
+	}
+	processors[mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeRepair] = repairProcessor
+
+	return processors, nil
+}
+
+// ordersProcessor returns the registered orders processor.
+func (p *MechaTacticsGame) ordersProcessor() (*turn_sheet_processor.MechaTacticsGameOrdersProcessor, bool) {
+	op, ok := p.Processors[mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders].(*turn_sheet_processor.MechaTacticsGameOrdersProcessor)
+	return op, ok
+}
+
+// getMechInstancesForGameInstance retrieves all mech instances for a game instance.
+func (p *MechaTacticsGame) getMechInstancesForGameInstance(_ context.Context, gameInstanceRec *game_record.GameInstance) ([]*mecha_tactics_game_record.MechaTacticsGameMechInstance, error) {
+	l := p.Logger.WithFunctionContext("MechaTacticsGame/getMechInstancesForGameInstance")
+
+	mechInstanceRecs, err := p.Domain.GetManyMechaTacticsGameMechInstanceRecs(
+		&coresql.Options{
+			Params: []coresql.Param{
+				{
+					Col: mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceGameInstanceID,
+					Val: gameInstanceRec.ID,
+				},
+			},
+			OrderBy: []coresql.OrderBy{
+				{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceCreatedAt, Direction: coresql.OrderDirectionASC},
+			},
+		},
+	)
+	if err != nil {
+		l.Warn("failed to get mech instances error >%v<", err)
+		return nil, err
+	}
+
+	return mechInstanceRecs, nil
+}
+
+// isPlayerMech reports whether a mech is owned by a player subscription.
+func isPlayerMech(mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) bool {
+	return mechInstance.GameSubscriptionInstanceID.Valid
+}
+
+// loadWeaponConfig decodes the weapon loadout persisted on a mech instance.
+func loadWeaponConfig(mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) ([]mecha_tactics_game_record.WeaponConfigEntry, error) {
+	var weaponConfig []mecha_tactics_game_record.WeaponConfigEntry
+	if len(mechInstance.WeaponConfigJSON) > 0 {
+		if err := json.Unmarshal(mechInstance.WeaponConfigJSON, &weaponConfig); err != nil {
+			return nil, err
+		}
+	}
+	return weaponConfig, nil
+}
@@ -0,0 +1,143 @@
+package mecha_tactics_game_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/harness"
+	"gitlab.com/alienspaces/playbymail/internal/jobworker/mecha_tactics_game"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+	"gitlab.com/alienspaces/playbymail/internal/utils/deps"
+)
+
+// TestMechaTacticsGame_StartAndProcessTurn starts a mecha tactics instance and
+// runs its first turn through the same steps as the game turn processing worker.
+func TestMechaTacticsGame_StartAndProcessTurn(t *testing.T) {
+	cfg, err := config.Parse()
+	require.NoError(t, err, "Parse returns without error")
+
+	l, s, j, scanner, err := deps.NewDefaultDependencies(cfg)
+	require.NoError(t, err, "NewDefaultDependencies returns without error")
+
+	th, err := harness.NewTesting(cfg, l, s, j, scanner, harness.DefaultDataConfig())
+	require.NoError(t, err, "NewTesting returns without error")
+
+	// Keep transaction open so domain can query the data it creates
+	th.ShouldCommitData = false
+
+	_, err = th.Setup()
+	require.NoError(t, err, "Test data setup returns without error")
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err, "Test data teardown returns without error")
+	}()
+
+	m := th.Domain.(*domain.Domain)
+
+	// The default harness creates GameMechaTacticsGameRef with one starter mech
+	// and one opponent mech for a single computer opponent, but no game
+	// instances or subscriptions. We create them here.
+	gameRec, err := th.Data.GetGameRecByRef(harness.GameMechaTacticsGameRef)
+	require.NoError(t, err, "GetGameRecByRef returns without error")
+
+	accountUserRec, err := th.Data.GetAccountUserRecByRef(harness.AccountUserStandardRef)
+	require.NoError(t, err, "GetAccountUserRecByRef returns without error")
+
+	accountUserContactRec, err := th.Data.GetAccountUserContactRecByAccountUserID(accountUserRec.ID)
+	require.NoError(t, err, "GetAccountUserContactRecByAccountUserID returns without error")
+
+	// A manager subscription is required before a game instance can be created.
+	_, err = m.CreateGameSubscriptionRec(&game_record.GameSubscription{
+		GameID:           gameRec.ID,
+		AccountID:        accountUserRec.AccountID,
+		AccountUserID:    accountUserRec.ID,
+		SubscriptionType: game_record.GameSubscriptionTypeManager,
+		Status:           game_record.GameSubscriptionStatusActive,
+	})
+	require.NoError(t, err, "CreateGameSubscriptionRec (manager) returns without error")
+
+	playerSubRec, err := m.CreateGameSubscriptionRec(&game_record.GameSubscription{
+		GameID:               gameRec.ID,
+		AccountID:            accountUserRec.AccountID,
+		AccountUserID:        accountUserRec.ID,
+		AccountUserContactID: nullstring.FromString(accountUserContactRec.ID),
+		SubscriptionType:     game_record.GameSubscriptionTypePlayer,
+		Status:               game_record.GameSubscriptionStatusActive,
+		DeliveryMethod:       nullstring.FromString(game_record.GameSubscriptionDeliveryMethodEmail),
+	})
+	require.NoError(t, err, "CreateGameSubscriptionRec (player) returns without error")
+
+	gameInstanceRec, err := m.CreateGameInstanceRec(&game_record.GameInstance{
+		GameID:              gameRec.ID,
+		Status:              game_record.GameInstanceStatusCreated,
+		RequiredPlayerCount: 1,
+		DeliveryEmail:       true,
+	})
+	require.NoError(t, err, "CreateGameInstanceRec returns without error")
+
+	subInstanceRec, err := m.CreateGameSubscriptionInstanceRec(&game_record.GameSubscriptionInstance{
+		AccountID:          accountUserRec.AccountID,
+		AccountUserID:      accountUserRec.ID,
+		GameSubscriptionID: playerSubRec.ID,
+		GameInstanceID:     gameInstanceRec.ID,
+	})
+	require.NoError(t, err, "CreateGameSubscriptionInstanceRec returns without error")
+
+	// Start the instance
+	gameInstanceRec, instanceData, err := m.StartGameInstance(gameInstanceRec.ID)
+	require.NoError(t, err, "StartGameInstance returns without error")
+	require.Equal(t, game_record.GameInstanceStatusStarted, gameInstanceRec.Status, "Instance status is started")
+	require.Equal(t, 0, gameInstanceRec.CurrentTurn, "CurrentTurn is 0")
+	require.NotNil(t, instanceData.MechaTacticsGame, "MechaTacticsGame data should be non-nil for mecha tactics game")
+
+	// The default mech_count of 1 issues one mech to the player; the opponent
+	// mech is assigned to the computer opponent.
+	var playerMechs, opponentMechs int
+	for _, mechInstanceRec := range instanceData.MechaTacticsGame.MechInstances {
+		if mechInstanceRec.GameSubscriptionInstanceID.Valid {
+			require.Equal(t, subInstanceRec.ID, mechInstanceRec.GameSubscriptionInstanceID.String, "Player mech belongs to the player subscription instance")
+			playerMechs++
+			continue
+		}
+		require.True(t, mechInstanceRec.MechaTacticsGameComputerOpponentID.Valid, "Non-player mech belongs to a computer opponent")
+		opponentMechs++
+	}
+	require.Equal(t, 1, playerMechs, "Player mech count equals expected")
+	require.Equal(t, 1, opponentMechs, "Computer opponent mech count equals expected")
+
+	proc, err := mecha_tactics_game.NewMechaTacticsGame(m.Log, m, cfg)
+	require.NoError(t, err, "NewMechaTacticsGame returns without error")
+
+	// Process the first turn
+	ctx := context.Background()
+
+	gameInstanceRec, err = m.BeginTurnProcessing(gameInstanceRec.ID)
+	require.NoError(t, err, "BeginTurnProcessing returns without error")
+
+	err = proc.ProcessTurnSheets(ctx, gameInstanceRec)
+	require.NoError(t, err, "ProcessTurnSheets returns without error")
+
+	gameInstanceRec, err = m.CompleteTurn(gameInstanceRec.ID)
+	require.NoError(t, err, "CompleteTurn returns without error")
+	require.Equal(t, game_record.GameInstanceStatusStarted, gameInstanceRec.Status, "Instance is still started with both sides standing")
+	require.Equal(t, 1, gameInstanceRec.CurrentTurn, "CurrentTurn is 1")
+
+	turnSheetRecs, err := proc.CreateTurnSheets(ctx, gameInstanceRec)
+	require.NoError(t, err, "CreateTurnSheets returns without error")
+	require.NotEmpty(t, turnSheetRecs, "Turn sheets are created for the player mech")
+
+	var ordersSheets int
+	for _, turnSheetRec := range turnSheetRecs {
+		require.Equal(t, 1, turnSheetRec.TurnNumber, "Turn sheet is for turn 1")
+		if turnSheetRec.SheetType == mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders {
+			ordersSheets++
+		}
+	}
+	require.Equal(t, 1, ordersSheets, "One orders sheet is created for the player mech")
+}
@@ -0,0 +1,230 @@
+package mecha_tactics_game
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+// takeoverOpponent returns the computer opponent profile used to pilot a player's mech
+// when the player has missed turns: a balanced pilot of average aggression and
+// intelligence.
+func takeoverOpponent(gameInstanceRec *game_record.GameInstance) *mecha_tactics_game_record.MechaTacticsGameComputerOpponent {
+	return &mecha_tactics_game_record.MechaTacticsGameComputerOpponent{
+		GameID:      gameInstanceRec.GameID,
+		Name:        "Autopilot",
+		Description: "Pilots the mech while its pilot is absent.",
+		Aggression:  5,
+		IQ:          5,
+	}
+}
+
+// StandingOrderScannedData returns the scanned data that carries out a player's standing
+// order for a turn sheet they did not submit. Nil is returned when the standing order
+// calls for no action this turn.
+func (p *MechaTacticsGame) StandingOrderScannedData(ctx context.Context, gameInstanceRec *game_record.GameInstance, turnSheetRec *game_record.GameTurnSheet, standingOrder string) (json.RawMessage, error) {
+	l := p.Logger.WithFunctionContext("MechaTacticsGame/StandingOrderScannedData")
+
+	mechInstance, err := p.getMechInstanceForTurnSheet(turnSheetRec)
+	if err != nil {
+		return nil, err
+	}
+
+	switch standingOrder {
+	case mecha_tactics_game_record.MechaTacticsGameStandingOrderHoldPosition:
+		if turnSheetRec.SheetType != mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders {
+			return nil, nil
+		}
+		return p.holdPositionScannedData(ctx, gameInstanceRec, mechInstance)
+	case mecha_tactics_game_record.MechaTacticsGameStandingOrderRepeatLastOrders:
+		return p.lastTurnScannedData(mechInstance, turnSheetRec)
+	case mecha_tactics_game_record.MechaTacticsGameStandingOrderAutoRepair:
+		if turnSheetRec.SheetType != mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeRepair {
+			return nil, nil
+		}
+		return p.autoRepairScannedData(mechInstance)
+	default:
+		l.Warn("unsupported standing order >%s< for turn sheet type >%s<", standingOrder, turnSheetRec.SheetType)
+		return nil, nil
+	}
+}
+
+// ComputerTakeoverScannedData returns the scanned data for a turn sheet when the computer
+// has taken over piloting a player's mech. Orders come from the computer opponent decision
+// engine and the repair sheet repairs the mech when it is damaged.
+func (p *MechaTacticsGame) ComputerTakeoverScannedData(ctx context.Context, gameInstanceRec *game_record.GameInstance, turnSheetRec *game_record.GameTurnSheet) (json.RawMessage, error) {
+	l := p.Logger.WithFunctionContext("MechaTacticsGame/ComputerTakeoverScannedData")
+
+	mechInstance, err := p.getMechInstanceForTurnSheet(turnSheetRec)
+	if err != nil {
+		return nil, err
+	}
+
+	switch turnSheetRec.SheetType {
+	case mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders:
+		if !isPlayerMech(mechInstance) {
+			return nil, nil
+		}
+
+		orders, err := p.DecisionEngine.GenerateOrdersForPlayer(ctx, gameInstanceRec, takeoverOpponent(gameInstanceRec), mechInstance.GameSubscriptionInstanceID.String)
+		if err != nil {
+			l.Warn("decision engine failed for mech instance >%s< error >%v<", mechInstance.ID, err)
+			return nil, err
+		}
+
+		for _, order := range orders {
+			if order.MechInstanceID == mechInstance.ID {
+				return json.Marshal(order)
+			}
+		}
+
+		return nil, nil
+	case mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeRepair:
+		return p.autoRepairScannedData(mechInstance)
+	default:
+		return nil, nil
+	}
+}
+
+// AppendMissedTurnEvent appends a turn event to the mechs the turn sheets belong to so
+// the outcome of a missed turn is reported on the next turn's sheets.
+func (p *MechaTacticsGame) AppendMissedTurnEvent(ctx context.Context, gameInstanceRec *game_record.GameInstance, turnSheetRecs []*game_record.GameTurnSheet, evt turnsheet.TurnEvent) error {
+	l := p.Logger.WithFunctionContext("MechaTacticsGame/AppendMissedTurnEvent")
+
+	mechInstances := map[string]*mecha_tactics_game_record.MechaTacticsGameMechInstance{}
+	for _, turnSheetRec := range turnSheetRecs {
+		mechInstance, err := p.getMechInstanceForTurnSheet(turnSheetRec)
+		if err != nil {
+			return err
+		}
+		mechInstances[mechInstance.ID] = mechInstance
+	}
+
+	for _, mechInstance := range mechInstances {
+		if err := turnsheet.AppendMechaTacticsGameTurnEvent(mechInstance, evt); err != nil {
+			return fmt.Errorf("failed to append missed turn event: %w", err)
+		}
+		if _, err := p.Domain.UpdateMechaTacticsGameMechInstanceRec(mechInstance); err != nil {
+			l.Warn("failed to update mech instance >%s< error >%v<", mechInstance.ID, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// holdPositionScannedData returns orders that keep the mech in its hex, turned toward the
+// nearest enemy and firing on the best target in range.
+func (p *MechaTacticsGame) holdPositionScannedData(ctx context.Context, gameInstanceRec *game_record.GameInstance, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) (json.RawMessage, error) {
+	if mechInstance.Status == mecha_tactics_game_record.MechInstanceStatusDestroyed ||
+		mechInstance.Status == mecha_tactics_game_record.MechInstanceStatusShutdown {
+		return nil, nil
+	}
+
+	opponentRec := takeoverOpponent(gameInstanceRec)
+
+	state, err := p.DecisionEngine.buildGameStateContext(ctx, gameInstanceRec, opponentRec, func(m *mecha_tactics_game_record.MechaTacticsGameMechInstance) bool {
+		return m.ID == mechInstance.ID ||
+			(isPlayerMech(m) && m.GameSubscriptionInstanceID.String == mechInstance.GameSubscriptionInstanceID.String)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build game state context: %w", err)
+	}
+
+	strategy := &ruleBasedStrategy{}
+
+	order := turnsheet.MechaTacticsOrdersScanData{
+		MechInstanceID:             mechInstance.ID,
+		AttackTargetMechInstanceID: strategy.pickAttackTarget(opponentRec, mechInstance, mechInstance.MechaTacticsGameHexID, state),
+	}
+	if facing, ok := strategy.pickFacing(mechInstance.MechaTacticsGameHexID, state); ok {
+		order.Facing = domain.MechaTacticsFacingLabel(facing)
+	}
+
+	return json.Marshal(order)
+}
+
+// autoRepairScannedData returns repair orders that repair the mech's structure when it is
+// damaged. Repairs are only carried out in a depot hex.
+func (p *MechaTacticsGame) autoRepairScannedData(mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) (json.RawMessage, error) {
+	if mechInstance.Status == mecha_tactics_game_record.MechInstanceStatusDestroyed {
+		return nil, nil
+	}
+
+	chassisRec, err := p.Domain.GetMechaTacticsGameChassisRec(mechInstance.MechaTacticsGameChassisID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if mechInstance.CurrentStructure >= chassisRec.StructurePoints {
+		return nil, nil
+	}
+
+	return json.Marshal(turnsheet.MechaTacticsRepairScanData{
+		MechInstanceID:  mechInstance.ID,
+		RepairStructure: true,
+	})
+}
+
+// lastTurnScannedData returns the scanned data of the mech's turn sheet of the same type
+// from the previous turn.
+func (p *MechaTacticsGame) lastTurnScannedData(mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance, turnSheetRec *game_record.GameTurnSheet) (json.RawMessage, error) {
+	if turnSheetRec.TurnNumber <= 1 {
+		return nil, nil
+	}
+
+	lastTurnSheetRecs, err := p.getTurnSheetsForMech(mechInstance, turnSheetRec.TurnNumber-1)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lastTurnSheetRec := range lastTurnSheetRecs {
+		if lastTurnSheetRec.SheetType == turnSheetRec.SheetType && len(lastTurnSheetRec.ScannedData) > 0 {
+			return lastTurnSheetRec.ScannedData, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getMechInstanceForTurnSheet returns the mech instance a turn sheet was created for.
+func (p *MechaTacticsGame) getMechInstanceForTurnSheet(turnSheetRec *game_record.GameTurnSheet) (*mecha_tactics_game_record.MechaTacticsGameMechInstance, error) {
+	l := p.Logger.WithFunctionContext("MechaTacticsGame/getMechInstanceForTurnSheet")
+
+	mechaTacticsGameTurnSheetRecs, err := p.Domain.GetManyMechaTacticsGameTurnSheetRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameTurnSheetGameTurnSheetID, Val: turnSheetRec.ID},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		l.Warn("failed to get mecha tactics turn sheet for turn sheet >%s< error >%v<", turnSheetRec.ID, err)
+		return nil, err
+	}
+	if len(mechaTacticsGameTurnSheetRecs) == 0 {
+		return nil, fmt.Errorf("no mech instance found for turn sheet >%s<", turnSheetRec.ID)
+	}
+
+	return p.Domain.GetMechaTacticsGameMechInstanceRec(mechaTacticsGameTurnSheetRecs[0].MechaTacticsGameMechInstanceID, nil)
+}
+
+// getDroppedGameSubscriptionInstanceIDs returns the game subscription instances of players
+// dropped from a game instance for missing too many turns.
+func (p *MechaTacticsGame) getDroppedGameSubscriptionInstanceIDs(gameInstanceRec *game_record.GameInstance) (map[string]bool, error) {
+	droppedRecs, err := p.Domain.GetDroppedGameSubscriptionInstanceRecsByInstance(gameInstanceRec.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	droppedIDs := make(map[string]bool, len(droppedRecs))
+	for _, droppedRec := range droppedRecs {
+		droppedIDs[droppedRec.ID] = true
+	}
+
+	return droppedIDs, nil
+}
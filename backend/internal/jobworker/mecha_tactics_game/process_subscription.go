@@ -0,0 +1,48 @@
+package mecha_tactics_game
+
+import (
+	"context"
+	"encoding/json"
+
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+// MechaTacticsGameJoinGameProcessor handles subscription processing for mecha tactics games.
+// Player mechs are created at game start by PopulateMechaTacticsGameInstanceData;
+// this processor exists only to handle the physical-mail scan flow (pilot name).
+type MechaTacticsGameJoinGameProcessor struct {
+	Logger logger.Logger
+	Domain *domain.Domain
+}
+
+// NewMechaTacticsGameJoinGameProcessor creates a new mecha tactics join game processor.
+func NewMechaTacticsGameJoinGameProcessor(l logger.Logger, d *domain.Domain) (*MechaTacticsGameJoinGameProcessor, error) {
+	return &MechaTacticsGameJoinGameProcessor{Logger: l, Domain: d}, nil
+}
+
+// ProcessGameSubscriptionProcessing is a no-op for mecha tactics games.
+// Mechs are created at game-start time from the designer's mech templates, not
+// at join time. The pilot name from a physical join-game scan is logged only.
+func (p *MechaTacticsGameJoinGameProcessor) ProcessGameSubscriptionProcessing(
+	ctx context.Context,
+	subscriptionRec *game_record.GameSubscription,
+	turnSheetRec *game_record.GameTurnSheet,
+) error {
+	l := p.Logger.WithFunctionContext("MechaTacticsGameJoinGameProcessor/ProcessGameSubscriptionProcessing")
+
+	l.Info("processing mecha tactics subscription ID >%s< (no-op: mechs created at game start)", subscriptionRec.ID)
+
+	if turnSheetRec != nil && len(turnSheetRec.ScannedData) > 0 {
+		var scanData turnsheet.MechaTacticsGameJoinGameScanData
+		if err := json.Unmarshal(turnSheetRec.ScannedData, &scanData); err == nil {
+			if err := scanData.Validate(); err == nil {
+				l.Info("physical join scan for subscription >%s<: pilot name >%s<", subscriptionRec.ID, scanData.PilotName)
+			}
+		}
+	}
+
+	return nil
+}
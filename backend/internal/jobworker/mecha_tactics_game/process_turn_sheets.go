@@ -0,0 +1,452 @@
+package mecha_tactics_game
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/jobworker/mecha_tactics_game/turn_sheet_processor"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+// turnStartState records the mech states that last exactly one full turn, so
+// end of turn only clears states entered before this turn began.
+type turnStartState struct {
+	Repairing map[string]bool
+	Shutdown  map[string]bool
+}
+
+// ProcessTurnSheets processes all turn sheet records for the current turn of a mecha tactics instance.
+func (p *MechaTacticsGame) ProcessTurnSheets(ctx context.Context, gameInstanceRec *game_record.GameInstance) error {
+	l := p.Logger.WithFunctionContext("MechaTacticsGame/ProcessTurnSheets")
+
+	l.Info("processing mecha tactics turn for instance >%s< turn >%d<", gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
+
+	// Reset accumulated attacks for this processing run
+	p.pendingAttacks = nil
+
+	mechInstanceRecs, err := p.getMechInstancesForGameInstance(ctx, gameInstanceRec)
+	if err != nil {
+		l.Warn("failed to get mech instances for game instance >%s< error >%v<", gameInstanceRec.ID, err)
+		return err
+	}
+
+	l.Info("found >%d< mech instances for game instance >%s<", len(mechInstanceRecs), gameInstanceRec.ID)
+
+	if len(mechInstanceRecs) == 0 {
+		l.Info("no mech instances found for game instance >%s<", gameInstanceRec.ID)
+		return nil
+	}
+
+	startState := turnStartState{
+		Repairing: make(map[string]bool),
+		Shutdown:  make(map[string]bool),
+	}
+	for _, mechInstanceRec := range mechInstanceRecs {
+		if mechInstanceRec.IsRepairing {
+			startState.Repairing[mechInstanceRec.ID] = true
+		}
+		if mechInstanceRec.Status == mecha_tactics_game_record.MechInstanceStatusShutdown {
+			startState.Shutdown[mechInstanceRec.ID] = true
+		}
+	}
+
+	var errs []error
+	for _, mechInstanceRec := range mechInstanceRecs {
+		if !isPlayerMech(mechInstanceRec) {
+			continue
+		}
+		if err := p.processMechTurnSheets(ctx, gameInstanceRec, mechInstanceRec); err != nil {
+			l.Warn("failed to process turn sheets for mech >%s< error >%v<", mechInstanceRec.ID, err)
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to process turn sheets for some mechs: %v", errs)
+	}
+
+	if err := p.processComputerOpponentOrders(ctx, gameInstanceRec); err != nil {
+		l.Warn("failed to process computer opponent orders >%v< — continuing (non-fatal)", err)
+	}
+
+	// Resolve combat from all collected attack declarations
+	if err := p.resolveCombat(ctx, l, gameInstanceRec, p.pendingAttacks); err != nil {
+		l.Warn("failed to resolve combat >%v< — continuing (non-fatal)", err)
+	}
+	p.pendingAttacks = nil
+
+	// Run end-of-turn lifecycle (heat dissipation, shutdown recovery, repair completion, supply accrual)
+	if err := p.runEndOfTurn(ctx, l, gameInstanceRec, startState); err != nil {
+		l.Warn("failed to run end-of-turn lifecycle >%v< — continuing (non-fatal)", err)
+	}
+
+	return nil
+}
+
+// processComputerOpponentOrders generates and applies orders for all computer
+// opponent mechs for the current turn. Errors are non-fatal to avoid blocking
+// the human players' turn.
+func (p *MechaTacticsGame) processComputerOpponentOrders(ctx context.Context, gameInstanceRec *game_record.GameInstance) error {
+	l := p.Logger.WithFunctionContext("MechaTacticsGame/processComputerOpponentOrders")
+
+	l.Info("generating computer opponent orders for game instance >%s< turn >%d<", gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
+
+	opponentRecs, err := p.Domain.GetManyMechaTacticsGameComputerOpponentRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameComputerOpponentGameID, Val: gameInstanceRec.GameID},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get computer opponents: %w", err)
+	}
+
+	if len(opponentRecs) == 0 {
+		l.Info("no computer opponents for game >%s<", gameInstanceRec.GameID)
+		return nil
+	}
+
+	ordersProc, ok := p.ordersProcessor()
+	if !ok {
+		return fmt.Errorf("orders processor unavailable — cannot apply computer opponent orders")
+	}
+
+	for _, opponentRec := range opponentRecs {
+		orders, err := p.DecisionEngine.GenerateOrdersForOpponent(ctx, gameInstanceRec, opponentRec)
+		if err != nil {
+			l.Warn("decision engine failed for opponent >%s<: %v", opponentRec.Name, err)
+			continue
+		}
+
+		// Reload state after each opponent so later opponents see earlier moves.
+		battlefield, err := p.Domain.LoadMechaTacticsBattlefield(gameInstanceRec.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to load battlefield: %w", err)
+		}
+
+		orders = p.resolveAIOrderIDs(l, gameInstanceRec.ID, opponentRec.Name, battlefield, orders)
+
+		for _, order := range orders {
+			if err := p.applyComputerOpponentOrder(l, gameInstanceRec, opponentRec, ordersProc, battlefield, order); err != nil {
+				l.Warn("failed to apply order for mech >%s< opponent >%s<: %v", order.MechInstanceID, opponentRec.Name, err)
+				continue
+			}
+		}
+
+		l.Info("applied %d orders for computer opponent >%s<", len(orders), opponentRec.Name)
+	}
+
+	return nil
+}
+
+// applyComputerOpponentOrder applies a single mech's orders generated by the
+// decision engine through the same rules as the human orders processor and
+// queues its attack declaration. When the mech moves, a sighting event is
+// appended to every player mech in the game.
+func (p *MechaTacticsGame) applyComputerOpponentOrder(
+	l logger.Logger,
+	gameInstanceRec *game_record.GameInstance,
+	opponentRec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent,
+	ordersProc *turn_sheet_processor.MechaTacticsGameOrdersProcessor,
+	battlefield *domain.MechaTacticsBattlefield,
+	order turnsheet.MechaTacticsOrdersScanData,
+) error {
+	if order.MechInstanceID == "" {
+		return nil
+	}
+
+	mechInstanceRec, err := p.Domain.GetMechaTacticsGameMechInstanceRec(order.MechInstanceID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get mech instance >%s<: %w", order.MechInstanceID, err)
+	}
+
+	if mechInstanceRec.GameInstanceID != gameInstanceRec.ID {
+		return fmt.Errorf("mech instance >%s< does not belong to game instance >%s<", order.MechInstanceID, gameInstanceRec.ID)
+	}
+
+	if !mechInstanceRec.MechaTacticsGameComputerOpponentID.Valid || mechInstanceRec.MechaTacticsGameComputerOpponentID.String != opponentRec.ID {
+		return fmt.Errorf("mech instance >%s< is not controlled by opponent >%s<", order.MechInstanceID, opponentRec.ID)
+	}
+
+	fromHexID := mechInstanceRec.MechaTacticsGameHexID
+	if _, err := ordersProc.ApplyOrders(l, battlefield, mechInstanceRec, order); err != nil {
+		return err
+	}
+
+	if mechInstanceRec.MechaTacticsGameHexID != fromHexID {
+		p.recordOpponentMovement(l, gameInstanceRec, battlefield, mechInstanceRec)
+	}
+
+	if order.AttackTargetMechInstanceID != "" {
+		p.pendingAttacks = append(p.pendingAttacks, turn_sheet_processor.AttackDeclaration{
+			AttackerMechInstanceID: mechInstanceRec.ID,
+			TargetMechInstanceID:   order.AttackTargetMechInstanceID,
+		})
+	}
+
+	return nil
+}
+
+// recordOpponentMovement appends a movement event to every player mech in the
+// game so the player's "What Happened" panel surfaces enemy positioning
+// changes. Failure to write events is logged but not fatal.
+func (p *MechaTacticsGame) recordOpponentMovement(
+	l logger.Logger,
+	gameInstanceRec *game_record.GameInstance,
+	battlefield *domain.MechaTacticsBattlefield,
+	mechInstanceRec *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+) {
+	message := fmt.Sprintf("Enemy mech %s changed position.", mechInstanceRec.Callsign)
+	if hexRec, ok := battlefield.HexByID[mechInstanceRec.MechaTacticsGameHexID]; ok {
+		message = fmt.Sprintf("Enemy mech %s moved to hex %d,%d facing %s.", mechInstanceRec.Callsign,
+			hexRec.HexColumn, hexRec.HexRow, domain.MechaTacticsFacingLabel(mechInstanceRec.Facing))
+	}
+
+	mechs, err := p.getMechInstancesForGameInstance(context.Background(), gameInstanceRec)
+	if err != nil {
+		l.Warn("failed to load mech instances for opponent movement event: %v", err)
+		return
+	}
+
+	evt := turnsheet.TurnEvent{
+		Category: turnsheet.TurnEventCategoryMovement,
+		Icon:     turnsheet.TurnEventIconMovement,
+		Message:  message,
+	}
+
+	for _, mech := range mechs {
+		// Events only surface on player turn sheets.
+		if !isPlayerMech(mech) || mech.Status == mecha_tactics_game_record.MechInstanceStatusDestroyed {
+			continue
+		}
+		if err := turnsheet.AppendMechaTacticsGameTurnEvent(mech, evt); err != nil {
+			l.Warn("failed to append opponent movement event to mech >%s<: %v", mech.ID, err)
+			continue
+		}
+		if _, err := p.Domain.UpdateMechaTacticsGameMechInstanceRec(mech); err != nil {
+			l.Warn("failed to persist opponent movement event on mech >%s<: %v", mech.ID, err)
+		}
+	}
+}
+
+// resolveAIOrderIDs inspects each AI-generated order and, when a hex or mech
+// reference looks like a label rather than a UUID, resolves it back to the
+// correct ID. LLM-driven strategies occasionally emit "column,row" labels or
+// mech callsigns in fields the engine expects to contain UUIDs; without this
+// rescue the order is dropped and the opponent effectively forfeits the turn.
+func (p *MechaTacticsGame) resolveAIOrderIDs(
+	l logger.Logger,
+	gameInstanceID, opponentName string,
+	battlefield *domain.MechaTacticsBattlefield,
+	orders []turnsheet.MechaTacticsOrdersScanData,
+) []turnsheet.MechaTacticsOrdersScanData {
+	if len(orders) == 0 {
+		return orders
+	}
+
+	needsResolve := false
+	for _, o := range orders {
+		if !looksLikeUUID(o.MechInstanceID) ||
+			(o.MoveToHexID != "" && !looksLikeUUID(o.MoveToHexID)) ||
+			(o.AttackTargetMechInstanceID != "" && !looksLikeUUID(o.AttackTargetMechInstanceID)) {
+			needsResolve = true
+			break
+		}
+	}
+
+	var mechByCallsign map[string]string
+	if needsResolve {
+		var err error
+		mechByCallsign, err = p.buildMechInstanceCallsignIndex(gameInstanceID)
+		if err != nil {
+			l.Warn("failed to build mech callsign index for opponent >%s<: %v", opponentName, err)
+		}
+	}
+
+	hexByLabel := make(map[string]string, len(battlefield.HexByID))
+	for _, h := range battlefield.HexByID {
+		hexByLabel[fmt.Sprintf("%d,%d", h.HexColumn, h.HexRow)] = h.ID
+		if h.Name != "" {
+			hexByLabel[strings.ToLower(strings.TrimSpace(h.Name))] = h.ID
+		}
+	}
+
+	for i := range orders {
+		o := &orders[i]
+		o.Facing = strings.ToUpper(strings.TrimSpace(o.Facing))
+		if o.MechInstanceID != "" && !looksLikeUUID(o.MechInstanceID) {
+			if id, ok := mechByCallsign[strings.ToLower(strings.TrimSpace(o.MechInstanceID))]; ok {
+				l.Info("resolved opponent >%s< mech reference >%s< to >%s<", opponentName, o.MechInstanceID, id)
+				o.MechInstanceID = id
+			} else {
+				l.Warn("opponent >%s< returned unresolvable mech reference >%s<", opponentName, o.MechInstanceID)
+			}
+		}
+		if o.MoveToHexID != "" && !looksLikeUUID(o.MoveToHexID) {
+			key := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(o.MoveToHexID), " ", ""))
+			if id, ok := hexByLabel[key]; ok {
+				l.Info("resolved opponent >%s< hex reference >%s< to >%s<", opponentName, o.MoveToHexID, id)
+				o.MoveToHexID = id
+			} else {
+				l.Warn("opponent >%s< returned unresolvable hex reference >%s< — dropping movement", opponentName, o.MoveToHexID)
+				o.MoveToHexID = ""
+			}
+		}
+		if o.AttackTargetMechInstanceID != "" && !looksLikeUUID(o.AttackTargetMechInstanceID) {
+			if id, ok := mechByCallsign[strings.ToLower(strings.TrimSpace(o.AttackTargetMechInstanceID))]; ok {
+				l.Info("resolved opponent >%s< target reference >%s< to >%s<", opponentName, o.AttackTargetMechInstanceID, id)
+				o.AttackTargetMechInstanceID = id
+			} else {
+				l.Warn("opponent >%s< returned unresolvable target reference >%s< — dropping attack", opponentName, o.AttackTargetMechInstanceID)
+				o.AttackTargetMechInstanceID = ""
+			}
+		}
+	}
+	return orders
+}
+
+func (p *MechaTacticsGame) buildMechInstanceCallsignIndex(gameInstanceID string) (map[string]string, error) {
+	mechInsts, err := p.Domain.GetManyMechaTacticsGameMechInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceGameInstanceID, Val: gameInstanceID},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]string, len(mechInsts))
+	for _, mi := range mechInsts {
+		out[strings.ToLower(strings.TrimSpace(mi.Callsign))] = mi.ID
+	}
+	return out, nil
+}
+
+// looksLikeUUID is a cheap heuristic: length 36 with dashes in the
+// canonical positions. We deliberately avoid a strict regex/parse so
+// mixed-case IDs and any valid UUID string passes through untouched.
+func looksLikeUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	return s[8] == '-' && s[13] == '-' && s[18] == '-' && s[23] == '-'
+}
+
+// processMechTurnSheets processes all turn sheets for a specific mech instance.
+func (p *MechaTacticsGame) processMechTurnSheets(ctx context.Context, gameInstanceRec *game_record.GameInstance, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) error {
+	l := p.Logger.WithFunctionContext("MechaTacticsGame/processMechTurnSheets")
+
+	l.Info("processing turn sheets for mech >%s< turn >%d<", mechInstance.ID, gameInstanceRec.CurrentTurn)
+
+	turnSheetRecs, err := p.getTurnSheetsForMech(mechInstance, gameInstanceRec.CurrentTurn)
+	if err != nil {
+		l.Warn("failed to get turn sheets for mech >%s< turn >%d< error >%v<", mechInstance.ID, gameInstanceRec.CurrentTurn, err)
+		return err
+	}
+
+	l.Info("found >%d< turn sheets for mech >%s< turn >%d<", len(turnSheetRecs), mechInstance.ID, gameInstanceRec.CurrentTurn)
+
+	if len(turnSheetRecs) == 0 {
+		return nil
+	}
+
+	slices.SortFunc(turnSheetRecs, func(a, b *game_record.GameTurnSheet) int {
+		return a.SheetOrder - b.SheetOrder
+	})
+
+	for _, turnSheet := range turnSheetRecs {
+		if err := p.processTurnSheet(ctx, gameInstanceRec, mechInstance, turnSheet); err != nil {
+			l.Warn("failed to process turn sheet >%s< for mech >%s< error >%v<", turnSheet.ID, mechInstance.ID, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectAttackFromOrdersSheet extracts the attack declaration from an orders
+// turn sheet and appends it to p.pendingAttacks.
+func (p *MechaTacticsGame) collectAttackFromOrdersSheet(l logger.Logger, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance, turnSheet *game_record.GameTurnSheet) {
+	if turnSheet.SheetType != mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders {
+		return
+	}
+	op, ok := p.ordersProcessor()
+	if !ok {
+		return
+	}
+	attack, err := op.ExtractAttackDeclaration(mechInstance, turnSheet)
+	if err != nil {
+		l.Warn("failed to extract attack declaration: %v", err)
+		return
+	}
+	if attack != nil {
+		p.pendingAttacks = append(p.pendingAttacks, *attack)
+	}
+}
+
+// processTurnSheet processes a single turn sheet for a mech instance.
+func (p *MechaTacticsGame) processTurnSheet(ctx context.Context, gameInstanceRec *game_record.GameInstance, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance, turnSheet *game_record.GameTurnSheet) error {
+	l := p.Logger.WithFunctionContext("MechaTacticsGame/processTurnSheet")
+
+	l.Info("processing turn sheet >%s< type >%s< for mech >%s<", turnSheet.ID, turnSheet.SheetType, mechInstance.ID)
+
+	if len(turnSheet.ScannedData) == 0 {
+		l.Info("skipping turn sheet >%s< — no scanned data (not yet submitted)", turnSheet.ID)
+		return nil
+	}
+
+	processor, exists := p.Processors[turnSheet.SheetType]
+	if !exists {
+		l.Warn("unsupported sheet type >%s< for turn sheet >%s<", turnSheet.SheetType, turnSheet.ID)
+		return fmt.Errorf("unsupported sheet type: %s", turnSheet.SheetType)
+	}
+
+	if err := processor.ProcessTurnSheetResponse(ctx, gameInstanceRec, mechInstance, turnSheet); err != nil {
+		return err
+	}
+
+	// Collect attack declarations from orders sheets for combat resolution
+	p.collectAttackFromOrdersSheet(l, mechInstance, turnSheet)
+
+	return nil
+}
+
+// getTurnSheetsForMech retrieves turn sheets for a specific mech instance and turn.
+func (p *MechaTacticsGame) getTurnSheetsForMech(mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance, turnNumber int) ([]*game_record.GameTurnSheet, error) {
+	l := p.Logger.WithFunctionContext("MechaTacticsGame/getTurnSheetsForMech")
+
+	mechaTacticsGameTurnSheetRecs, err := p.Domain.GetManyMechaTacticsGameTurnSheetRecs(
+		&coresql.Options{
+			Params: []coresql.Param{
+				{
+					Col: mecha_tactics_game_record.FieldMechaTacticsGameTurnSheetMechaTacticsGameMechInstanceID,
+					Val: mechInstance.ID,
+				},
+			},
+		},
+	)
+	if err != nil {
+		l.Warn("failed to get mecha tactics turn sheets for mech >%s< turn >%d< error >%v<", mechInstance.ID, turnNumber, err)
+		return nil, err
+	}
+
+	var turnSheetRecs []*game_record.GameTurnSheet
+	for _, mtTurnSheet := range mechaTacticsGameTurnSheetRecs {
+		turnSheetRec, err := p.Domain.GetGameTurnSheetRec(mtTurnSheet.GameTurnSheetID, nil)
+		if err != nil {
+			l.Warn("failed to get game turn sheet >%s< error >%v<", mtTurnSheet.GameTurnSheetID, err)
+			return nil, err
+		}
+		if turnSheetRec.TurnNumber != turnNumber {
+			continue
+		}
+		turnSheetRecs = append(turnSheetRecs, turnSheetRec)
+	}
+
+	return turnSheetRecs, nil
+}
@@ -0,0 +1,459 @@
+package turn_sheet_processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"gitlab.com/alienspaces/playbymail/core/convert"
+	"gitlab.com/alienspaces/playbymail/core/record"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/turnsheetutil"
+)
+
+// AttackDeclaration represents a declared attack from one mech to another.
+type AttackDeclaration struct {
+	AttackerMechInstanceID string
+	TargetMechInstanceID   string
+}
+
+// MechaTacticsGameOrdersProcessor implements the TurnSheetProcessor interface
+// (defined in the parent mecha_tactics_game package)
+
+// MechaTacticsGameOrdersProcessor processes orders turn sheet business logic for mecha tactics
+type MechaTacticsGameOrdersProcessor struct {
+	Logger logger.Logger
+	Domain *domain.Domain
+}
+
+// NewMechaTacticsGameOrdersProcessor creates a new mecha tactics orders processor.
+func NewMechaTacticsGameOrdersProcessor(l logger.Logger, d *domain.Domain) (*MechaTacticsGameOrdersProcessor, error) {
+	l = l.WithFunctionContext("NewMechaTacticsGameOrdersProcessor")
+
+	p := &MechaTacticsGameOrdersProcessor{
+		Logger: l,
+		Domain: d,
+	}
+	return p, nil
+}
+
+// GetSheetType returns the sheet type this processor handles (implements TurnSheetProcessor interface).
+func (p *MechaTacticsGameOrdersProcessor) GetSheetType() string {
+	return mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders
+}
+
+// ProcessTurnSheetResponse applies the movement and facing orders from a
+// single orders turn sheet (implements TurnSheetProcessor interface). Attack
+// declarations are collected separately via ExtractAttackDeclaration.
+func (p *MechaTacticsGameOrdersProcessor) ProcessTurnSheetResponse(ctx context.Context, gameInstanceRec *game_record.GameInstance, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance, turnSheet *game_record.GameTurnSheet) error {
+	l := p.Logger.WithFunctionContext("MechaTacticsGameOrdersProcessor/ProcessTurnSheetResponse")
+
+	l.Info("processing orders for turn sheet >%s< for mech instance >%s<", turnSheet.ID, mechInstance.ID)
+
+	if turnSheet.SheetType != mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders {
+		return fmt.Errorf("invalid sheet type: expected %s, got %s", mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders, turnSheet.SheetType)
+	}
+
+	var scanData turnsheet.MechaTacticsOrdersScanData
+	if err := json.Unmarshal(turnSheet.ScannedData, &scanData); err != nil {
+		l.Warn("failed to unmarshal scanned data >%v<", err)
+		return fmt.Errorf("failed to parse scanned data: %w", err)
+	}
+
+	// Each sheet is issued for exactly one mech, so the sheet's mech wins over
+	// whatever was scanned.
+	scanData.MechInstanceID = mechInstance.ID
+
+	// Reload the mech so a repair applied earlier in this processing run is seen.
+	freshMech, err := p.Domain.GetMechaTacticsGameMechInstanceRec(mechInstance.ID, nil)
+	if err != nil {
+		l.Warn("failed to reload mech instance >%s< >%v<", mechInstance.ID, err)
+		return fmt.Errorf("failed to reload mech instance: %w", err)
+	}
+
+	battlefield, err := p.Domain.LoadMechaTacticsBattlefield(gameInstanceRec.GameID)
+	if err != nil {
+		l.Warn("failed to load battlefield >%v<", err)
+		return fmt.Errorf("failed to load battlefield: %w", err)
+	}
+
+	if _, err := p.ApplyOrders(l, battlefield, freshMech, scanData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ApplyOrders applies a single mech's movement and facing orders, enforcing
+// the movement point budget, and persists the mech. Destroyed, shutdown and
+// repairing mechs ignore their orders. Returns true when the mech changed.
+// The computer opponent orders are applied through this same path.
+func (p *MechaTacticsGameOrdersProcessor) ApplyOrders(
+	l logger.Logger,
+	battlefield *domain.MechaTacticsBattlefield,
+	mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+	order turnsheet.MechaTacticsOrdersScanData,
+) (bool, error) {
+	if !mechCanAct(mechInstance) {
+		l.Info("mech >%s< is %s (repairing >%t<) — ignoring orders", mechInstance.ID, mechInstance.Status, mechInstance.IsRepairing)
+		return false, nil
+	}
+
+	changed := false
+	var events []turnsheet.TurnEvent
+
+	if order.MoveToHexID != "" && order.MoveToHexID != mechInstance.MechaTacticsGameHexID {
+		chassisRec, err := p.Domain.GetMechaTacticsGameChassisRec(mechInstance.MechaTacticsGameChassisID, nil)
+		if err != nil {
+			l.Warn("failed to get chassis >%s< for movement validation >%v<", mechInstance.MechaTacticsGameChassisID, err)
+			return false, fmt.Errorf("failed to get chassis: %w", err)
+		}
+
+		reachable := battlefield.ReachableHexes(mechInstance.MechaTacticsGameHexID, chassisRec.Speed)
+		mpSpent, ok := reachable[order.MoveToHexID]
+		if !ok {
+			l.Warn("mech >%s< cannot reach hex >%s< within speed budget %d", mechInstance.ID, order.MoveToHexID, chassisRec.Speed)
+			events = append(events, turnsheet.TurnEvent{
+				Category: turnsheet.TurnEventCategoryMovement,
+				Icon:     turnsheet.TurnEventIconMovement,
+				Message:  fmt.Sprintf("%s could not reach the ordered hex and held position.", mechInstance.Callsign),
+			})
+		} else {
+			mechInstance.MechaTacticsGameHexID = order.MoveToHexID
+			changed = true
+			events = append(events, turnsheet.TurnEvent{
+				Category: turnsheet.TurnEventCategoryMovement,
+				Icon:     turnsheet.TurnEventIconMovement,
+				Message:  fmt.Sprintf("%s moved to %s (%d MP).", mechInstance.Callsign, hexDisplayName(battlefield, order.MoveToHexID), mpSpent),
+			})
+			l.Info("moved mech >%s< to hex >%s< spending >%d< MP", mechInstance.ID, order.MoveToHexID, mpSpent)
+		}
+	}
+
+	if order.Facing != "" {
+		facing, ok := domain.MechaTacticsFacingFromLabel(order.Facing)
+		if !ok {
+			l.Warn("mech >%s< has unknown facing >%s< — keeping current facing", mechInstance.ID, order.Facing)
+		} else if facing != mechInstance.Facing {
+			mechInstance.Facing = facing
+			changed = true
+			events = append(events, turnsheet.TurnEvent{
+				Category: turnsheet.TurnEventCategoryMovement,
+				Icon:     turnsheet.TurnEventIconMovement,
+				Message:  fmt.Sprintf("%s turned to face %s.", mechInstance.Callsign, order.Facing),
+			})
+		}
+	}
+
+	if len(events) == 0 {
+		return false, nil
+	}
+
+	if mechInstance.GameSubscriptionInstanceID.Valid {
+		for _, evt := range events {
+			if err := turnsheet.AppendMechaTacticsGameTurnEvent(mechInstance, evt); err != nil {
+				l.Warn("failed to append movement event for mech >%s< >%v<", mechInstance.ID, err)
+			}
+		}
+	}
+
+	if _, err := p.Domain.UpdateMechaTacticsGameMechInstanceRec(mechInstance); err != nil {
+		l.Warn("failed to update mech instance >%s< >%v<", mechInstance.ID, err)
+		return false, fmt.Errorf("failed to update mech instance: %w", err)
+	}
+
+	return changed, nil
+}
+
+// ExtractAttackDeclaration reads scanned data from an orders turn sheet and
+// returns the declared attack, or nil when no attack was ordered. Called after
+// ProcessTurnSheetResponse to collect attacks for combat resolution.
+func (p *MechaTacticsGameOrdersProcessor) ExtractAttackDeclaration(
+	mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+	turnSheet *game_record.GameTurnSheet,
+) (*AttackDeclaration, error) {
+	if len(turnSheet.ScannedData) == 0 {
+		return nil, nil
+	}
+
+	var scanData turnsheet.MechaTacticsOrdersScanData
+	if err := json.Unmarshal(turnSheet.ScannedData, &scanData); err != nil {
+		return nil, fmt.Errorf("failed to parse scanned data: %w", err)
+	}
+
+	if scanData.AttackTargetMechInstanceID == "" {
+		return nil, nil
+	}
+
+	return &AttackDeclaration{
+		AttackerMechInstanceID: mechInstance.ID,
+		TargetMechInstanceID:   scanData.AttackTargetMechInstanceID,
+	}, nil
+}
+
+// CreateNextTurnSheet creates a new orders turn sheet for a mech instance (implements TurnSheetProcessor interface).
+func (p *MechaTacticsGameOrdersProcessor) CreateNextTurnSheet(ctx context.Context, gameInstanceRec *game_record.GameInstance, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) (*game_record.GameTurnSheet, error) {
+	l := p.Logger.WithFunctionContext("MechaTacticsGameOrdersProcessor/CreateNextTurnSheet")
+
+	l.Info("creating orders turn sheet for mech instance >%s<", mechInstance.ID)
+
+	// Step 1: Get the account user for the mech owner via subscription chain
+	accountUserRec, err := mechInstanceAccountUser(p.Domain, mechInstance)
+	if err != nil {
+		l.Warn("failed to get account user for mech instance >%s< >%v<", mechInstance.ID, err)
+		return nil, fmt.Errorf("failed to get account user: %w", err)
+	}
+
+	// Step 2: Get the game record
+	gameRec, err := p.Domain.GetGameRec(gameInstanceRec.GameID, nil)
+	if err != nil {
+		l.Warn("failed to get game >%v<", err)
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	// Step 3: Load background image
+	var backgroundImage *string
+	bgImageURL, err := p.Domain.GetGameTurnSheetImageDataURL(gameRec.ID, mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders)
+	if err != nil {
+		l.Warn("failed to get turn sheet background image >%v<", err)
+	} else if bgImageURL != "" {
+		backgroundImage = &bgImageURL
+		l.Info("loaded background image for mecha tactics orders turn sheet, length >%d<", len(bgImageURL))
+	}
+
+	// Step 4: Load the battlefield and every mech in the game instance
+	battlefield, err := p.Domain.LoadMechaTacticsBattlefield(gameInstanceRec.GameID)
+	if err != nil {
+		l.Warn("failed to load battlefield >%v<", err)
+		return nil, fmt.Errorf("failed to load battlefield: %w", err)
+	}
+
+	allMechs, err := p.Domain.GetManyMechaTacticsGameMechInstanceRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechInstanceGameInstanceID, Val: gameInstanceRec.ID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get mech instances >%v<", err)
+		return nil, fmt.Errorf("failed to get mech instances: %w", err)
+	}
+
+	// Step 5: Generate turn sheet code
+	turnSheetCode, err := turnsheetutil.GeneratePlayGameTurnSheetCode(record.NewRecordID())
+	if err != nil {
+		l.Warn("failed to generate turn sheet code >%v<", err)
+		return nil, fmt.Errorf("failed to generate turn sheet code: %w", err)
+	}
+
+	// Step 6: Read and clear turn events accumulated during previous turn processing
+	turnEvents, err := turnsheet.ReadAndClearMechaTacticsGameTurnEvents(mechInstance)
+	if err != nil {
+		l.Warn("failed to read turn events for mech >%s< >%v<", mechInstance.ID, err)
+		turnEvents = nil
+	} else if len(turnEvents) > 0 {
+		if _, err := p.Domain.UpdateMechaTacticsGameMechInstanceRec(mechInstance); err != nil {
+			l.Warn("failed to persist cleared turn events for mech >%s< >%v<", mechInstance.ID, err)
+		}
+	}
+
+	turnNumber := gameInstanceRec.CurrentTurn
+	locale := accountLocale(l, p.Domain, accountUserRec.AccountID)
+	title := i18n.T(locale, "turnsheet.mecha_tactics.orders.title_callsign", "callsign", mechInstance.Callsign)
+	instructions := turnsheet.MechaTacticsGameOrdersInstructions(locale)
+
+	sheetData := p.buildOrdersData(l, battlefield, mechInstance, allMechs)
+	sheetData.TurnSheetTemplateData = turnsheet.TurnSheetTemplateData{
+		GameName:              convert.Ptr(gameRec.Name),
+		GameType:              convert.Ptr(gameRec.GameType),
+		TurnNumber:            &turnNumber,
+		AccountName:           convert.Ptr(accountUserRec.Email),
+		TurnSheetTitle:        &title,
+		TurnSheetDescription:  convert.Ptr(gameRec.Description),
+		TurnSheetInstructions: &instructions,
+		TurnSheetCode:         convert.Ptr(turnSheetCode),
+		BackgroundImage:       backgroundImage,
+		Locale:                &locale,
+		TurnEvents:            turnEvents,
+	}
+
+	// Step 7: Create the game_turn_sheet record and link it to the mech instance
+	createdTurnSheetRec, err := createMechTurnSheet(p.Domain, gameInstanceRec, mechInstance, accountUserRec, mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders, sheetData)
+	if err != nil {
+		l.Warn("failed to create orders turn sheet >%v<", err)
+		return nil, err
+	}
+
+	l.Info("created orders turn sheet >%s< for mech instance >%s< turn >%d<", createdTurnSheetRec.ID, mechInstance.ID, gameInstanceRec.CurrentTurn)
+	return createdTurnSheetRec, nil
+}
+
+// buildOrdersData assembles the mech stats, hex map and enemy target list for
+// an orders turn sheet.
+func (p *MechaTacticsGameOrdersProcessor) buildOrdersData(
+	l logger.Logger,
+	battlefield *domain.MechaTacticsBattlefield,
+	mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+	allMechs []*mecha_tactics_game_record.MechaTacticsGameMechInstance,
+) *turnsheet.MechaTacticsOrdersData {
+	data := &turnsheet.MechaTacticsOrdersData{
+		MechInstanceID:   mechInstance.ID,
+		MechCallsign:     mechInstance.Callsign,
+		MechStatus:       mechInstance.Status,
+		CurrentArmor:     mechInstance.CurrentArmor,
+		CurrentStructure: mechInstance.CurrentStructure,
+		CurrentHeat:      mechInstance.CurrentHeat,
+		PilotSkill:       mechInstance.PilotSkill,
+		Facing:           domain.MechaTacticsFacingLabel(mechInstance.Facing),
+		SupplyPoints:     mechInstance.SupplyPoints,
+		IsRepairing:      mechInstance.IsRepairing,
+		Weapons:          mechWeaponEntries(l, p.Domain, mechInstance),
+		FacingOptions:    turnsheet.MechaTacticsFacingOptions,
+	}
+
+	chassisRec, err := p.Domain.GetMechaTacticsGameChassisRec(mechInstance.MechaTacticsGameChassisID, nil)
+	if err != nil {
+		l.Warn("failed to get chassis >%s< for mech >%s< >%v<", mechInstance.MechaTacticsGameChassisID, mechInstance.ID, err)
+	} else {
+		data.ChassisName = chassisRec.Name
+		data.ChassisClass = chassisRec.ChassisClass
+		data.MaxArmor = chassisRec.ArmorPoints
+		data.MaxStructure = chassisRec.StructurePoints
+		data.HeatCapacity = chassisRec.HeatCapacity
+		data.Speed = chassisRec.Speed
+	}
+
+	if currentHex, ok := battlefield.HexByID[mechInstance.MechaTacticsGameHexID]; ok {
+		data.CurrentHexLabel = fmt.Sprintf("%d,%d", currentHex.HexColumn, currentHex.HexRow)
+	}
+	if terrain := battlefield.Terrain(mechInstance.MechaTacticsGameHexID); terrain != nil {
+		data.CurrentTerrain = terrain.Name
+	}
+
+	// Reachable hexes are only offered to mechs that can act this turn.
+	var reachable map[string]int
+	if mechCanAct(mechInstance) {
+		reachable = battlefield.ReachableHexes(mechInstance.MechaTacticsGameHexID, data.Speed)
+	}
+
+	occupants := make(map[string][]string)
+	for _, other := range allMechs {
+		if other.Status == mecha_tactics_game_record.MechInstanceStatusDestroyed {
+			continue
+		}
+		occupants[other.MechaTacticsGameHexID] = append(occupants[other.MechaTacticsGameHexID], other.Callsign)
+	}
+
+	for _, hexRec := range battlefield.HexByID {
+		opt := turnsheet.MechaTacticsHexOption{
+			HexID:     hexRec.ID,
+			Column:    hexRec.HexColumn,
+			Row:       hexRec.HexRow,
+			Name:      hexRec.Name,
+			Elevation: hexRec.Elevation,
+			IsDepot:   hexRec.IsStartingHex,
+			IsCurrent: hexRec.ID == mechInstance.MechaTacticsGameHexID,
+			Occupants: occupants[hexRec.ID],
+		}
+		if terrain := battlefield.Terrain(hexRec.ID); terrain != nil {
+			opt.TerrainName = terrain.Name
+			opt.MovementPointCost = terrain.MovementPointCost
+			opt.CoverModifier = terrain.CoverModifier
+		}
+		if cost, ok := reachable[hexRec.ID]; ok {
+			opt.IsReachable = true
+			opt.MovementPointsToReach = cost
+		}
+		data.Hexes = append(data.Hexes, opt)
+	}
+
+	slices.SortFunc(data.Hexes, func(a, b turnsheet.MechaTacticsHexOption) int {
+		if a.Row != b.Row {
+			return a.Row - b.Row
+		}
+		return a.Column - b.Column
+	})
+	data.MapWidth, data.MapHeight = turnsheet.LayoutMechaTacticsHexMap(data.Hexes)
+
+	data.EnemyMechs = p.getEnemyMechOptions(battlefield, mechInstance, allMechs)
+
+	return data
+}
+
+// getEnemyMechOptions lists every mech not owned by the same player or
+// computer opponent as the given mech.
+func (p *MechaTacticsGameOrdersProcessor) getEnemyMechOptions(
+	battlefield *domain.MechaTacticsBattlefield,
+	mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+	allMechs []*mecha_tactics_game_record.MechaTacticsGameMechInstance,
+) []turnsheet.MechaTacticsEnemyMechOption {
+	var enemies []turnsheet.MechaTacticsEnemyMechOption
+	for _, other := range allMechs {
+		if other.Status == mecha_tactics_game_record.MechInstanceStatusDestroyed {
+			continue
+		}
+		if SameOwner(mechInstance, other) {
+			continue
+		}
+
+		opt := turnsheet.MechaTacticsEnemyMechOption{
+			MechInstanceID: other.ID,
+			Callsign:       other.Callsign,
+			Facing:         domain.MechaTacticsFacingLabel(other.Facing),
+			Distance:       battlefield.Distance(mechInstance.MechaTacticsGameHexID, other.MechaTacticsGameHexID),
+		}
+		if hexRec, ok := battlefield.HexByID[other.MechaTacticsGameHexID]; ok {
+			opt.HexLabel = fmt.Sprintf("%d,%d", hexRec.HexColumn, hexRec.HexRow)
+		}
+		if chassisRec, err := p.Domain.GetMechaTacticsGameChassisRec(other.MechaTacticsGameChassisID, nil); err == nil {
+			opt.ChassisName = chassisRec.Name
+		}
+		enemies = append(enemies, opt)
+	}
+
+	slices.SortFunc(enemies, func(a, b turnsheet.MechaTacticsEnemyMechOption) int {
+		return a.Distance - b.Distance
+	})
+
+	return enemies
+}
+
+// SameOwner reports whether two mechs belong to the same player subscription
+// or the same computer opponent.
+func SameOwner(a, b *mecha_tactics_game_record.MechaTacticsGameMechInstance) bool {
+	if a.GameSubscriptionInstanceID.Valid && b.GameSubscriptionInstanceID.Valid {
+		return a.GameSubscriptionInstanceID.String == b.GameSubscriptionInstanceID.String
+	}
+	if a.MechaTacticsGameComputerOpponentID.Valid && b.MechaTacticsGameComputerOpponentID.Valid {
+		return a.MechaTacticsGameComputerOpponentID.String == b.MechaTacticsGameComputerOpponentID.String
+	}
+	return false
+}
+
+// mechCanAct reports whether a mech may move and attack this turn.
+func mechCanAct(mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) bool {
+	if mechInstance.IsRepairing {
+		return false
+	}
+	return mechInstance.Status != mecha_tactics_game_record.MechInstanceStatusDestroyed &&
+		mechInstance.Status != mecha_tactics_game_record.MechInstanceStatusShutdown
+}
+
+// hexDisplayName returns the designer name of a hex, falling back to its
+// coordinate label.
+func hexDisplayName(battlefield *domain.MechaTacticsBattlefield, hexID string) string {
+	hexRec, ok := battlefield.HexByID[hexID]
+	if !ok {
+		return hexID
+	}
+	if hexRec.Name != "" {
+		return fmt.Sprintf("%s (%d,%d)", hexRec.Name, hexRec.HexColumn, hexRec.HexRow)
+	}
+	return fmt.Sprintf("hex %d,%d", hexRec.HexColumn, hexRec.HexRow)
+}
@@ -0,0 +1,378 @@
+package turn_sheet_processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/core/convert"
+	"gitlab.com/alienspaces/playbymail/core/record"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/turnsheetutil"
+)
+
+const (
+	// weaponSwapCost is the supply point cost of installing a weapon into a slot.
+	weaponSwapCost = 1
+	// structurePerSupplyPoint is how much missing structure one supply point restores.
+	structurePerSupplyPoint = 10
+)
+
+// StructureRepairCost returns the supply point cost of restoring a mech to
+// full structure: one point per 10 missing structure, rounded up, minimum 1.
+// Returns 0 when the mech is undamaged.
+func StructureRepairCost(currentStructure, maxStructure int) int {
+	missing := maxStructure - currentStructure
+	if missing <= 0 {
+		return 0
+	}
+	cost := (missing + structurePerSupplyPoint - 1) / structurePerSupplyPoint
+	if cost < 1 {
+		cost = 1
+	}
+	return cost
+}
+
+// MechaTacticsGameRepairProcessor implements the TurnSheetProcessor interface
+// (defined in the parent mecha_tactics_game package)
+
+// MechaTacticsGameRepairProcessor processes repair turn sheet business logic for mecha tactics
+type MechaTacticsGameRepairProcessor struct {
+	Logger logger.Logger
+	Domain *domain.Domain
+}
+
+// NewMechaTacticsGameRepairProcessor creates a new mecha tactics repair processor.
+func NewMechaTacticsGameRepairProcessor(l logger.Logger, d *domain.Domain) (*MechaTacticsGameRepairProcessor, error) {
+	l = l.WithFunctionContext("NewMechaTacticsGameRepairProcessor")
+
+	p := &MechaTacticsGameRepairProcessor{
+		Logger: l,
+		Domain: d,
+	}
+	return p, nil
+}
+
+// GetSheetType returns the sheet type this processor handles (implements TurnSheetProcessor interface).
+func (p *MechaTacticsGameRepairProcessor) GetSheetType() string {
+	return mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeRepair
+}
+
+// ProcessTurnSheetResponse applies structure repair and weapon swaps from a
+// single repair turn sheet (implements TurnSheetProcessor interface). Any
+// applied repair puts the mech into the repairing state, which excludes it
+// from movement and combat.
+func (p *MechaTacticsGameRepairProcessor) ProcessTurnSheetResponse(ctx context.Context, gameInstanceRec *game_record.GameInstance, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance, turnSheet *game_record.GameTurnSheet) error {
+	l := p.Logger.WithFunctionContext("MechaTacticsGameRepairProcessor/ProcessTurnSheetResponse")
+
+	l.Info("processing repair for turn sheet >%s< for mech instance >%s<", turnSheet.ID, mechInstance.ID)
+
+	if turnSheet.SheetType != mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeRepair {
+		return fmt.Errorf("invalid sheet type: expected %s, got %s", mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeRepair, turnSheet.SheetType)
+	}
+
+	var scanData turnsheet.MechaTacticsRepairScanData
+	if err := json.Unmarshal(turnSheet.ScannedData, &scanData); err != nil {
+		l.Warn("failed to unmarshal scanned data >%v<", err)
+		return fmt.Errorf("failed to parse scanned data: %w", err)
+	}
+
+	if !scanData.RepairStructure && len(scanData.WeaponSwaps) == 0 {
+		l.Info("no repair orders for mech >%s<", mechInstance.ID)
+		return nil
+	}
+
+	freshMech, err := p.Domain.GetMechaTacticsGameMechInstanceRec(mechInstance.ID, nil)
+	if err != nil {
+		l.Warn("failed to reload mech instance >%s< >%v<", mechInstance.ID, err)
+		return fmt.Errorf("failed to reload mech instance: %w", err)
+	}
+
+	if freshMech.Status == mecha_tactics_game_record.MechInstanceStatusDestroyed {
+		l.Info("mech >%s< is destroyed — ignoring repair orders", freshMech.ID)
+		return nil
+	}
+
+	if freshMech.IsRepairing {
+		l.Info("mech >%s< is already repairing — ignoring repair orders", freshMech.ID)
+		return nil
+	}
+
+	hexRec, err := p.Domain.GetMechaTacticsGameHexRec(freshMech.MechaTacticsGameHexID, nil)
+	if err != nil {
+		l.Warn("failed to get hex >%s< >%v<", freshMech.MechaTacticsGameHexID, err)
+		return fmt.Errorf("failed to get hex: %w", err)
+	}
+	if !hexRec.IsStartingHex {
+		l.Info("mech >%s< is not in a depot hex — ignoring repair orders", freshMech.ID)
+		p.appendEvent(l, freshMech, fmt.Sprintf("%s is not at a depot; repair orders were ignored.", freshMech.Callsign))
+		return p.persist(l, freshMech)
+	}
+
+	repaired := false
+
+	if scanData.RepairStructure {
+		if p.applyStructureRepair(l, freshMech) {
+			repaired = true
+		}
+	}
+
+	if len(scanData.WeaponSwaps) > 0 {
+		if p.applyWeaponSwaps(l, gameInstanceRec, freshMech, scanData.WeaponSwaps) {
+			repaired = true
+		}
+	}
+
+	if repaired {
+		freshMech.IsRepairing = true
+		p.appendEvent(l, freshMech, fmt.Sprintf("%s is under repair and will sit out movement and combat.", freshMech.Callsign))
+	}
+
+	return p.persist(l, freshMech)
+}
+
+// applyStructureRepair restores the mech to full structure when supply allows.
+func (p *MechaTacticsGameRepairProcessor) applyStructureRepair(l logger.Logger, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) bool {
+	chassisRec, err := p.Domain.GetMechaTacticsGameChassisRec(mechInstance.MechaTacticsGameChassisID, nil)
+	if err != nil {
+		l.Warn("failed to get chassis >%s< >%v<", mechInstance.MechaTacticsGameChassisID, err)
+		return false
+	}
+
+	cost := StructureRepairCost(mechInstance.CurrentStructure, chassisRec.StructurePoints)
+	if cost == 0 {
+		l.Info("mech >%s< has no structure damage", mechInstance.ID)
+		return false
+	}
+
+	if mechInstance.SupplyPoints < cost {
+		p.appendEvent(l, mechInstance, fmt.Sprintf("%s could not repair structure: needs %d supply, has %d.",
+			mechInstance.Callsign, cost, mechInstance.SupplyPoints))
+		return false
+	}
+
+	mechInstance.SupplyPoints -= cost
+	mechInstance.CurrentStructure = chassisRec.StructurePoints
+	if mechInstance.Status == mecha_tactics_game_record.MechInstanceStatusDamaged {
+		mechInstance.Status = mecha_tactics_game_record.MechInstanceStatusOperational
+	}
+
+	p.appendEvent(l, mechInstance, fmt.Sprintf("%s structure repaired to %d for %d supply.",
+		mechInstance.Callsign, mechInstance.CurrentStructure, cost))
+
+	return true
+}
+
+// applyWeaponSwaps installs replacement weapons into slots. A swap must name
+// an existing slot and a weapon from the same game with a matching mount size.
+func (p *MechaTacticsGameRepairProcessor) applyWeaponSwaps(
+	l logger.Logger,
+	gameInstanceRec *game_record.GameInstance,
+	mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+	swaps []turnsheet.MechaTacticsWeaponSwap,
+) bool {
+	weaponConfig, err := mechWeaponConfig(mechInstance)
+	if err != nil {
+		l.Warn("failed to read weapon config >%v<", err)
+		return false
+	}
+
+	swapped := false
+	for _, swap := range swaps {
+		if swap.SlotLocation == "" || swap.WeaponID == "" {
+			continue
+		}
+
+		slotIdx := -1
+		for i, slot := range weaponConfig {
+			if slot.SlotLocation == swap.SlotLocation {
+				slotIdx = i
+				break
+			}
+		}
+		if slotIdx == -1 {
+			l.Warn("mech >%s< has no slot >%s<", mechInstance.ID, swap.SlotLocation)
+			continue
+		}
+		if weaponConfig[slotIdx].WeaponID == swap.WeaponID {
+			continue
+		}
+
+		newWeapon, err := p.Domain.GetMechaTacticsGameWeaponRec(swap.WeaponID, nil)
+		if err != nil || newWeapon.GameID != gameInstanceRec.GameID {
+			l.Warn("weapon >%s< not found for game >%s< >%v<", swap.WeaponID, gameInstanceRec.GameID, err)
+			continue
+		}
+
+		if currentID := weaponConfig[slotIdx].WeaponID; currentID != "" {
+			currentWeapon, err := p.Domain.GetMechaTacticsGameWeaponRec(currentID, nil)
+			if err == nil && currentWeapon.MountSize != newWeapon.MountSize {
+				p.appendEvent(l, mechInstance, fmt.Sprintf("%s cannot fit %s into the %s slot (%s mount required).",
+					mechInstance.Callsign, newWeapon.Name, swap.SlotLocation, currentWeapon.MountSize))
+				continue
+			}
+		}
+
+		if mechInstance.SupplyPoints < weaponSwapCost {
+			p.appendEvent(l, mechInstance, fmt.Sprintf("%s could not install %s: not enough supply.",
+				mechInstance.Callsign, newWeapon.Name))
+			continue
+		}
+
+		mechInstance.SupplyPoints -= weaponSwapCost
+		weaponConfig[slotIdx].WeaponID = newWeapon.ID
+		swapped = true
+
+		p.appendEvent(l, mechInstance, fmt.Sprintf("%s installed %s in the %s slot.",
+			mechInstance.Callsign, newWeapon.Name, swap.SlotLocation))
+	}
+
+	if swapped {
+		mechInstance.WeaponConfig = weaponConfig
+		b, err := json.Marshal(weaponConfig)
+		if err != nil {
+			l.Warn("failed to marshal weapon config >%v<", err)
+			return false
+		}
+		mechInstance.WeaponConfigJSON = b
+	}
+
+	return swapped
+}
+
+func (p *MechaTacticsGameRepairProcessor) appendEvent(l logger.Logger, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance, message string) {
+	if err := turnsheet.AppendMechaTacticsGameTurnEvent(mechInstance, turnsheet.TurnEvent{
+		Category: turnsheet.TurnEventCategorySystem,
+		Icon:     turnsheet.TurnEventIconSystem,
+		Message:  message,
+	}); err != nil {
+		l.Warn("failed to append repair event for mech >%s< >%v<", mechInstance.ID, err)
+	}
+}
+
+func (p *MechaTacticsGameRepairProcessor) persist(l logger.Logger, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) error {
+	if _, err := p.Domain.UpdateMechaTacticsGameMechInstanceRec(mechInstance); err != nil {
+		l.Warn("failed to update mech instance >%s< >%v<", mechInstance.ID, err)
+		return fmt.Errorf("failed to update mech instance: %w", err)
+	}
+	return nil
+}
+
+// CreateNextTurnSheet creates a repair turn sheet for a mech standing in a
+// depot hex (implements TurnSheetProcessor interface). Returns nil when the
+// mech is not at a depot, is destroyed, or is already repairing.
+func (p *MechaTacticsGameRepairProcessor) CreateNextTurnSheet(ctx context.Context, gameInstanceRec *game_record.GameInstance, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) (*game_record.GameTurnSheet, error) {
+	l := p.Logger.WithFunctionContext("MechaTacticsGameRepairProcessor/CreateNextTurnSheet")
+
+	if mechInstance.Status == mecha_tactics_game_record.MechInstanceStatusDestroyed || mechInstance.IsRepairing {
+		l.Info("mech >%s< is %s (repairing >%t<) — no repair sheet", mechInstance.ID, mechInstance.Status, mechInstance.IsRepairing)
+		return nil, nil
+	}
+
+	hexRec, err := p.Domain.GetMechaTacticsGameHexRec(mechInstance.MechaTacticsGameHexID, nil)
+	if err != nil {
+		l.Warn("failed to get hex >%s< >%v<", mechInstance.MechaTacticsGameHexID, err)
+		return nil, fmt.Errorf("failed to get hex: %w", err)
+	}
+	if !hexRec.IsStartingHex {
+		l.Info("mech >%s< is not in a depot hex — no repair sheet", mechInstance.ID)
+		return nil, nil
+	}
+
+	l.Info("creating repair turn sheet for mech instance >%s<", mechInstance.ID)
+
+	accountUserRec, err := mechInstanceAccountUser(p.Domain, mechInstance)
+	if err != nil {
+		l.Warn("failed to get account user for mech instance >%s< >%v<", mechInstance.ID, err)
+		return nil, fmt.Errorf("failed to get account user: %w", err)
+	}
+
+	gameRec, err := p.Domain.GetGameRec(gameInstanceRec.GameID, nil)
+	if err != nil {
+		l.Warn("failed to get game >%v<", err)
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	var backgroundImage *string
+	bgImageURL, err := p.Domain.GetGameTurnSheetImageDataURL(gameRec.ID, mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeRepair)
+	if err != nil {
+		l.Warn("failed to get turn sheet background image >%v<", err)
+	} else if bgImageURL != "" {
+		backgroundImage = &bgImageURL
+	}
+
+	weaponRecs, err := p.Domain.GetManyMechaTacticsGameWeaponRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: mecha_tactics_game_record.FieldMechaTacticsGameWeaponGameID, Val: gameInstanceRec.GameID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get weapons >%v<", err)
+		return nil, fmt.Errorf("failed to get weapons: %w", err)
+	}
+
+	turnSheetCode, err := turnsheetutil.GeneratePlayGameTurnSheetCode(record.NewRecordID())
+	if err != nil {
+		l.Warn("failed to generate turn sheet code >%v<", err)
+		return nil, fmt.Errorf("failed to generate turn sheet code: %w", err)
+	}
+
+	turnNumber := gameInstanceRec.CurrentTurn
+	locale := accountLocale(l, p.Domain, accountUserRec.AccountID)
+	title := i18n.T(locale, "turnsheet.mecha_tactics.repair.title_callsign", "callsign", mechInstance.Callsign)
+	instructions := turnsheet.MechaTacticsGameRepairInstructions(locale)
+
+	sheetData := &turnsheet.MechaTacticsRepairData{
+		TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
+			GameName:              convert.Ptr(gameRec.Name),
+			GameType:              convert.Ptr(gameRec.GameType),
+			TurnNumber:            &turnNumber,
+			AccountName:           convert.Ptr(accountUserRec.Email),
+			TurnSheetTitle:        &title,
+			TurnSheetDescription:  convert.Ptr(gameRec.Description),
+			TurnSheetInstructions: &instructions,
+			TurnSheetCode:         convert.Ptr(turnSheetCode),
+			BackgroundImage:       backgroundImage,
+			Locale:                &locale,
+		},
+		MechInstanceID:   mechInstance.ID,
+		MechCallsign:     mechInstance.Callsign,
+		DepotName:        hexRec.Name,
+		CurrentStructure: mechInstance.CurrentStructure,
+		SupplyPoints:     mechInstance.SupplyPoints,
+		WeaponSwapCost:   weaponSwapCost,
+		Weapons:          mechWeaponEntries(l, p.Domain, mechInstance),
+	}
+	if sheetData.DepotName == "" {
+		sheetData.DepotName = fmt.Sprintf("%d,%d", hexRec.HexColumn, hexRec.HexRow)
+	}
+
+	chassisRec, err := p.Domain.GetMechaTacticsGameChassisRec(mechInstance.MechaTacticsGameChassisID, nil)
+	if err != nil {
+		l.Warn("failed to get chassis >%s< >%v<", mechInstance.MechaTacticsGameChassisID, err)
+	} else {
+		sheetData.ChassisName = chassisRec.Name
+		sheetData.ChassisClass = chassisRec.ChassisClass
+		sheetData.MaxStructure = chassisRec.StructurePoints
+		sheetData.StructureRepairCost = StructureRepairCost(mechInstance.CurrentStructure, chassisRec.StructurePoints)
+	}
+
+	for _, weaponRec := range weaponRecs {
+		sheetData.AvailableWeapons = append(sheetData.AvailableWeapons, weaponEntryFromRec(weaponRec))
+	}
+
+	createdTurnSheetRec, err := createMechTurnSheet(p.Domain, gameInstanceRec, mechInstance, accountUserRec, mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeRepair, sheetData)
+	if err != nil {
+		l.Warn("failed to create repair turn sheet >%v<", err)
+		return nil, err
+	}
+
+	l.Info("created repair turn sheet >%s< for mech instance >%s< turn >%d<", createdTurnSheetRec.ID, mechInstance.ID, gameInstanceRec.CurrentTurn)
+	return createdTurnSheetRec, nil
+}
@@ -0,0 +1,159 @@
+package turn_sheet_processor
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+)
+
+// mechInstanceAccountUser resolves the account_user record for a player-owned
+// mech instance by walking the subscription chain:
+//
+//	mecha_tactics_game_mech_instance.game_subscription_instance_id
+//	  -> game_subscription_instance.game_subscription_id
+//	  -> game_subscription.account_user_id
+func mechInstanceAccountUser(d *domain.Domain, mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) (*account_record.AccountUser, error) {
+	if !mechInstance.GameSubscriptionInstanceID.Valid {
+		return nil, fmt.Errorf("mech instance >%s< has no game_subscription_instance_id", mechInstance.ID)
+	}
+
+	subInstRec, err := d.GetGameSubscriptionInstanceRec(mechInstance.GameSubscriptionInstanceID.String, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game subscription instance: %w", err)
+	}
+
+	subRecs, err := d.GetManyGameSubscriptionRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: game_record.FieldGameSubscriptionID, Val: subInstRec.GameSubscriptionID},
+		},
+		Limit: 1,
+	})
+	if err != nil || len(subRecs) == 0 {
+		return nil, fmt.Errorf("failed to get game subscription for instance >%s<: %w", subInstRec.GameSubscriptionID, err)
+	}
+
+	accountUserRec, err := d.GetAccountUserRec(subRecs[0].AccountUserID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account user >%s<: %w", subRecs[0].AccountUserID, err)
+	}
+
+	return accountUserRec, nil
+}
+
+// mechWeaponConfig decodes the weapon loadout persisted on a mech instance.
+func mechWeaponConfig(mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance) ([]mecha_tactics_game_record.WeaponConfigEntry, error) {
+	var weaponConfig []mecha_tactics_game_record.WeaponConfigEntry
+	if len(mechInstance.WeaponConfigJSON) > 0 {
+		if err := json.Unmarshal(mechInstance.WeaponConfigJSON, &weaponConfig); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal weapon config for mech >%s<: %w", mechInstance.ID, err)
+		}
+	}
+	return weaponConfig, nil
+}
+
+// mechWeaponEntries resolves a mech's weapon loadout into turn sheet weapon
+// entries, one per occupied slot.
+func mechWeaponEntries(
+	l logger.Logger,
+	d *domain.Domain,
+	mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+) []turnsheet.MechaTacticsWeaponEntry {
+	weaponConfig, err := mechWeaponConfig(mechInstance)
+	if err != nil {
+		l.Warn("failed to read weapon config >%v<", err)
+		return nil
+	}
+
+	var entries []turnsheet.MechaTacticsWeaponEntry
+	for _, slot := range weaponConfig {
+		if slot.WeaponID == "" {
+			continue
+		}
+		weaponRec, err := d.GetMechaTacticsGameWeaponRec(slot.WeaponID, nil)
+		if err != nil {
+			l.Warn("failed to get weapon >%s< >%v<", slot.WeaponID, err)
+			continue
+		}
+		entry := weaponEntryFromRec(weaponRec)
+		entry.SlotLocation = slot.SlotLocation
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func weaponEntryFromRec(weaponRec *mecha_tactics_game_record.MechaTacticsGameWeapon) turnsheet.MechaTacticsWeaponEntry {
+	return turnsheet.MechaTacticsWeaponEntry{
+		WeaponID:  weaponRec.ID,
+		Name:      weaponRec.Name,
+		Damage:    weaponRec.Damage,
+		HeatCost:  weaponRec.HeatCost,
+		RangeBand: weaponRec.RangeBand,
+		MountSize: weaponRec.MountSize,
+	}
+}
+
+// createMechTurnSheet persists a game_turn_sheet for a mech and links it to the
+// mech instance via mecha_tactics_game_turn_sheet.
+func createMechTurnSheet(
+	d *domain.Domain,
+	gameInstanceRec *game_record.GameInstance,
+	mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+	accountUserRec *account_record.AccountUser,
+	sheetType string,
+	sheetData any,
+) (*game_record.GameTurnSheet, error) {
+	sheetDataBytes, err := json.Marshal(sheetData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sheet data: %w", err)
+	}
+
+	gameTurnSheet := &game_record.GameTurnSheet{
+		GameID:           gameInstanceRec.GameID,
+		AccountID:        accountUserRec.AccountID,
+		AccountUserID:    accountUserRec.ID,
+		TurnNumber:       gameInstanceRec.CurrentTurn,
+		SheetType:        sheetType,
+		SheetOrder:       mecha_tactics_game_record.MechaTacticsGameSheetOrderForType(sheetType),
+		SheetData:        json.RawMessage(sheetDataBytes),
+		IsCompleted:      false,
+		ProcessingStatus: game_record.TurnSheetProcessingStatusPending,
+	}
+	gameTurnSheet.GameInstanceID = nullstring.FromString(gameInstanceRec.ID)
+
+	createdTurnSheetRec, err := d.CreateGameTurnSheetRec(gameTurnSheet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create turn sheet record: %w", err)
+	}
+
+	_, err = d.CreateMechaTacticsGameTurnSheetRec(&mecha_tactics_game_record.MechaTacticsGameTurnSheet{
+		GameID:                         gameInstanceRec.GameID,
+		MechaTacticsGameMechInstanceID: mechInstance.ID,
+		GameTurnSheetID:                createdTurnSheetRec.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mecha tactics turn sheet record: %w", err)
+	}
+
+	return createdTurnSheetRec, nil
+}
+
+// accountLocale returns the locale turn sheets for an account are rendered in,
+// falling back to the default locale when the account locale cannot be resolved.
+func accountLocale(l logger.Logger, d *domain.Domain, accountID string) string {
+	locale, err := d.GetAccountLocale(accountID)
+	if err != nil {
+		l.Warn("failed to get account >%s< locale >%v<", accountID, err)
+		return i18n.DefaultLocale
+	}
+	return locale
+}
@@ -0,0 +1,661 @@
+package jobworker
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+
+	corejobworker "gitlab.com/alienspaces/playbymail/core/jobworker"
+	"gitlab.com/alienspaces/playbymail/core/type/emailer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/core/type/storer"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/jobqueue"
+	"gitlab.com/alienspaces/playbymail/internal/mailbox"
+	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+	"gitlab.com/alienspaces/playbymail/internal/utils/turnsheetutil"
+)
+
+// ProcessInboundMailWorkerArgs defines the job payload for processing a claimed inbound mail message
+type ProcessInboundMailWorkerArgs struct {
+	MessageID string
+}
+
+func (ProcessInboundMailWorkerArgs) Kind() string {
+	return "process-inbound-mail"
+}
+
+func (ProcessInboundMailWorkerArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{Queue: jobqueue.QueueDefault}
+}
+
+// ProcessInboundMailWorker submits turn sheets from an email sent by a player. Attached
+// photos or scans of turn sheets are read with the turn sheet scanner, and plain-text
+// orders in the message body are applied to the player's open turn sheets. The player
+// is sent a receipt listing what was accepted and any problems found.
+//
+// Mail is only accepted when the receiving mail server authenticated the sender's domain
+// and the subject carries the reply reference from the player's latest turn notification,
+// so a forged From address alone cannot submit orders for another player.
+type ProcessInboundMailWorker struct {
+	river.WorkerDefaults[ProcessInboundMailWorkerArgs]
+	emailClient emailer.Emailer
+	scanner     turnsheet.TurnSheetScanner
+	JobWorker
+}
+
+func NewProcessInboundMailWorker(l logger.Logger, cfg config.Config, s storer.Storer, e emailer.Emailer) (*ProcessInboundMailWorker, error) {
+	l = l.WithPackageContext("ProcessInboundMailWorker")
+
+	l.Info("instantiating ProcessInboundMailWorker")
+
+	jw, err := NewJobWorker(l, cfg, s)
+	if err != nil {
+		return nil, err
+	}
+
+	if e == nil {
+		l.Warn("email client is nil, assuming registration-only instantiation")
+	}
+
+	scanner, err := turnsheet.NewScanner(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProcessInboundMailWorker{
+		JobWorker:   *jw,
+		emailClient: e,
+		scanner:     scanner,
+	}, nil
+}
+
+func (w *ProcessInboundMailWorker) Work(ctx context.Context, j *river.Job[ProcessInboundMailWorkerArgs]) error {
+	l := w.Log.WithFunctionContext("ProcessInboundMailWorker/Work")
+
+	l.Info("running job ID >%s< Args >%#v<", strconv.FormatInt(j.ID, 10), j.Args)
+
+	if w.emailClient == nil {
+		return fmt.Errorf("email client is nil")
+	}
+
+	mb, err := newInboundMailbox(w.Config)
+	if err != nil {
+		return err
+	}
+	if mb == nil {
+		return fmt.Errorf("inbound mail provider is not configured")
+	}
+
+	c, m, err := w.beginJob(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		m.Tx.Rollback(context.Background())
+	}()
+
+	_, err = w.DoWork(ctx, m, c, mb, j)
+	if err != nil {
+		l.Error("ProcessInboundMailWorker job ID >%s< Args >%#v< failed >%v<", strconv.FormatInt(j.ID, 10), j.Args, err)
+		return err
+	}
+
+	if err := corejobworker.CompleteJob(ctx, m.Tx, j); err != nil {
+		return err
+	}
+
+	if err := mb.Done(ctx, j.Args.MessageID); err != nil {
+		l.Warn("failed to mark inbound mail message >%s< done >%v<", j.Args.MessageID, err)
+	}
+
+	return nil
+}
+
+// ProcessInboundMailDoWorkResult summarises the work carried out by the worker
+type ProcessInboundMailDoWorkResult struct {
+	TurnSheetsSubmitted int
+	Problems            int
+}
+
+// inboundMailReceipt collects what happened to a player's email for the receipt reply
+// in the locale the receipt is sent in
+type inboundMailReceipt struct {
+	localizer   *i18n.Localizer
+	Accepted    []string
+	Outstanding []string
+	Problems    []string
+}
+
+func (r *inboundMailReceipt) problem(key string, args ...any) {
+	r.Problems = append(r.Problems, r.localizer.T(key, args...))
+}
+
+func (w *ProcessInboundMailWorker) DoWork(ctx context.Context, m *domain.Domain, c *river.Client[pgx.Tx], mb mailbox.Mailbox, j *river.Job[ProcessInboundMailWorkerArgs]) (*ProcessInboundMailDoWorkResult, error) {
+	l := w.Log.WithFunctionContext("ProcessInboundMailWorker/DoWork")
+
+	msg, err := mb.Get(ctx, j.Args.MessageID)
+	if err != nil {
+		l.Warn("failed to get inbound mail message >%s< >%v<", j.Args.MessageID, err)
+		return nil, err
+	}
+
+	l.Info("processing inbound mail message >%s< from >%s< subject >%s<", msg.ID, msg.From, msg.Subject)
+
+	// Mail that fails sender authentication is dropped without a receipt so forged
+	// mail does not cause replies to the address it claims to be from
+	if w.Config.InboundMailRequireSenderAuth && !msg.SenderAuthenticated(w.Config.InboundMailAuthServID) {
+		l.Warn("inbound mail message >%s< from >%s< failed sender authentication, ignoring", msg.ID, msg.From)
+		return &ProcessInboundMailDoWorkResult{Problems: 1}, nil
+	}
+
+	// Mail from unknown senders is answered in the default locale
+	receipt := &inboundMailReceipt{localizer: i18n.NewLocalizer(i18n.DefaultLocale)}
+
+	accountUserRec, err := m.GetAccountUserRecByEmail(msg.From)
+	if err != nil {
+		l.Warn("failed to get account user by email >%s< >%v<", msg.From, err)
+		return nil, err
+	}
+
+	if accountUserRec == nil {
+		l.Info("inbound mail sender >%s< does not match an account", msg.From)
+		receipt.problem("email.inbound_mail_receipt.problem.unknown_sender", "email", msg.From)
+		return w.rejectInboundMail(msg, nil, receipt)
+	}
+
+	if locale, err := m.GetAccountLocale(accountUserRec.AccountID); err != nil {
+		l.Warn("failed to get account >%s< locale >%v<", accountUserRec.AccountID, err)
+	} else {
+		receipt.localizer = i18n.NewLocalizer(locale)
+	}
+
+	subscriptionInstanceRec, err := getInboundMailReplySubscriptionInstance(l, m, msg.Subject, accountUserRec)
+	if err != nil {
+		return nil, err
+	}
+
+	if subscriptionInstanceRec == nil {
+		receipt.problem("email.inbound_mail_receipt.problem.not_a_reply")
+		return w.rejectInboundMail(msg, accountUserRec, receipt)
+	}
+
+	openRecs, err := getInboundMailOpenTurnSheets(l, m, accountUserRec, subscriptionInstanceRec.GameInstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	gameNames, err := getInboundMailGameNames(m, openRecs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Scan data for each submitted turn sheet keyed by turn sheet ID
+	submitted := map[string][]byte{}
+
+	// Scanned images and the review of their scan data keyed by turn sheet ID
+	scans := map[string]*inboundMailScan{}
+
+	for _, attachment := range msg.Attachments {
+		name := attachment.Name
+		if name == "" {
+			name = "attachment"
+		}
+
+		if !strings.HasPrefix(attachment.ContentType, "image/") {
+			receipt.problem("email.inbound_mail_receipt.problem.not_an_image", "name", name)
+			continue
+		}
+
+		rec, scanData, review, err := w.scanInboundMailAttachment(ctx, l, m, openRecs, attachment.Content)
+		if err != nil {
+			receipt.problem("email.inbound_mail_receipt.problem.unreadable", "name", name, "error", err.Error())
+			continue
+		}
+
+		submitted[rec.ID] = scanData
+		scans[rec.ID] = &inboundMailScan{imageData: attachment.Content, review: review}
+	}
+
+	orders := turnsheet.ParseTextOrderLines(msg.ReplyText())
+	if len(orders) > 0 {
+		w.parseInboundMailTextOrders(ctx, l, openRecs, gameNames, orders, submitted, receipt)
+	}
+
+	if len(submitted) == 0 && len(receipt.Problems) == 0 {
+		if len(openRecs) == 0 {
+			receipt.problem("email.inbound_mail_receipt.problem.no_open_turn_sheets")
+		} else {
+			receipt.problem("email.inbound_mail_receipt.problem.no_orders")
+		}
+	}
+
+	// Submit turn sheets
+	now := time.Now()
+	submittedGameInstances := map[string]bool{}
+
+	for _, rec := range openRecs {
+		scanData, ok := submitted[rec.ID]
+		if !ok {
+			continue
+		}
+
+		rec.ScannedData = json.RawMessage(scanData)
+		rec.ScannedAt = sql.NullTime{Time: now, Valid: true}
+		rec.ProcessingStatus = game_record.TurnSheetProcessingStatusProcessed
+
+		// Scanned turn sheets with issues are held for the game manager to review
+		scan := scans[rec.ID]
+		if scan != nil {
+			rec.ProcessingStatus = scan.review.ProcessingStatus()
+		}
+
+		if rec.ProcessingStatus == game_record.TurnSheetProcessingStatusProcessed {
+			rec.IsCompleted = true
+			rec.CompletedAt = sql.NullTime{Time: now, Valid: true}
+		}
+
+		if _, err := m.UpdateGameTurnSheetRec(rec); err != nil {
+			l.Warn("failed to update turn sheet >%s< >%v<", rec.ID, err)
+			return nil, err
+		}
+
+		if scan != nil {
+			issues, err := scan.review.IssuesJSON()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := m.UpsertGameTurnSheetScanRec(rec.ID, scan.imageData, scan.review.Confidence, issues); err != nil {
+				l.Warn("failed to record scan of turn sheet >%s< >%v<", rec.ID, err)
+				return nil, err
+			}
+		}
+
+		if !rec.IsCompleted {
+			receipt.problem("email.inbound_mail_receipt.problem.needs_review",
+				"turn_sheet", receipt.turnSheetLabel(gameNames, rec), "issue", scan.review.Issues[0].Message)
+			continue
+		}
+
+		receipt.Accepted = append(receipt.Accepted, receipt.turnSheetLabel(gameNames, rec))
+		submittedGameInstances[rec.GameInstanceID.String] = true
+	}
+
+	for _, rec := range openRecs {
+		if _, ok := submitted[rec.ID]; !ok && submittedGameInstances[rec.GameInstanceID.String] {
+			receipt.Outstanding = append(receipt.Outstanding, receipt.turnSheetLabel(gameNames, rec))
+		}
+	}
+
+	for gameInstanceID := range submittedGameInstances {
+		if err := w.completeInboundMailSubmission(ctx, m, c, gameInstanceID, accountUserRec, receipt); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.sendInboundMailReceipt(msg, accountUserRec, receipt); err != nil {
+		return nil, err
+	}
+
+	l.Info("inbound mail message >%s< submitted >%d< turn sheets with >%d< problems", msg.ID, len(receipt.Accepted), len(receipt.Problems))
+
+	return &ProcessInboundMailDoWorkResult{
+		TurnSheetsSubmitted: len(receipt.Accepted),
+		Problems:            len(receipt.Problems),
+	}, nil
+}
+
+// inboundMailScan is a turn sheet image attached to an email and the review of the
+// data scanned from it
+type inboundMailScan struct {
+	imageData []byte
+	review    *turnsheet.ScanReview
+}
+
+// scanInboundMailAttachment reads the turn sheet code from an attached image, scans
+// the matching open turn sheet and reviews the scanned data
+func (w *ProcessInboundMailWorker) scanInboundMailAttachment(ctx context.Context, l logger.Logger, m *domain.Domain, openRecs []*game_record.GameTurnSheet, imageData []byte) (*game_record.GameTurnSheet, []byte, *turnsheet.ScanReview, error) {
+	turnSheetCode, err := w.scanner.GetTurnSheetCodeFromImage(ctx, l, imageData)
+	if err != nil {
+		l.Warn("failed to extract turn sheet code >%v<", err)
+		return nil, nil, nil, fmt.Errorf("the turn sheet code could not be found")
+	}
+
+	codeData, err := turnsheetutil.ParsePlayGameTurnSheetCodeData(turnSheetCode)
+	if err != nil {
+		l.Warn("failed to parse play game turn sheet code >%v<", err)
+		return nil, nil, nil, fmt.Errorf("the turn sheet code is not a turn sheet for a game in progress")
+	}
+
+	var rec *game_record.GameTurnSheet
+	for _, openRec := range openRecs {
+		if openRec.ID == codeData.GameTurnSheetID {
+			rec = openRec
+			break
+		}
+	}
+
+	if rec == nil {
+		return nil, nil, nil, fmt.Errorf("the turn sheet is not one of your turn sheets waiting for orders")
+	}
+
+	scanData, err := w.scanner.GetTurnSheetScanData(ctx, l, rec.SheetType, rec.SheetData, imageData)
+	if err != nil {
+		l.Warn("failed to scan turn sheet >%s< >%v<", rec.ID, err)
+		return nil, nil, nil, fmt.Errorf("the orders on the turn sheet could not be read")
+	}
+
+	review, err := turnsheet.ReviewScanData(l, m.Config(), rec.SheetType, rec.SheetData, scanData, turnSheetCode)
+	if err != nil {
+		l.Warn("failed to review scan of turn sheet >%s< >%v<", rec.ID, err)
+		return nil, nil, nil, fmt.Errorf("the orders on the turn sheet could not be read")
+	}
+
+	return rec, scanData, review, nil
+}
+
+// parseInboundMailTextOrders applies plain-text orders to the open turn sheets of the game
+// instance the email replied to that were not submitted as scanned images
+func (w *ProcessInboundMailWorker) parseInboundMailTextOrders(ctx context.Context, l logger.Logger, openRecs []*game_record.GameTurnSheet, gameNames map[string]string, orders []turnsheet.TextOrder, submitted map[string][]byte, receipt *inboundMailReceipt) {
+	if len(submitted) == len(openRecs) {
+		if len(submitted) == 0 {
+			receipt.problem("email.inbound_mail_receipt.problem.no_open_turn_sheets")
+		}
+		return
+	}
+
+	claimed := map[int]bool{}
+
+	for _, rec := range openRecs {
+		if _, ok := submitted[rec.ID]; ok {
+			continue
+		}
+
+		parser, err := turnsheet.GetTextOrderParser(l, w.Config, rec.SheetType)
+		if err != nil {
+			l.Warn("failed to get text order parser for sheet type >%s< >%v<", rec.SheetType, err)
+			continue
+		}
+		if parser == nil {
+			continue
+		}
+
+		var sheetOrders []turnsheet.TextOrder
+		for _, order := range orders {
+			for _, keyword := range parser.TextOrderKeywords() {
+				if order.Keyword == keyword {
+					sheetOrders = append(sheetOrders, order)
+					claimed[order.Line] = true
+				}
+			}
+		}
+
+		if len(sheetOrders) == 0 {
+			continue
+		}
+
+		scanData, err := parser.ParseTextOrders(ctx, l, rec.SheetData, sheetOrders)
+		if err != nil {
+			receipt.problem("email.inbound_mail_receipt.problem.invalid_orders", "turn_sheet", receipt.turnSheetLabel(gameNames, rec), "error", err.Error())
+			continue
+		}
+
+		submitted[rec.ID] = scanData
+	}
+
+	for _, order := range orders {
+		if !claimed[order.Line] {
+			receipt.problem("email.inbound_mail_receipt.problem.unknown_order", "line", order.Line, "order", strconv.Quote(order.String()))
+		}
+	}
+}
+
+// inboundMailReplyRefRe matches the reply reference carried in the subject of turn
+// notification emails and kept in the subject of replies, e.g. "[ref 0b6c...]"
+var inboundMailReplyRefRe = regexp.MustCompile(`(?i)\[ref ([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})\]`)
+
+// inboundMailReplySubject adds the reply reference for a player's turn sheet token to the
+// subject of an email the player can reply to with their orders
+func inboundMailReplySubject(subject, turnSheetToken string) string {
+	return fmt.Sprintf("%s [ref %s]", subject, turnSheetToken)
+}
+
+// inboundMailReplyToken returns the turn sheet token from the reply reference in an email
+// subject, or an empty string when the subject has no reply reference
+func inboundMailReplyToken(subject string) string {
+	match := inboundMailReplyRefRe.FindStringSubmatch(subject)
+	if match == nil {
+		return ""
+	}
+	return strings.ToLower(match[1])
+}
+
+// getInboundMailReplySubscriptionInstance returns the player's game subscription instance
+// holding the turn sheet token referenced in the email subject. Nil is returned when the
+// subject has no reply reference, the token is unknown or has expired, or the token was
+// issued to another account.
+func getInboundMailReplySubscriptionInstance(l logger.Logger, m *domain.Domain, subject string, accountUserRec *account_record.AccountUser) (*game_record.GameSubscriptionInstance, error) {
+	turnSheetToken := inboundMailReplyToken(subject)
+	if turnSheetToken == "" {
+		l.Info("inbound mail subject >%s< has no reply reference", subject)
+		return nil, nil
+	}
+
+	rec, err := m.GetGameSubscriptionInstanceRecByTurnSheetToken(turnSheetToken)
+	if err != nil {
+		l.Warn("failed to get game subscription instance by turn sheet token >%v<", err)
+		return nil, err
+	}
+
+	if rec == nil {
+		l.Info("inbound mail reply reference is unknown or has expired")
+		return nil, nil
+	}
+
+	if rec.AccountID != accountUserRec.AccountID {
+		l.Warn("inbound mail reply reference for instance >%s< does not belong to account >%s<", rec.ID, accountUserRec.AccountID)
+		return nil, nil
+	}
+
+	return rec, nil
+}
+
+// getInboundMailOpenTurnSheets returns the player's open turn sheets for a game instance
+func getInboundMailOpenTurnSheets(l logger.Logger, m *domain.Domain, accountUserRec *account_record.AccountUser, gameInstanceID string) ([]*game_record.GameTurnSheet, error) {
+	recs, err := m.GetOpenGameTurnSheetRecsByAccountUser(accountUserRec.ID)
+	if err != nil {
+		l.Warn("failed to get open turn sheets for account user >%s< >%v<", accountUserRec.ID, err)
+		return nil, err
+	}
+
+	var openRecs []*game_record.GameTurnSheet
+	for _, rec := range recs {
+		if rec.GameInstanceID.String == gameInstanceID {
+			openRecs = append(openRecs, rec)
+		}
+	}
+
+	return openRecs, nil
+}
+
+// rejectInboundMail sends the sender a receipt explaining why nothing in their email was submitted
+func (w *ProcessInboundMailWorker) rejectInboundMail(msg *mailbox.Message, accountUserRec *account_record.AccountUser, receipt *inboundMailReceipt) (*ProcessInboundMailDoWorkResult, error) {
+	if err := w.sendInboundMailReceipt(msg, accountUserRec, receipt); err != nil {
+		return nil, err
+	}
+	return &ProcessInboundMailDoWorkResult{Problems: len(receipt.Problems)}, nil
+}
+
+// completeInboundMailSubmission invalidates the player's turn sheet link once all their turn
+// sheets for the turn are submitted and queues early turn processing when every player has
+// submitted and the game instance is configured to process when all are submitted
+func (w *ProcessInboundMailWorker) completeInboundMailSubmission(ctx context.Context, m *domain.Domain, c *river.Client[pgx.Tx], gameInstanceID string, accountUserRec *account_record.AccountUser, receipt *inboundMailReceipt) error {
+	l := w.Log.WithFunctionContext("ProcessInboundMailWorker/completeInboundMailSubmission")
+
+	gameInstanceRec, err := m.GetGameInstanceRec(gameInstanceID, nil)
+	if err != nil {
+		l.Warn("failed to get game instance >%s< >%v<", gameInstanceID, err)
+		return err
+	}
+
+	openRecs, err := m.GetOpenGameTurnSheetRecsByAccountUser(accountUserRec.ID)
+	if err != nil {
+		return err
+	}
+
+	playerSubmitted := true
+	for _, rec := range openRecs {
+		if rec.GameInstanceID.String == gameInstanceID {
+			playerSubmitted = false
+			break
+		}
+	}
+
+	if playerSubmitted {
+		links, err := m.GetGameSubscriptionInstanceRecsByInstance(gameInstanceID)
+		if err != nil {
+			return err
+		}
+		for _, link := range links {
+			if link.AccountID != accountUserRec.AccountID {
+				continue
+			}
+			if err := m.InvalidateGameSubscriptionInstanceTurnSheetToken(link.ID); err != nil {
+				l.Warn("failed to invalidate turn sheet token for instance >%s< >%v<", link.ID, err)
+			}
+		}
+	}
+
+	if !gameInstanceRec.ProcessWhenAllSubmitted || gameInstanceRec.Status != game_record.GameInstanceStatusStarted {
+		return nil
+	}
+
+	allSubmitted, err := m.IsGameInstanceTurnSubmitted(gameInstanceRec)
+	if err != nil {
+		l.Warn("failed to check all turn sheets submitted >%v<", err)
+		return nil
+	}
+
+	if !allSubmitted {
+		return nil
+	}
+
+	l.Info("all players submitted for game instance >%s< turn >%d<, enqueueing early turn processing", gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
+
+	_, err = c.InsertTx(ctx, m.Tx, GameTurnProcessingWorkerArgs{
+		GameInstanceID: gameInstanceRec.ID,
+		TurnNumber:     gameInstanceRec.CurrentTurn,
+	}, nil)
+	if err != nil {
+		l.Warn("failed to enqueue early turn processing >%v<", err)
+		return err
+	}
+
+	return nil
+}
+
+// sendInboundMailReceipt replies to the sender with the turn sheets accepted from their email,
+// any turn sheets still outstanding and any problems found
+func (w *ProcessInboundMailWorker) sendInboundMailReceipt(msg *mailbox.Message, accountUserRec *account_record.AccountUser, receipt *inboundMailReceipt) error {
+	l := w.Log.WithFunctionContext("ProcessInboundMailWorker/sendInboundMailReceipt")
+
+	locale := receipt.localizer.Locale()
+
+	tmpl, err := ParseEmailTemplate(w.Config.TemplatesPath, locale, "inbound_mail_receipt.email.html")
+	if err != nil {
+		l.Warn("failed to parse email template >%v<", err)
+		return err
+	}
+
+	var accountURL string
+	if accountUserRec != nil {
+		accountURL = fmt.Sprintf("%s/account", w.Config.AppHost)
+	}
+
+	tmplData := struct {
+		Accepted     []string
+		Outstanding  []string
+		Problems     []string
+		SupportEmail string
+		AccountURL   string
+		Year         int
+	}{
+		Accepted:     receipt.Accepted,
+		Outstanding:  receipt.Outstanding,
+		Problems:     receipt.Problems,
+		SupportEmail: w.Config.SupportEmailAddress,
+		AccountURL:   accountURL,
+		Year:         time.Now().Year(),
+	}
+
+	var body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&body, "base", tmplData); err != nil {
+		l.Warn("failed to render email template >%v<", err)
+		return err
+	}
+
+	subject := msg.Subject
+	if subject == "" {
+		subject = i18n.T(locale, "email.inbound_mail_receipt.subject")
+	}
+	if !strings.HasPrefix(strings.ToLower(subject), "re:") {
+		subject = "Re: " + subject
+	}
+
+	emailMsg := &emailer.Message{
+		From:    w.Config.InboundMailAddress,
+		To:      []string{msg.From},
+		Subject: subject,
+		Body:    body.String(),
+	}
+
+	if err := w.emailClient.Send(emailMsg); err != nil {
+		l.Warn("failed to send inbound mail receipt email >%v<", err)
+		return err
+	}
+
+	l.Info("sent inbound mail receipt to >%s< accepted >%d< problems >%d<", msg.From, len(receipt.Accepted), len(receipt.Problems))
+
+	return nil
+}
+
+// getInboundMailGameNames returns game names keyed by game instance ID for the given turn sheets
+func getInboundMailGameNames(m *domain.Domain, recs []*game_record.GameTurnSheet) (map[string]string, error) {
+	names := map[string]string{}
+	for _, rec := range recs {
+		if _, ok := names[rec.GameInstanceID.String]; ok {
+			continue
+		}
+		gameRec, err := m.GetGameRec(rec.GameID, nil)
+		if err != nil {
+			return nil, err
+		}
+		names[rec.GameInstanceID.String] = gameRec.Name
+	}
+	return names, nil
+}
+
+// turnSheetLabel describes a turn sheet in receipt emails, e.g. "The Sunken Keep turn 3: Location Choice"
+func (r *inboundMailReceipt) turnSheetLabel(gameNames map[string]string, rec *game_record.GameTurnSheet) string {
+	title := strings.ReplaceAll(rec.SheetType, "_", " ")
+
+	var data turnsheet.TurnSheetTemplateData
+	if err := json.Unmarshal(rec.SheetData, &data); err == nil && data.TurnSheetTitle != nil && *data.TurnSheetTitle != "" {
+		title = *data.TurnSheetTitle
+	}
+
+	return r.localizer.T("email.inbound_mail_receipt.turn_sheet", "game", gameNames[rec.GameInstanceID.String], "turn", rec.TurnNumber, "title", title)
+}
@@ -0,0 +1,45 @@
+package jobworker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInboundMailReplyToken(t *testing.T) {
+	token := "0b6c2f6e-4c1d-4f7a-9a0e-3d2b1c4e5f60"
+
+	subject := inboundMailReplySubject("Turn 3 is ready for The Sunken Keep", token)
+	require.Equal(t, "Turn 3 is ready for The Sunken Keep [ref "+token+"]", subject)
+
+	tests := []struct {
+		name    string
+		subject string
+		want    string
+	}{
+		{
+			name:    "reply keeps the reference",
+			subject: "Re: " + subject,
+			want:    token,
+		},
+		{
+			name:    "reference in upper case",
+			subject: "RE: Turn 3 [REF 0B6C2F6E-4C1D-4F7A-9A0E-3D2B1C4E5F60]",
+			want:    token,
+		},
+		{
+			name:    "reference removed from the subject",
+			subject: "Re: Turn 3 is ready for The Sunken Keep",
+		},
+		{
+			name:    "reference is not a token",
+			subject: "Re: Turn 3 [ref 12345]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, inboundMailReplyToken(tt.subject))
+		})
+	}
+}
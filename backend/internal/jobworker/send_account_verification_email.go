@@ -10,8 +10,6 @@ import (
 	"github.com/riverqueue/river"
 
 	"bytes"
-	"html/template"
-	"path/filepath"
 	"time"
 
 	corejobworker "gitlab.com/alienspaces/playbymail/core/jobworker"
@@ -19,6 +17,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/core/type/storer"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/jobqueue"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
 )
@@ -129,9 +128,13 @@ func (w *SendAccountVerificationEmailWorker) DoWork(ctx context.Context, m *doma
 	}
 
 	// Render the HTML email template
-	baseTmplPath := filepath.Join(w.Config.TemplatesPath, "email", "base.email.html")
-	specificTmplPath := filepath.Join(w.Config.TemplatesPath, "email", "account_verification.email.html")
-	tmpl, err := template.ParseFiles(baseTmplPath, specificTmplPath)
+	locale, err := m.GetAccountLocale(accountUserRec.AccountID)
+	if err != nil {
+		l.Warn("failed to get account locale >%v<", err)
+		return nil, err
+	}
+
+	tmpl, err := ParseEmailTemplate(w.Config.TemplatesPath, locale, "account_verification.email.html")
 	if err != nil {
 		l.Warn("failed to parse email template >%v<", err)
 		return nil, err
@@ -156,7 +159,7 @@ func (w *SendAccountVerificationEmailWorker) DoWork(ctx context.Context, m *doma
 	emailMsg := &emailer.Message{
 		From:    w.Config.NoReplyEmailAddress,
 		To:      []string{accountUserRec.Email},
-		Subject: "Your PlayByMail verification code",
+		Subject: i18n.T(locale, "email.account_verification.subject"),
 		Body:    body.String(),
 	}
 	if err := w.emailClient.Send(emailMsg); err != nil {
@@ -0,0 +1,223 @@
+package jobworker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+
+	corejobworker "gitlab.com/alienspaces/playbymail/core/jobworker"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/emailer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/core/type/storer"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/jobqueue"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// SendGameResultsEmailWorkerArgs defines the job payload for sending a player their final game results
+type SendGameResultsEmailWorkerArgs struct {
+	GameSubscriptionInstanceID string
+}
+
+func (SendGameResultsEmailWorkerArgs) Kind() string {
+	return "send-game-results-email"
+}
+
+func (SendGameResultsEmailWorkerArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{Queue: jobqueue.QueueDefault}
+}
+
+// SendGameResultsEmailWorker sends an email with a player's final placing and a secure
+// link to their final results turn sheet when a game instance completes
+type SendGameResultsEmailWorker struct {
+	river.WorkerDefaults[SendGameResultsEmailWorkerArgs]
+	emailClient emailer.Emailer
+	JobWorker
+}
+
+func NewSendGameResultsEmailWorker(l logger.Logger, cfg config.Config, s storer.Storer, e emailer.Emailer) (*SendGameResultsEmailWorker, error) {
+	l = l.WithPackageContext("SendGameResultsEmailWorker")
+
+	l.Info("instantiating SendGameResultsEmailWorker")
+
+	jw, err := NewJobWorker(l, cfg, s)
+	if err != nil {
+		return nil, err
+	}
+
+	if e == nil {
+		l.Warn("email client is nil, assuming registration-only instantiation")
+	}
+
+	if cfg.TemplatesPath == "" {
+		return nil, fmt.Errorf("templates path is empty")
+	}
+
+	if _, err := os.Stat(cfg.TemplatesPath); os.IsNotExist(err) {
+		return nil, fmt.Errorf("templates path does not exist >%s<", cfg.TemplatesPath)
+	}
+
+	return &SendGameResultsEmailWorker{
+		JobWorker:   *jw,
+		emailClient: e,
+	}, nil
+}
+
+func (w *SendGameResultsEmailWorker) Work(ctx context.Context, j *river.Job[SendGameResultsEmailWorkerArgs]) error {
+	l := w.Log.WithFunctionContext("SendGameResultsEmailWorker/Work")
+
+	l.Info("running job ID >%s< Args >%#v<", strconv.FormatInt(j.ID, 10), j.Args)
+
+	if w.emailClient == nil {
+		return fmt.Errorf("email client is nil")
+	}
+
+	c, m, err := w.beginJob(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		m.Tx.Rollback(context.Background())
+	}()
+
+	_, err = w.DoWork(ctx, m, c, j)
+	if err != nil {
+		l.Error("SendGameResultsEmailWorker job ID >%s< Args >%#v< failed >%v<", strconv.FormatInt(j.ID, 10), j.Args, err)
+		return err
+	}
+
+	return corejobworker.CompleteJob(ctx, m.Tx, j)
+}
+
+// SendGameResultsEmailDoWorkResult summarises the work carried out by the worker
+type SendGameResultsEmailDoWorkResult struct {
+	RecordCount int
+}
+
+func (w *SendGameResultsEmailWorker) DoWork(ctx context.Context, m *domain.Domain, c *river.Client[pgx.Tx], j *river.Job[SendGameResultsEmailWorkerArgs]) (*SendGameResultsEmailDoWorkResult, error) {
+	l := w.Log.WithFunctionContext("SendGameResultsEmailWorker/DoWork")
+
+	l.Info("preparing game results email for instance ID >%s<", j.Args.GameSubscriptionInstanceID)
+
+	instanceRec, err := m.GetGameSubscriptionInstanceRec(j.Args.GameSubscriptionInstanceID, nil)
+	if err != nil {
+		l.Warn("failed to get game subscription instance record >%v<", err)
+		return nil, err
+	}
+
+	gameInstanceRec, err := m.GetGameInstanceRec(instanceRec.GameInstanceID, nil)
+	if err != nil {
+		l.Warn("failed to get game instance ID >%s< >%v<", instanceRec.GameInstanceID, err)
+		return nil, err
+	}
+
+	if !gameInstanceRec.DeliveryEmail {
+		l.Info("email delivery not enabled for game instance >%s<, skipping game results email", gameInstanceRec.ID)
+		return &SendGameResultsEmailDoWorkResult{RecordCount: 0}, nil
+	}
+
+	resultRecs, err := m.GetManyGameInstanceResultRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: game_record.FieldGameInstanceResultGameInstanceID, Val: gameInstanceRec.ID},
+			{Col: game_record.FieldGameInstanceResultGameSubscriptionInstanceID, Val: instanceRec.ID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get game instance result >%v<", err)
+		return nil, err
+	}
+	if len(resultRecs) == 0 {
+		l.Info("no result recorded for subscription instance >%s<, skipping game results email", instanceRec.ID)
+		return &SendGameResultsEmailDoWorkResult{RecordCount: 0}, nil
+	}
+	resultRec := resultRecs[0]
+
+	accountRec, err := m.GetAccountUserRecByAccountID(instanceRec.AccountID, nil)
+	if err != nil {
+		l.Warn("failed to get account user record for account >%s< >%v<", instanceRec.AccountID, err)
+		return nil, err
+	}
+
+	gameRec, err := m.GetGameRec(gameInstanceRec.GameID, nil)
+	if err != nil {
+		l.Warn("failed to get game record >%v<", err)
+		return nil, err
+	}
+
+	turnSheetToken, err := m.GenerateGameSubscriptionInstanceTurnSheetToken(j.Args.GameSubscriptionInstanceID)
+	if err != nil {
+		l.Warn("failed to generate game subscription instance turn sheet token >%v<", err)
+		return nil, err
+	}
+
+	turnSheetPath := fmt.Sprintf("/player/game-subscription-instances/%s/turn-sheets/%s", j.Args.GameSubscriptionInstanceID, turnSheetToken)
+	turnSheetURL := fmt.Sprintf("%s%s", w.Config.AppHost, turnSheetPath)
+
+	locale, err := m.GetAccountLocale(instanceRec.AccountID)
+	if err != nil {
+		l.Warn("failed to get account locale >%v<", err)
+		return nil, err
+	}
+
+	tmpl, err := ParseEmailTemplate(w.Config.TemplatesPath, locale, "game_results.email.html")
+	if err != nil {
+		l.Warn("failed to parse email template >%v<", err)
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	accountURL := fmt.Sprintf("%s/account", w.Config.AppHost)
+
+	tmplData := struct {
+		GameName                string
+		Placing                 int
+		IsWinner                bool
+		EndConditionDescription string
+		Summary                 string
+		ResultsURL              string
+		SupportEmail            string
+		AccountURL              string
+		Year                    int
+	}{
+		GameName:                gameRec.Name,
+		Placing:                 resultRec.Placing,
+		IsWinner:                resultRec.IsWinner,
+		EndConditionDescription: turnsheet.GameEndConditionDescription(locale, resultRec.EndCondition),
+		Summary:                 resultRec.Summary,
+		ResultsURL:              turnSheetURL,
+		SupportEmail:            w.Config.SupportEmailAddress,
+		AccountURL:              accountURL,
+		Year:                    time.Now().Year(),
+	}
+
+	if err := tmpl.ExecuteTemplate(&body, "base", tmplData); err != nil {
+		l.Warn("failed to render email template >%v<", err)
+		return nil, err
+	}
+
+	emailMsg := &emailer.Message{
+		From:    w.Config.NoReplyEmailAddress,
+		To:      []string{accountRec.Email},
+		Subject: i18n.T(locale, "email.game_results.subject", "game", gameRec.Name),
+		Body:    body.String(),
+	}
+
+	if err := w.emailClient.Send(emailMsg); err != nil {
+		l.Warn("failed to send game results email >%v<", err)
+		return nil, err
+	}
+
+	l.Info("sent game results email to >%s< for game >%s<", accountRec.Email, gameRec.Name)
+
+	return &SendGameResultsEmailDoWorkResult{RecordCount: 1}, nil
+}
@@ -4,10 +4,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"html/template"
 	"net/url"
 	"os"
-	"path/filepath"
 	"strconv"
 	"time"
 
@@ -21,6 +19,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/core/type/storer"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/jobqueue"
 	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
@@ -154,9 +153,13 @@ func (w *SendGameSubscriptionApprovalEmailWorker) DoWork(ctx context.Context, m
 	}
 
 	// Render the HTML email template
-	baseTmplPath := filepath.Join(w.Config.TemplatesPath, "email", "base.email.html")
-	specificTmplPath := filepath.Join(w.Config.TemplatesPath, "email", "game_subscription_approval.email.html")
-	tmpl, err := template.ParseFiles(baseTmplPath, specificTmplPath)
+	locale, err := m.GetAccountLocale(accountUserRec.AccountID)
+	if err != nil {
+		l.Warn("failed to get account locale >%v<", err)
+		return nil, err
+	}
+
+	tmpl, err := ParseEmailTemplate(w.Config.TemplatesPath, locale, "game_subscription_approval.email.html")
 	if err != nil {
 		l.Warn("failed to parse email template >%v<", err)
 		return nil, err
@@ -190,7 +193,7 @@ func (w *SendGameSubscriptionApprovalEmailWorker) DoWork(ctx context.Context, m
 	emailMsg := &emailer.Message{
 		From:    w.Config.NoReplyEmailAddress,
 		To:      []string{accountUserRec.Email},
-		Subject: fmt.Sprintf("Confirm your subscription to %s", gameRec.Name),
+		Subject: i18n.T(locale, "email.subscription_approval.subject", "game", gameRec.Name),
 		Body:    body.String(),
 	}
 
@@ -4,9 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"html/template"
 	"os"
-	"path/filepath"
 	"strconv"
 	"time"
 
@@ -18,6 +16,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/core/type/storer"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/jobqueue"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
 )
@@ -136,9 +135,9 @@ func (w *SendPlayerInvitationEmailWorker) DoWork(ctx context.Context, m *domain.
 	joinURL := fmt.Sprintf("%s/player/join-game/%s", w.Config.AppHost, j.Args.GameSubscriptionID)
 
 	// Render the HTML email template.
-	baseTmplPath := filepath.Join(w.Config.TemplatesPath, "email", "base.email.html")
-	specificTmplPath := filepath.Join(w.Config.TemplatesPath, "email", "player_invitation.email.html")
-	tmpl, err := template.ParseFiles(baseTmplPath, specificTmplPath)
+	// Invited players may not have an account yet
+	locale := i18n.DefaultLocale
+	tmpl, err := ParseEmailTemplate(w.Config.TemplatesPath, locale, "player_invitation.email.html")
 	if err != nil {
 		l.Warn("failed to parse email template >%v<", err)
 		return nil, err
@@ -165,7 +164,7 @@ func (w *SendPlayerInvitationEmailWorker) DoWork(ctx context.Context, m *domain.
 	emailMsg := &emailer.Message{
 		From:    w.Config.NoReplyEmailAddress,
 		To:      []string{j.Args.Email},
-		Subject: fmt.Sprintf("You're invited to play %s", gameRec.Name),
+		Subject: i18n.T(locale, "email.player_invitation.subject", "game", gameRec.Name),
 		Body:    body.String(),
 	}
 
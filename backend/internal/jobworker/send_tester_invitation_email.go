@@ -4,9 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"html/template"
 	"os"
-	"path/filepath"
 	"strconv"
 	"time"
 
@@ -18,6 +16,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/core/type/storer"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/jobqueue"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
 )
@@ -149,9 +148,9 @@ func (w *SendTesterInvitationEmailWorker) DoWork(ctx context.Context, m *domain.
 	joinURL := fmt.Sprintf("%s%s", w.Config.AppHost, joinPath)
 
 	// Render the HTML email template
-	baseTmplPath := filepath.Join(w.Config.TemplatesPath, "email", "base.email.html")
-	specificTmplPath := filepath.Join(w.Config.TemplatesPath, "email", "tester_invitation.email.html")
-	tmpl, err := template.ParseFiles(baseTmplPath, specificTmplPath)
+	// Invited testers may not have an account yet
+	locale := i18n.DefaultLocale
+	tmpl, err := ParseEmailTemplate(w.Config.TemplatesPath, locale, "tester_invitation.email.html")
 	if err != nil {
 		l.Warn("failed to parse email template >%v<", err)
 		return nil, err
@@ -178,7 +177,7 @@ func (w *SendTesterInvitationEmailWorker) DoWork(ctx context.Context, m *domain.
 	emailMsg := &emailer.Message{
 		From:    w.Config.NoReplyEmailAddress,
 		To:      []string{j.Args.Email},
-		Subject: fmt.Sprintf("You're invited to test %s", gameRec.Name),
+		Subject: i18n.T(locale, "email.tester_invitation.subject", "game", gameRec.Name),
 		Body:    body.String(),
 	}
 
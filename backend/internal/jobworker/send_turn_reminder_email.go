@@ -0,0 +1,261 @@
+package jobworker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+
+	corejobworker "gitlab.com/alienspaces/playbymail/core/jobworker"
+	"gitlab.com/alienspaces/playbymail/core/type/emailer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/core/type/storer"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/jobqueue"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// SendTurnReminderEmailWorkerArgs defines the job payload for sending turn deadline reminder emails
+type SendTurnReminderEmailWorkerArgs struct {
+	GameSubscriptionInstanceID string
+	TurnNumber                 int
+}
+
+func (SendTurnReminderEmailWorkerArgs) Kind() string {
+	return "send-turn-reminder-email"
+}
+
+func (SendTurnReminderEmailWorkerArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{Queue: jobqueue.QueueDefault}
+}
+
+// SendTurnReminderEmailWorker sends an email reminding a player that their turn sheets
+// have not been submitted and the turn deadline is approaching
+type SendTurnReminderEmailWorker struct {
+	river.WorkerDefaults[SendTurnReminderEmailWorkerArgs]
+	emailClient emailer.Emailer
+	JobWorker
+}
+
+func NewSendTurnReminderEmailWorker(l logger.Logger, cfg config.Config, s storer.Storer, e emailer.Emailer) (*SendTurnReminderEmailWorker, error) {
+	l = l.WithPackageContext("SendTurnReminderEmailWorker")
+
+	l.Info("instantiating SendTurnReminderEmailWorker")
+
+	jw, err := NewJobWorker(l, cfg, s)
+	if err != nil {
+		return nil, err
+	}
+
+	if e == nil {
+		l.Warn("email client is nil, assuming registration-only instantiation")
+	}
+
+	if cfg.TemplatesPath == "" {
+		return nil, fmt.Errorf("templates path is empty")
+	}
+
+	if _, err := os.Stat(cfg.TemplatesPath); os.IsNotExist(err) {
+		return nil, fmt.Errorf("templates path does not exist >%s<", cfg.TemplatesPath)
+	}
+
+	return &SendTurnReminderEmailWorker{
+		JobWorker:   *jw,
+		emailClient: e,
+	}, nil
+}
+
+func (w *SendTurnReminderEmailWorker) Work(ctx context.Context, j *river.Job[SendTurnReminderEmailWorkerArgs]) error {
+	l := w.Log.WithFunctionContext("SendTurnReminderEmailWorker/Work")
+
+	l.Info("running job ID >%s< Args >%#v<", strconv.FormatInt(j.ID, 10), j.Args)
+
+	if w.emailClient == nil {
+		return fmt.Errorf("email client is nil")
+	}
+
+	c, m, err := w.beginJob(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		m.Tx.Rollback(context.Background())
+	}()
+
+	_, err = w.DoWork(ctx, m, c, j)
+	if err != nil {
+		l.Error("SendTurnReminderEmailWorker job ID >%s< Args >%#v< failed >%v<", strconv.FormatInt(j.ID, 10), j.Args, err)
+		return err
+	}
+
+	return corejobworker.CompleteJob(ctx, m.Tx, j)
+}
+
+// SendTurnReminderEmailDoWorkResult summarises the work carried out by the worker
+type SendTurnReminderEmailDoWorkResult struct {
+	RecordCount int
+}
+
+func (w *SendTurnReminderEmailWorker) DoWork(ctx context.Context, m *domain.Domain, c *river.Client[pgx.Tx], j *river.Job[SendTurnReminderEmailWorkerArgs]) (*SendTurnReminderEmailDoWorkResult, error) {
+	l := w.Log.WithFunctionContext("SendTurnReminderEmailWorker/DoWork")
+
+	l.Info("preparing turn reminder email for instance ID >%s< turn >%d<", j.Args.GameSubscriptionInstanceID, j.Args.TurnNumber)
+
+	instanceRec, err := m.GetGameSubscriptionInstanceRec(j.Args.GameSubscriptionInstanceID, nil)
+	if err != nil {
+		l.Warn("failed to get game subscription instance record >%v<", err)
+		return nil, err
+	}
+
+	gameInstanceRec, err := m.GetGameInstanceRec(instanceRec.GameInstanceID, nil)
+	if err != nil {
+		l.Warn("failed to get game instance ID >%s< >%v<", instanceRec.GameInstanceID, err)
+		return nil, err
+	}
+
+	// The turn may have been processed or the player may have submitted since the
+	// reminder was queued
+	if gameInstanceRec.Status != game_record.GameInstanceStatusStarted ||
+		gameInstanceRec.CurrentTurn != j.Args.TurnNumber ||
+		!gameInstanceRec.NextTurnDueAt.Valid {
+		l.Info("game instance >%s< is no longer waiting on turn >%d<, skipping turn reminder", gameInstanceRec.ID, j.Args.TurnNumber)
+		return &SendTurnReminderEmailDoWorkResult{RecordCount: 0}, nil
+	}
+
+	turnSheetRecs, err := m.GetGameTurnSheetRecsByGameInstance(gameInstanceRec.ID, j.Args.TurnNumber)
+	if err != nil {
+		l.Warn("failed to get turn sheets for game instance >%s< turn >%d< >%v<", gameInstanceRec.ID, j.Args.TurnNumber, err)
+		return nil, err
+	}
+
+	incomplete := 0
+	for _, turnSheetRec := range turnSheetRecs {
+		if turnSheetRec.AccountUserID == instanceRec.AccountUserID && !turnSheetRec.IsCompleted {
+			incomplete++
+		}
+	}
+
+	if incomplete == 0 {
+		l.Info("game subscription instance >%s< has no turn sheets to submit, skipping turn reminder", instanceRec.ID)
+		return &SendTurnReminderEmailDoWorkResult{RecordCount: 0}, nil
+	}
+
+	accountRec, err := m.GetAccountRec(instanceRec.AccountID, nil)
+	if err != nil {
+		l.Warn("failed to get account record >%s< >%v<", instanceRec.AccountID, err)
+		return nil, err
+	}
+
+	accountUserRec, err := m.GetAccountUserRec(instanceRec.AccountUserID, nil)
+	if err != nil {
+		l.Warn("failed to get account user record >%s< >%v<", instanceRec.AccountUserID, err)
+		return nil, err
+	}
+
+	gameRec, err := m.GetGameRec(gameInstanceRec.GameID, nil)
+	if err != nil {
+		l.Warn("failed to get game record >%v<", err)
+		return nil, err
+	}
+
+	// Reuse the turn sheet token from the turn notification while it is still valid so
+	// the link in that email keeps working
+	turnSheetToken := instanceRec.TurnSheetToken.String
+	if turnSheetToken == "" || !instanceRec.TurnSheetTokenExpiresAt.Valid || instanceRec.TurnSheetTokenExpiresAt.Time.Before(time.Now()) {
+		turnSheetToken, err = m.GenerateGameSubscriptionInstanceTurnSheetToken(instanceRec.ID)
+		if err != nil {
+			l.Warn("failed to generate game subscription instance turn sheet token >%v<", err)
+			return nil, err
+		}
+	}
+
+	turnSheetPath := fmt.Sprintf("/player/game-subscription-instances/%s/turn-sheets/%s", instanceRec.ID, turnSheetToken)
+	turnSheetURL := fmt.Sprintf("%s%s", w.Config.AppHost, turnSheetPath)
+
+	locale := i18n.NormalizeLocale(accountRec.Locale.String)
+	deadlineDate, deadlineTime := formatTurnDeadline(gameInstanceRec.NextTurnDueAt.Time, accountRec.Timezone.String, locale)
+
+	tmpl, err := ParseEmailTemplate(w.Config.TemplatesPath, locale, "turn_reminder.email.html")
+	if err != nil {
+		l.Warn("failed to parse email template >%v<", err)
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	accountURL := fmt.Sprintf("%s/account", w.Config.AppHost)
+
+	tmplData := struct {
+		GameName       string
+		TurnNumber     int
+		TurnSheetCount int
+		TurnSheetURL   string
+		DeadlineDate   string
+		DeadlineTime   string
+		SupportEmail   string
+		AccountURL     string
+		ReplyByEmail   bool
+		Year           int
+	}{
+		GameName:       gameRec.Name,
+		TurnNumber:     j.Args.TurnNumber,
+		TurnSheetCount: incomplete,
+		TurnSheetURL:   turnSheetURL,
+		DeadlineDate:   deadlineDate,
+		DeadlineTime:   deadlineTime,
+		SupportEmail:   w.Config.SupportEmailAddress,
+		AccountURL:     accountURL,
+		ReplyByEmail:   w.Config.InboundMailProvider != "",
+		Year:           time.Now().Year(),
+	}
+
+	if err := tmpl.ExecuteTemplate(&body, "base", tmplData); err != nil {
+		l.Warn("failed to render email template >%v<", err)
+		return nil, err
+	}
+
+	// When inbound mail is enabled replies to the reminder are read as turn submissions,
+	// identified by the reply reference in the subject
+	from := w.Config.NoReplyEmailAddress
+	subject := i18n.T(locale, "email.turn_reminder.subject", "turn", j.Args.TurnNumber, "game", gameRec.Name, "date", deadlineDate)
+	if tmplData.ReplyByEmail {
+		from = w.Config.InboundMailAddress
+		subject = inboundMailReplySubject(subject, turnSheetToken)
+	}
+
+	emailMsg := &emailer.Message{
+		From:    from,
+		To:      []string{accountUserRec.Email},
+		Subject: subject,
+		Body:    body.String(),
+	}
+
+	if err := w.emailClient.Send(emailMsg); err != nil {
+		l.Warn("failed to send turn reminder email >%v<", err)
+		return nil, err
+	}
+
+	l.Info("sent turn reminder email to >%s< for game >%s< turn >%d<", accountUserRec.Email, gameRec.Name, j.Args.TurnNumber)
+
+	return &SendTurnReminderEmailDoWorkResult{RecordCount: 1}, nil
+}
+
+// formatTurnDeadline formats the turn deadline as a date and time in the account
+// timezone and locale, falling back to UTC when the account has no valid timezone
+func formatTurnDeadline(deadline time.Time, timezone, locale string) (string, string) {
+	loc := time.UTC
+	if timezone != "" {
+		if tzLoc, err := time.LoadLocation(timezone); err == nil {
+			loc = tzLoc
+		}
+	}
+	deadline = deadline.In(loc)
+	z := i18n.NewLocalizer(locale)
+	return z.FormatDate(deadline), z.FormatTime(deadline)
+}
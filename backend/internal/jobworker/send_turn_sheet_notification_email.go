@@ -4,9 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"html/template"
 	"os"
-	"path/filepath"
 	"strconv"
 	"time"
 
@@ -18,6 +16,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/core/type/storer"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/jobqueue"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
 )
@@ -169,23 +168,28 @@ func (w *SendTurnSheetNotificationEmailWorker) DoWork(ctx context.Context, m *do
 	turnSheetPath := fmt.Sprintf("/player/game-subscription-instances/%s/turn-sheets/%s", j.Args.GameSubscriptionInstanceID, turnSheetToken)
 	turnSheetURL := fmt.Sprintf("%s%s", w.Config.AppHost, turnSheetPath)
 
+	locale, err := m.GetAccountLocale(instanceRec.AccountID)
+	if err != nil {
+		l.Warn("failed to get account locale >%v<", err)
+		return nil, err
+	}
+	z := i18n.NewLocalizer(locale)
+
 	// Format expiration date/time
 	var expirationDate, expirationTime string
 	if instanceRec.TurnSheetTokenExpiresAt.Valid {
 		expirationTimeVal := instanceRec.TurnSheetTokenExpiresAt.Time
-		expirationDate = expirationTimeVal.Format("January 2, 2006")
-		expirationTime = expirationTimeVal.Format("3:04 PM MST")
+		expirationDate = z.FormatDate(expirationTimeVal)
+		expirationTime = z.FormatTime(expirationTimeVal)
 	} else {
 		// Fallback: 30-day safety-net (matches turnSheetTokenExpiry in domain)
 		expirationTimeVal := time.Now().Add(30 * 24 * time.Hour)
-		expirationDate = expirationTimeVal.Format("January 2, 2006")
-		expirationTime = expirationTimeVal.Format("3:04 PM MST")
+		expirationDate = z.FormatDate(expirationTimeVal)
+		expirationTime = z.FormatTime(expirationTimeVal)
 	}
 
 	// Render the HTML email template
-	baseTmplPath := filepath.Join(w.Config.TemplatesPath, "email", "base.email.html")
-	specificTmplPath := filepath.Join(w.Config.TemplatesPath, "email", "turn_sheet_notification.email.html")
-	tmpl, err := template.ParseFiles(baseTmplPath, specificTmplPath)
+	tmpl, err := ParseEmailTemplate(w.Config.TemplatesPath, locale, "turn_sheet_notification.email.html")
 	if err != nil {
 		l.Warn("failed to parse email template >%v<", err)
 		return nil, err
@@ -203,6 +207,7 @@ func (w *SendTurnSheetNotificationEmailWorker) DoWork(ctx context.Context, m *do
 		ExpirationTime string
 		SupportEmail   string
 		AccountURL     string
+		ReplyByEmail   bool
 		Year           int
 	}{
 		GameName:       gameRec.Name,
@@ -212,6 +217,7 @@ func (w *SendTurnSheetNotificationEmailWorker) DoWork(ctx context.Context, m *do
 		ExpirationTime: expirationTime,
 		SupportEmail:   w.Config.SupportEmailAddress,
 		AccountURL:     accountURL,
+		ReplyByEmail:   w.Config.InboundMailProvider != "",
 		Year:           time.Now().Year(),
 	}
 
@@ -220,10 +226,19 @@ func (w *SendTurnSheetNotificationEmailWorker) DoWork(ctx context.Context, m *do
 		return nil, err
 	}
 
+	// When inbound mail is enabled replies to the notification are read as turn submissions,
+	// identified by the reply reference in the subject
+	from := w.Config.NoReplyEmailAddress
+	subject := z.T("email.turn_sheet_notification.subject", "turn", j.Args.TurnNumber, "game", gameRec.Name)
+	if tmplData.ReplyByEmail {
+		from = w.Config.InboundMailAddress
+		subject = inboundMailReplySubject(subject, turnSheetToken)
+	}
+
 	emailMsg := &emailer.Message{
-		From:    w.Config.NoReplyEmailAddress,
+		From:    from,
 		To:      []string{accountRec.Email},
-		Subject: fmt.Sprintf("Turn %d is ready for %s", j.Args.TurnNumber, gameRec.Name),
+		Subject: subject,
 		Body:    body.String(),
 	}
 
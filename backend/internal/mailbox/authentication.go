@@ -0,0 +1,116 @@
+package mailbox
+
+import (
+	"regexp"
+	"strings"
+)
+
+// authResultCommentRe matches RFC 5322 comments, e.g. "(sender IP is 192.0.2.1)"
+var authResultCommentRe = regexp.MustCompile(`\([^()]*\)`)
+
+// authResult is a single method result from an Authentication-Results header,
+// e.g. "dkim=pass header.d=example.com"
+type authResult struct {
+	method string
+	result string
+	props  map[string]string
+}
+
+// SenderAuthenticated reports whether the receiving mail server verified that
+// the message was sent by the domain in its From address. Only the
+// Authentication-Results header added by the receiving server is trusted,
+// identified by its authserv-id, or the topmost header when authservID is
+// empty. The sender is authenticated when DMARC passes, or when DKIM or SPF
+// passes for a domain aligned with the From address.
+func (m *Message) SenderAuthenticated(authservID string) bool {
+	var header string
+	for _, h := range m.AuthenticationResults {
+		id, _, _ := strings.Cut(h, ";")
+		if authservID == "" || strings.EqualFold(strings.TrimSpace(id), authservID) {
+			header = h
+			break
+		}
+	}
+	if header == "" {
+		return false
+	}
+
+	_, fromDomain, ok := strings.Cut(m.From, "@")
+	if !ok || fromDomain == "" {
+		return false
+	}
+
+	for _, res := range parseAuthenticationResults(header) {
+		if res.result != "pass" {
+			continue
+		}
+		switch res.method {
+		case "dmarc":
+			if d := res.props["header.from"]; d == "" || domainsAligned(d, fromDomain) {
+				return true
+			}
+		case "dkim":
+			if domainsAligned(res.props["header.d"], fromDomain) {
+				return true
+			}
+		case "spf":
+			mailFrom := res.props["smtp.mailfrom"]
+			if _, d, ok := strings.Cut(mailFrom, "@"); ok {
+				mailFrom = d
+			}
+			if domainsAligned(mailFrom, fromDomain) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// parseAuthenticationResults returns the method results of an
+// Authentication-Results header value, skipping the leading authserv-id
+func parseAuthenticationResults(header string) []authResult {
+	header = authResultCommentRe.ReplaceAllString(header, " ")
+
+	parts := strings.Split(header, ";")
+	if len(parts) < 2 {
+		return nil
+	}
+
+	var results []authResult
+	for _, part := range parts[1:] {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			continue
+		}
+		method, result, ok := strings.Cut(fields[0], "=")
+		if !ok {
+			continue
+		}
+		res := authResult{
+			method: strings.ToLower(method),
+			result: strings.ToLower(result),
+			props:  map[string]string{},
+		}
+		for _, field := range fields[1:] {
+			if key, val, ok := strings.Cut(field, "="); ok {
+				res.props[strings.ToLower(key)] = strings.ToLower(strings.Trim(val, `"`))
+			}
+		}
+		results = append(results, res)
+	}
+
+	return results
+}
+
+// domainsAligned reports whether an authenticated domain is aligned with the
+// From domain, i.e. the From domain is the same as or a subdomain of it
+func domainsAligned(authDomain, fromDomain string) bool {
+	authDomain = strings.ToLower(strings.TrimSuffix(authDomain, "."))
+	fromDomain = strings.ToLower(strings.TrimSuffix(fromDomain, "."))
+	if authDomain == "" || fromDomain == "" {
+		return false
+	}
+
+	return authDomain == fromDomain || strings.HasSuffix(fromDomain, "."+authDomain)
+}
@@ -0,0 +1,75 @@
+package mailbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Provider names for inbound mailboxes
+const (
+	ProviderNone    string = ""
+	ProviderMaildir string = "maildir"
+)
+
+// Message is an inbound email message
+type Message struct {
+	ID          string
+	MessageID   string
+	From        string
+	FromName    string
+	Subject     string
+	Text        string
+	Attachments []Attachment
+	ReceivedAt  time.Time
+	// AuthenticationResults are the Authentication-Results header values,
+	// topmost first, added by the mail servers that received the message
+	AuthenticationResults []string
+}
+
+// Attachment is a file attached to an inbound email message
+type Attachment struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+// Mailbox is a source of inbound email messages.
+//
+// Messages move through the mailbox in three steps: List returns messages that
+// have arrived but have not been claimed, Claim removes a message from the list
+// so it is only processed once, and Done is called when processing of a claimed
+// message has finished.
+type Mailbox interface {
+	// List returns the IDs of messages waiting to be processed
+	List(ctx context.Context) ([]string, error)
+	// Claim marks a message as being processed so it is no longer listed
+	Claim(ctx context.Context, id string) error
+	// Get returns a claimed message
+	Get(ctx context.Context, id string) (*Message, error)
+	// Done marks a claimed message as processed
+	Done(ctx context.Context, id string) error
+}
+
+// Config identifies the mailbox provider and its location
+type Config struct {
+	Provider    string
+	MaildirPath string
+}
+
+// NewMailbox returns the mailbox for the configured provider. A nil mailbox is
+// returned when no provider is configured.
+func NewMailbox(cfg Config) (Mailbox, error) {
+	switch cfg.Provider {
+	case ProviderNone:
+		return nil, nil
+	case ProviderMaildir:
+		d, err := NewMaildir(cfg.MaildirPath)
+		if err != nil {
+			return nil, err
+		}
+		return d, nil
+	default:
+		return nil, fmt.Errorf("unsupported inbound mail provider: %s", cfg.Provider)
+	}
+}
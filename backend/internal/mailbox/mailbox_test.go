@@ -0,0 +1,209 @@
+package mailbox
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const plainReply = "From: Ellie Hart <Ellie@Example.com>\r\n" +
+	"To: turns@playbymail.games\r\n" +
+	"Subject: Re: Turn 3 is ready for The Sunken Keep\r\n" +
+	"Date: Mon, 20 Apr 2026 10:00:00 +0000\r\n" +
+	"Message-Id: <reply-1@example.com>\r\n" +
+	"Authentication-Results: mx.playbymail.games; dkim=pass header.d=example.com\r\n" +
+	"Content-Type: text/plain; charset=utf-8\r\n" +
+	"\r\n" +
+	"GO Dark Forest\r\n" +
+	"\r\n" +
+	"Thanks!\r\n" +
+	"-- \r\n" +
+	"Ellie\r\n"
+
+const multipartReply = "From: marcus@example.com\r\n" +
+	"Subject: =?utf-8?q?Re:_Turn_3_is_ready_for_Iron_Front?=\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/mixed; boundary=\"outer\"\r\n" +
+	"\r\n" +
+	"--outer\r\n" +
+	"Content-Type: multipart/alternative; boundary=\"inner\"\r\n" +
+	"\r\n" +
+	"--inner\r\n" +
+	"Content-Type: text/html; charset=utf-8\r\n" +
+	"\r\n" +
+	"<p>MECH Hammer MOVE Ridge</p>\r\n" +
+	"--inner\r\n" +
+	"Content-Type: text/plain; charset=utf-8\r\n" +
+	"Content-Transfer-Encoding: quoted-printable\r\n" +
+	"\r\n" +
+	"MECH Hammer MOVE Ridge=0D=0A\r\n" +
+	"\r\n" +
+	"On Mon, 20 Apr 2026 at 09:00, Play by Mail <noreply@playbymail.games> wrote:\r\n" +
+	"> Turn 3 is ready\r\n" +
+	"--inner--\r\n" +
+	"--outer\r\n" +
+	"Content-Type: image/png\r\n" +
+	"Content-Disposition: attachment; filename=\"sheet.png\"\r\n" +
+	"Content-Transfer-Encoding: base64\r\n" +
+	"\r\n" +
+	"iVBORw0KGgo=\r\n" +
+	"--outer--\r\n"
+
+func TestParseMessage(t *testing.T) {
+	t.Run("plain text reply", func(t *testing.T) {
+		msg, err := ParseMessage(strings.NewReader(plainReply))
+		require.NoError(t, err)
+		require.Equal(t, "ellie@example.com", msg.From)
+		require.Equal(t, "Ellie Hart", msg.FromName)
+		require.Equal(t, "Re: Turn 3 is ready for The Sunken Keep", msg.Subject)
+		require.Equal(t, "<reply-1@example.com>", msg.MessageID)
+		require.False(t, msg.ReceivedAt.IsZero())
+		require.Empty(t, msg.Attachments)
+		require.Equal(t, "GO Dark Forest\n\nThanks!", msg.ReplyText())
+		require.Equal(t, []string{"mx.playbymail.games; dkim=pass header.d=example.com"}, msg.AuthenticationResults)
+		require.True(t, msg.SenderAuthenticated(""))
+	})
+
+	t.Run("multipart reply with attachment", func(t *testing.T) {
+		msg, err := ParseMessage(strings.NewReader(multipartReply))
+		require.NoError(t, err)
+		require.Equal(t, "marcus@example.com", msg.From)
+		require.Equal(t, "Re: Turn 3 is ready for Iron Front", msg.Subject)
+		require.Equal(t, "MECH Hammer MOVE Ridge", msg.ReplyText(), "plain text part is preferred and quoted text dropped")
+		require.Len(t, msg.Attachments, 1)
+		require.Equal(t, "sheet.png", msg.Attachments[0].Name)
+		require.Equal(t, "image/png", msg.Attachments[0].ContentType)
+		require.Equal(t, []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}, msg.Attachments[0].Content)
+	})
+
+	t.Run("missing sender", func(t *testing.T) {
+		_, err := ParseMessage(strings.NewReader("Subject: hello\r\n\r\nbody\r\n"))
+		require.Error(t, err)
+	})
+}
+
+func TestMessageSenderAuthenticated(t *testing.T) {
+	tests := []struct {
+		name       string
+		from       string
+		results    []string
+		authservID string
+		want       bool
+	}{
+		{
+			name:    "dmarc pass",
+			from:    "ellie@example.com",
+			results: []string{"mx.playbymail.games; spf=fail smtp.mailfrom=bounce@mailer.net; dkim=none; dmarc=pass header.from=example.com"},
+			want:    true,
+		},
+		{
+			name:    "dkim pass for the sender domain",
+			from:    "ellie@mail.example.com",
+			results: []string{"mx.playbymail.games; dkim=pass (2048-bit key) header.d=example.com header.s=sel1"},
+			want:    true,
+		},
+		{
+			name:    "spf pass for the sender domain",
+			from:    "ellie@example.com",
+			results: []string{"mx.playbymail.games; spf=pass (sender IP is 192.0.2.1) smtp.mailfrom=ellie@example.com"},
+			want:    true,
+		},
+		{
+			name:    "dkim pass for another domain",
+			from:    "ellie@example.com",
+			results: []string{"mx.playbymail.games; dkim=pass header.d=attacker.net; spf=pass smtp.mailfrom=attacker.net; dmarc=fail header.from=example.com"},
+		},
+		{
+			name:    "dkim pass for a subdomain of the sender domain",
+			from:    "ellie@example.com",
+			results: []string{"mx.playbymail.games; dkim=pass header.d=evil.example.com"},
+		},
+		{
+			name: "no authentication results",
+			from: "ellie@example.com",
+		},
+		{
+			name: "only the topmost header is trusted",
+			from: "ellie@example.com",
+			results: []string{
+				"mx.playbymail.games; dkim=fail header.d=example.com",
+				"mx.playbymail.games; dkim=pass header.d=example.com",
+			},
+		},
+		{
+			name: "header from the trusted server",
+			from: "ellie@example.com",
+			results: []string{
+				"spoofed.example; dkim=fail header.d=example.com",
+				"mx.playbymail.games; dkim=pass header.d=example.com",
+			},
+			authservID: "mx.playbymail.games",
+			want:       true,
+		},
+		{
+			name:       "no header from the trusted server",
+			from:       "ellie@example.com",
+			results:    []string{"spoofed.example; dkim=pass header.d=example.com"},
+			authservID: "mx.playbymail.games",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &Message{From: tt.from, AuthenticationResults: tt.results}
+			require.Equal(t, tt.want, msg.SenderAuthenticated(tt.authservID))
+		})
+	}
+}
+
+func TestMaildir(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	mb, err := NewMailbox(Config{Provider: ProviderMaildir, MaildirPath: dir})
+	require.NoError(t, err)
+
+	ids, err := mb.List(ctx)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "new", "1713607200.M1P1.host"), []byte(plainReply), 0o600))
+
+	ids, err = mb.List(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1713607200.M1P1.host"}, ids)
+
+	_, err = mb.Get(ctx, ids[0])
+	require.Error(t, err, "unclaimed messages cannot be read")
+
+	require.NoError(t, mb.Claim(ctx, ids[0]))
+
+	remaining, err := mb.List(ctx)
+	require.NoError(t, err)
+	require.Empty(t, remaining, "claimed messages are no longer listed")
+
+	msg, err := mb.Get(ctx, ids[0])
+	require.NoError(t, err)
+	require.Equal(t, ids[0], msg.ID)
+	require.Equal(t, "ellie@example.com", msg.From)
+
+	require.NoError(t, mb.Done(ctx, ids[0]))
+	_, err = os.Stat(filepath.Join(dir, "cur", ids[0]+":2,ST"))
+	require.NoError(t, err)
+
+	_, err = mb.Get(ctx, "../escape")
+	require.Error(t, err)
+}
+
+func TestNewMailbox(t *testing.T) {
+	mb, err := NewMailbox(Config{})
+	require.NoError(t, err)
+	require.Nil(t, mb)
+
+	_, err = NewMailbox(Config{Provider: "pigeon"})
+	require.Error(t, err)
+}
@@ -0,0 +1,176 @@
+package mailbox
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Maildir implements Mailbox over a local maildir directory. Delivered messages
+// arrive in new/, are moved to cur/ when claimed and are flagged as trashed
+// once processed. Any mail transfer agent that delivers to maildir (or a
+// developer copying .eml files into new/) can feed the mailbox.
+type Maildir struct {
+	path string
+}
+
+const (
+	maildirNew = "new"
+	maildirCur = "cur"
+	maildirTmp = "tmp"
+
+	// Maildir info suffixes, "S" seen and "T" trashed
+	maildirInfoClaimed = ":2,S"
+	maildirInfoDone    = ":2,ST"
+)
+
+// NewMaildir returns a maildir mailbox, creating the maildir directories when
+// they do not exist.
+func NewMaildir(path string) (*Maildir, error) {
+	if path == "" {
+		return nil, fmt.Errorf("maildir path is empty")
+	}
+
+	for _, dir := range []string{maildirNew, maildirCur, maildirTmp} {
+		if err := os.MkdirAll(filepath.Join(path, dir), 0o750); err != nil {
+			return nil, fmt.Errorf("failed to create maildir directory >%s<: %w", dir, err)
+		}
+	}
+
+	return &Maildir{path: path}, nil
+}
+
+// List returns the IDs of messages in new/, oldest first
+func (d *Maildir) List(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(d.path, maildirNew))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read maildir: %w", err)
+	}
+
+	type entry struct {
+		id      string
+		modTime int64
+	}
+
+	var found []entry
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		found = append(found, entry{id: maildirID(e.Name()), modTime: info.ModTime().UnixNano()})
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		if found[i].modTime != found[j].modTime {
+			return found[i].modTime < found[j].modTime
+		}
+		return found[i].id < found[j].id
+	})
+
+	ids := make([]string, 0, len(found))
+	for _, e := range found {
+		ids = append(ids, e.id)
+	}
+
+	return ids, nil
+}
+
+// Claim moves a message from new/ to cur/
+func (d *Maildir) Claim(ctx context.Context, id string) error {
+	name, err := d.find(maildirNew, id)
+	if err != nil {
+		return err
+	}
+
+	from := filepath.Join(d.path, maildirNew, name)
+	to := filepath.Join(d.path, maildirCur, id+maildirInfoClaimed)
+	if err := os.Rename(from, to); err != nil {
+		return fmt.Errorf("failed to claim message >%s<: %w", id, err)
+	}
+
+	return nil
+}
+
+// Get reads and parses a claimed message from cur/
+func (d *Maildir) Get(ctx context.Context, id string) (*Message, error) {
+	name, err := d.find(maildirCur, id)
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(d.path, maildirCur, name)
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open message >%s<: %w", id, err)
+	}
+	defer f.Close()
+
+	msg, err := ParseMessage(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse message >%s<: %w", id, err)
+	}
+	msg.ID = id
+
+	if msg.ReceivedAt.IsZero() {
+		if info, err := os.Stat(path); err == nil {
+			msg.ReceivedAt = info.ModTime()
+		}
+	}
+
+	return msg, nil
+}
+
+// Done flags a claimed message as trashed so mail clients and clean up jobs
+// can expunge it.
+func (d *Maildir) Done(ctx context.Context, id string) error {
+	name, err := d.find(maildirCur, id)
+	if err != nil {
+		return err
+	}
+
+	from := filepath.Join(d.path, maildirCur, name)
+	to := filepath.Join(d.path, maildirCur, id+maildirInfoDone)
+	if from == to {
+		return nil
+	}
+	if err := os.Rename(from, to); err != nil {
+		return fmt.Errorf("failed to complete message >%s<: %w", id, err)
+	}
+
+	return nil
+}
+
+// find returns the file name of a message in a maildir sub directory
+func (d *Maildir) find(dir, id string) (string, error) {
+	if id == "" || strings.ContainsAny(id, `/\`) {
+		return "", fmt.Errorf("invalid message ID >%s<", id)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(d.path, dir))
+	if err != nil {
+		return "", fmt.Errorf("failed to read maildir: %w", err)
+	}
+
+	for _, e := range entries {
+		if !e.IsDir() && maildirID(e.Name()) == id {
+			return e.Name(), nil
+		}
+	}
+
+	return "", fmt.Errorf("message >%s< not found in maildir %s/", id, dir)
+}
+
+// maildirID strips the info suffix from a maildir file name
+func maildirID(name string) string {
+	if i := strings.Index(name, ":"); i >= 0 {
+		return name[:i]
+	}
+	return name
+}
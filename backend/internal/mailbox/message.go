@@ -0,0 +1,226 @@
+package mailbox
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+// maxPartSize limits the size of any single decoded message part
+const maxPartSize = 25 * 1024 * 1024
+
+// ParseMessage parses an RFC 5322 message, extracting the sender, subject, the
+// plain text body and any attached files. When a message has no plain text
+// part the HTML part is reduced to text.
+func ParseMessage(r io.Reader) (*Message, error) {
+	m, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read message: %w", err)
+	}
+
+	dec := new(mime.WordDecoder)
+
+	msg := &Message{
+		MessageID: strings.TrimSpace(m.Header.Get("Message-Id")),
+	}
+
+	for _, h := range m.Header["Authentication-Results"] {
+		msg.AuthenticationResults = append(msg.AuthenticationResults, strings.TrimSpace(h))
+	}
+
+	subject, err := dec.DecodeHeader(m.Header.Get("Subject"))
+	if err != nil {
+		subject = m.Header.Get("Subject")
+	}
+	msg.Subject = strings.TrimSpace(subject)
+
+	from, err := m.Header.AddressList("From")
+	if err != nil || len(from) == 0 {
+		return nil, fmt.Errorf("message has no valid From address")
+	}
+	msg.From = strings.ToLower(from[0].Address)
+	msg.FromName = from[0].Name
+
+	if date, err := m.Header.Date(); err == nil {
+		msg.ReceivedAt = date
+	}
+
+	p := &messageParts{}
+	if err := p.walk(m.Header, m.Body); err != nil {
+		return nil, err
+	}
+
+	msg.Text = p.text
+	if msg.Text == "" && p.html != "" {
+		msg.Text = htmlToText(p.html)
+	}
+	msg.Attachments = p.attachments
+
+	return msg, nil
+}
+
+// partHeader is satisfied by both message and MIME part headers
+type partHeader interface {
+	Get(key string) string
+}
+
+type messageParts struct {
+	text        string
+	html        string
+	attachments []Attachment
+}
+
+func (p *messageParts) walk(h partHeader, body io.Reader) error {
+	contentType := h.Get("Content-Type")
+	if contentType == "" {
+		contentType = "text/plain"
+	}
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "application/octet-stream"
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		boundary := params["boundary"]
+		if boundary == "" {
+			return fmt.Errorf("multipart message has no boundary")
+		}
+		mr := multipart.NewReader(body, boundary)
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read message part: %w", err)
+			}
+			if err := p.walk(part.Header, part); err != nil {
+				return err
+			}
+		}
+	}
+
+	content, err := decodePart(h.Get("Content-Transfer-Encoding"), body)
+	if err != nil {
+		return err
+	}
+
+	disposition, dispParams, _ := mime.ParseMediaType(h.Get("Content-Disposition"))
+	filename := dispParams["filename"]
+	if filename == "" {
+		filename = params["name"]
+	}
+
+	isAttachment := disposition == "attachment" || filename != "" ||
+		(!strings.HasPrefix(mediaType, "text/") && mediaType != "message/rfc822")
+
+	switch {
+	case isAttachment:
+		p.attachments = append(p.attachments, Attachment{
+			Name:        filename,
+			ContentType: mediaType,
+			Content:     content,
+		})
+	case mediaType == "text/plain" && p.text == "":
+		p.text = string(content)
+	case mediaType == "text/html" && p.html == "":
+		p.html = string(content)
+	}
+
+	return nil
+}
+
+func decodePart(encoding string, body io.Reader) ([]byte, error) {
+	var r io.Reader
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		r = base64.NewDecoder(base64.StdEncoding, &newlineStripper{r: body})
+	case "quoted-printable":
+		r = quotedprintable.NewReader(body)
+	default:
+		r = body
+	}
+
+	content, err := io.ReadAll(io.LimitReader(r, maxPartSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode message part: %w", err)
+	}
+	if len(content) > maxPartSize {
+		return nil, fmt.Errorf("message part exceeds maximum size of %d bytes", maxPartSize)
+	}
+
+	return content, nil
+}
+
+// newlineStripper removes line breaks from base64 encoded content
+type newlineStripper struct {
+	r io.Reader
+}
+
+func (n *newlineStripper) Read(p []byte) (int, error) {
+	buf := make([]byte, len(p))
+	for {
+		c, err := n.r.Read(buf)
+		out := 0
+		for _, b := range buf[:c] {
+			if b != '\r' && b != '\n' && b != ' ' && b != '\t' {
+				p[out] = b
+				out++
+			}
+		}
+		if out > 0 || err != nil {
+			return out, err
+		}
+	}
+}
+
+var (
+	htmlBreakRe = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>|</li>|</tr>`)
+	htmlTagRe   = regexp.MustCompile(`(?s)<[^>]*>`)
+	htmlBlockRe = regexp.MustCompile(`(?is)<(style|script|head)[^>]*>.*?</(style|script|head)>`)
+)
+
+// htmlToText reduces an HTML body to plain text lines
+func htmlToText(s string) string {
+	s = htmlBlockRe.ReplaceAllString(s, "")
+	s = htmlBreakRe.ReplaceAllString(s, "\n")
+	s = htmlTagRe.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+
+	var buf bytes.Buffer
+	for _, line := range strings.Split(s, "\n") {
+		buf.WriteString(strings.TrimSpace(line))
+		buf.WriteString("\n")
+	}
+
+	return strings.TrimSpace(buf.String())
+}
+
+var replyHeaderRe = regexp.MustCompile(`(?i)^(on\s.+wrote:|-+\s*original message\s*-+|from:\s.+)$`)
+
+// ReplyText returns the text the sender wrote, dropping quoted lines, the
+// quoted message that follows a reply header and any signature block.
+func (m *Message) ReplyText() string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(m.Text, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if line == "-- " || trimmed == "--" || replyHeaderRe.MatchString(trimmed) {
+			break
+		}
+		if strings.HasPrefix(trimmed, ">") {
+			continue
+		}
+		lines = append(lines, strings.TrimRight(line, " \t"))
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
@@ -28,6 +28,12 @@ func AccountRequestToRecord(l logger.Logger, r *http.Request, rec *account_recor
 		if req.Timezone != nil {
 			rec.Timezone = sql.NullString{String: *req.Timezone, Valid: true}
 		}
+		if req.Locale != nil {
+			rec.Locale = sql.NullString{String: *req.Locale, Valid: *req.Locale != ""}
+		}
+		if req.TurnReminderOptOut != nil {
+			rec.TurnReminderOptOut = *req.TurnReminderOptOut
+		}
 	case server.HttpMethodPut, server.HttpMethodPatch:
 		rec.Name = convert.String(req.Name)
 		if req.Timezone != nil {
@@ -35,6 +41,14 @@ func AccountRequestToRecord(l logger.Logger, r *http.Request, rec *account_recor
 		} else {
 			rec.Timezone = sql.NullString{}
 		}
+		// The locale is left unchanged when not provided and cleared when empty
+		if req.Locale != nil {
+			rec.Locale = sql.NullString{String: *req.Locale, Valid: *req.Locale != ""}
+		}
+		// The reminder opt out is left unchanged when not provided
+		if req.TurnReminderOptOut != nil {
+			rec.TurnReminderOptOut = *req.TurnReminderOptOut
+		}
 	default:
 		return nil, fmt.Errorf("unsupported HTTP method")
 	}
@@ -48,13 +62,19 @@ func AccountRecordToResponseData(l logger.Logger, rec *account_record.Account) (
 	if rec.Timezone.Valid {
 		timezone = &rec.Timezone.String
 	}
+	var locale *string
+	if rec.Locale.Valid {
+		locale = &rec.Locale.String
+	}
 	return &account_schema.AccountResponseData{
-		ID:        rec.ID,
-		Name:      rec.Name,
-		Status:    rec.Status,
-		Timezone:  timezone,
-		CreatedAt: rec.CreatedAt,
-		UpdatedAt: nulltime.ToTimePtr(rec.UpdatedAt),
+		ID:                 rec.ID,
+		Name:               rec.Name,
+		Status:             rec.Status,
+		Timezone:           timezone,
+		Locale:             locale,
+		TurnReminderOptOut: rec.TurnReminderOptOut,
+		CreatedAt:          rec.CreatedAt,
+		UpdatedAt:          nulltime.ToTimePtr(rec.UpdatedAt),
 	}, nil
 }
 
@@ -0,0 +1,79 @@
+package mapper
+
+import (
+	"net/http"
+
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/account_schema"
+)
+
+func AccountUserAPITokenRequestToRecord(l logger.Logger, r *http.Request, rec *account_record.AccountUserAPIToken) (*account_record.AccountUserAPIToken, error) {
+	l.Debug("mapping account user API token request to record")
+
+	var req account_schema.AccountUserAPITokenRequest
+	_, err := server.ReadRequest(l, r, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.Name = req.Name
+	rec.Permissions = req.Permissions
+	rec.GameIDs = req.GameIDs
+	rec.ExpiresAt = nulltime.FromTimePtr(req.ExpiresAt)
+
+	return rec, nil
+}
+
+// AccountUserAPITokenRecordToResponseData maps an API token record. The token
+// itself is only given when the token has just been created.
+func AccountUserAPITokenRecordToResponseData(l logger.Logger, rec *account_record.AccountUserAPIToken, token string) (*account_schema.AccountUserAPITokenResponseData, error) {
+	l.Debug("mapping account user API token record to response data")
+
+	gameIDs := rec.GameIDs
+	if gameIDs == nil {
+		gameIDs = []string{}
+	}
+
+	return &account_schema.AccountUserAPITokenResponseData{
+		ID:            rec.ID,
+		AccountUserID: rec.AccountUserID,
+		Name:          rec.Name,
+		Token:         token,
+		TokenPrefix:   rec.TokenPrefix,
+		Permissions:   rec.Permissions,
+		GameIDs:       gameIDs,
+		ExpiresAt:     nulltime.ToTimePtr(rec.ExpiresAt),
+		LastUsedAt:    nulltime.ToTimePtr(rec.LastUsedAt),
+		CreatedAt:     rec.CreatedAt,
+		UpdatedAt:     nulltime.ToTimePtr(rec.UpdatedAt),
+	}, nil
+}
+
+func AccountUserAPITokenRecordToResponse(l logger.Logger, rec *account_record.AccountUserAPIToken, token string) (*account_schema.AccountUserAPITokenResponse, error) {
+	l.Debug("mapping account user API token record to response")
+	data, err := AccountUserAPITokenRecordToResponseData(l, rec, token)
+	if err != nil {
+		return nil, err
+	}
+	return &account_schema.AccountUserAPITokenResponse{
+		Data: data,
+	}, nil
+}
+
+func AccountUserAPITokenRecordsToCollectionResponse(l logger.Logger, recs []*account_record.AccountUserAPIToken) (account_schema.AccountUserAPITokenCollectionResponse, error) {
+	l.Debug("mapping account user API token records to collection response")
+	data := []*account_schema.AccountUserAPITokenResponseData{}
+	for _, rec := range recs {
+		d, err := AccountUserAPITokenRecordToResponseData(l, rec, "")
+		if err != nil {
+			return account_schema.AccountUserAPITokenCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return account_schema.AccountUserAPITokenCollectionResponse{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package mapper
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/account_schema"
+)
+
+// AccountUserSessionRecordToResponseData maps a session record, flagging the
+// session the request was authenticated with as current
+func AccountUserSessionRecordToResponseData(l logger.Logger, rec *account_record.AccountUserSession, currentSessionID string) (*account_schema.AccountUserSessionResponseData, error) {
+	l.Debug("mapping account user session record to response data")
+	return &account_schema.AccountUserSessionResponseData{
+		ID:            rec.ID,
+		AccountUserID: rec.AccountUserID,
+		UserAgent:     nullstring.ToString(rec.UserAgent),
+		Current:       rec.ID == currentSessionID,
+		LastSeenAt:    rec.LastSeenAt,
+		ExpiresAt:     rec.ExpiresAt,
+		CreatedAt:     rec.CreatedAt,
+		UpdatedAt:     nulltime.ToTimePtr(rec.UpdatedAt),
+	}, nil
+}
+
+func AccountUserSessionRecordsToCollectionResponse(l logger.Logger, recs []*account_record.AccountUserSession, currentSessionID string) (account_schema.AccountUserSessionCollectionResponse, error) {
+	l.Debug("mapping account user session records to collection response")
+	data := []*account_schema.AccountUserSessionResponseData{}
+	for _, rec := range recs {
+		d, err := AccountUserSessionRecordToResponseData(l, rec, currentSessionID)
+		if err != nil {
+			return account_schema.AccountUserSessionCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return account_schema.AccountUserSessionCollectionResponse{
+		Data: data,
+	}, nil
+}
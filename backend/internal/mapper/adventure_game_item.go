@@ -26,6 +26,7 @@ func AdventureGameItemRequestToRecord(l logger.Logger, r *http.Request, rec *adv
 		rec.Description = req.Description
 		rec.CanBeEquipped = req.CanBeEquipped
 		rec.IsStartingItem = req.IsStartingItem
+		rec.IsGoalItem = req.IsGoalItem
 		if req.ItemCategory != "" {
 			rec.ItemCategory = &req.ItemCategory
 		} else {
@@ -64,6 +65,7 @@ func AdventureGameItemRecordToResponseData(l logger.Logger, rec *adventure_game_
 		ItemCategory:   itemCategory,
 		EquipmentSlot:  equipmentSlot,
 		IsStartingItem: rec.IsStartingItem,
+		IsGoalItem:     rec.IsGoalItem,
 		CreatedAt:      rec.CreatedAt,
 		UpdatedAt:      nulltime.ToTimePtr(rec.UpdatedAt),
 		DeletedAt:      nulltime.ToTimePtr(rec.DeletedAt),
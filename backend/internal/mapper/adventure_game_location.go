@@ -26,10 +26,12 @@ func AdventureGameLocationRequestToRecord(l logger.Logger, r *http.Request, rec
 		rec.Name = req.Name
 		rec.Description = req.Description
 		rec.IsStartingLocation = req.IsStartingLocation
+		rec.IsGoalLocation = req.IsGoalLocation
 	case server.HttpMethodPut, server.HttpMethodPatch:
 		rec.Name = req.Name
 		rec.Description = req.Description
 		rec.IsStartingLocation = req.IsStartingLocation
+		rec.IsGoalLocation = req.IsGoalLocation
 	default:
 		return nil, fmt.Errorf("unsupported HTTP method")
 	}
@@ -45,6 +47,7 @@ func AdventureGameLocationRecordToResponseData(l logger.Logger, rec *adventure_g
 		Name:               rec.Name,
 		Description:        rec.Description,
 		IsStartingLocation: rec.IsStartingLocation,
+		IsGoalLocation:     rec.IsGoalLocation,
 		CreatedAt:          rec.CreatedAt,
 		UpdatedAt:          nulltime.ToTimePtr(rec.UpdatedAt),
 		DeletedAt:          nulltime.ToTimePtr(rec.DeletedAt),
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"gitlab.com/alienspaces/playbymail/core/nullint64"
 	"gitlab.com/alienspaces/playbymail/core/nullstring"
 	"gitlab.com/alienspaces/playbymail/core/nulltime"
 	"gitlab.com/alienspaces/playbymail/core/server"
@@ -43,6 +44,10 @@ func GameInstanceRequestToRecord(l logger.Logger, r *http.Request, rec *game_rec
 		}
 		rec.IsClosedTesting = req.IsClosedTesting
 		rec.ProcessWhenAllSubmitted = req.ProcessWhenAllSubmitted
+		rec.MaxTurns = nullint64.FromInt64Ptr(req.MaxTurns)
+		rec.MissedTurnPolicy = req.MissedTurnPolicy
+		rec.MissedTurnTakeoverAfter = nullint64.FromInt64Ptr(req.MissedTurnTakeoverAfter)
+		rec.MissedTurnDropAfter = nullint64.FromInt64Ptr(req.MissedTurnDropAfter)
 	case server.HttpMethodPut, server.HttpMethodPatch:
 		if req.TurnDurationHours != 0 {
 			rec.TurnDurationHours = req.TurnDurationHours
@@ -68,6 +73,12 @@ func GameInstanceRequestToRecord(l logger.Logger, r *http.Request, rec *game_rec
 		}
 		rec.IsClosedTesting = req.IsClosedTesting
 		rec.ProcessWhenAllSubmitted = req.ProcessWhenAllSubmitted
+		rec.MaxTurns = nullint64.FromInt64Ptr(req.MaxTurns)
+		if req.MissedTurnPolicy != "" {
+			rec.MissedTurnPolicy = req.MissedTurnPolicy
+		}
+		rec.MissedTurnTakeoverAfter = nullint64.FromInt64Ptr(req.MissedTurnTakeoverAfter)
+		rec.MissedTurnDropAfter = nullint64.FromInt64Ptr(req.MissedTurnDropAfter)
 	default:
 		return nil, fmt.Errorf("unsupported HTTP method")
 	}
@@ -77,6 +88,22 @@ func GameInstanceRequestToRecord(l logger.Logger, r *http.Request, rec *game_rec
 
 func GameInstanceRecordToResponseData(l logger.Logger, rec *game_record.GameInstance, playerCount int, gameSubscriptionInstanceID *string) (*game_schema.GameInstanceResponseData, error) {
 	l.Debug("mapping game_instance record to response data")
+
+	var maxTurns *int64
+	if rec.MaxTurns.Valid {
+		maxTurns = &rec.MaxTurns.Int64
+	}
+
+	var missedTurnTakeoverAfter *int64
+	if rec.MissedTurnTakeoverAfter.Valid {
+		missedTurnTakeoverAfter = &rec.MissedTurnTakeoverAfter.Int64
+	}
+
+	var missedTurnDropAfter *int64
+	if rec.MissedTurnDropAfter.Valid {
+		missedTurnDropAfter = &rec.MissedTurnDropAfter.Int64
+	}
+
 	return &game_schema.GameInstanceResponseData{
 		ID:                                rec.ID,
 		GameID:                            rec.GameID,
@@ -95,6 +122,10 @@ func GameInstanceRecordToResponseData(l logger.Logger, rec *game_record.GameInst
 		PlayerCount:                       playerCount,
 		IsClosedTesting:                   rec.IsClosedTesting,
 		ProcessWhenAllSubmitted:           rec.ProcessWhenAllSubmitted,
+		MaxTurns:                          maxTurns,
+		MissedTurnPolicy:                  rec.MissedTurnPolicy,
+		MissedTurnTakeoverAfter:           missedTurnTakeoverAfter,
+		MissedTurnDropAfter:               missedTurnDropAfter,
 		ClosedTestingJoinGameKey:          nullstring.ToStringPtr(rec.ClosedTestingJoinGameKey),
 		ClosedTestingJoinGameKeyExpiresAt: nulltime.ToTimePtr(rec.ClosedTestingJoinGameKeyExpiresAt),
 		CreatedAt:                         rec.CreatedAt,
@@ -14,6 +14,8 @@ func GameParameterRecordToResponseData(l logger.Logger, rec *game_record.GamePar
 		Description:  &rec.Description,
 		ValueType:    rec.ValueType,
 		DefaultValue: &rec.DefaultValue,
+		MinValue:     rec.MinValue,
+		MaxValue:     rec.MaxValue,
 	}, nil
 }
 
@@ -0,0 +1,88 @@
+package mapper
+
+import (
+	"net/http"
+
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/game_schema"
+)
+
+// GamePrintBatchReprintRequestToRecord maps a reprint request to a new pending
+// print batch record for the same game instance turn as the source batch
+func GamePrintBatchReprintRequestToRecord(l logger.Logger, r *http.Request, sourceRec *game_record.GamePrintBatch) (*game_record.GamePrintBatch, error) {
+	l.Debug("mapping game_print_batch reprint request to record")
+
+	var req game_schema.GamePrintBatchReprintRequest
+	_, err := server.ReadRequest(l, r, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	rec := &game_record.GamePrintBatch{
+		GameID:                     sourceRec.GameID,
+		GameInstanceID:             sourceRec.GameInstanceID,
+		GameSubscriptionInstanceID: sourceRec.GameSubscriptionInstanceID,
+		TurnNumber:                 sourceRec.TurnNumber,
+		Status:                     game_record.GamePrintBatchStatusPending,
+	}
+
+	if req.GameSubscriptionInstanceID != "" {
+		rec.GameSubscriptionInstanceID = nullstring.FromString(req.GameSubscriptionInstanceID)
+	}
+
+	return rec, nil
+}
+
+func GamePrintBatchRecordToResponseData(l logger.Logger, rec *game_record.GamePrintBatch) (*game_schema.GamePrintBatch, error) {
+	l.Debug("mapping game_print_batch record to response data")
+	data := &game_schema.GamePrintBatch{
+		ID:                         rec.ID,
+		GameID:                     rec.GameID,
+		GameInstanceID:             rec.GameInstanceID,
+		GameSubscriptionInstanceID: nullstring.ToString(rec.GameSubscriptionInstanceID),
+		TurnNumber:                 rec.TurnNumber,
+		Status:                     rec.Status,
+		PlayerCount:                rec.PlayerCount,
+		DocumentCount:              rec.DocumentCount,
+		PrinterJobID:               nullstring.ToString(rec.PrinterJobID),
+		PrinterJobState:            nullstring.ToString(rec.PrinterJobState),
+		PrinterJobStateMessage:     nullstring.ToString(rec.PrinterJobStateMessage),
+		ErrorMessage:               nullstring.ToString(rec.ErrorMessage),
+		SubmittedAt:                nulltime.ToTimePtr(rec.SubmittedAt),
+		CompletedAt:                nulltime.ToTimePtr(rec.CompletedAt),
+		CreatedAt:                  rec.CreatedAt,
+		UpdatedAt:                  nulltime.ToTimePtr(rec.UpdatedAt),
+	}
+
+	return data, nil
+}
+
+func GamePrintBatchRecordToResponse(l logger.Logger, rec *game_record.GamePrintBatch) (*game_schema.GamePrintBatchResponse, error) {
+	l.Debug("mapping game_print_batch record to response")
+	data, err := GamePrintBatchRecordToResponseData(l, rec)
+	if err != nil {
+		return nil, err
+	}
+	return &game_schema.GamePrintBatchResponse{
+		Data: data,
+	}, nil
+}
+
+func GamePrintBatchRecsToCollectionResponse(l logger.Logger, recs []*game_record.GamePrintBatch) (game_schema.GamePrintBatchCollectionResponse, error) {
+	l.Debug("mapping game_print_batch records to collection response")
+	data := []*game_schema.GamePrintBatch{}
+	for _, rec := range recs {
+		d, err := GamePrintBatchRecordToResponseData(l, rec)
+		if err != nil {
+			return game_schema.GamePrintBatchCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return game_schema.GamePrintBatchCollectionResponse{
+		Data: data,
+	}, nil
+}
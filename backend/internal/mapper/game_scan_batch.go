@@ -0,0 +1,92 @@
+package mapper
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/game_schema"
+)
+
+func GameScanBatchRecordToResponseData(l logger.Logger, rec *game_record.GameScanBatch) (*game_schema.GameScanBatch, error) {
+	l.Debug("mapping game_scan_batch record to response data")
+	data := &game_schema.GameScanBatch{
+		ID:              rec.ID,
+		GameID:          rec.GameID,
+		GameInstanceID:  rec.GameInstanceID,
+		Status:          rec.Status,
+		FileName:        rec.FileName,
+		PageCount:       rec.PageCount,
+		PagesProcessed:  rec.PagesProcessed,
+		MatchedCount:    rec.MatchedCount,
+		DuplicateCount:  rec.DuplicateCount,
+		UnreadableCount: rec.UnreadableCount,
+		WrongTurnCount:  rec.WrongTurnCount,
+		WrongGameCount:  rec.WrongGameCount,
+		ErrorMessage:    nullstring.ToString(rec.ErrorMessage),
+		CompletedAt:     nulltime.ToTimePtr(rec.CompletedAt),
+		CreatedAt:       rec.CreatedAt,
+		UpdatedAt:       nulltime.ToTimePtr(rec.UpdatedAt),
+	}
+
+	return data, nil
+}
+
+func GameScanBatchRecordToResponse(l logger.Logger, rec *game_record.GameScanBatch) (*game_schema.GameScanBatchResponse, error) {
+	l.Debug("mapping game_scan_batch record to response")
+	data, err := GameScanBatchRecordToResponseData(l, rec)
+	if err != nil {
+		return nil, err
+	}
+	return &game_schema.GameScanBatchResponse{
+		Data: data,
+	}, nil
+}
+
+func GameScanBatchRecsToCollectionResponse(l logger.Logger, recs []*game_record.GameScanBatch) (game_schema.GameScanBatchCollectionResponse, error) {
+	l.Debug("mapping game_scan_batch records to collection response")
+	data := []*game_schema.GameScanBatch{}
+	for _, rec := range recs {
+		d, err := GameScanBatchRecordToResponseData(l, rec)
+		if err != nil {
+			return game_schema.GameScanBatchCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return game_schema.GameScanBatchCollectionResponse{
+		Data: data,
+	}, nil
+}
+
+func GameScanBatchPageRecordToResponseData(l logger.Logger, rec *game_record.GameScanBatchPage) (*game_schema.GameScanBatchPage, error) {
+	l.Debug("mapping game_scan_batch_page record to response data")
+	data := &game_schema.GameScanBatchPage{
+		ID:              rec.ID,
+		GameScanBatchID: rec.GameScanBatchID,
+		PageNumber:      rec.PageNumber,
+		Source:          rec.Source,
+		Status:          rec.Status,
+		GameTurnSheetID: nullstring.ToString(rec.GameTurnSheetID),
+		SheetType:       nullstring.ToString(rec.SheetType),
+		Message:         nullstring.ToString(rec.Message),
+		CreatedAt:       rec.CreatedAt,
+		UpdatedAt:       nulltime.ToTimePtr(rec.UpdatedAt),
+	}
+
+	return data, nil
+}
+
+func GameScanBatchPageRecsToCollectionResponse(l logger.Logger, recs []*game_record.GameScanBatchPage) (game_schema.GameScanBatchPageCollectionResponse, error) {
+	l.Debug("mapping game_scan_batch_page records to collection response")
+	data := []*game_schema.GameScanBatchPage{}
+	for _, rec := range recs {
+		d, err := GameScanBatchPageRecordToResponseData(l, rec)
+		if err != nil {
+			return game_schema.GameScanBatchPageCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return game_schema.GameScanBatchPageCollectionResponse{
+		Data: data,
+	}, nil
+}
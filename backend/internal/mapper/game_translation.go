@@ -0,0 +1,75 @@
+package mapper
+
+import (
+	"fmt"
+	"net/http"
+
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/game_schema"
+)
+
+// GameTranslationRequestToRecord maps a game translation request to a record
+func GameTranslationRequestToRecord(l logger.Logger, r *http.Request, rec *game_record.GameTranslation) (*game_record.GameTranslation, error) {
+	l.Debug("mapping game_translation request to record")
+
+	var req game_schema.GameTranslationRequest
+	_, err := server.ReadRequest(l, r, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch server.HttpMethod(r.Method) {
+	case server.HttpMethodPost, server.HttpMethodPut, server.HttpMethodPatch:
+		rec.Locale = req.Locale
+		rec.RecordID = req.RecordID
+		rec.FieldName = req.FieldName
+		rec.Text = req.Text
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method")
+	}
+
+	return rec, nil
+}
+
+func GameTranslationRecordToResponseData(l logger.Logger, rec *game_record.GameTranslation) (*game_schema.GameTranslation, error) {
+	l.Debug("mapping game_translation record to response data")
+	return &game_schema.GameTranslation{
+		ID:        rec.ID,
+		GameID:    rec.GameID,
+		Locale:    rec.Locale,
+		RecordID:  rec.RecordID,
+		FieldName: rec.FieldName,
+		Text:      rec.Text,
+		CreatedAt: rec.CreatedAt,
+		UpdatedAt: nulltime.ToTimePtr(rec.UpdatedAt),
+	}, nil
+}
+
+func GameTranslationRecordToResponse(l logger.Logger, rec *game_record.GameTranslation) (*game_schema.GameTranslationResponse, error) {
+	l.Debug("mapping game_translation record to response")
+	data, err := GameTranslationRecordToResponseData(l, rec)
+	if err != nil {
+		return nil, err
+	}
+	return &game_schema.GameTranslationResponse{
+		Data: data,
+	}, nil
+}
+
+func GameTranslationRecsToCollectionResponse(l logger.Logger, recs []*game_record.GameTranslation) (game_schema.GameTranslationCollectionResponse, error) {
+	l.Debug("mapping game_translation records to collection response")
+	data := []*game_schema.GameTranslation{}
+	for _, rec := range recs {
+		d, err := GameTranslationRecordToResponseData(l, rec)
+		if err != nil {
+			return game_schema.GameTranslationCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return game_schema.GameTranslationCollectionResponse{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package mapper
+
+import (
+	"encoding/json"
+
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/game_schema"
+)
+
+// GameTurnSheetReviewToResponseData maps a turn sheet and its scan to review response
+// data. The scan is nil when the turn sheet has no recorded scan image.
+func GameTurnSheetReviewToResponseData(l logger.Logger, rec *game_record.GameTurnSheet, scanRec *game_record.GameTurnSheetScan) (*game_schema.GameTurnSheetReview, error) {
+	l.Debug("mapping game_turn_sheet record to review response data")
+	data := &game_schema.GameTurnSheetReview{
+		GameTurnSheetID:  rec.ID,
+		GameInstanceID:   nullstring.ToString(rec.GameInstanceID),
+		AccountUserID:    rec.AccountUserID,
+		TurnNumber:       rec.TurnNumber,
+		SheetType:        rec.SheetType,
+		ProcessingStatus: rec.ProcessingStatus,
+		SheetData:        rec.SheetData,
+		ScannedData:      rec.ScannedData,
+		ScannedAt:        nulltime.ToTimePtr(rec.ScannedAt),
+		Issues:           []game_schema.GameTurnSheetReviewIssue{},
+	}
+
+	if scanRec != nil {
+		data.HasImage = len(scanRec.ImageData) > 0
+		data.Confidence = scanRec.Confidence
+		data.ReviewedByAccountUserID = nullstring.ToString(scanRec.ReviewedByAccountUserID)
+		data.ReviewedAt = nulltime.ToTimePtr(scanRec.ReviewedAt)
+		if len(scanRec.Issues) > 0 {
+			if err := json.Unmarshal(scanRec.Issues, &data.Issues); err != nil {
+				l.Warn("failed to unmarshal scan issues >%v<", err)
+				return nil, err
+			}
+		}
+	}
+
+	return data, nil
+}
+
+func GameTurnSheetReviewToResponse(l logger.Logger, rec *game_record.GameTurnSheet, scanRec *game_record.GameTurnSheetScan) (*game_schema.GameTurnSheetReviewResponse, error) {
+	l.Debug("mapping game_turn_sheet record to review response")
+	data, err := GameTurnSheetReviewToResponseData(l, rec, scanRec)
+	if err != nil {
+		return nil, err
+	}
+	return &game_schema.GameTurnSheetReviewResponse{
+		Data: data,
+	}, nil
+}
+
+// GameTurnSheetReviewsToCollectionResponse maps turn sheets and their scans, keyed by
+// turn sheet ID, to a review collection response
+func GameTurnSheetReviewsToCollectionResponse(l logger.Logger, recs []*game_record.GameTurnSheet, scanRecs map[string]*game_record.GameTurnSheetScan) (game_schema.GameTurnSheetReviewCollectionResponse, error) {
+	l.Debug("mapping game_turn_sheet records to review collection response")
+	data := []*game_schema.GameTurnSheetReview{}
+	for _, rec := range recs {
+		d, err := GameTurnSheetReviewToResponseData(l, rec, scanRecs[rec.ID])
+		if err != nil {
+			return game_schema.GameTurnSheetReviewCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return game_schema.GameTurnSheetReviewCollectionResponse{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,185 @@
+package mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/mecha_game_schema"
+)
+
+func MechaGameCampaignRequestToRecord(l logger.Logger, r *http.Request, rec *mecha_game_record.MechaGameCampaign) (*mecha_game_record.MechaGameCampaign, error) {
+	l.Debug("mapping mecha_game_campaign request to record")
+
+	var req mecha_game_schema.MechaGameCampaignRequest
+	_, err := server.ReadRequest(l, r, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch server.HttpMethod(r.Method) {
+	case server.HttpMethodPost, server.HttpMethodPut, server.HttpMethodPatch:
+		rec.Name = req.Name
+		rec.Description = req.Description
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method")
+	}
+
+	return rec, nil
+}
+
+func MechaGameCampaignRecordToResponseData(l logger.Logger, rec *mecha_game_record.MechaGameCampaign) (*mecha_game_schema.MechaGameCampaignResponseData, error) {
+	l.Debug("mapping mecha_game_campaign record to response data")
+	return &mecha_game_schema.MechaGameCampaignResponseData{
+		ID:            rec.ID,
+		AccountUserID: rec.AccountUserID,
+		Name:          rec.Name,
+		Description:   rec.Description,
+		CreatedAt:     rec.CreatedAt,
+		UpdatedAt:     nulltime.ToTimePtr(rec.UpdatedAt),
+		DeletedAt:     nulltime.ToTimePtr(rec.DeletedAt),
+	}, nil
+}
+
+func MechaGameCampaignRecordToResponse(l logger.Logger, rec *mecha_game_record.MechaGameCampaign) (*mecha_game_schema.MechaGameCampaignResponse, error) {
+	l.Debug("mapping mecha_game_campaign record to response")
+	data, err := MechaGameCampaignRecordToResponseData(l, rec)
+	if err != nil {
+		return nil, err
+	}
+	return &mecha_game_schema.MechaGameCampaignResponse{
+		Data: data,
+	}, nil
+}
+
+func MechaGameCampaignRecordsToCollectionResponse(l logger.Logger, recs []*mecha_game_record.MechaGameCampaign) (mecha_game_schema.MechaGameCampaignCollectionResponse, error) {
+	l.Debug("mapping mecha_game_campaign records to collection response")
+	data := []*mecha_game_schema.MechaGameCampaignResponseData{}
+	for _, rec := range recs {
+		d, err := MechaGameCampaignRecordToResponseData(l, rec)
+		if err != nil {
+			return mecha_game_schema.MechaGameCampaignCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return mecha_game_schema.MechaGameCampaignCollectionResponse{
+		Data: data,
+	}, nil
+}
+
+func MechaGameCampaignInstanceRequestToRecord(l logger.Logger, r *http.Request, rec *mecha_game_record.MechaGameCampaignInstance) (*mecha_game_record.MechaGameCampaignInstance, error) {
+	l.Debug("mapping mecha_game_campaign_instance request to record")
+
+	var req mecha_game_schema.MechaGameCampaignInstanceRequest
+	_, err := server.ReadRequest(l, r, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch server.HttpMethod(r.Method) {
+	case server.HttpMethodPost:
+		rec.GameInstanceID = req.GameInstanceID
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method")
+	}
+
+	return rec, nil
+}
+
+func MechaGameCampaignInstanceRecordToResponseData(l logger.Logger, rec *mecha_game_record.MechaGameCampaignInstance) (*mecha_game_schema.MechaGameCampaignInstanceResponseData, error) {
+	l.Debug("mapping mecha_game_campaign_instance record to response data")
+	return &mecha_game_schema.MechaGameCampaignInstanceResponseData{
+		ID:                  rec.ID,
+		MechaGameCampaignID: rec.MechaGameCampaignID,
+		GameInstanceID:      rec.GameInstanceID,
+		SequenceNumber:      rec.SequenceNumber,
+		RecordedAt:          nulltime.ToTimePtr(rec.RecordedAt),
+		CreatedAt:           rec.CreatedAt,
+		UpdatedAt:           nulltime.ToTimePtr(rec.UpdatedAt),
+		DeletedAt:           nulltime.ToTimePtr(rec.DeletedAt),
+	}, nil
+}
+
+func MechaGameCampaignInstanceRecordToResponse(l logger.Logger, rec *mecha_game_record.MechaGameCampaignInstance) (*mecha_game_schema.MechaGameCampaignInstanceResponse, error) {
+	l.Debug("mapping mecha_game_campaign_instance record to response")
+	data, err := MechaGameCampaignInstanceRecordToResponseData(l, rec)
+	if err != nil {
+		return nil, err
+	}
+	return &mecha_game_schema.MechaGameCampaignInstanceResponse{
+		Data: data,
+	}, nil
+}
+
+func MechaGameCampaignInstanceRecordsToCollectionResponse(l logger.Logger, recs []*mecha_game_record.MechaGameCampaignInstance) (mecha_game_schema.MechaGameCampaignInstanceCollectionResponse, error) {
+	l.Debug("mapping mecha_game_campaign_instance records to collection response")
+	data := []*mecha_game_schema.MechaGameCampaignInstanceResponseData{}
+	for _, rec := range recs {
+		d, err := MechaGameCampaignInstanceRecordToResponseData(l, rec)
+		if err != nil {
+			return mecha_game_schema.MechaGameCampaignInstanceCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return mecha_game_schema.MechaGameCampaignInstanceCollectionResponse{
+		Data: data,
+	}, nil
+}
+
+// MechaGameCampaignSquadRecordToResponseData maps a campaign squad roster and
+// its mechs to response data.
+func MechaGameCampaignSquadRecordToResponseData(l logger.Logger, rec *mecha_game_record.MechaGameCampaignSquad, mechRecs []*mecha_game_record.MechaGameCampaignMech) (*mecha_game_schema.MechaGameCampaignSquadResponseData, error) {
+	l.Debug("mapping mecha_game_campaign_squad record to response data")
+
+	salvage := []mecha_game_schema.MechaGameCampaignSalvageItem{}
+	if len(rec.Salvage) > 0 {
+		if err := json.Unmarshal(rec.Salvage, &salvage); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal salvage for campaign squad >%s<: %w", rec.ID, err)
+		}
+	}
+
+	mechs := []mecha_game_schema.MechaGameCampaignMechResponseData{}
+	for _, mechRec := range mechRecs {
+		weapons := []mecha_game_schema.MechaGameCampaignMechWeapon{}
+		if len(mechRec.WeaponConfig) > 0 {
+			if err := json.Unmarshal(mechRec.WeaponConfig, &weapons); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal weapon config for campaign mech >%s<: %w", mechRec.ID, err)
+			}
+		}
+		equipment := []mecha_game_schema.MechaGameCampaignMechEquipment{}
+		if len(mechRec.EquipmentConfig) > 0 {
+			if err := json.Unmarshal(mechRec.EquipmentConfig, &equipment); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal equipment config for campaign mech >%s<: %w", mechRec.ID, err)
+			}
+		}
+		mechs = append(mechs, mecha_game_schema.MechaGameCampaignMechResponseData{
+			ID:               mechRec.ID,
+			Callsign:         mechRec.Callsign,
+			ChassisName:      mechRec.ChassisName,
+			CurrentArmor:     mechRec.CurrentArmor,
+			CurrentStructure: mechRec.CurrentStructure,
+			PilotSkill:       mechRec.PilotSkill,
+			ExperiencePoints: mechRec.ExperiencePoints,
+			Weapons:          weapons,
+			Equipment:        equipment,
+			BattlesSurvived:  mechRec.BattlesSurvived,
+		})
+	}
+
+	return &mecha_game_schema.MechaGameCampaignSquadResponseData{
+		ID:                  rec.ID,
+		MechaGameCampaignID: rec.MechaGameCampaignID,
+		AccountUserID:       rec.AccountUserID,
+		SupplyPoints:        rec.SupplyPoints,
+		Salvage:             salvage,
+		BattlesFought:       rec.BattlesFought,
+		Mechs:               mechs,
+		CreatedAt:           rec.CreatedAt,
+		UpdatedAt:           nulltime.ToTimePtr(rec.UpdatedAt),
+		DeletedAt:           nulltime.ToTimePtr(rec.DeletedAt),
+	}, nil
+}
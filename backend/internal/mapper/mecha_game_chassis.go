@@ -44,6 +44,11 @@ func MechaGameChassisRequestToRecord(l logger.Logger, r *http.Request, rec *mech
 		if rec.Speed == 0 {
 			rec.Speed = 3
 		}
+		if req.SensorRange != nil {
+			rec.SensorRange = *req.SensorRange
+		} else if server.HttpMethod(r.Method) == server.HttpMethodPost {
+			rec.SensorRange = mecha_game_record.DefaultChassisSensorRange
+		}
 		rec.SmallSlots = req.SmallSlots
 		rec.MediumSlots = req.MediumSlots
 		rec.LargeSlots = req.LargeSlots
@@ -75,6 +80,7 @@ func MechaGameChassisRecordToResponseData(l logger.Logger, rec *mecha_game_recor
 		SmallSlots:      rec.SmallSlots,
 		MediumSlots:     rec.MediumSlots,
 		LargeSlots:      rec.LargeSlots,
+		SensorRange:     rec.SensorRange,
 		CreatedAt:       rec.CreatedAt,
 		UpdatedAt:       nulltime.ToTimePtr(rec.UpdatedAt),
 		DeletedAt:       nulltime.ToTimePtr(rec.DeletedAt),
@@ -31,6 +31,9 @@ func MechaGameSectorRequestToRecord(l logger.Logger, r *http.Request, rec *mecha
 		rec.Elevation = req.Elevation
 		rec.CoverModifier = req.CoverModifier
 		rec.IsStartingSector = req.IsStartingSector
+		rec.IsObjectiveSector = req.IsObjectiveSector
+		rec.VictoryPoints = req.VictoryPoints
+		rec.CaptureTurns = req.CaptureTurns
 	default:
 		return nil, fmt.Errorf("unsupported HTTP method")
 	}
@@ -41,17 +44,20 @@ func MechaGameSectorRequestToRecord(l logger.Logger, r *http.Request, rec *mecha
 func MechaGameSectorRecordToResponseData(l logger.Logger, rec *mecha_game_record.MechaGameSector) (*mecha_game_schema.MechaGameSectorResponseData, error) {
 	l.Debug("mapping mecha_game_sector record to response data")
 	return &mecha_game_schema.MechaGameSectorResponseData{
-		ID:               rec.ID,
-		GameID:           rec.GameID,
-		Name:             rec.Name,
-		Description:      rec.Description,
-		TerrainType:      rec.TerrainType,
-		Elevation:        rec.Elevation,
-		CoverModifier:    rec.CoverModifier,
-		IsStartingSector: rec.IsStartingSector,
-		CreatedAt:        rec.CreatedAt,
-		UpdatedAt:        nulltime.ToTimePtr(rec.UpdatedAt),
-		DeletedAt:        nulltime.ToTimePtr(rec.DeletedAt),
+		ID:                rec.ID,
+		GameID:            rec.GameID,
+		Name:              rec.Name,
+		Description:       rec.Description,
+		TerrainType:       rec.TerrainType,
+		Elevation:         rec.Elevation,
+		CoverModifier:     rec.CoverModifier,
+		IsStartingSector:  rec.IsStartingSector,
+		IsObjectiveSector: rec.IsObjectiveSector,
+		VictoryPoints:     rec.VictoryPoints,
+		CaptureTurns:      rec.CaptureTurns,
+		CreatedAt:         rec.CreatedAt,
+		UpdatedAt:         nulltime.ToTimePtr(rec.UpdatedAt),
+		DeletedAt:         nulltime.ToTimePtr(rec.DeletedAt),
 	}, nil
 }
 
@@ -0,0 +1,97 @@
+package mapper
+
+import (
+	"fmt"
+	"net/http"
+
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/mecha_tactics_game_schema"
+)
+
+func MechaTacticsGameChassisRequestToRecord(l logger.Logger, r *http.Request, rec *mecha_tactics_game_record.MechaTacticsGameChassis) (*mecha_tactics_game_record.MechaTacticsGameChassis, error) {
+	l.Debug("mapping mecha_tactics_game_chassis request to record")
+
+	var req mecha_tactics_game_schema.MechaTacticsGameChassisRequest
+	_, err := server.ReadRequest(l, r, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch server.HttpMethod(r.Method) {
+	case server.HttpMethodPost, server.HttpMethodPut, server.HttpMethodPatch:
+		rec.Name = req.Name
+		rec.Description = req.Description
+		rec.ChassisClass = req.ChassisClass
+		if rec.ChassisClass == "" {
+			rec.ChassisClass = mecha_tactics_game_record.ChassisClassMedium
+		}
+		rec.ArmorPoints = req.ArmorPoints
+		if rec.ArmorPoints == 0 {
+			rec.ArmorPoints = 100
+		}
+		rec.StructurePoints = req.StructurePoints
+		if rec.StructurePoints == 0 {
+			rec.StructurePoints = 50
+		}
+		rec.HeatCapacity = req.HeatCapacity
+		if rec.HeatCapacity == 0 {
+			rec.HeatCapacity = 30
+		}
+		rec.Speed = req.Speed
+		if rec.Speed == 0 {
+			rec.Speed = 3
+		}
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method")
+	}
+
+	return rec, nil
+}
+
+func MechaTacticsGameChassisRecordToResponseData(l logger.Logger, rec *mecha_tactics_game_record.MechaTacticsGameChassis) (*mecha_tactics_game_schema.MechaTacticsGameChassisResponseData, error) {
+	l.Debug("mapping mecha_tactics_game_chassis record to response data")
+
+	return &mecha_tactics_game_schema.MechaTacticsGameChassisResponseData{
+		ID:              rec.ID,
+		GameID:          rec.GameID,
+		Name:            rec.Name,
+		Description:     rec.Description,
+		ChassisClass:    rec.ChassisClass,
+		ArmorPoints:     rec.ArmorPoints,
+		StructurePoints: rec.StructurePoints,
+		HeatCapacity:    rec.HeatCapacity,
+		Speed:           rec.Speed,
+		CreatedAt:       rec.CreatedAt,
+		UpdatedAt:       nulltime.ToTimePtr(rec.UpdatedAt),
+		DeletedAt:       nulltime.ToTimePtr(rec.DeletedAt),
+	}, nil
+}
+
+func MechaTacticsGameChassisRecordToResponse(l logger.Logger, rec *mecha_tactics_game_record.MechaTacticsGameChassis) (*mecha_tactics_game_schema.MechaTacticsGameChassisResponse, error) {
+	l.Debug("mapping mecha_tactics_game_chassis record to response")
+	data, err := MechaTacticsGameChassisRecordToResponseData(l, rec)
+	if err != nil {
+		return nil, err
+	}
+	return &mecha_tactics_game_schema.MechaTacticsGameChassisResponse{
+		Data: data,
+	}, nil
+}
+
+func MechaTacticsGameChassisRecordsToCollectionResponse(l logger.Logger, recs []*mecha_tactics_game_record.MechaTacticsGameChassis) (mecha_tactics_game_schema.MechaTacticsGameChassisCollectionResponse, error) {
+	l.Debug("mapping mecha_tactics_game_chassis records to collection response")
+	data := []*mecha_tactics_game_schema.MechaTacticsGameChassisResponseData{}
+	for _, rec := range recs {
+		d, err := MechaTacticsGameChassisRecordToResponseData(l, rec)
+		if err != nil {
+			return mecha_tactics_game_schema.MechaTacticsGameChassisCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return mecha_tactics_game_schema.MechaTacticsGameChassisCollectionResponse{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package mapper
+
+import (
+	"fmt"
+	"net/http"
+
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/mecha_tactics_game_schema"
+)
+
+func MechaTacticsGameComputerOpponentRequestToRecord(l logger.Logger, r *http.Request, rec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent) (*mecha_tactics_game_record.MechaTacticsGameComputerOpponent, error) {
+	l.Debug("mapping mecha_tactics_game_computer_opponent request to record")
+
+	var req mecha_tactics_game_schema.MechaTacticsGameComputerOpponentRequest
+	_, err := server.ReadRequest(l, r, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch server.HttpMethod(r.Method) {
+	case server.HttpMethodPost, server.HttpMethodPut, server.HttpMethodPatch:
+		rec.Name = req.Name
+		rec.Description = req.Description
+		rec.Aggression = req.Aggression
+		rec.IQ = req.IQ
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method")
+	}
+
+	return rec, nil
+}
+
+func MechaTacticsGameComputerOpponentRecordToResponseData(l logger.Logger, rec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent) (*mecha_tactics_game_schema.MechaTacticsGameComputerOpponentResponseData, error) {
+	l.Debug("mapping mecha_tactics_game_computer_opponent record to response data")
+
+	return &mecha_tactics_game_schema.MechaTacticsGameComputerOpponentResponseData{
+		ID:          rec.ID,
+		GameID:      rec.GameID,
+		Name:        rec.Name,
+		Description: rec.Description,
+		Aggression:  rec.Aggression,
+		IQ:          rec.IQ,
+		CreatedAt:   rec.CreatedAt,
+		UpdatedAt:   nulltime.ToTimePtr(rec.UpdatedAt),
+		DeletedAt:   nulltime.ToTimePtr(rec.DeletedAt),
+	}, nil
+}
+
+func MechaTacticsGameComputerOpponentRecordToResponse(l logger.Logger, rec *mecha_tactics_game_record.MechaTacticsGameComputerOpponent) (*mecha_tactics_game_schema.MechaTacticsGameComputerOpponentResponse, error) {
+	l.Debug("mapping mecha_tactics_game_computer_opponent record to response")
+	data, err := MechaTacticsGameComputerOpponentRecordToResponseData(l, rec)
+	if err != nil {
+		return nil, err
+	}
+	return &mecha_tactics_game_schema.MechaTacticsGameComputerOpponentResponse{
+		Data: data,
+	}, nil
+}
+
+func MechaTacticsGameComputerOpponentRecordsToCollectionResponse(l logger.Logger, recs []*mecha_tactics_game_record.MechaTacticsGameComputerOpponent) (mecha_tactics_game_schema.MechaTacticsGameComputerOpponentCollectionResponse, error) {
+	l.Debug("mapping mecha_tactics_game_computer_opponent records to collection response")
+	data := []*mecha_tactics_game_schema.MechaTacticsGameComputerOpponentResponseData{}
+	for _, rec := range recs {
+		d, err := MechaTacticsGameComputerOpponentRecordToResponseData(l, rec)
+		if err != nil {
+			return mecha_tactics_game_schema.MechaTacticsGameComputerOpponentCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return mecha_tactics_game_schema.MechaTacticsGameComputerOpponentCollectionResponse{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package mapper
+
+import (
+	"fmt"
+	"net/http"
+
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/mecha_tactics_game_schema"
+)
+
+func MechaTacticsGameHexRequestToRecord(l logger.Logger, r *http.Request, rec *mecha_tactics_game_record.MechaTacticsGameHex) (*mecha_tactics_game_record.MechaTacticsGameHex, error) {
+	l.Debug("mapping mecha_tactics_game_hex request to record")
+
+	var req mecha_tactics_game_schema.MechaTacticsGameHexRequest
+	_, err := server.ReadRequest(l, r, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch server.HttpMethod(r.Method) {
+	case server.HttpMethodPost, server.HttpMethodPut, server.HttpMethodPatch:
+		rec.MechaTacticsGameTerrainTypeID = req.MechaTacticsGameTerrainTypeID
+		rec.HexColumn = req.HexColumn
+		rec.HexRow = req.HexRow
+		rec.Name = req.Name
+		rec.Description = req.Description
+		rec.Elevation = req.Elevation
+		rec.IsStartingHex = req.IsStartingHex
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method")
+	}
+
+	return rec, nil
+}
+
+func MechaTacticsGameHexRecordToResponseData(l logger.Logger, rec *mecha_tactics_game_record.MechaTacticsGameHex) (*mecha_tactics_game_schema.MechaTacticsGameHexResponseData, error) {
+	l.Debug("mapping mecha_tactics_game_hex record to response data")
+
+	return &mecha_tactics_game_schema.MechaTacticsGameHexResponseData{
+		ID:                            rec.ID,
+		GameID:                        rec.GameID,
+		MechaTacticsGameTerrainTypeID: rec.MechaTacticsGameTerrainTypeID,
+		HexColumn:                     rec.HexColumn,
+		HexRow:                        rec.HexRow,
+		Name:                          rec.Name,
+		Description:                   rec.Description,
+		Elevation:                     rec.Elevation,
+		IsStartingHex:                 rec.IsStartingHex,
+		CreatedAt:                     rec.CreatedAt,
+		UpdatedAt:                     nulltime.ToTimePtr(rec.UpdatedAt),
+		DeletedAt:                     nulltime.ToTimePtr(rec.DeletedAt),
+	}, nil
+}
+
+func MechaTacticsGameHexRecordToResponse(l logger.Logger, rec *mecha_tactics_game_record.MechaTacticsGameHex) (*mecha_tactics_game_schema.MechaTacticsGameHexResponse, error) {
+	l.Debug("mapping mecha_tactics_game_hex record to response")
+	data, err := MechaTacticsGameHexRecordToResponseData(l, rec)
+	if err != nil {
+		return nil, err
+	}
+	return &mecha_tactics_game_schema.MechaTacticsGameHexResponse{
+		Data: data,
+	}, nil
+}
+
+func MechaTacticsGameHexRecordsToCollectionResponse(l logger.Logger, recs []*mecha_tactics_game_record.MechaTacticsGameHex) (mecha_tactics_game_schema.MechaTacticsGameHexCollectionResponse, error) {
+	l.Debug("mapping mecha_tactics_game_hex records to collection response")
+	data := []*mecha_tactics_game_schema.MechaTacticsGameHexResponseData{}
+	for _, rec := range recs {
+		d, err := MechaTacticsGameHexRecordToResponseData(l, rec)
+		if err != nil {
+			return mecha_tactics_game_schema.MechaTacticsGameHexCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return mecha_tactics_game_schema.MechaTacticsGameHexCollectionResponse{
+		Data: data,
+	}, nil
+}
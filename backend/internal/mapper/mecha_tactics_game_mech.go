@@ -0,0 +1,99 @@
+package mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/mecha_tactics_game_schema"
+)
+
+func MechaTacticsGameMechRequestToRecord(l logger.Logger, r *http.Request, rec *mecha_tactics_game_record.MechaTacticsGameMech) (*mecha_tactics_game_record.MechaTacticsGameMech, error) {
+	l.Debug("mapping mecha_tactics_game_mech request to record")
+
+	var req mecha_tactics_game_schema.MechaTacticsGameMechRequest
+	_, err := server.ReadRequest(l, r, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch server.HttpMethod(r.Method) {
+	case server.HttpMethodPost, server.HttpMethodPut, server.HttpMethodPatch:
+		rec.MechaTacticsGameChassisID = req.MechaTacticsGameChassisID
+		rec.MechType = req.MechType
+		rec.Callsign = req.Callsign
+		rec.WeaponConfig = make([]mecha_tactics_game_record.WeaponConfigEntry, 0, len(req.WeaponConfig))
+		for _, wc := range req.WeaponConfig {
+			rec.WeaponConfig = append(rec.WeaponConfig, mecha_tactics_game_record.WeaponConfigEntry{
+				WeaponID:     wc.WeaponID,
+				SlotLocation: wc.SlotLocation,
+			})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method")
+	}
+
+	return rec, nil
+}
+
+func MechaTacticsGameMechRecordToResponseData(l logger.Logger, rec *mecha_tactics_game_record.MechaTacticsGameMech) (*mecha_tactics_game_schema.MechaTacticsGameMechResponseData, error) {
+	l.Debug("mapping mecha_tactics_game_mech record to response data")
+
+	// Weapon config is only decoded into the record on write; records read
+	// back from the database carry the raw JSON column.
+	entries := rec.WeaponConfig
+	if len(entries) == 0 && len(rec.WeaponConfigJSON) > 0 {
+		if err := json.Unmarshal(rec.WeaponConfigJSON, &entries); err != nil {
+			return nil, err
+		}
+	}
+	weaponConfig := make([]mecha_tactics_game_schema.WeaponConfigEntry, 0, len(entries))
+	for _, wc := range entries {
+		weaponConfig = append(weaponConfig, mecha_tactics_game_schema.WeaponConfigEntry{
+			WeaponID:     wc.WeaponID,
+			SlotLocation: wc.SlotLocation,
+		})
+	}
+
+	return &mecha_tactics_game_schema.MechaTacticsGameMechResponseData{
+		ID:                        rec.ID,
+		GameID:                    rec.GameID,
+		MechaTacticsGameChassisID: rec.MechaTacticsGameChassisID,
+		MechType:                  rec.MechType,
+		Callsign:                  rec.Callsign,
+		WeaponConfig:              weaponConfig,
+		CreatedAt:                 rec.CreatedAt,
+		UpdatedAt:                 nulltime.ToTimePtr(rec.UpdatedAt),
+		DeletedAt:                 nulltime.ToTimePtr(rec.DeletedAt),
+	}, nil
+}
+
+func MechaTacticsGameMechRecordToResponse(l logger.Logger, rec *mecha_tactics_game_record.MechaTacticsGameMech) (*mecha_tactics_game_schema.MechaTacticsGameMechResponse, error) {
+	l.Debug("mapping mecha_tactics_game_mech record to response")
+	data, err := MechaTacticsGameMechRecordToResponseData(l, rec)
+	if err != nil {
+		return nil, err
+	}
+	return &mecha_tactics_game_schema.MechaTacticsGameMechResponse{
+		Data: data,
+	}, nil
+}
+
+func MechaTacticsGameMechRecordsToCollectionResponse(l logger.Logger, recs []*mecha_tactics_game_record.MechaTacticsGameMech) (mecha_tactics_game_schema.MechaTacticsGameMechCollectionResponse, error) {
+	l.Debug("mapping mecha_tactics_game_mech records to collection response")
+	data := []*mecha_tactics_game_schema.MechaTacticsGameMechResponseData{}
+	for _, rec := range recs {
+		d, err := MechaTacticsGameMechRecordToResponseData(l, rec)
+		if err != nil {
+			return mecha_tactics_game_schema.MechaTacticsGameMechCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return mecha_tactics_game_schema.MechaTacticsGameMechCollectionResponse{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package mapper
+
+import (
+	"fmt"
+	"net/http"
+
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/mecha_tactics_game_schema"
+)
+
+func MechaTacticsGameTerrainTypeRequestToRecord(l logger.Logger, r *http.Request, rec *mecha_tactics_game_record.MechaTacticsGameTerrainType) (*mecha_tactics_game_record.MechaTacticsGameTerrainType, error) {
+	l.Debug("mapping mecha_tactics_game_terrain_type request to record")
+
+	var req mecha_tactics_game_schema.MechaTacticsGameTerrainTypeRequest
+	_, err := server.ReadRequest(l, r, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch server.HttpMethod(r.Method) {
+	case server.HttpMethodPost, server.HttpMethodPut, server.HttpMethodPatch:
+		rec.Name = req.Name
+		rec.Description = req.Description
+		rec.MovementPointCost = req.MovementPointCost
+		if rec.MovementPointCost == 0 {
+			rec.MovementPointCost = 1
+		}
+		rec.CoverModifier = req.CoverModifier
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method")
+	}
+
+	return rec, nil
+}
+
+func MechaTacticsGameTerrainTypeRecordToResponseData(l logger.Logger, rec *mecha_tactics_game_record.MechaTacticsGameTerrainType) (*mecha_tactics_game_schema.MechaTacticsGameTerrainTypeResponseData, error) {
+	l.Debug("mapping mecha_tactics_game_terrain_type record to response data")
+
+	return &mecha_tactics_game_schema.MechaTacticsGameTerrainTypeResponseData{
+		ID:                rec.ID,
+		GameID:            rec.GameID,
+		Name:              rec.Name,
+		Description:       rec.Description,
+		MovementPointCost: rec.MovementPointCost,
+		CoverModifier:     rec.CoverModifier,
+		CreatedAt:         rec.CreatedAt,
+		UpdatedAt:         nulltime.ToTimePtr(rec.UpdatedAt),
+		DeletedAt:         nulltime.ToTimePtr(rec.DeletedAt),
+	}, nil
+}
+
+func MechaTacticsGameTerrainTypeRecordToResponse(l logger.Logger, rec *mecha_tactics_game_record.MechaTacticsGameTerrainType) (*mecha_tactics_game_schema.MechaTacticsGameTerrainTypeResponse, error) {
+	l.Debug("mapping mecha_tactics_game_terrain_type record to response")
+	data, err := MechaTacticsGameTerrainTypeRecordToResponseData(l, rec)
+	if err != nil {
+		return nil, err
+	}
+	return &mecha_tactics_game_schema.MechaTacticsGameTerrainTypeResponse{
+		Data: data,
+	}, nil
+}
+
+func MechaTacticsGameTerrainTypeRecordsToCollectionResponse(l logger.Logger, recs []*mecha_tactics_game_record.MechaTacticsGameTerrainType) (mecha_tactics_game_schema.MechaTacticsGameTerrainTypeCollectionResponse, error) {
+	l.Debug("mapping mecha_tactics_game_terrain_type records to collection response")
+	data := []*mecha_tactics_game_schema.MechaTacticsGameTerrainTypeResponseData{}
+	for _, rec := range recs {
+		d, err := MechaTacticsGameTerrainTypeRecordToResponseData(l, rec)
+		if err != nil {
+			return mecha_tactics_game_schema.MechaTacticsGameTerrainTypeCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return mecha_tactics_game_schema.MechaTacticsGameTerrainTypeCollectionResponse{
+		Data: data,
+	}, nil
+}
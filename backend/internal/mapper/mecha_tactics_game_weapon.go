@@ -0,0 +1,89 @@
+package mapper
+
+import (
+	"fmt"
+	"net/http"
+
+	"gitlab.com/alienspaces/playbymail/core/nulltime"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/mecha_tactics_game_schema"
+)
+
+func MechaTacticsGameWeaponRequestToRecord(l logger.Logger, r *http.Request, rec *mecha_tactics_game_record.MechaTacticsGameWeapon) (*mecha_tactics_game_record.MechaTacticsGameWeapon, error) {
+	l.Debug("mapping mecha_tactics_game_weapon request to record")
+
+	var req mecha_tactics_game_schema.MechaTacticsGameWeaponRequest
+	_, err := server.ReadRequest(l, r, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch server.HttpMethod(r.Method) {
+	case server.HttpMethodPost, server.HttpMethodPut, server.HttpMethodPatch:
+		rec.Name = req.Name
+		rec.Description = req.Description
+		rec.Damage = req.Damage
+		if rec.Damage == 0 {
+			rec.Damage = 5
+		}
+		rec.HeatCost = req.HeatCost
+		rec.RangeBand = req.RangeBand
+		if rec.RangeBand == "" {
+			rec.RangeBand = mecha_tactics_game_record.WeaponRangeBandMedium
+		}
+		rec.MountSize = req.MountSize
+		if rec.MountSize == "" {
+			rec.MountSize = mecha_tactics_game_record.WeaponMountSizeMedium
+		}
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method")
+	}
+
+	return rec, nil
+}
+
+func MechaTacticsGameWeaponRecordToResponseData(l logger.Logger, rec *mecha_tactics_game_record.MechaTacticsGameWeapon) (*mecha_tactics_game_schema.MechaTacticsGameWeaponResponseData, error) {
+	l.Debug("mapping mecha_tactics_game_weapon record to response data")
+
+	return &mecha_tactics_game_schema.MechaTacticsGameWeaponResponseData{
+		ID:          rec.ID,
+		GameID:      rec.GameID,
+		Name:        rec.Name,
+		Description: rec.Description,
+		Damage:      rec.Damage,
+		HeatCost:    rec.HeatCost,
+		RangeBand:   rec.RangeBand,
+		MountSize:   rec.MountSize,
+		CreatedAt:   rec.CreatedAt,
+		UpdatedAt:   nulltime.ToTimePtr(rec.UpdatedAt),
+		DeletedAt:   nulltime.ToTimePtr(rec.DeletedAt),
+	}, nil
+}
+
+func MechaTacticsGameWeaponRecordToResponse(l logger.Logger, rec *mecha_tactics_game_record.MechaTacticsGameWeapon) (*mecha_tactics_game_schema.MechaTacticsGameWeaponResponse, error) {
+	l.Debug("mapping mecha_tactics_game_weapon record to response")
+	data, err := MechaTacticsGameWeaponRecordToResponseData(l, rec)
+	if err != nil {
+		return nil, err
+	}
+	return &mecha_tactics_game_schema.MechaTacticsGameWeaponResponse{
+		Data: data,
+	}, nil
+}
+
+func MechaTacticsGameWeaponRecordsToCollectionResponse(l logger.Logger, recs []*mecha_tactics_game_record.MechaTacticsGameWeapon) (mecha_tactics_game_schema.MechaTacticsGameWeaponCollectionResponse, error) {
+	l.Debug("mapping mecha_tactics_game_weapon records to collection response")
+	data := []*mecha_tactics_game_schema.MechaTacticsGameWeaponResponseData{}
+	for _, rec := range recs {
+		d, err := MechaTacticsGameWeaponRecordToResponseData(l, rec)
+		if err != nil {
+			return mecha_tactics_game_schema.MechaTacticsGameWeaponCollectionResponse{}, err
+		}
+		data = append(data, d)
+	}
+	return mecha_tactics_game_schema.MechaTacticsGameWeaponCollectionResponse{
+		Data: data,
+	}, nil
+}
@@ -1,7 +1,29 @@
 package mapper
 
-import "gitlab.com/alienspaces/playbymail/schema/api/player_schema"
+import (
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/schema/api/player_schema"
+)
 
 func MapVerifyGameSubscriptionTokenResponse(token string) *player_schema.VerifyGameSubscriptionTokenResponse {
 	return &player_schema.VerifyGameSubscriptionTokenResponse{SessionToken: token}
 }
+
+func MapGameSubscriptionInstanceStandingOrdersResponse(rec *game_record.GameSubscriptionInstance, gameInstanceRec *game_record.GameInstance, availableStandingOrders map[string][]string) (*player_schema.GameSubscriptionInstanceStandingOrdersResponse, error) {
+	standingOrders, err := rec.GetStandingOrders()
+	if err != nil {
+		return nil, err
+	}
+
+	if availableStandingOrders == nil {
+		availableStandingOrders = map[string][]string{}
+	}
+
+	return &player_schema.GameSubscriptionInstanceStandingOrdersResponse{
+		GameSubscriptionInstanceID: rec.ID,
+		MissedTurnPolicy:           gameInstanceRec.MissedTurnPolicy,
+		MissedTurns:                rec.MissedTurns,
+		StandingOrders:             standingOrders,
+		AvailableStandingOrders:    availableStandingOrders,
+	}, nil
+}
@@ -0,0 +1,150 @@
+package omr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// codeAlphabet is the URL safe base64 alphabet turn sheet codes are written in.
+// Each code character is stored in the code mark as its 6 bit index.
+const codeAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+// MaxCodeLength is the longest turn sheet code that fits in the code mark
+const MaxCodeLength = (CodeMarkMaxRows - 3) * 8 / 6
+
+// EncodeCode returns the code mark cells for a turn sheet code, one row of
+// CodeMarkColumns cells per byte with true for a dark cell.
+//
+// The bytes are the code length, the code characters packed as 6 bit
+// alphabet indexes, and a CRC-16 checksum of the preceding bytes.
+func EncodeCode(code string) ([][]bool, error) {
+	if code == "" {
+		return nil, fmt.Errorf("turn sheet code is empty")
+	}
+	if len(code) > MaxCodeLength {
+		return nil, fmt.Errorf("turn sheet code length %d exceeds maximum %d", len(code), MaxCodeLength)
+	}
+
+	data := []byte{byte(len(code))}
+
+	var acc uint32
+	bits := 0
+	for _, r := range code {
+		idx := strings.IndexRune(codeAlphabet, r)
+		if idx < 0 {
+			return nil, fmt.Errorf("turn sheet code contains unsupported character %q", r)
+		}
+		acc = acc<<6 | uint32(idx)
+		bits += 6
+		for bits >= 8 {
+			bits -= 8
+			data = append(data, byte(acc>>bits))
+		}
+	}
+	if bits > 0 {
+		data = append(data, byte(acc<<(8-bits)))
+	}
+
+	crc := crc16(data)
+	data = append(data, byte(crc>>8), byte(crc))
+
+	rows := make([][]bool, len(data))
+	for i, b := range data {
+		rows[i] = make([]bool, CodeMarkColumns)
+		for c := range CodeMarkColumns {
+			rows[i][c] = b&(0x80>>c) != 0
+		}
+	}
+
+	return rows, nil
+}
+
+// ReadCode reads the turn sheet code from the code mark. When the code mark
+// cannot be read the page is tried upside down, and if that succeeds the page
+// is left rotated so later reads use the corrected orientation.
+func (p *Page) ReadCode() (string, error) {
+	code, err := p.readCode()
+	if err == nil {
+		return code, nil
+	}
+
+	p.Rotate()
+	code, rotatedErr := p.readCode()
+	if rotatedErr == nil {
+		return code, nil
+	}
+	p.Rotate()
+
+	return "", err
+}
+
+func (p *Page) readCode() (string, error) {
+	length := int(p.readCodeRow(0))
+	if length == 0 {
+		return "", fmt.Errorf("code mark not found")
+	}
+
+	packed := (length*6 + 7) / 8
+	rows := 1 + packed + 2
+	if rows > CodeMarkMaxRows {
+		return "", fmt.Errorf("code mark length %d is invalid", length)
+	}
+
+	data := make([]byte, rows)
+	for r := range rows {
+		data[r] = p.readCodeRow(r)
+	}
+
+	crc := uint16(data[rows-2])<<8 | uint16(data[rows-1])
+	if crc16(data[:rows-2]) != crc {
+		return "", fmt.Errorf("code mark checksum does not match")
+	}
+
+	var sb strings.Builder
+	var acc uint32
+	bits := 0
+	for _, b := range data[1 : 1+packed] {
+		acc = acc<<8 | uint32(b)
+		bits += 8
+		for bits >= 6 && sb.Len() < length {
+			bits -= 6
+			sb.WriteByte(codeAlphabet[(acc>>bits)&0x3f])
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// readCodeRow reads one byte from a code mark row. Each cell is sampled in
+// its centre and counts as dark when most samples are dark.
+func (p *Page) readCodeRow(row int) byte {
+	var b byte
+	for c := range CodeMarkColumns {
+		cell := Box{
+			X:      CodeMarkLeftMM + float64(c)*CodeMarkCellSizeMM,
+			Y:      CodeMarkTopMM + float64(row)*CodeMarkCellSizeMM,
+			Width:  CodeMarkCellSizeMM,
+			Height: CodeMarkCellSizeMM,
+		}
+		if p.Fill(cell) > 0.5 {
+			b |= 0x80 >> c
+		}
+	}
+	return b
+}
+
+// crc16 returns the CRC-16/CCITT-FALSE checksum of data
+func crc16(data []byte) uint16 {
+	crc := uint16(0xffff)
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,393 @@
+// Package omr reads optical marks from scanned or photographed turn sheets
+// without a hosted AI service.
+//
+// Printed turn sheets carry four solid registration marks, one in each corner
+// of the page, and a code mark in the left margin: a grid of light and dark
+// cells encoding the turn sheet code. The registration marks locate the page
+// in the image so that any point on the printed A4 page, given in
+// millimetres, can be sampled. Filled checkboxes and radio buttons are then
+// detected by sampling the boxes at the coordinates the sheet template
+// printed them at.
+//
+// The page geometry constants below must match the .omr-* styles in
+// templates/turnsheet/base.template.
+package omr
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/jpeg" // Register JPEG decoder
+	_ "image/png"  // Register PNG decoder
+	"math"
+
+	_ "golang.org/x/image/webp" // Register WebP decoder
+)
+
+// Page geometry in millimetres
+const (
+	PageWidthMM  = 210.0
+	PageHeightMM = 297.0
+
+	// RegistrationMarkSizeMM is the side of each square registration mark
+	RegistrationMarkSizeMM = 5.0
+	// RegistrationMarkInsetMM is the distance from the page edges to the outer
+	// edges of each registration mark
+	RegistrationMarkInsetMM = 6.0
+
+	// CodeMarkLeftMM and CodeMarkTopMM position the top left corner of the code mark
+	CodeMarkLeftMM = 6.0
+	CodeMarkTopMM  = 40.0
+	// CodeMarkCellSizeMM is the side of each code mark cell
+	CodeMarkCellSizeMM = 1.5
+	// CodeMarkColumns is the number of cells in each code mark row, one byte per row
+	CodeMarkColumns = 8
+	// CodeMarkMaxRows is the number of rows that fit in the left margin
+	CodeMarkMaxRows = 120
+)
+
+// MarkThreshold is the fraction of dark pixels inside a box above which the
+// box is considered marked
+const MarkThreshold = 0.15
+
+// maxImageDimension is the largest width or height images are reduced to
+// before reading. Code mark cells remain around 10 pixels square.
+const maxImageDimension = 2400
+
+// Box is a rectangle on the printed page in millimetres from the top left
+// corner of the page
+type Box struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// Field is a checkbox or radio button printed on a turn sheet
+type Field struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	// Type is the HTML input type, "radio" or "checkbox"
+	Type string `json:"type"`
+	// Checked is true when the field is printed already marked
+	Checked bool `json:"checked"`
+	Box     Box  `json:"box"`
+}
+
+// Layout describes the fields printed on a turn sheet
+type Layout struct {
+	Fields []Field `json:"fields"`
+	// WrittenFields names the printed fields that are completed by hand and
+	// cannot be read from marks
+	WrittenFields []string `json:"written_fields"`
+}
+
+// registration mark centres in millimetres
+var (
+	markCentreLeftMM   = RegistrationMarkInsetMM + RegistrationMarkSizeMM/2
+	markCentreRightMM  = PageWidthMM - RegistrationMarkInsetMM - RegistrationMarkSizeMM/2
+	markCentreTopMM    = RegistrationMarkInsetMM + RegistrationMarkSizeMM/2
+	markCentreBottomMM = PageHeightMM - RegistrationMarkInsetMM - RegistrationMarkSizeMM/2
+)
+
+type point struct {
+	x, y float64
+}
+
+// Page is a turn sheet located in an image by its registration marks
+type Page struct {
+	gray      *grayImage
+	threshold uint8
+	// corners holds the registration mark centres in image pixels in the
+	// order top left, top right, bottom left, bottom right
+	corners [4]point
+}
+
+// DecodePage decodes a PNG, JPEG or WebP image and locates the turn sheet in it
+func DecodePage(data []byte) (*Page, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	return NewPage(img)
+}
+
+// NewPage locates the registration marks of a printed turn sheet in an image
+func NewPage(img image.Image) (*Page, error) {
+	g := newGrayImage(img, maxImageDimension)
+	if g.w < 100 || g.h < 100 {
+		return nil, fmt.Errorf("image is too small to read (%dx%d)", g.w, g.h)
+	}
+
+	p := &Page{
+		gray:      g,
+		threshold: g.otsuThreshold(),
+	}
+
+	// Expected registration mark side in pixels assuming the page roughly fills the image
+	expected := RegistrationMarkSizeMM * float64(min(g.w, g.h)) / PageWidthMM
+
+	windowW := g.w * 3 / 10
+	windowH := g.h / 4
+	windows := [4]image.Rectangle{
+		image.Rect(0, 0, windowW, windowH),
+		image.Rect(g.w-windowW, 0, g.w, windowH),
+		image.Rect(0, g.h-windowH, windowW, g.h),
+		image.Rect(g.w-windowW, g.h-windowH, g.w, g.h),
+	}
+	cornerPoints := [4]point{
+		{0, 0},
+		{float64(g.w), 0},
+		{0, float64(g.h)},
+		{float64(g.w), float64(g.h)},
+	}
+
+	names := [4]string{"top left", "top right", "bottom left", "bottom right"}
+	for i, window := range windows {
+		c, ok := p.findRegistrationMark(window, cornerPoints[i], expected)
+		if !ok {
+			return nil, fmt.Errorf("%s registration mark not found", names[i])
+		}
+		p.corners[i] = c
+	}
+
+	return p, nil
+}
+
+// Rotate turns the page half way round, for sheets scanned upside down
+func (p *Page) Rotate() {
+	p.corners = [4]point{p.corners[3], p.corners[2], p.corners[1], p.corners[0]}
+}
+
+// Fill returns the fraction of dark pixels inside the central area of a box.
+// The outer quarter on each side is ignored so printed box borders are not counted.
+func (p *Page) Fill(b Box) float64 {
+	const samples = 12
+
+	inner := Box{
+		X:      b.X + b.Width/4,
+		Y:      b.Y + b.Height/4,
+		Width:  b.Width / 2,
+		Height: b.Height / 2,
+	}
+
+	dark := 0
+	for sy := range samples {
+		for sx := range samples {
+			x := inner.X + (float64(sx)+0.5)*inner.Width/samples
+			y := inner.Y + (float64(sy)+0.5)*inner.Height/samples
+			if p.isDark(x, y) {
+				dark++
+			}
+		}
+	}
+
+	return float64(dark) / (samples * samples)
+}
+
+// IsMarked returns true when a box has been filled in
+func (p *Page) IsMarked(b Box) bool {
+	return p.Fill(b) >= MarkThreshold
+}
+
+// isDark returns true when the image pixel at a page position in millimetres is dark
+func (p *Page) isDark(xMM, yMM float64) bool {
+	pt := p.toImage(xMM, yMM)
+	x := int(math.Round(pt.x))
+	y := int(math.Round(pt.y))
+	if x < 0 || y < 0 || x >= p.gray.w || y >= p.gray.h {
+		return false
+	}
+	return p.gray.at(x, y) < p.threshold
+}
+
+// toImage maps a page position in millimetres to image pixels by bilinear
+// interpolation between the registration mark centres, which allows for
+// rotation, scaling and mild perspective in photographs
+func (p *Page) toImage(xMM, yMM float64) point {
+	u := (xMM - markCentreLeftMM) / (markCentreRightMM - markCentreLeftMM)
+	v := (yMM - markCentreTopMM) / (markCentreBottomMM - markCentreTopMM)
+
+	tl, tr, bl, br := p.corners[0], p.corners[1], p.corners[2], p.corners[3]
+
+	return point{
+		x: (1-u)*(1-v)*tl.x + u*(1-v)*tr.x + (1-u)*v*bl.x + u*v*br.x,
+		y: (1-u)*(1-v)*tl.y + u*(1-v)*tr.y + (1-u)*v*bl.y + u*v*br.y,
+	}
+}
+
+// findRegistrationMark returns the centre of the solid square closest to the
+// image corner within the search window
+func (p *Page) findRegistrationMark(window image.Rectangle, corner point, expected float64) (point, bool) {
+	g := p.gray
+	w := window.Dx()
+	visited := make([]bool, w*window.Dy())
+
+	minSide := expected * 0.3
+	maxSide := expected * 2.5
+
+	var best point
+	bestDistance := math.MaxFloat64
+	found := false
+
+	stack := []image.Point{}
+	for y := window.Min.Y; y < window.Max.Y; y++ {
+		for x := window.Min.X; x < window.Max.X; x++ {
+			i := (y-window.Min.Y)*w + (x - window.Min.X)
+			if visited[i] || g.at(x, y) >= p.threshold {
+				continue
+			}
+
+			// Flood fill the dark component
+			visited[i] = true
+			stack = append(stack[:0], image.Pt(x, y))
+			count := 0
+			sumX, sumY := 0, 0
+			bounds := image.Rect(x, y, x+1, y+1)
+
+			for len(stack) > 0 {
+				pt := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+
+				count++
+				sumX += pt.X
+				sumY += pt.Y
+				bounds = bounds.Union(image.Rect(pt.X, pt.Y, pt.X+1, pt.Y+1))
+
+				for _, n := range [4]image.Point{{pt.X + 1, pt.Y}, {pt.X - 1, pt.Y}, {pt.X, pt.Y + 1}, {pt.X, pt.Y - 1}} {
+					if !n.In(window) {
+						continue
+					}
+					ni := (n.Y-window.Min.Y)*w + (n.X - window.Min.X)
+					if visited[ni] || g.at(n.X, n.Y) >= p.threshold {
+						continue
+					}
+					visited[ni] = true
+					stack = append(stack, n)
+				}
+			}
+
+			bw := float64(bounds.Dx())
+			bh := float64(bounds.Dy())
+			if bw < minSide || bh < minSide || bw > maxSide || bh > maxSide {
+				continue
+			}
+			if aspect := bw / bh; aspect < 0.6 || aspect > 1.6 {
+				continue
+			}
+			if fill := float64(count) / (bw * bh); fill < 0.75 {
+				continue
+			}
+
+			centre := point{x: float64(sumX) / float64(count), y: float64(sumY) / float64(count)}
+			if d := math.Hypot(centre.x-corner.x, centre.y-corner.y); d < bestDistance {
+				bestDistance = d
+				best = centre
+				found = true
+			}
+		}
+	}
+
+	return best, found
+}
+
+// grayImage is an 8 bit luminance copy of an image
+type grayImage struct {
+	w, h int
+	pix  []uint8
+}
+
+func (g *grayImage) at(x, y int) uint8 {
+	return g.pix[y*g.w+x]
+}
+
+// newGrayImage converts an image to luminance, reducing it by area averaging
+// so that neither side exceeds maxDim
+func newGrayImage(img image.Image, maxDim int) *grayImage {
+	b := img.Bounds()
+	scale := 1.0
+	if longest := max(b.Dx(), b.Dy()); longest > maxDim {
+		scale = float64(longest) / float64(maxDim)
+	}
+
+	w := max(1, int(float64(b.Dx())/scale))
+	h := max(1, int(float64(b.Dy())/scale))
+
+	sums := make([]uint32, w*h)
+	counts := make([]uint32, w*h)
+
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		dy := min(h-1, int(float64(y-b.Min.Y)/scale))
+		for x := b.Min.X; x < b.Max.X; x++ {
+			dx := min(w-1, int(float64(x-b.Min.X)/scale))
+			i := dy*w + dx
+			sums[i] += uint32(luminance(img, x, y))
+			counts[i]++
+		}
+	}
+
+	g := &grayImage{w: w, h: h, pix: make([]uint8, w*h)}
+	for i := range g.pix {
+		if counts[i] > 0 {
+			g.pix[i] = uint8(sums[i] / counts[i])
+		}
+	}
+
+	return g
+}
+
+// luminance returns the 8 bit luminance of a pixel, reading the luma plane
+// directly for the image types decoded from JPEG and greyscale scans
+func luminance(img image.Image, x, y int) uint8 {
+	switch m := img.(type) {
+	case *image.YCbCr:
+		return m.Y[m.YOffset(x, y)]
+	case *image.Gray:
+		return m.Pix[m.PixOffset(x, y)]
+	}
+
+	r, g, b, _ := img.At(x, y).RGBA()
+	return uint8((19595*r + 38470*g + 7471*b + 1<<15) >> 24)
+}
+
+// otsuThreshold returns the luminance that best separates dark marks from paper
+func (g *grayImage) otsuThreshold() uint8 {
+	var hist [256]int
+	for _, v := range g.pix {
+		hist[v]++
+	}
+
+	total := len(g.pix)
+	sum := 0.0
+	for i, c := range hist {
+		sum += float64(i * c)
+	}
+
+	sumB := 0.0
+	weightB := 0
+	bestVariance := 0.0
+	threshold := 128
+
+	for t := range 256 {
+		weightB += hist[t]
+		if weightB == 0 {
+			continue
+		}
+		weightF := total - weightB
+		if weightF == 0 {
+			break
+		}
+
+		sumB += float64(t * hist[t])
+		meanB := sumB / float64(weightB)
+		meanF := (sum - sumB) / float64(weightF)
+
+		variance := float64(weightB) * float64(weightF) * (meanB - meanF) * (meanB - meanF)
+		if variance > bestVariance {
+			bestVariance = variance
+			threshold = t + 1
+		}
+	}
+
+	return uint8(min(threshold, 255))
+}
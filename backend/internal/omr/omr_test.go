@@ -0,0 +1,155 @@
+package omr_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/internal/omr"
+)
+
+const testCode = "eyJjb2RlX3R5cGUiOiJwbGF5IiwiZ2FtZV90dXJuX3NoZWV0X2lkIjoiNGQ2ZjFjN2EtOGI2Yi00YjI2LWE1NjAtMmI0YzFjNmQ2YjBlIn0"
+
+// testPage draws a printed turn sheet at the given pixels per millimetre
+type testPage struct {
+	img   *image.Gray
+	scale float64
+}
+
+func newTestPage(scale float64) *testPage {
+	img := image.NewGray(image.Rect(0, 0, int(omr.PageWidthMM*scale), int(omr.PageHeightMM*scale)))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+	p := &testPage{img: img, scale: scale}
+
+	inset := omr.RegistrationMarkInsetMM
+	size := omr.RegistrationMarkSizeMM
+	for _, pos := range [][2]float64{
+		{inset, inset},
+		{omr.PageWidthMM - inset - size, inset},
+		{inset, omr.PageHeightMM - inset - size},
+		{omr.PageWidthMM - inset - size, omr.PageHeightMM - inset - size},
+	} {
+		p.fill(omr.Box{X: pos[0], Y: pos[1], Width: size, Height: size})
+	}
+
+	return p
+}
+
+func (p *testPage) fill(b omr.Box) {
+	for y := int(b.Y * p.scale); y < int((b.Y+b.Height)*p.scale); y++ {
+		for x := int(b.X * p.scale); x < int((b.X+b.Width)*p.scale); x++ {
+			p.img.SetGray(x, y, color.Gray{Y: 0x10})
+		}
+	}
+}
+
+// outline draws a printed box border
+func (p *testPage) outline(b omr.Box) {
+	const line = 0.3
+	p.fill(omr.Box{X: b.X, Y: b.Y, Width: b.Width, Height: line})
+	p.fill(omr.Box{X: b.X, Y: b.Y + b.Height - line, Width: b.Width, Height: line})
+	p.fill(omr.Box{X: b.X, Y: b.Y, Width: line, Height: b.Height})
+	p.fill(omr.Box{X: b.X + b.Width - line, Y: b.Y, Width: line, Height: b.Height})
+}
+
+func (p *testPage) code(t *testing.T, code string) {
+	rows, err := omr.EncodeCode(code)
+	require.NoError(t, err)
+	for r, row := range rows {
+		for c, dark := range row {
+			if dark {
+				p.fill(omr.Box{
+					X:      omr.CodeMarkLeftMM + float64(c)*omr.CodeMarkCellSizeMM,
+					Y:      omr.CodeMarkTopMM + float64(r)*omr.CodeMarkCellSizeMM,
+					Width:  omr.CodeMarkCellSizeMM,
+					Height: omr.CodeMarkCellSizeMM,
+				})
+			}
+		}
+	}
+}
+
+// rotated returns the page turned half way round
+func (p *testPage) rotated() image.Image {
+	b := p.img.Bounds()
+	out := image.NewGray(b)
+	for y := 0; y < b.Dy(); y++ {
+		for x := 0; x < b.Dx(); x++ {
+			out.SetGray(b.Dx()-1-x, b.Dy()-1-y, p.img.GrayAt(x, y))
+		}
+	}
+	return out
+}
+
+func TestEncodeCode(t *testing.T) {
+	rows, err := omr.EncodeCode(testCode)
+	require.NoError(t, err)
+	require.Len(t, rows, 1+(len(testCode)*6+7)/8+2)
+	require.LessOrEqual(t, len(rows), omr.CodeMarkMaxRows)
+
+	_, err = omr.EncodeCode("")
+	require.Error(t, err)
+
+	_, err = omr.EncodeCode("not a code!")
+	require.ErrorContains(t, err, "unsupported character")
+}
+
+func TestPage(t *testing.T) {
+	marked := omr.Box{X: 40, Y: 100, Width: 4, Height: 4}
+	unmarked := omr.Box{X: 40, Y: 110, Width: 4, Height: 4}
+
+	draw := func(scale float64) *testPage {
+		p := newTestPage(scale)
+		p.code(t, testCode)
+		p.outline(marked)
+		p.outline(unmarked)
+		// A pen mark across the centre of the box
+		p.fill(omr.Box{X: marked.X + 0.8, Y: marked.Y + 1.5, Width: 2.4, Height: 1})
+		return p
+	}
+
+	tests := []struct {
+		name  string
+		image func() image.Image
+	}{
+		{name: "300 dpi scan", image: func() image.Image { return draw(300 / 25.4).img }},
+		{name: "low resolution photo", image: func() image.Image { return draw(5).img }},
+		{name: "upside down scan", image: func() image.Image { return draw(8).rotated() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := omr.NewPage(tt.image())
+			require.NoError(t, err)
+
+			code, err := page.ReadCode()
+			require.NoError(t, err)
+			require.Equal(t, testCode, code)
+
+			require.True(t, page.IsMarked(marked), "marked box fill %.2f", page.Fill(marked))
+			require.False(t, page.IsMarked(unmarked), "unmarked box fill %.2f", page.Fill(unmarked))
+		})
+	}
+}
+
+func TestPage_MissingMarks(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 840, 1188))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+
+	_, err := omr.NewPage(img)
+	require.ErrorContains(t, err, "registration mark not found")
+}
+
+func TestPage_MissingCode(t *testing.T) {
+	page, err := omr.NewPage(newTestPage(5).img)
+	require.NoError(t, err)
+
+	_, err = page.ReadCode()
+	require.ErrorContains(t, err, "code mark not found")
+}
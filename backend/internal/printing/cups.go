@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/OpenPrinting/goipp"
 )
@@ -18,6 +20,8 @@ type CUPSPrinter struct {
 	baseURL string
 }
 
+var _ JobTracker = &CUPSPrinter{}
+
 // NewCUPSPrinter creates a new CUPS printer client
 func NewCUPSPrinter(device Device) *CUPSPrinter {
 	return &CUPSPrinter{
@@ -96,8 +100,7 @@ func (p *CUPSPrinter) Print(ctx context.Context, data []byte, options PrintOptio
 	req.Operation.Add(goipp.MakeAttribute("attributes-natural-language", goipp.TagLanguage, goipp.String("en-US")))
 
 	// Add printer URI
-	printerURI := fmt.Sprintf("ipp://%s:%d/printers/default", p.device.Host, p.device.Port)
-	req.Operation.Add(goipp.MakeAttribute("printer-uri", goipp.TagURI, goipp.String(printerURI)))
+	req.Operation.Add(goipp.MakeAttribute("printer-uri", goipp.TagURI, goipp.String(p.printerURI())))
 
 	// Add job attributes
 	req.Operation.Add(goipp.MakeAttribute("requesting-user-name", goipp.TagName, goipp.String("playbymail")))
@@ -130,7 +133,7 @@ func (p *CUPSPrinter) Print(ctx context.Context, data []byte, options PrintOptio
 	// Build HTTP request with IPP message + document data
 	body := io.MultiReader(bytes.NewBuffer(payload), bytes.NewBuffer(data))
 
-	url := p.baseURL + "/printers/default"
+	url := p.baseURL + p.printerPath()
 	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, body)
 	if err != nil {
 		return fmt.Errorf("failed to create HTTP request: %w", err)
@@ -266,3 +269,183 @@ func (p *CUPSPrinter) Discover() ([]Device, error) {
 		},
 	}, nil
 }
+
+// SubmitJob creates a single IPP job and sends each document to it in order
+// using Create-Job and Send-Document, so the documents are printed collated.
+func (p *CUPSPrinter) SubmitJob(ctx context.Context, name string, documents [][]byte, options PrintOptions) (*PrintJob, error) {
+	if len(documents) == 0 {
+		return nil, fmt.Errorf("print job has no documents")
+	}
+
+	req := p.newIPPRequest(goipp.OpCreateJob)
+	req.Operation.Add(goipp.MakeAttribute("job-name", goipp.TagName, goipp.String(name)))
+
+	if options.Copies > 1 {
+		req.Job.Add(goipp.MakeAttribute("copies", goipp.TagInteger, goipp.Integer(options.Copies)))
+	}
+
+	if options.Duplex {
+		req.Job.Add(goipp.MakeAttribute("sides", goipp.TagKeyword, goipp.String("two-sided-long-edge")))
+	} else {
+		req.Job.Add(goipp.MakeAttribute("sides", goipp.TagKeyword, goipp.String("one-sided")))
+	}
+
+	if options.PaperSize != "" {
+		req.Job.Add(goipp.MakeAttribute("media", goipp.TagKeyword, goipp.String(options.PaperSize)))
+	}
+
+	resp, err := p.sendIPPRequest(ctx, req, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create print job: %w", err)
+	}
+
+	job := jobFromIPPResponse(resp)
+	if job.ID == "" {
+		return nil, fmt.Errorf("create job response did not include a job-id")
+	}
+
+	jobID, err := strconv.Atoi(job.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid job-id >%s<: %w", job.ID, err)
+	}
+
+	for idx, document := range documents {
+		req := p.newIPPRequest(goipp.OpSendDocument)
+		req.Operation.Add(goipp.MakeAttribute("job-id", goipp.TagInteger, goipp.Integer(jobID)))
+		req.Operation.Add(goipp.MakeAttribute("document-format", goipp.TagMimeType, goipp.String("application/pdf")))
+		req.Operation.Add(goipp.MakeAttribute("last-document", goipp.TagBoolean, goipp.Boolean(idx == len(documents)-1)))
+
+		resp, err := p.sendIPPRequest(ctx, req, document)
+		if err != nil {
+			return nil, fmt.Errorf("failed to send document %d of %d to job %d: %w", idx+1, len(documents), jobID, err)
+		}
+
+		if sent := jobFromIPPResponse(resp); sent.Status != "" {
+			job.Status = sent.Status
+			job.StateMessage = sent.StateMessage
+		}
+	}
+
+	job.Pages = len(documents)
+	job.Document = name
+	job.Created = time.Now()
+
+	return job, nil
+}
+
+// GetJob retrieves the current state of a job using Get-Job-Attributes
+func (p *CUPSPrinter) GetJob(ctx context.Context, jobID string) (*PrintJob, error) {
+	id, err := strconv.Atoi(jobID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid job ID >%s<: %w", jobID, err)
+	}
+
+	req := p.newIPPRequest(goipp.OpGetJobAttributes)
+	req.Operation.Add(goipp.MakeAttribute("job-id", goipp.TagInteger, goipp.Integer(id)))
+	req.Operation.Add(goipp.MakeAttr("requested-attributes", goipp.TagKeyword,
+		goipp.String("job-id"), goipp.String("job-state"), goipp.String("job-state-message")))
+
+	resp, err := p.sendIPPRequest(ctx, req, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job attributes: %w", err)
+	}
+
+	job := jobFromIPPResponse(resp)
+	if job.ID == "" {
+		job.ID = jobID
+	}
+
+	return job, nil
+}
+
+// printerPath returns the HTTP path of the configured printer queue
+func (p *CUPSPrinter) printerPath() string {
+	queue := p.device.Queue
+	if queue == "" {
+		queue = "default"
+	}
+	return "/printers/" + queue
+}
+
+// printerURI returns the IPP URI of the configured printer queue
+func (p *CUPSPrinter) printerURI() string {
+	return fmt.Sprintf("ipp://%s:%d%s", p.device.Host, p.device.Port, p.printerPath())
+}
+
+// newIPPRequest creates an IPP request with the operation attributes every request requires
+func (p *CUPSPrinter) newIPPRequest(op goipp.Op) *goipp.Message {
+	req := goipp.NewRequest(goipp.DefaultVersion, op, 1)
+	req.Operation.Add(goipp.MakeAttribute("attributes-charset", goipp.TagCharset, goipp.String("utf-8")))
+	req.Operation.Add(goipp.MakeAttribute("attributes-natural-language", goipp.TagLanguage, goipp.String("en-US")))
+	req.Operation.Add(goipp.MakeAttribute("printer-uri", goipp.TagURI, goipp.String(p.printerURI())))
+	req.Operation.Add(goipp.MakeAttribute("requesting-user-name", goipp.TagName, goipp.String("playbymail")))
+	return req
+}
+
+// sendIPPRequest posts an IPP request, followed by optional document data, and
+// returns the decoded response. Any non-successful IPP status is returned as an error.
+func (p *CUPSPrinter) sendIPPRequest(ctx context.Context, req *goipp.Message, data []byte) (*goipp.Message, error) {
+	payload, err := req.EncodeBytes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode IPP message: %w", err)
+	}
+
+	body := io.MultiReader(bytes.NewBuffer(payload), bytes.NewBuffer(data))
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", p.baseURL+p.printerPath(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", goipp.ContentType)
+	httpReq.Header.Set("Accept", goipp.ContentType)
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send IPP request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("HTTP request failed with status: %d", resp.StatusCode)
+	}
+
+	respMsg := &goipp.Message{}
+	if err := respMsg.Decode(resp.Body); err != nil {
+		return nil, fmt.Errorf("failed to decode IPP response: %w", err)
+	}
+
+	// Status codes below 0x0100 are successful, including the
+	// "ignored or substituted attributes" variants CUPS returns.
+	if status := goipp.Status(respMsg.Code); status >= 0x0100 {
+		return nil, fmt.Errorf("IPP request failed with status: %s", status.String())
+	}
+
+	return respMsg, nil
+}
+
+// jobFromIPPResponse reads the job-id, job-state and job-state-message
+// attributes from an IPP response job attributes group.
+func jobFromIPPResponse(resp *goipp.Message) *PrintJob {
+	job := &PrintJob{}
+
+	for _, attr := range resp.Job {
+		if len(attr.Values) == 0 {
+			continue
+		}
+		switch attr.Name {
+		case "job-id":
+			if v, ok := attr.Values[0].V.(goipp.Integer); ok {
+				job.ID = strconv.Itoa(int(v))
+			}
+		case "job-state":
+			if v, ok := attr.Values[0].V.(goipp.Integer); ok {
+				job.Status = jobStateFromEnum(int(v))
+			}
+		case "job-state-message":
+			job.StateMessage = attr.Values[0].V.String()
+		}
+	}
+
+	return job
+}
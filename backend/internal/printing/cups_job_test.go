@@ -0,0 +1,123 @@
+package printing
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/internal/printing/ipptest"
+)
+
+func newStandInPrinter(srv *ipptest.Server) *CUPSPrinter {
+	return NewCUPSPrinter(Device{
+		Host:     srv.Host(),
+		Port:     srv.Port(),
+		Protocol: "cups",
+		Queue:    "turn-sheets",
+		Timeout:  5 * time.Second,
+	})
+}
+
+func TestCUPSPrinter_SubmitJob(t *testing.T) {
+	srv := ipptest.NewServer()
+	defer srv.Close()
+
+	printer := newStandInPrinter(srv)
+
+	documents := [][]byte{
+		[]byte("%PDF-1.4 cover"),
+		[]byte("%PDF-1.4 sheet one"),
+		[]byte("%PDF-1.4 sheet two"),
+	}
+
+	job, err := printer.SubmitJob(context.Background(), "turn-3-batch", documents, PrintOptions{Copies: 1, PaperSize: "iso_a4_210x297mm"})
+	if err != nil {
+		t.Fatalf("SubmitJob() error = %v", err)
+	}
+
+	if job.ID == "" {
+		t.Fatal("SubmitJob() returned a job without an ID")
+	}
+	if job.Status != JobStateCompleted {
+		t.Errorf("SubmitJob() status = %v, want %v", job.Status, JobStateCompleted)
+	}
+	if job.Pages != len(documents) {
+		t.Errorf("SubmitJob() pages = %v, want %v", job.Pages, len(documents))
+	}
+
+	jobs := srv.Jobs()
+	if len(jobs) != 1 {
+		t.Fatalf("stand-in server received %d jobs, want 1", len(jobs))
+	}
+	if jobs[0].Name != "turn-3-batch" {
+		t.Errorf("job name = %v, want %v", jobs[0].Name, "turn-3-batch")
+	}
+	if len(jobs[0].Documents) != len(documents) {
+		t.Fatalf("job documents = %d, want %d", len(jobs[0].Documents), len(documents))
+	}
+	for idx := range documents {
+		if !bytes.Equal(jobs[0].Documents[idx], documents[idx]) {
+			t.Errorf("document %d = %q, want %q", idx, jobs[0].Documents[idx], documents[idx])
+		}
+	}
+}
+
+func TestCUPSPrinter_SubmitJobNoDocuments(t *testing.T) {
+	srv := ipptest.NewServer()
+	defer srv.Close()
+
+	printer := newStandInPrinter(srv)
+
+	if _, err := printer.SubmitJob(context.Background(), "empty", nil, PrintOptions{}); err == nil {
+		t.Error("SubmitJob() with no documents expected an error")
+	}
+
+	if jobs := srv.Jobs(); len(jobs) != 0 {
+		t.Errorf("stand-in server received %d jobs, want 0", len(jobs))
+	}
+}
+
+func TestCUPSPrinter_GetJob(t *testing.T) {
+	srv := ipptest.NewServer()
+	srv.HoldJobs = true
+	defer srv.Close()
+
+	printer := newStandInPrinter(srv)
+	ctx := context.Background()
+
+	job, err := printer.SubmitJob(ctx, "held", [][]byte{[]byte("%PDF-1.4")}, PrintOptions{})
+	if err != nil {
+		t.Fatalf("SubmitJob() error = %v", err)
+	}
+	if job.Status != JobStateProcessing {
+		t.Errorf("SubmitJob() status = %v, want %v", job.Status, JobStateProcessing)
+	}
+	if IsJobStateFinal(job.Status) {
+		t.Errorf("IsJobStateFinal(%v) = true, want false", job.Status)
+	}
+
+	jobID, _ := strconv.Atoi(job.ID)
+	if !srv.SetJobState(jobID, JobStateAborted, "paper jam") {
+		t.Fatalf("SetJobState() job %d not found", jobID)
+	}
+
+	got, err := printer.GetJob(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("GetJob() error = %v", err)
+	}
+	if got.Status != JobStateAborted {
+		t.Errorf("GetJob() status = %v, want %v", got.Status, JobStateAborted)
+	}
+	if got.StateMessage != "paper jam" {
+		t.Errorf("GetJob() state message = %v, want %v", got.StateMessage, "paper jam")
+	}
+	if !IsJobStateFinal(got.Status) {
+		t.Errorf("IsJobStateFinal(%v) = false, want true", got.Status)
+	}
+
+	if _, err := printer.GetJob(ctx, "999"); err == nil {
+		t.Error("GetJob() for unknown job expected an error")
+	}
+}
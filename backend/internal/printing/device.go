@@ -12,6 +12,7 @@ type Device struct {
 	Host     string
 	Port     int
 	Protocol string // "cups", "raw", "http"
+	Queue    string // CUPS printer queue name, "default" when empty
 	Timeout  time.Duration
 }
 
@@ -42,11 +43,12 @@ type ScanJob struct {
 
 // PrintJob represents a print job
 type PrintJob struct {
-	ID       string
-	Status   string
-	Pages    int
-	Created  time.Time
-	Document string
+	ID           string
+	Status       string
+	StateMessage string
+	Pages        int
+	Created      time.Time
+	Document     string
 }
 
 // PrintOptions defines printing parameters
@@ -0,0 +1,233 @@
+// Package ipptest provides a minimal in-process IPP printer that stands in
+// for a CUPS server in tests and local development. It implements the subset
+// of IPP used by printing.CUPSPrinter: Print-Job, Create-Job, Send-Document
+// and Get-Job-Attributes. Submitted documents are recorded, not printed.
+package ipptest
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/OpenPrinting/goipp"
+)
+
+// IPP job-state keywords and their enum values
+var jobStateEnums = map[string]int{
+	"pending":            3,
+	"pending-held":       4,
+	"processing":         5,
+	"processing-stopped": 6,
+	"canceled":           7,
+	"aborted":            8,
+	"completed":          9,
+}
+
+// Job is a job received by the stand-in server
+type Job struct {
+	ID           int
+	Name         string
+	State        string
+	StateMessage string
+	Documents    [][]byte
+}
+
+// Server is a stand-in IPP printer backed by httptest.Server
+type Server struct {
+	// HoldJobs leaves jobs in the processing state once their last document
+	// arrives instead of completing them immediately. Use SetJobState to
+	// move held jobs on.
+	HoldJobs bool
+
+	server *httptest.Server
+	mu     sync.Mutex
+	jobs   map[int]*Job
+	nextID int
+}
+
+// NewServer starts a stand-in IPP printer listening on a local port
+func NewServer() *Server {
+	s := &Server{
+		jobs:   make(map[int]*Job),
+		nextID: 1,
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Close shuts the server down
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Host returns the host the server is listening on
+func (s *Server) Host() string {
+	host, _, _ := net.SplitHostPort(s.server.Listener.Addr().String())
+	return host
+}
+
+// Port returns the port the server is listening on
+func (s *Server) Port() int {
+	_, port, _ := net.SplitHostPort(s.server.Listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return p
+}
+
+// Jobs returns a copy of all jobs received, ordered by job ID
+func (s *Server) Jobs() []Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs := make([]Job, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		jobs = append(jobs, *job)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+
+	return jobs
+}
+
+// SetJobState changes the state of a job, returning false when the job does not exist
+func (s *Server) SetJobState(id int, state, message string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return false
+	}
+	job.State = state
+	job.StateMessage = message
+
+	return true
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	req := &goipp.Message{}
+	if err := req.Decode(r.Body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	status, job := s.dispatch(req, data)
+	var jobCopy *Job
+	if job != nil {
+		c := *job
+		jobCopy = &c
+	}
+	s.mu.Unlock()
+
+	resp := goipp.NewResponse(goipp.DefaultVersion, status, req.RequestID)
+	resp.Operation.Add(goipp.MakeAttribute("attributes-charset", goipp.TagCharset, goipp.String("utf-8")))
+	resp.Operation.Add(goipp.MakeAttribute("attributes-natural-language", goipp.TagLanguage, goipp.String("en-US")))
+
+	if jobCopy != nil {
+		resp.Job.Add(goipp.MakeAttribute("job-id", goipp.TagInteger, goipp.Integer(jobCopy.ID)))
+		resp.Job.Add(goipp.MakeAttribute("job-state", goipp.TagEnum, goipp.Integer(jobStateEnums[jobCopy.State])))
+		if jobCopy.StateMessage != "" {
+			resp.Job.Add(goipp.MakeAttribute("job-state-message", goipp.TagText, goipp.String(jobCopy.StateMessage)))
+		}
+	}
+
+	w.Header().Set("Content-Type", goipp.ContentType)
+	w.WriteHeader(http.StatusOK)
+	_ = resp.Encode(w)
+}
+
+// dispatch applies a request to the job table. Callers must hold s.mu.
+func (s *Server) dispatch(req *goipp.Message, data []byte) (goipp.Status, *Job) {
+	switch goipp.Op(req.Code) {
+	case goipp.OpPrintJob:
+		job := s.createJob(req)
+		s.addDocument(job, data, true)
+		return goipp.StatusOk, job
+	case goipp.OpCreateJob:
+		return goipp.StatusOk, s.createJob(req)
+	case goipp.OpSendDocument:
+		job, ok := s.jobs[intAttribute(req.Operation, "job-id")]
+		if !ok {
+			return goipp.StatusErrorNotFound, nil
+		}
+		s.addDocument(job, data, boolAttribute(req.Operation, "last-document"))
+		return goipp.StatusOk, job
+	case goipp.OpGetJobAttributes:
+		job, ok := s.jobs[intAttribute(req.Operation, "job-id")]
+		if !ok {
+			return goipp.StatusErrorNotFound, nil
+		}
+		return goipp.StatusOk, job
+	default:
+		return goipp.StatusErrorOperationNotSupported, nil
+	}
+}
+
+func (s *Server) createJob(req *goipp.Message) *Job {
+	job := &Job{
+		ID:    s.nextID,
+		Name:  stringAttribute(req.Operation, "job-name"),
+		State: "pending",
+	}
+	s.jobs[job.ID] = job
+	s.nextID++
+
+	return job
+}
+
+func (s *Server) addDocument(job *Job, data []byte, last bool) {
+	job.Documents = append(job.Documents, data)
+	if !last {
+		return
+	}
+	if s.HoldJobs {
+		job.State = "processing"
+		return
+	}
+	job.State = "completed"
+}
+
+func intAttribute(attrs goipp.Attributes, name string) int {
+	for _, attr := range attrs {
+		if attr.Name == name && len(attr.Values) > 0 {
+			if v, ok := attr.Values[0].V.(goipp.Integer); ok {
+				return int(v)
+			}
+		}
+	}
+	return 0
+}
+
+func boolAttribute(attrs goipp.Attributes, name string) bool {
+	for _, attr := range attrs {
+		if attr.Name == name && len(attr.Values) > 0 {
+			if v, ok := attr.Values[0].V.(goipp.Boolean); ok {
+				return bool(v)
+			}
+		}
+	}
+	return false
+}
+
+func stringAttribute(attrs goipp.Attributes, name string) string {
+	for _, attr := range attrs {
+		if attr.Name == name && len(attr.Values) > 0 {
+			return attr.Values[0].V.String()
+		}
+	}
+	return ""
+}
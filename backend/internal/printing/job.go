@@ -0,0 +1,56 @@
+package printing
+
+import "context"
+
+// Print job states as reported by the IPP job-state attribute
+const (
+	JobStatePending           = "pending"
+	JobStatePendingHeld       = "pending-held"
+	JobStateProcessing        = "processing"
+	JobStateProcessingStopped = "processing-stopped"
+	JobStateCanceled          = "canceled"
+	JobStateAborted           = "aborted"
+	JobStateCompleted         = "completed"
+)
+
+// JobTracker is implemented by printers that can submit a collated
+// multi-document job and report on its progress after submission.
+type JobTracker interface {
+	// SubmitJob submits all documents as a single job, printed in order.
+	SubmitJob(ctx context.Context, name string, documents [][]byte, options PrintOptions) (*PrintJob, error)
+
+	// GetJob returns the current state of a previously submitted job.
+	GetJob(ctx context.Context, jobID string) (*PrintJob, error)
+}
+
+// IsJobStateFinal reports whether a job in the given state will not change state again.
+func IsJobStateFinal(state string) bool {
+	switch state {
+	case JobStateCanceled, JobStateAborted, JobStateCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+// jobStateFromEnum maps an IPP job-state enum value to its keyword.
+func jobStateFromEnum(v int) string {
+	switch v {
+	case 3:
+		return JobStatePending
+	case 4:
+		return JobStatePendingHeld
+	case 5:
+		return JobStateProcessing
+	case 6:
+		return JobStateProcessingStopped
+	case 7:
+		return JobStateCanceled
+	case 8:
+		return JobStateAborted
+	case 9:
+		return JobStateCompleted
+	default:
+		return ""
+	}
+}
@@ -13,9 +13,11 @@ const (
 )
 
 const (
-	FieldAccountName     string = "name"
-	FieldAccountStatus   string = "status"
-	FieldAccountTimezone string = "timezone"
+	FieldAccountName               string = "name"
+	FieldAccountStatus             string = "status"
+	FieldAccountTimezone           string = "timezone"
+	FieldAccountLocale             string = "locale"
+	FieldAccountTurnReminderOptOut string = "turn_reminder_opt_out"
 )
 
 const (
@@ -28,6 +30,10 @@ type Account struct {
 	Name     string         `db:"name"`
 	Status   string         `db:"status"`
 	Timezone sql.NullString `db:"timezone"`
+	// Locale is the language turn sheets and emails are written in
+	Locale sql.NullString `db:"locale"`
+	// TurnReminderOptOut stops turn deadline reminder emails being sent
+	TurnReminderOptOut bool `db:"turn_reminder_opt_out"`
 }
 
 // ToNamedArgs converts the struct to a map of named arguments
@@ -37,5 +43,7 @@ func (r *Account) ToNamedArgs() pgx.NamedArgs {
 	args[FieldAccountName] = r.Name
 	args[FieldAccountStatus] = r.Status
 	args[FieldAccountTimezone] = r.Timezone
+	args[FieldAccountLocale] = r.Locale
+	args[FieldAccountTurnReminderOptOut] = r.TurnReminderOptOut
 	return args
 }
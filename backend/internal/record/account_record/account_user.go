@@ -19,8 +19,6 @@ const (
 	FieldAccountUserEmail                      string = "email"
 	FieldAccountUserVerificationToken          string = "verification_token"
 	FieldAccountUserVerificationTokenExpiresAt string = "verification_token_expires_at"
-	FieldAccountUserSessionToken               string = "session_token"
-	FieldAccountUserSessionTokenExpiresAt      string = "session_token_expires_at"
 	FieldAccountUserStatus                     string = "status"
 	FieldAccountUserCreatedAt                  string = "created_at"
 	FieldAccountUserUpdatedAt                  string = "updated_at"
@@ -38,8 +36,6 @@ type AccountUser struct {
 	Email                      string         `db:"email"`
 	VerificationToken          sql.NullString `db:"verification_token"`
 	VerificationTokenExpiresAt sql.NullTime   `db:"verification_token_expires_at"`
-	SessionToken               sql.NullString `db:"session_token"`
-	SessionTokenExpiresAt      sql.NullTime   `db:"session_token_expires_at"`
 	Status                     string         `db:"status"`
 }
 
@@ -49,8 +45,6 @@ func (r *AccountUser) ToNamedArgs() pgx.NamedArgs {
 	args[FieldAccountUserEmail] = r.Email
 	args[FieldAccountUserVerificationToken] = r.VerificationToken
 	args[FieldAccountUserVerificationTokenExpiresAt] = r.VerificationTokenExpiresAt
-	args[FieldAccountUserSessionToken] = r.SessionToken
-	args[FieldAccountUserSessionTokenExpiresAt] = r.SessionTokenExpiresAt
 	args[FieldAccountUserStatus] = r.Status
 	return args
 }
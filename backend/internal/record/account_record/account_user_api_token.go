@@ -0,0 +1,65 @@
+package account_record
+
+import (
+	"database/sql"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+// AccountUserAPIToken
+const (
+	TableAccountUserAPIToken string = "account_user_api_token"
+)
+
+const (
+	FieldAccountUserAPITokenID            string = "id"
+	FieldAccountUserAPITokenAccountID     string = "account_id"
+	FieldAccountUserAPITokenAccountUserID string = "account_user_id"
+	FieldAccountUserAPITokenName          string = "name"
+	FieldAccountUserAPITokenTokenPrefix   string = "token_prefix"
+	FieldAccountUserAPITokenTokenHash     string = "token_hash"
+	FieldAccountUserAPITokenPermissions   string = "permissions"
+	FieldAccountUserAPITokenGameIDs       string = "game_ids"
+	FieldAccountUserAPITokenExpiresAt     string = "expires_at"
+	FieldAccountUserAPITokenLastUsedAt    string = "last_used_at"
+	FieldAccountUserAPITokenCreatedAt     string = "created_at"
+	FieldAccountUserAPITokenUpdatedAt     string = "updated_at"
+)
+
+// Permissions an API token may grant, matching the request handler permissions
+const (
+	AccountUserAPITokenPermissionGameDesign     string = "game_design"
+	AccountUserAPITokenPermissionGameManagement string = "game_management"
+	AccountUserAPITokenPermissionGamePlaying    string = "game_playing"
+)
+
+// AccountUserAPIToken is a long lived token an account user creates for
+// automation. Only the HMAC hash of the token is stored.
+type AccountUserAPIToken struct {
+	record.Record
+	AccountID     string       `db:"account_id"`
+	AccountUserID string       `db:"account_user_id"`
+	Name          string       `db:"name"`
+	TokenPrefix   string       `db:"token_prefix"`
+	TokenHash     string       `db:"token_hash"`
+	Permissions   []string     `db:"permissions"`
+	GameIDs       []string     `db:"game_ids"`
+	ExpiresAt     sql.NullTime `db:"expires_at"`
+	LastUsedAt    sql.NullTime `db:"last_used_at"`
+}
+
+func (r *AccountUserAPIToken) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldAccountUserAPITokenAccountID] = r.AccountID
+	args[FieldAccountUserAPITokenAccountUserID] = r.AccountUserID
+	args[FieldAccountUserAPITokenName] = r.Name
+	args[FieldAccountUserAPITokenTokenPrefix] = r.TokenPrefix
+	args[FieldAccountUserAPITokenTokenHash] = r.TokenHash
+	args[FieldAccountUserAPITokenPermissions] = r.Permissions
+	args[FieldAccountUserAPITokenGameIDs] = r.GameIDs
+	args[FieldAccountUserAPITokenExpiresAt] = r.ExpiresAt
+	args[FieldAccountUserAPITokenLastUsedAt] = r.LastUsedAt
+	return args
+}
@@ -0,0 +1,50 @@
+package account_record
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+// AccountUserSession
+const (
+	TableAccountUserSession string = "account_user_session"
+)
+
+const (
+	FieldAccountUserSessionID            string = "id"
+	FieldAccountUserSessionAccountID     string = "account_id"
+	FieldAccountUserSessionAccountUserID string = "account_user_id"
+	FieldAccountUserSessionSessionToken  string = "session_token"
+	FieldAccountUserSessionExpiresAt     string = "expires_at"
+	FieldAccountUserSessionLastSeenAt    string = "last_seen_at"
+	FieldAccountUserSessionUserAgent     string = "user_agent"
+	FieldAccountUserSessionCreatedAt     string = "created_at"
+	FieldAccountUserSessionUpdatedAt     string = "updated_at"
+)
+
+// AccountUserSession is a signed in device or client of an account user. Only
+// the HMAC hash of the session token issued to the device is stored.
+type AccountUserSession struct {
+	record.Record
+	AccountID     string         `db:"account_id"`
+	AccountUserID string         `db:"account_user_id"`
+	SessionToken  string         `db:"session_token"`
+	ExpiresAt     time.Time      `db:"expires_at"`
+	LastSeenAt    time.Time      `db:"last_seen_at"`
+	UserAgent     sql.NullString `db:"user_agent"`
+}
+
+func (r *AccountUserSession) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldAccountUserSessionAccountID] = r.AccountID
+	args[FieldAccountUserSessionAccountUserID] = r.AccountUserID
+	args[FieldAccountUserSessionSessionToken] = r.SessionToken
+	args[FieldAccountUserSessionExpiresAt] = r.ExpiresAt
+	args[FieldAccountUserSessionLastSeenAt] = r.LastSeenAt
+	args[FieldAccountUserSessionUserAgent] = r.UserAgent
+	return args
+}
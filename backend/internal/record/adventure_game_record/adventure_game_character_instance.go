@@ -1,6 +1,7 @@
 package adventure_game_record
 
 import (
+	"database/sql"
 	"encoding/json"
 
 	"github.com/jackc/pgx/v5"
@@ -21,6 +22,8 @@ const (
 	FieldAdventureGameCharacterInstanceHealth                          string = "health"
 	FieldAdventureGameCharacterInstanceInventoryCapacity               string = "inventory_capacity"
 	FieldAdventureGameCharacterInstanceLastTurnEvents                  string = "last_turn_events"
+	FieldAdventureGameCharacterInstanceLivesLost                       string = "lives_lost"
+	FieldAdventureGameCharacterInstanceRetiredAtTurn                   string = "retired_at_turn"
 	FieldAdventureGameCharacterInstanceCreatedAt                       string = "created_at"
 	FieldAdventureGameCharacterInstanceUpdatedAt                       string = "updated_at"
 	FieldAdventureGameCharacterInstanceDeletedAt                       string = "deleted_at"
@@ -35,6 +38,14 @@ type AdventureGameCharacterInstance struct {
 	Health                          int             `db:"health"`
 	InventoryCapacity               int             `db:"inventory_capacity"`
 	LastTurnEvents                  json.RawMessage `db:"last_turn_events"`
+	LivesLost                       int             `db:"lives_lost"`
+	RetiredAtTurn                   sql.NullInt64   `db:"retired_at_turn"`
+}
+
+// IsRetired returns true when the character has run out of lives and no
+// longer takes part in the game.
+func (r *AdventureGameCharacterInstance) IsRetired() bool {
+	return r.RetiredAtTurn.Valid
 }
 
 func (r *AdventureGameCharacterInstance) ToNamedArgs() pgx.NamedArgs {
@@ -46,5 +57,7 @@ func (r *AdventureGameCharacterInstance) ToNamedArgs() pgx.NamedArgs {
 	args[FieldAdventureGameCharacterInstanceHealth] = r.Health
 	args[FieldAdventureGameCharacterInstanceInventoryCapacity] = r.InventoryCapacity
 	args[FieldAdventureGameCharacterInstanceLastTurnEvents] = r.LastTurnEvents
+	args[FieldAdventureGameCharacterInstanceLivesLost] = r.LivesLost
+	args[FieldAdventureGameCharacterInstanceRetiredAtTurn] = r.RetiredAtTurn
 	return args
 }
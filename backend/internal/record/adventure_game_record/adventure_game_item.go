@@ -16,6 +16,7 @@ const (
 	FieldAdventureGameItemCategory       = "item_category"
 	FieldAdventureGameItemEquipmentSlot  = "equipment_slot"
 	FieldAdventureGameItemIsStartingItem = "is_starting_item"
+	FieldAdventureGameItemIsGoalItem     = "is_goal_item"
 )
 
 // Equipment slot constants — the possible values for the equipment_slot column.
@@ -35,6 +36,7 @@ type AdventureGameItem struct {
 	ItemCategory   *string `db:"item_category"`
 	EquipmentSlot  *string `db:"equipment_slot"`
 	IsStartingItem bool    `db:"is_starting_item"`
+	IsGoalItem     bool    `db:"is_goal_item"`
 }
 
 func (r *AdventureGameItem) ToNamedArgs() pgx.NamedArgs {
@@ -46,5 +48,6 @@ func (r *AdventureGameItem) ToNamedArgs() pgx.NamedArgs {
 	args[FieldAdventureGameItemCategory] = r.ItemCategory
 	args[FieldAdventureGameItemEquipmentSlot] = r.EquipmentSlot
 	args[FieldAdventureGameItemIsStartingItem] = r.IsStartingItem
+	args[FieldAdventureGameItemIsGoalItem] = r.IsGoalItem
 	return args
 }
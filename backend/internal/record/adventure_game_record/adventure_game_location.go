@@ -16,6 +16,7 @@ const (
 	FieldAdventureGameLocationName               string = "name"
 	FieldAdventureGameLocationDescription        string = "description"
 	FieldAdventureGameLocationIsStartingLocation string = "is_starting_location"
+	FieldAdventureGameLocationIsGoalLocation     string = "is_goal_location"
 )
 
 type AdventureGameLocation struct {
@@ -24,6 +25,7 @@ type AdventureGameLocation struct {
 	Name               string `db:"name"`
 	Description        string `db:"description"`
 	IsStartingLocation bool   `db:"is_starting_location"`
+	IsGoalLocation     bool   `db:"is_goal_location"`
 }
 
 func (r *AdventureGameLocation) ToNamedArgs() pgx.NamedArgs {
@@ -32,5 +34,6 @@ func (r *AdventureGameLocation) ToNamedArgs() pgx.NamedArgs {
 	args[FieldAdventureGameLocationName] = r.Name
 	args[FieldAdventureGameLocationDescription] = r.Description
 	args[FieldAdventureGameLocationIsStartingLocation] = r.IsStartingLocation
+	args[FieldAdventureGameLocationIsGoalLocation] = r.IsGoalLocation
 	return args
 }
@@ -28,6 +28,7 @@ const (
 	AdventureGameTurnSheetTypeCombat              = "adventure_game_combat"
 	AdventureGameTurnSheetTypePuzzle              = "adventure_game_puzzle"
 	AdventureGameTurnSheetTypeCreatureEncounter   = "adventure_game_monster"
+	AdventureGameTurnSheetTypeAdventureEnded      = "adventure_game_adventure_ended"
 )
 
 // AdventureGameTurnSheetProcessingOrder defines the order in which
@@ -83,8 +84,25 @@ var AdventureGameSheetTypes = set.New(
 	AdventureGameTurnSheetTypeCombat,
 	AdventureGameTurnSheetTypePuzzle,
 	AdventureGameTurnSheetTypeCreatureEncounter,
+	AdventureGameTurnSheetTypeAdventureEnded,
 )
 
+// Standing orders a player can leave for a turn sheet type. When the game
+// instance missed turn policy is standing orders they are carried out for
+// turn sheets the player has not submitted by the turn deadline.
+const (
+	// AdventureGameStandingOrderFleeAggressiveCreatures moves the character
+	// to the first open exit when aggressive creatures share its location.
+	AdventureGameStandingOrderFleeAggressiveCreatures = "flee_aggressive_creatures"
+)
+
+// AdventureGameStandingOrders lists the standing orders available for each adventure game turn sheet type
+var AdventureGameStandingOrders = map[string][]string{
+	AdventureGameTurnSheetTypeLocationChoice: {
+		AdventureGameStandingOrderFleeAggressiveCreatures,
+	},
+}
+
 type AdventureGameTurnSheet struct {
 	record.Record
 	GameID                           string `db:"game_id"`
@@ -21,8 +21,9 @@ const (
 )
 
 const (
-	GameTypeAdventure   string = "adventure"
-	GameTypeMecha string = "mecha"
+	GameTypeAdventure    string = "adventure"
+	GameTypeMecha        string = "mecha"
+	GameTypeMechaTactics string = "mecha_tactics"
 )
 
 const (
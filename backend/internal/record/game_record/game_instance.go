@@ -27,6 +27,10 @@ const (
 	FieldGameInstanceClosedTestingJoinGameKeyExpiresAt string = "closed_testing_join_game_key_expires_at"
 	FieldGameInstanceTurnDurationHours                 string = "turn_duration_hours"
 	FieldGameInstanceProcessWhenAllSubmitted           string = "process_when_all_submitted"
+	FieldGameInstanceMaxTurns                          string = "max_turns"
+	FieldGameInstanceMissedTurnPolicy                  string = "missed_turn_policy"
+	FieldGameInstanceMissedTurnTakeoverAfter           string = "missed_turn_takeover_after"
+	FieldGameInstanceMissedTurnDropAfter               string = "missed_turn_drop_after"
 	FieldGameInstanceStartedAt                         string = "started_at"
 	FieldGameInstanceCompletedAt                       string = "completed_at"
 	FieldGameInstanceLastTurnProcessedAt               string = "last_turn_processed_at"
@@ -45,6 +49,16 @@ const (
 	GameInstanceStatusCancelled = "cancelled"
 )
 
+// Missed turn policy constants determine what happens to a player's turn sheets
+// that have not been submitted by the turn deadline
+const (
+	// GameInstanceMissedTurnPolicyIdle leaves unsubmitted turn sheets unprocessed
+	GameInstanceMissedTurnPolicyIdle = "idle"
+	// GameInstanceMissedTurnPolicyStandingOrders carries out the player's standing
+	// orders for unsubmitted turn sheets
+	GameInstanceMissedTurnPolicyStandingOrders = "standing_orders"
+)
+
 type GameInstance struct {
 	record.Record
 	GameID                            string         `db:"game_id"`
@@ -63,6 +77,10 @@ type GameInstance struct {
 	ClosedTestingJoinGameKeyExpiresAt sql.NullTime   `db:"closed_testing_join_game_key_expires_at"`
 	TurnDurationHours                 int            `db:"turn_duration_hours"`
 	ProcessWhenAllSubmitted           bool           `db:"process_when_all_submitted"`
+	MaxTurns                          sql.NullInt64  `db:"max_turns"`
+	MissedTurnPolicy                  string         `db:"missed_turn_policy"`
+	MissedTurnTakeoverAfter           sql.NullInt64  `db:"missed_turn_takeover_after"`
+	MissedTurnDropAfter               sql.NullInt64  `db:"missed_turn_drop_after"`
 }
 
 func (r *GameInstance) ToNamedArgs() pgx.NamedArgs {
@@ -83,5 +101,9 @@ func (r *GameInstance) ToNamedArgs() pgx.NamedArgs {
 	args[FieldGameInstanceClosedTestingJoinGameKeyExpiresAt] = r.ClosedTestingJoinGameKeyExpiresAt
 	args[FieldGameInstanceTurnDurationHours] = r.TurnDurationHours
 	args[FieldGameInstanceProcessWhenAllSubmitted] = r.ProcessWhenAllSubmitted
+	args[FieldGameInstanceMaxTurns] = r.MaxTurns
+	args[FieldGameInstanceMissedTurnPolicy] = r.MissedTurnPolicy
+	args[FieldGameInstanceMissedTurnTakeoverAfter] = r.MissedTurnTakeoverAfter
+	args[FieldGameInstanceMissedTurnDropAfter] = r.MissedTurnDropAfter
 	return args
 }
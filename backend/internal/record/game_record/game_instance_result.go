@@ -0,0 +1,81 @@
+package game_record
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/collection/set"
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+// GameInstanceResult
+const (
+	TableGameInstanceResult string = "game_instance_result"
+)
+
+const (
+	FieldGameInstanceResultID                         string = "id"
+	FieldGameInstanceResultGameID                     string = "game_id"
+	FieldGameInstanceResultGameInstanceID             string = "game_instance_id"
+	FieldGameInstanceResultGameSubscriptionInstanceID string = "game_subscription_instance_id"
+	FieldGameInstanceResultPlacing                    string = "placing"
+	FieldGameInstanceResultIsWinner                   string = "is_winner"
+	FieldGameInstanceResultEndCondition               string = "end_condition"
+	FieldGameInstanceResultSummary                    string = "summary"
+	FieldGameInstanceResultCreatedAt                  string = "created_at"
+	FieldGameInstanceResultUpdatedAt                  string = "updated_at"
+	FieldGameInstanceResultDeletedAt                  string = "deleted_at"
+)
+
+// End condition constants
+// - max_turns: The run reached its configured maximum number of turns
+// - goal_location_reached: An adventure character reached a goal location
+// - goal_item_held: An adventure character is holding a goal item
+// - all_characters_retired: Every adventure character has run out of lives
+// - last_squad_standing: Only one mecha squad has mechs left standing
+// - objective_held: A mecha squad held an objective sector for the required turns
+// - last_side_standing: Only one mecha tactics player or computer opponent has mechs left standing
+const (
+	GameEndConditionMaxTurns             string = "max_turns"
+	GameEndConditionGoalLocationReached  string = "goal_location_reached"
+	GameEndConditionGoalItemHeld         string = "goal_item_held"
+	GameEndConditionAllCharactersRetired string = "all_characters_retired"
+	GameEndConditionLastSquadStanding    string = "last_squad_standing"
+	GameEndConditionObjectiveHeld        string = "objective_held"
+	GameEndConditionLastSideStanding     string = "last_side_standing"
+)
+
+var GameEndConditions = set.New(
+	GameEndConditionMaxTurns,
+	GameEndConditionGoalLocationReached,
+	GameEndConditionGoalItemHeld,
+	GameEndConditionAllCharactersRetired,
+	GameEndConditionLastSquadStanding,
+	GameEndConditionObjectiveHeld,
+	GameEndConditionLastSideStanding,
+)
+
+// GameInstanceResult is a player's final placing when a game instance completes.
+// Players sharing a placing are tied; IsWinner is set for every player placed first
+// when the end condition produced a winner.
+type GameInstanceResult struct {
+	record.Record
+	GameID                     string `db:"game_id"`
+	GameInstanceID             string `db:"game_instance_id"`
+	GameSubscriptionInstanceID string `db:"game_subscription_instance_id"`
+	Placing                    int    `db:"placing"`
+	IsWinner                   bool   `db:"is_winner"`
+	EndCondition               string `db:"end_condition"`
+	Summary                    string `db:"summary"`
+}
+
+func (r *GameInstanceResult) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldGameInstanceResultGameID] = r.GameID
+	args[FieldGameInstanceResultGameInstanceID] = r.GameInstanceID
+	args[FieldGameInstanceResultGameSubscriptionInstanceID] = r.GameSubscriptionInstanceID
+	args[FieldGameInstanceResultPlacing] = r.Placing
+	args[FieldGameInstanceResultIsWinner] = r.IsWinner
+	args[FieldGameInstanceResultEndCondition] = r.EndCondition
+	args[FieldGameInstanceResultSummary] = r.Summary
+	return args
+}
@@ -34,4 +34,9 @@ type GameParameter struct {
 	// value is explicitly set. This is optional - the game engine may have
 	// its own internal defaults
 	DefaultValue string
+
+	// MinValue and MaxValue bound the values accepted for an integer
+	// parameter. A nil bound is not enforced
+	MinValue *int
+	MaxValue *int
 }
@@ -0,0 +1,92 @@
+package game_record
+
+import (
+	"database/sql"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/collection/set"
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+// GamePrintBatch
+const (
+	TableGamePrintBatch string = "game_print_batch"
+)
+
+const (
+	FieldGamePrintBatchID                         string = "id"
+	FieldGamePrintBatchGameID                     string = "game_id"
+	FieldGamePrintBatchGameInstanceID             string = "game_instance_id"
+	FieldGamePrintBatchGameSubscriptionInstanceID string = "game_subscription_instance_id"
+	FieldGamePrintBatchTurnNumber                 string = "turn_number"
+	FieldGamePrintBatchStatus                     string = "status"
+	FieldGamePrintBatchPlayerCount                string = "player_count"
+	FieldGamePrintBatchDocumentCount              string = "document_count"
+	FieldGamePrintBatchPrinterJobID               string = "printer_job_id"
+	FieldGamePrintBatchPrinterJobState            string = "printer_job_state"
+	FieldGamePrintBatchPrinterJobStateMessage     string = "printer_job_state_message"
+	FieldGamePrintBatchErrorMessage               string = "error_message"
+	FieldGamePrintBatchSubmittedAt                string = "submitted_at"
+	FieldGamePrintBatchCompletedAt                string = "completed_at"
+	FieldGamePrintBatchCreatedAt                  string = "created_at"
+	FieldGamePrintBatchUpdatedAt                  string = "updated_at"
+	FieldGamePrintBatchDeletedAt                  string = "deleted_at"
+)
+
+// Print batch status constants
+// - pending: The batch has been recorded and is waiting to be rendered and submitted
+// - submitted: The batch has been submitted to the print device and the job is being tracked
+// - completed: The print device reported the job completed
+// - failed: Rendering or submission failed, or the print device aborted or canceled the job
+const (
+	GamePrintBatchStatusPending   string = "pending"
+	GamePrintBatchStatusSubmitted string = "submitted"
+	GamePrintBatchStatusCompleted string = "completed"
+	GamePrintBatchStatusFailed    string = "failed"
+)
+
+var GamePrintBatchStatuses = set.New(
+	GamePrintBatchStatusPending,
+	GamePrintBatchStatusSubmitted,
+	GamePrintBatchStatusCompleted,
+	GamePrintBatchStatusFailed,
+)
+
+// GamePrintBatch is a per-turn batch of printed turn sheets for players with
+// physical post or local delivery. When GameSubscriptionInstanceID is set the
+// batch is a reprint for a single player.
+type GamePrintBatch struct {
+	record.Record
+	GameID                     string         `db:"game_id"`
+	GameInstanceID             string         `db:"game_instance_id"`
+	GameSubscriptionInstanceID sql.NullString `db:"game_subscription_instance_id"`
+	TurnNumber                 int            `db:"turn_number"`
+	Status                     string         `db:"status"`
+	PlayerCount                int            `db:"player_count"`
+	DocumentCount              int            `db:"document_count"`
+	PrinterJobID               sql.NullString `db:"printer_job_id"`
+	PrinterJobState            sql.NullString `db:"printer_job_state"`
+	PrinterJobStateMessage     sql.NullString `db:"printer_job_state_message"`
+	ErrorMessage               sql.NullString `db:"error_message"`
+	SubmittedAt                sql.NullTime   `db:"submitted_at"`
+	CompletedAt                sql.NullTime   `db:"completed_at"`
+}
+
+func (r *GamePrintBatch) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldGamePrintBatchGameID] = r.GameID
+	args[FieldGamePrintBatchGameInstanceID] = r.GameInstanceID
+	args[FieldGamePrintBatchGameSubscriptionInstanceID] = r.GameSubscriptionInstanceID
+	args[FieldGamePrintBatchTurnNumber] = r.TurnNumber
+	args[FieldGamePrintBatchStatus] = r.Status
+	args[FieldGamePrintBatchPlayerCount] = r.PlayerCount
+	args[FieldGamePrintBatchDocumentCount] = r.DocumentCount
+	args[FieldGamePrintBatchPrinterJobID] = r.PrinterJobID
+	args[FieldGamePrintBatchPrinterJobState] = r.PrinterJobState
+	args[FieldGamePrintBatchPrinterJobStateMessage] = r.PrinterJobStateMessage
+	args[FieldGamePrintBatchErrorMessage] = r.ErrorMessage
+	args[FieldGamePrintBatchSubmittedAt] = r.SubmittedAt
+	args[FieldGamePrintBatchCompletedAt] = r.CompletedAt
+	return args
+}
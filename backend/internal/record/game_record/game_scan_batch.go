@@ -0,0 +1,97 @@
+package game_record
+
+import (
+	"database/sql"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/collection/set"
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+// GameScanBatch
+const (
+	TableGameScanBatch string = "game_scan_batch"
+)
+
+const (
+	FieldGameScanBatchID              string = "id"
+	FieldGameScanBatchGameID          string = "game_id"
+	FieldGameScanBatchGameInstanceID  string = "game_instance_id"
+	FieldGameScanBatchStatus          string = "status"
+	FieldGameScanBatchFileName        string = "file_name"
+	FieldGameScanBatchDocumentData    string = "document_data"
+	FieldGameScanBatchPageCount       string = "page_count"
+	FieldGameScanBatchPagesProcessed  string = "pages_processed"
+	FieldGameScanBatchMatchedCount    string = "matched_count"
+	FieldGameScanBatchDuplicateCount  string = "duplicate_count"
+	FieldGameScanBatchUnreadableCount string = "unreadable_count"
+	FieldGameScanBatchWrongTurnCount  string = "wrong_turn_count"
+	FieldGameScanBatchWrongGameCount  string = "wrong_game_count"
+	FieldGameScanBatchErrorMessage    string = "error_message"
+	FieldGameScanBatchCompletedAt     string = "completed_at"
+	FieldGameScanBatchCreatedAt       string = "created_at"
+	FieldGameScanBatchUpdatedAt       string = "updated_at"
+	FieldGameScanBatchDeletedAt       string = "deleted_at"
+)
+
+// GameScanBatchMaxSize is the maximum size of an uploaded scan batch document
+const GameScanBatchMaxSize int = 104857600 // 100MB in bytes
+
+// Scan batch status constants
+// - pending: The document has been uploaded and is waiting to be split into pages
+// - processing: The document has been split and pages are being routed to turn sheets
+// - completed: Every page has been processed
+// - failed: The document could not be split into pages
+const (
+	GameScanBatchStatusPending    string = "pending"
+	GameScanBatchStatusProcessing string = "processing"
+	GameScanBatchStatusCompleted  string = "completed"
+	GameScanBatchStatusFailed     string = "failed"
+)
+
+var GameScanBatchStatuses = set.New(
+	GameScanBatchStatusPending,
+	GameScanBatchStatusProcessing,
+	GameScanBatchStatusCompleted,
+	GameScanBatchStatusFailed,
+)
+
+// GameScanBatch is a bulk upload of scanned turn sheets for a game instance.
+// DocumentData holds the uploaded document until it has been split into pages.
+type GameScanBatch struct {
+	record.Record
+	GameID          string         `db:"game_id"`
+	GameInstanceID  string         `db:"game_instance_id"`
+	Status          string         `db:"status"`
+	FileName        string         `db:"file_name"`
+	DocumentData    []byte         `db:"document_data"`
+	PageCount       int            `db:"page_count"`
+	PagesProcessed  int            `db:"pages_processed"`
+	MatchedCount    int            `db:"matched_count"`
+	DuplicateCount  int            `db:"duplicate_count"`
+	UnreadableCount int            `db:"unreadable_count"`
+	WrongTurnCount  int            `db:"wrong_turn_count"`
+	WrongGameCount  int            `db:"wrong_game_count"`
+	ErrorMessage    sql.NullString `db:"error_message"`
+	CompletedAt     sql.NullTime   `db:"completed_at"`
+}
+
+func (r *GameScanBatch) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldGameScanBatchGameID] = r.GameID
+	args[FieldGameScanBatchGameInstanceID] = r.GameInstanceID
+	args[FieldGameScanBatchStatus] = r.Status
+	args[FieldGameScanBatchFileName] = r.FileName
+	args[FieldGameScanBatchDocumentData] = r.DocumentData
+	args[FieldGameScanBatchPageCount] = r.PageCount
+	args[FieldGameScanBatchPagesProcessed] = r.PagesProcessed
+	args[FieldGameScanBatchMatchedCount] = r.MatchedCount
+	args[FieldGameScanBatchDuplicateCount] = r.DuplicateCount
+	args[FieldGameScanBatchUnreadableCount] = r.UnreadableCount
+	args[FieldGameScanBatchWrongTurnCount] = r.WrongTurnCount
+	args[FieldGameScanBatchWrongGameCount] = r.WrongGameCount
+	args[FieldGameScanBatchErrorMessage] = r.ErrorMessage
+	args[FieldGameScanBatchCompletedAt] = r.CompletedAt
+	return args
+}
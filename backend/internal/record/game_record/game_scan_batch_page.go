@@ -0,0 +1,82 @@
+package game_record
+
+import (
+	"database/sql"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/collection/set"
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+// GameScanBatchPage
+const (
+	TableGameScanBatchPage string = "game_scan_batch_page"
+)
+
+const (
+	FieldGameScanBatchPageID              string = "id"
+	FieldGameScanBatchPageGameScanBatchID string = "game_scan_batch_id"
+	FieldGameScanBatchPagePageNumber      string = "page_number"
+	FieldGameScanBatchPageSource          string = "source"
+	FieldGameScanBatchPageImageData       string = "image_data"
+	FieldGameScanBatchPageStatus          string = "status"
+	FieldGameScanBatchPageGameTurnSheetID string = "game_turn_sheet_id"
+	FieldGameScanBatchPageSheetType       string = "sheet_type"
+	FieldGameScanBatchPageMessage         string = "message"
+	FieldGameScanBatchPageCreatedAt       string = "created_at"
+	FieldGameScanBatchPageUpdatedAt       string = "updated_at"
+	FieldGameScanBatchPageDeletedAt       string = "deleted_at"
+)
+
+// Scan batch page status constants
+// - pending: The page has not been processed
+// - matched: The page was scanned and submitted for its turn sheet
+// - duplicate: The turn sheet had already been submitted
+// - unreadable: The turn sheet code or the page contents could not be read
+// - wrong_turn: The turn sheet is not for the current turn of the game instance
+// - wrong_game: The turn sheet belongs to a different game instance
+const (
+	GameScanBatchPageStatusPending    string = "pending"
+	GameScanBatchPageStatusMatched    string = "matched"
+	GameScanBatchPageStatusDuplicate  string = "duplicate"
+	GameScanBatchPageStatusUnreadable string = "unreadable"
+	GameScanBatchPageStatusWrongTurn  string = "wrong_turn"
+	GameScanBatchPageStatusWrongGame  string = "wrong_game"
+)
+
+var GameScanBatchPageStatuses = set.New(
+	GameScanBatchPageStatusPending,
+	GameScanBatchPageStatusMatched,
+	GameScanBatchPageStatusDuplicate,
+	GameScanBatchPageStatusUnreadable,
+	GameScanBatchPageStatusWrongTurn,
+	GameScanBatchPageStatusWrongGame,
+)
+
+// GameScanBatchPage is a single page of a scan batch and the outcome of routing
+// it to a turn sheet. ImageData holds the page image until it has been processed.
+type GameScanBatchPage struct {
+	record.Record
+	GameScanBatchID string         `db:"game_scan_batch_id"`
+	PageNumber      int            `db:"page_number"`
+	Source          string         `db:"source"`
+	ImageData       []byte         `db:"image_data"`
+	Status          string         `db:"status"`
+	GameTurnSheetID sql.NullString `db:"game_turn_sheet_id"`
+	SheetType       sql.NullString `db:"sheet_type"`
+	Message         sql.NullString `db:"message"`
+}
+
+func (r *GameScanBatchPage) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldGameScanBatchPageGameScanBatchID] = r.GameScanBatchID
+	args[FieldGameScanBatchPagePageNumber] = r.PageNumber
+	args[FieldGameScanBatchPageSource] = r.Source
+	args[FieldGameScanBatchPageImageData] = r.ImageData
+	args[FieldGameScanBatchPageStatus] = r.Status
+	args[FieldGameScanBatchPageGameTurnSheetID] = r.GameTurnSheetID
+	args[FieldGameScanBatchPageSheetType] = r.SheetType
+	args[FieldGameScanBatchPageMessage] = r.Message
+	return args
+}
@@ -2,6 +2,7 @@ package game_record
 
 import (
 	"database/sql"
+	"encoding/json"
 
 	"github.com/jackc/pgx/v5"
 
@@ -21,6 +22,11 @@ const (
 	FieldGameSubscriptionInstanceGameInstanceID          = "game_instance_id"
 	FieldGameSubscriptionInstanceTurnSheetToken          = "turn_sheet_token"
 	FieldGameSubscriptionInstanceTurnSheetTokenExpiresAt = "turn_sheet_token_expires_at"
+	FieldGameSubscriptionInstanceStandingOrders          = "standing_orders"
+	FieldGameSubscriptionInstanceMissedTurns             = "missed_turns"
+	FieldGameSubscriptionInstanceDroppedAt               = "dropped_at"
+	FieldGameSubscriptionInstanceTurnReminderTurn        = "turn_reminder_turn"
+	FieldGameSubscriptionInstanceTurnReminderHours       = "turn_reminder_hours"
 	FieldGameSubscriptionInstanceCreatedAt               = "created_at"
 	FieldGameSubscriptionInstanceUpdatedAt               = "updated_at"
 	FieldGameSubscriptionInstanceDeletedAt               = "deleted_at"
@@ -35,6 +41,34 @@ type GameSubscriptionInstance struct {
 	GameInstanceID          string         `db:"game_instance_id"`
 	TurnSheetToken          sql.NullString `db:"turn_sheet_token"`
 	TurnSheetTokenExpiresAt sql.NullTime   `db:"turn_sheet_token_expires_at"`
+	// StandingOrders is a JSON object of turn sheet type to the standing order
+	// carried out when the player misses that turn sheet
+	StandingOrders json.RawMessage `db:"standing_orders"`
+	// MissedTurns is the number of consecutive turns the player has not submitted
+	MissedTurns int          `db:"missed_turns"`
+	DroppedAt   sql.NullTime `db:"dropped_at"`
+	// TurnReminderTurn and TurnReminderHours record the turn and the hours
+	// before the turn deadline of the last turn reminder email sent
+	TurnReminderTurn  int `db:"turn_reminder_turn"`
+	TurnReminderHours int `db:"turn_reminder_hours"`
+}
+
+// IsDropped returns true when the player has been dropped from the game
+// instance for missing too many turns.
+func (r *GameSubscriptionInstance) IsDropped() bool {
+	return r.DroppedAt.Valid
+}
+
+// GetStandingOrders returns the player's standing orders keyed by turn sheet type
+func (r *GameSubscriptionInstance) GetStandingOrders() (map[string]string, error) {
+	standingOrders := map[string]string{}
+	if len(r.StandingOrders) == 0 {
+		return standingOrders, nil
+	}
+	if err := json.Unmarshal(r.StandingOrders, &standingOrders); err != nil {
+		return nil, err
+	}
+	return standingOrders, nil
 }
 
 func (r *GameSubscriptionInstance) ToNamedArgs() pgx.NamedArgs {
@@ -45,5 +79,10 @@ func (r *GameSubscriptionInstance) ToNamedArgs() pgx.NamedArgs {
 	args[FieldGameSubscriptionInstanceGameInstanceID] = r.GameInstanceID
 	args[FieldGameSubscriptionInstanceTurnSheetToken] = r.TurnSheetToken
 	args[FieldGameSubscriptionInstanceTurnSheetTokenExpiresAt] = r.TurnSheetTokenExpiresAt
+	args[FieldGameSubscriptionInstanceStandingOrders] = r.StandingOrders
+	args[FieldGameSubscriptionInstanceMissedTurns] = r.MissedTurns
+	args[FieldGameSubscriptionInstanceDroppedAt] = r.DroppedAt
+	args[FieldGameSubscriptionInstanceTurnReminderTurn] = r.TurnReminderTurn
+	args[FieldGameSubscriptionInstanceTurnReminderHours] = r.TurnReminderHours
 	return args
 }
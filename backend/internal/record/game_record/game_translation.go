@@ -0,0 +1,50 @@
+package game_record
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+// GameTranslation table name
+const TableGameTranslation string = "game_translation"
+
+// GameTranslation field names
+const (
+	FieldGameTranslationID        string = "id"
+	FieldGameTranslationGameID    string = "game_id"
+	FieldGameTranslationLocale    string = "locale"
+	FieldGameTranslationRecordID  string = "record_id"
+	FieldGameTranslationFieldName string = "field_name"
+	FieldGameTranslationText      string = "text"
+)
+
+// GameTranslation translatable content record fields
+const (
+	GameTranslationFieldNameName        string = "name"
+	GameTranslationFieldNameDescription string = "description"
+)
+
+// GameTranslation is a designer supplied translation of a single text field of
+// a game content record, such as the name of an adventure game location. Players
+// using the translation's locale see the translated text in place of the text the
+// designer wrote.
+type GameTranslation struct {
+	record.Record
+	GameID    string `db:"game_id"`
+	Locale    string `db:"locale"`
+	RecordID  string `db:"record_id"`
+	FieldName string `db:"field_name"`
+	Text      string `db:"text"`
+}
+
+// ToNamedArgs converts the GameTranslation record to named arguments for database operations
+func (r *GameTranslation) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldGameTranslationGameID] = r.GameID
+	args[FieldGameTranslationLocale] = r.Locale
+	args[FieldGameTranslationRecordID] = r.RecordID
+	args[FieldGameTranslationFieldName] = r.FieldName
+	args[FieldGameTranslationText] = r.Text
+	return args
+}
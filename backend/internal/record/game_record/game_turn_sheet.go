@@ -39,13 +39,21 @@ const (
 // Turn sheet processing status constants
 // - pending: The turn sheet has not been processed yet
 // - processed: The turn sheet has been processed successfully
+// - review: The scanned data has issues and is waiting on a manager to review it
 // - error: The turn sheet has an error
 const (
 	TurnSheetProcessingStatusPending   string = "pending"
 	TurnSheetProcessingStatusProcessed string = "processed"
+	TurnSheetProcessingStatusReview    string = "review"
 	TurnSheetProcessingStatusError     string = "error"
 )
 
+// Turn sheet types shared by all game types
+// - game_results: The final results sent to every player when a game instance completes
+const (
+	GameTurnSheetTypeGameResults string = "game_results"
+)
+
 type GameTurnSheet struct {
 	record.Record
 	GameID           string          `db:"game_id" json:"game_id"`
@@ -0,0 +1,52 @@
+package game_record
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+// GameTurnSheetScan
+const (
+	TableGameTurnSheetScan string = "game_turn_sheet_scan"
+)
+
+const (
+	FieldGameTurnSheetScanID                      string = "id"
+	FieldGameTurnSheetScanGameTurnSheetID         string = "game_turn_sheet_id"
+	FieldGameTurnSheetScanImageData               string = "image_data"
+	FieldGameTurnSheetScanConfidence              string = "confidence"
+	FieldGameTurnSheetScanIssues                  string = "issues"
+	FieldGameTurnSheetScanReviewedByAccountUserID string = "reviewed_by_account_user_id"
+	FieldGameTurnSheetScanReviewedAt              string = "reviewed_at"
+	FieldGameTurnSheetScanCreatedAt               string = "created_at"
+	FieldGameTurnSheetScanUpdatedAt               string = "updated_at"
+	FieldGameTurnSheetScanDeletedAt               string = "deleted_at"
+)
+
+// GameTurnSheetScan is the image a turn sheet was last scanned from, with the
+// confidence and issues found checking the scanned data. Issues holds a JSON
+// array of issues with a type and message.
+type GameTurnSheetScan struct {
+	record.Record
+	GameTurnSheetID         string          `db:"game_turn_sheet_id"`
+	ImageData               []byte          `db:"image_data"`
+	Confidence              float64         `db:"confidence"`
+	Issues                  json.RawMessage `db:"issues"`
+	ReviewedByAccountUserID sql.NullString  `db:"reviewed_by_account_user_id"`
+	ReviewedAt              sql.NullTime    `db:"reviewed_at"`
+}
+
+func (r *GameTurnSheetScan) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldGameTurnSheetScanGameTurnSheetID] = r.GameTurnSheetID
+	args[FieldGameTurnSheetScanImageData] = r.ImageData
+	args[FieldGameTurnSheetScanConfidence] = r.Confidence
+	args[FieldGameTurnSheetScanIssues] = r.Issues
+	args[FieldGameTurnSheetScanReviewedByAccountUserID] = r.ReviewedByAccountUserID
+	args[FieldGameTurnSheetScanReviewedAt] = r.ReviewedAt
+	return args
+}
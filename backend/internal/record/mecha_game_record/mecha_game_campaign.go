@@ -0,0 +1,38 @@
+package mecha_game_record
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+const (
+	TableMechaGameCampaign string = "mecha_game_campaign"
+)
+
+const (
+	FieldMechaGameCampaignID            string = "id"
+	FieldMechaGameCampaignAccountUserID string = "account_user_id"
+	FieldMechaGameCampaignName          string = "name"
+	FieldMechaGameCampaignDescription   string = "description"
+	FieldMechaGameCampaignCreatedAt     string = "created_at"
+	FieldMechaGameCampaignUpdatedAt     string = "updated_at"
+	FieldMechaGameCampaignDeletedAt     string = "deleted_at"
+)
+
+// MechaGameCampaign is a manager-owned sequence of mecha game instances
+// (battles) that carries each player's squad from one battle to the next.
+type MechaGameCampaign struct {
+	record.Record
+	AccountUserID string `db:"account_user_id"`
+	Name          string `db:"name"`
+	Description   string `db:"description"`
+}
+
+func (r *MechaGameCampaign) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldMechaGameCampaignAccountUserID] = r.AccountUserID
+	args[FieldMechaGameCampaignName] = r.Name
+	args[FieldMechaGameCampaignDescription] = r.Description
+	return args
+}
@@ -0,0 +1,43 @@
+package mecha_game_record
+
+import (
+	"database/sql"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+const (
+	TableMechaGameCampaignInstance string = "mecha_game_campaign_instance"
+)
+
+const (
+	FieldMechaGameCampaignInstanceID                  string = "id"
+	FieldMechaGameCampaignInstanceMechaGameCampaignID string = "mecha_game_campaign_id"
+	FieldMechaGameCampaignInstanceGameInstanceID      string = "game_instance_id"
+	FieldMechaGameCampaignInstanceSequenceNumber      string = "sequence_number"
+	FieldMechaGameCampaignInstanceRecordedAt          string = "recorded_at"
+	FieldMechaGameCampaignInstanceCreatedAt           string = "created_at"
+	FieldMechaGameCampaignInstanceUpdatedAt           string = "updated_at"
+	FieldMechaGameCampaignInstanceDeletedAt           string = "deleted_at"
+)
+
+// MechaGameCampaignInstance is a battle in a mecha campaign. RecordedAt is set
+// once the battle's outcome has been written back to the campaign rosters.
+type MechaGameCampaignInstance struct {
+	record.Record
+	MechaGameCampaignID string       `db:"mecha_game_campaign_id"`
+	GameInstanceID      string       `db:"game_instance_id"`
+	SequenceNumber      int          `db:"sequence_number"`
+	RecordedAt          sql.NullTime `db:"recorded_at"`
+}
+
+func (r *MechaGameCampaignInstance) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldMechaGameCampaignInstanceMechaGameCampaignID] = r.MechaGameCampaignID
+	args[FieldMechaGameCampaignInstanceGameInstanceID] = r.GameInstanceID
+	args[FieldMechaGameCampaignInstanceSequenceNumber] = r.SequenceNumber
+	args[FieldMechaGameCampaignInstanceRecordedAt] = r.RecordedAt
+	return args
+}
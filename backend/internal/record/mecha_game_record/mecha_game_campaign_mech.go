@@ -0,0 +1,82 @@
+package mecha_game_record
+
+import (
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+const (
+	TableMechaGameCampaignMech string = "mecha_game_campaign_mech"
+)
+
+const (
+	FieldMechaGameCampaignMechID                       string = "id"
+	FieldMechaGameCampaignMechMechaGameCampaignSquadID string = "mecha_game_campaign_squad_id"
+	FieldMechaGameCampaignMechCallsign                 string = "callsign"
+	FieldMechaGameCampaignMechChassisName              string = "chassis_name"
+	FieldMechaGameCampaignMechCurrentArmor             string = "current_armor"
+	FieldMechaGameCampaignMechCurrentStructure         string = "current_structure"
+	FieldMechaGameCampaignMechPilotSkill               string = "pilot_skill"
+	FieldMechaGameCampaignMechExperiencePoints         string = "experience_points"
+	FieldMechaGameCampaignMechWeaponConfig             string = "weapon_config"
+	FieldMechaGameCampaignMechEquipmentConfig          string = "equipment_config"
+	FieldMechaGameCampaignMechBattlesSurvived          string = "battles_survived"
+	FieldMechaGameCampaignMechCreatedAt                string = "created_at"
+	FieldMechaGameCampaignMechUpdatedAt                string = "updated_at"
+	FieldMechaGameCampaignMechDeletedAt                string = "deleted_at"
+)
+
+// MechaGameCampaignMech is a surviving mech on a campaign squad roster. The
+// chassis, weapons and equipment are held by name and resolved against the
+// game of each battle the mech is deployed to.
+type MechaGameCampaignMech struct {
+	record.Record
+	MechaGameCampaignSquadID string          `db:"mecha_game_campaign_squad_id"`
+	Callsign                 string          `db:"callsign"`
+	ChassisName              string          `db:"chassis_name"`
+	CurrentArmor             int             `db:"current_armor"`
+	CurrentStructure         int             `db:"current_structure"`
+	PilotSkill               int             `db:"pilot_skill"`
+	ExperiencePoints         int             `db:"experience_points"`
+	WeaponConfig             json.RawMessage `db:"weapon_config"`
+	EquipmentConfig          json.RawMessage `db:"equipment_config"`
+	BattlesSurvived          int             `db:"battles_survived"`
+}
+
+// CampaignWeaponEntry is a weapon mounted on a campaign mech.
+type CampaignWeaponEntry struct {
+	WeaponName   string `json:"weapon_name"`
+	SlotLocation string `json:"slot_location"`
+}
+
+// CampaignEquipmentEntry is an equipment item mounted on a campaign mech.
+type CampaignEquipmentEntry struct {
+	EquipmentName string `json:"equipment_name"`
+	SlotLocation  string `json:"slot_location"`
+}
+
+func (r *MechaGameCampaignMech) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldMechaGameCampaignMechMechaGameCampaignSquadID] = r.MechaGameCampaignSquadID
+	args[FieldMechaGameCampaignMechCallsign] = r.Callsign
+	args[FieldMechaGameCampaignMechChassisName] = r.ChassisName
+	args[FieldMechaGameCampaignMechCurrentArmor] = r.CurrentArmor
+	args[FieldMechaGameCampaignMechCurrentStructure] = r.CurrentStructure
+	args[FieldMechaGameCampaignMechPilotSkill] = r.PilotSkill
+	args[FieldMechaGameCampaignMechExperiencePoints] = r.ExperiencePoints
+	if len(r.WeaponConfig) == 0 {
+		args[FieldMechaGameCampaignMechWeaponConfig] = json.RawMessage("[]")
+	} else {
+		args[FieldMechaGameCampaignMechWeaponConfig] = r.WeaponConfig
+	}
+	if len(r.EquipmentConfig) == 0 {
+		args[FieldMechaGameCampaignMechEquipmentConfig] = json.RawMessage("[]")
+	} else {
+		args[FieldMechaGameCampaignMechEquipmentConfig] = r.EquipmentConfig
+	}
+	args[FieldMechaGameCampaignMechBattlesSurvived] = r.BattlesSurvived
+	return args
+}
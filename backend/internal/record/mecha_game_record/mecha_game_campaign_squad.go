@@ -0,0 +1,57 @@
+package mecha_game_record
+
+import (
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+const (
+	TableMechaGameCampaignSquad string = "mecha_game_campaign_squad"
+)
+
+const (
+	FieldMechaGameCampaignSquadID                  string = "id"
+	FieldMechaGameCampaignSquadMechaGameCampaignID string = "mecha_game_campaign_id"
+	FieldMechaGameCampaignSquadAccountUserID       string = "account_user_id"
+	FieldMechaGameCampaignSquadSupplyPoints        string = "supply_points"
+	FieldMechaGameCampaignSquadSalvage             string = "salvage"
+	FieldMechaGameCampaignSquadBattlesFought       string = "battles_fought"
+	FieldMechaGameCampaignSquadCreatedAt           string = "created_at"
+	FieldMechaGameCampaignSquadUpdatedAt           string = "updated_at"
+	FieldMechaGameCampaignSquadDeletedAt           string = "deleted_at"
+)
+
+// MechaGameCampaignSquad is a player's squad roster carried between the
+// battles of a campaign. The roster's mechs are MechaGameCampaignMech records.
+type MechaGameCampaignSquad struct {
+	record.Record
+	MechaGameCampaignID string          `db:"mecha_game_campaign_id"`
+	AccountUserID       string          `db:"account_user_id"`
+	SupplyPoints        int             `db:"supply_points"`
+	Salvage             json.RawMessage `db:"salvage"`
+	BattlesFought       int             `db:"battles_fought"`
+}
+
+// MechaGameSalvageItem is a salvaged weapon held by a squad. Weapons are held
+// by name so salvage can be installed in any game with a weapon of that name.
+type MechaGameSalvageItem struct {
+	WeaponName string `json:"weapon_name"`
+	Quantity   int    `json:"quantity"`
+}
+
+func (r *MechaGameCampaignSquad) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldMechaGameCampaignSquadMechaGameCampaignID] = r.MechaGameCampaignID
+	args[FieldMechaGameCampaignSquadAccountUserID] = r.AccountUserID
+	args[FieldMechaGameCampaignSquadSupplyPoints] = r.SupplyPoints
+	if len(r.Salvage) == 0 {
+		args[FieldMechaGameCampaignSquadSalvage] = json.RawMessage("[]")
+	} else {
+		args[FieldMechaGameCampaignSquadSalvage] = r.Salvage
+	}
+	args[FieldMechaGameCampaignSquadBattlesFought] = r.BattlesFought
+	return args
+}
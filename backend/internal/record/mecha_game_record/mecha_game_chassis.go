@@ -23,6 +23,7 @@ const (
 	FieldMechaGameChassisSmallSlots     string = "small_slots"
 	FieldMechaGameChassisMediumSlots    string = "medium_slots"
 	FieldMechaGameChassisLargeSlots     string = "large_slots"
+	FieldMechaGameChassisSensorRange    string = "sensor_range"
 	FieldMechaGameChassisCreatedAt      string = "created_at"
 	FieldMechaGameChassisUpdatedAt      string = "updated_at"
 	FieldMechaGameChassisDeletedAt      string = "deleted_at"
@@ -35,6 +36,11 @@ const (
 	ChassisClassAssault string = "assault"
 )
 
+// DefaultChassisSensorRange is the sensor range, in sector hops, given to a
+// chassis when none is specified. Matches the mecha_game_chassis.sensor_range
+// column default.
+const DefaultChassisSensorRange int = 2
+
 // DefaultSlotsForChassisClass returns the default small/medium/large slot
 // counts to pre-fill for a newly created chassis of the given class. These
 // match the per-class backfill applied by the mecha_game_chassis_slots
@@ -69,6 +75,7 @@ type MechaGameChassis struct {
 	SmallSlots      int    `db:"small_slots"`
 	MediumSlots     int    `db:"medium_slots"`
 	LargeSlots      int    `db:"large_slots"`
+	SensorRange     int    `db:"sensor_range"`
 }
 
 func (r *MechaGameChassis) ToNamedArgs() pgx.NamedArgs {
@@ -84,5 +91,6 @@ func (r *MechaGameChassis) ToNamedArgs() pgx.NamedArgs {
 	args[FieldMechaGameChassisSmallSlots] = r.SmallSlots
 	args[FieldMechaGameChassisMediumSlots] = r.MediumSlots
 	args[FieldMechaGameChassisLargeSlots] = r.LargeSlots
+	args[FieldMechaGameChassisSensorRange] = r.SensorRange
 	return args
 }
@@ -43,6 +43,7 @@ const (
 	EquipmentEffectKindJumpJets          string = "jump_jets"
 	EquipmentEffectKindECM               string = "ecm"
 	EquipmentEffectKindAmmoBin           string = "ammo_bin"
+	EquipmentEffectKindSensor            string = "sensor"
 )
 
 // Per-kind sanity bounds for validator and client-side UI. Stacking is
@@ -55,6 +56,7 @@ const (
 	EquipmentMagnitudeMaxJumpJets          int = 5
 	EquipmentMagnitudeMaxECM               int = 50
 	EquipmentMagnitudeMaxAmmoBin           int = 200
+	EquipmentMagnitudeMaxSensor            int = 3
 )
 
 // MagnitudeMaxForEffectKind returns the per-kind cap enforced by the validator.
@@ -73,12 +75,14 @@ func MagnitudeMaxForEffectKind(kind string) int {
 		return EquipmentMagnitudeMaxECM
 	case EquipmentEffectKindAmmoBin:
 		return EquipmentMagnitudeMaxAmmoBin
+	case EquipmentEffectKindSensor:
+		return EquipmentMagnitudeMaxSensor
 	default:
 		return 0
 	}
 }
 
-// ValidEquipmentEffectKind returns true when kind is one of the six supported
+// ValidEquipmentEffectKind returns true when kind is one of the seven supported
 // effect kinds.
 func ValidEquipmentEffectKind(kind string) bool {
 	return MagnitudeMaxForEffectKind(kind) > 0
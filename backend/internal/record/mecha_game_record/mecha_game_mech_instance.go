@@ -1,6 +1,8 @@
 package mecha_game_record
 
 import (
+	"database/sql"
+
 	"github.com/jackc/pgx/v5"
 
 	"gitlab.com/alienspaces/playbymail/core/record"
@@ -28,6 +30,8 @@ const (
 	FieldMechaGameMechInstanceEquipmentConfig       string = "equipment_config"
 	FieldMechaGameMechInstanceAmmoRemaining         string = "ammo_remaining"
 	FieldMechaGameMechInstanceIsRefitting           string = "is_refitting"
+	FieldMechaGameMechInstanceMechaGameCampaignMechID    string = "mecha_game_campaign_mech_id"
+	FieldMechaGameMechInstanceDestroyedBySquadInstanceID string = "destroyed_by_squad_instance_id"
 	FieldMechaGameMechInstanceCreatedAt             string = "created_at"
 	FieldMechaGameMechInstanceUpdatedAt             string = "updated_at"
 	FieldMechaGameMechInstanceDeletedAt             string = "deleted_at"
@@ -60,6 +64,11 @@ type MechaGameMechInstance struct {
 	EquipmentConfigJSON   []byte                 `db:"equipment_config"`
 	AmmoRemaining         int                    `db:"ammo_remaining"`
 	IsRefitting           bool                   `db:"is_refitting"`
+	// MechaGameCampaignMechID is the campaign roster mech this mech was
+	// deployed from; NULL for mechs fresh from the starter squad
+	MechaGameCampaignMechID sql.NullString `db:"mecha_game_campaign_mech_id"`
+	// DestroyedBySquadInstanceID is the squad whose fire destroyed this mech
+	DestroyedBySquadInstanceID sql.NullString `db:"destroyed_by_squad_instance_id"`
 }
 
 func (r *MechaGameMechInstance) ToNamedArgs() pgx.NamedArgs {
@@ -86,5 +95,7 @@ func (r *MechaGameMechInstance) ToNamedArgs() pgx.NamedArgs {
 	args[FieldMechaGameMechInstanceEquipmentConfig] = marshalConfigForWrite(r.EquipmentConfig, r.EquipmentConfigJSON)
 	args[FieldMechaGameMechInstanceAmmoRemaining] = r.AmmoRemaining
 	args[FieldMechaGameMechInstanceIsRefitting] = r.IsRefitting
+	args[FieldMechaGameMechInstanceMechaGameCampaignMechID] = r.MechaGameCampaignMechID
+	args[FieldMechaGameMechInstanceDestroyedBySquadInstanceID] = r.DestroyedBySquadInstanceID
 	return args
 }
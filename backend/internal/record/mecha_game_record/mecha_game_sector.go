@@ -11,17 +11,20 @@ const (
 )
 
 const (
-	FieldMechaGameSectorID               string = "id"
-	FieldMechaGameSectorGameID           string = "game_id"
-	FieldMechaGameSectorName             string = "name"
-	FieldMechaGameSectorDescription      string = "description"
-	FieldMechaGameSectorTerrainType      string = "terrain_type"
-	FieldMechaGameSectorElevation        string = "elevation"
-	FieldMechaGameSectorCoverModifier    string = "cover_modifier"
-	FieldMechaGameSectorIsStartingSector string = "is_starting_sector"
-	FieldMechaGameSectorCreatedAt        string = "created_at"
-	FieldMechaGameSectorUpdatedAt        string = "updated_at"
-	FieldMechaGameSectorDeletedAt        string = "deleted_at"
+	FieldMechaGameSectorID                string = "id"
+	FieldMechaGameSectorGameID            string = "game_id"
+	FieldMechaGameSectorName              string = "name"
+	FieldMechaGameSectorDescription       string = "description"
+	FieldMechaGameSectorTerrainType       string = "terrain_type"
+	FieldMechaGameSectorElevation         string = "elevation"
+	FieldMechaGameSectorCoverModifier     string = "cover_modifier"
+	FieldMechaGameSectorIsStartingSector  string = "is_starting_sector"
+	FieldMechaGameSectorIsObjectiveSector string = "is_objective_sector"
+	FieldMechaGameSectorVictoryPoints     string = "victory_points"
+	FieldMechaGameSectorCaptureTurns      string = "capture_turns"
+	FieldMechaGameSectorCreatedAt         string = "created_at"
+	FieldMechaGameSectorUpdatedAt         string = "updated_at"
+	FieldMechaGameSectorDeletedAt         string = "deleted_at"
 )
 
 const (
@@ -32,15 +35,21 @@ const (
 	SectorTerrainTypeWater  string = "water"
 )
 
+// MechaGameSector is a design sector. An objective sector is captured by a
+// squad holding it uncontested for CaptureTurns consecutive turns and scores
+// VictoryPoints for its owner at the end of every turn.
 type MechaGameSector struct {
 	record.Record
-	GameID           string `db:"game_id"`
-	Name             string `db:"name"`
-	Description      string `db:"description"`
-	TerrainType      string `db:"terrain_type"`
-	Elevation        int    `db:"elevation"`
-	CoverModifier    int    `db:"cover_modifier"`
-	IsStartingSector bool   `db:"is_starting_sector"`
+	GameID            string `db:"game_id"`
+	Name              string `db:"name"`
+	Description       string `db:"description"`
+	TerrainType       string `db:"terrain_type"`
+	Elevation         int    `db:"elevation"`
+	CoverModifier     int    `db:"cover_modifier"`
+	IsStartingSector  bool   `db:"is_starting_sector"`
+	IsObjectiveSector bool   `db:"is_objective_sector"`
+	VictoryPoints     int    `db:"victory_points"`
+	CaptureTurns      int    `db:"capture_turns"`
 }
 
 func (r *MechaGameSector) ToNamedArgs() pgx.NamedArgs {
@@ -52,5 +61,8 @@ func (r *MechaGameSector) ToNamedArgs() pgx.NamedArgs {
 	args[FieldMechaGameSectorElevation] = r.Elevation
 	args[FieldMechaGameSectorCoverModifier] = r.CoverModifier
 	args[FieldMechaGameSectorIsStartingSector] = r.IsStartingSector
+	args[FieldMechaGameSectorIsObjectiveSector] = r.IsObjectiveSector
+	args[FieldMechaGameSectorVictoryPoints] = r.VictoryPoints
+	args[FieldMechaGameSectorCaptureTurns] = r.CaptureTurns
 	return args
 }
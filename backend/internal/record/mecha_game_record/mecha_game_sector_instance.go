@@ -1,6 +1,8 @@
 package mecha_game_record
 
 import (
+	"database/sql"
+
 	"github.com/jackc/pgx/v5"
 
 	"gitlab.com/alienspaces/playbymail/core/record"
@@ -11,20 +13,31 @@ const (
 )
 
 const (
-	FieldMechaGameSectorInstanceID                  string = "id"
-	FieldMechaGameSectorInstanceGameID              string = "game_id"
-	FieldMechaGameSectorInstanceGameInstanceID      string = "game_instance_id"
-	FieldMechaGameSectorInstanceMechaGameSectorID string = "mecha_game_sector_id"
-	FieldMechaGameSectorInstanceCreatedAt           string = "created_at"
-	FieldMechaGameSectorInstanceUpdatedAt           string = "updated_at"
-	FieldMechaGameSectorInstanceDeletedAt           string = "deleted_at"
+	FieldMechaGameSectorInstanceID                         string = "id"
+	FieldMechaGameSectorInstanceGameID                     string = "game_id"
+	FieldMechaGameSectorInstanceGameInstanceID             string = "game_instance_id"
+	FieldMechaGameSectorInstanceMechaGameSectorID          string = "mecha_game_sector_id"
+	FieldMechaGameSectorInstanceControllingSquadInstanceID string = "controlling_squad_instance_id"
+	FieldMechaGameSectorInstanceControlledSinceTurn        string = "controlled_since_turn"
+	FieldMechaGameSectorInstanceOwningSquadInstanceID      string = "owning_squad_instance_id"
+	FieldMechaGameSectorInstanceCreatedAt                  string = "created_at"
+	FieldMechaGameSectorInstanceUpdatedAt                  string = "updated_at"
+	FieldMechaGameSectorInstanceDeletedAt                  string = "deleted_at"
 )
 
+// MechaGameSectorInstance is the runtime record for a design sector in a game instance.
+// ControllingSquadInstanceID is the squad holding the sector uncontested since
+// ControlledSinceTurn; both are NULL while the sector is uncontrolled.
+// OwningSquadInstanceID is the squad that captured an objective sector, kept
+// until another squad captures it.
 type MechaGameSectorInstance struct {
 	record.Record
-	GameID               string `db:"game_id"`
-	GameInstanceID       string `db:"game_instance_id"`
-	MechaGameSectorID  string `db:"mecha_game_sector_id"`
+	GameID                     string         `db:"game_id"`
+	GameInstanceID             string         `db:"game_instance_id"`
+	MechaGameSectorID          string         `db:"mecha_game_sector_id"`
+	ControllingSquadInstanceID sql.NullString `db:"controlling_squad_instance_id"`
+	ControlledSinceTurn        sql.NullInt64  `db:"controlled_since_turn"`
+	OwningSquadInstanceID      sql.NullString `db:"owning_squad_instance_id"`
 }
 
 func (r *MechaGameSectorInstance) ToNamedArgs() pgx.NamedArgs {
@@ -32,5 +45,8 @@ func (r *MechaGameSectorInstance) ToNamedArgs() pgx.NamedArgs {
 	args[FieldMechaGameSectorInstanceGameID] = r.GameID
 	args[FieldMechaGameSectorInstanceGameInstanceID] = r.GameInstanceID
 	args[FieldMechaGameSectorInstanceMechaGameSectorID] = r.MechaGameSectorID
+	args[FieldMechaGameSectorInstanceControllingSquadInstanceID] = r.ControllingSquadInstanceID
+	args[FieldMechaGameSectorInstanceControlledSinceTurn] = r.ControlledSinceTurn
+	args[FieldMechaGameSectorInstanceOwningSquadInstanceID] = r.OwningSquadInstanceID
 	return args
 }
@@ -24,11 +24,13 @@ const (
 const (
 	SquadTypeStarter  = "starter"
 	SquadTypeOpponent = "opponent"
+	SquadTypeReserve  = "reserve"
 )
 
-// MechaGameSquad is a design-time squad template. Two types exist:
+// MechaGameSquad is a design-time squad template. Three types exist:
 //   - starter: the loadout cloned for every player when they join a run (at most one per game)
 //   - opponent: a template randomly assigned to a computer opponent when a run starts
+//   - reserve: a pool of mechs used to pad player squads up to the run's squad_size (at most one per game)
 type MechaGameSquad struct {
 	record.Record
 	GameID      string `db:"game_id"`
@@ -22,6 +22,9 @@ const (
 	FieldMechaGameSquadInstanceMechaGameComputerOpponentID    string = "mecha_game_computer_opponent_id"
 	FieldMechaGameSquadInstanceLastTurnEvents             string = "last_turn_events"
 	FieldMechaGameSquadInstanceSupplyPoints               string = "supply_points"
+	FieldMechaGameSquadInstanceContacts                   string = "contacts"
+	FieldMechaGameSquadInstanceSalvage                    string = "salvage"
+	FieldMechaGameSquadInstanceVictoryPoints              string = "victory_points"
 	FieldMechaGameSquadInstanceCreatedAt                  string = "created_at"
 	FieldMechaGameSquadInstanceUpdatedAt                  string = "updated_at"
 	FieldMechaGameSquadInstanceDeletedAt                  string = "deleted_at"
@@ -39,6 +42,23 @@ type MechaGameSquadInstance struct {
 	MechaGameComputerOpponentID    sql.NullString  `db:"mecha_game_computer_opponent_id"`
 	LastTurnEvents             json.RawMessage `db:"last_turn_events"`
 	SupplyPoints               int             `db:"supply_points"`
+	Contacts                   json.RawMessage `db:"contacts"`
+	Salvage                    json.RawMessage `db:"salvage"`
+	VictoryPoints              int             `db:"victory_points"`
+}
+
+// MechaGameContact is an enemy mech a squad has detected. Contacts are kept
+// after the enemy drops out of sensor range so the squad knows where it was
+// last seen.
+type MechaGameContact struct {
+	MechInstanceID   string `json:"mech_instance_id"`
+	Callsign         string `json:"callsign"`
+	SectorInstanceID string `json:"sector_instance_id"`
+	SectorName       string `json:"sector_name"`
+	// LastSeenTurn is the turn the contact was last detected
+	LastSeenTurn int `json:"last_seen_turn"`
+	// Detected is true while the contact is inside the squad's sensor range
+	Detected bool `json:"detected"`
 }
 
 func (r *MechaGameSquadInstance) ToNamedArgs() pgx.NamedArgs {
@@ -54,5 +74,16 @@ func (r *MechaGameSquadInstance) ToNamedArgs() pgx.NamedArgs {
 		args[FieldMechaGameSquadInstanceLastTurnEvents] = r.LastTurnEvents
 	}
 	args[FieldMechaGameSquadInstanceSupplyPoints] = r.SupplyPoints
+	if len(r.Contacts) == 0 {
+		args[FieldMechaGameSquadInstanceContacts] = json.RawMessage("[]")
+	} else {
+		args[FieldMechaGameSquadInstanceContacts] = r.Contacts
+	}
+	if len(r.Salvage) == 0 {
+		args[FieldMechaGameSquadInstanceSalvage] = json.RawMessage("[]")
+	} else {
+		args[FieldMechaGameSquadInstanceSalvage] = r.Salvage
+	}
+	args[FieldMechaGameSquadInstanceVictoryPoints] = r.VictoryPoints
 	return args
 }
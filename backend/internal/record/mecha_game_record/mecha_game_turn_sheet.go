@@ -74,6 +74,32 @@ var MechaGameSheetTypes = set.New(
 	MechaGameTurnSheetTypeSquadManagement,
 )
 
+// Standing orders a player can leave for a turn sheet type. When the game
+// instance missed turn policy is standing orders they are carried out for
+// turn sheets the player has not submitted by the turn deadline.
+const (
+	// MechaGameStandingOrderHoldPosition keeps every mech in its sector and
+	// fires on the best enemy target within range.
+	MechaGameStandingOrderHoldPosition string = "hold_position"
+	// MechaGameStandingOrderRepeatLastOrders repeats the orders carried out
+	// on the previous turn's orders sheet.
+	MechaGameStandingOrderRepeatLastOrders string = "repeat_last_orders"
+	// MechaGameStandingOrderAutoRepair repairs the structure of every
+	// damaged mech waiting at a depot.
+	MechaGameStandingOrderAutoRepair string = "auto_repair"
+)
+
+// MechaGameStandingOrders lists the standing orders available for each mecha turn sheet type
+var MechaGameStandingOrders = map[string][]string{
+	MechaGameTurnSheetTypeOrders: {
+		MechaGameStandingOrderHoldPosition,
+		MechaGameStandingOrderRepeatLastOrders,
+	},
+	MechaGameTurnSheetTypeSquadManagement: {
+		MechaGameStandingOrderAutoRepair,
+	},
+}
+
 type MechaGameTurnSheet struct {
 	record.Record
 	GameID               string `db:"game_id"`
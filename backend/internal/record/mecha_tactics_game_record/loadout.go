@@ -0,0 +1,18 @@
+package mecha_tactics_game_record
+
+import "encoding/json"
+
+// marshalConfigForWrite resolves the JSON bytes to persist for a weapon_config
+// column. The decoded struct slice is preferred when it carries entries; when
+// empty the raw JSON bytes loaded from the DB are used so that read-modify-write
+// cycles (e.g. moving a mech or updating heat) don't wipe the persisted loadout.
+func marshalConfigForWrite[T any](decoded []T, raw []byte) string {
+	if len(decoded) > 0 {
+		b, _ := json.Marshal(decoded)
+		return string(b)
+	}
+	if len(raw) > 0 {
+		return string(raw)
+	}
+	return "[]"
+}
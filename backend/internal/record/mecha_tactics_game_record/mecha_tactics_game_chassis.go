@@ -0,0 +1,61 @@
+package mecha_tactics_game_record
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+const (
+	TableMechaTacticsGameChassis string = "mecha_tactics_game_chassis"
+)
+
+const (
+	FieldMechaTacticsGameChassisID              string = "id"
+	FieldMechaTacticsGameChassisGameID          string = "game_id"
+	FieldMechaTacticsGameChassisName            string = "name"
+	FieldMechaTacticsGameChassisDescription     string = "description"
+	FieldMechaTacticsGameChassisChassisClass    string = "chassis_class"
+	FieldMechaTacticsGameChassisArmorPoints     string = "armor_points"
+	FieldMechaTacticsGameChassisStructurePoints string = "structure_points"
+	FieldMechaTacticsGameChassisHeatCapacity    string = "heat_capacity"
+	FieldMechaTacticsGameChassisSpeed           string = "speed"
+	FieldMechaTacticsGameChassisCreatedAt       string = "created_at"
+	FieldMechaTacticsGameChassisUpdatedAt       string = "updated_at"
+	FieldMechaTacticsGameChassisDeletedAt       string = "deleted_at"
+)
+
+const (
+	ChassisClassLight   string = "light"
+	ChassisClassMedium  string = "medium"
+	ChassisClassHeavy   string = "heavy"
+	ChassisClassAssault string = "assault"
+)
+
+// MechaTacticsGameChassis is the blueprint for a mech body. Speed is the
+// movement point (MP) budget a mech built on this chassis may spend entering
+// hexes each turn.
+type MechaTacticsGameChassis struct {
+	record.Record
+	GameID          string `db:"game_id"`
+	Name            string `db:"name"`
+	Description     string `db:"description"`
+	ChassisClass    string `db:"chassis_class"`
+	ArmorPoints     int    `db:"armor_points"`
+	StructurePoints int    `db:"structure_points"`
+	HeatCapacity    int    `db:"heat_capacity"`
+	Speed           int    `db:"speed"`
+}
+
+func (r *MechaTacticsGameChassis) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldMechaTacticsGameChassisGameID] = r.GameID
+	args[FieldMechaTacticsGameChassisName] = r.Name
+	args[FieldMechaTacticsGameChassisDescription] = r.Description
+	args[FieldMechaTacticsGameChassisChassisClass] = r.ChassisClass
+	args[FieldMechaTacticsGameChassisArmorPoints] = r.ArmorPoints
+	args[FieldMechaTacticsGameChassisStructurePoints] = r.StructurePoints
+	args[FieldMechaTacticsGameChassisHeatCapacity] = r.HeatCapacity
+	args[FieldMechaTacticsGameChassisSpeed] = r.Speed
+	return args
+}
@@ -0,0 +1,47 @@
+package mecha_tactics_game_record
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+const (
+	TableMechaTacticsGameComputerOpponent string = "mecha_tactics_game_computer_opponent"
+)
+
+const (
+	FieldMechaTacticsGameComputerOpponentID          string = "id"
+	FieldMechaTacticsGameComputerOpponentGameID      string = "game_id"
+	FieldMechaTacticsGameComputerOpponentName        string = "name"
+	FieldMechaTacticsGameComputerOpponentDescription string = "description"
+	FieldMechaTacticsGameComputerOpponentAggression  string = "aggression"
+	FieldMechaTacticsGameComputerOpponentIQ          string = "iq"
+	FieldMechaTacticsGameComputerOpponentCreatedAt   string = "created_at"
+	FieldMechaTacticsGameComputerOpponentUpdatedAt   string = "updated_at"
+	FieldMechaTacticsGameComputerOpponentDeletedAt   string = "deleted_at"
+)
+
+// MechaTacticsGameComputerOpponent is an AI behaviour profile for
+// computer-controlled mechs.
+//
+// Aggression (1-10): 1 = purely defensive, 10 = all-out assault.
+// IQ (1-10): 1 = predictable/random moves, 10 = expert use of terrain, facing and positioning.
+type MechaTacticsGameComputerOpponent struct {
+	record.Record
+	GameID      string `db:"game_id"`
+	Name        string `db:"name"`
+	Description string `db:"description"`
+	Aggression  int    `db:"aggression"`
+	IQ          int    `db:"iq"`
+}
+
+func (r *MechaTacticsGameComputerOpponent) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldMechaTacticsGameComputerOpponentGameID] = r.GameID
+	args[FieldMechaTacticsGameComputerOpponentName] = r.Name
+	args[FieldMechaTacticsGameComputerOpponentDescription] = r.Description
+	args[FieldMechaTacticsGameComputerOpponentAggression] = r.Aggression
+	args[FieldMechaTacticsGameComputerOpponentIQ] = r.IQ
+	return args
+}
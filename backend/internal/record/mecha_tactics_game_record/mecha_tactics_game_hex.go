@@ -0,0 +1,54 @@
+package mecha_tactics_game_record
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+const (
+	TableMechaTacticsGameHex string = "mecha_tactics_game_hex"
+)
+
+const (
+	FieldMechaTacticsGameHexID                            string = "id"
+	FieldMechaTacticsGameHexGameID                        string = "game_id"
+	FieldMechaTacticsGameHexMechaTacticsGameTerrainTypeID string = "mecha_tactics_game_terrain_type_id"
+	FieldMechaTacticsGameHexHexColumn                     string = "hex_column"
+	FieldMechaTacticsGameHexHexRow                        string = "hex_row"
+	FieldMechaTacticsGameHexName                          string = "name"
+	FieldMechaTacticsGameHexDescription                   string = "description"
+	FieldMechaTacticsGameHexElevation                     string = "elevation"
+	FieldMechaTacticsGameHexIsStartingHex                 string = "is_starting_hex"
+	FieldMechaTacticsGameHexCreatedAt                     string = "created_at"
+	FieldMechaTacticsGameHexUpdatedAt                     string = "updated_at"
+	FieldMechaTacticsGameHexDeletedAt                     string = "deleted_at"
+)
+
+// MechaTacticsGameHex is a single cell of the battlefield placed at axial
+// coordinates (HexColumn, HexRow). Adjacency is derived from the coordinates,
+// there are no explicit links. Starting hexes double as depots.
+type MechaTacticsGameHex struct {
+	record.Record
+	GameID                        string `db:"game_id"`
+	MechaTacticsGameTerrainTypeID string `db:"mecha_tactics_game_terrain_type_id"`
+	HexColumn                     int    `db:"hex_column"`
+	HexRow                        int    `db:"hex_row"`
+	Name                          string `db:"name"`
+	Description                   string `db:"description"`
+	Elevation                     int    `db:"elevation"`
+	IsStartingHex                 bool   `db:"is_starting_hex"`
+}
+
+func (r *MechaTacticsGameHex) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldMechaTacticsGameHexGameID] = r.GameID
+	args[FieldMechaTacticsGameHexMechaTacticsGameTerrainTypeID] = r.MechaTacticsGameTerrainTypeID
+	args[FieldMechaTacticsGameHexHexColumn] = r.HexColumn
+	args[FieldMechaTacticsGameHexHexRow] = r.HexRow
+	args[FieldMechaTacticsGameHexName] = r.Name
+	args[FieldMechaTacticsGameHexDescription] = r.Description
+	args[FieldMechaTacticsGameHexElevation] = r.Elevation
+	args[FieldMechaTacticsGameHexIsStartingHex] = r.IsStartingHex
+	return args
+}
@@ -0,0 +1,58 @@
+package mecha_tactics_game_record
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+// WeaponConfigEntry represents a weapon assigned to a mech at a specific slot.
+type WeaponConfigEntry struct {
+	WeaponID     string `json:"weapon_id"`
+	SlotLocation string `json:"slot_location"`
+}
+
+const (
+	TableMechaTacticsGameMech string = "mecha_tactics_game_mech"
+)
+
+const (
+	FieldMechaTacticsGameMechID                        string = "id"
+	FieldMechaTacticsGameMechGameID                    string = "game_id"
+	FieldMechaTacticsGameMechMechaTacticsGameChassisID string = "mecha_tactics_game_chassis_id"
+	FieldMechaTacticsGameMechMechType                  string = "mech_type"
+	FieldMechaTacticsGameMechCallsign                  string = "callsign"
+	FieldMechaTacticsGameMechWeaponConfig              string = "weapon_config"
+	FieldMechaTacticsGameMechCreatedAt                 string = "created_at"
+	FieldMechaTacticsGameMechUpdatedAt                 string = "updated_at"
+	FieldMechaTacticsGameMechDeletedAt                 string = "deleted_at"
+)
+
+const (
+	MechTypeStarter  = "starter"
+	MechTypeOpponent = "opponent"
+)
+
+// MechaTacticsGameMech is a design-time mech template. Two types exist:
+//   - starter: cloned for every player when they join a run; the first
+//     "mech count" starter mechs (cycling when there are fewer) are issued
+//   - opponent: assigned round-robin to the computer opponents when a run starts
+type MechaTacticsGameMech struct {
+	record.Record
+	GameID                    string              `db:"game_id"`
+	MechaTacticsGameChassisID string              `db:"mecha_tactics_game_chassis_id"`
+	MechType                  string              `db:"mech_type"`
+	Callsign                  string              `db:"callsign"`
+	WeaponConfig              []WeaponConfigEntry `db:"-"`
+	WeaponConfigJSON          []byte              `db:"weapon_config"`
+}
+
+func (r *MechaTacticsGameMech) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldMechaTacticsGameMechGameID] = r.GameID
+	args[FieldMechaTacticsGameMechMechaTacticsGameChassisID] = r.MechaTacticsGameChassisID
+	args[FieldMechaTacticsGameMechMechType] = r.MechType
+	args[FieldMechaTacticsGameMechCallsign] = r.Callsign
+	args[FieldMechaTacticsGameMechWeaponConfig] = marshalConfigForWrite(r.WeaponConfig, r.WeaponConfigJSON)
+	return args
+}
@@ -0,0 +1,113 @@
+package mecha_tactics_game_record
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+const (
+	TableMechaTacticsGameMechInstance string = "mecha_tactics_game_mech_instance"
+)
+
+const (
+	FieldMechaTacticsGameMechInstanceID                                 string = "id"
+	FieldMechaTacticsGameMechInstanceGameID                             string = "game_id"
+	FieldMechaTacticsGameMechInstanceGameInstanceID                     string = "game_instance_id"
+	FieldMechaTacticsGameMechInstanceMechaTacticsGameMechID             string = "mecha_tactics_game_mech_id"
+	FieldMechaTacticsGameMechInstanceGameSubscriptionInstanceID         string = "game_subscription_instance_id"
+	FieldMechaTacticsGameMechInstanceMechaTacticsGameComputerOpponentID string = "mecha_tactics_game_computer_opponent_id"
+	FieldMechaTacticsGameMechInstanceMechaTacticsGameChassisID          string = "mecha_tactics_game_chassis_id"
+	FieldMechaTacticsGameMechInstanceMechaTacticsGameHexID              string = "mecha_tactics_game_hex_id"
+	FieldMechaTacticsGameMechInstanceFacing                             string = "facing"
+	FieldMechaTacticsGameMechInstanceCallsign                           string = "callsign"
+	FieldMechaTacticsGameMechInstanceCurrentArmor                       string = "current_armor"
+	FieldMechaTacticsGameMechInstanceCurrentStructure                   string = "current_structure"
+	FieldMechaTacticsGameMechInstanceCurrentHeat                        string = "current_heat"
+	FieldMechaTacticsGameMechInstancePilotSkill                         string = "pilot_skill"
+	FieldMechaTacticsGameMechInstanceStatus                             string = "status"
+	FieldMechaTacticsGameMechInstanceWeaponConfig                       string = "weapon_config"
+	FieldMechaTacticsGameMechInstanceSupplyPoints                       string = "supply_points"
+	FieldMechaTacticsGameMechInstanceIsRepairing                        string = "is_repairing"
+	FieldMechaTacticsGameMechInstanceLastTurnEvents                     string = "last_turn_events"
+	FieldMechaTacticsGameMechInstanceCreatedAt                          string = "created_at"
+	FieldMechaTacticsGameMechInstanceUpdatedAt                          string = "updated_at"
+	FieldMechaTacticsGameMechInstanceDeletedAt                          string = "deleted_at"
+)
+
+const (
+	MechInstanceStatusOperational string = "operational"
+	MechInstanceStatusDamaged     string = "damaged"
+	MechInstanceStatusDestroyed   string = "destroyed"
+	MechInstanceStatusShutdown    string = "shutdown"
+)
+
+// Facing values index the six faces of a flat-topped hex, clockwise from north.
+const (
+	FacingNorth     int = 0
+	FacingNorthEast int = 1
+	FacingSouthEast int = 2
+	FacingSouth     int = 3
+	FacingSouthWest int = 4
+	FacingNorthWest int = 5
+)
+
+// MechaTacticsGameMechInstance is the runtime record for a single mech in a
+// game instance. Mechs are owned individually, there are no squads.
+// For player-owned mechs, GameSubscriptionInstanceID is set; MechaTacticsGameComputerOpponentID is NULL.
+// For computer-opponent mechs, MechaTacticsGameComputerOpponentID is set; GameSubscriptionInstanceID is NULL.
+//
+// The hex map is not copied per instance; MechaTacticsGameHexID references the
+// design hex the mech currently occupies.
+type MechaTacticsGameMechInstance struct {
+	record.Record
+	GameID                             string              `db:"game_id"`
+	GameInstanceID                     string              `db:"game_instance_id"`
+	MechaTacticsGameMechID             string              `db:"mecha_tactics_game_mech_id"`
+	GameSubscriptionInstanceID         sql.NullString      `db:"game_subscription_instance_id"`
+	MechaTacticsGameComputerOpponentID sql.NullString      `db:"mecha_tactics_game_computer_opponent_id"`
+	MechaTacticsGameChassisID          string              `db:"mecha_tactics_game_chassis_id"`
+	MechaTacticsGameHexID              string              `db:"mecha_tactics_game_hex_id"`
+	Facing                             int                 `db:"facing"`
+	Callsign                           string              `db:"callsign"`
+	CurrentArmor                       int                 `db:"current_armor"`
+	CurrentStructure                   int                 `db:"current_structure"`
+	CurrentHeat                        int                 `db:"current_heat"`
+	PilotSkill                         int                 `db:"pilot_skill"`
+	Status                             string              `db:"status"`
+	WeaponConfig                       []WeaponConfigEntry `db:"-"`
+	WeaponConfigJSON                   []byte              `db:"weapon_config"`
+	SupplyPoints                       int                 `db:"supply_points"`
+	IsRepairing                        bool                `db:"is_repairing"`
+	LastTurnEvents                     json.RawMessage     `db:"last_turn_events"`
+}
+
+func (r *MechaTacticsGameMechInstance) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldMechaTacticsGameMechInstanceGameID] = r.GameID
+	args[FieldMechaTacticsGameMechInstanceGameInstanceID] = r.GameInstanceID
+	args[FieldMechaTacticsGameMechInstanceMechaTacticsGameMechID] = r.MechaTacticsGameMechID
+	args[FieldMechaTacticsGameMechInstanceGameSubscriptionInstanceID] = r.GameSubscriptionInstanceID
+	args[FieldMechaTacticsGameMechInstanceMechaTacticsGameComputerOpponentID] = r.MechaTacticsGameComputerOpponentID
+	args[FieldMechaTacticsGameMechInstanceMechaTacticsGameChassisID] = r.MechaTacticsGameChassisID
+	args[FieldMechaTacticsGameMechInstanceMechaTacticsGameHexID] = r.MechaTacticsGameHexID
+	args[FieldMechaTacticsGameMechInstanceFacing] = r.Facing
+	args[FieldMechaTacticsGameMechInstanceCallsign] = r.Callsign
+	args[FieldMechaTacticsGameMechInstanceCurrentArmor] = r.CurrentArmor
+	args[FieldMechaTacticsGameMechInstanceCurrentStructure] = r.CurrentStructure
+	args[FieldMechaTacticsGameMechInstanceCurrentHeat] = r.CurrentHeat
+	args[FieldMechaTacticsGameMechInstancePilotSkill] = r.PilotSkill
+	args[FieldMechaTacticsGameMechInstanceStatus] = r.Status
+	args[FieldMechaTacticsGameMechInstanceWeaponConfig] = marshalConfigForWrite(r.WeaponConfig, r.WeaponConfigJSON)
+	args[FieldMechaTacticsGameMechInstanceSupplyPoints] = r.SupplyPoints
+	args[FieldMechaTacticsGameMechInstanceIsRepairing] = r.IsRepairing
+	if len(r.LastTurnEvents) == 0 {
+		args[FieldMechaTacticsGameMechInstanceLastTurnEvents] = json.RawMessage("[]")
+	} else {
+		args[FieldMechaTacticsGameMechInstanceLastTurnEvents] = r.LastTurnEvents
+	}
+	return args
+}
@@ -0,0 +1,46 @@
+package mecha_tactics_game_record
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+const (
+	TableMechaTacticsGameTerrainType string = "mecha_tactics_game_terrain_type"
+)
+
+const (
+	FieldMechaTacticsGameTerrainTypeID                string = "id"
+	FieldMechaTacticsGameTerrainTypeGameID            string = "game_id"
+	FieldMechaTacticsGameTerrainTypeName              string = "name"
+	FieldMechaTacticsGameTerrainTypeDescription       string = "description"
+	FieldMechaTacticsGameTerrainTypeMovementPointCost string = "movement_point_cost"
+	FieldMechaTacticsGameTerrainTypeCoverModifier     string = "cover_modifier"
+	FieldMechaTacticsGameTerrainTypeCreatedAt         string = "created_at"
+	FieldMechaTacticsGameTerrainTypeUpdatedAt         string = "updated_at"
+	FieldMechaTacticsGameTerrainTypeDeletedAt         string = "deleted_at"
+)
+
+// MechaTacticsGameTerrainType is a designer-defined terrain classification
+// applied to hexes. MovementPointCost is the MP spent entering a hex of this
+// terrain; CoverModifier adjusts the hit chance against a mech standing in it
+// (negative values make the target harder to hit).
+type MechaTacticsGameTerrainType struct {
+	record.Record
+	GameID            string `db:"game_id"`
+	Name              string `db:"name"`
+	Description       string `db:"description"`
+	MovementPointCost int    `db:"movement_point_cost"`
+	CoverModifier     int    `db:"cover_modifier"`
+}
+
+func (r *MechaTacticsGameTerrainType) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldMechaTacticsGameTerrainTypeGameID] = r.GameID
+	args[FieldMechaTacticsGameTerrainTypeName] = r.Name
+	args[FieldMechaTacticsGameTerrainTypeDescription] = r.Description
+	args[FieldMechaTacticsGameTerrainTypeMovementPointCost] = r.MovementPointCost
+	args[FieldMechaTacticsGameTerrainTypeCoverModifier] = r.CoverModifier
+	return args
+}
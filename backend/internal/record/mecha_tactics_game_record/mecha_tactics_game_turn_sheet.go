@@ -0,0 +1,119 @@
+package mecha_tactics_game_record
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/collection/set"
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+const (
+	TableMechaTacticsGameTurnSheet string = "mecha_tactics_game_turn_sheet"
+)
+
+const (
+	FieldMechaTacticsGameTurnSheetID                             string = "id"
+	FieldMechaTacticsGameTurnSheetGameID                         string = "game_id"
+	FieldMechaTacticsGameTurnSheetMechaTacticsGameMechInstanceID string = "mecha_tactics_game_mech_instance_id"
+	FieldMechaTacticsGameTurnSheetGameTurnSheetID                string = "game_turn_sheet_id"
+	FieldMechaTacticsGameTurnSheetCreatedAt                      string = "created_at"
+	FieldMechaTacticsGameTurnSheetUpdatedAt                      string = "updated_at"
+	FieldMechaTacticsGameTurnSheetDeletedAt                      string = "deleted_at"
+)
+
+const (
+	MechaTacticsGameTurnSheetTypeJoinGame string = "mecha_tactics_join_game"
+	MechaTacticsGameTurnSheetTypeOrders   string = "mecha_tactics_orders"
+	MechaTacticsGameTurnSheetTypeRepair   string = "mecha_tactics_repair"
+)
+
+// MechaTacticsGameTurnSheetProcessingOrder defines the order in which mecha
+// tactics turn sheets are processed during turn resolution. Repair resolves
+// before orders so repairing mechs are excluded from movement and combat.
+// The join game sheet is excluded; it is handled through the subscription workflow.
+var MechaTacticsGameTurnSheetProcessingOrder = []string{
+	MechaTacticsGameTurnSheetTypeRepair, // 1 - apply structure repair and weapon swaps
+	MechaTacticsGameTurnSheetTypeOrders, // 2 - process movement, facing and attack orders
+}
+
+// MechaTacticsGameSheetOrderForType returns the 1-indexed processing order
+// for a mecha tactics turn sheet type. Returns 0 if the type is not
+// in the processing order (e.g. join_game).
+func MechaTacticsGameSheetOrderForType(sheetType string) int {
+	for i, t := range MechaTacticsGameTurnSheetProcessingOrder {
+		if t == sheetType {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// MechaTacticsGameTurnSheetPresentationOrder defines the order in which mecha
+// tactics turn sheets are presented to the player. Orders are shown first
+// (primary action), repair is secondary.
+var MechaTacticsGameTurnSheetPresentationOrder = []string{
+	MechaTacticsGameTurnSheetTypeOrders, // 1 - movement, facing and attack
+	MechaTacticsGameTurnSheetTypeRepair, // 2 - depot repairs and weapon swaps
+}
+
+// MechaTacticsGameSheetPresentationOrderForType returns the 1-indexed
+// presentation order for a mecha tactics turn sheet type. Returns 0 if not in the order.
+func MechaTacticsGameSheetPresentationOrderForType(sheetType string) int {
+	for i, t := range MechaTacticsGameTurnSheetPresentationOrder {
+		if t == sheetType {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// MechaTacticsGameSheetTypes is the set of all mecha tactics sheet type strings.
+var MechaTacticsGameSheetTypes = set.New(
+	MechaTacticsGameTurnSheetTypeJoinGame,
+	MechaTacticsGameTurnSheetTypeOrders,
+	MechaTacticsGameTurnSheetTypeRepair,
+)
+
+// Standing orders a player can leave for a turn sheet type. When the game
+// instance missed turn policy is standing orders they are carried out for
+// turn sheets the player has not submitted by the turn deadline.
+const (
+	// MechaTacticsGameStandingOrderHoldPosition keeps the mech in its hex,
+	// turns it toward the nearest enemy and fires on the best target in range.
+	MechaTacticsGameStandingOrderHoldPosition string = "hold_position"
+	// MechaTacticsGameStandingOrderRepeatLastOrders repeats the orders
+	// carried out on the previous turn's orders sheet.
+	MechaTacticsGameStandingOrderRepeatLastOrders string = "repeat_last_orders"
+	// MechaTacticsGameStandingOrderAutoRepair repairs the mech's structure
+	// when it is damaged and waiting in a depot hex.
+	MechaTacticsGameStandingOrderAutoRepair string = "auto_repair"
+)
+
+// MechaTacticsGameStandingOrders lists the standing orders available for each
+// mecha tactics turn sheet type
+var MechaTacticsGameStandingOrders = map[string][]string{
+	MechaTacticsGameTurnSheetTypeOrders: {
+		MechaTacticsGameStandingOrderHoldPosition,
+		MechaTacticsGameStandingOrderRepeatLastOrders,
+	},
+	MechaTacticsGameTurnSheetTypeRepair: {
+		MechaTacticsGameStandingOrderAutoRepair,
+	},
+}
+
+// MechaTacticsGameTurnSheet links a game turn sheet to the mech instance it
+// was issued for. Players receive one sheet of each type per mech.
+type MechaTacticsGameTurnSheet struct {
+	record.Record
+	GameID                         string `db:"game_id"`
+	MechaTacticsGameMechInstanceID string `db:"mecha_tactics_game_mech_instance_id"`
+	GameTurnSheetID                string `db:"game_turn_sheet_id"`
+}
+
+func (r *MechaTacticsGameTurnSheet) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldMechaTacticsGameTurnSheetGameID] = r.GameID
+	args[FieldMechaTacticsGameTurnSheetMechaTacticsGameMechInstanceID] = r.MechaTacticsGameMechInstanceID
+	args[FieldMechaTacticsGameTurnSheetGameTurnSheetID] = r.GameTurnSheetID
+	return args
+}
@@ -0,0 +1,58 @@
+package mecha_tactics_game_record
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/record"
+)
+
+const (
+	TableMechaTacticsGameWeapon string = "mecha_tactics_game_weapon"
+)
+
+const (
+	FieldMechaTacticsGameWeaponID          string = "id"
+	FieldMechaTacticsGameWeaponGameID      string = "game_id"
+	FieldMechaTacticsGameWeaponName        string = "name"
+	FieldMechaTacticsGameWeaponDescription string = "description"
+	FieldMechaTacticsGameWeaponDamage      string = "damage"
+	FieldMechaTacticsGameWeaponHeatCost    string = "heat_cost"
+	FieldMechaTacticsGameWeaponRangeBand   string = "range_band"
+	FieldMechaTacticsGameWeaponMountSize   string = "mount_size"
+	FieldMechaTacticsGameWeaponCreatedAt   string = "created_at"
+	FieldMechaTacticsGameWeaponUpdatedAt   string = "updated_at"
+	FieldMechaTacticsGameWeaponDeletedAt   string = "deleted_at"
+)
+
+const (
+	WeaponRangeBandShort  string = "short"
+	WeaponRangeBandMedium string = "medium"
+	WeaponRangeBandLong   string = "long"
+
+	WeaponMountSizeSmall  string = "small"
+	WeaponMountSizeMedium string = "medium"
+	WeaponMountSizeLarge  string = "large"
+)
+
+type MechaTacticsGameWeapon struct {
+	record.Record
+	GameID      string `db:"game_id"`
+	Name        string `db:"name"`
+	Description string `db:"description"`
+	Damage      int    `db:"damage"`
+	HeatCost    int    `db:"heat_cost"`
+	RangeBand   string `db:"range_band"`
+	MountSize   string `db:"mount_size"`
+}
+
+func (r *MechaTacticsGameWeapon) ToNamedArgs() pgx.NamedArgs {
+	args := r.Record.ToNamedArgs()
+	args[FieldMechaTacticsGameWeaponGameID] = r.GameID
+	args[FieldMechaTacticsGameWeaponName] = r.Name
+	args[FieldMechaTacticsGameWeaponDescription] = r.Description
+	args[FieldMechaTacticsGameWeaponDamage] = r.Damage
+	args[FieldMechaTacticsGameWeaponHeatCost] = r.HeatCost
+	args[FieldMechaTacticsGameWeaponRangeBand] = r.RangeBand
+	args[FieldMechaTacticsGameWeaponMountSize] = r.MountSize
+	return args
+}
@@ -0,0 +1,20 @@
+package game_turn_sheet_scan
+
+import (
+	"github.com/jackc/pgx/v5"
+	"gitlab.com/alienspaces/playbymail/core/repository"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/core/type/repositor"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+)
+
+const TableName = game_record.TableGameTurnSheetScan
+
+// NewRepository matches the RepositoryConstructor signature
+func NewRepository(l logger.Logger, tx pgx.Tx) (repositor.Repositor, error) {
+	return repository.NewGeneric[game_record.GameTurnSheetScan](repository.NewArgs{
+		Tx:        tx,
+		TableName: TableName,
+		Record:    game_record.GameTurnSheetScan{},
+	})
+}
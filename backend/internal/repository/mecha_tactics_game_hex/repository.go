@@ -0,0 +1,24 @@
+package mecha_tactics_game_hex
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/alienspaces/playbymail/core/repository"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/core/type/repositor"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+const (
+	TableName string = mecha_tactics_game_record.TableMechaTacticsGameHex
+)
+
+func NewRepository(l logger.Logger, tx pgx.Tx) (repositor.Repositor, error) {
+	return repository.NewGeneric[mecha_tactics_game_record.MechaTacticsGameHex, *mecha_tactics_game_record.MechaTacticsGameHex](
+		repository.NewArgs{
+			Tx:        tx,
+			TableName: TableName,
+			Record:    mecha_tactics_game_record.MechaTacticsGameHex{},
+		},
+	)
+}
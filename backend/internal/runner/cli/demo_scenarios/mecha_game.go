@@ -155,6 +155,7 @@ func mechaGameConfigs() []harness.GameConfig {
 						StructurePoints: 24,
 						HeatCapacity:    16,
 						Speed:           8,
+						SensorRange:     3,
 					},
 				},
 				{
@@ -167,6 +168,7 @@ func mechaGameConfigs() []harness.GameConfig {
 						StructurePoints: 32,
 						HeatCapacity:    20,
 						Speed:           7,
+						SensorRange:     3,
 					},
 				},
 				{
@@ -215,6 +217,7 @@ func mechaGameConfigs() []harness.GameConfig {
 						StructurePoints: 152,
 						HeatCapacity:    42,
 						Speed:           3,
+						SensorRange:     1,
 					},
 				},
 			},
@@ -0,0 +1,134 @@
+package runner
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/gamepackage"
+)
+
+// exportGamePackage writes a game's complete definition to a portable game
+// package file
+func (rnr *Runner) exportGamePackage(c *cli.Context) error {
+	l := loggerWithFunctionContext(rnr.Log, "exportGamePackage")
+
+	gameID := c.String("game-id")
+	if gameID == "" {
+		return fmt.Errorf("--game-id is required")
+	}
+
+	outputPath := c.String("output")
+	if outputPath == "" {
+		return fmt.Errorf("--output is required")
+	}
+
+	l.Info("** Export Game Package for game >%s< **", gameID)
+
+	if err := rnr.InitDomain(); err != nil {
+		l.Warn("failed domain init >%v<", err)
+		return err
+	}
+
+	dm, ok := rnr.Domain.(*domain.Domain)
+	if !ok {
+		return fmt.Errorf("domain type assertion failed")
+	}
+
+	pkg, err := dm.ExportGamePackage(gameID)
+	if err != nil {
+		l.Warn("failed exporting game package >%s< >%v<", gameID, err)
+		return err
+	}
+
+	data, err := gamepackage.Write(pkg)
+	if err != nil {
+		l.Warn("failed writing game package >%s< >%v<", gameID, err)
+		return err
+	}
+
+	if err := os.WriteFile(outputPath, data, 0o600); err != nil {
+		l.Warn("failed writing game package file >%s< >%v<", outputPath, err)
+		return err
+	}
+
+	fmt.Printf("Exported game %q (%s) to %s\n", pkg.Game.Name, pkg.Game.GameType, outputPath)
+
+	return nil
+}
+
+// importGamePackage creates a new draft game from a game package file, owned
+// and managed by the account user with the given email address
+func (rnr *Runner) importGamePackage(c *cli.Context) error {
+	l := loggerWithFunctionContext(rnr.Log, "importGamePackage")
+
+	inputPath := c.String("file")
+	if inputPath == "" {
+		return fmt.Errorf("--file is required")
+	}
+
+	email := c.String("email")
+	if email == "" {
+		return fmt.Errorf("--email is required")
+	}
+
+	l.Info("** Import Game Package from >%s< **", inputPath)
+
+	data, err := os.ReadFile(inputPath)
+	if err != nil {
+		l.Warn("failed reading game package file >%s< >%v<", inputPath, err)
+		return err
+	}
+
+	pkg, err := gamepackage.Read(data)
+	if err != nil {
+		l.Warn("failed reading game package >%s< >%v<", inputPath, err)
+		return err
+	}
+
+	if err := rnr.InitDomain(); err != nil {
+		l.Warn("failed domain init >%v<", err)
+		return err
+	}
+
+	dm, ok := rnr.Domain.(*domain.Domain)
+	if !ok {
+		return fmt.Errorf("domain type assertion failed")
+	}
+
+	accountUserRec, err := dm.GetAccountUserRecByEmail(email)
+	if err != nil {
+		l.Warn("failed looking up account user by email >%s< >%v<", email, err)
+		return err
+	}
+	if accountUserRec == nil {
+		return fmt.Errorf("no account user found with email %s", email)
+	}
+
+	gameRec, err := dm.ImportGamePackage(pkg)
+	if err != nil {
+		l.Warn("failed importing game package >%v<", err)
+		return err
+	}
+
+	if _, err := dm.CreateDesignerSubscriptionForNewGame(gameRec, accountUserRec.AccountID, accountUserRec.ID); err != nil {
+		l.Warn("failed creating designer subscription for game >%s< >%v<", gameRec.ID, err)
+		return err
+	}
+
+	if _, err := dm.CreateManagerSubscriptionForNewGame(gameRec, accountUserRec.AccountID, accountUserRec.ID); err != nil {
+		l.Warn("failed creating manager subscription for game >%s< >%v<", gameRec.ID, err)
+		return err
+	}
+
+	if err := rnr.Domain.Commit(); err != nil {
+		l.Warn("failed committing game package import >%v<", err)
+		return err
+	}
+
+	fmt.Printf("Imported game %q (%s) as draft game %s\n", gameRec.Name, gameRec.GameType, gameRec.ID)
+
+	return nil
+}
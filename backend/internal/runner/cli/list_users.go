@@ -11,7 +11,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
 )
 
-// listUsers prints all accounts and their users with status and session info.
+// listUsers prints all accounts and their users with status and the number of signed in sessions.
 func (rnr *Runner) listUsers(c *cli.Context) error {
 	l := loggerWithFunctionContext(rnr.Log, "listUsers")
 
@@ -44,12 +44,28 @@ func (rnr *Runner) listUsers(c *cli.Context) error {
 		accountsByID[a.ID] = a
 	}
 
+	sessionRecs, err := dm.GetManyAccountUserSessionRecs(nil)
+	if err != nil {
+		l.Warn("failed getting account user session records >%v<", err)
+		return err
+	}
+
+	now := time.Now()
+
+	activeSessions := make(map[string]int)
+	expiredSessions := make(map[string]int)
+	for _, s := range sessionRecs {
+		if s.ExpiresAt.After(now) {
+			activeSessions[s.AccountUserID]++
+		} else {
+			expiredSessions[s.AccountUserID]++
+		}
+	}
+
 	sort.Slice(accountUserRecs, func(i, j int) bool {
 		return accountUserRecs[i].CreatedAt.After(accountUserRecs[j].CreatedAt)
 	})
 
-	now := time.Now()
-
 	fmt.Printf("\n%-40s  %-10s  %-18s  %-14s  %-20s  %-20s\n",
 		"Email", "Acct Status", "User Status", "Session", "Created", "Last Updated")
 	fmt.Printf("%-40s  %-10s  %-18s  %-14s  %-20s  %-20s\n",
@@ -68,12 +84,10 @@ func (rnr *Runner) listUsers(c *cli.Context) error {
 		}
 
 		sessionInfo := "no session"
-		if u.SessionToken.Valid && u.SessionToken.String != "" {
-			if u.SessionTokenExpiresAt.Valid && u.SessionTokenExpiresAt.Time.After(now) {
-				sessionInfo = "active"
-			} else {
-				sessionInfo = "expired"
-			}
+		if n := activeSessions[u.ID]; n > 0 {
+			sessionInfo = fmt.Sprintf("%d active", n)
+		} else if expiredSessions[u.ID] > 0 {
+			sessionInfo = "expired"
 		}
 
 		created := u.CreatedAt.Local().Format("2006-01-02 15:04")
@@ -620,13 +620,7 @@ func (rnr *Runner) removeGameInstanceDependents(dm *domain.Domain, instanceID st
 		}
 	}
 
-	for _, rec := range squadInsts {
-		if err := dm.RemoveMechaGameSquadInstanceRec(rec.ID); err != nil {
-			return fmt.Errorf("failed removing squad instance >%s<: %w", rec.ID, err)
-		}
-	}
-
-	// Sector instances
+	// Sector instances reference their controlling squad instance — remove before squad instances.
 	sectorInsts, err := dm.GetManyMechaGameSectorInstanceRecs(byInstance)
 	if err != nil {
 		return fmt.Errorf("failed getting sector instances: %w", err)
@@ -637,6 +631,12 @@ func (rnr *Runner) removeGameInstanceDependents(dm *domain.Domain, instanceID st
 		}
 	}
 
+	for _, rec := range squadInsts {
+		if err := dm.RemoveMechaGameSquadInstanceRec(rec.ID); err != nil {
+			return fmt.Errorf("failed removing squad instance >%s<: %w", rec.ID, err)
+		}
+	}
+
 	// Adventure game item instances must be removed before character instances
 	// (item_instance.adventure_game_character_instance_id FK references character_instance)
 	itemInsts, err := dm.GetManyAdventureGameItemInstanceRecs(byInstance)
@@ -128,6 +128,47 @@ Use --delete to remove an existing demo game without loading a new one.`,
 			},
 			Action: r.resendTurnSheetEmail,
 		},
+		// Game packages
+		{
+			Name:    "game-export",
+			Aliases: []string{"ge"},
+			Usage:   "Export a game definition, including images, as a portable game package",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "game-id",
+					Aliases:  []string{"g"},
+					Usage:    "ID of the game to export (required)",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "output",
+					Aliases:  []string{"o"},
+					Usage:    "Path of the game package file to write (required)",
+					Required: true,
+				},
+			},
+			Action: r.exportGamePackage,
+		},
+		{
+			Name:    "game-import",
+			Aliases: []string{"gi"},
+			Usage:   "Import a game package as a new draft game",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "file",
+					Aliases:  []string{"f"},
+					Usage:    "Path of the game package file to import (required)",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "email",
+					Aliases:  []string{"e"},
+					Usage:    "Email of the account user who will design and manage the game (required)",
+					Required: true,
+				},
+			},
+			Action: r.importGamePackage,
+		},
 	},
 }
 
@@ -37,6 +37,8 @@ func AccountHandlerConfig(cfg config.Config, l logger.Logger, scnr turnsheet.Tur
 		accountUserHandlerConfig,
 		accountUserContactHandlerConfig,
 		accountSubscriptionHandlerConfig,
+		accountUserSessionHandlerConfig,
+		accountUserAPITokenHandlerConfig,
 	}
 
 	for _, fn := range handlerConfigFuncs {
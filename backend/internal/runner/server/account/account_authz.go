@@ -53,3 +53,20 @@ func authorizeAccountUserSelf(l logger.Logger, r *http.Request, accountID, accou
 
 	return authenData, nil
 }
+
+// authorizeAccountSession verifies the request is authenticated with a session
+// rather than an API token. Used by handlers that manage credentials, so an API
+// token cannot be used to create further API tokens.
+func authorizeAccountSession(l logger.Logger, r *http.Request) (*server.AuthenData, error) {
+	authenData, err := authorizeAccountRead(l, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if authenData.APITokenID != "" {
+		l.Warn("API token >%s< cannot be used to manage credentials", authenData.APITokenID)
+		return nil, coreerror.NewUnauthorizedError()
+	}
+
+	return authenData, nil
+}
@@ -476,7 +476,7 @@ func verifyAuthHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Par
 		}
 	}
 
-	sessionToken, err := mm.VerifyAccountUserVerificationToken(req.VerificationToken, testBypassEnabled)
+	sessionToken, err := mm.VerifyAccountUserVerificationToken(req.VerificationToken, r.UserAgent(), testBypassEnabled)
 	if err != nil {
 		l.Warn("failed verifying account verification token >%v<", err)
 		return err
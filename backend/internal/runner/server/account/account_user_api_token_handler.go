@@ -0,0 +1,211 @@
+package account
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+)
+
+const (
+	GetManyAccountUserAPITokens  = "get-many-account-user-api-tokens"
+	CreateOneAccountUserAPIToken = "create-one-account-user-api-token"
+	DeleteOneAccountUserAPIToken = "delete-one-account-user-api-token"
+)
+
+func accountUserAPITokenHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "accountUserAPITokenHandlerConfig")
+
+	l.Debug("adding account user API token handler configuration")
+
+	accountUserAPITokenConfig := make(map[string]server.HandlerConfig)
+
+	collectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/account_schema",
+			Name:     "account_user_api_token.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, []jsonschema.Schema{
+			{
+				Location: "api/account_schema",
+				Name:     "account_user_api_token.schema.json",
+			},
+		}...),
+	}
+
+	requestSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/account_schema",
+			Name:     "account_user_api_token.request.schema.json",
+		},
+		References: referenceSchemas,
+	}
+
+	responseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/account_schema",
+			Name:     "account_user_api_token.response.schema.json",
+		},
+		References: append(referenceSchemas, []jsonschema.Schema{
+			{
+				Location: "api/account_schema",
+				Name:     "account_user_api_token.schema.json",
+			},
+		}...),
+	}
+
+	accountUserAPITokenConfig[GetManyAccountUserAPITokens] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/me/api-tokens",
+		HandlerFunc: getManyAccountUserAPITokensHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateResponseSchema: collectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:   true,
+			Collection: true,
+			Title:      "Get authenticated account user API token collection",
+		},
+	}
+
+	accountUserAPITokenConfig[CreateOneAccountUserAPIToken] = server.HandlerConfig{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/me/api-tokens",
+		HandlerFunc: createAccountUserAPITokenHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Create authenticated account user API token",
+		},
+	}
+
+	accountUserAPITokenConfig[DeleteOneAccountUserAPIToken] = server.HandlerConfig{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/me/api-tokens/:api_token_id",
+		HandlerFunc: deleteAccountUserAPITokenHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Revoke authenticated account user API token",
+		},
+	}
+
+	return accountUserAPITokenConfig, nil
+}
+
+func getManyAccountUserAPITokensHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyAccountUserAPITokensHandler")
+
+	authenData, err := authorizeAccountSession(l, r)
+	if err != nil {
+		return err
+	}
+
+	mm := m.(*domain.Domain)
+
+	recs, err := mm.GetManyAccountUserAPITokenRecs(&coresql.Options{
+		Params: []coresql.Param{
+			{Col: account_record.FieldAccountUserAPITokenAccountUserID, Val: authenData.AccountUser.ID},
+		},
+		OrderBy: []coresql.OrderBy{
+			{Col: account_record.FieldAccountUserAPITokenCreatedAt, Direction: coresql.OrderDirectionDESC},
+		},
+	})
+	if err != nil {
+		l.Warn("failed to get account user API token records >%v<", err)
+		return err
+	}
+
+	res, err := mapper.AccountUserAPITokenRecordsToCollectionResponse(l, recs)
+	if err != nil {
+		l.Warn("failed mapping account user API token records to collection response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res)
+}
+
+func createAccountUserAPITokenHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "createAccountUserAPITokenHandler")
+
+	authenData, err := authorizeAccountSession(l, r)
+	if err != nil {
+		return err
+	}
+
+	mm := m.(*domain.Domain)
+
+	rec := &account_record.AccountUserAPIToken{
+		AccountID:     authenData.AccountUser.AccountID,
+		AccountUserID: authenData.AccountUser.ID,
+	}
+
+	rec, err = mapper.AccountUserAPITokenRequestToRecord(l, r, rec)
+	if err != nil {
+		l.Warn("failed mapping account user API token request to record >%v<", err)
+		return err
+	}
+
+	rec, token, err := mm.GenerateAccountUserAPIToken(rec)
+	if err != nil {
+		l.Warn("failed to generate account user API token >%v<", err)
+		return err
+	}
+
+	res, err := mapper.AccountUserAPITokenRecordToResponse(l, rec, token)
+	if err != nil {
+		l.Warn("failed mapping account user API token record to response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusCreated, res)
+}
+
+func deleteAccountUserAPITokenHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "deleteAccountUserAPITokenHandler")
+
+	apiTokenID := pp.ByName("api_token_id")
+	if apiTokenID == "" {
+		return coreerror.NewInvalidDataError("api_token_id is required")
+	}
+
+	authenData, err := authorizeAccountSession(l, r)
+	if err != nil {
+		return err
+	}
+
+	mm := m.(*domain.Domain)
+
+	if err := mm.RevokeAccountUserAPIToken(authenData.AccountUser.ID, apiTokenID); err != nil {
+		l.Warn("failed to revoke account user API token >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusNoContent, nil)
+}
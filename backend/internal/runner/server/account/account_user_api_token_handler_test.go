@@ -0,0 +1,195 @@
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/harness"
+	"gitlab.com/alienspaces/playbymail/internal/record/account_record"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/account"
+	"gitlab.com/alienspaces/playbymail/internal/utils/testutil"
+	"gitlab.com/alienspaces/playbymail/schema/api/account_schema"
+)
+
+// createTestAccountUserAPIToken creates an API token for an account user so
+// requests can be authenticated with it
+func createTestAccountUserAPIToken(t *testing.T, th *harness.Testing, accountUserRec *account_record.AccountUser) (*account_record.AccountUserAPIToken, string) {
+	t.Helper()
+
+	tx, err := th.Store.BeginTx()
+	require.NoError(t, err, "BeginTx returns without error")
+	mm := th.Domain.(*domain.Domain)
+	err = mm.Init(tx)
+	require.NoError(t, err, "Domain init returns without error")
+
+	rec, token, err := mm.GenerateAccountUserAPIToken(&account_record.AccountUserAPIToken{
+		AccountID:     accountUserRec.AccountID,
+		AccountUserID: accountUserRec.ID,
+		Name:          "Test automation",
+		Permissions:   []string{account_record.AccountUserAPITokenPermissionGamePlaying},
+	})
+	require.NoError(t, err, "GenerateAccountUserAPIToken returns without error")
+
+	err = tx.Commit(context.TODO())
+	require.NoError(t, err, "Commit returns without error")
+
+	return rec, token
+}
+
+func Test_accountUserAPITokenHandler(t *testing.T) {
+	t.Parallel()
+
+	th := testutil.NewTestHarness(t)
+	require.NotNil(t, th, "newTestHarness returns without error")
+
+	_, err := th.Setup()
+	require.NoError(t, err, "Test data setup returns without error")
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err, "Test data teardown returns without error")
+	}()
+
+	accountUserRec, err := th.Data.GetAccountUserRecByRef(harness.AccountUserStandardRef)
+	require.NoError(t, err, "GetAccountUserRecByRef returns without error")
+
+	proPlayerAccountUserRec, err := th.Data.GetAccountUserRecByRef(harness.AccountUserProPlayerRef)
+	require.NoError(t, err, "GetAccountUserRecByRef returns without error")
+
+	_, apiToken := createTestAccountUserAPIToken(t, th, accountUserRec)
+	proPlayerAPITokenRec, _ := createTestAccountUserAPIToken(t, th, proPlayerAccountUserRec)
+
+	authHeaderAPIToken := func(d harness.Data) map[string]string {
+		return map[string]string{
+			"Authorization": "Bearer " + apiToken,
+		}
+	}
+
+	type testCase struct {
+		testutil.TestCase
+		expectToken bool
+	}
+
+	testCaseResponseDecoder := testutil.TestCaseResponseDecoderGeneric[account_schema.AccountUserAPITokenResponse]
+	testCaseCollectionResponseDecoder := testutil.TestCaseResponseDecoderGeneric[account_schema.AccountUserAPITokenCollectionResponse]
+
+	testCases := []testCase{
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated user when create API token then returns token",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[account.CreateOneAccountUserAPIToken]
+				},
+				RequestHeaders: testutil.AuthHeaderStandard,
+				RequestBody: func(d harness.Data) any {
+					return account_schema.AccountUserAPITokenRequest{
+						Name:        "Build pipeline",
+						Permissions: []string{account_record.AccountUserAPITokenPermissionGamePlaying},
+					}
+				},
+				ResponseDecoder: testCaseResponseDecoder,
+				ResponseCode:    http.StatusCreated,
+			},
+			expectToken: true,
+		},
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated user when create API token with unknown permission then returns bad request",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[account.CreateOneAccountUserAPIToken]
+				},
+				RequestHeaders: testutil.AuthHeaderStandard,
+				RequestBody: func(d harness.Data) any {
+					return account_schema.AccountUserAPITokenRequest{
+						Name:        "Build pipeline",
+						Permissions: []string{"game_admin"},
+					}
+				},
+				ResponseCode: http.StatusBadRequest,
+			},
+		},
+		{
+			TestCase: testutil.TestCase{
+				Name: "API token when create API token then returns unauthorized",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[account.CreateOneAccountUserAPIToken]
+				},
+				RequestHeaders: authHeaderAPIToken,
+				RequestBody: func(d harness.Data) any {
+					return account_schema.AccountUserAPITokenRequest{
+						Name:        "Another token",
+						Permissions: []string{account_record.AccountUserAPITokenPermissionGamePlaying},
+					}
+				},
+				ResponseCode: http.StatusUnauthorized,
+			},
+		},
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated user when get many API tokens then returns tokens without secrets",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[account.GetManyAccountUserAPITokens]
+				},
+				RequestHeaders:  testutil.AuthHeaderStandard,
+				ResponseDecoder: testCaseCollectionResponseDecoder,
+				ResponseCode:    http.StatusOK,
+			},
+		},
+		{
+			TestCase: testutil.TestCase{
+				Name: "API token when get authenticated account user then returns account user",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[account.GetMe]
+				},
+				RequestHeaders: authHeaderAPIToken,
+				ResponseCode:   http.StatusOK,
+			},
+		},
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated user when revoke API token of another account user then returns not found",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[account.DeleteOneAccountUserAPIToken]
+				},
+				RequestHeaders: testutil.AuthHeaderStandard,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":api_token_id": proPlayerAPITokenRec.ID,
+					}
+				},
+				ResponseCode: http.StatusNotFound,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running test >%s<", testCase.Name)
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			testFunc := func(method string, body any) {
+				switch resp := body.(type) {
+				case account_schema.AccountUserAPITokenResponse:
+					require.NotNil(t, resp.Data, "Response data is not nil")
+					require.Equal(t, accountUserRec.ID, resp.Data.AccountUserID, "API token AccountUserID matches")
+					if testCase.expectToken {
+						require.True(t, strings.HasPrefix(resp.Data.Token, resp.Data.TokenPrefix), "API token starts with its prefix")
+						require.True(t, domain.IsAccountUserAPIToken(resp.Data.Token), "API token is recognised as an API token")
+					}
+				case account_schema.AccountUserAPITokenCollectionResponse:
+					require.NotEmpty(t, resp.Data, "Response has API tokens")
+					for _, d := range resp.Data {
+						require.Equal(t, accountUserRec.ID, d.AccountUserID, "API token belongs to the authenticated account user")
+						require.Empty(t, d.Token, "API token secret is not returned")
+					}
+				}
+			}
+
+			testutil.RunTestCase(t, th, &testCase, testFunc)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package account
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+)
+
+const (
+	GetManyAccountUserSessions    = "get-many-account-user-sessions"
+	DeleteOneAccountUserSession   = "delete-one-account-user-session"
+	DeleteManyAccountUserSessions = "delete-many-account-user-sessions"
+)
+
+func accountUserSessionHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "accountUserSessionHandlerConfig")
+
+	l.Debug("adding account user session handler configuration")
+
+	accountUserSessionConfig := make(map[string]server.HandlerConfig)
+
+	collectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/account_schema",
+			Name:     "account_user_session.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, []jsonschema.Schema{
+			{
+				Location: "api/account_schema",
+				Name:     "account_user_session.schema.json",
+			},
+		}...),
+	}
+
+	accountUserSessionConfig[GetManyAccountUserSessions] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/me/sessions",
+		HandlerFunc: getManyAccountUserSessionsHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateResponseSchema: collectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:   true,
+			Collection: true,
+			Title:      "Get authenticated account user session collection",
+		},
+	}
+
+	// Sign out everywhere, including the session making the request
+	accountUserSessionConfig[DeleteManyAccountUserSessions] = server.HandlerConfig{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/me/sessions",
+		HandlerFunc: deleteManyAccountUserSessionsHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Revoke all authenticated account user sessions",
+		},
+	}
+
+	accountUserSessionConfig[DeleteOneAccountUserSession] = server.HandlerConfig{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/me/sessions/:session_id",
+		HandlerFunc: deleteAccountUserSessionHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Revoke authenticated account user session",
+		},
+	}
+
+	return accountUserSessionConfig, nil
+}
+
+func getManyAccountUserSessionsHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyAccountUserSessionsHandler")
+
+	authenData, err := authorizeAccountSession(l, r)
+	if err != nil {
+		return err
+	}
+
+	mm := m.(*domain.Domain)
+
+	recs, err := mm.GetActiveAccountUserSessionRecs(authenData.AccountUser.ID)
+	if err != nil {
+		l.Warn("failed to get account user session records >%v<", err)
+		return err
+	}
+
+	res, err := mapper.AccountUserSessionRecordsToCollectionResponse(l, recs, authenData.SessionID)
+	if err != nil {
+		l.Warn("failed mapping account user session records to collection response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res)
+}
+
+func deleteAccountUserSessionHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "deleteAccountUserSessionHandler")
+
+	sessionID := pp.ByName("session_id")
+	if sessionID == "" {
+		return coreerror.NewInvalidDataError("session_id is required")
+	}
+
+	authenData, err := authorizeAccountSession(l, r)
+	if err != nil {
+		return err
+	}
+
+	mm := m.(*domain.Domain)
+
+	if err := mm.RevokeAccountUserSession(authenData.AccountUser.ID, sessionID); err != nil {
+		l.Warn("failed to revoke account user session >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusNoContent, nil)
+}
+
+func deleteManyAccountUserSessionsHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "deleteManyAccountUserSessionsHandler")
+
+	authenData, err := authorizeAccountSession(l, r)
+	if err != nil {
+		return err
+	}
+
+	mm := m.(*domain.Domain)
+
+	count, err := mm.RevokeAllAccountUserSessions(authenData.AccountUser.ID)
+	if err != nil {
+		l.Warn("failed to revoke account user sessions >%v<", err)
+		return err
+	}
+
+	l.Info("signed account user >%s< out of >%d< sessions", authenData.AccountUser.ID, count)
+
+	return server.WriteResponse(l, w, http.StatusNoContent, nil)
+}
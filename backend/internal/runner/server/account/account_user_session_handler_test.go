@@ -0,0 +1,124 @@
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/harness"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/account"
+	"gitlab.com/alienspaces/playbymail/internal/utils/testutil"
+	"gitlab.com/alienspaces/playbymail/schema/api/account_schema"
+)
+
+func Test_accountUserSessionHandler(t *testing.T) {
+	t.Parallel()
+
+	th := testutil.NewTestHarness(t)
+	require.NotNil(t, th, "newTestHarness returns without error")
+
+	_, err := th.Setup()
+	require.NoError(t, err, "Test data setup returns without error")
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err, "Test data teardown returns without error")
+	}()
+
+	testCaseCollectionResponseDecoder := testutil.TestCaseResponseDecoderGeneric[account_schema.AccountUserSessionCollectionResponse]
+
+	accountUserRec, err := th.Data.GetAccountUserRecByRef(harness.AccountUserStandardRef)
+	require.NoError(t, err, "GetAccountUserRecByRef returns without error")
+
+	proPlayerAccountUserRec, err := th.Data.GetAccountUserRecByRef(harness.AccountUserProPlayerRef)
+	require.NoError(t, err, "GetAccountUserRecByRef returns without error")
+
+	// The pro player's harness session, which the standard user must not be
+	// able to revoke
+	tx, err := th.Store.BeginTx()
+	require.NoError(t, err, "BeginTx returns without error")
+	mm := th.Domain.(*domain.Domain)
+	err = mm.Init(tx)
+	require.NoError(t, err, "Domain init returns without error")
+
+	proPlayerSessionRecs, err := mm.GetActiveAccountUserSessionRecs(proPlayerAccountUserRec.ID)
+	require.NoError(t, err, "GetActiveAccountUserSessionRecs returns without error")
+	require.NotEmpty(t, proPlayerSessionRecs, "pro player has an active session")
+
+	err = tx.Rollback(context.TODO())
+	require.NoError(t, err, "Rollback returns without error")
+
+	testCases := []testutil.TestCase{
+		{
+			Name: "authenticated user when get many sessions then returns current session",
+			HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+				return rnr.GetHandlerConfig()[account.GetManyAccountUserSessions]
+			},
+			RequestHeaders:  testutil.AuthHeaderStandard,
+			ResponseDecoder: testCaseCollectionResponseDecoder,
+			ResponseCode:    http.StatusOK,
+		},
+		{
+			Name: "unauthenticated request when get many sessions then returns unauthorized",
+			HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+				return rnr.GetHandlerConfig()[account.GetManyAccountUserSessions]
+			},
+			ResponseCode: http.StatusUnauthorized,
+		},
+		{
+			Name: "authenticated user when revoke session of another account user then returns not found",
+			HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+				return rnr.GetHandlerConfig()[account.DeleteOneAccountUserSession]
+			},
+			RequestHeaders: testutil.AuthHeaderStandard,
+			RequestPathParams: func(d harness.Data) map[string]string {
+				return map[string]string{
+					":session_id": proPlayerSessionRecs[0].ID,
+				}
+			},
+			ResponseCode: http.StatusNotFound,
+		},
+		{
+			Name: "authenticated user when revoke all sessions then returns no content",
+			HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+				return rnr.GetHandlerConfig()[account.DeleteManyAccountUserSessions]
+			},
+			RequestHeaders: testutil.AuthHeaderStandard,
+			ResponseCode:   http.StatusNoContent,
+		},
+		{
+			Name: "unauthenticated request when revoke all sessions then returns unauthorized",
+			HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+				return rnr.GetHandlerConfig()[account.DeleteManyAccountUserSessions]
+			},
+			ResponseCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running test >%s<", testCase.Name)
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			testFunc := func(method string, body any) {
+				if testCase.TestResponseCode() != http.StatusOK {
+					return
+				}
+
+				require.NotNil(t, body, "Response body is not nil")
+				resp, ok := body.(account_schema.AccountUserSessionCollectionResponse)
+				require.True(t, ok, "Response body is of type account_schema.AccountUserSessionCollectionResponse")
+				require.Len(t, resp.Data, 1, "Response has the harness session")
+
+				session := resp.Data[0]
+				require.Equal(t, accountUserRec.ID, session.AccountUserID, "Session AccountUserID matches")
+				require.True(t, session.Current, "Session is the current session")
+				require.True(t, session.ExpiresAt.After(session.LastSeenAt), "Session ExpiresAt is after LastSeenAt")
+			}
+
+			testutil.RunTestCase(t, th, &testCase, testFunc)
+		})
+	}
+}
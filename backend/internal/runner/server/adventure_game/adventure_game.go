@@ -40,6 +40,7 @@ func AdventureGameHandlerConfig(cfg config.Config, l logger.Logger, scnr turnshe
 		adventureGameItemPlacementHandlerConfig,
 		adventureGameCreaturePlacementHandlerConfig,
 		adventureGameLocationHandlerConfig,
+		adventureGameLocationPathHandlerConfig,
 		adventureGameLocationLinkHandlerConfig,
 		adventureGameLocationLinkRequirementHandlerConfig,
 		adventureGameLocationTurnSheetImageHandlerConfig,
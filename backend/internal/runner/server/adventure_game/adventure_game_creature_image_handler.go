@@ -14,10 +14,11 @@ import (
 	_ "golang.org/x/image/webp"
 
 	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
 	"gitlab.com/alienspaces/playbymail/core/nullstring"
 	"gitlab.com/alienspaces/playbymail/core/queryparam"
-	coresql "gitlab.com/alienspaces/playbymail/core/sql"
 	"gitlab.com/alienspaces/playbymail/core/server"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
 	"gitlab.com/alienspaces/playbymail/core/type/domainer"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
@@ -51,11 +52,21 @@ func adventureGameCreatureImageHandlerConfig(l logger.Logger) (map[string]server
 			AuthzPermissions: []server.AuthorizedPermission{
 				handler_auth.PermissionGameDesign,
 			},
+			ValidateResponseSchema: jsonschema.SchemaWithReferences{
+				Main: jsonschema.Schema{
+					Location: "api/game_schema",
+					Name:     "game_image.response.schema.json",
+				},
+				References: append(referenceSchemas, []jsonschema.Schema{
+					{Location: "api/game_schema", Name: "game_image.schema.json"},
+				}...),
+			},
 		},
 		DocumentationConfig: server.DocumentationConfig{
-			Document:    true,
-			Title:       "Upload creature portrait image",
-			Description: "Upload a portrait image for an adventure game creature. Accepts multipart form data with 'image' file. Images must be WebP, PNG, or JPEG format, max 1MB.",
+			Document:           true,
+			Title:              "Upload creature portrait image",
+			Description:        "Upload a portrait image for an adventure game creature. Accepts multipart form data with 'image' file. Images must be WebP, PNG, or JPEG format, max 1MB.",
+			RequestContentType: server.HeaderContentTypeMultipart,
 		},
 	}
 
@@ -67,6 +78,15 @@ func adventureGameCreatureImageHandlerConfig(l logger.Logger) (map[string]server
 			AuthenTypes: []server.AuthenticationType{
 				server.AuthenticationTypeToken,
 			},
+			ValidateResponseSchema: jsonschema.SchemaWithReferences{
+				Main: jsonschema.Schema{
+					Location: "api/game_schema",
+					Name:     "creature_image.response.schema.json",
+				},
+				References: append(referenceSchemas, []jsonschema.Schema{
+					{Location: "api/game_schema", Name: "game_image.schema.json"},
+				}...),
+			},
 		},
 		DocumentationConfig: server.DocumentationConfig{
 			Document:    true,
@@ -0,0 +1,129 @@
+package adventure_game
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+)
+
+const (
+	GetAdventureGameLocationPath = "get-adventure-game-location-path"
+)
+
+type adventureGameLocationPathResponseData struct {
+	LocationID string                         `json:"location_id"`
+	Reachable  bool                           `json:"reachable"`
+	Steps      []domain.AdventureGamePathStep `json:"steps"`
+}
+
+type adventureGameLocationPathResponse struct {
+	Data adventureGameLocationPathResponseData `json:"data"`
+}
+
+func adventureGameLocationPathHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "adventureGameLocationPathHandlerConfig")
+
+	l.Debug("Adding adventure_game_location_path handler configuration")
+
+	config := make(map[string]server.HandlerConfig)
+
+	config[GetAdventureGameLocationPath] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/adventure-games/:game_id/locations/:location_id/path",
+		HandlerFunc: getAdventureGameLocationPathHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameDesign,
+			},
+			ValidateResponseSchema: jsonschema.SchemaWithReferences{
+				Main: jsonschema.Schema{
+					Location: "api/adventure_game_schema",
+					Name:     "adventure_game_location_path.response.schema.json",
+				},
+				References: referenceSchemas,
+			},
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:    true,
+			Title:       "Get adventure game location path",
+			Description: "Explain the steps a player takes from a starting location to reach a location, or report that it can never be reached.",
+		},
+	}
+
+	return config, nil
+}
+
+func getAdventureGameLocationPathHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getAdventureGameLocationPathHandler")
+
+	gameID := pp.ByName("game_id")
+	if gameID == "" {
+		l.Warn("game ID is empty")
+		return coreerror.RequiredPathParameter("game_id")
+	}
+
+	locationID := pp.ByName("location_id")
+	if locationID == "" {
+		l.Warn("location ID is empty")
+		return coreerror.RequiredPathParameter("location_id")
+	}
+
+	mm := m.(*domain.Domain)
+
+	// Paths give away the solution to a game so only its designer may see them
+	authenData, err := requireDesignerSubscription(l, r, mm, gameID)
+	if err != nil {
+		return err
+	}
+
+	// Steps are reported in the designer's locale
+	locale, err := mm.GetAccountLocale(authenData.AccountUser.AccountID)
+	if err != nil {
+		l.Warn("failed getting account locale >%v<", err)
+		return err
+	}
+
+	steps, reachable, err := mm.ExplainAdventureGameLocationPath(gameID, locationID)
+	if err != nil {
+		l.Warn("failed explaining path to location >%s< >%v<", locationID, err)
+		return err
+	}
+
+	if steps == nil {
+		steps = []domain.AdventureGamePathStep{}
+	}
+
+	for idx, step := range steps {
+		steps[idx] = step.Localize(locale)
+	}
+
+	res := adventureGameLocationPathResponse{
+		Data: adventureGameLocationPathResponseData{
+			LocationID: locationID,
+			Reachable:  reachable,
+			Steps:      steps,
+		},
+	}
+
+	if err := server.WriteResponse(l, w, http.StatusOK, res); err != nil {
+		l.Warn("failed writing response >%v<", err)
+		return err
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	_ "golang.org/x/image/webp"
 
 	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
 	"gitlab.com/alienspaces/playbymail/core/nullstring"
 	"gitlab.com/alienspaces/playbymail/core/queryparam"
 	"gitlab.com/alienspaces/playbymail/core/record"
@@ -61,6 +62,15 @@ func adventureGameLocationTurnSheetImageHandlerConfig(l logger.Logger) (map[stri
 			AuthzPermissions: []server.AuthorizedPermission{
 				handler_auth.PermissionGameDesign,
 			},
+			ValidateResponseSchema: jsonschema.SchemaWithReferences{
+				Main: jsonschema.Schema{
+					Location: "api/game_schema",
+					Name:     "game_image.response.schema.json",
+				},
+				References: append(referenceSchemas, []jsonschema.Schema{
+					{Location: "api/game_schema", Name: "game_image.schema.json"},
+				}...),
+			},
 		},
 		DocumentationConfig: server.DocumentationConfig{
 			Document: true,
@@ -70,6 +80,7 @@ func adventureGameLocationTurnSheetImageHandlerConfig(l logger.Logger) (map[stri
 				"Images must be WebP, PNG, or JPEG format, max 1MB. " +
 				"Recommended: 2480x3508px (A4 @ 300 DPI) for best print quality. " +
 				"Use the preview endpoint to see how images appear in the turn sheet.",
+			RequestContentType: server.HeaderContentTypeMultipart,
 		},
 	}
 
@@ -82,6 +93,15 @@ func adventureGameLocationTurnSheetImageHandlerConfig(l logger.Logger) (map[stri
 			AuthenTypes: []server.AuthenticationType{
 				server.AuthenticationTypeToken,
 			},
+			ValidateResponseSchema: jsonschema.SchemaWithReferences{
+				Main: jsonschema.Schema{
+					Location: "api/game_schema",
+					Name:     "location_turn_sheet_image.response.schema.json",
+				},
+				References: append(referenceSchemas, []jsonschema.Schema{
+					{Location: "api/game_schema", Name: "game_image.schema.json"},
+				}...),
+			},
 		},
 		DocumentationConfig: server.DocumentationConfig{
 			Document:    true,
@@ -125,6 +145,7 @@ func adventureGameLocationTurnSheetImageHandlerConfig(l logger.Logger) (map[stri
 			Title:    "Preview location choice turn sheet",
 			Description: "Generate and preview a location choice turn sheet PDF with uploaded " +
 				"background images. Returns PDF with Content-Disposition: inline for browser preview.",
+			ResponseContentType: server.HeaderContentTypePDF,
 		},
 	}
 
@@ -35,12 +35,17 @@ func GameHandlerConfig(cfg config.Config, l logger.Logger, scnr turnsheet.TurnSh
 	handlerConfigFuncs := []func(logger.Logger) (map[string]server.HandlerConfig, error){
 		gameHandlerConfig,
 		gameValidateHandlerConfig,
+		gamePackageHandlerConfig,
 		gameImageHandlerConfig,
 		gameParameterHandlerConfig,
 		gameSubscriptionHandlerConfig,
 		gameSubscriptionJoinHandlerConfig,
 		gameInstanceHandlerConfig,
 		gameInstanceParameterHandlerConfig,
+		gamePrintBatchHandlerConfig,
+		gameScanBatchHandlerConfig,
+		gameTurnSheetReviewHandlerConfig,
+		gameTranslationHandlerConfig,
 	}
 
 	for _, fn := range handlerConfigFuncs {
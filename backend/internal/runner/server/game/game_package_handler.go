@@ -0,0 +1,218 @@
+package game
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/gamepackage"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+)
+
+const (
+	ExportGamePackage = "export-game-package"
+	ImportGamePackage = "import-game-package"
+)
+
+// gamePackageFormFieldName is the multipart form field holding an imported game package
+const gamePackageFormFieldName = "file"
+
+func gamePackageHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "gamePackageHandlerConfig")
+
+	l.Debug("Adding game package handler configuration")
+
+	config := make(map[string]server.HandlerConfig)
+
+	responseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/game_schema",
+			Name:     "game.response.schema.json",
+		},
+		References: append(referenceSchemas, []jsonschema.Schema{
+			{
+				Location: "api/game_schema",
+				Name:     "game.schema.json",
+			},
+		}...),
+	}
+
+	config[ExportGamePackage] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/games/:game_id/package",
+		HandlerFunc: exportGamePackageHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameDesign,
+			},
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:            true,
+			Title:               "Export game package",
+			Description:         "Export the complete game definition, including images, as a portable ZIP game package.",
+			ResponseContentType: server.HeaderContentTypeZIP,
+		},
+	}
+
+	config[ImportGamePackage] = server.HandlerConfig{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/game-packages",
+		HandlerFunc: importGamePackageHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameDesign,
+			},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Import game package",
+			Description: "Create a new draft game from a game package uploaded as multipart form field 'file'. " +
+				"The package is validated and the game is created completely or not at all.",
+			RequestContentType: server.HeaderContentTypeMultipart,
+		},
+	}
+
+	return config, nil
+}
+
+func exportGamePackageHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "exportGamePackageHandler")
+
+	gameID := pp.ByName("game_id")
+	if gameID == "" {
+		l.Warn("game ID is empty")
+		return coreerror.RequiredPathParameter("game_id")
+	}
+
+	mm := m.(*domain.Domain)
+
+	if _, _, err := requireDesignerSubscription(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	pkg, err := mm.ExportGamePackage(gameID)
+	if err != nil {
+		l.Warn("failed exporting game package >%s< >%v<", gameID, err)
+		return err
+	}
+
+	data, err := gamepackage.Write(pkg)
+	if err != nil {
+		l.Warn("failed writing game package >%s< >%v<", gameID, err)
+		return coreerror.NewInternalError("failed to write game package: %v", err)
+	}
+
+	filename := fmt.Sprintf("%s.zip", gamePackageFilename(pkg.Game.Name))
+
+	l.Info("responding with game package for game >%s< size >%d<", gameID, len(data))
+
+	w.Header().Set("Content-Type", server.HeaderContentTypeZIP)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename)) //nolint:gocritic // HTTP Content-Disposition requires specific quote format
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(data); err != nil {
+		l.Warn("failed writing game package response >%v<", err)
+		return err
+	}
+
+	return nil
+}
+
+var gamePackageFilenameInvalid = regexp.MustCompile(`[^a-z0-9]+`)
+
+// gamePackageFilename returns a file name safe version of a game name
+func gamePackageFilename(name string) string {
+	filename := strings.Trim(gamePackageFilenameInvalid.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if filename == "" {
+		return "game"
+	}
+	return filename
+}
+
+func importGamePackageHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "importGamePackageHandler")
+
+	authenData := server.GetRequestAuthenData(l, r)
+	mm := m.(*domain.Domain)
+
+	// Allow for multipart form overhead on top of the package size
+	r.Body = http.MaxBytesReader(w, r.Body, int64(gamepackage.MaxSize)+(1<<20))
+
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		l.Warn("failed to parse multipart form >%v<", err)
+		return coreerror.NewInvalidDataError("failed to parse multipart form, game packages must be at most 50MB: %v", err)
+	}
+
+	file, _, err := r.FormFile(gamePackageFormFieldName)
+	if err != nil {
+		l.Warn("failed to get game package file >%v<", err)
+		return coreerror.NewInvalidDataError("game package file is required")
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		l.Warn("failed to read game package file >%v<", err)
+		return coreerror.NewInvalidDataError("failed to read game package file")
+	}
+
+	pkg, err := gamepackage.Read(data)
+	if err != nil {
+		l.Warn("failed reading game package >%v<", err)
+		return coreerror.NewInvalidDataError("%v", err)
+	}
+
+	rec, err := mm.ImportGamePackage(pkg)
+	if err != nil {
+		l.Warn("failed importing game package >%v<", err)
+		return err
+	}
+
+	// The importing designer owns and manages the new game, as with games
+	// created from scratch
+	if _, err := mm.CreateDesignerSubscriptionForNewGame(rec, authenData.AccountUser.AccountID, authenData.AccountUser.ID); err != nil {
+		l.Warn("failed creating designer subscription for game >%s< >%v<", rec.ID, err)
+		return err
+	}
+
+	if _, err := mm.CreateManagerSubscriptionForNewGame(rec, authenData.AccountUser.AccountID, authenData.AccountUser.ID); err != nil {
+		l.Warn("failed creating manager subscription for game >%s< >%v<", rec.ID, err)
+		return err
+	}
+
+	res, err := mapper.GameRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	l.Info("responding with imported game record id >%s<", rec.ID)
+
+	if err := server.WriteResponse(l, w, http.StatusCreated, res); err != nil {
+		l.Warn("failed writing response >%v<", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,277 @@
+package game
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/nullstring"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/jobworker"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+)
+
+// API Resource Paths
+//
+// GET (collection)  /api/v1/manager/games/{game_id}/instances/{instance_id}/print-batches
+// GET (document)    /api/v1/manager/games/{game_id}/instances/{instance_id}/print-batches/{print_batch_id}
+// POST (document)   /api/v1/manager/games/{game_id}/instances/{instance_id}/print-batches/{print_batch_id}/reprint
+//
+// Print batches are created by turn processing for game instances with physical
+// post or local delivery enabled. A reprint creates a new print batch for the same
+// turn, optionally limited to a single player's subscription instance.
+
+const (
+	GetManyGamePrintBatches = "get-many-game-print-batches"
+	GetOneGamePrintBatch    = "get-one-game-print-batch"
+	ReprintGamePrintBatch   = "reprint-game-print-batch"
+)
+
+func gamePrintBatchHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "gamePrintBatchHandlerConfig")
+
+	l.Debug("adding game print batch handler configuration")
+
+	gamePrintBatchConfig := make(map[string]server.HandlerConfig)
+
+	collectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/game_schema",
+			Name:     "game_print_batch.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, []jsonschema.Schema{
+			{
+				Location: "api/game_schema",
+				Name:     "game_print_batch.schema.json",
+			},
+		}...),
+	}
+
+	reprintRequestSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/game_schema",
+			Name:     "game_print_batch_reprint.request.schema.json",
+		},
+		References: referenceSchemas,
+	}
+
+	responseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/game_schema",
+			Name:     "game_print_batch.response.schema.json",
+		},
+		References: append(referenceSchemas, []jsonschema.Schema{
+			{
+				Location: "api/game_schema",
+				Name:     "game_print_batch.schema.json",
+			},
+		}...),
+	}
+
+	gamePrintBatchConfig[GetManyGamePrintBatches] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/manager/games/:game_id/instances/:instance_id/print-batches",
+		HandlerFunc: getManyGamePrintBatchesHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateResponseSchema: collectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:   true,
+			Collection: true,
+			Title:      "Get game instance print batch collection",
+		},
+	}
+
+	gamePrintBatchConfig[GetOneGamePrintBatch] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/manager/games/:game_id/instances/:instance_id/print-batches/:print_batch_id",
+		HandlerFunc: getOneGamePrintBatchHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Get game instance print batch",
+		},
+	}
+
+	gamePrintBatchConfig[ReprintGamePrintBatch] = server.HandlerConfig{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/manager/games/:game_id/instances/:instance_id/print-batches/:print_batch_id/reprint",
+		HandlerFunc: reprintGamePrintBatchHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameManagement,
+			},
+			ValidateRequestSchema:  reprintRequestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Reprint game instance print batch",
+		},
+	}
+
+	return gamePrintBatchConfig, nil
+}
+
+func getManyGamePrintBatchesHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyGamePrintBatchesHandler")
+
+	gameID := pp.ByName("game_id")
+	instanceID := pp.ByName("instance_id")
+
+	l.Info("getting many game print batches for game >%s< instance >%s<", gameID, instanceID)
+
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeManagerModify(l, r, mm, gameID, instanceID); err != nil {
+		return err
+	}
+
+	opts := queryparam.ToSQLOptionsWithDefaults(qp)
+	opts.Params = append(opts.Params, sql.Param{
+		Col: game_record.FieldGamePrintBatchGameInstanceID,
+		Val: instanceID,
+	})
+
+	recs, err := mm.GetManyGamePrintBatchRecs(opts)
+	if err != nil {
+		l.Warn("failed getting game print batches >%v<", err)
+		return err
+	}
+
+	response, err := mapper.GamePrintBatchRecsToCollectionResponse(l, recs)
+	if err != nil {
+		l.Warn("failed mapping game print batch records to collection response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, response, server.XPaginationHeader(len(recs), qp.PageSize))
+}
+
+func getOneGamePrintBatchHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getOneGamePrintBatchHandler")
+
+	gameID := pp.ByName("game_id")
+	instanceID := pp.ByName("instance_id")
+	printBatchID := pp.ByName("print_batch_id")
+
+	l.Info("getting game print batch >%s< for game >%s< instance >%s<", printBatchID, gameID, instanceID)
+
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeManagerModify(l, r, mm, gameID, instanceID); err != nil {
+		return err
+	}
+
+	rec, err := getInstanceGamePrintBatchRec(l, mm, instanceID, printBatchID)
+	if err != nil {
+		return err
+	}
+
+	response, err := mapper.GamePrintBatchRecordToResponse(l, rec)
+	if err != nil {
+		l.Warn("failed mapping game print batch record to response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, response)
+}
+
+func reprintGamePrintBatchHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "reprintGamePrintBatchHandler")
+
+	gameID := pp.ByName("game_id")
+	instanceID := pp.ByName("instance_id")
+	printBatchID := pp.ByName("print_batch_id")
+
+	l.Info("reprinting game print batch >%s< for game >%s< instance >%s<", printBatchID, gameID, instanceID)
+
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeManagerModify(l, r, mm, gameID, instanceID); err != nil {
+		return err
+	}
+
+	sourceRec, err := getInstanceGamePrintBatchRec(l, mm, instanceID, printBatchID)
+	if err != nil {
+		return err
+	}
+
+	rec, err := mapper.GamePrintBatchReprintRequestToRecord(l, r, sourceRec)
+	if err != nil {
+		l.Warn("failed mapping game print batch reprint request to record >%v<", err)
+		return err
+	}
+
+	if nullstring.IsValid(rec.GameSubscriptionInstanceID) {
+		subscriptionInstanceRec, err := mm.GetGameSubscriptionInstanceRec(rec.GameSubscriptionInstanceID.String, nil)
+		if err != nil {
+			l.Warn("failed getting game subscription instance >%s< >%v<", rec.GameSubscriptionInstanceID.String, err)
+			return err
+		}
+		if subscriptionInstanceRec.GameInstanceID != instanceID {
+			l.Warn("game subscription instance >%s< does not belong to game instance >%s<", subscriptionInstanceRec.ID, instanceID)
+			return coreerror.NewNotFoundError("game_subscription_instance", subscriptionInstanceRec.ID)
+		}
+	}
+
+	rec, err = mm.CreateGamePrintBatchRec(rec)
+	if err != nil {
+		l.Warn("failed creating game print batch >%v<", err)
+		return err
+	}
+
+	if _, err := jc.InsertTx(r.Context(), mm.Tx, &jobworker.GamePrintBatchWorkerArgs{
+		GamePrintBatchID: rec.ID,
+	}, nil); err != nil {
+		l.Warn("failed to enqueue game print batch job >%v<", err)
+		return err
+	}
+
+	response, err := mapper.GamePrintBatchRecordToResponse(l, rec)
+	if err != nil {
+		l.Warn("failed mapping game print batch record to response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusCreated, response)
+}
+
+// getInstanceGamePrintBatchRec returns the print batch, or not found when it does not
+// belong to the game instance
+func getInstanceGamePrintBatchRec(l logger.Logger, mm *domain.Domain, instanceID, printBatchID string) (*game_record.GamePrintBatch, error) {
+	rec, err := mm.GetGamePrintBatchRec(printBatchID, nil)
+	if err != nil {
+		l.Warn("failed getting game print batch >%v<", err)
+		return nil, err
+	}
+
+	if rec.GameInstanceID != instanceID {
+		l.Warn("print batch >%s< does not belong to game instance >%s<", printBatchID, instanceID)
+		return nil, coreerror.NewNotFoundError("print_batch", printBatchID)
+	}
+
+	return rec, nil
+}
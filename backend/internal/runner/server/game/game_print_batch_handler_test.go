@@ -0,0 +1,258 @@
+package game_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/harness"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	game "gitlab.com/alienspaces/playbymail/internal/runner/server/game"
+	"gitlab.com/alienspaces/playbymail/internal/utils/testutil"
+	"gitlab.com/alienspaces/playbymail/schema/api/game_schema"
+)
+
+// createTestGamePrintBatchRec creates a failed print batch for the game instance so
+// the handlers have a batch to list, fetch and reprint.
+func createTestGamePrintBatchRec(t *testing.T, th *harness.Testing, gameInstanceRec *game_record.GameInstance) *game_record.GamePrintBatch {
+	t.Helper()
+
+	tx, err := th.Store.BeginTx()
+	require.NoError(t, err, "BeginTx returns without error")
+	mm := th.Domain.(*domain.Domain)
+	err = mm.Init(tx)
+	require.NoError(t, err, "Domain init returns without error")
+
+	rec, err := mm.CreateGamePrintBatchRec(&game_record.GamePrintBatch{
+		GameID:         gameInstanceRec.GameID,
+		GameInstanceID: gameInstanceRec.ID,
+		TurnNumber:     gameInstanceRec.CurrentTurn,
+	})
+	require.NoError(t, err, "CreateGamePrintBatchRec returns without error")
+
+	rec, err = mm.MarkGamePrintBatchAsFailed(rec, "print device is not configured")
+	require.NoError(t, err, "MarkGamePrintBatchAsFailed returns without error")
+
+	err = tx.Commit(context.TODO())
+	require.NoError(t, err, "Commit returns without error")
+
+	return rec
+}
+
+func Test_getGamePrintBatchHandler(t *testing.T) {
+	t.Parallel()
+
+	th := testutil.NewTestHarness(t)
+	require.NotNil(t, th, "TestHarness returns without error")
+
+	_, err := th.Setup()
+	require.NoError(t, err, "Test data setup returns without error")
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err, "Test data teardown returns without error")
+	}()
+
+	gameRec, err := th.Data.GetGameRecByRef(harness.GameOneRef)
+	require.NoError(t, err, "GetGameRecByRef returns without error")
+
+	gameInstanceRec, err := th.Data.GetGameInstanceRecByRef(harness.GameInstanceOneRef)
+	require.NoError(t, err, "GetGameInstanceRecByRef returns without error")
+
+	otherGameInstanceRec, err := th.Data.GetGameInstanceRecByRef(harness.GameInstanceCleanRef)
+	require.NoError(t, err, "GetGameInstanceRecByRef returns without error")
+
+	printBatchRec := createTestGamePrintBatchRec(t, th, gameInstanceRec)
+
+	testCases := []struct {
+		testutil.TestCase
+		expectCount int
+	}{
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated manager when get many print batches then returns instance print batches",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[game.GetManyGamePrintBatches]
+				},
+				RequestHeaders: testutil.AuthHeaderProManager,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_id":     gameRec.ID,
+						":instance_id": gameInstanceRec.ID,
+					}
+				},
+				ResponseDecoder: testutil.TestCaseResponseDecoderGeneric[game_schema.GamePrintBatchCollectionResponse],
+				ResponseCode:    http.StatusOK,
+			},
+			expectCount: 1,
+		},
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated manager when get many print batches for instance without batches then returns empty collection",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[game.GetManyGamePrintBatches]
+				},
+				RequestHeaders: testutil.AuthHeaderProManager,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_id":     gameRec.ID,
+						":instance_id": otherGameInstanceRec.ID,
+					}
+				},
+				ResponseDecoder: testutil.TestCaseResponseDecoderGeneric[game_schema.GamePrintBatchCollectionResponse],
+				ResponseCode:    http.StatusOK,
+			},
+			expectCount: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running test >%s<\n", testCase.Name)
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			testFunc := func(method string, body any) {
+				require.NotNil(t, body, "Response body is not nil")
+
+				aResp := body.(game_schema.GamePrintBatchCollectionResponse).Data
+				require.Len(t, aResp, testCase.expectCount, "Response contains expected print batch count")
+				if testCase.expectCount > 0 {
+					require.Equal(t, printBatchRec.ID, aResp[0].ID, "Print batch ID equals expected")
+					require.Equal(t, game_record.GamePrintBatchStatusFailed, aResp[0].Status, "Print batch status equals expected")
+					require.Equal(t, "print device is not configured", aResp[0].ErrorMessage, "Print batch error message equals expected")
+				}
+			}
+
+			testutil.RunTestCase(t, th, &testCase.TestCase, testFunc)
+		})
+	}
+
+	oneTestCases := []struct {
+		testutil.TestCase
+	}{
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated manager when get one print batch then returns print batch",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[game.GetOneGamePrintBatch]
+				},
+				RequestHeaders: testutil.AuthHeaderProManager,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_id":        gameRec.ID,
+						":instance_id":    gameInstanceRec.ID,
+						":print_batch_id": printBatchRec.ID,
+					}
+				},
+				ResponseDecoder: testutil.TestCaseResponseDecoderGeneric[game_schema.GamePrintBatchResponse],
+				ResponseCode:    http.StatusOK,
+			},
+		},
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated manager when get one print batch from another instance then returns not found",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[game.GetOneGamePrintBatch]
+				},
+				RequestHeaders: testutil.AuthHeaderProManager,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_id":        gameRec.ID,
+						":instance_id":    otherGameInstanceRec.ID,
+						":print_batch_id": printBatchRec.ID,
+					}
+				},
+				ResponseDecoder: testutil.TestCaseResponseDecoderGeneric[map[string]any],
+				ResponseCode:    http.StatusNotFound,
+			},
+		},
+	}
+
+	for _, testCase := range oneTestCases {
+		t.Logf("Running test >%s<\n", testCase.Name)
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			testFunc := func(method string, body any) {
+				if testCase.ResponseCode != http.StatusOK {
+					return
+				}
+				require.NotNil(t, body, "Response body is not nil")
+
+				aResp := body.(game_schema.GamePrintBatchResponse).Data
+				require.Equal(t, printBatchRec.ID, aResp.ID, "Print batch ID equals expected")
+				require.Equal(t, gameInstanceRec.ID, aResp.GameInstanceID, "Print batch game instance ID equals expected")
+				require.Equal(t, printBatchRec.TurnNumber, aResp.TurnNumber, "Print batch turn number equals expected")
+			}
+
+			testutil.RunTestCase(t, th, &testCase.TestCase, testFunc)
+		})
+	}
+}
+
+func Test_reprintGamePrintBatchHandler(t *testing.T) {
+	t.Parallel()
+
+	th := testutil.NewTestHarness(t)
+	require.NotNil(t, th, "TestHarness returns without error")
+
+	_, err := th.Setup()
+	require.NoError(t, err, "Test data setup returns without error")
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err, "Test data teardown returns without error")
+	}()
+
+	gameRec, err := th.Data.GetGameRecByRef(harness.GameOneRef)
+	require.NoError(t, err, "GetGameRecByRef returns without error")
+
+	gameInstanceRec, err := th.Data.GetGameInstanceRecByRef(harness.GameInstanceOneRef)
+	require.NoError(t, err, "GetGameInstanceRecByRef returns without error")
+
+	printBatchRec := createTestGamePrintBatchRec(t, th, gameInstanceRec)
+
+	testCases := []struct {
+		testutil.TestCase
+	}{
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated manager when reprint print batch then returns new pending print batch for the same turn",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[game.ReprintGamePrintBatch]
+				},
+				RequestHeaders: testutil.AuthHeaderProManager,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_id":        gameRec.ID,
+						":instance_id":    gameInstanceRec.ID,
+						":print_batch_id": printBatchRec.ID,
+					}
+				},
+				RequestBody: func(d harness.Data) any {
+					return game_schema.GamePrintBatchReprintRequest{}
+				},
+				ResponseDecoder: testutil.TestCaseResponseDecoderGeneric[game_schema.GamePrintBatchResponse],
+				ResponseCode:    http.StatusCreated,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running test >%s<\n", testCase.Name)
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			testFunc := func(method string, body any) {
+				require.NotNil(t, body, "Response body is not nil")
+
+				aResp := body.(game_schema.GamePrintBatchResponse).Data
+				require.NotEqual(t, printBatchRec.ID, aResp.ID, "Reprint creates a new print batch")
+				require.Equal(t, game_record.GamePrintBatchStatusPending, aResp.Status, "Reprint status is pending")
+				require.Equal(t, printBatchRec.TurnNumber, aResp.TurnNumber, "Reprint turn number equals source turn number")
+				require.Empty(t, aResp.GameSubscriptionInstanceID, "Reprint of whole batch has no subscription instance")
+			}
+
+			testutil.RunTestCase(t, th, &testCase.TestCase, testFunc)
+		})
+	}
+}
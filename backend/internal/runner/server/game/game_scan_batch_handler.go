@@ -0,0 +1,363 @@
+package game
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/jobworker"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
+	"gitlab.com/alienspaces/playbymail/internal/scanbatch"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+)
+
+// API Resource Paths
+//
+// GET (collection)  /api/v1/manager/games/{game_id}/instances/{instance_id}/scan-batches
+// GET (document)    /api/v1/manager/games/{game_id}/instances/{instance_id}/scan-batches/{scan_batch_id}
+// GET (collection)  /api/v1/manager/games/{game_id}/instances/{instance_id}/scan-batches/{scan_batch_id}/pages
+// POST (document)   /api/v1/manager/games/{game_id}/instances/{instance_id}/scan-batches
+//
+// A scan batch is a bulk upload of scanned turn sheets, as a multi-page PDF, a ZIP
+// of page images or a single page image. The upload is processed in the background
+// and the batch and its pages report progress and the outcome of every page.
+
+const (
+	GetManyGameScanBatches    = "get-many-game-scan-batches"
+	GetOneGameScanBatch       = "get-one-game-scan-batch"
+	GetManyGameScanBatchPages = "get-many-game-scan-batch-pages"
+	CreateOneGameScanBatch    = "create-one-game-scan-batch"
+)
+
+// gameScanBatchFormFieldName is the multipart form field the scan batch document is uploaded in
+const gameScanBatchFormFieldName = "file"
+
+func gameScanBatchHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "gameScanBatchHandlerConfig")
+
+	l.Debug("adding game scan batch handler configuration")
+
+	gameScanBatchConfig := make(map[string]server.HandlerConfig)
+
+	collectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/game_schema",
+			Name:     "game_scan_batch.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, []jsonschema.Schema{
+			{
+				Location: "api/game_schema",
+				Name:     "game_scan_batch.schema.json",
+			},
+		}...),
+	}
+
+	responseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/game_schema",
+			Name:     "game_scan_batch.response.schema.json",
+		},
+		References: append(referenceSchemas, []jsonschema.Schema{
+			{
+				Location: "api/game_schema",
+				Name:     "game_scan_batch.schema.json",
+			},
+		}...),
+	}
+
+	pageCollectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/game_schema",
+			Name:     "game_scan_batch_page.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, []jsonschema.Schema{
+			{
+				Location: "api/game_schema",
+				Name:     "game_scan_batch_page.schema.json",
+			},
+		}...),
+	}
+
+	gameScanBatchConfig[GetManyGameScanBatches] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/manager/games/:game_id/instances/:instance_id/scan-batches",
+		HandlerFunc: getManyGameScanBatchesHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateResponseSchema: collectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:   true,
+			Collection: true,
+			Title:      "Get game instance scan batch collection",
+		},
+	}
+
+	gameScanBatchConfig[GetOneGameScanBatch] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/manager/games/:game_id/instances/:instance_id/scan-batches/:scan_batch_id",
+		HandlerFunc: getOneGameScanBatchHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Get game instance scan batch",
+		},
+	}
+
+	gameScanBatchConfig[GetManyGameScanBatchPages] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/manager/games/:game_id/instances/:instance_id/scan-batches/:scan_batch_id/pages",
+		HandlerFunc: getManyGameScanBatchPagesHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateResponseSchema: pageCollectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:   true,
+			Collection: true,
+			Title:      "Get game instance scan batch page collection",
+		},
+	}
+
+	gameScanBatchConfig[CreateOneGameScanBatch] = server.HandlerConfig{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/manager/games/:game_id/instances/:instance_id/scan-batches",
+		HandlerFunc: createOneGameScanBatchHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameManagement,
+			},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Upload game instance scan batch",
+			Description: "Upload scanned turn sheets as a multipart form `file` field containing " +
+				"a multi-page PDF, a ZIP of page images or a single page image. " +
+				"Pages are split and routed to their turn sheets in the background.",
+		},
+	}
+
+	return gameScanBatchConfig, nil
+}
+
+func getManyGameScanBatchesHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyGameScanBatchesHandler")
+
+	gameID := pp.ByName("game_id")
+	instanceID := pp.ByName("instance_id")
+
+	l.Info("getting many game scan batches for game >%s< instance >%s<", gameID, instanceID)
+
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeManagerModify(l, r, mm, gameID, instanceID); err != nil {
+		return err
+	}
+
+	opts := queryparam.ToSQLOptionsWithDefaults(qp)
+	opts.Params = append(opts.Params, sql.Param{
+		Col: game_record.FieldGameScanBatchGameInstanceID,
+		Val: instanceID,
+	})
+
+	recs, err := mm.GetManyGameScanBatchRecs(opts)
+	if err != nil {
+		l.Warn("failed getting game scan batches >%v<", err)
+		return err
+	}
+
+	response, err := mapper.GameScanBatchRecsToCollectionResponse(l, recs)
+	if err != nil {
+		l.Warn("failed mapping game scan batch records to collection response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, response, server.XPaginationHeader(len(recs), qp.PageSize))
+}
+
+func getOneGameScanBatchHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getOneGameScanBatchHandler")
+
+	gameID := pp.ByName("game_id")
+	instanceID := pp.ByName("instance_id")
+	scanBatchID := pp.ByName("scan_batch_id")
+
+	l.Info("getting game scan batch >%s< for game >%s< instance >%s<", scanBatchID, gameID, instanceID)
+
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeManagerModify(l, r, mm, gameID, instanceID); err != nil {
+		return err
+	}
+
+	rec, err := getInstanceGameScanBatchRec(l, mm, instanceID, scanBatchID)
+	if err != nil {
+		return err
+	}
+
+	response, err := mapper.GameScanBatchRecordToResponse(l, rec)
+	if err != nil {
+		l.Warn("failed mapping game scan batch record to response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, response)
+}
+
+func getManyGameScanBatchPagesHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyGameScanBatchPagesHandler")
+
+	gameID := pp.ByName("game_id")
+	instanceID := pp.ByName("instance_id")
+	scanBatchID := pp.ByName("scan_batch_id")
+
+	l.Info("getting game scan batch >%s< pages for game >%s< instance >%s<", scanBatchID, gameID, instanceID)
+
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeManagerModify(l, r, mm, gameID, instanceID); err != nil {
+		return err
+	}
+
+	rec, err := getInstanceGameScanBatchRec(l, mm, instanceID, scanBatchID)
+	if err != nil {
+		return err
+	}
+
+	pageRecs, err := mm.GetGameScanBatchPageRecsByBatch(rec.ID)
+	if err != nil {
+		l.Warn("failed getting game scan batch pages >%v<", err)
+		return err
+	}
+
+	response, err := mapper.GameScanBatchPageRecsToCollectionResponse(l, pageRecs)
+	if err != nil {
+		l.Warn("failed mapping game scan batch page records to collection response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, response)
+}
+
+func createOneGameScanBatchHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "createOneGameScanBatchHandler")
+
+	gameID := pp.ByName("game_id")
+	instanceID := pp.ByName("instance_id")
+
+	l.Info("creating game scan batch for game >%s< instance >%s<", gameID, instanceID)
+
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeManagerModify(l, r, mm, gameID, instanceID); err != nil {
+		return err
+	}
+
+	// Allow for multipart form overhead on top of the document size
+	r.Body = http.MaxBytesReader(w, r.Body, int64(game_record.GameScanBatchMaxSize)+(1<<20))
+
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		l.Warn("failed to parse multipart form >%v<", err)
+		return coreerror.NewInvalidDataError("failed to parse multipart form, documents must be at most 100MB: %v", err)
+	}
+
+	file, header, err := r.FormFile(gameScanBatchFormFieldName)
+	if err != nil {
+		l.Warn("failed to get scan batch file >%v<", err)
+		return coreerror.NewInvalidDataError("scan batch file is required")
+	}
+	defer file.Close()
+
+	documentData, err := io.ReadAll(file)
+	if err != nil {
+		l.Warn("failed to read scan batch file >%v<", err)
+		return coreerror.NewInvalidDataError("failed to read scan batch file")
+	}
+
+	if len(documentData) > game_record.GameScanBatchMaxSize {
+		l.Warn("scan batch file too large >%d< bytes, max >%d<", len(documentData), game_record.GameScanBatchMaxSize)
+		return coreerror.NewInvalidDataError("scan batch file too large, max 100MB")
+	}
+
+	// Reject documents that can never be split before queueing any work
+	if !scanbatch.IsPDF(documentData) && !scanbatch.IsZIP(documentData) && !scanbatch.IsImage(documentData) {
+		l.Warn("unsupported scan batch file >%s<", header.Filename)
+		return coreerror.NewInvalidDataError("%v", scanbatch.ErrUnsupportedDocument)
+	}
+
+	fileName := header.Filename
+	if fileName == "" {
+		fileName = "scan batch"
+	}
+	if len(fileName) > 255 {
+		fileName = fileName[:255]
+	}
+
+	rec, err := mm.CreateGameScanBatchRec(&game_record.GameScanBatch{
+		GameID:         gameID,
+		GameInstanceID: instanceID,
+		FileName:       fileName,
+		DocumentData:   documentData,
+	})
+	if err != nil {
+		l.Warn("failed creating game scan batch >%v<", err)
+		return err
+	}
+
+	if _, err := jc.InsertTx(r.Context(), mm.Tx, &jobworker.GameScanBatchWorkerArgs{
+		GameScanBatchID: rec.ID,
+	}, nil); err != nil {
+		l.Warn("failed to enqueue game scan batch job >%v<", err)
+		return err
+	}
+
+	response, err := mapper.GameScanBatchRecordToResponse(l, rec)
+	if err != nil {
+		l.Warn("failed mapping game scan batch record to response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusCreated, response)
+}
+
+// getInstanceGameScanBatchRec returns the scan batch, or not found when it does not
+// belong to the game instance
+func getInstanceGameScanBatchRec(l logger.Logger, mm *domain.Domain, instanceID, scanBatchID string) (*game_record.GameScanBatch, error) {
+	rec, err := mm.GetGameScanBatchRec(scanBatchID, nil)
+	if err != nil {
+		l.Warn("failed getting game scan batch >%v<", err)
+		return nil, err
+	}
+
+	if rec.GameInstanceID != instanceID {
+		l.Warn("scan batch >%s< does not belong to game instance >%s<", scanBatchID, instanceID)
+		return nil, coreerror.NewNotFoundError("scan_batch", scanBatchID)
+	}
+
+	return rec, nil
+}
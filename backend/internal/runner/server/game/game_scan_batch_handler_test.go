@@ -0,0 +1,204 @@
+package game_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/harness"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	game "gitlab.com/alienspaces/playbymail/internal/runner/server/game"
+	"gitlab.com/alienspaces/playbymail/internal/utils/testutil"
+	"gitlab.com/alienspaces/playbymail/schema/api/game_schema"
+)
+
+// createTestGameScanBatchRec creates a scan batch with a single unreadable page for
+// the game instance so the handlers have a batch and pages to list and fetch.
+func createTestGameScanBatchRec(t *testing.T, th *harness.Testing, gameInstanceRec *game_record.GameInstance) *game_record.GameScanBatch {
+	t.Helper()
+
+	tx, err := th.Store.BeginTx()
+	require.NoError(t, err, "BeginTx returns without error")
+	mm := th.Domain.(*domain.Domain)
+	err = mm.Init(tx)
+	require.NoError(t, err, "Domain init returns without error")
+
+	rec, err := mm.CreateGameScanBatchRec(&game_record.GameScanBatch{
+		GameID:         gameInstanceRec.GameID,
+		GameInstanceID: gameInstanceRec.ID,
+		FileName:       "returned-turn-sheets.pdf",
+		DocumentData:   []byte("%PDF-1.4"),
+	})
+	require.NoError(t, err, "CreateGameScanBatchRec returns without error")
+
+	pageRec, err := mm.CreateGameScanBatchPageRec(&game_record.GameScanBatchPage{
+		GameScanBatchID: rec.ID,
+		PageNumber:      1,
+		Source:          "page 1",
+	})
+	require.NoError(t, err, "CreateGameScanBatchPageRec returns without error")
+
+	rec, err = mm.MarkGameScanBatchAsProcessing(rec, 1)
+	require.NoError(t, err, "MarkGameScanBatchAsProcessing returns without error")
+
+	rec, err = mm.RecordGameScanBatchPageOutcome(rec, pageRec, game_record.GameScanBatchPageStatusUnreadable, "the turn sheet code could not be found")
+	require.NoError(t, err, "RecordGameScanBatchPageOutcome returns without error")
+
+	err = tx.Commit(context.TODO())
+	require.NoError(t, err, "Commit returns without error")
+
+	return rec
+}
+
+func Test_getGameScanBatchHandler(t *testing.T) {
+	t.Parallel()
+
+	th := testutil.NewTestHarness(t)
+	require.NotNil(t, th, "TestHarness returns without error")
+
+	_, err := th.Setup()
+	require.NoError(t, err, "Test data setup returns without error")
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err, "Test data teardown returns without error")
+	}()
+
+	gameRec, err := th.Data.GetGameRecByRef(harness.GameOneRef)
+	require.NoError(t, err, "GetGameRecByRef returns without error")
+
+	gameInstanceRec, err := th.Data.GetGameInstanceRecByRef(harness.GameInstanceOneRef)
+	require.NoError(t, err, "GetGameInstanceRecByRef returns without error")
+
+	otherGameInstanceRec, err := th.Data.GetGameInstanceRecByRef(harness.GameInstanceCleanRef)
+	require.NoError(t, err, "GetGameInstanceRecByRef returns without error")
+
+	scanBatchRec := createTestGameScanBatchRec(t, th, gameInstanceRec)
+
+	testCases := []struct {
+		testutil.TestCase
+		expectCount int
+	}{
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated manager when get many scan batches then returns instance scan batches",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[game.GetManyGameScanBatches]
+				},
+				RequestHeaders: testutil.AuthHeaderProManager,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_id":     gameRec.ID,
+						":instance_id": gameInstanceRec.ID,
+					}
+				},
+				ResponseDecoder: testutil.TestCaseResponseDecoderGeneric[game_schema.GameScanBatchCollectionResponse],
+				ResponseCode:    http.StatusOK,
+			},
+			expectCount: 1,
+		},
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated manager when get many scan batches for instance without batches then returns empty collection",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[game.GetManyGameScanBatches]
+				},
+				RequestHeaders: testutil.AuthHeaderProManager,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_id":     gameRec.ID,
+						":instance_id": otherGameInstanceRec.ID,
+					}
+				},
+				ResponseDecoder: testutil.TestCaseResponseDecoderGeneric[game_schema.GameScanBatchCollectionResponse],
+				ResponseCode:    http.StatusOK,
+			},
+			expectCount: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running test >%s<\n", testCase.Name)
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			testFunc := func(method string, body any) {
+				require.NotNil(t, body, "Response body is not nil")
+
+				aResp := body.(game_schema.GameScanBatchCollectionResponse).Data
+				require.Len(t, aResp, testCase.expectCount, "Response contains expected scan batch count")
+				if testCase.expectCount > 0 {
+					require.Equal(t, scanBatchRec.ID, aResp[0].ID, "Scan batch ID equals expected")
+					require.Equal(t, game_record.GameScanBatchStatusProcessing, aResp[0].Status, "Scan batch status equals expected")
+					require.Equal(t, 1, aResp[0].PagesProcessed, "Scan batch pages processed equals expected")
+					require.Equal(t, 1, aResp[0].UnreadableCount, "Scan batch unreadable count equals expected")
+				}
+			}
+
+			testutil.RunTestCase(t, th, &testCase.TestCase, testFunc)
+		})
+	}
+
+	pageTestCases := []struct {
+		testutil.TestCase
+	}{
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated manager when get scan batch pages then returns page outcomes",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[game.GetManyGameScanBatchPages]
+				},
+				RequestHeaders: testutil.AuthHeaderProManager,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_id":       gameRec.ID,
+						":instance_id":   gameInstanceRec.ID,
+						":scan_batch_id": scanBatchRec.ID,
+					}
+				},
+				ResponseDecoder: testutil.TestCaseResponseDecoderGeneric[game_schema.GameScanBatchPageCollectionResponse],
+				ResponseCode:    http.StatusOK,
+			},
+		},
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated manager when get scan batch pages for another instance then returns not found",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[game.GetManyGameScanBatchPages]
+				},
+				RequestHeaders: testutil.AuthHeaderProManager,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_id":       gameRec.ID,
+						":instance_id":   otherGameInstanceRec.ID,
+						":scan_batch_id": scanBatchRec.ID,
+					}
+				},
+				ResponseCode: http.StatusNotFound,
+			},
+		},
+	}
+
+	for _, testCase := range pageTestCases {
+		t.Logf("Running test >%s<\n", testCase.Name)
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			testFunc := func(method string, body any) {
+				if testCase.ResponseCode != http.StatusOK {
+					return
+				}
+				require.NotNil(t, body, "Response body is not nil")
+
+				aResp := body.(game_schema.GameScanBatchPageCollectionResponse).Data
+				require.Len(t, aResp, 1, "Response contains expected page count")
+				require.Equal(t, 1, aResp[0].PageNumber, "Page number equals expected")
+				require.Equal(t, game_record.GameScanBatchPageStatusUnreadable, aResp[0].Status, "Page status equals expected")
+				require.Equal(t, "the turn sheet code could not be found", aResp[0].Message, "Page message equals expected")
+			}
+
+			testutil.RunTestCase(t, th, &testCase.TestCase, testFunc)
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
 	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
 	"gitlab.com/alienspaces/playbymail/internal/utils/turnsheetutil"
@@ -75,9 +76,10 @@ func gameSubscriptionJoinHandlerConfig(l logger.Logger) (map[string]server.Handl
 			AuthenTypes: []server.AuthenticationType{server.AuthenticationTypeOptionalToken},
 		},
 		DocumentationConfig: server.DocumentationConfig{
-			Document:    true,
-			Title:       "Get join game turn sheet",
-			Description: "Returns the join game turn sheet as HTML for online completion or printing.",
+			Document:            true,
+			Title:               "Get join game turn sheet",
+			Description:         "Returns the join game turn sheet as HTML for online completion or printing.",
+			ResponseContentType: server.HeaderContentTypeHTML,
 		},
 	}
 
@@ -287,6 +289,8 @@ func getJoinSheetHandler(w http.ResponseWriter, r *http.Request, pp httprouter.P
 	switch gameRec.GameType {
 	case game_record.GameTypeMecha:
 		joinGameSheetType = mecha_game_record.MechaGameTurnSheetTypeJoinGame
+	case game_record.GameTypeMechaTactics:
+		joinGameSheetType = mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeJoinGame
 	default:
 		joinGameSheetType = adventure_game_record.AdventureGameTurnSheetTypeJoinGame
 	}
@@ -327,6 +331,12 @@ func getJoinSheetHandler(w http.ResponseWriter, r *http.Request, pp httprouter.P
 		AccountEmail: accountEmail,
 	}
 
+	if gameRec.GameType == game_record.GameTypeMecha {
+		if err := resolveJoinSheetMechaGameData(l, mm, subRec.ID, joinData); err != nil {
+			return err
+		}
+	}
+
 	backgroundImage, err := mm.GetGameTurnSheetImageDataURL(gameRec.ID, joinGameSheetType)
 	if err != nil {
 		l.Warn("failed to get turn sheet background image >%v<", err)
@@ -355,6 +365,71 @@ func getJoinSheetHandler(w http.ResponseWriter, r *http.Request, pp httprouter.P
 	return err
 }
 
+// resolveJoinSheetMechaGameData sets the squad size and ruleset a player
+// joining through the subscription will play with, taken from the instance they
+// would be placed in or the game type defaults when no instance currently has
+// capacity.
+func resolveJoinSheetMechaGameData(l logger.Logger, mm *domain.Domain, gameSubscriptionID string, joinData *turnsheet.JoinGameData) error {
+	instanceRec, err := mm.FindAvailableGameInstance(gameSubscriptionID)
+	if err != nil {
+		l.Warn("failed to find available game instance for subscription >%s< >%v<", gameSubscriptionID, err)
+		return err
+	}
+
+	if instanceRec == nil {
+		squadSize, err := domain.GetGameParameterIntegerDefault(game_record.GameTypeMecha, domain.MechaGameParameterSquadSize)
+		if err != nil {
+			l.Warn("failed to get default squad size >%v<", err)
+			return err
+		}
+
+		ruleset, err := domain.DefaultMechaGameRuleset()
+		if err != nil {
+			l.Warn("failed to get default ruleset >%v<", err)
+			return err
+		}
+
+		joinData.SquadSize = squadSize
+		joinData.MechaGameRules = mechaGameRulesSummary(ruleset)
+
+		return nil
+	}
+
+	squadSize, err := mm.GetGameInstanceIntegerParameterValue(instanceRec.ID, game_record.GameTypeMecha, domain.MechaGameParameterSquadSize)
+	if err != nil {
+		l.Warn("failed to get squad size for game instance >%s< >%v<", instanceRec.ID, err)
+		return err
+	}
+
+	ruleset, err := mm.GetMechaGameRuleset(instanceRec.ID)
+	if err != nil {
+		l.Warn("failed to get ruleset for game instance >%s< >%v<", instanceRec.ID, err)
+		return err
+	}
+
+	joinData.SquadSize = squadSize
+	joinData.MechaGameRules = mechaGameRulesSummary(ruleset)
+
+	return nil
+}
+
+func mechaGameRulesSummary(ruleset domain.MechaGameRuleset) *turnsheet.MechaGameRulesSummary {
+	return &turnsheet.MechaGameRulesSummary{
+		SupplyPointsPerTurn:    ruleset.SupplyPointsPerTurn,
+		HeatDissipationDivisor: ruleset.HeatDissipationDivisor,
+		AutoRepairPercent:      ruleset.AutoRepairPercent,
+		BaseHitChance:          ruleset.BaseHitChance,
+		HitChancePerSkill:      ruleset.HitChancePerSkill,
+		MaxHitChance:           ruleset.MaxHitChance,
+		PilotSkillThresholds:   ruleset.PilotSkillThresholds,
+
+		RoughMovementCost:         ruleset.RoughMovementCost,
+		UrbanMovementCost:         ruleset.UrbanMovementCost,
+		WaterHeatDissipationBonus: ruleset.WaterHeatDissipationBonus,
+		ObstructedFireRange:       ruleset.ObstructedFireRange,
+	}
+}
+
 func submitJoinHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
 	l = logging.LoggerWithFunctionContext(l, packageName, "submitJoinHandler")
 
@@ -0,0 +1,363 @@
+package game
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+)
+
+// API Resource CRUD Paths
+//
+// GET (collection)  /api/v1/games/{game_id}/translations
+// GET (document)    /api/v1/games/{game_id}/translations/{translation_id}
+// POST (document)   /api/v1/games/{game_id}/translations
+// PUT (document)    /api/v1/games/{game_id}/translations/{translation_id}
+// DELETE (document) /api/v1/games/{game_id}/translations/{translation_id}
+
+const (
+	GetManyGameTranslations  = "get-many-game-translations"
+	GetOneGameTranslation    = "get-one-game-translation"
+	CreateOneGameTranslation = "create-one-game-translation"
+	UpdateOneGameTranslation = "update-one-game-translation"
+	DeleteOneGameTranslation = "delete-one-game-translation"
+)
+
+func gameTranslationHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "gameTranslationHandlerConfig")
+
+	l.Debug("adding game translation handler configuration")
+
+	gameTranslationConfig := make(map[string]server.HandlerConfig)
+
+	collectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/game_schema",
+			Name:     "game_translation.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, []jsonschema.Schema{
+			{
+				Location: "api/game_schema",
+				Name:     "game_translation.schema.json",
+			},
+		}...),
+	}
+
+	requestSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/game_schema",
+			Name:     "game_translation.request.schema.json",
+		},
+		References: referenceSchemas,
+	}
+
+	responseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/game_schema",
+			Name:     "game_translation.response.schema.json",
+		},
+		References: append(referenceSchemas, []jsonschema.Schema{
+			{
+				Location: "api/game_schema",
+				Name:     "game_translation.schema.json",
+			},
+		}...),
+	}
+
+	gameTranslationConfig[GetManyGameTranslations] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/games/:game_id/translations",
+		HandlerFunc: getManyGameTranslationsHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameDesign,
+			},
+			ValidateResponseSchema: collectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:   true,
+			Collection: true,
+			Title:      "Get game translation collection",
+		},
+	}
+
+	gameTranslationConfig[GetOneGameTranslation] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/games/:game_id/translations/:translation_id",
+		HandlerFunc: getOneGameTranslationHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameDesign,
+			},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Get game translation",
+		},
+	}
+
+	gameTranslationConfig[CreateOneGameTranslation] = server.HandlerConfig{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/games/:game_id/translations",
+		HandlerFunc: createOneGameTranslationHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameDesign,
+			},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Create game translation",
+		},
+	}
+
+	gameTranslationConfig[UpdateOneGameTranslation] = server.HandlerConfig{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/games/:game_id/translations/:translation_id",
+		HandlerFunc: updateOneGameTranslationHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameDesign,
+			},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Update game translation",
+		},
+	}
+
+	gameTranslationConfig[DeleteOneGameTranslation] = server.HandlerConfig{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/games/:game_id/translations/:translation_id",
+		HandlerFunc: deleteOneGameTranslationHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameDesign,
+			},
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Delete game translation",
+		},
+	}
+
+	return gameTranslationConfig, nil
+}
+
+func getManyGameTranslationsHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyGameTranslationsHandler")
+
+	gameID := pp.ByName("game_id")
+
+	l.Info("getting many game translations for game >%s<", gameID)
+
+	mm := m.(*domain.Domain)
+
+	if _, _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	opts := queryparam.ToSQLOptionsWithDefaults(qp)
+	opts.Params = append(opts.Params, sql.Param{
+		Col: game_record.FieldGameTranslationGameID,
+		Val: gameID,
+	})
+
+	recs, err := mm.GetManyGameTranslationRecs(opts)
+	if err != nil {
+		l.Warn("failed getting game translations >%v<", err)
+		return err
+	}
+
+	response, err := mapper.GameTranslationRecsToCollectionResponse(l, recs)
+	if err != nil {
+		l.Warn("failed mapping game translation records to collection response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, response, server.XPaginationHeader(len(recs), qp.PageSize))
+}
+
+func getOneGameTranslationHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getOneGameTranslationHandler")
+
+	gameID := pp.ByName("game_id")
+	translationID := pp.ByName("translation_id")
+
+	l.Info("getting game translation >%s< for game >%s<", translationID, gameID)
+
+	mm := m.(*domain.Domain)
+
+	if _, _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec, err := getGameTranslationRecForGame(l, mm, gameID, translationID)
+	if err != nil {
+		return err
+	}
+
+	response, err := mapper.GameTranslationRecordToResponse(l, rec)
+	if err != nil {
+		l.Warn("failed mapping game translation record to response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, response)
+}
+
+func createOneGameTranslationHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "createOneGameTranslationHandler")
+
+	gameID := pp.ByName("game_id")
+
+	l.Info("creating game translation for game >%s<", gameID)
+
+	mm := m.(*domain.Domain)
+
+	if _, _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec, err := mapper.GameTranslationRequestToRecord(l, r, &game_record.GameTranslation{})
+	if err != nil {
+		l.Warn("failed mapping game translation request to record >%v<", err)
+		return err
+	}
+
+	// Ensure the game_id matches the URL parameter
+	rec.GameID = gameID
+
+	rec, err = mm.CreateGameTranslationRec(rec)
+	if err != nil {
+		l.Warn("failed creating game translation >%v<", err)
+		return err
+	}
+
+	response, err := mapper.GameTranslationRecordToResponse(l, rec)
+	if err != nil {
+		l.Warn("failed mapping game translation record to response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusCreated, response)
+}
+
+func updateOneGameTranslationHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "updateOneGameTranslationHandler")
+
+	gameID := pp.ByName("game_id")
+	translationID := pp.ByName("translation_id")
+
+	l.Info("updating game translation >%s< for game >%s<", translationID, gameID)
+
+	mm := m.(*domain.Domain)
+
+	if _, _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec, err := getGameTranslationRecForGame(l, mm, gameID, translationID)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mapper.GameTranslationRequestToRecord(l, r, rec)
+	if err != nil {
+		l.Warn("failed mapping game translation request to record >%v<", err)
+		return err
+	}
+
+	// Ensure the game_id doesn't change
+	rec.GameID = gameID
+
+	rec, err = mm.UpdateGameTranslationRec(rec)
+	if err != nil {
+		l.Warn("failed updating game translation >%v<", err)
+		return err
+	}
+
+	response, err := mapper.GameTranslationRecordToResponse(l, rec)
+	if err != nil {
+		l.Warn("failed mapping game translation record to response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, response)
+}
+
+func deleteOneGameTranslationHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "deleteOneGameTranslationHandler")
+
+	gameID := pp.ByName("game_id")
+	translationID := pp.ByName("translation_id")
+
+	l.Info("deleting game translation >%s< for game >%s<", translationID, gameID)
+
+	mm := m.(*domain.Domain)
+
+	if _, _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	if _, err := getGameTranslationRecForGame(l, mm, gameID, translationID); err != nil {
+		return err
+	}
+
+	if err := mm.DeleteGameTranslationRec(translationID); err != nil {
+		l.Warn("failed deleting game translation >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusNoContent, nil)
+}
+
+// getGameTranslationRecForGame returns a game translation, or not found when the
+// translation belongs to a different game
+func getGameTranslationRecForGame(l logger.Logger, mm *domain.Domain, gameID, translationID string) (*game_record.GameTranslation, error) {
+	rec, err := mm.GetGameTranslationRec(translationID, nil)
+	if err != nil {
+		l.Warn("failed getting game translation >%v<", err)
+		return nil, err
+	}
+
+	if rec.GameID != gameID {
+		l.Warn("translation >%s< does not belong to game >%s<", translationID, gameID)
+		return nil, coreerror.NewNotFoundError("translation", translationID)
+	}
+
+	return rec, nil
+}
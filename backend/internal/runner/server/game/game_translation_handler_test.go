@@ -0,0 +1,183 @@
+package game_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/internal/harness"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	game "gitlab.com/alienspaces/playbymail/internal/runner/server/game"
+	"gitlab.com/alienspaces/playbymail/internal/utils/testutil"
+	"gitlab.com/alienspaces/playbymail/schema/api/game_schema"
+)
+
+func Test_createOneGameTranslationHandler(t *testing.T) {
+	t.Parallel()
+
+	th := testutil.NewTestHarness(t)
+	require.NotNil(t, th, "newTestHarness returns without error")
+
+	_, err := th.Setup()
+	require.NoError(t, err, "Test data setup returns without error")
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err, "Test data teardown returns without error")
+	}()
+
+	gameRec, err := th.Data.GetGameRecByRef(harness.GameOneRef)
+	require.NoError(t, err, "GetGameRecByRef returns without error")
+
+	locationRec, err := th.Data.GetAdventureGameLocationRecByRef(harness.GameLocationOneRef)
+	require.NoError(t, err, "GetAdventureGameLocationRecByRef returns without error")
+
+	testCaseResponseDecoder := testutil.TestCaseResponseDecoderGeneric[game_schema.GameTranslationResponse]
+
+	gamePathParams := func(gameID string) func(d harness.Data) map[string]string {
+		return func(d harness.Data) map[string]string {
+			return map[string]string{
+				":game_id": gameID,
+			}
+		}
+	}
+
+	testCases := []testutil.TestCase{
+		{
+			Name: "authenticated designer when create translation of location name then returns created translation",
+			HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+				return rnr.GetHandlerConfig()[game.CreateOneGameTranslation]
+			},
+			RequestHeaders:    testutil.AuthHeaderProDesigner,
+			RequestPathParams: gamePathParams(gameRec.ID),
+			RequestBody: func(d harness.Data) any {
+				return game_schema.GameTranslationRequest{
+					Locale:    i18n.LocaleSpanish,
+					RecordID:  locationRec.ID,
+					FieldName: "name",
+					Text:      "El Bosque Oscuro",
+				}
+			},
+			ResponseDecoder: testCaseResponseDecoder,
+			ResponseCode:    http.StatusCreated,
+		},
+		{
+			Name: "authenticated designer when create translation with unsupported locale then returns bad request",
+			HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+				return rnr.GetHandlerConfig()[game.CreateOneGameTranslation]
+			},
+			RequestHeaders:    testutil.AuthHeaderProDesigner,
+			RequestPathParams: gamePathParams(gameRec.ID),
+			RequestBody: func(d harness.Data) any {
+				return game_schema.GameTranslationRequest{
+					Locale:    "xx",
+					RecordID:  locationRec.ID,
+					FieldName: "name",
+					Text:      "Unsupported",
+				}
+			},
+			ResponseDecoder: testCaseResponseDecoder,
+			ResponseCode:    http.StatusBadRequest,
+		},
+		{
+			Name: "authenticated designer when create translation of field that cannot be translated then returns bad request",
+			HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+				return rnr.GetHandlerConfig()[game.CreateOneGameTranslation]
+			},
+			RequestHeaders:    testutil.AuthHeaderProDesigner,
+			RequestPathParams: gamePathParams(gameRec.ID),
+			RequestBody: func(d harness.Data) any {
+				return game_schema.GameTranslationRequest{
+					Locale:    i18n.LocaleSpanish,
+					RecordID:  locationRec.ID,
+					FieldName: "status",
+					Text:      "activo",
+				}
+			},
+			ResponseDecoder: testCaseResponseDecoder,
+			ResponseCode:    http.StatusBadRequest,
+		},
+		{
+			Name: "authenticated designer when create translation for another game then returns unauthorized error",
+			HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+				return rnr.GetHandlerConfig()[game.CreateOneGameTranslation]
+			},
+			RequestHeaders:    testutil.AuthHeaderProDesigner,
+			RequestPathParams: gamePathParams("00000000-0000-0000-0000-000000000000"),
+			RequestBody: func(d harness.Data) any {
+				return game_schema.GameTranslationRequest{
+					Locale:    i18n.LocaleSpanish,
+					RecordID:  locationRec.ID,
+					FieldName: "name",
+					Text:      "El Bosque Oscuro",
+				}
+			},
+			ResponseDecoder: testCaseResponseDecoder,
+			ResponseCode:    http.StatusForbidden,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running test >%s<", testCase.Name)
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			testFunc := func(method string, body any) {
+				if testCase.TestResponseCode() != http.StatusCreated {
+					return
+				}
+
+				require.NotNil(t, body, "Response body is not nil")
+
+				req := testCase.TestRequestBody(th.Data).(game_schema.GameTranslationRequest)
+				aResp := body.(game_schema.GameTranslationResponse).Data
+
+				require.NotEmpty(t, aResp.ID, "Translation ID is not empty")
+				require.Equal(t, gameRec.ID, aResp.GameID, "Translation game ID equals the path game ID")
+				require.Equal(t, req.Locale, aResp.Locale, "Translation locale equals expected")
+				require.Equal(t, req.RecordID, aResp.RecordID, "Translation record ID equals expected")
+				require.Equal(t, req.FieldName, aResp.FieldName, "Translation field name equals expected")
+				require.Equal(t, req.Text, aResp.Text, "Translation text equals expected")
+			}
+
+			testutil.RunTestCase(t, th, &testCase, testFunc)
+		})
+	}
+}
+
+func Test_getManyGameTranslationsHandler(t *testing.T) {
+	t.Parallel()
+
+	th := testutil.NewTestHarness(t)
+	require.NotNil(t, th, "newTestHarness returns without error")
+
+	_, err := th.Setup()
+	require.NoError(t, err, "Test data setup returns without error")
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err, "Test data teardown returns without error")
+	}()
+
+	gameRec, err := th.Data.GetGameRecByRef(harness.GameOneRef)
+	require.NoError(t, err, "GetGameRecByRef returns without error")
+
+	testCase := testutil.TestCase{
+		Name: "authenticated designer when get many translations for game without translations then returns empty collection",
+		HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+			return rnr.GetHandlerConfig()[game.GetManyGameTranslations]
+		},
+		RequestHeaders: testutil.AuthHeaderProDesigner,
+		RequestPathParams: func(d harness.Data) map[string]string {
+			return map[string]string{
+				":game_id": gameRec.ID,
+			}
+		},
+		ResponseDecoder: testutil.TestCaseResponseDecoderGeneric[game_schema.GameTranslationCollectionResponse],
+		ResponseCode:    http.StatusOK,
+	}
+
+	testutil.RunTestCase(t, th, &testCase, func(method string, body any) {
+		require.NotNil(t, body, "Response body is not nil")
+		require.Empty(t, body.(game_schema.GameTranslationCollectionResponse).Data, "Response contains no translations")
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/riverqueue/river"
 
 	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
 	"gitlab.com/alienspaces/playbymail/core/nullstring"
 	"gitlab.com/alienspaces/playbymail/core/nulltime"
 	"gitlab.com/alienspaces/playbymail/core/queryparam"
@@ -69,6 +70,14 @@ func gameTurnSheetHandlerConfig(l logger.Logger, scanner turnsheet.TurnSheetScan
 				server.AuthenticationTypeToken,
 			},
 			// Permissions checked in handler (Player or Manager based on turn sheet type)
+
+			ValidateResponseSchema: jsonschema.SchemaWithReferences{
+				Main: jsonschema.Schema{
+					Location: "api/game_schema",
+					Name:     "game_turn_sheet.upload.response.schema.json",
+				},
+				References: referenceSchemas,
+			},
 		},
 		DocumentationConfig: server.DocumentationConfig{
 			Document: true,
@@ -76,6 +85,7 @@ func gameTurnSheetHandlerConfig(l logger.Logger, scanner turnsheet.TurnSheetScan
 			Description: "Upload a scanned turn sheet image and process it in a single pass. " +
 				"This extracts the turn sheet code, retrieves the turn sheet record, " +
 				"processes the scanned data, and saves the results.",
+			RequestContentType: server.HeaderContentTypeImage,
 		},
 	}
 
@@ -100,6 +110,7 @@ func gameTurnSheetHandlerConfig(l logger.Logger, scanner turnsheet.TurnSheetScan
 			Description: "Generate and download a join game turn sheet PDF. " +
 				"The same PDF can be printed multiple times for distribution. " +
 				"All join sheets for a game use the same turn sheet code.",
+			ResponseContentType: server.HeaderContentTypePDF,
 		},
 	}
 
@@ -363,15 +374,32 @@ func handlePlayGameTurnSheetUpload(ctx context.Context, l logger.Logger, scanner
 		return nil, 0, coreerror.NewInvalidDataError("failed to process turn sheet: %v", err)
 	}
 
+	// Scans with issues are held for a manager to review
+	review, err := turnsheet.ReviewScanData(l, m.Config(), turnSheetRec.SheetType, turnSheetRec.SheetData, scannedData, turnSheetCode)
+	if err != nil {
+		l.Warn("failed to review scanned data >%v<", err)
+		return nil, 0, coreerror.NewInternalError("failed to review scanned data >%v<", err)
+	}
+
 	turnSheetRec.ScannedData = json.RawMessage(scannedData)
 	turnSheetRec.ScannedAt = sql.NullTime{Time: time.Now(), Valid: true}
-	turnSheetRec.ProcessingStatus = game_record.TurnSheetProcessingStatusProcessed
+	turnSheetRec.ProcessingStatus = review.ProcessingStatus()
 
 	if _, err := m.UpdateGameTurnSheetRec(turnSheetRec); err != nil {
 		l.Warn("failed to update turn sheet record >%v<", err)
 		return nil, 0, coreerror.NewInternalError("failed to update turn sheet record >%v<", err)
 	}
 
+	issues, err := review.IssuesJSON()
+	if err != nil {
+		return nil, 0, coreerror.NewInternalError("failed to marshal scan issues >%v<", err)
+	}
+
+	if _, err := m.UpsertGameTurnSheetScanRec(turnSheetRec.ID, imageData, review.Confidence, issues); err != nil {
+		l.Warn("failed to record turn sheet scan >%v<", err)
+		return nil, 0, err
+	}
+
 	var scannedDataMap map[string]any
 	if err := json.Unmarshal(scannedData, &scannedDataMap); err != nil {
 		l.Warn("failed to unmarshal scanned data for response >%v<", err)
@@ -497,6 +525,12 @@ func downloadJoinGameTurnSheetsHandler(w http.ResponseWriter, r *http.Request, p
 		return coreerror.NewInvalidDataError("failed to create join game data: %v", err)
 	}
 
+	if gameRec.GameType == game_record.GameTypeMecha {
+		if err := resolveJoinSheetMechaGameData(l, mm, gameSubscriptionID, &joinData); err != nil {
+			return err
+		}
+	}
+
 	// Get uploaded turn sheet background image and add it to the data
 	turnSheetType := adventure_game_record.AdventureGameTurnSheetTypeJoinGame
 	backgroundImage, err := mm.GetGameTurnSheetImageDataURL(gameRec.ID, turnSheetType)
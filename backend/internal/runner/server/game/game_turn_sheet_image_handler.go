@@ -16,6 +16,7 @@ import (
 	_ "golang.org/x/image/webp"
 
 	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
 	"gitlab.com/alienspaces/playbymail/core/queryparam"
 	"gitlab.com/alienspaces/playbymail/core/server"
 	coresql "gitlab.com/alienspaces/playbymail/core/sql"
@@ -58,6 +59,15 @@ func gameImageHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, e
 			AuthzPermissions: []server.AuthorizedPermission{
 				handler_auth.PermissionGameDesign,
 			},
+			ValidateResponseSchema: jsonschema.SchemaWithReferences{
+				Main: jsonschema.Schema{
+					Location: "api/game_schema",
+					Name:     "game_image.response.schema.json",
+				},
+				References: append(referenceSchemas, []jsonschema.Schema{
+					{Location: "api/game_schema", Name: "game_image.schema.json"},
+				}...),
+			},
 		},
 		DocumentationConfig: server.DocumentationConfig{
 			Document: true,
@@ -69,6 +79,7 @@ func gameImageHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, e
 				"Images must be WebP, PNG, or JPEG format, max 1MB. " +
 				"Recommended: 2480x3508px (A4 @ 300 DPI) for best print quality. " +
 				"Use the preview endpoint to see how images appear in the turn sheet.",
+			RequestContentType: server.HeaderContentTypeMultipart,
 		},
 	}
 
@@ -81,6 +92,15 @@ func gameImageHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, e
 			AuthenTypes: []server.AuthenticationType{
 				server.AuthenticationTypeOptionalToken,
 			},
+			ValidateResponseSchema: jsonschema.SchemaWithReferences{
+				Main: jsonschema.Schema{
+					Location: "api/game_schema",
+					Name:     "game_image.collection.response.schema.json",
+				},
+				References: append(referenceSchemas, []jsonschema.Schema{
+					{Location: "api/game_schema", Name: "game_image.schema.json"},
+				}...),
+			},
 		},
 		DocumentationConfig: server.DocumentationConfig{
 			Document: true,
@@ -103,6 +123,15 @@ func gameImageHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, e
 			AuthzPermissions: []server.AuthorizedPermission{
 				handler_auth.PermissionGameDesign,
 			},
+			ValidateResponseSchema: jsonschema.SchemaWithReferences{
+				Main: jsonschema.Schema{
+					Location: "api/game_schema",
+					Name:     "game_image.response.schema.json",
+				},
+				References: append(referenceSchemas, []jsonschema.Schema{
+					{Location: "api/game_schema", Name: "game_image.schema.json"},
+				}...),
+			},
 		},
 		DocumentationConfig: server.DocumentationConfig{
 			Document:    true,
@@ -152,6 +181,7 @@ func gameImageHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, e
 			Description: "Generate and preview a game turn sheet PDF with uploaded background images. " +
 				"Query parameter 'turn_sheet_type' is required and specifies the turn sheet type (e.g., 'adventure_game_join_game', 'adventure_game_inventory_management'). " +
 				"Returns PDF with Content-Disposition: inline for browser preview.",
+			ResponseContentType: server.HeaderContentTypePDF,
 		},
 	}
 
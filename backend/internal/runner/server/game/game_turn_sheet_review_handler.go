@@ -0,0 +1,421 @@
+package game
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/jobworker"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+	"gitlab.com/alienspaces/playbymail/schema/api/game_schema"
+)
+
+// API Resource Paths
+//
+// GET (collection)  /api/v1/manager/games/{game_id}/instances/{instance_id}/turn-sheet-reviews
+// GET (document)    /api/v1/manager/games/{game_id}/instances/{instance_id}/turn-sheet-reviews/{game_turn_sheet_id}
+// GET (image)       /api/v1/manager/games/{game_id}/instances/{instance_id}/turn-sheet-reviews/{game_turn_sheet_id}/image
+// PUT (document)    /api/v1/manager/games/{game_id}/instances/{instance_id}/turn-sheet-reviews/{game_turn_sheet_id}
+// POST (document)   /api/v1/manager/games/{game_id}/instances/{instance_id}/turn-sheet-reviews/{game_turn_sheet_id}/approve
+//
+// Scanned turn sheets with a missing or wrong turn sheet code, or orders that are not
+// valid for the turn sheet, are held for review rather than completed. The manager
+// compares the scanned data with the scanned image, corrects the scanned data when
+// needed and approves the turn sheet, which completes it for turn processing.
+
+const (
+	GetManyGameTurnSheetReviews = "get-many-game-turn-sheet-reviews"
+	GetOneGameTurnSheetReview   = "get-one-game-turn-sheet-review"
+	GetGameTurnSheetReviewImage = "get-game-turn-sheet-review-image"
+	UpdateGameTurnSheetReview   = "update-game-turn-sheet-review"
+	ApproveGameTurnSheetReview  = "approve-game-turn-sheet-review"
+)
+
+func gameTurnSheetReviewHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "gameTurnSheetReviewHandlerConfig")
+
+	l.Debug("adding game turn sheet review handler configuration")
+
+	gameTurnSheetReviewConfig := make(map[string]server.HandlerConfig)
+
+	collectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/game_schema",
+			Name:     "game_turn_sheet_review.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, []jsonschema.Schema{
+			{
+				Location: "api/game_schema",
+				Name:     "game_turn_sheet_review.schema.json",
+			},
+		}...),
+	}
+
+	responseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/game_schema",
+			Name:     "game_turn_sheet_review.response.schema.json",
+		},
+		References: append(referenceSchemas, []jsonschema.Schema{
+			{
+				Location: "api/game_schema",
+				Name:     "game_turn_sheet_review.schema.json",
+			},
+		}...),
+	}
+
+	requestSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/game_schema",
+			Name:     "game_turn_sheet_review.request.schema.json",
+		},
+	}
+
+	gameTurnSheetReviewConfig[GetManyGameTurnSheetReviews] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/manager/games/:game_id/instances/:instance_id/turn-sheet-reviews",
+		HandlerFunc: getManyGameTurnSheetReviewsHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateResponseSchema: collectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:   true,
+			Collection: true,
+			Title:      "Get game instance turn sheet review collection",
+		},
+	}
+
+	gameTurnSheetReviewConfig[GetOneGameTurnSheetReview] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/manager/games/:game_id/instances/:instance_id/turn-sheet-reviews/:game_turn_sheet_id",
+		HandlerFunc: getOneGameTurnSheetReviewHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Get game instance turn sheet review",
+		},
+	}
+
+	gameTurnSheetReviewConfig[GetGameTurnSheetReviewImage] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/manager/games/:game_id/instances/:instance_id/turn-sheet-reviews/:game_turn_sheet_id/image",
+		HandlerFunc: getGameTurnSheetReviewImageHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:            true,
+			Title:               "Get game instance turn sheet review scanned image",
+			ResponseContentType: server.HeaderContentTypeImage,
+		},
+	}
+
+	gameTurnSheetReviewConfig[UpdateGameTurnSheetReview] = server.HandlerConfig{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/manager/games/:game_id/instances/:instance_id/turn-sheet-reviews/:game_turn_sheet_id",
+		HandlerFunc: updateGameTurnSheetReviewHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameManagement,
+			},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:    true,
+			Title:       "Correct game instance turn sheet review scanned data",
+			Description: "Replace the scanned data of a turn sheet held for review. The scanned data must be valid for the turn sheet.",
+		},
+	}
+
+	gameTurnSheetReviewConfig[ApproveGameTurnSheetReview] = server.HandlerConfig{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/manager/games/:game_id/instances/:instance_id/turn-sheet-reviews/:game_turn_sheet_id/approve",
+		HandlerFunc: approveGameTurnSheetReviewHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameManagement,
+			},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:    true,
+			Title:       "Approve game instance turn sheet review",
+			Description: "Accept the scanned data of a turn sheet held for review, completing the turn sheet for turn processing.",
+		},
+	}
+
+	return gameTurnSheetReviewConfig, nil
+}
+
+func getManyGameTurnSheetReviewsHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyGameTurnSheetReviewsHandler")
+
+	gameID := pp.ByName("game_id")
+	instanceID := pp.ByName("instance_id")
+
+	l.Info("getting many game turn sheet reviews for game >%s< instance >%s<", gameID, instanceID)
+
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeManagerModify(l, r, mm, gameID, instanceID); err != nil {
+		return err
+	}
+
+	recs, err := mm.GetGameTurnSheetRecsForReview(instanceID)
+	if err != nil {
+		l.Warn("failed getting game turn sheets for review >%v<", err)
+		return err
+	}
+
+	scanRecs := map[string]*game_record.GameTurnSheetScan{}
+	for _, rec := range recs {
+		scanRec, err := mm.GetGameTurnSheetScanRecByTurnSheet(rec.ID)
+		if err != nil {
+			l.Warn("failed getting game turn sheet >%s< scan >%v<", rec.ID, err)
+			return err
+		}
+		scanRecs[rec.ID] = scanRec
+	}
+
+	response, err := mapper.GameTurnSheetReviewsToCollectionResponse(l, recs, scanRecs)
+	if err != nil {
+		l.Warn("failed mapping game turn sheet records to review collection response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, response)
+}
+
+func getOneGameTurnSheetReviewHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getOneGameTurnSheetReviewHandler")
+
+	gameID := pp.ByName("game_id")
+	instanceID := pp.ByName("instance_id")
+	turnSheetID := pp.ByName("game_turn_sheet_id")
+
+	l.Info("getting game turn sheet review >%s< for game >%s< instance >%s<", turnSheetID, gameID, instanceID)
+
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeManagerModify(l, r, mm, gameID, instanceID); err != nil {
+		return err
+	}
+
+	rec, err := getInstanceGameTurnSheetRec(l, mm, instanceID, turnSheetID)
+	if err != nil {
+		return err
+	}
+
+	return writeGameTurnSheetReviewResponse(l, w, mm, rec)
+}
+
+func getGameTurnSheetReviewImageHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getGameTurnSheetReviewImageHandler")
+
+	gameID := pp.ByName("game_id")
+	instanceID := pp.ByName("instance_id")
+	turnSheetID := pp.ByName("game_turn_sheet_id")
+
+	l.Info("getting game turn sheet review >%s< image for game >%s< instance >%s<", turnSheetID, gameID, instanceID)
+
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeManagerModify(l, r, mm, gameID, instanceID); err != nil {
+		return err
+	}
+
+	rec, err := getInstanceGameTurnSheetRec(l, mm, instanceID, turnSheetID)
+	if err != nil {
+		return err
+	}
+
+	scanRec, err := mm.GetGameTurnSheetScanRecByTurnSheet(rec.ID)
+	if err != nil {
+		l.Warn("failed getting game turn sheet >%s< scan >%v<", rec.ID, err)
+		return err
+	}
+
+	if scanRec == nil || len(scanRec.ImageData) == 0 {
+		return coreerror.NewNotFoundError(game_record.TableGameTurnSheetScan, rec.ID)
+	}
+
+	w.Header().Set("Content-Type", http.DetectContentType(scanRec.ImageData))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(scanRec.ImageData); err != nil {
+		l.Warn("failed writing scanned image >%v<", err)
+		return err
+	}
+
+	return nil
+}
+
+func updateGameTurnSheetReviewHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "updateGameTurnSheetReviewHandler")
+
+	gameID := pp.ByName("game_id")
+	instanceID := pp.ByName("instance_id")
+	turnSheetID := pp.ByName("game_turn_sheet_id")
+
+	l.Info("updating game turn sheet review >%s< for game >%s< instance >%s<", turnSheetID, gameID, instanceID)
+
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeManagerModify(l, r, mm, gameID, instanceID); err != nil {
+		return err
+	}
+
+	rec, err := getInstanceGameTurnSheetRec(l, mm, instanceID, turnSheetID)
+	if err != nil {
+		return err
+	}
+
+	if rec.ProcessingStatus != game_record.TurnSheetProcessingStatusReview {
+		return coreerror.NewInvalidDataError("turn sheet is not waiting on review")
+	}
+
+	var req game_schema.GameTurnSheetReviewRequest
+	if _, err := server.ReadRequest(l, r, &req); err != nil {
+		l.Warn("failed to read request >%v<", err)
+		return err
+	}
+
+	if err := turnsheet.ValidateScanData(l, mm.Config(), rec.SheetType, rec.SheetData, req.ScannedData); err != nil {
+		l.Warn("corrected scanned data is not valid >%v<", err)
+		return coreerror.NewInvalidDataError("%v", err)
+	}
+
+	rec.ScannedData = req.ScannedData
+
+	rec, err = mm.UpdateGameTurnSheetRec(rec)
+	if err != nil {
+		l.Warn("failed updating game turn sheet >%s< >%v<", turnSheetID, err)
+		return err
+	}
+
+	return writeGameTurnSheetReviewResponse(l, w, mm, rec)
+}
+
+func approveGameTurnSheetReviewHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "approveGameTurnSheetReviewHandler")
+
+	gameID := pp.ByName("game_id")
+	instanceID := pp.ByName("instance_id")
+	turnSheetID := pp.ByName("game_turn_sheet_id")
+
+	l.Info("approving game turn sheet review >%s< for game >%s< instance >%s<", turnSheetID, gameID, instanceID)
+
+	mm := m.(*domain.Domain)
+
+	authenData, err := authorizeManagerModify(l, r, mm, gameID, instanceID)
+	if err != nil {
+		return err
+	}
+
+	rec, err := getInstanceGameTurnSheetRec(l, mm, instanceID, turnSheetID)
+	if err != nil {
+		return err
+	}
+
+	// Scanned data is only accepted once it is valid for the turn sheet, so a manager
+	// approving a turn sheet with invalid orders must correct them first
+	if len(rec.ScannedData) == 0 {
+		return coreerror.NewInvalidDataError("turn sheet has no scanned data to approve")
+	}
+
+	if err := turnsheet.ValidateScanData(l, mm.Config(), rec.SheetType, rec.SheetData, rec.ScannedData); err != nil {
+		l.Warn("scanned data is not valid >%v<", err)
+		return coreerror.NewInvalidDataError("scanned data must be corrected before approving: %v", err)
+	}
+
+	rec, err = mm.ApproveGameTurnSheetReview(rec, authenData.AccountUser.ID)
+	if err != nil {
+		l.Warn("failed approving game turn sheet >%s< >%v<", turnSheetID, err)
+		return err
+	}
+
+	gameInstanceRec, err := mm.GetGameInstanceRec(instanceID, nil)
+	if err != nil {
+		return err
+	}
+
+	// Check if we should trigger early turn processing
+	if gameInstanceRec.ProcessWhenAllSubmitted && gameInstanceRec.Status == game_record.GameInstanceStatusStarted {
+		allSubmitted, checkErr := mm.IsGameInstanceTurnSubmitted(gameInstanceRec)
+		if checkErr != nil {
+			l.Warn("failed to check all turn sheets submitted >%v<", checkErr)
+		} else if allSubmitted {
+			l.Info("all players submitted for game instance >%s< turn >%d<, enqueueing early turn processing", gameInstanceRec.ID, gameInstanceRec.CurrentTurn)
+			if _, err := jc.InsertTx(r.Context(), mm.Tx, jobworker.GameTurnProcessingWorkerArgs{
+				GameInstanceID: gameInstanceRec.ID,
+				TurnNumber:     gameInstanceRec.CurrentTurn,
+			}, nil); err != nil {
+				l.Warn("failed to enqueue early turn processing >%v<", err)
+				return err
+			}
+		}
+	}
+
+	return writeGameTurnSheetReviewResponse(l, w, mm, rec)
+}
+
+// getInstanceGameTurnSheetRec returns the turn sheet, or not found when it does not
+// belong to the game instance
+func getInstanceGameTurnSheetRec(l logger.Logger, mm *domain.Domain, instanceID, turnSheetID string) (*game_record.GameTurnSheet, error) {
+	rec, err := mm.GetGameTurnSheetRec(turnSheetID, nil)
+	if err != nil {
+		l.Warn("failed getting game turn sheet >%v<", err)
+		return nil, err
+	}
+
+	if rec.GameInstanceID.String != instanceID {
+		l.Warn("turn sheet >%s< does not belong to game instance >%s<", turnSheetID, instanceID)
+		return nil, coreerror.NewNotFoundError(game_record.TableGameTurnSheet, turnSheetID)
+	}
+
+	return rec, nil
+}
+
+func writeGameTurnSheetReviewResponse(l logger.Logger, w http.ResponseWriter, mm *domain.Domain, rec *game_record.GameTurnSheet) error {
+	scanRec, err := mm.GetGameTurnSheetScanRecByTurnSheet(rec.ID)
+	if err != nil {
+		l.Warn("failed getting game turn sheet >%s< scan >%v<", rec.ID, err)
+		return err
+	}
+
+	response, err := mapper.GameTurnSheetReviewToResponse(l, rec, scanRec)
+	if err != nil {
+		l.Warn("failed mapping game turn sheet record to review response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, response)
+}
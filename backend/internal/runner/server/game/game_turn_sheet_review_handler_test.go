@@ -0,0 +1,94 @@
+package game_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/internal/harness"
+	game "gitlab.com/alienspaces/playbymail/internal/runner/server/game"
+	"gitlab.com/alienspaces/playbymail/internal/utils/testutil"
+	"gitlab.com/alienspaces/playbymail/schema/api/game_schema"
+)
+
+func Test_getGameTurnSheetReviewHandler(t *testing.T) {
+	t.Parallel()
+
+	th := testutil.NewTestHarness(t)
+	require.NotNil(t, th, "TestHarness returns without error")
+
+	_, err := th.Setup()
+	require.NoError(t, err, "Test data setup returns without error")
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err, "Test data teardown returns without error")
+	}()
+
+	gameRec, err := th.Data.GetGameRecByRef(harness.GameOneRef)
+	require.NoError(t, err, "GetGameRecByRef returns without error")
+
+	gameInstanceRec, err := th.Data.GetGameInstanceRecByRef(harness.GameInstanceCleanRef)
+	require.NoError(t, err, "GetGameInstanceRecByRef returns without error")
+
+	testCases := []struct {
+		testutil.TestCase
+		expectCount int
+	}{
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated manager when get many turn sheet reviews for instance without reviews then returns empty collection",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[game.GetManyGameTurnSheetReviews]
+				},
+				RequestHeaders: testutil.AuthHeaderProManager,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_id":     gameRec.ID,
+						":instance_id": gameInstanceRec.ID,
+					}
+				},
+				ResponseDecoder: testutil.TestCaseResponseDecoderGeneric[game_schema.GameTurnSheetReviewCollectionResponse],
+				ResponseCode:    http.StatusOK,
+			},
+			expectCount: 0,
+		},
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated manager when approve turn sheet review that does not exist then returns not found",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[game.ApproveGameTurnSheetReview]
+				},
+				RequestHeaders: testutil.AuthHeaderProManager,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_id":            gameRec.ID,
+						":instance_id":        gameInstanceRec.ID,
+						":game_turn_sheet_id": uuid.NewString(),
+					}
+				},
+				ResponseCode: http.StatusNotFound,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running test >%s<\n", testCase.Name)
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			testFunc := func(method string, body any) {
+				if testCase.ResponseCode != http.StatusOK {
+					return
+				}
+				require.NotNil(t, body, "Response body is not nil")
+
+				aResp := body.(game_schema.GameTurnSheetReviewCollectionResponse).Data
+				require.Len(t, aResp, testCase.expectCount, "Response contains expected turn sheet review count")
+			}
+
+			testutil.RunTestCase(t, th, &testCase.TestCase, testFunc)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/riverqueue/river"
 
 	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
 	"gitlab.com/alienspaces/playbymail/core/queryparam"
 	"gitlab.com/alienspaces/playbymail/core/server"
 	"gitlab.com/alienspaces/playbymail/core/type/domainer"
@@ -22,8 +23,8 @@ const (
 )
 
 type gameValidationResponseData struct {
-	Valid  bool                          `json:"valid"`
-	Issues []domain.GameValidationIssue  `json:"issues"`
+	Valid  bool                         `json:"valid"`
+	Issues []domain.GameValidationIssue `json:"issues"`
 }
 
 type gameValidationResponse struct {
@@ -48,6 +49,13 @@ func gameValidateHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig
 			AuthzPermissions: []server.AuthorizedPermission{
 				handler_auth.PermissionGameDesign,
 			},
+			ValidateResponseSchema: jsonschema.SchemaWithReferences{
+				Main: jsonschema.Schema{
+					Location: "api/game_schema",
+					Name:     "game.validate.response.schema.json",
+				},
+				References: referenceSchemas,
+			},
 		},
 		DocumentationConfig: server.DocumentationConfig{
 			Document:    true,
@@ -70,7 +78,15 @@ func validateGameHandler(w http.ResponseWriter, r *http.Request, pp httprouter.P
 
 	mm := m.(*domain.Domain)
 
-	if _, _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+	authenData, _, err := authorizeDesignerModify(l, r, mm, gameID)
+	if err != nil {
+		return err
+	}
+
+	// Issues are reported in the designer's locale
+	locale, err := mm.GetAccountLocale(authenData.AccountUser.AccountID)
+	if err != nil {
+		l.Warn("failed getting account locale >%v<", err)
 		return err
 	}
 
@@ -85,11 +101,11 @@ func validateGameHandler(w http.ResponseWriter, r *http.Request, pp httprouter.P
 	}
 
 	valid := true
-	for _, issue := range issues {
+	for idx, issue := range issues {
 		if issue.Severity == domain.ValidationSeverityError {
 			valid = false
-			break
 		}
+		issues[idx] = issue.Localize(locale)
 	}
 
 	res := gameValidationResponse{
@@ -31,8 +31,8 @@ var subscriptionPermissionMap = map[string]server.AuthorizedPermission{
 	account_record.AccountSubscriptionTypeProfessionalPlayer:       PermissionGamePlaying,
 }
 
-// authenticateRequestTokenFunc authenticates a request based on a session token. Returning anything
-// other than an AuthenData{} with a valid Typewill result in a 401 Unauthorized response.
+// authenticateRequestTokenFunc authenticates a request based on a session token or an API token.
+// Returning anything other than an AuthenData{} with a valid Typewill result in a 401 Unauthorized response.
 func AuthenticateRequestTokenFunc(cfg config.Config, l logger.Logger, m domainer.Domainer, r *http.Request) (server.AuthenData, error) {
 
 	l.Info("authenticateRequestTokenFunc called")
@@ -120,24 +120,38 @@ func AuthenticateRequestTokenFunc(cfg config.Config, l logger.Logger, m domainer
 		return server.AuthenData{}, nil
 	}
 
-	// Verify the session token
-	tokenPreview := token
-	if len(token) > 20 {
-		tokenPreview = token[:20]
-	}
-	l.Info("verifying session token >%s<", tokenPreview)
-	accountUserRec, err := mm.VerifyAccountUserSessionToken(token)
-	if err != nil {
-		l.Warn("failed to verify account session token >%v<", err)
-		return server.AuthenData{}, err
+	// Verify the API token or session token
+	var sessionRec *account_record.AccountUserSession
+	var apiTokenRec *account_record.AccountUserAPIToken
+	var accountUserRec *account_record.AccountUser
+	var err error
+
+	if domain.IsAccountUserAPIToken(token) {
+		l.Info("verifying API token")
+		apiTokenRec, accountUserRec, err = mm.VerifyAccountUserAPIToken(token)
+		if err != nil {
+			l.Warn("failed to verify account API token >%v<", err)
+			return server.AuthenData{}, err
+		}
+	} else {
+		tokenPreview := token
+		if len(token) > 20 {
+			tokenPreview = token[:20]
+		}
+		l.Info("verifying session token >%s<", tokenPreview)
+		sessionRec, accountUserRec, err = mm.VerifyAccountUserSessionToken(token)
+		if err != nil {
+			l.Warn("failed to verify account session token >%v<", err)
+			return server.AuthenData{}, err
+		}
 	}
 
 	if accountUserRec == nil {
-		l.Warn("no account found for session token")
+		l.Warn("no account found for token")
 		return server.AuthenData{}, nil
 	}
 
-	l.Info("verified session token for account user ID >%s< Email >%s<", accountUserRec.ID, accountUserRec.Email)
+	l.Info("verified token for account user ID >%s< Email >%s<", accountUserRec.ID, accountUserRec.Email)
 
 	// Get account contact name and ID if available
 	accountUserContactRec, err := mm.GetAccountUserContactRecByAccountUserID(accountUserRec.ID, nil)
@@ -193,6 +207,18 @@ func AuthenticateRequestTokenFunc(cfg config.Config, l logger.Logger, m domainer
 		}
 	}
 
+	// API tokens only grant the permissions they were created with, and only
+	// while the account user still has those permissions
+	if apiTokenRec != nil {
+		tokenPermissionSet := make(map[server.AuthorizedPermission]bool)
+		for _, perm := range apiTokenRec.Permissions {
+			if permissionSet[server.AuthorizedPermission(perm)] {
+				tokenPermissionSet[server.AuthorizedPermission(perm)] = true
+			}
+		}
+		permissionSet = tokenPermissionSet
+	}
+
 	// Convert set to slice
 	permissions := make([]server.AuthorizedPermission, 0, len(permissionSet))
 	for perm := range permissionSet {
@@ -211,6 +237,15 @@ func AuthenticateRequestTokenFunc(cfg config.Config, l logger.Logger, m domainer
 		},
 	}
 
+	if sessionRec != nil {
+		authenData.SessionID = sessionRec.ID
+	}
+
+	if apiTokenRec != nil {
+		authenData.APITokenID = apiTokenRec.ID
+		authenData.GameIDs = apiTokenRec.GameIDs
+	}
+
 	l.Info("authenticated account: ID=%s Email=%s Name=%s Permissions=%v Subscriptions=%d",
 		authenData.AccountUser.ID, authenData.AccountUser.Email, authenData.AccountUser.Name, permissions, len(accountSubscriptions))
 
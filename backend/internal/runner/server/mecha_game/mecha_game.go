@@ -40,6 +40,7 @@ func MechaGameHandlerConfig(cfg config.Config, l logger.Logger, scnr turnsheet.T
 		mechaGameSquadHandlerConfig,
 		mechaGameSquadMechHandlerConfig,
 		mechaGameComputerOpponentHandlerConfig,
+		mechaGameCampaignHandlerConfig,
 	}
 
 	for _, fn := range handlerConfigFuncs {
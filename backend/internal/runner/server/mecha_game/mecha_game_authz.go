@@ -5,9 +5,11 @@ import (
 
 	coreerror "gitlab.com/alienspaces/playbymail/core/error"
 	"gitlab.com/alienspaces/playbymail/core/server"
+	coresql "gitlab.com/alienspaces/playbymail/core/sql"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
 	"gitlab.com/alienspaces/playbymail/internal/domain"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
 )
 
 // requireDesignerSubscription verifies the authenticated account user holds an active
@@ -34,3 +36,54 @@ func requireDesignerSubscription(l logger.Logger, r *http.Request, mm *domain.Do
 func authorizeDesignerModify(l logger.Logger, r *http.Request, mm *domain.Domain, gameID string) (*server.AuthenData, error) {
 	return requireDesignerSubscription(l, r, mm, gameID)
 }
+
+// authorizeCampaignOwner verifies the authenticated account user owns the given
+// mecha game campaign and returns the campaign.
+func authorizeCampaignOwner(l logger.Logger, r *http.Request, mm *domain.Domain, campaignID string, lock *coresql.Lock) (*server.AuthenData, *mecha_game_record.MechaGameCampaign, error) {
+	authenData := server.GetRequestAuthenData(l, r)
+
+	campaignRec, err := mm.GetMechaGameCampaignRec(campaignID, lock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if campaignRec.AccountUserID != authenData.AccountUser.ID {
+		l.Warn("authenticated account_user >%s< does not own mecha game campaign >%s<", authenData.AccountUser.ID, campaignID)
+		return nil, nil, coreerror.NewNotFoundError("campaign", campaignID)
+	}
+
+	return authenData, campaignRec, nil
+}
+
+// authorizeManagerInstance verifies the authenticated account user manages the
+// given game instance by confirming their manager subscription for the game is
+// linked to it via game_subscription_instance.
+func authorizeManagerInstance(l logger.Logger, r *http.Request, mm *domain.Domain, gameInstanceRec *game_record.GameInstance) error {
+	authenData := server.GetRequestAuthenData(l, r)
+
+	managerSubRec, err := mm.GetGameSubscriptionRecByAccountUserAndGame(
+		authenData.AccountUser.ID,
+		gameInstanceRec.GameID,
+		game_record.GameSubscriptionTypeManager,
+	)
+	if err != nil {
+		l.Warn("failed to find manager subscription for account_user >%s< and game >%s<: %v",
+			authenData.AccountUser.ID, gameInstanceRec.GameID, err)
+		return coreerror.NewUnauthorizedError()
+	}
+
+	instanceLinks, err := mm.GetGameSubscriptionInstanceRecsBySubscription(managerSubRec.ID)
+	if err != nil {
+		l.Warn("failed to get instance links for subscription >%s<: %v", managerSubRec.ID, err)
+		return coreerror.NewUnauthorizedError()
+	}
+
+	for _, link := range instanceLinks {
+		if link.GameInstanceID == gameInstanceRec.ID {
+			return nil
+		}
+	}
+
+	l.Warn("authenticated account_user >%s< does not manage game instance >%s<", authenData.AccountUser.ID, gameInstanceRec.ID)
+	return coreerror.NewUnauthorizedError()
+}
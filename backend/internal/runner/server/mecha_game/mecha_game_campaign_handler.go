@@ -0,0 +1,589 @@
+package mecha_game
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+	"gitlab.com/alienspaces/playbymail/schema/api/mecha_game_schema"
+)
+
+// API Resource CRUD Paths
+//
+// GET (collection)  /api/v1/manager/mecha-campaigns
+// GET (document)    /api/v1/manager/mecha-campaigns/{campaign_id}
+// POST (document)   /api/v1/manager/mecha-campaigns
+// PUT (document)    /api/v1/manager/mecha-campaigns/{campaign_id}
+// DELETE (document) /api/v1/manager/mecha-campaigns/{campaign_id}
+//
+// GET (collection)  /api/v1/manager/mecha-campaigns/{campaign_id}/instances
+// POST (document)   /api/v1/manager/mecha-campaigns/{campaign_id}/instances
+// DELETE (document) /api/v1/manager/mecha-campaigns/{campaign_id}/instances/{campaign_instance_id}
+//
+// GET (collection)  /api/v1/manager/mecha-campaigns/{campaign_id}/squads
+
+const (
+	GetManyMechaGameCampaigns          = "get-many-mecha-campaigns"
+	GetOneMechaGameCampaign            = "get-one-mecha-campaign"
+	CreateOneMechaGameCampaign         = "create-one-mecha-campaign"
+	UpdateOneMechaGameCampaign         = "update-one-mecha-campaign"
+	DeleteOneMechaGameCampaign         = "delete-one-mecha-campaign"
+	GetManyMechaGameCampaignInstances  = "get-many-mecha-campaign-instances"
+	CreateOneMechaGameCampaignInstance = "create-one-mecha-campaign-instance"
+	DeleteOneMechaGameCampaignInstance = "delete-one-mecha-campaign-instance"
+	GetManyMechaGameCampaignSquads     = "get-many-mecha-campaign-squads"
+)
+
+func mechaGameCampaignHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "mechaGameCampaignHandlerConfig")
+	l.Debug("Adding mecha campaign handler configuration")
+
+	config := make(map[string]server.HandlerConfig)
+
+	collectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_game_schema",
+			Name:     "mecha_game_campaign.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_game_schema",
+			Name:     "mecha_game_campaign.schema.json",
+		}),
+	}
+
+	requestSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_game_schema",
+			Name:     "mecha_game_campaign.request.schema.json",
+		},
+		References: referenceSchemas,
+	}
+
+	responseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_game_schema",
+			Name:     "mecha_game_campaign.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_game_schema",
+			Name:     "mecha_game_campaign.schema.json",
+		}),
+	}
+
+	instanceCollectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_game_schema",
+			Name:     "mecha_game_campaign_instance.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_game_schema",
+			Name:     "mecha_game_campaign_instance.schema.json",
+		}),
+	}
+
+	instanceRequestSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_game_schema",
+			Name:     "mecha_game_campaign_instance.request.schema.json",
+		},
+		References: referenceSchemas,
+	}
+
+	instanceResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_game_schema",
+			Name:     "mecha_game_campaign_instance.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_game_schema",
+			Name:     "mecha_game_campaign_instance.schema.json",
+		}),
+	}
+
+	squadCollectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_game_schema",
+			Name:     "mecha_game_campaign_squad.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_game_schema",
+			Name:     "mecha_game_campaign_squad.schema.json",
+		}),
+	}
+
+	config[GetManyMechaGameCampaigns] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/manager/mecha-campaigns",
+		HandlerFunc: getManyMechaGameCampaignsHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateResponseSchema: collectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:   true,
+			Collection: true,
+			Title:      "Get mecha campaigns",
+		},
+	}
+
+	config[GetOneMechaGameCampaign] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/manager/mecha-campaigns/:campaign_id",
+		HandlerFunc: getOneMechaGameCampaignHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Get mecha campaign",
+		},
+	}
+
+	config[CreateOneMechaGameCampaign] = server.HandlerConfig{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/manager/mecha-campaigns",
+		HandlerFunc: createOneMechaGameCampaignHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameManagement,
+			},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Create mecha campaign",
+		},
+	}
+
+	config[UpdateOneMechaGameCampaign] = server.HandlerConfig{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/manager/mecha-campaigns/:campaign_id",
+		HandlerFunc: updateOneMechaGameCampaignHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameManagement,
+			},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Update mecha campaign",
+		},
+	}
+
+	config[DeleteOneMechaGameCampaign] = server.HandlerConfig{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/manager/mecha-campaigns/:campaign_id",
+		HandlerFunc: deleteOneMechaGameCampaignHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameManagement,
+			},
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Delete mecha campaign",
+		},
+	}
+
+	config[GetManyMechaGameCampaignInstances] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/manager/mecha-campaigns/:campaign_id/instances",
+		HandlerFunc: getManyMechaGameCampaignInstancesHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateResponseSchema: instanceCollectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:   true,
+			Collection: true,
+			Title:      "Get mecha campaign battles",
+		},
+	}
+
+	config[CreateOneMechaGameCampaignInstance] = server.HandlerConfig{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/manager/mecha-campaigns/:campaign_id/instances",
+		HandlerFunc: createOneMechaGameCampaignInstanceHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameManagement,
+			},
+			ValidateRequestSchema:  instanceRequestSchema,
+			ValidateResponseSchema: instanceResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Add mecha campaign battle",
+		},
+	}
+
+	config[DeleteOneMechaGameCampaignInstance] = server.HandlerConfig{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/manager/mecha-campaigns/:campaign_id/instances/:campaign_instance_id",
+		HandlerFunc: deleteOneMechaGameCampaignInstanceHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGameManagement,
+			},
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Remove mecha campaign battle",
+		},
+	}
+
+	config[GetManyMechaGameCampaignSquads] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/manager/mecha-campaigns/:campaign_id/squads",
+		HandlerFunc: getManyMechaGameCampaignSquadsHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{
+				server.AuthenticationTypeToken,
+			},
+			ValidateResponseSchema: squadCollectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document:   true,
+			Collection: true,
+			Title:      "Get mecha campaign squad rosters",
+		},
+	}
+
+	return config, nil
+}
+
+func getManyMechaGameCampaignsHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyMechaGameCampaignsHandler")
+
+	authenData := server.GetRequestAuthenData(l, r)
+
+	opts := queryparam.ToSQLOptionsWithDefaults(qp)
+	opts.Params = append(opts.Params, sql.Param{
+		Col: mecha_game_record.FieldMechaGameCampaignAccountUserID,
+		Val: authenData.AccountUser.ID,
+	})
+
+	mm := m.(*domain.Domain)
+
+	recs, err := mm.GetManyMechaGameCampaignRecs(opts)
+	if err != nil {
+		l.Warn("failed getting mecha campaign records >%v<", err)
+		return err
+	}
+
+	res, err := mapper.MechaGameCampaignRecordsToCollectionResponse(l, recs)
+	if err != nil {
+		return err
+	}
+
+	if err = server.WriteResponse(l, w, http.StatusOK, res, server.XPaginationHeader(len(recs), qp.PageSize)); err != nil {
+		l.Warn("failed writing response >%v<", err)
+		return err
+	}
+
+	return nil
+}
+
+func getOneMechaGameCampaignHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getOneMechaGameCampaignHandler")
+
+	campaignID := pp.ByName("campaign_id")
+	mm := m.(*domain.Domain)
+
+	_, rec, err := authorizeCampaignOwner(l, r, mm, campaignID, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := mapper.MechaGameCampaignRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	if err = server.WriteResponse(l, w, http.StatusOK, res); err != nil {
+		l.Warn("failed writing response >%v<", err)
+		return err
+	}
+
+	return nil
+}
+
+func createOneMechaGameCampaignHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "createOneMechaGameCampaignHandler")
+
+	authenData := server.GetRequestAuthenData(l, r)
+	mm := m.(*domain.Domain)
+
+	rec := &mecha_game_record.MechaGameCampaign{
+		AccountUserID: authenData.AccountUser.ID,
+	}
+
+	rec, err := mapper.MechaGameCampaignRequestToRecord(l, r, rec)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mm.CreateMechaGameCampaignRec(rec)
+	if err != nil {
+		l.Warn("failed creating mecha campaign record >%v<", err)
+		return err
+	}
+
+	res, err := mapper.MechaGameCampaignRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	if err = server.WriteResponse(l, w, http.StatusCreated, res); err != nil {
+		l.Warn("failed writing response >%v<", err)
+		return err
+	}
+
+	return nil
+}
+
+func updateOneMechaGameCampaignHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "updateOneMechaGameCampaignHandler")
+
+	campaignID := pp.ByName("campaign_id")
+	mm := m.(*domain.Domain)
+
+	_, rec, err := authorizeCampaignOwner(l, r, mm, campaignID, sql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mapper.MechaGameCampaignRequestToRecord(l, r, rec)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mm.UpdateMechaGameCampaignRec(rec)
+	if err != nil {
+		l.Warn("failed updating mecha campaign record >%v<", err)
+		return err
+	}
+
+	res, err := mapper.MechaGameCampaignRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	if err = server.WriteResponse(l, w, http.StatusOK, res); err != nil {
+		l.Warn("failed writing response >%v<", err)
+		return err
+	}
+
+	return nil
+}
+
+func deleteOneMechaGameCampaignHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "deleteOneMechaGameCampaignHandler")
+
+	campaignID := pp.ByName("campaign_id")
+	mm := m.(*domain.Domain)
+
+	if _, _, err := authorizeCampaignOwner(l, r, mm, campaignID, nil); err != nil {
+		return err
+	}
+
+	if err := mm.DeleteMechaGameCampaignRec(campaignID); err != nil {
+		l.Warn("failed deleting mecha campaign record >%v<", err)
+		return err
+	}
+
+	if err := server.WriteResponse(l, w, http.StatusNoContent, nil); err != nil {
+		l.Warn("failed writing response >%v<", err)
+		return err
+	}
+
+	return nil
+}
+
+func getManyMechaGameCampaignInstancesHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyMechaGameCampaignInstancesHandler")
+
+	campaignID := pp.ByName("campaign_id")
+	mm := m.(*domain.Domain)
+
+	if _, _, err := authorizeCampaignOwner(l, r, mm, campaignID, nil); err != nil {
+		return err
+	}
+
+	recs, err := mm.GetMechaGameCampaignInstanceRecsByCampaign(campaignID)
+	if err != nil {
+		l.Warn("failed getting mecha campaign instance records >%v<", err)
+		return err
+	}
+
+	res, err := mapper.MechaGameCampaignInstanceRecordsToCollectionResponse(l, recs)
+	if err != nil {
+		return err
+	}
+
+	if err = server.WriteResponse(l, w, http.StatusOK, res); err != nil {
+		l.Warn("failed writing response >%v<", err)
+		return err
+	}
+
+	return nil
+}
+
+func createOneMechaGameCampaignInstanceHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "createOneMechaGameCampaignInstanceHandler")
+
+	campaignID := pp.ByName("campaign_id")
+	mm := m.(*domain.Domain)
+
+	if _, _, err := authorizeCampaignOwner(l, r, mm, campaignID, nil); err != nil {
+		return err
+	}
+
+	rec, err := mapper.MechaGameCampaignInstanceRequestToRecord(l, r, &mecha_game_record.MechaGameCampaignInstance{})
+	if err != nil {
+		return err
+	}
+
+	gameInstanceRec, err := mm.GetGameInstanceRec(rec.GameInstanceID, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeManagerInstance(l, r, mm, gameInstanceRec); err != nil {
+		return err
+	}
+
+	rec, err = mm.AddMechaGameCampaignInstance(campaignID, gameInstanceRec.ID)
+	if err != nil {
+		l.Warn("failed adding mecha campaign instance record >%v<", err)
+		return err
+	}
+
+	res, err := mapper.MechaGameCampaignInstanceRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	if err = server.WriteResponse(l, w, http.StatusCreated, res); err != nil {
+		l.Warn("failed writing response >%v<", err)
+		return err
+	}
+
+	return nil
+}
+
+func deleteOneMechaGameCampaignInstanceHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "deleteOneMechaGameCampaignInstanceHandler")
+
+	campaignID := pp.ByName("campaign_id")
+	campaignInstanceID := pp.ByName("campaign_instance_id")
+	mm := m.(*domain.Domain)
+
+	if _, _, err := authorizeCampaignOwner(l, r, mm, campaignID, nil); err != nil {
+		return err
+	}
+
+	rec, err := mm.GetMechaGameCampaignInstanceRec(campaignInstanceID, nil)
+	if err != nil {
+		return err
+	}
+
+	if rec.MechaGameCampaignID != campaignID {
+		return coreerror.NewNotFoundError("campaign instance", campaignInstanceID)
+	}
+
+	if err := mm.DeleteMechaGameCampaignInstanceRec(campaignInstanceID); err != nil {
+		l.Warn("failed deleting mecha campaign instance record >%v<", err)
+		return err
+	}
+
+	if err := server.WriteResponse(l, w, http.StatusNoContent, nil); err != nil {
+		l.Warn("failed writing response >%v<", err)
+		return err
+	}
+
+	return nil
+}
+
+func getManyMechaGameCampaignSquadsHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyMechaGameCampaignSquadsHandler")
+
+	campaignID := pp.ByName("campaign_id")
+	mm := m.(*domain.Domain)
+
+	if _, _, err := authorizeCampaignOwner(l, r, mm, campaignID, nil); err != nil {
+		return err
+	}
+
+	squadRecs, err := mm.GetManyMechaGameCampaignSquadRecs(&sql.Options{
+		Params: []sql.Param{
+			{Col: mecha_game_record.FieldMechaGameCampaignSquadMechaGameCampaignID, Val: campaignID},
+		},
+	})
+	if err != nil {
+		l.Warn("failed getting mecha campaign squad records >%v<", err)
+		return err
+	}
+
+	res := mecha_game_schema.MechaGameCampaignSquadCollectionResponse{
+		Data: []*mecha_game_schema.MechaGameCampaignSquadResponseData{},
+	}
+	for _, squadRec := range squadRecs {
+		mechRecs, err := mm.GetMechaGameCampaignMechRecsBySquad(squadRec.ID)
+		if err != nil {
+			l.Warn("failed getting mecha campaign mech records >%v<", err)
+			return err
+		}
+		data, err := mapper.MechaGameCampaignSquadRecordToResponseData(l, squadRec, mechRecs)
+		if err != nil {
+			return err
+		}
+		res.Data = append(res.Data, data)
+	}
+
+	if err = server.WriteResponse(l, w, http.StatusOK, res); err != nil {
+		l.Warn("failed writing response >%v<", err)
+		return err
+	}
+
+	return nil
+}
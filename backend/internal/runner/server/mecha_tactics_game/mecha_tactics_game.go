@@ -0,0 +1,52 @@
+package mecha_tactics_game
+
+import (
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+)
+
+const (
+	packageName = "mecha_tactics_game"
+)
+
+var referenceSchemas = []jsonschema.Schema{
+	{
+		Location: "api/common_schema",
+		Name:     "query.schema.json",
+	},
+	{
+		Location: "api/common_schema",
+		Name:     "common.schema.json",
+	},
+}
+
+func MechaTacticsGameHandlerConfig(cfg config.Config, l logger.Logger, scnr turnsheet.TurnSheetScanner) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "MechaTacticsGameHandlerConfig")
+
+	l.Debug("Adding mecha tactics handler configuration")
+
+	mechaTacticsGameConfig := make(map[string]server.HandlerConfig)
+
+	handlerConfigFuncs := []func(logger.Logger) (map[string]server.HandlerConfig, error){
+		mechaTacticsGameChassisHandlerConfig,
+		mechaTacticsGameWeaponHandlerConfig,
+		mechaTacticsGameTerrainTypeHandlerConfig,
+		mechaTacticsGameHexHandlerConfig,
+		mechaTacticsGameMechHandlerConfig,
+		mechaTacticsGameComputerOpponentHandlerConfig,
+	}
+
+	for _, fn := range handlerConfigFuncs {
+		cfg, err := fn(l)
+		if err != nil {
+			return nil, err
+		}
+		mechaTacticsGameConfig = server.MergeHandlerConfigs(mechaTacticsGameConfig, cfg)
+	}
+
+	return mechaTacticsGameConfig, nil
+}
@@ -0,0 +1,270 @@
+package mecha_tactics_game
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+)
+
+const (
+	GetManyMechaTacticsGameChassis   = "get-many-mecha-tactics-chassis"
+	GetOneMechaTacticsGameChassis    = "get-one-mecha-tactics-chassis"
+	CreateOneMechaTacticsGameChassis = "create-one-mecha-tactics-chassis"
+	UpdateOneMechaTacticsGameChassis = "update-one-mecha-tactics-chassis"
+	DeleteOneMechaTacticsGameChassis = "delete-one-mecha-tactics-chassis"
+)
+
+func mechaTacticsGameChassisHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "mechaTacticsGameChassisHandlerConfig")
+
+	l.Debug("Adding mecha tactics chassis handler configuration")
+
+	chassisConfig := make(map[string]server.HandlerConfig)
+
+	collectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_chassis.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_chassis.schema.json",
+		}),
+	}
+
+	requestSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_chassis.request.schema.json",
+		},
+		References: referenceSchemas,
+	}
+
+	responseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_chassis.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_chassis.schema.json",
+		}),
+	}
+
+	chassisConfig[GetManyMechaTacticsGameChassis] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/chassis",
+		HandlerFunc: getManyMechaTacticsGameChassisHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			ValidateResponseSchema: collectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Collection: true, Title: "Get mecha tactics chassis"},
+	}
+
+	chassisConfig[GetOneMechaTacticsGameChassis] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/chassis/:chassis_id",
+		HandlerFunc: getOneMechaTacticsGameChassisHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Get mecha tactics chassis"},
+	}
+
+	chassisConfig[CreateOneMechaTacticsGameChassis] = server.HandlerConfig{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/chassis",
+		HandlerFunc: createOneMechaTacticsGameChassisHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions:       []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Create mecha tactics chassis"},
+	}
+
+	chassisConfig[UpdateOneMechaTacticsGameChassis] = server.HandlerConfig{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/chassis/:chassis_id",
+		HandlerFunc: updateOneMechaTacticsGameChassisHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions:       []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Update mecha tactics chassis"},
+	}
+
+	chassisConfig[DeleteOneMechaTacticsGameChassis] = server.HandlerConfig{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/chassis/:chassis_id",
+		HandlerFunc: deleteOneMechaTacticsGameChassisHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:      []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions: []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Delete mecha tactics chassis"},
+	}
+
+	return chassisConfig, nil
+}
+
+func getManyMechaTacticsGameChassisHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyMechaTacticsGameChassisHandler")
+
+	gameID := pp.ByName("game_id")
+	if gameID == "" {
+		return coreerror.NewParamError("game_id is required")
+	}
+
+	opts := queryparam.ToSQLOptionsWithDefaults(qp)
+	opts.Params = append(opts.Params, sql.Param{Col: mecha_tactics_game_record.FieldMechaTacticsGameChassisGameID, Val: gameID})
+
+	mm := m.(*domain.Domain)
+	recs, err := mm.GetManyMechaTacticsGameChassisRecs(opts)
+	if err != nil {
+		l.Warn("failed getting mecha tactics chassis records >%v<", err)
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameChassisRecordsToCollectionResponse(l, recs)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res, server.XPaginationHeader(len(recs), qp.PageSize))
+}
+
+func getOneMechaTacticsGameChassisHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getOneMechaTacticsGameChassisHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("chassis_id")
+	mm := m.(*domain.Domain)
+
+	rec, err := mm.GetMechaTacticsGameChassisRec(recID, nil)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("chassis", recID)
+	}
+
+	res, err := mapper.MechaTacticsGameChassisRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res)
+}
+
+func createOneMechaTacticsGameChassisHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "createOneMechaTacticsGameChassisHandler")
+
+	gameID := pp.ByName("game_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec := &mecha_tactics_game_record.MechaTacticsGameChassis{GameID: gameID}
+	rec, err := mapper.MechaTacticsGameChassisRequestToRecord(l, r, rec)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mm.CreateMechaTacticsGameChassisRec(rec)
+	if err != nil {
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameChassisRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusCreated, res)
+}
+
+func updateOneMechaTacticsGameChassisHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "updateOneMechaTacticsGameChassisHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("chassis_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec, err := mm.GetMechaTacticsGameChassisRec(recID, sql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("chassis", recID)
+	}
+
+	rec, err = mapper.MechaTacticsGameChassisRequestToRecord(l, r, rec)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mm.UpdateMechaTacticsGameChassisRec(rec)
+	if err != nil {
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameChassisRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res)
+}
+
+func deleteOneMechaTacticsGameChassisHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "deleteOneMechaTacticsGameChassisHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("chassis_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec, err := mm.GetMechaTacticsGameChassisRec(recID, nil)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("chassis", recID)
+	}
+
+	if err := mm.DeleteMechaTacticsGameChassisRec(recID); err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusNoContent, nil)
+}
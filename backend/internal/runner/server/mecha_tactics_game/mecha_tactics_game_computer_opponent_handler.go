@@ -0,0 +1,270 @@
+package mecha_tactics_game
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+)
+
+const (
+	GetManyMechaTacticsGameComputerOpponents  = "get-many-mecha-tactics-computer-opponents"
+	GetOneMechaTacticsGameComputerOpponent    = "get-one-mecha-tactics-computer-opponent"
+	CreateOneMechaTacticsGameComputerOpponent = "create-one-mecha-tactics-computer-opponent"
+	UpdateOneMechaTacticsGameComputerOpponent = "update-one-mecha-tactics-computer-opponent"
+	DeleteOneMechaTacticsGameComputerOpponent = "delete-one-mecha-tactics-computer-opponent"
+)
+
+func mechaTacticsGameComputerOpponentHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "mechaTacticsGameComputerOpponentHandlerConfig")
+
+	l.Debug("Adding mecha tactics computer opponent handler configuration")
+
+	computerOpponentConfig := make(map[string]server.HandlerConfig)
+
+	collectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_computer_opponent.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_computer_opponent.schema.json",
+		}),
+	}
+
+	requestSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_computer_opponent.request.schema.json",
+		},
+		References: referenceSchemas,
+	}
+
+	responseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_computer_opponent.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_computer_opponent.schema.json",
+		}),
+	}
+
+	computerOpponentConfig[GetManyMechaTacticsGameComputerOpponents] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/computer-opponents",
+		HandlerFunc: getManyMechaTacticsGameComputerOpponentsHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			ValidateResponseSchema: collectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Collection: true, Title: "Get mecha tactics computer opponents"},
+	}
+
+	computerOpponentConfig[GetOneMechaTacticsGameComputerOpponent] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/computer-opponents/:computer_opponent_id",
+		HandlerFunc: getOneMechaTacticsGameComputerOpponentHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Get mecha tactics computer opponent"},
+	}
+
+	computerOpponentConfig[CreateOneMechaTacticsGameComputerOpponent] = server.HandlerConfig{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/computer-opponents",
+		HandlerFunc: createOneMechaTacticsGameComputerOpponentHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions:       []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Create mecha tactics computer opponent"},
+	}
+
+	computerOpponentConfig[UpdateOneMechaTacticsGameComputerOpponent] = server.HandlerConfig{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/computer-opponents/:computer_opponent_id",
+		HandlerFunc: updateOneMechaTacticsGameComputerOpponentHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions:       []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Update mecha tactics computer opponent"},
+	}
+
+	computerOpponentConfig[DeleteOneMechaTacticsGameComputerOpponent] = server.HandlerConfig{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/computer-opponents/:computer_opponent_id",
+		HandlerFunc: deleteOneMechaTacticsGameComputerOpponentHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:      []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions: []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Delete mecha tactics computer opponent"},
+	}
+
+	return computerOpponentConfig, nil
+}
+
+func getManyMechaTacticsGameComputerOpponentsHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyMechaTacticsGameComputerOpponentsHandler")
+
+	gameID := pp.ByName("game_id")
+	if gameID == "" {
+		return coreerror.NewParamError("game_id is required")
+	}
+
+	opts := queryparam.ToSQLOptionsWithDefaults(qp)
+	opts.Params = append(opts.Params, sql.Param{Col: mecha_tactics_game_record.FieldMechaTacticsGameComputerOpponentGameID, Val: gameID})
+
+	mm := m.(*domain.Domain)
+	recs, err := mm.GetManyMechaTacticsGameComputerOpponentRecs(opts)
+	if err != nil {
+		l.Warn("failed getting mecha tactics computer opponent records >%v<", err)
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameComputerOpponentRecordsToCollectionResponse(l, recs)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res, server.XPaginationHeader(len(recs), qp.PageSize))
+}
+
+func getOneMechaTacticsGameComputerOpponentHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getOneMechaTacticsGameComputerOpponentHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("computer_opponent_id")
+	mm := m.(*domain.Domain)
+
+	rec, err := mm.GetMechaTacticsGameComputerOpponentRec(recID, nil)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("computer opponent", recID)
+	}
+
+	res, err := mapper.MechaTacticsGameComputerOpponentRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res)
+}
+
+func createOneMechaTacticsGameComputerOpponentHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "createOneMechaTacticsGameComputerOpponentHandler")
+
+	gameID := pp.ByName("game_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec := &mecha_tactics_game_record.MechaTacticsGameComputerOpponent{GameID: gameID}
+	rec, err := mapper.MechaTacticsGameComputerOpponentRequestToRecord(l, r, rec)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mm.CreateMechaTacticsGameComputerOpponentRec(rec)
+	if err != nil {
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameComputerOpponentRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusCreated, res)
+}
+
+func updateOneMechaTacticsGameComputerOpponentHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "updateOneMechaTacticsGameComputerOpponentHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("computer_opponent_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec, err := mm.GetMechaTacticsGameComputerOpponentRec(recID, sql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("computer opponent", recID)
+	}
+
+	rec, err = mapper.MechaTacticsGameComputerOpponentRequestToRecord(l, r, rec)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mm.UpdateMechaTacticsGameComputerOpponentRec(rec)
+	if err != nil {
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameComputerOpponentRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res)
+}
+
+func deleteOneMechaTacticsGameComputerOpponentHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "deleteOneMechaTacticsGameComputerOpponentHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("computer_opponent_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec, err := mm.GetMechaTacticsGameComputerOpponentRec(recID, nil)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("computer opponent", recID)
+	}
+
+	if err := mm.DeleteMechaTacticsGameComputerOpponentRec(recID); err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusNoContent, nil)
+}
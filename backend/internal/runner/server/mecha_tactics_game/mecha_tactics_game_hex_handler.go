@@ -0,0 +1,270 @@
+package mecha_tactics_game
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+)
+
+const (
+	GetManyMechaTacticsGameHexes = "get-many-mecha-tactics-hexes"
+	GetOneMechaTacticsGameHex    = "get-one-mecha-tactics-hex"
+	CreateOneMechaTacticsGameHex = "create-one-mecha-tactics-hex"
+	UpdateOneMechaTacticsGameHex = "update-one-mecha-tactics-hex"
+	DeleteOneMechaTacticsGameHex = "delete-one-mecha-tactics-hex"
+)
+
+func mechaTacticsGameHexHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "mechaTacticsGameHexHandlerConfig")
+
+	l.Debug("Adding mecha tactics hex handler configuration")
+
+	hexConfig := make(map[string]server.HandlerConfig)
+
+	collectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_hex.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_hex.schema.json",
+		}),
+	}
+
+	requestSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_hex.request.schema.json",
+		},
+		References: referenceSchemas,
+	}
+
+	responseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_hex.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_hex.schema.json",
+		}),
+	}
+
+	hexConfig[GetManyMechaTacticsGameHexes] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/hexes",
+		HandlerFunc: getManyMechaTacticsGameHexesHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			ValidateResponseSchema: collectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Collection: true, Title: "Get mecha tactics hexes"},
+	}
+
+	hexConfig[GetOneMechaTacticsGameHex] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/hexes/:hex_id",
+		HandlerFunc: getOneMechaTacticsGameHexHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Get mecha tactics hex"},
+	}
+
+	hexConfig[CreateOneMechaTacticsGameHex] = server.HandlerConfig{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/hexes",
+		HandlerFunc: createOneMechaTacticsGameHexHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions:       []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Create mecha tactics hex"},
+	}
+
+	hexConfig[UpdateOneMechaTacticsGameHex] = server.HandlerConfig{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/hexes/:hex_id",
+		HandlerFunc: updateOneMechaTacticsGameHexHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions:       []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Update mecha tactics hex"},
+	}
+
+	hexConfig[DeleteOneMechaTacticsGameHex] = server.HandlerConfig{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/hexes/:hex_id",
+		HandlerFunc: deleteOneMechaTacticsGameHexHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:      []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions: []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Delete mecha tactics hex"},
+	}
+
+	return hexConfig, nil
+}
+
+func getManyMechaTacticsGameHexesHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyMechaTacticsGameHexesHandler")
+
+	gameID := pp.ByName("game_id")
+	if gameID == "" {
+		return coreerror.NewParamError("game_id is required")
+	}
+
+	opts := queryparam.ToSQLOptionsWithDefaults(qp)
+	opts.Params = append(opts.Params, sql.Param{Col: mecha_tactics_game_record.FieldMechaTacticsGameHexGameID, Val: gameID})
+
+	mm := m.(*domain.Domain)
+	recs, err := mm.GetManyMechaTacticsGameHexRecs(opts)
+	if err != nil {
+		l.Warn("failed getting mecha tactics hex records >%v<", err)
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameHexRecordsToCollectionResponse(l, recs)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res, server.XPaginationHeader(len(recs), qp.PageSize))
+}
+
+func getOneMechaTacticsGameHexHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getOneMechaTacticsGameHexHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("hex_id")
+	mm := m.(*domain.Domain)
+
+	rec, err := mm.GetMechaTacticsGameHexRec(recID, nil)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("hex", recID)
+	}
+
+	res, err := mapper.MechaTacticsGameHexRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res)
+}
+
+func createOneMechaTacticsGameHexHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "createOneMechaTacticsGameHexHandler")
+
+	gameID := pp.ByName("game_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec := &mecha_tactics_game_record.MechaTacticsGameHex{GameID: gameID}
+	rec, err := mapper.MechaTacticsGameHexRequestToRecord(l, r, rec)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mm.CreateMechaTacticsGameHexRec(rec)
+	if err != nil {
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameHexRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusCreated, res)
+}
+
+func updateOneMechaTacticsGameHexHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "updateOneMechaTacticsGameHexHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("hex_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec, err := mm.GetMechaTacticsGameHexRec(recID, sql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("hex", recID)
+	}
+
+	rec, err = mapper.MechaTacticsGameHexRequestToRecord(l, r, rec)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mm.UpdateMechaTacticsGameHexRec(rec)
+	if err != nil {
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameHexRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res)
+}
+
+func deleteOneMechaTacticsGameHexHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "deleteOneMechaTacticsGameHexHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("hex_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec, err := mm.GetMechaTacticsGameHexRec(recID, nil)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("hex", recID)
+	}
+
+	if err := mm.DeleteMechaTacticsGameHexRec(recID); err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusNoContent, nil)
+}
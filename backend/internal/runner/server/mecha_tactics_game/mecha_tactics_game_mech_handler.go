@@ -0,0 +1,270 @@
+package mecha_tactics_game
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+)
+
+const (
+	GetManyMechaTacticsGameMechs  = "get-many-mecha-tactics-mechs"
+	GetOneMechaTacticsGameMech    = "get-one-mecha-tactics-mech"
+	CreateOneMechaTacticsGameMech = "create-one-mecha-tactics-mech"
+	UpdateOneMechaTacticsGameMech = "update-one-mecha-tactics-mech"
+	DeleteOneMechaTacticsGameMech = "delete-one-mecha-tactics-mech"
+)
+
+func mechaTacticsGameMechHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "mechaTacticsGameMechHandlerConfig")
+
+	l.Debug("Adding mecha tactics mech handler configuration")
+
+	mechConfig := make(map[string]server.HandlerConfig)
+
+	collectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_mech.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_mech.schema.json",
+		}),
+	}
+
+	requestSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_mech.request.schema.json",
+		},
+		References: referenceSchemas,
+	}
+
+	responseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_mech.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_mech.schema.json",
+		}),
+	}
+
+	mechConfig[GetManyMechaTacticsGameMechs] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/mechs",
+		HandlerFunc: getManyMechaTacticsGameMechsHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			ValidateResponseSchema: collectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Collection: true, Title: "Get mecha tactics mechs"},
+	}
+
+	mechConfig[GetOneMechaTacticsGameMech] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/mechs/:mech_id",
+		HandlerFunc: getOneMechaTacticsGameMechHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Get mecha tactics mech"},
+	}
+
+	mechConfig[CreateOneMechaTacticsGameMech] = server.HandlerConfig{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/mechs",
+		HandlerFunc: createOneMechaTacticsGameMechHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions:       []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Create mecha tactics mech"},
+	}
+
+	mechConfig[UpdateOneMechaTacticsGameMech] = server.HandlerConfig{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/mechs/:mech_id",
+		HandlerFunc: updateOneMechaTacticsGameMechHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions:       []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Update mecha tactics mech"},
+	}
+
+	mechConfig[DeleteOneMechaTacticsGameMech] = server.HandlerConfig{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/mechs/:mech_id",
+		HandlerFunc: deleteOneMechaTacticsGameMechHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:      []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions: []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Delete mecha tactics mech"},
+	}
+
+	return mechConfig, nil
+}
+
+func getManyMechaTacticsGameMechsHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyMechaTacticsGameMechsHandler")
+
+	gameID := pp.ByName("game_id")
+	if gameID == "" {
+		return coreerror.NewParamError("game_id is required")
+	}
+
+	opts := queryparam.ToSQLOptionsWithDefaults(qp)
+	opts.Params = append(opts.Params, sql.Param{Col: mecha_tactics_game_record.FieldMechaTacticsGameMechGameID, Val: gameID})
+
+	mm := m.(*domain.Domain)
+	recs, err := mm.GetManyMechaTacticsGameMechRecs(opts)
+	if err != nil {
+		l.Warn("failed getting mecha tactics mech records >%v<", err)
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameMechRecordsToCollectionResponse(l, recs)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res, server.XPaginationHeader(len(recs), qp.PageSize))
+}
+
+func getOneMechaTacticsGameMechHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getOneMechaTacticsGameMechHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("mech_id")
+	mm := m.(*domain.Domain)
+
+	rec, err := mm.GetMechaTacticsGameMechRec(recID, nil)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("mech", recID)
+	}
+
+	res, err := mapper.MechaTacticsGameMechRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res)
+}
+
+func createOneMechaTacticsGameMechHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "createOneMechaTacticsGameMechHandler")
+
+	gameID := pp.ByName("game_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec := &mecha_tactics_game_record.MechaTacticsGameMech{GameID: gameID}
+	rec, err := mapper.MechaTacticsGameMechRequestToRecord(l, r, rec)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mm.CreateMechaTacticsGameMechRec(rec)
+	if err != nil {
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameMechRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusCreated, res)
+}
+
+func updateOneMechaTacticsGameMechHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "updateOneMechaTacticsGameMechHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("mech_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec, err := mm.GetMechaTacticsGameMechRec(recID, sql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("mech", recID)
+	}
+
+	rec, err = mapper.MechaTacticsGameMechRequestToRecord(l, r, rec)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mm.UpdateMechaTacticsGameMechRec(rec)
+	if err != nil {
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameMechRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res)
+}
+
+func deleteOneMechaTacticsGameMechHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "deleteOneMechaTacticsGameMechHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("mech_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec, err := mm.GetMechaTacticsGameMechRec(recID, nil)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("mech", recID)
+	}
+
+	if err := mm.DeleteMechaTacticsGameMechRec(recID); err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusNoContent, nil)
+}
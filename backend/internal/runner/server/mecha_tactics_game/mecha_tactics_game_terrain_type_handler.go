@@ -0,0 +1,270 @@
+package mecha_tactics_game
+
+import (
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/sql"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+)
+
+const (
+	GetManyMechaTacticsGameTerrainTypes  = "get-many-mecha-tactics-terrain-types"
+	GetOneMechaTacticsGameTerrainType    = "get-one-mecha-tactics-terrain-type"
+	CreateOneMechaTacticsGameTerrainType = "create-one-mecha-tactics-terrain-type"
+	UpdateOneMechaTacticsGameTerrainType = "update-one-mecha-tactics-terrain-type"
+	DeleteOneMechaTacticsGameTerrainType = "delete-one-mecha-tactics-terrain-type"
+)
+
+func mechaTacticsGameTerrainTypeHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "mechaTacticsGameTerrainTypeHandlerConfig")
+
+	l.Debug("Adding mecha tactics terrain type handler configuration")
+
+	terrainTypeConfig := make(map[string]server.HandlerConfig)
+
+	collectionResponseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_terrain_type.collection.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_terrain_type.schema.json",
+		}),
+	}
+
+	requestSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_terrain_type.request.schema.json",
+		},
+		References: referenceSchemas,
+	}
+
+	responseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_terrain_type.response.schema.json",
+		},
+		References: append(referenceSchemas, jsonschema.Schema{
+			Location: "api/mecha_tactics_game_schema",
+			Name:     "mecha_tactics_game_terrain_type.schema.json",
+		}),
+	}
+
+	terrainTypeConfig[GetManyMechaTacticsGameTerrainTypes] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/terrain-types",
+		HandlerFunc: getManyMechaTacticsGameTerrainTypesHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			ValidateResponseSchema: collectionResponseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Collection: true, Title: "Get mecha tactics terrain types"},
+	}
+
+	terrainTypeConfig[GetOneMechaTacticsGameTerrainType] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/terrain-types/:terrain_type_id",
+		HandlerFunc: getOneMechaTacticsGameTerrainTypeHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Get mecha tactics terrain type"},
+	}
+
+	terrainTypeConfig[CreateOneMechaTacticsGameTerrainType] = server.HandlerConfig{
+		Method:      http.MethodPost,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/terrain-types",
+		HandlerFunc: createOneMechaTacticsGameTerrainTypeHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions:       []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Create mecha tactics terrain type"},
+	}
+
+	terrainTypeConfig[UpdateOneMechaTacticsGameTerrainType] = server.HandlerConfig{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/terrain-types/:terrain_type_id",
+		HandlerFunc: updateOneMechaTacticsGameTerrainTypeHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:            []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions:       []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+			ValidateRequestSchema:  requestSchema,
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Update mecha tactics terrain type"},
+	}
+
+	terrainTypeConfig[DeleteOneMechaTacticsGameTerrainType] = server.HandlerConfig{
+		Method:      http.MethodDelete,
+		Path:        "/api/v1/mecha-tactics-games/:game_id/terrain-types/:terrain_type_id",
+		HandlerFunc: deleteOneMechaTacticsGameTerrainTypeHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes:      []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions: []server.AuthorizedPermission{handler_auth.PermissionGameDesign},
+		},
+		DocumentationConfig: server.DocumentationConfig{Document: true, Title: "Delete mecha tactics terrain type"},
+	}
+
+	return terrainTypeConfig, nil
+}
+
+func getManyMechaTacticsGameTerrainTypesHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getManyMechaTacticsGameTerrainTypesHandler")
+
+	gameID := pp.ByName("game_id")
+	if gameID == "" {
+		return coreerror.NewParamError("game_id is required")
+	}
+
+	opts := queryparam.ToSQLOptionsWithDefaults(qp)
+	opts.Params = append(opts.Params, sql.Param{Col: mecha_tactics_game_record.FieldMechaTacticsGameTerrainTypeGameID, Val: gameID})
+
+	mm := m.(*domain.Domain)
+	recs, err := mm.GetManyMechaTacticsGameTerrainTypeRecs(opts)
+	if err != nil {
+		l.Warn("failed getting mecha tactics terrain type records >%v<", err)
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameTerrainTypeRecordsToCollectionResponse(l, recs)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res, server.XPaginationHeader(len(recs), qp.PageSize))
+}
+
+func getOneMechaTacticsGameTerrainTypeHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getOneMechaTacticsGameTerrainTypeHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("terrain_type_id")
+	mm := m.(*domain.Domain)
+
+	rec, err := mm.GetMechaTacticsGameTerrainTypeRec(recID, nil)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("terrain type", recID)
+	}
+
+	res, err := mapper.MechaTacticsGameTerrainTypeRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res)
+}
+
+func createOneMechaTacticsGameTerrainTypeHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "createOneMechaTacticsGameTerrainTypeHandler")
+
+	gameID := pp.ByName("game_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec := &mecha_tactics_game_record.MechaTacticsGameTerrainType{GameID: gameID}
+	rec, err := mapper.MechaTacticsGameTerrainTypeRequestToRecord(l, r, rec)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mm.CreateMechaTacticsGameTerrainTypeRec(rec)
+	if err != nil {
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameTerrainTypeRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusCreated, res)
+}
+
+func updateOneMechaTacticsGameTerrainTypeHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "updateOneMechaTacticsGameTerrainTypeHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("terrain_type_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec, err := mm.GetMechaTacticsGameTerrainTypeRec(recID, sql.ForUpdateNoWait)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("terrain type", recID)
+	}
+
+	rec, err = mapper.MechaTacticsGameTerrainTypeRequestToRecord(l, r, rec)
+	if err != nil {
+		return err
+	}
+
+	rec, err = mm.UpdateMechaTacticsGameTerrainTypeRec(rec)
+	if err != nil {
+		return err
+	}
+
+	res, err := mapper.MechaTacticsGameTerrainTypeRecordToResponse(l, rec)
+	if err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res)
+}
+
+func deleteOneMechaTacticsGameTerrainTypeHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "deleteOneMechaTacticsGameTerrainTypeHandler")
+
+	gameID := pp.ByName("game_id")
+	recID := pp.ByName("terrain_type_id")
+	mm := m.(*domain.Domain)
+
+	if _, err := authorizeDesignerModify(l, r, mm, gameID); err != nil {
+		return err
+	}
+
+	rec, err := mm.GetMechaTacticsGameTerrainTypeRec(recID, nil)
+	if err != nil {
+		return err
+	}
+	if rec.GameID != gameID {
+		return coreerror.NewNotFoundError("terrain type", recID)
+	}
+
+	if err := mm.DeleteMechaTacticsGameTerrainTypeRec(recID); err != nil {
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusNoContent, nil)
+}
@@ -36,6 +36,7 @@ func PlayerHandlerConfig(cfg config.Config, l logger.Logger, scnr turnsheet.Turn
 	// Additional handler configurations are added here
 	handlerConfigFuncs := []func(logger.Logger) (map[string]server.HandlerConfig, error){
 		playerTurnSheetHandlerConfig,
+		playerStandingOrdersHandlerConfig,
 	}
 
 	for _, fn := range handlerConfigFuncs {
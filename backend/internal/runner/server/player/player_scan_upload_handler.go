@@ -10,6 +10,7 @@ import (
 	"github.com/riverqueue/river"
 
 	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
 	"gitlab.com/alienspaces/playbymail/core/queryparam"
 	"gitlab.com/alienspaces/playbymail/core/server"
 	coresql "gitlab.com/alienspaces/playbymail/core/sql"
@@ -43,6 +44,15 @@ func playerScanHandlerConfig(l logger.Logger, scnr turnsheet.TurnSheetScanner) (
 			AuthzPermissions: []server.AuthorizedPermission{
 				"game_playing",
 			},
+			ValidateResponseSchema: jsonschema.SchemaWithReferences{
+				Main: jsonschema.Schema{
+					Location: "api/player_schema",
+					Name:     "player.update-game-subscription-turn-sheet.response.schema.json",
+				},
+				References: append(referenceSchemas, []jsonschema.Schema{
+					{Location: "api/player_schema", Name: "game_turn_sheet.schema.json"},
+				}...),
+			},
 		},
 		DocumentationConfig: server.DocumentationConfig{
 			Document: true,
@@ -50,6 +60,7 @@ func playerScanHandlerConfig(l logger.Logger, scnr turnsheet.TurnSheetScanner) (
 			Description: "Upload a scanned image of a completed turn sheet. " +
 				"The backend runs OCR to extract the form data and saves it to the turn sheet. " +
 				"Accepts multipart form data with an 'image' field. Auth: session token.",
+			RequestContentType: server.HeaderContentTypeMultipart,
 		},
 	}
 
@@ -129,7 +140,10 @@ func uploadGameSubscriptionInstanceTurnSheetScanHandler(scnr turnsheet.TurnSheet
 		// When a scanner is available, run OCR to extract form data from the image.
 		// When scnr is nil (test environments without OCR configured), skip OCR and
 		// store the image bytes as-is in scanned_data for later processing.
-		var scannedDataBytes []byte
+		var (
+			scannedDataBytes []byte
+			review           *turnsheet.ScanReview
+		)
 		if scnr != nil {
 			ctx := r.Context()
 			scannedDataBytes, err = scnr.GetTurnSheetScanData(ctx, l, turnSheetRec.SheetType, turnSheetRec.SheetData, imageData)
@@ -137,6 +151,19 @@ func uploadGameSubscriptionInstanceTurnSheetScanHandler(scnr turnsheet.TurnSheet
 				l.Warn("failed to scan turn sheet >%s< >%v<", gameTurnSheetID, err)
 				return coreerror.NewInvalidDataError("failed to process scanned image: %v", err)
 			}
+
+			// A turn sheet code that cannot be read is reported as an issue with the scan
+			turnSheetCode, err := scnr.GetTurnSheetCodeFromImage(ctx, l, imageData)
+			if err != nil {
+				l.Warn("failed to read turn sheet code from scan of turn sheet >%s< >%v<", gameTurnSheetID, err)
+				turnSheetCode = ""
+			}
+
+			review, err = turnsheet.ReviewScanData(l, mm.Config(), turnSheetRec.SheetType, turnSheetRec.SheetData, scannedDataBytes, turnSheetCode)
+			if err != nil {
+				l.Warn("failed to review scanned data for turn sheet >%s< >%v<", gameTurnSheetID, err)
+				return err
+			}
 		} else {
 			l.Info("no scanner configured; storing raw image reference for turn sheet >%s<", gameTurnSheetID)
 			scannedDataBytes, err = json.Marshal(map[string]string{"status": "pending_ocr"})
@@ -146,6 +173,9 @@ func uploadGameSubscriptionInstanceTurnSheetScanHandler(scnr turnsheet.TurnSheet
 		}
 
 		turnSheetRec.ScannedData = scannedDataBytes
+		if review != nil {
+			turnSheetRec.ProcessingStatus = review.ProcessingStatus()
+		}
 
 		updatedRec, err := mm.UpdateGameTurnSheetRec(turnSheetRec)
 		if err != nil {
@@ -153,6 +183,18 @@ func uploadGameSubscriptionInstanceTurnSheetScanHandler(scnr turnsheet.TurnSheet
 			return err
 		}
 
+		// Retain the scanned image so a manager can compare it with the scanned data
+		if review != nil {
+			issues, err := review.IssuesJSON()
+			if err != nil {
+				return coreerror.NewInternalError("failed to marshal scan issues")
+			}
+			if _, err := mm.UpsertGameTurnSheetScanRec(turnSheetRec.ID, imageData, review.Confidence, issues); err != nil {
+				l.Warn("failed to record scan of turn sheet >%s< >%v<", gameTurnSheetID, err)
+				return err
+			}
+		}
+
 		l.Info("saved scanned data for turn sheet >%s< via game subscription instance >%s<", gameTurnSheetID, gameSubscriptionInstanceRec.ID)
 
 		return server.WriteResponse(l, w, http.StatusOK, updatedRec)
@@ -0,0 +1,174 @@
+package player
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/julienschmidt/httprouter"
+	"github.com/riverqueue/river"
+
+	coreerror "gitlab.com/alienspaces/playbymail/core/error"
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/queryparam"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/core/type/domainer"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/domain"
+	"gitlab.com/alienspaces/playbymail/internal/mapper"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
+	"gitlab.com/alienspaces/playbymail/internal/utils/logging"
+	"gitlab.com/alienspaces/playbymail/schema/api/player_schema"
+)
+
+const (
+	GetGameSubscriptionInstanceStandingOrders    = "get-game-subscription-instance-standing-orders"
+	UpdateGameSubscriptionInstanceStandingOrders = "update-game-subscription-instance-standing-orders"
+)
+
+func playerStandingOrdersHandlerConfig(l logger.Logger) (map[string]server.HandlerConfig, error) {
+	l = logging.LoggerWithFunctionContext(l, packageName, "playerStandingOrdersHandlerConfig")
+
+	l.Debug("Adding player standing orders handler configuration")
+
+	playerStandingOrdersConfig := make(map[string]server.HandlerConfig)
+
+	responseSchema := jsonschema.SchemaWithReferences{
+		Main: jsonschema.Schema{
+			Location: "api/player_schema",
+			Name:     "player.get-game-subscription-instance-standing-orders.response.schema.json",
+		},
+		References: referenceSchemas,
+	}
+
+	// GET /api/v1/player/game-subscription-instances/:game_subscription_instance_id/standing-orders
+	playerStandingOrdersConfig[GetGameSubscriptionInstanceStandingOrders] = server.HandlerConfig{
+		Method:      http.MethodGet,
+		Path:        "/api/v1/player/game-subscription-instances/:game_subscription_instance_id/standing-orders",
+		HandlerFunc: getGameSubscriptionInstanceStandingOrdersHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGamePlaying,
+			},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Get standing orders",
+			Description: "Returns the player's standing orders for a game_subscription_instance and the standing orders " +
+				"available for each turn sheet type. Standing orders are carried out for turn sheets the player does not " +
+				"submit when the game instance missed turn policy is standing orders. Auth: session token.",
+		},
+	}
+
+	// PUT /api/v1/player/game-subscription-instances/:game_subscription_instance_id/standing-orders
+	playerStandingOrdersConfig[UpdateGameSubscriptionInstanceStandingOrders] = server.HandlerConfig{
+		Method:      http.MethodPut,
+		Path:        "/api/v1/player/game-subscription-instances/:game_subscription_instance_id/standing-orders",
+		HandlerFunc: updateGameSubscriptionInstanceStandingOrdersHandler,
+		MiddlewareConfig: server.MiddlewareConfig{
+			AuthenTypes: []server.AuthenticationType{server.AuthenticationTypeToken},
+			AuthzPermissions: []server.AuthorizedPermission{
+				handler_auth.PermissionGamePlaying,
+			},
+			ValidateRequestSchema: jsonschema.SchemaWithReferences{
+				Main: jsonschema.Schema{
+					Location: "api/player_schema",
+					Name:     "player.update-game-subscription-instance-standing-orders.request.schema.json",
+				},
+				References: referenceSchemas,
+			},
+			ValidateResponseSchema: responseSchema,
+		},
+		DocumentationConfig: server.DocumentationConfig{
+			Document: true,
+			Title:    "Update standing orders",
+			Description: "Replace the player's standing orders for a game_subscription_instance. Each turn sheet type " +
+				"may have one standing order from those available for the game type. Auth: session token.",
+		},
+	}
+
+	return playerStandingOrdersConfig, nil
+}
+
+// getGameSubscriptionInstanceStandingOrdersHandler returns the standing orders for a game_subscription_instance.
+func getGameSubscriptionInstanceStandingOrdersHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "getGameSubscriptionInstanceStandingOrdersHandler")
+
+	mm := m.(*domain.Domain)
+
+	gameSubscriptionInstanceRec, err := resolveGameSubscriptionInstance(l, r, pp, mm)
+	if err != nil {
+		return err
+	}
+
+	return writeGameSubscriptionInstanceStandingOrdersResponse(l, w, mm, gameSubscriptionInstanceRec)
+}
+
+// updateGameSubscriptionInstanceStandingOrdersHandler replaces the standing orders for a game_subscription_instance.
+func updateGameSubscriptionInstanceStandingOrdersHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
+	l = logging.LoggerWithFunctionContext(l, packageName, "updateGameSubscriptionInstanceStandingOrdersHandler")
+
+	mm := m.(*domain.Domain)
+
+	gameSubscriptionInstanceRec, err := resolveGameSubscriptionInstance(l, r, pp, mm)
+	if err != nil {
+		return err
+	}
+
+	if gameSubscriptionInstanceRec.IsDropped() {
+		return coreerror.NewInvalidDataError("player has been dropped from this game and standing orders can no longer be changed")
+	}
+
+	var req player_schema.UpdateGameSubscriptionInstanceStandingOrdersRequest
+	if _, err := server.ReadRequest(l, r, &req); err != nil {
+		l.Warn("failed reading request >%v<", err)
+		return err
+	}
+
+	if req.StandingOrders == nil {
+		req.StandingOrders = map[string]string{}
+	}
+
+	standingOrders, err := json.Marshal(req.StandingOrders)
+	if err != nil {
+		l.Warn("failed to marshal standing orders >%v<", err)
+		return coreerror.NewInvalidDataError("invalid standing_orders format")
+	}
+
+	gameSubscriptionInstanceRec.StandingOrders = standingOrders
+
+	gameSubscriptionInstanceRec, err = mm.UpdateGameSubscriptionInstanceRec(gameSubscriptionInstanceRec)
+	if err != nil {
+		l.Warn("failed to update standing orders for game subscription instance >%s< >%v<", gameSubscriptionInstanceRec.ID, err)
+		return err
+	}
+
+	l.Info("updated standing orders for game subscription instance >%s<", gameSubscriptionInstanceRec.ID)
+
+	return writeGameSubscriptionInstanceStandingOrdersResponse(l, w, mm, gameSubscriptionInstanceRec)
+}
+
+func writeGameSubscriptionInstanceStandingOrdersResponse(l logger.Logger, w http.ResponseWriter, mm *domain.Domain, gameSubscriptionInstanceRec *game_record.GameSubscriptionInstance) error {
+	gameInstanceRec, err := mm.GetGameInstanceRec(gameSubscriptionInstanceRec.GameInstanceID, nil)
+	if err != nil {
+		l.Warn("failed to get game instance >%s< >%v<", gameSubscriptionInstanceRec.GameInstanceID, err)
+		return err
+	}
+
+	gameRec, err := mm.GetGameRec(gameInstanceRec.GameID, nil)
+	if err != nil {
+		l.Warn("failed to get game >%s< >%v<", gameInstanceRec.GameID, err)
+		return err
+	}
+
+	res, err := mapper.MapGameSubscriptionInstanceStandingOrdersResponse(gameSubscriptionInstanceRec, gameInstanceRec, domain.GameTypeStandingOrders(gameRec.GameType))
+	if err != nil {
+		l.Warn("failed to map standing orders response >%v<", err)
+		return err
+	}
+
+	return server.WriteResponse(l, w, http.StatusOK, res)
+}
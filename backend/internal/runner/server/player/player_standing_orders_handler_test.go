@@ -0,0 +1,156 @@
+package player_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/server"
+	"gitlab.com/alienspaces/playbymail/internal/harness"
+	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/player"
+	"gitlab.com/alienspaces/playbymail/internal/utils/testutil"
+)
+
+func Test_getGameSubscriptionInstanceStandingOrdersHandler(t *testing.T) {
+	t.Parallel()
+
+	th := testutil.NewTestHarness(t)
+	require.NotNil(t, th)
+
+	_, err := th.Setup()
+	require.NoError(t, err)
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err)
+	}()
+
+	testCases := []struct {
+		testutil.TestCase
+	}{
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated standard player gets standing orders for their gsi",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[player.GetGameSubscriptionInstanceStandingOrders]
+				},
+				RequestHeaders: testutil.AuthHeaderStandard,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_subscription_instance_id": gameSubscriptionInstanceIDForStandardPlayer(t, d),
+					}
+				},
+				ResponseCode: http.StatusOK,
+			},
+		},
+		{
+			TestCase: testutil.TestCase{
+				Name: "unauthenticated request returns unauthorized",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[player.GetGameSubscriptionInstanceStandingOrders]
+				},
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_subscription_instance_id": gameSubscriptionInstanceIDForStandardPlayer(t, d),
+					}
+				},
+				ResponseCode: http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running test >%s<\n", testCase.Name)
+		t.Run(testCase.Name, func(t *testing.T) {
+			testutil.RunTestCase(t, th, &testCase.TestCase, nil)
+		})
+	}
+}
+
+func Test_updateGameSubscriptionInstanceStandingOrdersHandler(t *testing.T) {
+	t.Parallel()
+
+	th := testutil.NewTestHarness(t)
+	require.NotNil(t, th)
+
+	_, err := th.Setup()
+	require.NoError(t, err)
+	defer func() {
+		err = th.Teardown()
+		require.NoError(t, err)
+	}()
+
+	testCases := []struct {
+		testutil.TestCase
+	}{
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated player sets an available standing order then returns 200",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[player.UpdateGameSubscriptionInstanceStandingOrders]
+				},
+				RequestHeaders: testutil.AuthHeaderStandard,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_subscription_instance_id": gameSubscriptionInstanceIDForStandardPlayer(t, d),
+					}
+				},
+				RequestBody: func(d harness.Data) any {
+					return map[string]any{
+						"standing_orders": map[string]string{
+							adventure_game_record.AdventureGameTurnSheetTypeLocationChoice: adventure_game_record.AdventureGameStandingOrderFleeAggressiveCreatures,
+						},
+					}
+				},
+				ResponseCode: http.StatusOK,
+			},
+		},
+		{
+			TestCase: testutil.TestCase{
+				Name: "authenticated player sets a standing order not available for the turn sheet type then returns 400",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[player.UpdateGameSubscriptionInstanceStandingOrders]
+				},
+				RequestHeaders: testutil.AuthHeaderStandard,
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_subscription_instance_id": gameSubscriptionInstanceIDForStandardPlayer(t, d),
+					}
+				},
+				RequestBody: func(d harness.Data) any {
+					return map[string]any{
+						"standing_orders": map[string]string{
+							adventure_game_record.AdventureGameTurnSheetTypeInventoryManagement: adventure_game_record.AdventureGameStandingOrderFleeAggressiveCreatures,
+						},
+					}
+				},
+				ResponseCode: http.StatusBadRequest,
+			},
+		},
+		{
+			TestCase: testutil.TestCase{
+				Name: "unauthenticated request returns unauthorized",
+				HandlerConfig: func(rnr testutil.TestRunnerer) server.HandlerConfig {
+					return rnr.GetHandlerConfig()[player.UpdateGameSubscriptionInstanceStandingOrders]
+				},
+				RequestPathParams: func(d harness.Data) map[string]string {
+					return map[string]string{
+						":game_subscription_instance_id": gameSubscriptionInstanceIDForStandardPlayer(t, d),
+					}
+				},
+				RequestBody: func(d harness.Data) any {
+					return map[string]any{"standing_orders": map[string]string{}}
+				},
+				ResponseCode: http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running test >%s<\n", testCase.Name)
+		t.Run(testCase.Name, func(t *testing.T) {
+			testutil.RunTestCase(t, th, &testCase.TestCase, nil)
+		})
+	}
+}
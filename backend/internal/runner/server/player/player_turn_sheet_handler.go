@@ -115,6 +115,7 @@ func playerTurnSheetHandlerConfig(l logger.Logger) (map[string]server.HandlerCon
 			Title:    "Request new turn sheet token",
 			Description: "Request a new turn sheet token if the current one has expired. " +
 				"Validates email matches the account and generates a new token.",
+			NoResponseBody: true,
 		},
 	}
 
@@ -245,9 +246,10 @@ func playerTurnSheetHandlerConfig(l logger.Logger) (map[string]server.HandlerCon
 			},
 		},
 		DocumentationConfig: server.DocumentationConfig{
-			Document:    true,
-			Title:       "Download turn sheet PDF",
-			Description: "Download a printable PDF of a specific turn sheet via game_subscription_instance_id. Auth: session token.",
+			Document:            true,
+			Title:               "Download turn sheet PDF",
+			Description:         "Download a printable PDF of a specific turn sheet via game_subscription_instance_id. Auth: session token.",
+			ResponseContentType: server.HeaderContentTypePDF,
 		},
 	}
 
@@ -479,6 +481,11 @@ func saveGameSubscriptionInstanceTurnSheetHandler(w http.ResponseWriter, r *http
 
 	turnSheetRec.ScannedData = scannedDataBytes
 
+	// Scanned data the player has checked and saved no longer needs a manager to review it
+	if turnSheetRec.ProcessingStatus == game_record.TurnSheetProcessingStatusReview {
+		turnSheetRec.ProcessingStatus = game_record.TurnSheetProcessingStatusProcessed
+	}
+
 	updatedRec, err := mm.UpdateGameTurnSheetRec(turnSheetRec)
 	if err != nil {
 		l.Warn("failed to update turn sheet >%v<", err)
@@ -556,7 +563,7 @@ func submitGameSubscriptionInstanceTurnSheetsHandler(w http.ResponseWriter, r *h
 
 	// Check if we should trigger early turn processing
 	if gameInstanceRec.ProcessWhenAllSubmitted && gameInstanceRec.Status == game_record.GameInstanceStatusStarted {
-		allSubmitted, checkErr := checkAllTurnSheetsSubmitted(l, mm, gameInstanceRec)
+		allSubmitted, checkErr := mm.IsGameInstanceTurnSubmitted(gameInstanceRec)
 		if checkErr != nil {
 			l.Warn("failed to check all turn sheets submitted >%v<", checkErr)
 		} else if allSubmitted {
@@ -577,37 +584,6 @@ func submitGameSubscriptionInstanceTurnSheetsHandler(w http.ResponseWriter, r *h
 	})
 }
 
-// checkAllTurnSheetsSubmitted checks whether all active players in a game instance
-// have completed all their turn sheets for the current turn.
-func checkAllTurnSheetsSubmitted(l logger.Logger, mm *domain.Domain, gi *game_record.GameInstance) (bool, error) {
-	l = logging.LoggerWithFunctionContext(l, packageName, "checkAllTurnSheetsSubmitted")
-
-	// Get all turn sheets for this game instance and current turn
-	allSheets, err := mm.GameTurnSheetRepository().GetMany(&coresql.Options{
-		Params: []coresql.Param{
-			{Col: game_record.FieldGameTurnSheetGameInstanceID, Val: gi.ID},
-			{Col: game_record.FieldGameTurnSheetTurnNumber, Val: gi.CurrentTurn},
-		},
-	})
-	if err != nil {
-		return false, fmt.Errorf("failed to get turn sheets for instance >%s< turn >%d<: %w", gi.ID, gi.CurrentTurn, err)
-	}
-
-	if len(allSheets) == 0 {
-		return false, nil
-	}
-
-	for _, ts := range allSheets {
-		if !ts.IsCompleted {
-			l.Debug("turn sheet >%s< not yet completed for instance >%s< turn >%d<", ts.ID, gi.ID, gi.CurrentTurn)
-			return false, nil
-		}
-	}
-
-	l.Info("all >%d< turn sheets completed for instance >%s< turn >%d<", len(allSheets), gi.ID, gi.CurrentTurn)
-	return true, nil
-}
-
 // downloadGameSubscriptionInstanceTurnSheetPDFHandler returns a printable PDF for a specific turn sheet so the
 // player can fill it in offline and mail it back.
 func downloadGameSubscriptionInstanceTurnSheetPDFHandler(w http.ResponseWriter, r *http.Request, pp httprouter.Params, qp *queryparam.QueryParams, l logger.Logger, m domainer.Domainer, jc *river.Client[pgx.Tx]) error {
@@ -721,7 +697,7 @@ func verifyGameSubscriptionTokenHandler(w http.ResponseWriter, r *http.Request,
 	}
 
 	// Generate session token for the account
-	sessionToken, err := mm.GenerateAccountUserSessionToken(accountUserRec)
+	sessionToken, err := mm.GenerateAccountUserSessionToken(accountUserRec, r.UserAgent())
 	if err != nil {
 		l.Warn("failed to generate account user session token >%v<", err)
 		return err
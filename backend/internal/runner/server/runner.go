@@ -22,6 +22,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/internal/runner/server/game"
 	"gitlab.com/alienspaces/playbymail/internal/runner/server/handler_auth"
 	"gitlab.com/alienspaces/playbymail/internal/runner/server/mecha_game"
+	"gitlab.com/alienspaces/playbymail/internal/runner/server/mecha_tactics_game"
 	"gitlab.com/alienspaces/playbymail/internal/runner/server/player"
 	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
@@ -70,6 +71,12 @@ func NewRunner(cfg config.Config, l logger.Logger, s storer.Storer, j *river.Cli
 	l.Warn("setting authenticate request function")
 	r.AuthenticateRequestFunc = r.authenticateRequestFunc
 
+	r.OpenAPIInfo = server.OpenAPIInfo{
+		Title:       "Play by Mail API",
+		Description: "API for designing, managing and playing play by mail games.",
+		Version:     "1.0.0",
+	}
+
 	// Additional handler configurations are added here
 	handlerConfigFuncs := []func(config.Config, logger.Logger, turnsheet.TurnSheetScanner) (map[string]server.HandlerConfig, error){
 		// Account related handlers
@@ -78,6 +85,8 @@ func NewRunner(cfg config.Config, l logger.Logger, s storer.Storer, j *river.Cli
 		adventure_game.AdventureGameHandlerConfig,
 		// MechaGame handlers
 		mecha_game.MechaGameHandlerConfig,
+		// MechaTacticsGame handlers
+		mecha_tactics_game.MechaTacticsGameHandlerConfig,
 		// Catalog handlers (public)
 		catalog.CatalogHandlerConfig,
 		// Player handlers
@@ -1,10 +1,17 @@
 package runner_test
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	coreconfig "gitlab.com/alienspaces/playbymail/core/config"
+	"gitlab.com/alienspaces/playbymail/core/log"
+	"gitlab.com/alienspaces/playbymail/core/server"
+	runner "gitlab.com/alienspaces/playbymail/internal/runner/server"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
 	"gitlab.com/alienspaces/playbymail/internal/utils/deps"
 	"gitlab.com/alienspaces/playbymail/internal/utils/testutil"
@@ -24,3 +31,53 @@ func TestNewRunner(t *testing.T) {
 	err = r.Init(s)
 	require.NoError(t, err, "Init returns without error")
 }
+
+func TestOpenAPIDocument(t *testing.T) {
+
+	cwd, err := os.Getwd()
+	require.NoError(t, err, "Getwd returns without error")
+
+	cfg := config.Config{
+		Config: coreconfig.Config{
+			SchemaPath: filepath.Join(cwd, "..", "..", "..", "schema"),
+		},
+	}
+
+	r, err := runner.NewRunner(cfg, log.NewDefaultLogger(), nil, nil, nil)
+	require.NoError(t, err, "NewRunner returns without error")
+
+	// Every documented route must describe its request and response bodies so
+	// clients can be generated from the OpenAPI document
+	for _, err := range server.ValidateDocumentationConfig(r.GetHandlerConfig()) {
+		t.Error(err)
+	}
+
+	doc, err := r.GenerateOpenAPIDocument()
+	require.NoError(t, err, "GenerateOpenAPIDocument returns without error")
+	require.NotEmpty(t, doc.Paths, "OpenAPI document has paths")
+
+	// Every schema reference must resolve to a document component
+	for key, schema := range doc.Components.Schemas {
+		requireOpenAPIRefsResolve(t, doc, key, schema)
+	}
+}
+
+func requireOpenAPIRefsResolve(t *testing.T, doc *server.OpenAPIDocument, key string, v any) {
+	switch c := v.(type) {
+	case map[string]any:
+		for k, val := range c {
+			if ref, ok := val.(string); ok && k == "$ref" {
+				refKey, ok := strings.CutPrefix(ref, "#/components/schemas/")
+				require.True(t, ok, "schema >%s< reference >%s< is a component reference", key, ref)
+				refKey, _, _ = strings.Cut(refKey, "/")
+				require.Contains(t, doc.Components.Schemas, refKey, "schema >%s< reference >%s< component exists", key, ref)
+				continue
+			}
+			requireOpenAPIRefsResolve(t, doc, key, val)
+		}
+	case []any:
+		for _, val := range c {
+			requireOpenAPIRefsResolve(t, doc, key, val)
+		}
+	}
+}
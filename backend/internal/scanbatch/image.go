@@ -0,0 +1,190 @@
+package scanbatch
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	"image/png"
+
+	_ "golang.org/x/image/webp"
+)
+
+// encodeImage returns a PDF image XObject as a JPEG when it is stored as one,
+// otherwise decodes the image samples and encodes them as a PNG
+func (d *pdfDocument) encodeImage(s *pdfStream) ([]byte, error) {
+	if imageMask, _ := d.resolve(s.dict["ImageMask"]).(bool); imageMask {
+		return nil, errors.New("unsupported image mask")
+	}
+
+	filters, _ := d.streamFilters(s)
+	if len(filters) > 0 {
+		last := filters[len(filters)-1]
+		if last == "DCTDecode" || last == "DCT" {
+			data, err := d.decodeStream(s, true)
+			if err != nil {
+				return nil, err
+			}
+			if err := checkImageConfig(data); err != nil {
+				return nil, err
+			}
+			return data, nil
+		}
+	}
+
+	// Check the size before decoding so the stride and buffer sizes below
+	// cannot overflow
+	width, _ := d.resolve(s.dict["Width"]).(int)
+	height, _ := d.resolve(s.dict["Height"]).(int)
+	if err := checkImageSize(width, height); err != nil {
+		return nil, err
+	}
+
+	samples, err := d.decodeStream(s, false)
+	if err != nil {
+		return nil, err
+	}
+
+	bpc := intOrDefault(d.resolve(s.dict["BitsPerComponent"]), 8)
+	if bpc != 1 && bpc != 8 {
+		return nil, fmt.Errorf("unsupported image bits per component %d", bpc)
+	}
+
+	components, err := d.colorComponents(s.dict["ColorSpace"])
+	if err != nil {
+		return nil, err
+	}
+	if components != 1 && components != 3 && components != 4 {
+		return nil, fmt.Errorf("unsupported image color components %d", components)
+	}
+
+	// A decode array of [1 0] inverts the samples
+	invert := false
+	if decode, ok := d.resolve(s.dict["Decode"]).(pdfArray); ok && len(decode) >= 2 {
+		if first, ok := decode[0].(int); ok && first == 1 {
+			invert = true
+		}
+	}
+
+	stride := (width*components*bpc + 7) / 8
+	if len(samples) < stride*height {
+		return nil, errors.New("image data is truncated")
+	}
+
+	var img image.Image
+	switch components {
+	case 1:
+		gray := image.NewGray(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			row := samples[y*stride : (y+1)*stride]
+			for x := 0; x < width; x++ {
+				v := sample(row, x, bpc)
+				if invert {
+					v = 255 - v
+				}
+				gray.SetGray(x, y, color.Gray{Y: v})
+			}
+		}
+		img = gray
+	case 3, 4:
+		rgba := image.NewRGBA(image.Rect(0, 0, width, height))
+		for y := 0; y < height; y++ {
+			row := samples[y*stride : (y+1)*stride]
+			for x := 0; x < width; x++ {
+				c := make([]uint8, components)
+				for i := range c {
+					c[i] = sample(row, x*components+i, bpc)
+					if invert {
+						c[i] = 255 - c[i]
+					}
+				}
+				if components == 4 {
+					r, g, b := color.CMYKToRGB(c[0], c[1], c[2], c[3])
+					c = []uint8{r, g, b}
+				}
+				rgba.SetRGBA(x, y, color.RGBA{R: c[0], G: c[1], B: c[2], A: 255})
+			}
+		}
+		img = rgba
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// checkImageConfig reads the size from an encoded image header and checks it
+// before anything decodes the full image
+func checkImageConfig(data []byte) error {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to read image header: %w", err)
+	}
+	return checkImageSize(config.Width, config.Height)
+}
+
+// checkImageSize returns an error when an image has no size or more than
+// MaxImagePixels pixels
+func checkImageSize(width, height int) error {
+	if width <= 0 || height <= 0 {
+		return errors.New("image has no size")
+	}
+	if width > MaxImagePixels/height {
+		return ErrImageTooLarge
+	}
+	return nil
+}
+
+// colorComponents returns the number of color components for a color space
+func (d *pdfDocument) colorComponents(colorSpace any) (int, error) {
+	switch cs := d.resolve(colorSpace).(type) {
+	case pdfName:
+		switch cs {
+		case "DeviceGray", "CalGray", "G":
+			return 1, nil
+		case "DeviceRGB", "CalRGB", "RGB":
+			return 3, nil
+		case "DeviceCMYK", "CMYK":
+			return 4, nil
+		}
+		return 0, fmt.Errorf("unsupported image color space %s", cs)
+	case pdfArray:
+		if len(cs) == 0 {
+			break
+		}
+		family, _ := d.resolve(cs[0]).(pdfName)
+		switch family {
+		case "ICCBased":
+			if len(cs) > 1 {
+				if n, ok := d.resolve(d.resolveDict(cs[1])["N"]).(int); ok {
+					return n, nil
+				}
+			}
+		case "CalGray":
+			return 1, nil
+		case "CalRGB":
+			return 3, nil
+		}
+		return 0, fmt.Errorf("unsupported image color space %s", family)
+	case nil:
+		return 0, errors.New("image has no color space")
+	}
+	return 0, errors.New("unsupported image color space")
+}
+
+// sample returns the sample at index i in a row scaled to 8 bits
+func sample(row []byte, i, bpc int) uint8 {
+	if bpc == 8 {
+		return row[i]
+	}
+	bit := (row[i/8] >> (7 - uint(i%8))) & 1
+	if bit == 1 {
+		return 255
+	}
+	return 0
+}
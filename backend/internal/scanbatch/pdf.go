@@ -0,0 +1,649 @@
+package scanbatch
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// PDF object types
+type (
+	pdfName    string
+	pdfKeyword string
+	pdfDict    map[pdfName]any
+	pdfArray   []any
+	pdfRef     struct{ num, gen int }
+	pdfStream  struct {
+		dict pdfDict
+		data []byte
+	}
+)
+
+// pdfDocument holds every object in a PDF by object number. Objects are found
+// by scanning the file rather than reading the cross reference table, which
+// tolerates the damaged or truncated tables some scanners produce.
+type pdfDocument struct {
+	data    []byte
+	objects map[int]any
+	budget  *decompressBudget
+}
+
+var pdfObjectPattern = regexp.MustCompile(`(?:^|\s)(\d+)\s+(\d+)\s+obj\b`)
+
+func parsePDF(data []byte, budget *decompressBudget) (*pdfDocument, error) {
+	if bytes.Contains(data, []byte("/Encrypt")) {
+		return nil, errors.New("encrypted PDF documents are not supported")
+	}
+
+	doc := &pdfDocument{
+		data:    data,
+		objects: map[int]any{},
+		budget:  budget,
+	}
+
+	// Later definitions of an object replace earlier ones, matching how
+	// incremental updates are appended to a PDF
+	offsets := map[int]int{}
+	for _, match := range pdfObjectPattern.FindAllSubmatchIndex(data, -1) {
+		num, err := strconv.Atoi(string(data[match[2]:match[3]]))
+		if err != nil {
+			continue
+		}
+		obj, err := doc.parseIndirectObject(match[1])
+		if err != nil {
+			continue
+		}
+		doc.objects[num] = obj
+		offsets[num] = match[1]
+	}
+
+	// Stream lengths given as indirect references can only be resolved once
+	// every object has been found, so those streams are read again
+	var objStreams []*pdfStream
+	for num, offset := range offsets {
+		s, ok := doc.objects[num].(*pdfStream)
+		if !ok {
+			continue
+		}
+		if _, ok := s.dict["Length"].(pdfRef); ok {
+			if obj, err := doc.parseIndirectObject(offset); err == nil {
+				doc.objects[num] = obj
+				s, _ = obj.(*pdfStream)
+			}
+		}
+		if s != nil && s.dict["Type"] == pdfName("ObjStm") {
+			objStreams = append(objStreams, s)
+		}
+	}
+
+	for _, s := range objStreams {
+		if err := doc.loadObjectStream(s); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(doc.objects) == 0 {
+		return nil, errors.New("PDF document does not contain any objects")
+	}
+
+	return doc, nil
+}
+
+// parseIndirectObject parses the object following "N G obj" at pos, reading
+// the stream data when the object is a stream
+func (d *pdfDocument) parseIndirectObject(pos int) (any, error) {
+	p := &pdfParser{buf: d.data, pos: pos}
+
+	obj, err := p.parseObject()
+	if err != nil {
+		return nil, err
+	}
+
+	dict, ok := obj.(pdfDict)
+	if !ok {
+		return obj, nil
+	}
+
+	save := p.pos
+	if kw, err := p.parseObject(); err != nil || kw != pdfKeyword("stream") {
+		p.pos = save
+		return dict, nil
+	}
+
+	// Stream data starts after the end of line following the stream keyword
+	start := p.pos
+	if start < len(d.data) && d.data[start] == '\r' {
+		start++
+	}
+	if start < len(d.data) && d.data[start] == '\n' {
+		start++
+	}
+
+	if length, ok := d.resolve(dict["Length"]).(int); ok && length >= 0 && start+length <= len(d.data) {
+		rest := bytes.TrimLeft(d.data[start+length:], "\r\n \t")
+		if bytes.HasPrefix(rest, []byte("endstream")) {
+			return &pdfStream{dict: dict, data: d.data[start : start+length]}, nil
+		}
+	}
+
+	end := bytes.Index(d.data[start:], []byte("endstream"))
+	if end < 0 {
+		return nil, errors.New("stream is missing endstream")
+	}
+	streamData := bytes.TrimSuffix(d.data[start:start+end], []byte("\n"))
+	streamData = bytes.TrimSuffix(streamData, []byte("\r"))
+
+	return &pdfStream{dict: dict, data: streamData}, nil
+}
+
+// loadObjectStream adds the objects compressed in an object stream that have
+// not been defined directly in the file
+func (d *pdfDocument) loadObjectStream(s *pdfStream) error {
+	data, err := d.decodeStream(s, false)
+	if err != nil {
+		return fmt.Errorf("failed to decode object stream: %w", err)
+	}
+
+	n, _ := d.resolve(s.dict["N"]).(int)
+	first, _ := d.resolve(s.dict["First"]).(int)
+	if first < 0 || first > len(data) {
+		return errors.New("object stream has an invalid first object offset")
+	}
+
+	header := &pdfParser{buf: data[:first]}
+	for i := 0; i < n; i++ {
+		numObj, err := header.parseObject()
+		if err != nil {
+			return err
+		}
+		offsetObj, err := header.parseObject()
+		if err != nil {
+			return err
+		}
+		num, ok1 := numObj.(int)
+		offset, ok2 := offsetObj.(int)
+		if !ok1 || !ok2 || first+offset > len(data) {
+			return errors.New("object stream has an invalid header")
+		}
+		if _, exists := d.objects[num]; exists {
+			continue
+		}
+		p := &pdfParser{buf: data, pos: first + offset}
+		obj, err := p.parseObject()
+		if err != nil {
+			return err
+		}
+		d.objects[num] = obj
+	}
+
+	return nil
+}
+
+// resolve follows indirect references to the referenced object
+func (d *pdfDocument) resolve(obj any) any {
+	for i := 0; i < 32; i++ {
+		ref, ok := obj.(pdfRef)
+		if !ok {
+			return obj
+		}
+		obj = d.objects[ref.num]
+	}
+	return nil
+}
+
+func (d *pdfDocument) resolveDict(obj any) pdfDict {
+	switch v := d.resolve(obj).(type) {
+	case pdfDict:
+		return v
+	case *pdfStream:
+		return v.dict
+	}
+	return nil
+}
+
+// pages returns the page dictionaries in document order, with inherited
+// resources copied on to each page
+func (d *pdfDocument) pages() []pdfDict {
+	var pages []pdfDict
+
+	for _, num := range d.objectNumbers() {
+		catalog, ok := d.objects[num].(pdfDict)
+		if !ok || catalog["Type"] != pdfName("Catalog") {
+			continue
+		}
+		visited := map[int]bool{}
+		d.walkPageTree(catalog["Pages"], nil, visited, &pages)
+		if len(pages) > 0 {
+			return pages
+		}
+	}
+
+	// Without a usable page tree fall back to every page object in object order
+	for _, num := range d.objectNumbers() {
+		page, ok := d.objects[num].(pdfDict)
+		if ok && page["Type"] == pdfName("Page") {
+			pages = append(pages, page)
+		}
+	}
+
+	return pages
+}
+
+func (d *pdfDocument) walkPageTree(node any, resources any, visited map[int]bool, pages *[]pdfDict) {
+	if ref, ok := node.(pdfRef); ok {
+		if visited[ref.num] {
+			return
+		}
+		visited[ref.num] = true
+	}
+
+	dict := d.resolveDict(node)
+	if dict == nil {
+		return
+	}
+
+	if r, ok := dict["Resources"]; ok {
+		resources = r
+	}
+
+	switch dict["Type"] {
+	case pdfName("Pages"):
+		kids, _ := d.resolve(dict["Kids"]).(pdfArray)
+		for _, kid := range kids {
+			d.walkPageTree(kid, resources, visited, pages)
+		}
+	case pdfName("Page"):
+		page := pdfDict{}
+		for k, v := range dict {
+			page[k] = v
+		}
+		page["Resources"] = resources
+		*pages = append(*pages, page)
+	}
+}
+
+func (d *pdfDocument) objectNumbers() []int {
+	nums := make([]int, 0, len(d.objects))
+	for num := range d.objects {
+		nums = append(nums, num)
+	}
+	sort.Ints(nums)
+	return nums
+}
+
+// pageImage returns the largest image drawn on the page as a JPEG or PNG
+func (d *pdfDocument) pageImage(page pdfDict) ([]byte, error) {
+	img := d.largestImage(d.resolveDict(page["Resources"]), 0)
+	if img == nil {
+		return nil, errors.New("page does not contain a scanned image")
+	}
+	return d.encodeImage(img)
+}
+
+func (d *pdfDocument) largestImage(resources pdfDict, depth int) *pdfStream {
+	if resources == nil || depth > 3 {
+		return nil
+	}
+
+	xobjects := d.resolveDict(resources["XObject"])
+
+	var largest *pdfStream
+	largestArea := -1
+	for _, obj := range xobjects {
+		s, ok := d.resolve(obj).(*pdfStream)
+		if !ok {
+			continue
+		}
+		candidate := s
+		switch s.dict["Subtype"] {
+		case pdfName("Image"):
+		case pdfName("Form"):
+			// Some scanners wrap the page image in a form
+			candidate = d.largestImage(d.resolveDict(s.dict["Resources"]), depth+1)
+		default:
+			continue
+		}
+		if candidate == nil {
+			continue
+		}
+		width, _ := d.resolve(candidate.dict["Width"]).(int)
+		height, _ := d.resolve(candidate.dict["Height"]).(int)
+		if width*height > largestArea {
+			largest = candidate
+			largestArea = width * height
+		}
+	}
+
+	return largest
+}
+
+// streamFilters returns the stream filters and their decode parameters
+func (d *pdfDocument) streamFilters(s *pdfStream) ([]pdfName, []pdfDict) {
+	var filters []pdfName
+	var params []pdfDict
+
+	switch f := d.resolve(s.dict["Filter"]).(type) {
+	case pdfName:
+		filters = append(filters, f)
+		params = append(params, d.resolveDict(s.dict["DecodeParms"]))
+	case pdfArray:
+		paramArray, _ := d.resolve(s.dict["DecodeParms"]).(pdfArray)
+		for i, v := range f {
+			name, _ := d.resolve(v).(pdfName)
+			filters = append(filters, name)
+			var p pdfDict
+			if i < len(paramArray) {
+				p = d.resolveDict(paramArray[i])
+			}
+			params = append(params, p)
+		}
+	}
+
+	return filters, params
+}
+
+// decodeStream applies the stream filters. When keepDCT is true decoding
+// stops at a DCTDecode filter, leaving JPEG data.
+func (d *pdfDocument) decodeStream(s *pdfStream, keepDCT bool) ([]byte, error) {
+	filters, params := d.streamFilters(s)
+
+	data := s.data
+	for i, filter := range filters {
+		switch filter {
+		case "FlateDecode", "Fl":
+			zr, err := zlib.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			inflated, err := d.budget.read(zr)
+			if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil, err
+			}
+			data, err = d.applyPredictor(inflated, params[i])
+			if err != nil {
+				return nil, err
+			}
+		case "DCTDecode", "DCT":
+			if keepDCT && i == len(filters)-1 {
+				return data, nil
+			}
+			return nil, errors.New("unsupported image encoding DCTDecode")
+		default:
+			return nil, fmt.Errorf("unsupported image encoding %s", filter)
+		}
+	}
+
+	return data, nil
+}
+
+// applyPredictor reverses the PNG row predictors used with Flate compression
+func (d *pdfDocument) applyPredictor(data []byte, params pdfDict) ([]byte, error) {
+	predictor, _ := d.resolve(params["Predictor"]).(int)
+	if predictor <= 1 {
+		return data, nil
+	}
+	if predictor < 10 {
+		return nil, fmt.Errorf("unsupported predictor %d", predictor)
+	}
+
+	colors := intOrDefault(d.resolve(params["Colors"]), 1)
+	bpc := intOrDefault(d.resolve(params["BitsPerComponent"]), 8)
+	columns := intOrDefault(d.resolve(params["Columns"]), 1)
+
+	bpp := (colors*bpc + 7) / 8
+	rowLen := (colors*bpc*columns + 7) / 8
+	if rowLen <= 0 {
+		return nil, errors.New("invalid predictor parameters")
+	}
+
+	out := make([]byte, 0, len(data))
+	prev := make([]byte, rowLen)
+	for pos := 0; pos+1+rowLen <= len(data); pos += 1 + rowLen {
+		filterType := data[pos]
+		row := make([]byte, rowLen)
+		copy(row, data[pos+1:pos+1+rowLen])
+		for i := range row {
+			var left, upLeft byte
+			if i >= bpp {
+				left = row[i-bpp]
+				upLeft = prev[i-bpp]
+			}
+			up := prev[i]
+			switch filterType {
+			case 0:
+			case 1:
+				row[i] += left
+			case 2:
+				row[i] += up
+			case 3:
+				row[i] += byte((int(left) + int(up)) / 2)
+			case 4:
+				row[i] += paeth(left, up, upLeft)
+			default:
+				return nil, fmt.Errorf("invalid PNG predictor row filter %d", filterType)
+			}
+		}
+		out = append(out, row...)
+		prev = row
+	}
+
+	return out, nil
+}
+
+func paeth(a, b, c byte) byte {
+	p := int(a) + int(b) - int(c)
+	pa, pb, pc := abs(p-int(a)), abs(p-int(b)), abs(p-int(c))
+	switch {
+	case pa <= pb && pa <= pc:
+		return a
+	case pb <= pc:
+		return b
+	}
+	return c
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func intOrDefault(v any, def int) int {
+	if i, ok := v.(int); ok {
+		return i
+	}
+	return def
+}
+
+// pdfParser parses PDF objects from a buffer
+type pdfParser struct {
+	buf []byte
+	pos int
+}
+
+func isPDFWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+}
+
+func isPDFDelimiter(c byte) bool {
+	switch c {
+	case '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return true
+	}
+	return false
+}
+
+func (p *pdfParser) skipSpace() {
+	for p.pos < len(p.buf) {
+		c := p.buf[p.pos]
+		if isPDFWhitespace(c) {
+			p.pos++
+			continue
+		}
+		if c == '%' {
+			for p.pos < len(p.buf) && p.buf[p.pos] != '\r' && p.buf[p.pos] != '\n' {
+				p.pos++
+			}
+			continue
+		}
+		return
+	}
+}
+
+func (p *pdfParser) token() string {
+	start := p.pos
+	for p.pos < len(p.buf) && !isPDFWhitespace(p.buf[p.pos]) && !isPDFDelimiter(p.buf[p.pos]) {
+		p.pos++
+	}
+	return string(p.buf[start:p.pos])
+}
+
+func (p *pdfParser) parseObject() (any, error) {
+	p.skipSpace()
+	if p.pos >= len(p.buf) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	c := p.buf[p.pos]
+	switch {
+	case c == '/':
+		p.pos++
+		return pdfName(p.token()), nil
+	case c == '<' && p.pos+1 < len(p.buf) && p.buf[p.pos+1] == '<':
+		p.pos += 2
+		return p.parseDict()
+	case c == '<':
+		p.pos++
+		end := bytes.IndexByte(p.buf[p.pos:], '>')
+		if end < 0 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		s := p.buf[p.pos : p.pos+end]
+		p.pos += end + 1
+		return s, nil
+	case c == '[':
+		p.pos++
+		return p.parseArray()
+	case c == '(':
+		p.pos++
+		return p.parseString()
+	case c == '+' || c == '-' || c == '.' || (c >= '0' && c <= '9'):
+		return p.parseNumberOrRef()
+	case isPDFDelimiter(c):
+		p.pos++
+		return pdfKeyword(string(c)), nil
+	}
+
+	switch kw := p.token(); kw {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	default:
+		return pdfKeyword(kw), nil
+	}
+}
+
+func (p *pdfParser) parseDict() (pdfDict, error) {
+	dict := pdfDict{}
+	for {
+		p.skipSpace()
+		if p.pos+1 < len(p.buf) && p.buf[p.pos] == '>' && p.buf[p.pos+1] == '>' {
+			p.pos += 2
+			return dict, nil
+		}
+		key, err := p.parseObject()
+		if err != nil {
+			return nil, err
+		}
+		name, ok := key.(pdfName)
+		if !ok {
+			return nil, fmt.Errorf("invalid dictionary key %v", key)
+		}
+		value, err := p.parseObject()
+		if err != nil {
+			return nil, err
+		}
+		dict[name] = value
+	}
+}
+
+func (p *pdfParser) parseArray() (pdfArray, error) {
+	arr := pdfArray{}
+	for {
+		p.skipSpace()
+		if p.pos < len(p.buf) && p.buf[p.pos] == ']' {
+			p.pos++
+			return arr, nil
+		}
+		value, err := p.parseObject()
+		if err != nil {
+			return nil, err
+		}
+		arr = append(arr, value)
+	}
+}
+
+func (p *pdfParser) parseString() ([]byte, error) {
+	var s []byte
+	depth := 1
+	for p.pos < len(p.buf) {
+		c := p.buf[p.pos]
+		p.pos++
+		switch c {
+		case '\\':
+			if p.pos < len(p.buf) {
+				s = append(s, p.buf[p.pos])
+				p.pos++
+			}
+			continue
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return s, nil
+			}
+		}
+		s = append(s, c)
+	}
+	return nil, io.ErrUnexpectedEOF
+}
+
+// parseNumberOrRef parses a number, or an indirect reference "N G R"
+func (p *pdfParser) parseNumberOrRef() (any, error) {
+	tok := p.token()
+
+	num, err := strconv.Atoi(tok)
+	if err != nil {
+		f, err := strconv.ParseFloat(tok, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", tok)
+		}
+		return f, nil
+	}
+
+	save := p.pos
+	p.skipSpace()
+	if p.pos < len(p.buf) && p.buf[p.pos] >= '0' && p.buf[p.pos] <= '9' {
+		if gen, err := strconv.Atoi(p.token()); err == nil {
+			p.skipSpace()
+			if p.pos < len(p.buf) && p.buf[p.pos] == 'R' &&
+				(p.pos+1 == len(p.buf) || isPDFWhitespace(p.buf[p.pos+1]) || isPDFDelimiter(p.buf[p.pos+1])) {
+				p.pos++
+				return pdfRef{num: num, gen: gen}, nil
+			}
+		}
+	}
+	p.pos = save
+
+	return num, nil
+}
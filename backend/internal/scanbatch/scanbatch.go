@@ -0,0 +1,268 @@
+// Package scanbatch splits bulk scanned documents into single page images so
+// each page can be routed to the turn sheet scanner.
+//
+// Managers scanning a stack of returned paper turn sheets typically end up
+// with one multi-page PDF from the scanner, or a ZIP file of page images.
+// SplitPages accepts either, as well as a single page image, and returns the
+// pages in document order. ZIP entries are read in name order and PDFs inside
+// a ZIP are split in place.
+//
+// PDF support is intentionally limited to what document scanners produce: each
+// page is expected to carry a single scanned image, stored as JPEG (DCTDecode)
+// or as Flate compressed samples. Pages that cannot be extracted are returned
+// with Err set rather than failing the whole document, so the remaining pages
+// can still be processed.
+//
+// Decompressed data is capped per ZIP entry or PDF stream and in total across
+// the document, so a small compressed upload cannot exhaust memory. Exceeding
+// either cap fails the whole document. Page images are also checked against
+// MaxImagePixels before they are decoded, and a page that is too large is
+// returned with Err set.
+package scanbatch
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+)
+
+// MaxPages is the maximum number of pages accepted in a single document
+const MaxPages = 500
+
+// MaxEntrySize is the maximum decompressed size of a single ZIP entry or PDF stream
+const MaxEntrySize = 100 << 20
+
+// MaxDecompressedSize is the maximum total decompressed size of the ZIP entries
+// and PDF streams read from a single document
+const MaxDecompressedSize = 1 << 30
+
+// MaxImagePixels is the maximum number of pixels in a single page image, enough
+// for an A3 page scanned at 600 dpi
+const MaxImagePixels = 80_000_000
+
+// ErrUnsupportedDocument is returned when a document is not a PDF, ZIP or image
+var ErrUnsupportedDocument = errors.New("unsupported document, expected a PDF, ZIP, JPEG, PNG or WebP file")
+
+// ErrTooManyPages is returned when a document has more than MaxPages pages
+var ErrTooManyPages = fmt.Errorf("document has more than %d pages", MaxPages)
+
+// ErrEntryTooLarge is returned when a ZIP entry or PDF stream decompresses to more than MaxEntrySize bytes
+var ErrEntryTooLarge = fmt.Errorf("document contains a file or image larger than %d MB when decompressed", MaxEntrySize>>20)
+
+// ErrDocumentTooLarge is returned when a document decompresses to more than MaxDecompressedSize bytes
+var ErrDocumentTooLarge = fmt.Errorf("document is larger than %d MB when decompressed", MaxDecompressedSize>>20)
+
+// ErrImageTooLarge is returned when a page image has more than MaxImagePixels pixels
+var ErrImageTooLarge = fmt.Errorf("image is larger than %d megapixels", MaxImagePixels/1_000_000)
+
+// Page is a single page split from a scanned document
+type Page struct {
+	// Number is the page number within the document, starting at 1
+	Number int
+	// Source describes where in the document the page came from
+	Source string
+	// Image is the page image, JPEG, PNG or WebP encoded
+	Image []byte
+	// Err is set when the page image could not be extracted
+	Err error
+}
+
+// SplitPages splits a PDF, ZIP or single image document into page images
+func SplitPages(data []byte) ([]*Page, error) {
+	pages, err := splitDocument("", data, false, newDecompressBudget())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pages) == 0 {
+		return nil, errors.New("document does not contain any pages")
+	}
+
+	if len(pages) > MaxPages {
+		return nil, ErrTooManyPages
+	}
+
+	for i, page := range pages {
+		page.Number = i + 1
+	}
+
+	return pages, nil
+}
+
+func splitDocument(name string, data []byte, inZIP bool, budget *decompressBudget) ([]*Page, error) {
+	switch {
+	case IsPDF(data):
+		return splitPDF(name, data, budget)
+	case IsZIP(data):
+		if inZIP {
+			return nil, errors.New("nested ZIP files are not supported")
+		}
+		return splitZIP(data, budget)
+	case IsImage(data):
+		if err := checkImageConfig(data); err != nil {
+			return nil, err
+		}
+		source := name
+		if source == "" {
+			source = "image"
+		}
+		return []*Page{{Source: source, Image: data}}, nil
+	}
+	return nil, ErrUnsupportedDocument
+}
+
+// IsPDF returns true when the data is a PDF document
+func IsPDF(data []byte) bool {
+	return bytes.HasPrefix(data, []byte("%PDF-"))
+}
+
+// IsZIP returns true when the data is a ZIP file
+func IsZIP(data []byte) bool {
+	return bytes.HasPrefix(data, []byte("PK\x03\x04"))
+}
+
+// IsImage returns true when the data is a JPEG, PNG or WebP image
+func IsImage(data []byte) bool {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8, 0xFF}):
+		return true
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return true
+	case len(data) >= 12 && bytes.Equal(data[0:4], []byte("RIFF")) && bytes.Equal(data[8:12], []byte("WEBP")):
+		return true
+	}
+	return false
+}
+
+func splitPDF(name string, data []byte, budget *decompressBudget) ([]*Page, error) {
+	doc, err := parsePDF(data, budget)
+	if err != nil {
+		if name != "" {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		return nil, err
+	}
+
+	pageDicts := doc.pages()
+
+	pages := make([]*Page, 0, len(pageDicts))
+	for i, pageDict := range pageDicts {
+		source := fmt.Sprintf("page %d", i+1)
+		if name != "" {
+			source = fmt.Sprintf("%s page %d", name, i+1)
+		}
+		img, err := doc.pageImage(pageDict)
+		if isDecompressLimitError(err) {
+			return nil, fmt.Errorf("%s: %w", source, err)
+		}
+		pages = append(pages, &Page{Source: source, Image: img, Err: err})
+	}
+
+	return pages, nil
+}
+
+func splitZIP(data []byte, budget *decompressBudget) ([]*Page, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ZIP file: %w", err)
+	}
+
+	files := make([]*zip.File, 0, len(zr.File))
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || isHiddenZIPEntry(f.Name) {
+			continue
+		}
+		files = append(files, f)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+
+	var pages []*Page
+	for _, f := range files {
+		content, err := readZIPEntry(f, budget)
+		if isDecompressLimitError(err) {
+			return nil, fmt.Errorf("%s: %w", f.Name, err)
+		}
+		if err == nil {
+			var entryPages []*Page
+			entryPages, err = splitDocument(f.Name, content, true, budget)
+			if isDecompressLimitError(err) {
+				return nil, err
+			}
+			if err == nil {
+				pages = append(pages, entryPages...)
+			}
+		}
+		if err != nil {
+			pages = append(pages, &Page{Source: f.Name, Err: err})
+		}
+		if len(pages) > MaxPages {
+			return nil, ErrTooManyPages
+		}
+	}
+
+	return pages, nil
+}
+
+// isHiddenZIPEntry returns true for operating system metadata commonly added
+// to ZIP files, such as __MACOSX resource forks and dot files
+func isHiddenZIPEntry(name string) bool {
+	if strings.HasPrefix(name, "__MACOSX/") {
+		return true
+	}
+	return strings.HasPrefix(path.Base(name), ".")
+}
+
+func readZIPEntry(f *zip.File, budget *decompressBudget) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return budget.read(rc)
+}
+
+// decompressBudget limits the data decompressed from a document, both for each
+// ZIP entry or PDF stream and in total
+type decompressBudget struct {
+	entryLimit int64
+	remaining  int64
+}
+
+func newDecompressBudget() *decompressBudget {
+	return &decompressBudget{
+		entryLimit: MaxEntrySize,
+		remaining:  MaxDecompressedSize,
+	}
+}
+
+// read reads decompressed data, failing with ErrEntryTooLarge or
+// ErrDocumentTooLarge as soon as either limit is exceeded
+func (b *decompressBudget) read(r io.Reader) ([]byte, error) {
+	limit := min(b.entryLimit, b.remaining)
+
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if int64(len(data)) > limit {
+		if limit < b.entryLimit {
+			return nil, ErrDocumentTooLarge
+		}
+		return nil, ErrEntryTooLarge
+	}
+	b.remaining -= int64(len(data))
+
+	return data, err
+}
+
+// isDecompressLimitError returns true when an error fails the whole document
+// because too much data would be decompressed from it
+func isDecompressLimitError(err error) bool {
+	return errors.Is(err, ErrEntryTooLarge) || errors.Is(err, ErrDocumentTooLarge)
+}
@@ -0,0 +1,360 @@
+package scanbatch
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testJPEG returns a small JPEG image
+func testJPEG(t *testing.T) []byte {
+	t.Helper()
+
+	img := image.NewGray(image.Rect(0, 0, 8, 8))
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	return buf.Bytes()
+}
+
+// testPNG returns a small PNG image
+func testPNG(t *testing.T) []byte {
+	t.Helper()
+
+	img := image.NewGray(image.Rect(0, 0, 4, 4))
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// testPNGHeader returns a PNG signature and header chunk claiming the given
+// size, with no image data
+func testPNGHeader(width, height uint32) []byte {
+	chunk := []byte("IHDR")
+	chunk = binary.BigEndian.AppendUint32(chunk, width)
+	chunk = binary.BigEndian.AppendUint32(chunk, height)
+	chunk = append(chunk, 8, 0, 0, 0, 0)
+
+	data := []byte("\x89PNG\r\n\x1a\n")
+	data = binary.BigEndian.AppendUint32(data, 13)
+	data = append(data, chunk...)
+	return binary.BigEndian.AppendUint32(data, crc32.ChecksumIEEE(chunk))
+}
+
+// testJPEGHeader returns a JFIF JPEG start of image and frame header claiming
+// the given size, with no image data
+func testJPEGHeader(width, height uint16) []byte {
+	data := []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00, 0x10}
+	data = append(data, "JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00"...)
+	data = append(data, 0xFF, 0xC0, 0x00, 0x0B, 0x08)
+	data = binary.BigEndian.AppendUint16(data, height)
+	data = binary.BigEndian.AppendUint16(data, width)
+	return append(data, 0x01, 0x01, 0x11, 0x00)
+}
+
+func deflate(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	_, err := zw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+// testPDF builds a PDF with one page per image stream. Each image is given as
+// its stream dictionary entries and data.
+type testPDFImage struct {
+	dict string
+	data []byte
+}
+
+func testPDF(images []testPDFImage) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1 and 2 are the catalog and page tree, then each page is followed
+	// by its content stream and image
+	kids := []string{}
+	for i := range images {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 3+i*3))
+	}
+
+	fmt.Fprintf(&buf, "1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+	fmt.Fprintf(&buf, "2 0 obj\n<< /Type /Pages /Kids [%s] /Count %d >>\nendobj\n", strings.Join(kids, " "), len(images))
+
+	for i, img := range images {
+		pageNum := 3 + i*3
+		contentNum := pageNum + 1
+		imageNum := pageNum + 2
+		content := "q 595 0 0 842 0 0 cm /Im0 Do Q"
+
+		fmt.Fprintf(&buf, "%d 0 obj\n<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] /Resources << /XObject << /Im0 %d 0 R >> >> /Contents %d 0 R >>\nendobj\n", pageNum, imageNum, contentNum)
+		fmt.Fprintf(&buf, "%d 0 obj\n<< /Length %d >>\nstream\n%s\nendstream\nendobj\n", contentNum, len(content), content)
+		fmt.Fprintf(&buf, "%d 0 obj\n<< /Type /XObject /Subtype /Image %s /Length %d >>\nstream\n", imageNum, img.dict, len(img.data))
+		buf.Write(img.data)
+		buf.WriteString("\nendstream\nendobj\n")
+	}
+
+	buf.WriteString("trailer\n<< /Root 1 0 R >>\n%%EOF\n")
+
+	return buf.Bytes()
+}
+
+func testZIP(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestSplitPages(t *testing.T) {
+	jpegData := testJPEG(t)
+	pngData := testPNG(t)
+
+	// A 3x2 grayscale image with a PNG up predictor on every row
+	graySamples := []byte{
+		2, 0, 128, 255,
+		2, 10, 10, 0,
+	}
+
+	jpegImage := testPDFImage{
+		dict: "/Width 8 /Height 8 /ColorSpace /DeviceGray /BitsPerComponent 8 /Filter /DCTDecode",
+		data: jpegData,
+	}
+	flateImage := testPDFImage{
+		dict: "/Width 3 /Height 2 /ColorSpace /DeviceGray /BitsPerComponent 8 /Filter /FlateDecode /DecodeParms << /Predictor 15 /Colors 1 /BitsPerComponent 8 /Columns 3 >>",
+		data: deflate(t, graySamples),
+	}
+	bilevelImage := testPDFImage{
+		dict: "/Width 8 /Height 1 /ColorSpace /DeviceGray /BitsPerComponent 1 /Decode [1 0] /Filter /FlateDecode",
+		data: deflate(t, []byte{0x0F}),
+	}
+	oversizedImage := testPDFImage{
+		dict: "/Width 20000 /Height 20000 /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /FlateDecode",
+		data: deflate(t, []byte{0}),
+	}
+	overflowImage := testPDFImage{
+		dict: "/Width 4294967296 /Height 4294967296 /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /FlateDecode",
+		data: deflate(t, []byte{0}),
+	}
+	oversizedJPEGImage := testPDFImage{
+		dict: "/Width 8 /Height 8 /ColorSpace /DeviceGray /BitsPerComponent 8 /Filter /DCTDecode",
+		data: testJPEGHeader(20000, 20000),
+	}
+	faxImage := testPDFImage{
+		dict: "/Width 8 /Height 8 /ColorSpace /DeviceGray /BitsPerComponent 1 /Filter /CCITTFaxDecode",
+		data: []byte{0x00},
+	}
+
+	tests := []struct {
+		name       string
+		data       []byte
+		wantErr    string
+		wantPages  int
+		checkPages func(t *testing.T, pages []*Page)
+	}{
+		{
+			name:      "single JPEG image is one page",
+			data:      jpegData,
+			wantPages: 1,
+			checkPages: func(t *testing.T, pages []*Page) {
+				require.Equal(t, jpegData, pages[0].Image)
+				require.Equal(t, 1, pages[0].Number)
+			},
+		},
+		{
+			name:      "PDF pages are split in page tree order",
+			data:      testPDF([]testPDFImage{jpegImage, flateImage, bilevelImage}),
+			wantPages: 3,
+			checkPages: func(t *testing.T, pages []*Page) {
+				for i, page := range pages {
+					require.NoError(t, page.Err)
+					require.Equal(t, i+1, page.Number)
+					require.Equal(t, fmt.Sprintf("page %d", i+1), page.Source)
+				}
+
+				require.Equal(t, jpegData, pages[0].Image, "JPEG images are returned unchanged")
+
+				img, err := png.Decode(bytes.NewReader(pages[1].Image))
+				require.NoError(t, err, "Flate images are encoded as PNG")
+				require.Equal(t, image.Rect(0, 0, 3, 2), img.Bounds())
+				require.Equal(t, color.Gray{Y: 128}, color.GrayModel.Convert(img.At(1, 0)))
+				require.Equal(t, color.Gray{Y: 138}, color.GrayModel.Convert(img.At(1, 1)), "up predictor adds the previous row")
+
+				img, err = png.Decode(bytes.NewReader(pages[2].Image))
+				require.NoError(t, err)
+				require.Equal(t, color.Gray{Y: 255}, color.GrayModel.Convert(img.At(0, 0)), "decode array inverts bilevel samples")
+				require.Equal(t, color.Gray{Y: 0}, color.GrayModel.Convert(img.At(7, 0)))
+			},
+		},
+		{
+			name:      "PDF page with an unsupported image encoding is returned with an error",
+			data:      testPDF([]testPDFImage{jpegImage, faxImage}),
+			wantPages: 2,
+			checkPages: func(t *testing.T, pages []*Page) {
+				require.NoError(t, pages[0].Err)
+				require.ErrorContains(t, pages[1].Err, "CCITTFaxDecode")
+				require.Nil(t, pages[1].Image)
+			},
+		},
+		{
+			name:      "PDF page with an image larger than MaxImagePixels is returned with an error",
+			data:      testPDF([]testPDFImage{oversizedImage, overflowImage, oversizedJPEGImage, jpegImage}),
+			wantPages: 4,
+			checkPages: func(t *testing.T, pages []*Page) {
+				require.ErrorIs(t, pages[0].Err, ErrImageTooLarge, "size is checked before the samples are decoded")
+				require.ErrorIs(t, pages[1].Err, ErrImageTooLarge, "size that would overflow the stride is rejected")
+				require.ErrorIs(t, pages[2].Err, ErrImageTooLarge, "JPEG header size is checked")
+				require.Nil(t, pages[0].Image)
+				require.NoError(t, pages[3].Err)
+			},
+		},
+		{
+			name: "ZIP image larger than MaxImagePixels is returned with an error",
+			data: testZIP(t, map[string][]byte{
+				"scan-001.png": testPNGHeader(20000, 20000),
+				"scan-002.png": pngData,
+			}),
+			wantPages: 2,
+			checkPages: func(t *testing.T, pages []*Page) {
+				require.ErrorIs(t, pages[0].Err, ErrImageTooLarge)
+				require.NoError(t, pages[1].Err)
+			},
+		},
+		{
+			name:    "single image larger than MaxImagePixels returns an error",
+			data:    testPNGHeader(20000, 20000),
+			wantErr: ErrImageTooLarge.Error(),
+		},
+		{
+			name: "ZIP entries are read in name order and PDFs are split",
+			data: testZIP(t, map[string][]byte{
+				"scan-002.pdf":        testPDF([]testPDFImage{jpegImage, jpegImage}),
+				"scan-001.png":        pngData,
+				"scan-003.txt":        []byte("notes"),
+				"__MACOSX/._scan.pdf": []byte("resource fork"),
+				"folder/":             nil,
+			}),
+			wantPages: 4,
+			checkPages: func(t *testing.T, pages []*Page) {
+				require.Equal(t, "scan-001.png", pages[0].Source)
+				require.Equal(t, pngData, pages[0].Image)
+				require.Equal(t, "scan-002.pdf page 1", pages[1].Source)
+				require.Equal(t, "scan-002.pdf page 2", pages[2].Source)
+				require.Equal(t, "scan-003.txt", pages[3].Source)
+				require.ErrorIs(t, pages[3].Err, ErrUnsupportedDocument)
+				require.Equal(t, 4, pages[3].Number)
+			},
+		},
+		{
+			name:    "unsupported document returns an error",
+			data:    []byte("not a document"),
+			wantErr: ErrUnsupportedDocument.Error(),
+		},
+		{
+			name:    "encrypted PDF returns an error",
+			data:    []byte("%PDF-1.4\ntrailer\n<< /Root 1 0 R /Encrypt 2 0 R >>\n"),
+			wantErr: "encrypted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pages, err := SplitPages(tt.data)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, pages, tt.wantPages)
+			if tt.checkPages != nil {
+				tt.checkPages(t, pages)
+			}
+		})
+	}
+}
+
+func TestSplitDocumentDecompressLimits(t *testing.T) {
+	pngData := testPNG(t)
+
+	// A Flate image that inflates to 4 KB of samples
+	largeImage := testPDFImage{
+		dict: "/Width 64 /Height 64 /ColorSpace /DeviceGray /BitsPerComponent 8 /Filter /FlateDecode",
+		data: deflate(t, make([]byte, 64*64)),
+	}
+
+	tests := []struct {
+		name       string
+		data       []byte
+		entryLimit int64
+		totalLimit int64
+		wantErr    error
+	}{
+		{
+			name:       "PDF stream larger than the entry limit fails the document",
+			data:       testPDF([]testPDFImage{largeImage}),
+			entryLimit: 1024,
+			totalLimit: 1 << 20,
+			wantErr:    ErrEntryTooLarge,
+		},
+		{
+			name:       "ZIP entry larger than the entry limit fails the document",
+			data:       testZIP(t, map[string][]byte{"scan-001.png": pngData, "scan-002.bin": make([]byte, 4096)}),
+			entryLimit: 1024,
+			totalLimit: 1 << 20,
+			wantErr:    ErrEntryTooLarge,
+		},
+		{
+			name: "ZIP entries larger than the total limit fail the document",
+			data: testZIP(t, map[string][]byte{
+				"scan-001.pdf": testPDF([]testPDFImage{largeImage}),
+				"scan-002.pdf": testPDF([]testPDFImage{largeImage}),
+			}),
+			entryLimit: 1 << 20,
+			totalLimit: 6000,
+			wantErr:    ErrDocumentTooLarge,
+		},
+		{
+			name:       "document within the limits is split",
+			data:       testPDF([]testPDFImage{largeImage, largeImage}),
+			entryLimit: 4096,
+			totalLimit: 8192,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget := &decompressBudget{entryLimit: tt.entryLimit, remaining: tt.totalLimit}
+			pages, err := splitDocument("", tt.data, false, budget)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, pages)
+				return
+			}
+			require.NoError(t, err)
+			for _, page := range pages {
+				require.NoError(t, page.Err)
+			}
+		})
+	}
+}
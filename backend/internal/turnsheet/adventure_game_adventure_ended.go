@@ -0,0 +1,138 @@
+package turnsheet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gitlab.com/alienspaces/playbymail/core/convert"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+	"gitlab.com/alienspaces/playbymail/internal/utils/turnsheetutil"
+)
+
+const adventureEndedTemplatePath = "turnsheet/adventure_game_adventure_ended.template"
+
+// DefaultAdventureEndedInstructions returns the default instruction text for adventure ended turn sheets.
+func DefaultAdventureEndedInstructions() string {
+	return AdventureEndedInstructions(i18n.DefaultLocale)
+}
+
+// AdventureEndedInstructions returns the instruction text for adventure ended turn sheets in a locale.
+func AdventureEndedInstructions(locale string) string {
+	return i18n.T(locale, "turnsheet.adventure_ended.instructions")
+}
+
+// AdventureEndedData is the data model for the final turn sheet sent to a player
+// whose character has lost all of their lives. The sheet is informational only
+// and does not accept player input.
+type AdventureEndedData struct {
+	TurnSheetTemplateData
+
+	CharacterName  string `json:"character_name"`
+	LivesLost      int    `json:"lives_lost"`
+	RetiredAtTurn  int    `json:"retired_at_turn"`
+	LocationName   string `json:"location_name,omitempty"`
+	CharacterLives int    `json:"character_lives"`
+}
+
+// AdventureEndedProcessor implements the DocumentProcessor interface for adventure ended sheets.
+type AdventureEndedProcessor struct {
+	*BaseProcessor
+}
+
+// NewAdventureEndedProcessor creates a new adventure ended processor.
+func NewAdventureEndedProcessor(l logger.Logger, cfg config.Config) (*AdventureEndedProcessor, error) {
+	baseProcessor, err := NewBaseProcessor(l, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &AdventureEndedProcessor{
+		BaseProcessor: baseProcessor,
+	}, nil
+}
+
+// GeneratePreviewData generates dummy data for an adventure ended turn sheet preview.
+func (p *AdventureEndedProcessor) GeneratePreviewData(ctx context.Context, l logger.Logger, gameRec *game_record.Game, backgroundImage *string) ([]byte, error) {
+	l = l.WithFunctionContext("AdventureEndedProcessor/GeneratePreviewData")
+
+	turnSheetCode, err := turnsheetutil.GeneratePlayGameTurnSheetCode("preview-turn-sheet-id")
+	if err != nil {
+		l.Warn("failed to generate turn sheet code >%v<", err)
+		return nil, fmt.Errorf("failed to generate turn sheet code: %w", err)
+	}
+
+	turnNumber := 8
+	title := "Your Adventure Has Ended"
+	instructions := DefaultAdventureEndedInstructions()
+	data := AdventureEndedData{
+		TurnSheetTemplateData: TurnSheetTemplateData{
+			GameName:              convert.Ptr(gameRec.Name),
+			GameType:              convert.Ptr(gameRec.GameType),
+			TurnNumber:            &turnNumber,
+			TurnSheetTitle:        &title,
+			TurnSheetDescription:  convert.Ptr(gameRec.Description),
+			TurnSheetInstructions: &instructions,
+			TurnSheetCode:         convert.Ptr(turnSheetCode),
+			TurnEvents: []TurnEvent{
+				{
+					Category: TurnEventCategoryCombat,
+					Icon:     TurnEventIconCombat,
+					Message:  "The Cave Troll slams you for 12 damage.",
+				},
+				{
+					Category: TurnEventCategorySystem,
+					Icon:     TurnEventIconDeath,
+					Message:  "You have fallen for the last time. Your adventure has ended.",
+				},
+			},
+		},
+		CharacterName:  "Aria the Brave",
+		LivesLost:      3,
+		RetiredAtTurn:  7,
+		LocationName:   "The Troll Bridge",
+		CharacterLives: 3,
+	}
+
+	if backgroundImage != nil {
+		data.BackgroundImage = backgroundImage
+	}
+
+	return json.Marshal(data)
+}
+
+// GenerateTurnSheet generates an adventure ended turn sheet document.
+func (p *AdventureEndedProcessor) GenerateTurnSheet(ctx context.Context, l logger.Logger, format DocumentFormat, sheetData []byte) ([]byte, error) {
+	l = l.WithFunctionContext("AdventureEndedProcessor/GenerateTurnSheet")
+
+	var data AdventureEndedData
+	if err := json.Unmarshal(sheetData, &data); err != nil {
+		l.Warn("failed to unmarshal sheet data >%v<", err)
+		return nil, fmt.Errorf("failed to parse sheet data: %w", err)
+	}
+
+	if err := p.ValidateBaseTemplateData(&data.TurnSheetTemplateData); err != nil {
+		l.Warn("failed to validate base template data >%v<", err)
+		return nil, fmt.Errorf("template data validation failed: %w", err)
+	}
+
+	if data.TurnSheetInstructions == nil || strings.TrimSpace(*data.TurnSheetInstructions) == "" {
+		instructions := AdventureEndedInstructions(data.TemplateLocale())
+		data.TurnSheetInstructions = &instructions
+	}
+
+	if data.TurnSheetTitle == nil || strings.TrimSpace(*data.TurnSheetTitle) == "" {
+		title := i18n.T(data.TemplateLocale(), "turnsheet.adventure_ended.title")
+		data.TurnSheetTitle = &title
+	}
+
+	return p.GenerateDocument(ctx, format, adventureEndedTemplatePath, &data)
+}
+
+// ScanTurnSheet always fails; adventure ended sheets do not accept player input.
+func (p *AdventureEndedProcessor) ScanTurnSheet(ctx context.Context, l logger.Logger, sheetData []byte, imageData []byte) ([]byte, error) {
+	return nil, fmt.Errorf("adventure ended turn sheets do not accept player input")
+}
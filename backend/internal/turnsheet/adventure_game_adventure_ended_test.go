@@ -0,0 +1,66 @@
+package turnsheet_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/convert"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/testutil"
+)
+
+func TestAdventureEndedProcessor_GenerateTurnSheet(t *testing.T) {
+	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
+
+	cfg.TemplatesPath = "../../templates"
+
+	processor, err := turnsheet.NewAdventureEndedProcessor(l, cfg)
+	require.NoError(t, err)
+
+	data := &turnsheet.AdventureEndedData{
+		TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
+			GameName:      convert.Ptr("The Enchanted Forest Adventure"),
+			GameType:      convert.Ptr("adventure"),
+			TurnNumber:    convert.Ptr(8),
+			TurnSheetCode: convert.Ptr(generateTestTurnSheetCode(t)),
+			TurnEvents: []turnsheet.TurnEvent{
+				{
+					Category: turnsheet.TurnEventCategorySystem,
+					Icon:     turnsheet.TurnEventIconDeath,
+					Message:  "You have fallen for the last time. Your adventure has ended.",
+				},
+			},
+		},
+		CharacterName:  "Aria the Brave",
+		LivesLost:      3,
+		RetiredAtTurn:  7,
+		LocationName:   "The Troll Bridge",
+		CharacterLives: 3,
+	}
+
+	sheetData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	html, err := processor.GenerateTurnSheet(context.Background(), l, turnsheet.DocumentFormatHTML, sheetData)
+	require.NoError(t, err)
+
+	output := string(html)
+	require.Contains(t, output, "Your Adventure Has Ended")
+	require.Contains(t, output, "Aria the Brave")
+	require.Contains(t, output, "Fell for the last time at The Troll Bridge.")
+	require.Contains(t, output, "Lost 3 of 3 lives and adventured until turn 7.")
+	require.Contains(t, output, "You have fallen for the last time.")
+}
+
+func TestAdventureEndedProcessor_ScanTurnSheet(t *testing.T) {
+	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
+
+	processor, err := turnsheet.NewAdventureEndedProcessor(l, cfg)
+	require.NoError(t, err)
+
+	_, err = processor.ScanTurnSheet(context.Background(), l, nil, []byte("image"))
+	require.Error(t, err, "adventure ended sheets do not accept player input")
+}
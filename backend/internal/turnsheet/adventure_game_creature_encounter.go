@@ -9,6 +9,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/convert"
 	"gitlab.com/alienspaces/playbymail/core/record"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/scanner"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
@@ -234,12 +235,12 @@ func (p *MonsterEncounterProcessor) GenerateTurnSheet(ctx context.Context, l log
 	}
 
 	if data.TurnSheetInstructions == nil || strings.TrimSpace(*data.TurnSheetInstructions) == "" {
-		instr := buildMonsterEncounterInstructions(data.MaxActions)
+		instr := MonsterEncounterInstructions(data.TemplateLocale(), data.MaxActions)
 		data.TurnSheetInstructions = &instr
 	}
 
 	if data.TurnSheetTitle == nil || strings.TrimSpace(*data.TurnSheetTitle) == "" {
-		title := "Creature Encounter"
+		title := i18n.T(data.TemplateLocale(), "turnsheet.encounter.title")
 		data.TurnSheetTitle = &title
 	}
 
@@ -295,26 +296,36 @@ func (p *MonsterEncounterProcessor) ScanTurnSheet(ctx context.Context, l logger.
 		return nil, fmt.Errorf("failed to decode structured response: %w", err)
 	}
 
-	if err := validateMonsterEncounterScanData(&data, &scanData); err != nil {
-		return nil, err
+	return json.Marshal(scanData)
+}
+
+// ValidateScanData checks the combat actions are within the number of actions allowed
+// and only target creatures in the encounter
+func (p *MonsterEncounterProcessor) ValidateScanData(sheetData []byte, scanData []byte) error {
+	var data MonsterEncounterData
+	if err := json.Unmarshal(sheetData, &data); err != nil {
+		return fmt.Errorf("failed to parse sheet data: %w", err)
 	}
 
-	return json.Marshal(scanData)
+	var monsterEncounterScanData MonsterEncounterScanData
+	if err := json.Unmarshal(scanData, &monsterEncounterScanData); err != nil {
+		return fmt.Errorf("failed to parse scan data: %w", err)
+	}
+
+	return validateMonsterEncounterScanData(&data, &monsterEncounterScanData)
 }
 
 // DefaultMonsterEncounterInstructions returns the default instruction text for the given number of combat actions.
 func DefaultMonsterEncounterInstructions(maxActions int) string {
-	return buildMonsterEncounterInstructions(maxActions)
+	return MonsterEncounterInstructions(i18n.DefaultLocale, maxActions)
 }
 
-func buildMonsterEncounterInstructions(maxActions int) string {
+// MonsterEncounterInstructions returns the instruction text for the given number of combat actions in a locale.
+func MonsterEncounterInstructions(locale string, maxActions int) string {
 	if maxActions == 0 {
 		maxActions = 3
 	}
-	return fmt.Sprintf(
-		"Choose up to %d combat actions below. For each slot select Do Nothing or Attack and write the creature name.\n\nIf you take any actions on your Inventory Management sheet this turn, your combat actions will be forfeited.",
-		maxActions,
-	)
+	return i18n.T(locale, "turnsheet.encounter.instructions", "max", maxActions)
 }
 
 func buildMonsterEncounterScanInstructions() string {
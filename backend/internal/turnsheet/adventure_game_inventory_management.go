@@ -9,6 +9,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/convert"
 	"gitlab.com/alienspaces/playbymail/core/record"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/scanner"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
@@ -128,13 +129,17 @@ type EquipAction struct {
 	Slot           string `json:"slot"`
 }
 
-const defaultInventoryManagementInstructions = "Manage your inventory by checking boxes to drop items, equip items, or move items to/from backpack."
 
 const inventoryManagementTemplatePath = "turnsheet/adventure_game_inventory_management.template"
 
 // DefaultInventoryManagementInstructions returns the default instruction text for inventory management turn sheets.
 func DefaultInventoryManagementInstructions() string {
-	return defaultInventoryManagementInstructions
+	return InventoryManagementInstructions(i18n.DefaultLocale)
+}
+
+// InventoryManagementInstructions returns the instruction text for inventory management turn sheets in a locale.
+func InventoryManagementInstructions(locale string) string {
+	return i18n.T(locale, "turnsheet.inventory.instructions")
 }
 
 // InventoryManagementProcessor implements the DocumentProcessor interface for inventory management turn sheets
@@ -258,13 +263,13 @@ func (p *InventoryManagementProcessor) GenerateTurnSheet(ctx context.Context, l
 
 	// Set default instructions if not provided
 	if inventoryData.TurnSheetInstructions == nil || strings.TrimSpace(*inventoryData.TurnSheetInstructions) == "" {
-		instruction := defaultInventoryManagementInstructions
+		instruction := InventoryManagementInstructions(inventoryData.TemplateLocale())
 		inventoryData.TurnSheetInstructions = &instruction
 	}
 
 	// Set default title if not provided
 	if inventoryData.TurnSheetTitle == nil || strings.TrimSpace(*inventoryData.TurnSheetTitle) == "" {
-		title := "Inventory Management"
+		title := i18n.T(inventoryData.TemplateLocale(), "turnsheet.inventory.title")
 		inventoryData.TurnSheetTitle = &title
 	}
 
@@ -338,11 +343,22 @@ func (p *InventoryManagementProcessor) ScanTurnSheet(ctx context.Context, l logg
 		return nil, fmt.Errorf("failed to decode structured inventory actions: %w", err)
 	}
 
-	if err := validateInventoryActions(&inventoryData, &scanData); err != nil {
-		return nil, err
+	return json.Marshal(scanData)
+}
+
+// ValidateScanData checks the inventory actions only refer to items on the turn sheet
+func (p *InventoryManagementProcessor) ValidateScanData(sheetData []byte, scanData []byte) error {
+	var inventoryData InventoryManagementData
+	if err := json.Unmarshal(sheetData, &inventoryData); err != nil {
+		return fmt.Errorf("failed to parse sheet data: %w", err)
 	}
 
-	return json.Marshal(scanData)
+	var inventoryScanData InventoryManagementScanData
+	if err := json.Unmarshal(scanData, &inventoryScanData); err != nil {
+		return fmt.Errorf("failed to parse scan data: %w", err)
+	}
+
+	return validateInventoryActions(&inventoryData, &inventoryScanData)
 }
 
 // buildInventoryManagementInstructions returns instructions for the AI-driven OCR service
@@ -9,18 +9,23 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/convert"
 	"gitlab.com/alienspaces/playbymail/core/record"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/scanner"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
 	"gitlab.com/alienspaces/playbymail/internal/utils/turnsheetutil"
 )
 
-const defaultJoinGameInstructions = "Fill out your account information and character name to join the game."
 const joinGameTemplatePath = "turnsheet/adventure_game_join_game.template"
 
 // DefaultJoinGameInstructions returns the default instruction text for join game turn sheets.
 func DefaultJoinGameInstructions() string {
-	return defaultJoinGameInstructions
+	return JoinGameInstructions(i18n.DefaultLocale)
+}
+
+// JoinGameInstructions returns the instruction text for join game turn sheets in a locale.
+func JoinGameInstructions(locale string) string {
+	return i18n.T(locale, "turnsheet.join.instructions")
 }
 
 // AdventureGameJoinGameScanData captures the fields extracted from a scanned adventure game join turn sheet
@@ -127,12 +132,12 @@ func (p *JoinGameProcessor) GenerateTurnSheet(ctx context.Context, l logger.Logg
 	}
 
 	if data.TurnSheetInstructions == nil || strings.TrimSpace(*data.TurnSheetInstructions) == "" {
-		instruction := defaultJoinGameInstructions
+		instruction := JoinGameInstructions(data.TemplateLocale())
 		data.TurnSheetInstructions = &instruction
 	}
 
 	if data.TurnSheetTitle == nil || strings.TrimSpace(*data.TurnSheetTitle) == "" {
-		title := "Join Game"
+		title := i18n.T(data.TemplateLocale(), "turnsheet.join.title")
 		data.TurnSheetTitle = &title
 	}
 
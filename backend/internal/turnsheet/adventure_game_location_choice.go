@@ -9,6 +9,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/convert"
 	"gitlab.com/alienspaces/playbymail/core/record"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/scanner"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
@@ -94,11 +95,14 @@ func (d *LocationChoiceScanData) GetChoices() []string {
 // LocationChoiceScannedDataSchemaName is the filename of the JSON schema for location choice scanned_data (under schema/turnsheet/adventure_game/).
 const LocationChoiceScannedDataSchemaName = "location_choice.schema.json"
 
-const defaultLocationChoiceInstructions = "Choose your next location or interact with an object at your current location."
+// LocationChoiceInstructions returns the instruction text for location choice turn sheets in a locale.
+func LocationChoiceInstructions(locale string) string {
+	return i18n.T(locale, "turnsheet.location_choice.instructions")
+}
 
 // DefaultLocationChoiceInstructions returns the default instruction text for location choice turn sheets.
 func DefaultLocationChoiceInstructions() string {
-	return defaultLocationChoiceInstructions
+	return LocationChoiceInstructions(i18n.DefaultLocale)
 }
 
 // LocationChoiceProcessor implements the DocumentProcessor interface for location choice turn sheets
@@ -211,7 +215,7 @@ func (p *LocationChoiceProcessor) GenerateTurnSheet(ctx context.Context, l logge
 	}
 
 	if locationChoiceData.TurnSheetInstructions == nil || strings.TrimSpace(*locationChoiceData.TurnSheetInstructions) == "" {
-		instruction := defaultLocationChoiceInstructions
+		instruction := LocationChoiceInstructions(locationChoiceData.TemplateLocale())
 		locationChoiceData.TurnSheetInstructions = &instruction
 	}
 
@@ -302,11 +306,22 @@ func (p *LocationChoiceProcessor) ScanTurnSheet(ctx context.Context, l logger.Lo
 		return nil, fmt.Errorf("failed to decode structured location choices: %w", err)
 	}
 
-	if err := validateLocationChoices(&locationChoiceData, &scanData); err != nil {
-		return nil, err
+	return json.Marshal(scanData)
+}
+
+// ValidateScanData checks the chosen location or object action was offered on the turn sheet
+func (p *LocationChoiceProcessor) ValidateScanData(sheetData []byte, scanData []byte) error {
+	var locationChoiceData LocationChoiceData
+	if err := json.Unmarshal(sheetData, &locationChoiceData); err != nil {
+		return fmt.Errorf("failed to parse sheet data: %w", err)
 	}
 
-	return json.Marshal(scanData)
+	var locationChoiceScanData LocationChoiceScanData
+	if err := json.Unmarshal(scanData, &locationChoiceScanData); err != nil {
+		return fmt.Errorf("failed to parse scan data: %w", err)
+	}
+
+	return validateLocationChoices(&locationChoiceData, &locationChoiceScanData)
 }
 
 // These are the instructions provided to the AI driven OCR service.
@@ -398,3 +413,39 @@ func validateLocationChoices(sheetData *LocationChoiceData, scanData *LocationCh
 
 	return nil
 }
+
+// TextOrderKeywords returns the plain-text order keywords accepted by location choice sheets
+func (p *LocationChoiceProcessor) TextOrderKeywords() []string {
+	return []string{"GO"}
+}
+
+// ParseTextOrders converts a "GO <location option>" order into location choice scan data
+func (p *LocationChoiceProcessor) ParseTextOrders(ctx context.Context, l logger.Logger, sheetData []byte, orders []TextOrder) ([]byte, error) {
+	l = l.WithFunctionContext("LocationChoiceProcessor/ParseTextOrders")
+
+	var data LocationChoiceData
+	if err := json.Unmarshal(sheetData, &data); err != nil {
+		l.Warn("failed to unmarshal sheet data >%v<", err)
+		return nil, fmt.Errorf("failed to parse sheet data: %w", err)
+	}
+
+	if len(orders) != 1 {
+		return nil, fmt.Errorf("choose exactly one location with a single GO order, found %d", len(orders))
+	}
+
+	options := map[string]string{}
+	for _, opt := range data.LocationOptions {
+		if opt.IsLocked {
+			continue
+		}
+		options[opt.LocationLinkName] = opt.LocationID
+	}
+
+	order := orders[0]
+	locationID, ok := matchTextOrderName(order.Args, options)
+	if !ok {
+		return nil, fmt.Errorf("line %d: %q is not one of the available locations %s", order.Line, order.Args, textOrderOptionNames(options))
+	}
+
+	return json.Marshal(LocationChoiceScanData{Choices: []string{locationID}})
+}
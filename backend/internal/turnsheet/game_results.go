@@ -0,0 +1,155 @@
+package turnsheet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gitlab.com/alienspaces/playbymail/core/convert"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+	"gitlab.com/alienspaces/playbymail/internal/utils/turnsheetutil"
+)
+
+const gameResultsTemplatePath = "turnsheet/game_results.template"
+
+// DefaultGameResultsInstructions returns the default instruction text for game results turn sheets.
+func DefaultGameResultsInstructions() string {
+	return GameResultsInstructions(i18n.DefaultLocale)
+}
+
+// GameResultsInstructions returns the instruction text for game results turn sheets in a locale.
+func GameResultsInstructions(locale string) string {
+	return i18n.T(locale, "turnsheet.results.instructions")
+}
+
+// GameResultsData is the data model for the final results turn sheet sent to every
+// player when a game instance completes. The sheet is informational only and does
+// not accept player input.
+type GameResultsData struct {
+	TurnSheetTemplateData
+
+	// EndCondition is the game_record end condition that completed the game
+	EndCondition string `json:"end_condition"`
+	// EndConditionDescription describes how the game ended for display
+	EndConditionDescription string `json:"end_condition_description"`
+	// Placing and IsWinner are the receiving player's own result
+	Placing  int  `json:"placing"`
+	IsWinner bool `json:"is_winner"`
+	// Standings lists every player's result, best placing first
+	Standings []GameResultsStanding `json:"standings,omitempty"`
+}
+
+// GameResultsStanding is a single player's result on the final results sheet
+type GameResultsStanding struct {
+	Placing    int    `json:"placing"`
+	PlayerName string `json:"player_name"`
+	IsWinner   bool   `json:"is_winner"`
+	Summary    string `json:"summary,omitempty"`
+	// IsPlayer marks the receiving player's own row
+	IsPlayer bool `json:"is_player,omitempty"`
+}
+
+// GameEndConditionDescription returns the player facing description of an end condition in a locale.
+func GameEndConditionDescription(locale, endCondition string) string {
+	if !game_record.GameEndConditions.Has(endCondition) {
+		return i18n.T(locale, "turnsheet.results.end_condition.default")
+	}
+	return i18n.T(locale, "turnsheet.results.end_condition."+endCondition)
+}
+
+// GameResultsProcessor implements the DocumentProcessor interface for game results sheets.
+type GameResultsProcessor struct {
+	*BaseProcessor
+}
+
+// NewGameResultsProcessor creates a new game results processor.
+func NewGameResultsProcessor(l logger.Logger, cfg config.Config) (*GameResultsProcessor, error) {
+	baseProcessor, err := NewBaseProcessor(l, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &GameResultsProcessor{
+		BaseProcessor: baseProcessor,
+	}, nil
+}
+
+// GeneratePreviewData generates dummy data for a game results turn sheet preview.
+func (p *GameResultsProcessor) GeneratePreviewData(ctx context.Context, l logger.Logger, gameRec *game_record.Game, backgroundImage *string) ([]byte, error) {
+	l = l.WithFunctionContext("GameResultsProcessor/GeneratePreviewData")
+
+	turnSheetCode, err := turnsheetutil.GeneratePlayGameTurnSheetCode("preview-turn-sheet-id")
+	if err != nil {
+		l.Warn("failed to generate turn sheet code >%v<", err)
+		return nil, fmt.Errorf("failed to generate turn sheet code: %w", err)
+	}
+
+	turnNumber := 12
+	title := "Final Results"
+	instructions := DefaultGameResultsInstructions()
+	data := GameResultsData{
+		TurnSheetTemplateData: TurnSheetTemplateData{
+			GameName:              convert.Ptr(gameRec.Name),
+			GameType:              convert.Ptr(gameRec.GameType),
+			TurnNumber:            &turnNumber,
+			TurnSheetTitle:        &title,
+			TurnSheetDescription:  convert.Ptr(gameRec.Description),
+			TurnSheetInstructions: &instructions,
+			TurnSheetCode:         convert.Ptr(turnSheetCode),
+		},
+		EndCondition:            game_record.GameEndConditionMaxTurns,
+		EndConditionDescription: GameEndConditionDescription(i18n.DefaultLocale, game_record.GameEndConditionMaxTurns),
+		Placing:                 2,
+		Standings: []GameResultsStanding{
+			{Placing: 1, PlayerName: "Ellie", Summary: "Aria the Brave survived having lost 0 lives."},
+			{Placing: 2, PlayerName: "Marcus", Summary: "Borin Ironhand survived having lost 1 life.", IsPlayer: true},
+			{Placing: 3, PlayerName: "Priya", Summary: "Cassia Nightshade fell for the last time on turn 9."},
+		},
+	}
+
+	if backgroundImage != nil {
+		data.BackgroundImage = backgroundImage
+	}
+
+	return json.Marshal(data)
+}
+
+// GenerateTurnSheet generates a game results turn sheet document.
+func (p *GameResultsProcessor) GenerateTurnSheet(ctx context.Context, l logger.Logger, format DocumentFormat, sheetData []byte) ([]byte, error) {
+	l = l.WithFunctionContext("GameResultsProcessor/GenerateTurnSheet")
+
+	var data GameResultsData
+	if err := json.Unmarshal(sheetData, &data); err != nil {
+		l.Warn("failed to unmarshal sheet data >%v<", err)
+		return nil, fmt.Errorf("failed to parse sheet data: %w", err)
+	}
+
+	if err := p.ValidateBaseTemplateData(&data.TurnSheetTemplateData); err != nil {
+		l.Warn("failed to validate base template data >%v<", err)
+		return nil, fmt.Errorf("template data validation failed: %w", err)
+	}
+
+	if data.TurnSheetInstructions == nil || strings.TrimSpace(*data.TurnSheetInstructions) == "" {
+		instructions := GameResultsInstructions(data.TemplateLocale())
+		data.TurnSheetInstructions = &instructions
+	}
+
+	if data.TurnSheetTitle == nil || strings.TrimSpace(*data.TurnSheetTitle) == "" {
+		title := i18n.T(data.TemplateLocale(), "turnsheet.results.title")
+		data.TurnSheetTitle = &title
+	}
+
+	if data.EndConditionDescription == "" {
+		data.EndConditionDescription = GameEndConditionDescription(data.TemplateLocale(), data.EndCondition)
+	}
+
+	return p.GenerateDocument(ctx, format, gameResultsTemplatePath, &data)
+}
+
+// ScanTurnSheet always fails; game results sheets do not accept player input.
+func (p *GameResultsProcessor) ScanTurnSheet(ctx context.Context, l logger.Logger, sheetData []byte, imageData []byte) ([]byte, error) {
+	return nil, fmt.Errorf("game results turn sheets do not accept player input")
+}
@@ -0,0 +1,97 @@
+package turnsheet_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/convert"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/testutil"
+)
+
+func TestGameResultsProcessor_GenerateTurnSheet(t *testing.T) {
+	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
+
+	cfg.TemplatesPath = "../../templates"
+
+	processor, err := turnsheet.NewGameResultsProcessor(l, cfg)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		data         *turnsheet.GameResultsData
+		wantContains []string
+	}{
+		{
+			name: "winner sees victory headline",
+			data: &turnsheet.GameResultsData{
+				EndCondition: game_record.GameEndConditionGoalLocationReached,
+				Placing:      1,
+				IsWinner:     true,
+				Standings: []turnsheet.GameResultsStanding{
+					{Placing: 1, PlayerName: "Ellie", IsWinner: true, Summary: "Aria the Brave completed the adventure.", IsPlayer: true},
+					{Placing: 2, PlayerName: "Marcus", Summary: "Borin Ironhand survived having lost 1 life."},
+				},
+			},
+			wantContains: []string{
+				"Final Results",
+				"Victory! You placed first.",
+				"The goal was reached.",
+				"Aria the Brave completed the adventure.",
+				"Borin Ironhand survived having lost 1 life.",
+			},
+		},
+		{
+			name: "non-winner sees their placing",
+			data: &turnsheet.GameResultsData{
+				EndCondition: game_record.GameEndConditionMaxTurns,
+				Placing:      2,
+				Standings: []turnsheet.GameResultsStanding{
+					{Placing: 1, PlayerName: "Ellie", Summary: "4 of 4 mechs left standing."},
+					{Placing: 2, PlayerName: "Marcus", Summary: "2 of 4 mechs left standing.", IsPlayer: true},
+				},
+			},
+			wantContains: []string{
+				"You placed 2nd.",
+				"The game reached its final turn.",
+				"2 of 4 mechs left standing.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.data.TurnSheetTemplateData = turnsheet.TurnSheetTemplateData{
+				GameName:      convert.Ptr("The Enchanted Forest Adventure"),
+				GameType:      convert.Ptr("adventure"),
+				TurnNumber:    convert.Ptr(12),
+				TurnSheetCode: convert.Ptr(generateTestTurnSheetCode(t)),
+			}
+
+			sheetData, err := json.Marshal(tt.data)
+			require.NoError(t, err)
+
+			html, err := processor.GenerateTurnSheet(context.Background(), l, turnsheet.DocumentFormatHTML, sheetData)
+			require.NoError(t, err)
+
+			output := string(html)
+			for _, want := range tt.wantContains {
+				require.Contains(t, output, want)
+			}
+		})
+	}
+}
+
+func TestGameResultsProcessor_ScanTurnSheet(t *testing.T) {
+	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
+
+	processor, err := turnsheet.NewGameResultsProcessor(l, cfg)
+	require.NoError(t, err)
+
+	_, err = processor.ScanTurnSheet(context.Background(), l, nil, []byte("image"))
+	require.Error(t, err, "game results sheets do not accept player input")
+}
@@ -9,6 +9,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/core/convert"
 	"gitlab.com/alienspaces/playbymail/core/record"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/scanner"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
@@ -17,12 +18,37 @@ import (
 
 const JoinGameScannedDataSchemaName = "join_game.schema.json"
 
-const defaultMechaGameJoinGameInstructions = "Fill out your account information and commander name to join the game."
 const mechaGameJoinGameTemplatePath = "turnsheet/mecha_game_join_game.template"
 
+// previewMechaGameSquadSize matches the default squad_size game parameter
+const previewMechaGameSquadSize = 4
+
+// previewMechaGameRules matches the default mecha game ruleset parameters
+func previewMechaGameRules() *MechaGameRulesSummary {
+	return &MechaGameRulesSummary{
+		SupplyPointsPerTurn:    2,
+		HeatDissipationDivisor: 3,
+		AutoRepairPercent:      25,
+		BaseHitChance:          50,
+		HitChancePerSkill:      5,
+		MaxHitChance:           95,
+		PilotSkillThresholds:   []int{0, 3, 8, 15, 24, 35, 48, 63, 80, 99},
+
+		RoughMovementCost:         2,
+		UrbanMovementCost:         2,
+		WaterHeatDissipationBonus: 5,
+		ObstructedFireRange:       1,
+	}
+}
+
 // DefaultMechaGameJoinGameInstructions returns the default instruction text for mecha join game turn sheets.
 func DefaultMechaGameJoinGameInstructions() string {
-	return defaultMechaGameJoinGameInstructions
+	return MechaGameJoinGameInstructions(i18n.DefaultLocale)
+}
+
+// MechaGameJoinGameInstructions returns the instruction text for mecha join game turn sheets in a locale.
+func MechaGameJoinGameInstructions(locale string) string {
+	return i18n.T(locale, "turnsheet.mecha.join.instructions")
 }
 
 // MechaGameJoinGameScanData captures the fields extracted from a scanned mecha join turn sheet.
@@ -89,6 +115,8 @@ func (p *MechaGameJoinGameProcessor) GeneratePreviewData(ctx context.Context, l
 	}
 
 	turnSheetData := createMechaGameJoinGameData(gameRec, turnSheetCode)
+	turnSheetData.SquadSize = previewMechaGameSquadSize
+	turnSheetData.MechaGameRules = previewMechaGameRules()
 
 	if backgroundImage != nil && *backgroundImage != "" {
 		turnSheetData.BackgroundImage = backgroundImage
@@ -113,12 +141,12 @@ func (p *MechaGameJoinGameProcessor) GenerateTurnSheet(ctx context.Context, l lo
 	}
 
 	if data.TurnSheetInstructions == nil || strings.TrimSpace(*data.TurnSheetInstructions) == "" {
-		instruction := defaultMechaGameJoinGameInstructions
+		instruction := MechaGameJoinGameInstructions(data.TemplateLocale())
 		data.TurnSheetInstructions = &instruction
 	}
 
 	if data.TurnSheetTitle == nil || strings.TrimSpace(*data.TurnSheetTitle) == "" {
-		title := "Join Game"
+		title := i18n.T(data.TemplateLocale(), "turnsheet.join.title")
 		data.TurnSheetTitle = &title
 	}
 
@@ -31,6 +31,8 @@ func MechaGameJoinGameFixture() DevFixture {
 				},
 				GameDescription:          "Command a squad of powerful war mechs!",
 				AvailableDeliveryMethods: DeliveryMethods{Email: true},
+				SquadSize:                previewMechaGameSquadSize,
+				MechaGameRules:           previewMechaGameRules(),
 			}
 		},
 		NewProcessor: func(l logger.Logger, cfg config.Config) (TurnSheetProcessor, error) {
@@ -154,6 +154,133 @@ func TestMechaGameJoinGameProcessor_GenerateTurnSheet_PreFillsAccountEmail(t *te
 		"pre-filled email should be readonly")
 }
 
+func TestMechaGameJoinGameProcessor_GenerateTurnSheet_ShowsSquadSize(t *testing.T) {
+	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
+	cfg.TemplatesPath = "../../templates"
+
+	processor, err := turnsheet.NewMechaGameJoinGameProcessor(l, cfg)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		squadSize     int
+		expectSection bool
+	}{
+		{
+			name:          "squad size is shown when set",
+			squadSize:     6,
+			expectSection: true,
+		},
+		{
+			name:          "squad size section is omitted when not set",
+			squadSize:     0,
+			expectSection: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(&turnsheet.JoinGameData{
+				TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
+					GameName:      convert.Ptr("Steel Thunder"),
+					GameType:      convert.Ptr("mecha"),
+					TurnNumber:    convert.Ptr(0),
+					TurnSheetCode: convert.Ptr(generateTestJoinTurnSheetCode(t)),
+				},
+				GameDescription:          "Command a squad of war mechs!",
+				AvailableDeliveryMethods: turnsheet.DeliveryMethods{Email: true},
+				SquadSize:                tt.squadSize,
+			})
+			require.NoError(t, err)
+
+			html, err := processor.GenerateTurnSheet(context.Background(), l, turnsheet.DocumentFormatHTML, data)
+			require.NoError(t, err)
+
+			htmlStr := string(html)
+			require.Equal(t, tt.expectSection, strings.Contains(htmlStr, "You will command a squad of 6 mechs."),
+				"squad size text presence equals expected")
+			require.Equal(t, tt.expectSection, strings.Contains(htmlStr, "squad-size-section"),
+				"squad size section presence equals expected")
+		})
+	}
+}
+
+func TestMechaGameJoinGameProcessor_GenerateTurnSheet_ShowsRules(t *testing.T) {
+	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
+	cfg.TemplatesPath = "../../templates"
+
+	processor, err := turnsheet.NewMechaGameJoinGameProcessor(l, cfg)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		rules         *turnsheet.MechaGameRulesSummary
+		expectSection bool
+	}{
+		{
+			name: "rules are shown when set",
+			rules: &turnsheet.MechaGameRulesSummary{
+				SupplyPointsPerTurn:    3,
+				HeatDissipationDivisor: 4,
+				AutoRepairPercent:      10,
+				BaseHitChance:          40,
+				HitChancePerSkill:      6,
+				MaxHitChance:           90,
+				PilotSkillThresholds:   []int{0, 5, 12},
+
+				RoughMovementCost:         3,
+				UrbanMovementCost:         2,
+				WaterHeatDissipationBonus: 6,
+				ObstructedFireRange:       1,
+			},
+			expectSection: true,
+		},
+		{
+			name:          "rules section is omitted when not set",
+			expectSection: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(&turnsheet.JoinGameData{
+				TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
+					GameName:      convert.Ptr("Steel Thunder"),
+					GameType:      convert.Ptr("mecha"),
+					TurnNumber:    convert.Ptr(0),
+					TurnSheetCode: convert.Ptr(generateTestJoinTurnSheetCode(t)),
+				},
+				GameDescription:          "Command a squad of war mechs!",
+				AvailableDeliveryMethods: turnsheet.DeliveryMethods{Email: true},
+				MechaGameRules:           tt.rules,
+			})
+			require.NoError(t, err)
+
+			html, err := processor.GenerateTurnSheet(context.Background(), l, turnsheet.DocumentFormatHTML, data)
+			require.NoError(t, err)
+
+			htmlStr := string(html)
+			require.Equal(t, tt.expectSection, strings.Contains(htmlStr, "rules-section"),
+				"rules section presence equals expected")
+			if !tt.expectSection {
+				return
+			}
+			for _, text := range []string{
+				"Weapons hit 40% of the time, plus 6% per pilot skill level, to a maximum of 90%.",
+				"Mechs shed 1/4 of their heat capacity each turn.",
+				"Field repairs restore 10% of maximum armor each turn.",
+				"Your squad receives 3 supply points each turn.",
+				"Pilots advance through 2 skill levels, requiring 5, 12 total experience.",
+				"Entering rough ground costs 3 movement points and urban sectors cost 2; other terrain costs 1.",
+				"Forest and urban sectors block fire beyond 1 sector hops.",
+				"Mechs standing in water shed 6 extra heat each turn.",
+			} {
+				require.Contains(t, htmlStr, text, "rules section contains rule")
+			}
+		})
+	}
+}
+
 func TestMechaGameJoinGameProcessor_GenerateTurnSheet_WithDeliveryMethods(t *testing.T) {
 	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
 	cfg.TemplatesPath = "../../templates"
@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"gitlab.com/alienspaces/playbymail/core/convert"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/scanner"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
@@ -17,12 +19,16 @@ import (
 // OrdersScannedDataSchemaName is the filename of the JSON schema for orders scanned_data (under schema/turnsheet/mecha/).
 const OrdersScannedDataSchemaName = "orders.schema.json"
 
-const defaultOrdersInstructions = "For each mech in your squad, choose a sector to move to and/or a target to attack."
 const ordersTemplatePath = "turnsheet/mecha_game_orders.template"
 
 // DefaultOrdersInstructions returns the default instruction text for orders turn sheets.
 func DefaultOrdersInstructions() string {
-	return defaultOrdersInstructions
+	return OrdersInstructions(i18n.DefaultLocale)
+}
+
+// OrdersInstructions returns the instruction text for orders turn sheets in a locale.
+func OrdersInstructions(locale string) string {
+	return i18n.T(locale, "turnsheet.mecha.orders.instructions")
 }
 
 // MechWeaponEntry is a single weapon fitted to a mech.
@@ -91,12 +97,18 @@ type MechOrderEntry struct {
 	AmmoCapacity int `json:"ammo_capacity,omitempty"`
 	// ReachableSectors lists sectors this mech can reach within its speed budget.
 	ReachableSectors []SectorOption `json:"reachable_sectors,omitempty"`
+	// OverwatchSectors lists sectors this mech can cover on overwatch: its
+	// own sector and the sectors linked to it.
+	OverwatchSectors []SectorOption `json:"overwatch_sectors,omitempty"`
 }
 
 // SectorOption represents a sector available for movement.
 type SectorOption struct {
 	SectorInstanceID string `json:"sector_instance_id"`
 	SectorName       string `json:"sector_name"`
+	TerrainType      string `json:"terrain_type,omitempty"`
+	// MovementCost is the movement points the mech spends reaching the sector
+	MovementCost int `json:"movement_cost,omitempty"`
 }
 
 // EnemyMechOption represents an enemy mech that can be attacked.
@@ -106,6 +118,37 @@ type EnemyMechOption struct {
 	SectorName     string `json:"sector_name"`
 }
 
+// ContactOption represents an enemy mech the squad has lost sensor contact
+// with, at the sector it was last seen in.
+type ContactOption struct {
+	Callsign     string `json:"callsign"`
+	SectorName   string `json:"sector_name"`
+	LastSeenTurn int    `json:"last_seen_turn"`
+}
+
+// ObjectiveStanding is an objective sector and the squad that owns it.
+type ObjectiveStanding struct {
+	SectorName string `json:"sector_name"`
+	// VictoryPoints is what the objective scores its owner every turn
+	VictoryPoints int `json:"victory_points"`
+	// CaptureTurns is how many turns a squad must hold the objective
+	// uncontested to capture it
+	CaptureTurns int `json:"capture_turns"`
+	// OwnerName is the squad owning the objective, empty while uncaptured
+	OwnerName  string `json:"owner_name,omitempty"`
+	IsOwnSquad bool   `json:"is_own_squad,omitempty"`
+	// HeldTurns is how many turns the player's squad has held the objective
+	// uncontested towards capturing it; zero when it is not holding it
+	HeldTurns int `json:"held_turns,omitempty"`
+}
+
+// VictoryPointStanding is a squad's victory point total.
+type VictoryPointStanding struct {
+	SquadName     string `json:"squad_name"`
+	VictoryPoints int    `json:"victory_points"`
+	IsOwnSquad    bool   `json:"is_own_squad,omitempty"`
+}
+
 // OrdersData is the data model for a mecha orders turn sheet.
 type OrdersData struct {
 	TurnSheetTemplateData
@@ -119,8 +162,17 @@ type OrdersData struct {
 	// Available adjacent sectors for movement
 	AvailableSectors []SectorOption `json:"available_sectors,omitempty"`
 
-	// Visible enemy mechs that can be targeted
+	// Enemy mechs detected by the squad's sensors that can be targeted
 	EnemyMechs []EnemyMechOption `json:"enemy_mechs,omitempty"`
+
+	// Enemy mechs out of sensor range at their last known positions
+	LastKnownContacts []ContactOption `json:"last_known_contacts,omitempty"`
+
+	// Objective sectors with their victory point values and owners
+	Objectives []ObjectiveStanding `json:"objectives,omitempty"`
+
+	// Victory point totals of every squad, highest first
+	VictoryPointStandings []VictoryPointStanding `json:"victory_point_standings,omitempty"`
 }
 
 // OrdersScanData represents scanned orders data submitted by the player.
@@ -133,6 +185,15 @@ type ScannedMechOrder struct {
 	MechInstanceID             string `json:"mech_instance_id"`
 	MoveToSectorInstanceID     string `json:"move_to_sector_instance_id,omitempty"`
 	AttackTargetMechInstanceID string `json:"attack_target_mech_instance_id,omitempty"`
+	// HoldAfterMove puts the mech on overwatch over its destination once it
+	// has finished moving
+	HoldAfterMove bool `json:"hold_after_move,omitempty"`
+	// OverwatchSectorInstanceID is the sector the mech stays in place to cover
+	// with opportunity fire, either its own sector or a linked one
+	OverwatchSectorInstanceID string `json:"overwatch_sector_instance_id,omitempty"`
+	// InterceptTargetMechInstanceID is the enemy mech the mech moves to engage
+	// wherever that mech moves to this turn
+	InterceptTargetMechInstanceID string `json:"intercept_target_mech_instance_id,omitempty"`
 }
 
 // MechaGameOrdersProcessor implements the DocumentProcessor interface for mecha orders sheets.
@@ -163,7 +224,7 @@ func (p *MechaGameOrdersProcessor) GeneratePreviewData(ctx context.Context, l lo
 
 	turnNumber := 1
 	title := "Mech Orders"
-	instructions := defaultOrdersInstructions
+	instructions := DefaultOrdersInstructions()
 	data := OrdersData{
 		TurnSheetTemplateData: TurnSheetTemplateData{
 			GameName:              convert.Ptr(gameRec.Name),
@@ -209,8 +270,13 @@ func (p *MechaGameOrdersProcessor) GeneratePreviewData(ctx context.Context, l lo
 					{Name: "Chaingun", Damage: 2, HeatCost: 0, RangeBand: "short", SlotLocation: "right-arm"},
 				},
 				ReachableSectors: []SectorOption{
-					{SectorInstanceID: "preview-sector-1", SectorName: "Northern Ridge"},
-					{SectorInstanceID: "preview-sector-2", SectorName: "Southern Flats"},
+					{SectorInstanceID: "preview-sector-1", SectorName: "Northern Ridge", TerrainType: "rough", MovementCost: 2},
+					{SectorInstanceID: "preview-sector-2", SectorName: "Southern Flats", TerrainType: "open", MovementCost: 1},
+				},
+				OverwatchSectors: []SectorOption{
+					{SectorInstanceID: "preview-sector-0", SectorName: "Central Wastes", TerrainType: "open"},
+					{SectorInstanceID: "preview-sector-1", SectorName: "Northern Ridge", TerrainType: "rough"},
+					{SectorInstanceID: "preview-sector-2", SectorName: "Southern Flats", TerrainType: "open"},
 				},
 			},
 			{
@@ -240,18 +306,26 @@ func (p *MechaGameOrdersProcessor) GeneratePreviewData(ctx context.Context, l lo
 				AmmoRemaining: 6,
 				AmmoCapacity:  10,
 				ReachableSectors: []SectorOption{
-					{SectorInstanceID: "preview-sector-1", SectorName: "Northern Ridge"},
-					{SectorInstanceID: "preview-sector-2", SectorName: "Southern Flats"},
+					{SectorInstanceID: "preview-sector-1", SectorName: "Northern Ridge", TerrainType: "rough", MovementCost: 2},
+					{SectorInstanceID: "preview-sector-2", SectorName: "Southern Flats", TerrainType: "open", MovementCost: 1},
+				},
+				OverwatchSectors: []SectorOption{
+					{SectorInstanceID: "preview-sector-0", SectorName: "Central Wastes", TerrainType: "open"},
+					{SectorInstanceID: "preview-sector-1", SectorName: "Northern Ridge", TerrainType: "rough"},
+					{SectorInstanceID: "preview-sector-2", SectorName: "Southern Flats", TerrainType: "open"},
 				},
 			},
 		},
 		AvailableSectors: []SectorOption{
-			{SectorInstanceID: "preview-sector-1", SectorName: "Northern Ridge"},
-			{SectorInstanceID: "preview-sector-2", SectorName: "Southern Flats"},
+			{SectorInstanceID: "preview-sector-1", SectorName: "Northern Ridge", TerrainType: "rough", MovementCost: 2},
+			{SectorInstanceID: "preview-sector-2", SectorName: "Southern Flats", TerrainType: "open", MovementCost: 1},
 		},
 		EnemyMechs: []EnemyMechOption{
 			{MechInstanceID: "enemy-mech-1", Callsign: "Stalker", SectorName: "Northern Ridge"},
 		},
+		LastKnownContacts: []ContactOption{
+			{Callsign: "Predator", SectorName: "Southern Flats", LastSeenTurn: 2},
+		},
 	}
 
 	if backgroundImage != nil {
@@ -277,12 +351,12 @@ func (p *MechaGameOrdersProcessor) GenerateTurnSheet(ctx context.Context, l logg
 	}
 
 	if data.TurnSheetInstructions == nil || strings.TrimSpace(*data.TurnSheetInstructions) == "" {
-		instructions := defaultOrdersInstructions
+		instructions := OrdersInstructions(data.TemplateLocale())
 		data.TurnSheetInstructions = &instructions
 	}
 
 	if data.TurnSheetTitle == nil || strings.TrimSpace(*data.TurnSheetTitle) == "" {
-		title := "Mech Orders"
+		title := i18n.T(data.TemplateLocale(), "turnsheet.mecha.orders.title")
 		data.TurnSheetTitle = &title
 	}
 
@@ -348,7 +422,7 @@ func (p *MechaGameOrdersProcessor) resolveOrdersTemplateData(sheetData []byte) *
 
 func defaultOrdersTemplateData() *OrdersData {
 	title := "Mech Orders"
-	instructions := defaultOrdersInstructions
+	instructions := DefaultOrdersInstructions()
 	return &OrdersData{
 		TurnSheetTemplateData: TurnSheetTemplateData{
 			TurnSheetTitle:        &title,
@@ -361,9 +435,12 @@ func ordersExpectedSchema() map[string]any {
 	return map[string]any{
 		"mech_orders": []map[string]any{
 			{
-				"mech_instance_id":               "",
-				"move_to_sector_instance_id":     "",
-				"attack_target_mech_instance_id": "",
+				"mech_instance_id":                  "",
+				"move_to_sector_instance_id":        "",
+				"attack_target_mech_instance_id":    "",
+				"hold_after_move":                   false,
+				"overwatch_sector_instance_id":      "",
+				"intercept_target_mech_instance_id": "",
 			},
 		},
 	}
@@ -379,6 +456,9 @@ Each entry in "mech_orders" must contain:
 - "mech_instance_id": the mech ID exactly as printed on the form
 - "move_to_sector_instance_id": the sector instance ID the player chose to move to, or empty string if staying in place
 - "attack_target_mech_instance_id": the enemy mech instance ID the player chose to attack, or empty string if no attack
+- "hold_after_move": true if the player ticked the hold box to keep the mech on overwatch at its destination, otherwise false
+- "overwatch_sector_instance_id": the sector instance ID the player chose for the mech to overwatch, or empty string if none
+- "intercept_target_mech_instance_id": the enemy mech instance ID the player chose for the mech to intercept, or empty string if none
 
 Return empty strings for fields the player left blank. Do not invent or guess values.`
 }
@@ -409,5 +489,137 @@ func normalizeOrdersScanData(data *OrdersScanData) {
 		data.MechOrders[i].MechInstanceID = strings.TrimSpace(data.MechOrders[i].MechInstanceID)
 		data.MechOrders[i].MoveToSectorInstanceID = strings.TrimSpace(data.MechOrders[i].MoveToSectorInstanceID)
 		data.MechOrders[i].AttackTargetMechInstanceID = strings.TrimSpace(data.MechOrders[i].AttackTargetMechInstanceID)
+		data.MechOrders[i].OverwatchSectorInstanceID = strings.TrimSpace(data.MechOrders[i].OverwatchSectorInstanceID)
+		data.MechOrders[i].InterceptTargetMechInstanceID = strings.TrimSpace(data.MechOrders[i].InterceptTargetMechInstanceID)
 	}
 }
+
+var mechOrderActionRe = regexp.MustCompile(`(?i)(?:^|\s+)(move|attack|hold|overwatch|intercept)\b`)
+
+// TextOrderKeywords returns the plain-text order keywords accepted by mecha orders sheets
+func (p *MechaGameOrdersProcessor) TextOrderKeywords() []string {
+	return []string{"MECH"}
+}
+
+// ParseTextOrders converts "MECH <callsign> MOVE <sector> ATTACK <enemy callsign>" orders into
+// mecha orders scan data. Either action may be omitted, and "MECH <callsign> HOLD" keeps a
+// mech in place without attacking. HOLD after MOVE holds the destination on overwatch,
+// "OVERWATCH <sector>" covers a sector without moving and "INTERCEPT <enemy callsign>"
+// engages an enemy mech wherever it moves.
+func (p *MechaGameOrdersProcessor) ParseTextOrders(ctx context.Context, l logger.Logger, sheetData []byte, orders []TextOrder) ([]byte, error) {
+	l = l.WithFunctionContext("MechaGameOrdersProcessor/ParseTextOrders")
+
+	var data OrdersData
+	if err := json.Unmarshal(sheetData, &data); err != nil {
+		l.Warn("failed to unmarshal sheet data >%v<", err)
+		return nil, fmt.Errorf("failed to parse sheet data: %w", err)
+	}
+
+	mechs := map[string]*MechOrderEntry{}
+	mechIDs := map[string]string{}
+	for i := range data.SquadMechs {
+		mech := &data.SquadMechs[i]
+		mechs[mech.MechInstanceID] = mech
+		mechIDs[mech.MechCallsign] = mech.MechInstanceID
+	}
+
+	enemyIDs := map[string]string{}
+	for _, enemy := range data.EnemyMechs {
+		enemyIDs[enemy.Callsign] = enemy.MechInstanceID
+	}
+
+	var scanData OrdersScanData
+	ordered := map[string]bool{}
+
+	for _, order := range orders {
+		actions := mechOrderActionRe.FindAllStringSubmatchIndex(order.Args, -1)
+		if len(actions) == 0 || actions[0][0] == 0 {
+			return nil, fmt.Errorf("line %d: expected MECH <callsign> followed by MOVE, ATTACK, HOLD, OVERWATCH or INTERCEPT", order.Line)
+		}
+
+		callsign := order.Args[:actions[0][0]]
+		mechID, ok := matchTextOrderName(callsign, mechIDs)
+		if !ok {
+			return nil, fmt.Errorf("line %d: %q is not one of your mechs %s", order.Line, strings.TrimSpace(callsign), textOrderOptionNames(mechIDs))
+		}
+		if ordered[mechID] {
+			return nil, fmt.Errorf("line %d: %s already has orders", order.Line, strings.TrimSpace(callsign))
+		}
+		ordered[mechID] = true
+
+		mechOrder := ScannedMechOrder{MechInstanceID: mechID}
+		hold := false
+
+		for i, action := range actions {
+			end := len(order.Args)
+			if i+1 < len(actions) {
+				end = actions[i+1][0]
+			}
+			verb := strings.ToUpper(order.Args[action[2]:action[3]])
+			arg := strings.TrimSpace(order.Args[action[1]:end])
+
+			switch verb {
+			case "MOVE":
+				sectors := map[string]string{}
+				sectorOptions := mechs[mechID].ReachableSectors
+				if len(sectorOptions) == 0 {
+					sectorOptions = data.AvailableSectors
+				}
+				for _, sector := range sectorOptions {
+					sectors[sector.SectorName] = sector.SectorInstanceID
+				}
+				sectorID, ok := matchTextOrderName(arg, sectors)
+				if !ok {
+					return nil, fmt.Errorf("line %d: %q is not a sector %s can move to %s", order.Line, arg, strings.TrimSpace(callsign), textOrderOptionNames(sectors))
+				}
+				mechOrder.MoveToSectorInstanceID = sectorID
+			case "ATTACK":
+				targetID, ok := matchTextOrderName(arg, enemyIDs)
+				if !ok {
+					return nil, fmt.Errorf("line %d: %q is not a visible enemy mech %s", order.Line, arg, textOrderOptionNames(enemyIDs))
+				}
+				mechOrder.AttackTargetMechInstanceID = targetID
+			case "HOLD":
+				if arg != "" {
+					return nil, fmt.Errorf("line %d: HOLD does not take a sector or target", order.Line)
+				}
+				hold = true
+			case "OVERWATCH":
+				sectors := map[string]string{}
+				for _, sector := range mechs[mechID].OverwatchSectors {
+					sectors[sector.SectorName] = sector.SectorInstanceID
+				}
+				sectorID, ok := matchTextOrderName(arg, sectors)
+				if !ok {
+					return nil, fmt.Errorf("line %d: %q is not a sector %s can overwatch %s", order.Line, arg, strings.TrimSpace(callsign), textOrderOptionNames(sectors))
+				}
+				mechOrder.OverwatchSectorInstanceID = sectorID
+			case "INTERCEPT":
+				targetID, ok := matchTextOrderName(arg, enemyIDs)
+				if !ok {
+					return nil, fmt.Errorf("line %d: %q is not a visible enemy mech %s", order.Line, arg, textOrderOptionNames(enemyIDs))
+				}
+				mechOrder.InterceptTargetMechInstanceID = targetID
+			}
+		}
+
+		if hold {
+			// HOLD on its own keeps the mech in place; after MOVE it holds
+			// the destination on overwatch
+			if mechOrder.MoveToSectorInstanceID == "" && len(actions) > 1 {
+				return nil, fmt.Errorf("line %d: HOLD can only be combined with MOVE", order.Line)
+			}
+			mechOrder.HoldAfterMove = mechOrder.MoveToSectorInstanceID != ""
+		}
+		if mechOrder.OverwatchSectorInstanceID != "" && mechOrder.MoveToSectorInstanceID != "" {
+			return nil, fmt.Errorf("line %d: OVERWATCH cannot be combined with MOVE, use MOVE <sector> HOLD to overwatch a destination", order.Line)
+		}
+		if mechOrder.InterceptTargetMechInstanceID != "" && (mechOrder.MoveToSectorInstanceID != "" || mechOrder.OverwatchSectorInstanceID != "") {
+			return nil, fmt.Errorf("line %d: INTERCEPT cannot be combined with MOVE or OVERWATCH", order.Line)
+		}
+
+		scanData.MechOrders = append(scanData.MechOrders, mechOrder)
+	}
+
+	return json.Marshal(scanData)
+}
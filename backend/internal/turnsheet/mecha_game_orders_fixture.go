@@ -44,9 +44,14 @@ func MechaGameOrdersFixture() DevFixture {
 						{Name: "Chaingun", Damage: 2, HeatCost: 0, RangeBand: "short", SlotLocation: "right-arm"},
 					},
 					ReachableSectors: []SectorOption{
-						{SectorInstanceID: "sector-1", SectorName: "Northern Ridge"},
-						{SectorInstanceID: "sector-2", SectorName: "Southern Flats"},
-						{SectorInstanceID: "sector-3", SectorName: "Eastern Pass"},
+						{SectorInstanceID: "sector-1", SectorName: "Northern Ridge", TerrainType: "rough", MovementCost: 2},
+						{SectorInstanceID: "sector-2", SectorName: "Southern Flats", TerrainType: "open", MovementCost: 1},
+						{SectorInstanceID: "sector-3", SectorName: "Eastern Pass", TerrainType: "forest", MovementCost: 1},
+					},
+					OverwatchSectors: []SectorOption{
+						{SectorInstanceID: "sector-0", SectorName: "Central Wastes", TerrainType: "open"},
+						{SectorInstanceID: "sector-1", SectorName: "Northern Ridge", TerrainType: "rough"},
+						{SectorInstanceID: "sector-2", SectorName: "Southern Flats", TerrainType: "open"},
 					},
 				},
 				{
@@ -64,8 +69,13 @@ func MechaGameOrdersFixture() DevFixture {
 					},
 					AmmoRemaining: 6, AmmoCapacity: 10,
 					ReachableSectors: []SectorOption{
-						{SectorInstanceID: "sector-1", SectorName: "Northern Ridge"},
-						{SectorInstanceID: "sector-2", SectorName: "Southern Flats"},
+						{SectorInstanceID: "sector-1", SectorName: "Northern Ridge", TerrainType: "rough", MovementCost: 2},
+						{SectorInstanceID: "sector-2", SectorName: "Southern Flats", TerrainType: "open", MovementCost: 1},
+					},
+					OverwatchSectors: []SectorOption{
+						{SectorInstanceID: "sector-0", SectorName: "Central Wastes", TerrainType: "open"},
+						{SectorInstanceID: "sector-1", SectorName: "Northern Ridge", TerrainType: "rough"},
+						{SectorInstanceID: "sector-2", SectorName: "Southern Flats", TerrainType: "open"},
 					},
 				},
 				{
@@ -81,10 +91,15 @@ func MechaGameOrdersFixture() DevFixture {
 						{Name: "Heat Sink", EffectKind: "heat_sink", Magnitude: 4, HeatCost: 0, MountSize: "small", SlotLocation: "right-torso"},
 					},
 					ReachableSectors: []SectorOption{
-						{SectorInstanceID: "sector-1", SectorName: "Northern Ridge"},
-						{SectorInstanceID: "sector-2", SectorName: "Southern Flats"},
-						{SectorInstanceID: "sector-3", SectorName: "Eastern Pass"},
-						{SectorInstanceID: "sector-4", SectorName: "Ridge Overlook"},
+						{SectorInstanceID: "sector-1", SectorName: "Northern Ridge", TerrainType: "rough", MovementCost: 2},
+						{SectorInstanceID: "sector-2", SectorName: "Southern Flats", TerrainType: "open", MovementCost: 1},
+						{SectorInstanceID: "sector-3", SectorName: "Eastern Pass", TerrainType: "forest", MovementCost: 1},
+						{SectorInstanceID: "sector-4", SectorName: "Ridge Overlook", TerrainType: "urban", MovementCost: 2},
+					},
+					OverwatchSectors: []SectorOption{
+						{SectorInstanceID: "sector-1", SectorName: "Northern Ridge", TerrainType: "rough"},
+						{SectorInstanceID: "sector-0", SectorName: "Central Wastes", TerrainType: "open"},
+						{SectorInstanceID: "sector-4", SectorName: "Ridge Overlook", TerrainType: "urban"},
 					},
 				},
 				{
@@ -95,15 +110,27 @@ func MechaGameOrdersFixture() DevFixture {
 				},
 			},
 			AvailableSectors: []SectorOption{
-				{SectorInstanceID: "sector-1", SectorName: "Northern Ridge"},
-				{SectorInstanceID: "sector-2", SectorName: "Southern Flats"},
-				{SectorInstanceID: "sector-3", SectorName: "Eastern Pass"},
-				{SectorInstanceID: "sector-4", SectorName: "Ridge Overlook"},
+				{SectorInstanceID: "sector-1", SectorName: "Northern Ridge", TerrainType: "rough", MovementCost: 2},
+				{SectorInstanceID: "sector-2", SectorName: "Southern Flats", TerrainType: "open", MovementCost: 1},
+				{SectorInstanceID: "sector-3", SectorName: "Eastern Pass", TerrainType: "forest", MovementCost: 1},
+				{SectorInstanceID: "sector-4", SectorName: "Ridge Overlook", TerrainType: "urban", MovementCost: 2},
 			},
 			EnemyMechs: []EnemyMechOption{
 				{MechInstanceID: "enemy-1", Callsign: "Stalker", SectorName: "Northern Ridge"},
 				{MechInstanceID: "enemy-2", Callsign: "Predator", SectorName: "Southern Flats"},
 			},
+			LastKnownContacts: []ContactOption{
+				{Callsign: "Ghost", SectorName: "Eastern Pass", LastSeenTurn: 1},
+			},
+			Objectives: []ObjectiveStanding{
+				{SectorName: "Ridge Overlook", VictoryPoints: 3, CaptureTurns: 2, OwnerName: "Ironclad Company"},
+				{SectorName: "Central Wastes", VictoryPoints: 1, CaptureTurns: 1, IsOwnSquad: true},
+				{SectorName: "Eastern Pass", VictoryPoints: 2, CaptureTurns: 2, HeldTurns: 1},
+			},
+			VictoryPointStandings: []VictoryPointStanding{
+				{SquadName: "Ironclad Company", VictoryPoints: 9},
+				{SquadName: "Marcus", VictoryPoints: 4, IsOwnSquad: true},
+			},
 		}
 		},
 		NewProcessor: func(l logger.Logger, cfg config.Config) (TurnSheetProcessor, error) {
@@ -169,6 +169,113 @@ func TestMechaGameOrdersProcessor_GenerateTurnSheet_ContainsMechStats(t *testing
 	require.Contains(t, htmlStr, "Ridge Overlook", "should render reachable sector option")
 }
 
+func TestMechaGameOrdersProcessor_GenerateTurnSheet_ContainsObjectiveStandings(t *testing.T) {
+	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
+	cfg.TemplatesPath = "../../templates"
+
+	processor, err := turnsheet.NewMechaGameOrdersProcessor(l, cfg)
+	require.NoError(t, err)
+
+	data := &turnsheet.OrdersData{
+		TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
+			GameName:      convert.Ptr("Steel Thunder"),
+			GameType:      convert.Ptr("mecha"),
+			TurnSheetCode: convert.Ptr(generateTestTurnSheetCode(t)),
+			TurnNumber:    convert.Ptr(4),
+		},
+		SquadName: "Alpha Squad",
+		Objectives: []turnsheet.ObjectiveStanding{
+			{SectorName: "Ridge Overlook", VictoryPoints: 3, CaptureTurns: 2, OwnerName: "Ironclad Company"},
+			{SectorName: "Central Wastes", VictoryPoints: 1, CaptureTurns: 1, IsOwnSquad: true},
+			{SectorName: "Eastern Pass", VictoryPoints: 2, CaptureTurns: 3, HeldTurns: 2},
+		},
+		VictoryPointStandings: []turnsheet.VictoryPointStanding{
+			{SquadName: "Ironclad Company", VictoryPoints: 9},
+			{SquadName: "Marcus", VictoryPoints: 4, IsOwnSquad: true},
+		},
+	}
+
+	sheetData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	html, err := processor.GenerateTurnSheet(context.Background(), l, turnsheet.DocumentFormatHTML, sheetData)
+	require.NoError(t, err)
+
+	htmlStr := string(html)
+	require.Contains(t, htmlStr, "Objectives", "should render objectives panel")
+	require.Contains(t, htmlStr, "3 VP per turn, captured after 2 turns uncontested", "should render objective value and capture rule")
+	require.Contains(t, htmlStr, "captured after 1 turn uncontested", "should render singular capture turn")
+	require.Contains(t, htmlStr, "held by Ironclad Company", "should render enemy owned objective")
+	require.Contains(t, htmlStr, "held by your squad", "should render own objective")
+	require.Contains(t, htmlStr, "uncaptured", "should render uncaptured objective")
+	require.Contains(t, htmlStr, "held 2 of 3", "should render capture progress")
+	require.Contains(t, htmlStr, "Victory Point Standings", "should render standings")
+	require.Contains(t, htmlStr, "Marcus (your squad)", "should highlight own squad standing")
+	require.Contains(t, htmlStr, "9 VP", "should render victory point totals")
+
+	data.Locale = convert.Ptr("es")
+	sheetData, err = json.Marshal(data)
+	require.NoError(t, err)
+
+	html, err = processor.GenerateTurnSheet(context.Background(), l, turnsheet.DocumentFormatHTML, sheetData)
+	require.NoError(t, err)
+
+	htmlStr = string(html)
+	require.Contains(t, htmlStr, "3 PV por turno, se captura tras 2 turnos sin oposición", "should render objective value in the sheet locale")
+	require.Contains(t, htmlStr, "se captura tras 1 turno sin oposición", "should render singular capture turn in the sheet locale")
+	require.Contains(t, htmlStr, "en poder de Ironclad Company", "should render enemy owned objective in the sheet locale")
+	require.Contains(t, htmlStr, "mantenido 2 de 3", "should render capture progress in the sheet locale")
+	require.Contains(t, htmlStr, "Marcus (tu escuadra)", "should highlight own squad standing in the sheet locale")
+	require.NotContains(t, htmlStr, "Victory Point Standings", "should not render English objective content")
+}
+
+func TestMechaGameOrdersProcessor_GenerateTurnSheet_Localised(t *testing.T) {
+	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
+	cfg.TemplatesPath = "../../templates"
+
+	processor, err := turnsheet.NewMechaGameOrdersProcessor(l, cfg)
+	require.NoError(t, err)
+
+	data := &turnsheet.OrdersData{
+		TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
+			GameName:      convert.Ptr("Steel Thunder"),
+			GameType:      convert.Ptr("mecha"),
+			TurnSheetCode: convert.Ptr(generateTestTurnSheetCode(t)),
+			TurnNumber:    convert.Ptr(2),
+			Locale:        convert.Ptr("es"),
+		},
+		SquadName: "Alpha Squad",
+		SquadMechs: []turnsheet.MechOrderEntry{
+			{
+				MechInstanceID:    "mech-1",
+				MechCallsign:      "Hammer",
+				MechStatus:        "damaged",
+				CurrentSectorName: "Central Wastes",
+				ChassisName:       "Scout",
+				ChassisClass:      "light",
+				CurrentArmor:      40,
+				MaxArmor:          72,
+				ReachableSectors: []turnsheet.SectorOption{
+					{SectorInstanceID: "sector-ridge", SectorName: "Ridge Overlook", TerrainType: "rough", MovementCost: 2},
+				},
+			},
+		},
+	}
+
+	sheetData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	html, err := processor.GenerateTurnSheet(context.Background(), l, turnsheet.DocumentFormatHTML, sheetData)
+	require.NoError(t, err)
+
+	htmlStr := string(html)
+	require.Contains(t, htmlStr, "Órdenes de mechs", "should render the default title in the sheet locale")
+	require.Contains(t, htmlStr, "Escuadra: Alpha Squad", "should render the squad label in the sheet locale")
+	require.Contains(t, htmlStr, "dañado", "should render the mech status in the sheet locale")
+	require.Contains(t, htmlStr, "Ridge Overlook (accidentado, 2 PM)", "should render terrain and movement cost in the sheet locale")
+	require.NotContains(t, htmlStr, "Move To (Sector)", "should not render English order labels")
+}
+
 func TestMechaGameOrdersProcessor_ScanTurnSheet_EmptyImageReturnsError(t *testing.T) {
 	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
 	cfg.TemplatesPath = "../../templates"
@@ -8,6 +8,7 @@ import (
 
 	"gitlab.com/alienspaces/playbymail/core/convert"
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/scanner"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
@@ -19,12 +20,16 @@ var _ DocumentProcessor = (*MechaGameSquadManagementProcessor)(nil)
 
 const SquadManagementScannedDataSchemaName = "squad_management.schema.json"
 
-const defaultManagementInstructions = "Manage your squad: repair structure, swap weapons, or schedule refits. Actions consume supply points and take effect next turn."
 const managementTemplatePath = "turnsheet/mecha_game_squad_management.template"
 
 // DefaultManagementInstructions returns the default instruction text for management sheets.
 func DefaultManagementInstructions() string {
-	return defaultManagementInstructions
+	return ManagementInstructions(i18n.DefaultLocale)
+}
+
+// ManagementInstructions returns the instruction text for management sheets in a locale.
+func ManagementInstructions(locale string) string {
+	return i18n.T(locale, "turnsheet.mecha.squad_management.instructions")
 }
 
 // SquadManagementData is the template data for the squad management sheet.
@@ -34,6 +39,20 @@ type SquadManagementData struct {
 	SupplyPoints  int                   `json:"supply_points"`
 	Mechs         []ManagementMechEntry `json:"mechs"`
 	WeaponCatalog []CatalogWeapon       `json:"weapon_catalog"`
+	// CampaignName and CampaignBattle are set when the game instance is a
+	// battle in a campaign. CampaignBattle is the battle's sequence number.
+	CampaignName   string `json:"campaign_name,omitempty"`
+	CampaignBattle int    `json:"campaign_battle,omitempty"`
+	// Salvage lists weapons the squad has salvaged in earlier campaign
+	// battles. Installing a salvaged weapon costs no supply points.
+	Salvage []SalvageWeapon `json:"salvage,omitempty"`
+}
+
+// SalvageWeapon is a salvaged weapon held by a campaign squad.
+type SalvageWeapon struct {
+	WeaponID string `json:"weapon_id"`
+	Name     string `json:"name"`
+	Quantity int    `json:"quantity"`
 }
 
 // ManagementMechEntry holds per-mech data for the management sheet.
@@ -229,7 +248,7 @@ func (p *MechaGameSquadManagementProcessor) GeneratePreviewData(ctx context.Cont
 
 	turnNumber := 1
 	title := "Squad Management"
-	instructions := defaultManagementInstructions
+	instructions := DefaultManagementInstructions()
 	data := SquadManagementData{
 		TurnSheetTemplateData: TurnSheetTemplateData{
 			GameName:              convert.Ptr(gameRec.Name),
@@ -31,8 +31,14 @@ func MechaGameSquadManagementFixture() DevFixture {
 						{Category: TurnEventCategorySystem, Icon: TurnEventIconSystem, Message: "Squad received 2 supply points (6 total)."},
 					},
 				},
-			SquadName:    "Alpha Squad",
-			SupplyPoints: 6,
+			SquadName:      "Alpha Squad",
+			SupplyPoints:   6,
+			CampaignName:   "Iron Coast",
+			CampaignBattle: 2,
+			Salvage: []SalvageWeapon{
+				{WeaponID: "cat-3", Name: "Pulse Cannon", Quantity: 1},
+				{WeaponID: "cat-4", Name: "Rocket Pack", Quantity: 2},
+			},
 			Mechs: []ManagementMechEntry{
 				{
 					MechInstanceID: "mech-1", Callsign: "Hammer", ChassisName: "Scout", ChassisClass: "light",
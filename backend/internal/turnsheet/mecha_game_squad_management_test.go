@@ -177,3 +177,69 @@ func TestMechaGameSquadManagementProcessor_ScanTurnSheet_EmptyImageReturnsError(
 	require.Error(t, err)
 	require.Nil(t, result)
 }
+
+func TestMechaGameSquadManagementProcessor_GenerateTurnSheet_ContainsCampaignSalvage(t *testing.T) {
+	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
+	cfg.TemplatesPath = "../../templates"
+
+	processor, err := turnsheet.NewMechaGameSquadManagementProcessor(l, cfg)
+	require.NoError(t, err)
+
+	data := &turnsheet.SquadManagementData{
+		TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
+			GameName:      convert.Ptr("Steel Thunder"),
+			GameType:      convert.Ptr("mecha"),
+			TurnSheetCode: convert.Ptr(generateTestTurnSheetCode(t)),
+			TurnNumber:    convert.Ptr(1),
+		},
+		SquadName:      "Alpha Squad",
+		SupplyPoints:   4,
+		CampaignName:   "Iron Coast",
+		CampaignBattle: 3,
+		Salvage: []turnsheet.SalvageWeapon{
+			{WeaponID: "cat-2", Name: "Rocket Pack", Quantity: 2},
+		},
+		Mechs: []turnsheet.ManagementMechEntry{
+			{
+				MechInstanceID:   "mech-1",
+				Callsign:         "Hammer",
+				IsAtDepot:        true,
+				CurrentStructure: 32,
+				MaxStructure:     32,
+				Weapons: []turnsheet.MechWeaponSlot{
+					{SlotLocation: "left-arm", CurrentWeaponID: "cat-1", CurrentWeaponName: "Light Pulse Cannon"},
+				},
+			},
+		},
+		WeaponCatalog: []turnsheet.CatalogWeapon{
+			{WeaponID: "cat-1", Name: "Light Pulse Cannon", Damage: 3, HeatCost: 1, RangeBand: "short"},
+			{WeaponID: "cat-2", Name: "Rocket Pack", Damage: 8, HeatCost: 3, RangeBand: "short", AmmoCapacity: 2},
+		},
+	}
+
+	sheetData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	html, err := processor.GenerateTurnSheet(context.Background(), l, turnsheet.DocumentFormatHTML, sheetData)
+	require.NoError(t, err)
+
+	htmlStr := string(html)
+	require.Contains(t, htmlStr, "Iron Coast", "should render the campaign name")
+	require.Contains(t, htmlStr, "Battle 3", "should render the campaign battle number")
+	require.Contains(t, htmlStr, "Salvage", "should render the salvage section")
+	require.Contains(t, htmlStr, "salvaged weapons free", "should note that salvaged weapons cost no supply points")
+
+	data.Locale = convert.Ptr("es")
+	sheetData, err = json.Marshal(data)
+	require.NoError(t, err)
+
+	html, err = processor.GenerateTurnSheet(context.Background(), l, turnsheet.DocumentFormatHTML, sheetData)
+	require.NoError(t, err)
+
+	htmlStr = string(html)
+	require.Contains(t, htmlStr, "Campaña:", "should render the campaign label in the sheet locale")
+	require.Contains(t, htmlStr, "Batalla 3", "should render the campaign battle in the sheet locale")
+	require.Contains(t, htmlStr, "Material recuperado", "should render the salvage section in the sheet locale")
+	require.Contains(t, htmlStr, "armas recuperadas gratis", "should render the salvage loadout note in the sheet locale")
+	require.NotContains(t, htmlStr, "Battle 3", "should not render English campaign content")
+}
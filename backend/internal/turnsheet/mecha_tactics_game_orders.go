@@ -0,0 +1,490 @@
+package turnsheet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"gitlab.com/alienspaces/playbymail/core/convert"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/scanner"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+	"gitlab.com/alienspaces/playbymail/internal/utils/turnsheetutil"
+)
+
+const mechaTacticsGameOrdersTemplatePath = "turnsheet/mecha_tactics_game_orders.template"
+
+// MechaTacticsFacingOptions are the facing labels printed on mecha tactics
+// orders sheets, clockwise from north.
+var MechaTacticsFacingOptions = []string{"N", "NE", "SE", "S", "SW", "NW"}
+
+// Hex map layout dimensions, in pixels, for the rendered orders sheet.
+const (
+	mechaTacticsHexMapHexWidth  = 48
+	mechaTacticsHexMapHexHeight = 42
+)
+
+// DefaultMechaTacticsGameOrdersInstructions returns the default instruction text for mecha tactics orders turn sheets.
+func DefaultMechaTacticsGameOrdersInstructions() string {
+	return MechaTacticsGameOrdersInstructions(i18n.DefaultLocale)
+}
+
+// MechaTacticsGameOrdersInstructions returns the instruction text for mecha tactics orders turn sheets in a locale.
+func MechaTacticsGameOrdersInstructions(locale string) string {
+	return i18n.T(locale, "turnsheet.mecha_tactics.orders.instructions")
+}
+
+// MechaTacticsWeaponEntry is a single weapon fitted to a mecha tactics mech.
+type MechaTacticsWeaponEntry struct {
+	WeaponID     string `json:"weapon_id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Damage       int    `json:"damage"`
+	HeatCost     int    `json:"heat_cost"`
+	RangeBand    string `json:"range_band,omitempty"`
+	MountSize    string `json:"mount_size,omitempty"`
+	SlotLocation string `json:"slot_location,omitempty"`
+}
+
+// MechaTacticsHexOption is a single hex on the battlefield map printed on the orders sheet.
+type MechaTacticsHexOption struct {
+	HexID             string `json:"hex_id"`
+	Column            int    `json:"column"`
+	Row               int    `json:"row"`
+	Name              string `json:"name,omitempty"`
+	TerrainName       string `json:"terrain_name,omitempty"`
+	MovementPointCost int    `json:"movement_point_cost"`
+	CoverModifier     int    `json:"cover_modifier"`
+	Elevation         int    `json:"elevation"`
+	IsDepot           bool   `json:"is_depot"`
+	IsCurrent         bool   `json:"is_current"`
+	// IsReachable is true when the mech can end its move in this hex this
+	// turn. Only reachable hexes are labelled on the printed map.
+	IsReachable bool `json:"is_reachable"`
+	// MovementPointsToReach is the cheapest MP cost of reaching this hex.
+	MovementPointsToReach int `json:"movement_points_to_reach,omitempty"`
+	// Occupants lists the callsigns of mechs standing in this hex.
+	Occupants []string `json:"occupants,omitempty"`
+	// Left and Top position the hex on the rendered map in pixels. They are
+	// populated by LayoutMechaTacticsHexMap.
+	Left int `json:"left"`
+	Top  int `json:"top"`
+}
+
+// Label returns the "column,row" coordinate label printed for a hex.
+func (h MechaTacticsHexOption) Label() string {
+	return fmt.Sprintf("%d,%d", h.Column, h.Row)
+}
+
+// MechaTacticsEnemyMechOption is an enemy mech that can be declared as an attack target.
+type MechaTacticsEnemyMechOption struct {
+	MechInstanceID string `json:"mech_instance_id"`
+	Callsign       string `json:"callsign"`
+	ChassisName    string `json:"chassis_name,omitempty"`
+	HexLabel       string `json:"hex_label,omitempty"`
+	Facing         string `json:"facing,omitempty"`
+	// Distance is the current hex distance from the ordered mech. Range is
+	// checked again after movement when the attack is resolved.
+	Distance int `json:"distance"`
+}
+
+// MechaTacticsOrdersData is the data model for a mecha tactics orders turn sheet.
+// One sheet is issued per mech.
+type MechaTacticsOrdersData struct {
+	TurnSheetTemplateData
+
+	MechInstanceID   string                    `json:"mech_instance_id"`
+	MechCallsign     string                    `json:"mech_callsign,omitempty"`
+	MechStatus       string                    `json:"mech_status,omitempty"`
+	ChassisName      string                    `json:"chassis_name,omitempty"`
+	ChassisClass     string                    `json:"chassis_class,omitempty"`
+	CurrentArmor     int                       `json:"current_armor"`
+	MaxArmor         int                       `json:"max_armor"`
+	CurrentStructure int                       `json:"current_structure"`
+	MaxStructure     int                       `json:"max_structure"`
+	CurrentHeat      int                       `json:"current_heat"`
+	HeatCapacity     int                       `json:"heat_capacity"`
+	Speed            int                       `json:"speed"`
+	PilotSkill       int                       `json:"pilot_skill"`
+	Facing           string                    `json:"facing,omitempty"`
+	CurrentHexLabel  string                    `json:"current_hex_label,omitempty"`
+	CurrentTerrain   string                    `json:"current_terrain,omitempty"`
+	SupplyPoints     int                       `json:"supply_points"`
+	IsRepairing      bool                      `json:"is_repairing"`
+	Weapons          []MechaTacticsWeaponEntry `json:"weapons,omitempty"`
+
+	// Hexes is the full battlefield map; reachable hexes are flagged.
+	Hexes     []MechaTacticsHexOption `json:"hexes,omitempty"`
+	MapWidth  int                     `json:"map_width"`
+	MapHeight int                     `json:"map_height"`
+
+	FacingOptions []string                      `json:"facing_options,omitempty"`
+	EnemyMechs    []MechaTacticsEnemyMechOption `json:"enemy_mechs,omitempty"`
+}
+
+// ReachableHexes returns the hexes the mech may move to this turn, excluding its current hex.
+func (d *MechaTacticsOrdersData) ReachableHexes() []MechaTacticsHexOption {
+	var out []MechaTacticsHexOption
+	for _, h := range d.Hexes {
+		if h.IsReachable && !h.IsCurrent {
+			out = append(out, h)
+		}
+	}
+	return out
+}
+
+// CanAct reports whether the mech can be given movement and attack orders this turn.
+func (d *MechaTacticsOrdersData) CanAct() bool {
+	return !d.IsRepairing && d.MechStatus != "destroyed" && d.MechStatus != "shutdown"
+}
+
+// MechaTacticsOrdersScanData represents the scanned orders submitted for a single mech.
+type MechaTacticsOrdersScanData struct {
+	MechInstanceID             string `json:"mech_instance_id"`
+	MoveToHexID                string `json:"move_to_hex_id,omitempty"`
+	Facing                     string `json:"facing,omitempty"`
+	AttackTargetMechInstanceID string `json:"attack_target_mech_instance_id,omitempty"`
+}
+
+// LayoutMechaTacticsHexMap assigns pixel positions to each hex for a
+// flat-topped axial layout and returns the overall map width and height.
+func LayoutMechaTacticsHexMap(hexes []MechaTacticsHexOption) (int, int) {
+	if len(hexes) == 0 {
+		return 0, 0
+	}
+
+	colStep := float64(mechaTacticsHexMapHexWidth) * 0.75
+	rowStep := float64(mechaTacticsHexMapHexHeight)
+
+	xs := make([]float64, len(hexes))
+	ys := make([]float64, len(hexes))
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for i, h := range hexes {
+		xs[i] = colStep * float64(h.Column)
+		ys[i] = rowStep * (float64(h.Row) + float64(h.Column)/2)
+		minX = math.Min(minX, xs[i])
+		minY = math.Min(minY, ys[i])
+		maxX = math.Max(maxX, xs[i])
+		maxY = math.Max(maxY, ys[i])
+	}
+
+	for i := range hexes {
+		hexes[i].Left = int(math.Round(xs[i] - minX))
+		hexes[i].Top = int(math.Round(ys[i] - minY))
+	}
+
+	return int(math.Round(maxX-minX)) + mechaTacticsHexMapHexWidth, int(math.Round(maxY-minY)) + mechaTacticsHexMapHexHeight
+}
+
+// MechaTacticsGameOrdersProcessor implements the DocumentProcessor interface for mecha tactics orders sheets.
+type MechaTacticsGameOrdersProcessor struct {
+	*BaseProcessor
+}
+
+// NewMechaTacticsGameOrdersProcessor creates a new mecha tactics orders processor.
+func NewMechaTacticsGameOrdersProcessor(l logger.Logger, cfg config.Config) (*MechaTacticsGameOrdersProcessor, error) {
+	baseProcessor, err := NewBaseProcessor(l, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &MechaTacticsGameOrdersProcessor{
+		BaseProcessor: baseProcessor,
+	}, nil
+}
+
+// GeneratePreviewData generates dummy data for a mecha tactics orders turn sheet preview.
+func (p *MechaTacticsGameOrdersProcessor) GeneratePreviewData(ctx context.Context, l logger.Logger, gameRec *game_record.Game, backgroundImage *string) ([]byte, error) {
+	l = l.WithFunctionContext("MechaTacticsGameOrdersProcessor/GeneratePreviewData")
+
+	turnSheetCode, err := turnsheetutil.GeneratePlayGameTurnSheetCode("preview-turn-sheet-id")
+	if err != nil {
+		l.Warn("failed to generate turn sheet code >%v<", err)
+		return nil, fmt.Errorf("failed to generate turn sheet code: %w", err)
+	}
+
+	turnNumber := 1
+	title := "Mech Orders"
+	instructions := DefaultMechaTacticsGameOrdersInstructions()
+
+	// A small three-column map with the mech in the centre.
+	hexes := []MechaTacticsHexOption{
+		{HexID: "preview-hex-1", Column: 0, Row: 0, TerrainName: "Open Ground", MovementPointCost: 1, IsDepot: true, Name: "Alpha Depot", IsReachable: true, MovementPointsToReach: 2},
+		{HexID: "preview-hex-2", Column: 0, Row: 1, TerrainName: "Dense Forest", MovementPointCost: 2, CoverModifier: -10, IsReachable: true, MovementPointsToReach: 3},
+		{HexID: "preview-hex-3", Column: 1, Row: -1, TerrainName: "Open Ground", MovementPointCost: 1, IsReachable: true, MovementPointsToReach: 1},
+		{HexID: "preview-hex-4", Column: 1, Row: 0, TerrainName: "Open Ground", MovementPointCost: 1, IsCurrent: true, IsReachable: true, Occupants: []string{"Hammer"}},
+		{HexID: "preview-hex-5", Column: 1, Row: 1, TerrainName: "Urban Ruins", MovementPointCost: 2, CoverModifier: -15, Elevation: 1, IsReachable: true, MovementPointsToReach: 2},
+		{HexID: "preview-hex-6", Column: 2, Row: -1, TerrainName: "Open Ground", MovementPointCost: 1, IsReachable: true, MovementPointsToReach: 1},
+		{HexID: "preview-hex-7", Column: 2, Row: 0, TerrainName: "Rocky Ridge", MovementPointCost: 3, Elevation: 2, Name: "Ridge Line", Occupants: []string{"Stalker"}},
+	}
+	mapWidth, mapHeight := LayoutMechaTacticsHexMap(hexes)
+
+	data := MechaTacticsOrdersData{
+		TurnSheetTemplateData: TurnSheetTemplateData{
+			GameName:              convert.Ptr(gameRec.Name),
+			GameType:              convert.Ptr(gameRec.GameType),
+			TurnNumber:            &turnNumber,
+			TurnSheetTitle:        &title,
+			TurnSheetDescription:  convert.Ptr(gameRec.Description),
+			TurnSheetInstructions: &instructions,
+			TurnSheetCode:         convert.Ptr(turnSheetCode),
+			TurnEvents: []TurnEvent{
+				{
+					Category: TurnEventCategoryMovement,
+					Icon:     TurnEventIconMovement,
+					Message:  "Hammer advanced to 1,0 facing NE.",
+				},
+				{
+					Category: TurnEventCategoryCombat,
+					Icon:     TurnEventIconCombat,
+					Message:  "Hammer fired Medium Laser at Stalker (front arc) — hit for 5 damage.",
+				},
+			},
+		},
+		MechInstanceID:   "preview-mech-1",
+		MechCallsign:     "Hammer",
+		MechStatus:       "operational",
+		ChassisName:      "Raven Light",
+		ChassisClass:     "light",
+		CurrentArmor:     55,
+		MaxArmor:         72,
+		CurrentStructure: 32,
+		MaxStructure:     32,
+		CurrentHeat:      4,
+		HeatCapacity:     18,
+		Speed:            3,
+		PilotSkill:       4,
+		Facing:           "NE",
+		CurrentHexLabel:  "1,0",
+		CurrentTerrain:   "Open Ground",
+		SupplyPoints:     4,
+		Weapons: []MechaTacticsWeaponEntry{
+			{Name: "Medium Laser", Damage: 5, HeatCost: 3, RangeBand: "medium", MountSize: "medium", SlotLocation: "right-arm"},
+			{Name: "LRM-10", Damage: 6, HeatCost: 4, RangeBand: "long", MountSize: "large", SlotLocation: "left-torso"},
+		},
+		Hexes:         hexes,
+		MapWidth:      mapWidth,
+		MapHeight:     mapHeight,
+		FacingOptions: MechaTacticsFacingOptions,
+		EnemyMechs: []MechaTacticsEnemyMechOption{
+			{MechInstanceID: "enemy-mech-1", Callsign: "Stalker", ChassisName: "Enforcer", HexLabel: "2,0", Facing: "SW", Distance: 1},
+		},
+	}
+
+	if backgroundImage != nil {
+		data.BackgroundImage = backgroundImage
+	}
+
+	return json.Marshal(data)
+}
+
+// GenerateTurnSheet generates a mecha tactics orders turn sheet document.
+func (p *MechaTacticsGameOrdersProcessor) GenerateTurnSheet(ctx context.Context, l logger.Logger, format DocumentFormat, sheetData []byte) ([]byte, error) {
+	l = l.WithFunctionContext("MechaTacticsGameOrdersProcessor/GenerateTurnSheet")
+
+	var data MechaTacticsOrdersData
+	if err := json.Unmarshal(sheetData, &data); err != nil {
+		l.Warn("failed to unmarshal sheet data >%v<", err)
+		return nil, fmt.Errorf("failed to parse sheet data: %w", err)
+	}
+
+	if err := p.ValidateBaseTemplateData(&data.TurnSheetTemplateData); err != nil {
+		l.Warn("failed to validate base template data >%v<", err)
+		return nil, fmt.Errorf("template data validation failed: %w", err)
+	}
+
+	if data.TurnSheetInstructions == nil || strings.TrimSpace(*data.TurnSheetInstructions) == "" {
+		instructions := MechaTacticsGameOrdersInstructions(data.TemplateLocale())
+		data.TurnSheetInstructions = &instructions
+	}
+
+	if data.TurnSheetTitle == nil || strings.TrimSpace(*data.TurnSheetTitle) == "" {
+		title := i18n.T(data.TemplateLocale(), "turnsheet.mecha.orders.title")
+		data.TurnSheetTitle = &title
+	}
+
+	if len(data.FacingOptions) == 0 {
+		data.FacingOptions = MechaTacticsFacingOptions
+	}
+
+	if data.MapWidth == 0 || data.MapHeight == 0 {
+		data.MapWidth, data.MapHeight = LayoutMechaTacticsHexMap(data.Hexes)
+	}
+
+	return p.GenerateDocument(ctx, format, mechaTacticsGameOrdersTemplatePath, &data)
+}
+
+// ScanTurnSheet extracts mech orders from the uploaded document using the hosted scanner.
+func (p *MechaTacticsGameOrdersProcessor) ScanTurnSheet(ctx context.Context, l logger.Logger, sheetData []byte, imageData []byte) ([]byte, error) {
+	l = l.WithFunctionContext("MechaTacticsGameOrdersProcessor/ScanTurnSheet")
+
+	if len(imageData) == 0 {
+		return nil, fmt.Errorf("empty image data provided")
+	}
+
+	templateData := p.resolveMechaTacticsOrdersTemplateData(sheetData)
+
+	templateImage, err := p.renderTemplatePreview(ctx, mechaTacticsGameOrdersTemplatePath, templateData)
+	if err != nil {
+		l.Warn("failed to generate template preview >%v<", err)
+		return nil, fmt.Errorf("failed to generate template preview: %w", err)
+	}
+
+	if len(templateImage) == 0 {
+		return nil, fmt.Errorf("template preview generation returned empty image")
+	}
+
+	req := scanner.StructuredScanRequest{
+		Instructions:       buildMechaTacticsOrdersInstructions(),
+		AdditionalContext:  buildMechaTacticsOrdersContext(templateData),
+		TemplateImage:      templateImage,
+		TemplateImageMIME:  "image/png",
+		FilledImage:        imageData,
+		ExpectedJSONSchema: mechaTacticsOrdersExpectedSchema(),
+	}
+
+	raw, err := p.Scanner.ExtractStructuredData(ctx, req)
+	if err != nil {
+		l.Warn("structured extraction failed >%v<", err)
+		return nil, fmt.Errorf("structured extraction failed: %w", err)
+	}
+
+	var scanData MechaTacticsOrdersScanData
+	if err := json.Unmarshal(raw, &scanData); err != nil {
+		l.Warn("failed to decode structured response >%v<", err)
+		return nil, fmt.Errorf("failed to decode structured response: %w", err)
+	}
+
+	normalizeMechaTacticsOrdersScanData(&scanData, templateData)
+
+	return json.Marshal(scanData)
+}
+
+func (p *MechaTacticsGameOrdersProcessor) resolveMechaTacticsOrdersTemplateData(sheetData []byte) *MechaTacticsOrdersData {
+	var data MechaTacticsOrdersData
+	if len(sheetData) > 0 {
+		if err := json.Unmarshal(sheetData, &data); err != nil {
+			return defaultMechaTacticsOrdersTemplateData()
+		}
+		if len(data.FacingOptions) == 0 {
+			data.FacingOptions = MechaTacticsFacingOptions
+		}
+		return &data
+	}
+	return defaultMechaTacticsOrdersTemplateData()
+}
+
+func defaultMechaTacticsOrdersTemplateData() *MechaTacticsOrdersData {
+	title := "Mech Orders"
+	instructions := DefaultMechaTacticsGameOrdersInstructions()
+	return &MechaTacticsOrdersData{
+		TurnSheetTemplateData: TurnSheetTemplateData{
+			TurnSheetTitle:        &title,
+			TurnSheetInstructions: &instructions,
+		},
+		FacingOptions: MechaTacticsFacingOptions,
+	}
+}
+
+func mechaTacticsOrdersExpectedSchema() map[string]any {
+	return map[string]any{
+		"mech_instance_id":               "",
+		"move_to_hex_id":                 "",
+		"facing":                         "",
+		"attack_target_mech_instance_id": "",
+	}
+}
+
+func buildMechaTacticsOrdersInstructions() string {
+	return `You are comparing two images of a PlayByMail "Mech Orders" form for a hex-grid mech battle.
+- Image 1 is the blank reference form showing the mech, the hex map, the reachable hexes and the enemy targets.
+- Image 2 is the completed form containing the player's handwritten orders.
+Extract the player's orders and return them as JSON with the keys:
+mech_instance_id, move_to_hex_id, facing, attack_target_mech_instance_id.
+
+- "mech_instance_id": the mech ID exactly as printed on the form
+- "move_to_hex_id": the hex ID of the destination hex from the reachable hexes list, or empty string if staying in place. If the player wrote a hex coordinate such as "2,-1", return the coordinate exactly as written.
+- "facing": one of N, NE, SE, S, SW, NW, or empty string if not chosen
+- "attack_target_mech_instance_id": the enemy mech ID the player chose to attack, or empty string if no attack
+
+Return empty strings for fields the player left blank. Do not invent or guess values.`
+}
+
+func buildMechaTacticsOrdersContext(data *MechaTacticsOrdersData) []string {
+	var ctx []string
+	if data != nil {
+		if data.GameName != nil {
+			ctx = append(ctx, fmt.Sprintf("Game name: %s", strings.TrimSpace(*data.GameName)))
+		}
+		if data.MechCallsign != "" {
+			ctx = append(ctx, fmt.Sprintf("Mech callsign: %s", data.MechCallsign))
+		}
+		if data.MechInstanceID != "" {
+			ctx = append(ctx, fmt.Sprintf("Mech ID: %s", data.MechInstanceID))
+		}
+		if data.TurnNumber != nil {
+			ctx = append(ctx, fmt.Sprintf("Turn number: %d", *data.TurnNumber))
+		}
+		for _, h := range data.ReachableHexes() {
+			ctx = append(ctx, fmt.Sprintf("Reachable hex %s has ID %s", h.Label(), h.HexID))
+		}
+		for _, e := range data.EnemyMechs {
+			ctx = append(ctx, fmt.Sprintf("Enemy mech %s has ID %s", e.Callsign, e.MechInstanceID))
+		}
+	}
+	ctx = append(ctx,
+		"The JSON must only contain the requested keys.",
+		"Return empty strings when the player left a field blank.",
+		"Copy mech, hex and enemy mech IDs exactly as printed — do not modify them.",
+	)
+	return ctx
+}
+
+// normalizeMechaTacticsOrdersScanData trims scanned values, upper-cases the
+// facing and resolves a hex written as a "column,row" coordinate to the
+// matching hex ID from the sheet's map.
+func normalizeMechaTacticsOrdersScanData(data *MechaTacticsOrdersScanData, sheet *MechaTacticsOrdersData) {
+	data.MechInstanceID = strings.TrimSpace(data.MechInstanceID)
+	data.MoveToHexID = strings.TrimSpace(data.MoveToHexID)
+	data.Facing = strings.ToUpper(strings.TrimSpace(data.Facing))
+	data.AttackTargetMechInstanceID = strings.TrimSpace(data.AttackTargetMechInstanceID)
+
+	if data.MechInstanceID == "" && sheet != nil {
+		data.MechInstanceID = sheet.MechInstanceID
+	}
+
+	if sheet == nil || data.MoveToHexID == "" {
+		return
+	}
+	col, row, ok := parseMechaTacticsHexLabel(data.MoveToHexID)
+	if !ok {
+		return
+	}
+	for _, h := range sheet.Hexes {
+		if h.Column == col && h.Row == row {
+			data.MoveToHexID = h.HexID
+			return
+		}
+	}
+}
+
+// parseMechaTacticsHexLabel parses a "column,row" hex label.
+func parseMechaTacticsHexLabel(label string) (int, int, bool) {
+	parts := strings.Split(label, ",")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	col, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, false
+	}
+	row, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return 0, 0, false
+	}
+	return col, row, true
+}
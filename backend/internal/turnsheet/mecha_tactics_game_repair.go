@@ -0,0 +1,321 @@
+package turnsheet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gitlab.com/alienspaces/playbymail/core/convert"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/scanner"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+	"gitlab.com/alienspaces/playbymail/internal/utils/turnsheetutil"
+)
+
+// MechaTacticsGameRepairScannedDataSchemaName is the filename of the JSON schema for mecha tactics repair scanned_data.
+const MechaTacticsGameRepairScannedDataSchemaName = "repair.schema.json"
+
+const mechaTacticsGameRepairTemplatePath = "turnsheet/mecha_tactics_game_repair.template"
+
+// DefaultMechaTacticsGameRepairInstructions returns the default instruction text for mecha tactics repair turn sheets.
+func DefaultMechaTacticsGameRepairInstructions() string {
+	return MechaTacticsGameRepairInstructions(i18n.DefaultLocale)
+}
+
+// MechaTacticsGameRepairInstructions returns the instruction text for mecha tactics repair turn sheets in a locale.
+func MechaTacticsGameRepairInstructions(locale string) string {
+	return i18n.T(locale, "turnsheet.mecha_tactics.repair.instructions")
+}
+
+// MechaTacticsRepairData is the data model for a mecha tactics repair turn sheet.
+// A repair sheet is only issued for a player mech standing in a depot hex.
+type MechaTacticsRepairData struct {
+	TurnSheetTemplateData
+
+	MechInstanceID   string `json:"mech_instance_id"`
+	MechCallsign     string `json:"mech_callsign,omitempty"`
+	ChassisName      string `json:"chassis_name,omitempty"`
+	ChassisClass     string `json:"chassis_class,omitempty"`
+	DepotName        string `json:"depot_name,omitempty"`
+	CurrentStructure int    `json:"current_structure"`
+	MaxStructure     int    `json:"max_structure"`
+	SupplyPoints     int    `json:"supply_points"`
+	// StructureRepairCost is the supply point cost of restoring the mech to full structure.
+	StructureRepairCost int `json:"structure_repair_cost"`
+	// WeaponSwapCost is the supply point cost of each weapon swap.
+	WeaponSwapCost int `json:"weapon_swap_cost"`
+
+	// Weapons is the mech's current loadout, one entry per slot.
+	Weapons []MechaTacticsWeaponEntry `json:"weapons,omitempty"`
+	// AvailableWeapons lists the weapons that can be installed.
+	AvailableWeapons []MechaTacticsWeaponEntry `json:"available_weapons,omitempty"`
+}
+
+// NeedsStructureRepair reports whether the mech has lost any structure.
+func (d *MechaTacticsRepairData) NeedsStructureRepair() bool {
+	return d.CurrentStructure < d.MaxStructure
+}
+
+// MechaTacticsRepairScanData represents the scanned repair orders submitted for a single mech.
+type MechaTacticsRepairScanData struct {
+	MechInstanceID  string                   `json:"mech_instance_id"`
+	RepairStructure bool                     `json:"repair_structure"`
+	WeaponSwaps     []MechaTacticsWeaponSwap `json:"weapon_swaps,omitempty"`
+}
+
+// MechaTacticsWeaponSwap installs a weapon into a slot.
+type MechaTacticsWeaponSwap struct {
+	SlotLocation string `json:"slot_location"`
+	WeaponID     string `json:"weapon_id"`
+}
+
+// MechaTacticsGameRepairProcessor implements the DocumentProcessor interface for mecha tactics repair sheets.
+type MechaTacticsGameRepairProcessor struct {
+	*BaseProcessor
+}
+
+// NewMechaTacticsGameRepairProcessor creates a new mecha tactics repair processor.
+func NewMechaTacticsGameRepairProcessor(l logger.Logger, cfg config.Config) (*MechaTacticsGameRepairProcessor, error) {
+	baseProcessor, err := NewBaseProcessor(l, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &MechaTacticsGameRepairProcessor{
+		BaseProcessor: baseProcessor,
+	}, nil
+}
+
+// GeneratePreviewData generates dummy data for a mecha tactics repair turn sheet preview.
+func (p *MechaTacticsGameRepairProcessor) GeneratePreviewData(ctx context.Context, l logger.Logger, gameRec *game_record.Game, backgroundImage *string) ([]byte, error) {
+	l = l.WithFunctionContext("MechaTacticsGameRepairProcessor/GeneratePreviewData")
+
+	turnSheetCode, err := turnsheetutil.GeneratePlayGameTurnSheetCode("preview-turn-sheet-id")
+	if err != nil {
+		l.Warn("failed to generate turn sheet code >%v<", err)
+		return nil, fmt.Errorf("failed to generate turn sheet code: %w", err)
+	}
+
+	turnNumber := 1
+	title := "Mech Repair"
+	instructions := DefaultMechaTacticsGameRepairInstructions()
+	data := MechaTacticsRepairData{
+		TurnSheetTemplateData: TurnSheetTemplateData{
+			GameName:              convert.Ptr(gameRec.Name),
+			GameType:              convert.Ptr(gameRec.GameType),
+			TurnNumber:            &turnNumber,
+			TurnSheetTitle:        &title,
+			TurnSheetDescription:  convert.Ptr(gameRec.Description),
+			TurnSheetInstructions: &instructions,
+			TurnSheetCode:         convert.Ptr(turnSheetCode),
+			HideNarrative:         true,
+		},
+		MechInstanceID:      "preview-mech-1",
+		MechCallsign:        "Hammer",
+		ChassisName:         "Raven Light",
+		ChassisClass:        "light",
+		DepotName:           "Alpha Depot",
+		CurrentStructure:    20,
+		MaxStructure:        32,
+		SupplyPoints:        4,
+		StructureRepairCost: 2,
+		WeaponSwapCost:      1,
+		Weapons: []MechaTacticsWeaponEntry{
+			{WeaponID: "preview-weapon-1", Name: "Medium Laser", Damage: 5, HeatCost: 3, RangeBand: "medium", MountSize: "medium", SlotLocation: "right-arm"},
+			{WeaponID: "preview-weapon-2", Name: "LRM-10", Damage: 6, HeatCost: 4, RangeBand: "long", MountSize: "large", SlotLocation: "left-torso"},
+		},
+		AvailableWeapons: []MechaTacticsWeaponEntry{
+			{WeaponID: "preview-weapon-1", Name: "Medium Laser", Damage: 5, HeatCost: 3, RangeBand: "medium", MountSize: "medium"},
+			{WeaponID: "preview-weapon-2", Name: "LRM-10", Damage: 6, HeatCost: 4, RangeBand: "long", MountSize: "large"},
+			{WeaponID: "preview-weapon-3", Name: "AC/10", Damage: 10, HeatCost: 3, RangeBand: "medium", MountSize: "large"},
+			{WeaponID: "preview-weapon-4", Name: "Small Laser", Damage: 3, HeatCost: 1, RangeBand: "short", MountSize: "small"},
+		},
+	}
+
+	if backgroundImage != nil {
+		data.BackgroundImage = backgroundImage
+	}
+
+	return json.Marshal(data)
+}
+
+// GenerateTurnSheet generates a mecha tactics repair turn sheet document.
+func (p *MechaTacticsGameRepairProcessor) GenerateTurnSheet(ctx context.Context, l logger.Logger, format DocumentFormat, sheetData []byte) ([]byte, error) {
+	l = l.WithFunctionContext("MechaTacticsGameRepairProcessor/GenerateTurnSheet")
+
+	var data MechaTacticsRepairData
+	if err := json.Unmarshal(sheetData, &data); err != nil {
+		l.Warn("failed to unmarshal sheet data >%v<", err)
+		return nil, fmt.Errorf("failed to parse sheet data: %w", err)
+	}
+
+	if err := p.ValidateBaseTemplateData(&data.TurnSheetTemplateData); err != nil {
+		l.Warn("failed to validate base template data >%v<", err)
+		return nil, fmt.Errorf("template data validation failed: %w", err)
+	}
+
+	if data.TurnSheetInstructions == nil || strings.TrimSpace(*data.TurnSheetInstructions) == "" {
+		instructions := MechaTacticsGameRepairInstructions(data.TemplateLocale())
+		data.TurnSheetInstructions = &instructions
+	}
+
+	if data.TurnSheetTitle == nil || strings.TrimSpace(*data.TurnSheetTitle) == "" {
+		title := i18n.T(data.TemplateLocale(), "turnsheet.mecha_tactics.repair.title")
+		data.TurnSheetTitle = &title
+	}
+
+	return p.GenerateDocument(ctx, format, mechaTacticsGameRepairTemplatePath, &data)
+}
+
+// ScanTurnSheet extracts repair orders from the uploaded document using the hosted scanner.
+func (p *MechaTacticsGameRepairProcessor) ScanTurnSheet(ctx context.Context, l logger.Logger, sheetData []byte, imageData []byte) ([]byte, error) {
+	l = l.WithFunctionContext("MechaTacticsGameRepairProcessor/ScanTurnSheet")
+
+	if len(imageData) == 0 {
+		return nil, fmt.Errorf("empty image data provided")
+	}
+
+	templateData := p.resolveMechaTacticsRepairTemplateData(sheetData)
+
+	templateImage, err := p.renderTemplatePreview(ctx, mechaTacticsGameRepairTemplatePath, templateData)
+	if err != nil {
+		l.Warn("failed to generate template preview >%v<", err)
+		return nil, fmt.Errorf("failed to generate template preview: %w", err)
+	}
+
+	if len(templateImage) == 0 {
+		return nil, fmt.Errorf("template preview generation returned empty image")
+	}
+
+	req := scanner.StructuredScanRequest{
+		Instructions:       buildMechaTacticsRepairInstructions(),
+		AdditionalContext:  buildMechaTacticsRepairContext(templateData),
+		TemplateImage:      templateImage,
+		TemplateImageMIME:  "image/png",
+		FilledImage:        imageData,
+		ExpectedJSONSchema: mechaTacticsRepairExpectedSchema(),
+	}
+
+	raw, err := p.Scanner.ExtractStructuredData(ctx, req)
+	if err != nil {
+		l.Warn("structured extraction failed >%v<", err)
+		return nil, fmt.Errorf("structured extraction failed: %w", err)
+	}
+
+	var scanData MechaTacticsRepairScanData
+	if err := json.Unmarshal(raw, &scanData); err != nil {
+		l.Warn("failed to decode structured response >%v<", err)
+		return nil, fmt.Errorf("failed to decode structured response: %w", err)
+	}
+
+	normalizeMechaTacticsRepairScanData(&scanData, templateData)
+
+	return json.Marshal(scanData)
+}
+
+func (p *MechaTacticsGameRepairProcessor) resolveMechaTacticsRepairTemplateData(sheetData []byte) *MechaTacticsRepairData {
+	var data MechaTacticsRepairData
+	if len(sheetData) > 0 {
+		if err := json.Unmarshal(sheetData, &data); err != nil {
+			return defaultMechaTacticsRepairTemplateData()
+		}
+		return &data
+	}
+	return defaultMechaTacticsRepairTemplateData()
+}
+
+func defaultMechaTacticsRepairTemplateData() *MechaTacticsRepairData {
+	title := "Mech Repair"
+	instructions := DefaultMechaTacticsGameRepairInstructions()
+	return &MechaTacticsRepairData{
+		TurnSheetTemplateData: TurnSheetTemplateData{
+			TurnSheetTitle:        &title,
+			TurnSheetInstructions: &instructions,
+			HideNarrative:         true,
+		},
+	}
+}
+
+func mechaTacticsRepairExpectedSchema() map[string]any {
+	return map[string]any{
+		"mech_instance_id": "",
+		"repair_structure": false,
+		"weapon_swaps": []map[string]any{
+			{
+				"slot_location": "",
+				"weapon_id":     "",
+			},
+		},
+	}
+}
+
+func buildMechaTacticsRepairInstructions() string {
+	return `You are comparing two images of a PlayByMail "Mech Repair" form.
+- Image 1 is the blank reference form showing the mech's current weapon slots and the weapons available to install.
+- Image 2 is the completed form containing the player's handwritten choices.
+Extract the player's repair orders and return them as JSON with the keys:
+mech_instance_id, repair_structure, weapon_swaps.
+
+- "mech_instance_id": the mech ID exactly as printed on the form
+- "repair_structure": true only if the player ticked the structure repair box, otherwise false
+- "weapon_swaps": one entry for each slot where the player wrote or selected a replacement weapon, with
+  "slot_location" copied exactly as printed for that slot and "weapon_id" set to the ID of the chosen weapon
+
+Leave "weapon_swaps" empty when no replacement weapons were chosen. Do not invent or guess values.`
+}
+
+func buildMechaTacticsRepairContext(data *MechaTacticsRepairData) []string {
+	var ctx []string
+	if data != nil {
+		if data.GameName != nil {
+			ctx = append(ctx, fmt.Sprintf("Game name: %s", strings.TrimSpace(*data.GameName)))
+		}
+		if data.MechCallsign != "" {
+			ctx = append(ctx, fmt.Sprintf("Mech callsign: %s", data.MechCallsign))
+		}
+		if data.MechInstanceID != "" {
+			ctx = append(ctx, fmt.Sprintf("Mech ID: %s", data.MechInstanceID))
+		}
+		for _, w := range data.Weapons {
+			ctx = append(ctx, fmt.Sprintf("Slot %s currently holds %s", w.SlotLocation, w.Name))
+		}
+		for _, w := range data.AvailableWeapons {
+			ctx = append(ctx, fmt.Sprintf("Weapon %s has ID %s", w.Name, w.WeaponID))
+		}
+	}
+	ctx = append(ctx,
+		"The JSON must only contain the requested keys.",
+		"Copy slot locations and weapon IDs exactly as printed — do not modify them.",
+	)
+	return ctx
+}
+
+// normalizeMechaTacticsRepairScanData trims scanned values, drops incomplete
+// weapon swaps and resolves weapons written by name to their IDs.
+func normalizeMechaTacticsRepairScanData(data *MechaTacticsRepairScanData, sheet *MechaTacticsRepairData) {
+	data.MechInstanceID = strings.TrimSpace(data.MechInstanceID)
+	if data.MechInstanceID == "" && sheet != nil {
+		data.MechInstanceID = sheet.MechInstanceID
+	}
+
+	var swaps []MechaTacticsWeaponSwap
+	for _, swap := range data.WeaponSwaps {
+		swap.SlotLocation = strings.TrimSpace(swap.SlotLocation)
+		swap.WeaponID = strings.TrimSpace(swap.WeaponID)
+		if swap.SlotLocation == "" || swap.WeaponID == "" {
+			continue
+		}
+		if sheet != nil {
+			for _, w := range sheet.AvailableWeapons {
+				if strings.EqualFold(w.Name, swap.WeaponID) {
+					swap.WeaponID = w.WeaponID
+					break
+				}
+			}
+		}
+		swaps = append(swaps, swap)
+	}
+	data.WeaponSwaps = swaps
+}
@@ -0,0 +1,67 @@
+package turnsheet
+
+import (
+	"encoding/json"
+
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
+)
+
+// AppendMechaTacticsGameTurnEvent appends an event to the mech instance's
+// last_turn_events JSON field.
+func AppendMechaTacticsGameTurnEvent(
+	mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+	event TurnEvent,
+) error {
+	events, err := readMechaTacticsGameTurnEvents(mechInstance)
+	if err != nil {
+		return err
+	}
+	events = append(events, event)
+	return writeMechaTacticsGameTurnEvents(mechInstance, events)
+}
+
+// ReadMechaTacticsGameTurnEvents returns all turn events without clearing them.
+func ReadMechaTacticsGameTurnEvents(
+	mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+) ([]TurnEvent, error) {
+	return readMechaTacticsGameTurnEvents(mechInstance)
+}
+
+// ReadAndClearMechaTacticsGameTurnEvents reads all turn events and clears the field.
+func ReadAndClearMechaTacticsGameTurnEvents(
+	mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+) ([]TurnEvent, error) {
+	events, err := readMechaTacticsGameTurnEvents(mechInstance)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeMechaTacticsGameTurnEvents(mechInstance, []TurnEvent{}); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func readMechaTacticsGameTurnEvents(
+	mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+) ([]TurnEvent, error) {
+	if len(mechInstance.LastTurnEvents) == 0 {
+		return []TurnEvent{}, nil
+	}
+	var events []TurnEvent
+	if err := json.Unmarshal(mechInstance.LastTurnEvents, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func writeMechaTacticsGameTurnEvents(
+	mechInstance *mecha_tactics_game_record.MechaTacticsGameMechInstance,
+	events []TurnEvent,
+) error {
+	data, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	mechInstance.LastTurnEvents = json.RawMessage(data)
+	return nil
+}
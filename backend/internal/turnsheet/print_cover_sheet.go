@@ -0,0 +1,64 @@
+package turnsheet
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+const printCoverSheetTemplatePath = "turnsheet/print_cover_sheet.template"
+
+// Print cover sheet delivery methods
+const (
+	PrintCoverSheetDeliveryMethodPost  string = "post"
+	PrintCoverSheetDeliveryMethodLocal string = "local"
+)
+
+// PrintCoverSheetData is the data model for the cover sheet printed in front of
+// each player's turn sheets in a print batch. The cover carries the player's
+// postal address for physical post delivery, or their name for local collection.
+type PrintCoverSheetData struct {
+	TurnSheetTemplateData
+
+	DeliveryMethod     string `json:"delivery_method"`
+	RecipientName      string `json:"recipient_name,omitempty"`
+	PostalAddressLine1 string `json:"postal_address_line1,omitempty"`
+	PostalAddressLine2 string `json:"postal_address_line2,omitempty"`
+	StateProvince      string `json:"state_province,omitempty"`
+	PostalCode         string `json:"postal_code,omitempty"`
+	Country            string `json:"country,omitempty"`
+	// SheetCount is the number of turn sheets collated behind the cover
+	SheetCount int `json:"sheet_count"`
+}
+
+// IsLocalCollection reports whether the sheets are collected in person rather than posted
+func (d *PrintCoverSheetData) IsLocalCollection() bool {
+	return d.DeliveryMethod == PrintCoverSheetDeliveryMethodLocal
+}
+
+// HasPostalAddress reports whether any postal address lines are present
+func (d *PrintCoverSheetData) HasPostalAddress() bool {
+	return d.PostalAddressLine1 != "" || d.PostalAddressLine2 != "" || d.PostalCode != ""
+}
+
+// GeneratePrintCoverSheet renders a print batch cover sheet
+func GeneratePrintCoverSheet(ctx context.Context, l logger.Logger, cfg config.Config, format DocumentFormat, data *PrintCoverSheetData) ([]byte, error) {
+	l = l.WithFunctionContext("GeneratePrintCoverSheet")
+
+	if data == nil {
+		return nil, fmt.Errorf("print cover sheet data is required")
+	}
+
+	// The cover sheet never carries a narrative panel
+	data.HideNarrative = true
+
+	processor, err := NewBaseProcessor(l, cfg)
+	if err != nil {
+		l.Warn("failed to create base processor >%v<", err)
+		return nil, err
+	}
+
+	return processor.GenerateDocument(ctx, format, printCoverSheetTemplatePath, data)
+}
@@ -0,0 +1,83 @@
+package turnsheet_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/core/convert"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/testutil"
+)
+
+func TestGeneratePrintCoverSheet(t *testing.T) {
+	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
+
+	cfg.TemplatesPath = "../../templates"
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		data        *turnsheet.PrintCoverSheetData
+		contains    []string
+		notContains []string
+	}{
+		{
+			name: "physical post with address",
+			data: &turnsheet.PrintCoverSheetData{
+				TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
+					GameName:   convert.Ptr("The Shattered Reach"),
+					TurnNumber: convert.Ptr(3),
+				},
+				DeliveryMethod:     turnsheet.PrintCoverSheetDeliveryMethodPost,
+				RecipientName:      "Ada Lovelace",
+				PostalAddressLine1: "12 Analytical Lane",
+				StateProvince:      "Somerset",
+				PostalCode:         "BA1 1AA",
+				Country:            "United Kingdom",
+				SheetCount:         2,
+			},
+			contains: []string{"Physical post", "Ada Lovelace", "12 Analytical Lane", "BA1 1AA", "2 turn sheets enclosed"},
+		},
+		{
+			name: "physical post without address",
+			data: &turnsheet.PrintCoverSheetData{
+				TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
+					AccountName: convert.Ptr("ada@example.com"),
+				},
+				DeliveryMethod: turnsheet.PrintCoverSheetDeliveryMethodPost,
+				SheetCount:     1,
+			},
+			contains: []string{"ada@example.com", "No postal address recorded", "1 turn sheet enclosed"},
+		},
+		{
+			name: "local collection",
+			data: &turnsheet.PrintCoverSheetData{
+				DeliveryMethod:     turnsheet.PrintCoverSheetDeliveryMethodLocal,
+				RecipientName:      "Ada Lovelace",
+				PostalAddressLine1: "12 Analytical Lane",
+				SheetCount:         1,
+			},
+			contains:    []string{"Local collection", "Ada Lovelace"},
+			notContains: []string{"12 Analytical Lane"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := turnsheet.GeneratePrintCoverSheet(ctx, l, cfg, turnsheet.DocumentFormatHTML, tt.data)
+			require.NoError(t, err, "should render cover sheet")
+			require.True(t, tt.data.HideNarrative, "cover sheet should hide the narrative panel")
+
+			html := string(output)
+			for _, s := range tt.contains {
+				require.Contains(t, html, s)
+			}
+			for _, s := range tt.notContains {
+				require.NotContains(t, html, s)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
@@ -20,6 +21,8 @@ func GetTurnSheetJoinGameData(gameRec *game_record.Game, turnSheetCode string) (
 		return createAdventureGameJoinGameData(gameRec, turnSheetCode), nil
 	case game_record.GameTypeMecha:
 		return createMechaGameJoinGameData(gameRec, turnSheetCode), nil
+	case game_record.GameTypeMechaTactics:
+		return createMechaTacticsGameJoinGameData(gameRec, turnSheetCode), nil
 	default:
 		return JoinGameData{}, fmt.Errorf("join game turn sheets not supported for game type: %s", gameRec.GameType)
 	}
@@ -46,13 +49,14 @@ func NewBaseProcessor(l logger.Logger, cfg config.Config) (*BaseProcessor, error
 		return nil, fmt.Errorf("failed to create image scanner: %w", err)
 	}
 
-	rendererInstance, err := generator.NewDocumentRenderer(l)
+	rendererInstance, err := newDocumentRenderer(l, cfg)
 	if err != nil {
 		l.Warn("failed to create document renderer >%v<", err)
 		return nil, fmt.Errorf("failed to create document renderer: %w", err)
 	}
 
 	templatePath := cfg.TemplatesPath
+	rendererInstance.SetTemplatePath(templatePath)
 
 	return &BaseProcessor{
 		Scanner:      scannerInstance,
@@ -63,12 +67,25 @@ func NewBaseProcessor(l logger.Logger, cfg config.Config) (*BaseProcessor, error
 	}, nil
 }
 
-// GenerateDocument renders a template in the requested document format.
+// newDocumentRenderer creates a document renderer that renders in the browser pool
+// and caches PDFs in the PDF cache shared by the process, sized from configuration
+func newDocumentRenderer(l logger.Logger, cfg config.Config) (*generator.DocumentRenderer, error) {
+	renderer, err := generator.NewDocumentRenderer(l)
+	if err != nil {
+		return nil, err
+	}
+
+	renderer.SetBrowserPool(generator.SharedBrowserPool(l, cfg))
+	renderer.SetPDFCache(generator.SharedPDFCache(cfg))
+
+	return renderer, nil
+}
+
+// GenerateDocument renders a template in the requested document format. Documents
+// may be generated concurrently.
 func (bp *BaseProcessor) GenerateDocument(ctx context.Context, format DocumentFormat, templatePath string, data any) ([]byte, error) {
 	l := bp.Log.WithFunctionContext("BaseProcessor/GenerateDocument")
 
-	bp.Renderer.SetTemplatePath(bp.TemplatePath)
-
 	switch format {
 	case DocumentFormatPDF, "":
 		l.Info("generating PDF document template=%s", templatePath)
@@ -216,8 +233,6 @@ func (bp *BaseProcessor) ExtractTextFromImage(ctx context.Context, imageData []b
 func (bp *BaseProcessor) renderTemplatePreview(ctx context.Context, templatePath string, data any) ([]byte, error) {
 	l := bp.Log.WithFunctionContext("BaseProcessor/renderTemplatePreview")
 
-	bp.Renderer.SetTemplatePath(bp.TemplatePath)
-
 	png, err := bp.Renderer.GeneratePNG(ctx, templatePath, data)
 	if err != nil {
 		l.Warn("failed to render template preview >%v<", err)
@@ -270,6 +285,45 @@ type JoinGameData struct {
 	GameDescription          string          `json:"game_description,omitempty"`
 	AvailableDeliveryMethods DeliveryMethods `json:"available_delivery_methods"`
 	AccountEmail             string          `json:"account_email,omitempty"`
+	// SquadSize is the number of mechs each player commands in a mecha game run
+	SquadSize int `json:"squad_size,omitempty"`
+	// MechaGameRules summarises the ruleset a mecha game run is played with
+	MechaGameRules *MechaGameRulesSummary `json:"mecha_game_rules,omitempty"`
+}
+
+// MechaGameRulesSummary is the ruleset of a mecha game run as printed on the
+// join game turn sheet.
+type MechaGameRulesSummary struct {
+	SupplyPointsPerTurn    int   `json:"supply_points_per_turn"`
+	HeatDissipationDivisor int   `json:"heat_dissipation_divisor"`
+	AutoRepairPercent      int   `json:"auto_repair_percent"`
+	BaseHitChance          int   `json:"base_hit_chance"`
+	HitChancePerSkill      int   `json:"hit_chance_per_skill"`
+	MaxHitChance           int   `json:"max_hit_chance"`
+	PilotSkillThresholds   []int `json:"pilot_skill_thresholds"`
+
+	RoughMovementCost         int `json:"rough_movement_cost"`
+	UrbanMovementCost         int `json:"urban_movement_cost"`
+	WaterHeatDissipationBonus int `json:"water_heat_dissipation_bonus"`
+	ObstructedFireRange       int `json:"obstructed_fire_range"`
+}
+
+// MaxPilotSkill returns the highest pilot skill level of the ruleset.
+func (r *MechaGameRulesSummary) MaxPilotSkill() int {
+	return len(r.PilotSkillThresholds) - 1
+}
+
+// PilotSkillExperience returns the experience required for each pilot skill
+// level above the first as a comma separated list.
+func (r *MechaGameRulesSummary) PilotSkillExperience() string {
+	if len(r.PilotSkillThresholds) < 2 {
+		return ""
+	}
+	parts := make([]string, 0, len(r.PilotSkillThresholds)-1)
+	for _, xp := range r.PilotSkillThresholds[1:] {
+		parts = append(parts, strconv.Itoa(xp))
+	}
+	return strings.Join(parts, ", ")
 }
 
 // HasDeliveryChoice returns true when more than one delivery method is available,
@@ -0,0 +1,261 @@
+package turnsheet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/omr"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// localTurnSheetScanner reads turn sheets with the optical mark reader instead
+// of a hosted AI agent. The turn sheet code is read from the printed code mark
+// and player choices from the checkboxes and radio buttons they marked, which
+// produces the same scanned data as submitting the turn sheet online. Turn
+// sheets with fields written in by hand cannot be read.
+type localTurnSheetScanner struct {
+	cfg config.Config
+}
+
+// GetTurnSheetCodeFromImage reads the turn sheet code from the printed code mark
+func (s *localTurnSheetScanner) GetTurnSheetCodeFromImage(ctx context.Context, l logger.Logger, imageData []byte) (string, error) {
+	l = l.WithFunctionContext("localTurnSheetScanner/GetTurnSheetCodeFromImage")
+
+	page, err := omr.DecodePage(imageData)
+	if err != nil {
+		l.Warn("failed to locate turn sheet in image >%v<", err)
+		return "", fmt.Errorf("failed to locate turn sheet in image: %w", err)
+	}
+
+	code, err := page.ReadCode()
+	if err != nil {
+		l.Warn("failed to read turn sheet code mark >%v<", err)
+		return "", fmt.Errorf("failed to read turn sheet code mark: %w", err)
+	}
+
+	l.Info("read turn sheet code >%s< from code mark", code)
+
+	return code, nil
+}
+
+// GetTurnSheetScanData reads the marked checkboxes and radio buttons from a turn sheet image
+func (s *localTurnSheetScanner) GetTurnSheetScanData(ctx context.Context, l logger.Logger, sheetType string, sheetData []byte, imageData []byte) ([]byte, error) {
+	l = l.WithFunctionContext("localTurnSheetScanner/GetTurnSheetScanData")
+
+	l.Info("scanning turn sheet type >%s< with the local mark reader", sheetType)
+
+	processor, err := GetDocumentProcessor(l, s.cfg, sheetType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get processor for sheet type %s: %w", sheetType, err)
+	}
+
+	// The turn sheet is laid out again exactly as it was printed to find where each mark box is
+	html, err := processor.GenerateTurnSheet(ctx, l, DocumentFormatHTML, sheetData)
+	if err != nil {
+		l.Warn("failed to generate turn sheet HTML >%v<", err)
+		return nil, fmt.Errorf("failed to generate turn sheet HTML: %w", err)
+	}
+
+	renderer, err := newDocumentRenderer(l, s.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create document renderer: %w", err)
+	}
+
+	layout, err := renderer.GenerateMarkLayout(ctx, string(html))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(layout.WrittenFields) > 0 {
+		l.Warn("turn sheet type >%s< has written fields >%v<", sheetType, layout.WrittenFields)
+		return nil, fmt.Errorf("turn sheet type %s has fields written by hand which the local scanner cannot read", sheetType)
+	}
+
+	page, err := omr.DecodePage(imageData)
+	if err != nil {
+		l.Warn("failed to locate turn sheet in image >%v<", err)
+		return nil, fmt.Errorf("failed to locate turn sheet in image: %w", err)
+	}
+
+	// Reading the code mark also corrects the orientation of sheets scanned upside down
+	code, err := page.ReadCode()
+	if err != nil {
+		l.Warn("failed to read turn sheet code mark >%v<", err)
+		return nil, fmt.Errorf("failed to read turn sheet code mark: %w", err)
+	}
+
+	var templateData TurnSheetTemplateData
+	if err := json.Unmarshal(sheetData, &templateData); err != nil {
+		return nil, fmt.Errorf("failed to parse sheet data: %w", err)
+	}
+
+	if templateData.TurnSheetCode != nil && *templateData.TurnSheetCode != code {
+		l.Warn("scanned turn sheet code >%s< does not match sheet data code >%s<", code, *templateData.TurnSheetCode)
+		return nil, fmt.Errorf("scanned image is not this turn sheet")
+	}
+
+	formData, err := readMarkedFormData(page, layout)
+	if err != nil {
+		l.Warn("failed to read marks >%v<", err)
+		return nil, err
+	}
+
+	scanData := formScanData(formData)
+
+	l.Info("read turn sheet marks >%v<", scanData)
+
+	return json.Marshal(scanData)
+}
+
+// readMarkedFormData returns form values for the marked fields in the same
+// shape as a submitted HTML form: a list of values for each checkbox group
+// and a single value for each radio group
+func readMarkedFormData(page *omr.Page, layout *omr.Layout) (map[string]any, error) {
+	formData := map[string]any{}
+	radioGroups := map[string][]omr.Field{}
+
+	for _, field := range layout.Fields {
+		switch field.Type {
+		case "checkbox":
+			values, _ := formData[field.Name].([]string)
+			if page.IsMarked(field.Box) {
+				values = append(values, field.Value)
+			}
+			formData[field.Name] = values
+		case "radio":
+			radioGroups[field.Name] = append(radioGroups[field.Name], field)
+		}
+	}
+
+	names := make([]string, 0, len(radioGroups))
+	for name := range radioGroups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		// Options printed already selected are the default for the group and
+		// are replaced by any other option the player marks
+		var marked, defaults []omr.Field
+		for _, field := range radioGroups[name] {
+			if !page.IsMarked(field.Box) {
+				continue
+			}
+			if field.Checked {
+				defaults = append(defaults, field)
+			} else {
+				marked = append(marked, field)
+			}
+		}
+
+		switch {
+		case len(marked) == 1:
+			formData[name] = marked[0].Value
+		case len(marked) > 1:
+			return nil, fmt.Errorf("more than one option is marked for %s", name)
+		case len(defaults) == 1:
+			formData[name] = defaults[0].Value
+		}
+	}
+
+	return formData, nil
+}
+
+var formActionKeyRe = regexp.MustCompile(`^action_(\d+)$`)
+
+// formScanData converts submitted form values into the scanned data shape the
+// turn sheet processors accept. This mirrors the conversion the player turn
+// sheet view applies before submitting turn sheets online.
+func formScanData(formData map[string]any) map[string]any {
+	scanData := map[string]any{}
+
+	var equip, drop, pickUp, unequip []string
+
+	// Per-item radio groups are named inv_<itemId> for inventory items and
+	// loc_<itemId> for items at the location, with the action as the value
+	for key, value := range formData {
+		action, _ := value.(string)
+		switch {
+		case strings.HasPrefix(key, "inv_"):
+			itemID := strings.TrimPrefix(key, "inv_")
+			switch action {
+			case "equip":
+				equip = append(equip, itemID)
+			case "drop":
+				drop = append(drop, itemID)
+			case "unequip":
+				unequip = append(unequip, itemID)
+			}
+		case strings.HasPrefix(key, "loc_"):
+			itemID := strings.TrimPrefix(key, "loc_")
+			switch action {
+			case "equip":
+				equip = append(equip, itemID)
+			case "pick_up":
+				pickUp = append(pickUp, itemID)
+			}
+		case formActionKeyRe.MatchString(key):
+			// Converted into the actions list below
+		default:
+			scanData[key] = value
+		}
+	}
+
+	// Checkbox drop and pick up actions for items that cannot be equipped
+	if values, ok := scanData["drop"].([]string); ok {
+		drop = append(drop, values...)
+		delete(scanData, "drop")
+	}
+	if values, ok := scanData["pick_up"].([]string); ok {
+		pickUp = append(pickUp, values...)
+		delete(scanData, "pick_up")
+	}
+
+	for key, values := range map[string][]string{"equip": equip, "drop": drop, "pick_up": pickUp, "unequip": unequip} {
+		if len(values) > 0 {
+			sort.Strings(values)
+			scanData[key] = values
+		}
+	}
+
+	// Creature encounter action slots are named action_<n>
+	var actions []map[string]any
+	for i := 0; ; i++ {
+		actionType, ok := formData[fmt.Sprintf("action_%d", i)].(string)
+		if !ok {
+			break
+		}
+		action := map[string]any{"action_type": actionType}
+		if target, _ := formData[fmt.Sprintf("action_%d_target", i)].(string); actionType == "attack" && target != "" {
+			action["target_creature_instance_id"] = target
+		}
+		delete(scanData, fmt.Sprintf("action_%d_target", i))
+		actions = append(actions, action)
+	}
+	// Trailing do nothing actions are not submitted
+	for len(actions) > 0 && actions[len(actions)-1]["action_type"] == "do_nothing" {
+		actions = actions[:len(actions)-1]
+	}
+	if len(actions) > 0 {
+		scanData["actions"] = actions
+	}
+
+	if values, ok := scanData["repair_structure"].([]string); ok {
+		scanData["repair_structure"] = slices.Contains(values, "true")
+	}
+
+	// Unmarked checkbox groups are left out as they are online
+	for key, value := range scanData {
+		if values, ok := value.([]string); ok && len(values) == 0 {
+			delete(scanData, key)
+		}
+	}
+
+	return scanData
+}
@@ -0,0 +1,73 @@
+package turnsheet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormScanData(t *testing.T) {
+	tests := []struct {
+		name     string
+		formData map[string]any
+		expect   map[string]any
+	}{
+		{
+			name: "location choice",
+			formData: map[string]any{
+				"location_choice": "loc-2",
+			},
+			expect: map[string]any{
+				"location_choice": "loc-2",
+			},
+		},
+		{
+			name: "inventory management",
+			formData: map[string]any{
+				"inv_item-1": "equip",
+				"inv_item-2": "drop",
+				"inv_item-3": "keep",
+				"loc_item-4": "pick_up",
+				"drop":       []string{"item-5"},
+				"pick_up":    []string{},
+			},
+			expect: map[string]any{
+				"equip":   []string{"item-1"},
+				"drop":    []string{"item-2", "item-5"},
+				"pick_up": []string{"item-4"},
+			},
+		},
+		{
+			name: "monster encounter",
+			formData: map[string]any{
+				"action_0":        "attack",
+				"action_0_target": "creature-1",
+				"action_1":        "flee",
+				"action_1_target": "creature-1",
+				"action_2":        "do_nothing",
+			},
+			expect: map[string]any{
+				"actions": []map[string]any{
+					{"action_type": "attack", "target_creature_instance_id": "creature-1"},
+					{"action_type": "flee"},
+				},
+			},
+		},
+		{
+			name: "mecha repair",
+			formData: map[string]any{
+				"repair_structure": []string{"true"},
+				"repairs":          []string{},
+			},
+			expect: map[string]any{
+				"repair_structure": true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expect, formScanData(tt.formData))
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
 )
 
@@ -73,6 +74,19 @@ func getDocumentProcessorMap(l logger.Logger, cfg config.Config) (map[string]Doc
 	}
 	maps.Copy(processors, mechaGameProcessors)
 
+	mechaTacticsGameProcessors, err := getMechaTacticsGameDocumentProcessorMap(l, cfg)
+	if err != nil {
+		return nil, err
+	}
+	maps.Copy(processors, mechaTacticsGameProcessors)
+
+	// Sheet types shared by all game types
+	gameResultsProcessor, err := NewGameResultsProcessor(l, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create game results processor: %w", err)
+	}
+	processors[game_record.GameTurnSheetTypeGameResults] = gameResultsProcessor
+
 	return processors, nil
 }
 
@@ -103,6 +117,12 @@ func getAdventureGameDocumentProcessorMap(l logger.Logger, cfg config.Config) (m
 	}
 	processors[adventure_game_record.AdventureGameTurnSheetTypeCreatureEncounter] = monsterEncounterProcessor
 
+	adventureEndedProcessor, err := NewAdventureEndedProcessor(l, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create adventure ended processor: %w", err)
+	}
+	processors[adventure_game_record.AdventureGameTurnSheetTypeAdventureEnded] = adventureEndedProcessor
+
 	return processors, nil
 }
 
@@ -129,3 +149,27 @@ func getMechaGameDocumentProcessorMap(l logger.Logger, cfg config.Config) (map[s
 
 	return processors, nil
 }
+
+func getMechaTacticsGameDocumentProcessorMap(l logger.Logger, cfg config.Config) (map[string]DocumentProcessor, error) {
+	processors := make(map[string]DocumentProcessor)
+
+	joinGameProcessor, err := NewMechaTacticsGameJoinGameProcessor(l, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mecha tactics join game processor: %w", err)
+	}
+	processors[mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeJoinGame] = joinGameProcessor
+
+	ordersProcessor, err := NewMechaTacticsGameOrdersProcessor(l, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mecha tactics orders processor: %w", err)
+	}
+	processors[mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders] = ordersProcessor
+
+	repairProcessor, err := NewMechaTacticsGameRepairProcessor(l, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mecha tactics repair processor: %w", err)
+	}
+	processors[mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeRepair] = repairProcessor
+
+	return processors, nil
+}
@@ -0,0 +1,61 @@
+package turnsheet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// RenderTurnSheetPDFs renders turn sheets to PDF in parallel, returning the PDFs in
+// the same order as the turn sheets. The shared browser pool limits how many sheets
+// render at once, and rendered PDFs are kept in the PDF cache so later downloads,
+// email resends and print batches of the same sheets are not rendered again.
+func RenderTurnSheetPDFs(ctx context.Context, l logger.Logger, cfg config.Config, turnSheetRecs []*game_record.GameTurnSheet) ([][]byte, error) {
+	l = l.WithFunctionContext("RenderTurnSheetPDFs")
+
+	l.Info("rendering >%d< turn sheets", len(turnSheetRecs))
+
+	processors, err := getDocumentProcessorMap(l, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create document processor map: %w", err)
+	}
+
+	pdfs := make([][]byte, len(turnSheetRecs))
+	errs := make([]error, len(turnSheetRecs))
+
+	wg := sync.WaitGroup{}
+
+	for idx, turnSheetRec := range turnSheetRecs {
+		processor, exists := processors[turnSheetRec.SheetType]
+		if !exists {
+			errs[idx] = fmt.Errorf("turn sheet %s: no processor registered for turn sheet type: %s", turnSheetRec.ID, turnSheetRec.SheetType)
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			pdf, err := processor.GenerateTurnSheet(ctx, l, DocumentFormatPDF, turnSheetRec.SheetData)
+			if err != nil {
+				l.Warn("failed to render turn sheet >%s< >%v<", turnSheetRec.ID, err)
+				errs[idx] = fmt.Errorf("turn sheet %s: %w", turnSheetRec.ID, err)
+				return
+			}
+			pdfs[idx] = pdf
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return pdfs, nil
+}
@@ -0,0 +1,161 @@
+package turnsheet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gitlab.com/alienspaces/playbymail/core/jsonschema"
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// ScanDataValidator is implemented by document scanners that can check scanned data
+// against the sheet data the turn sheet was printed with, for example that a chosen
+// location was one of the locations offered on the turn sheet
+type ScanDataValidator interface {
+	ValidateScanData(sheetData []byte, scanData []byte) error
+}
+
+// Scan issue types
+// - missing_code: The turn sheet code could not be read from the scanned image
+// - wrong_code: The turn sheet code read from the scanned image is for a different turn sheet
+// - invalid_data: The scanned data does not match the scanned data schema for the turn sheet type
+// - out_of_range: A choice in the scanned data was not offered on the turn sheet
+const (
+	ScanIssueTypeMissingCode string = "missing_code"
+	ScanIssueTypeWrongCode   string = "wrong_code"
+	ScanIssueTypeInvalidData string = "invalid_data"
+	ScanIssueTypeOutOfRange  string = "out_of_range"
+)
+
+// scanIssuePenalty is how much each type of issue lowers the confidence of a scan
+var scanIssuePenalty = map[string]float64{
+	ScanIssueTypeMissingCode: 0.25,
+	ScanIssueTypeWrongCode:   1,
+	ScanIssueTypeInvalidData: 0.5,
+	ScanIssueTypeOutOfRange:  0.5,
+}
+
+// ScanIssue is a problem found with the data scanned from a turn sheet
+type ScanIssue struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// ScanReview is the result of checking the data scanned from a turn sheet. Confidence
+// is 1 when no issues were found and is lowered by each issue, down to 0.
+type ScanReview struct {
+	Confidence float64     `json:"confidence"`
+	Issues     []ScanIssue `json:"issues"`
+}
+
+// NeedsReview returns true when a manager should check the scanned data before the
+// turn sheet is accepted
+func (r *ScanReview) NeedsReview() bool {
+	return len(r.Issues) > 0
+}
+
+// IssuesJSON returns the issues as a JSON array
+func (r *ScanReview) IssuesJSON() (json.RawMessage, error) {
+	if r.Issues == nil {
+		return json.RawMessage("[]"), nil
+	}
+	return json.Marshal(r.Issues)
+}
+
+// ProcessingStatus returns the turn sheet processing status for a scan, which holds the
+// turn sheet for review when issues were found
+func (r *ScanReview) ProcessingStatus() string {
+	if r.NeedsReview() {
+		return game_record.TurnSheetProcessingStatusReview
+	}
+	return game_record.TurnSheetProcessingStatusProcessed
+}
+
+func (r *ScanReview) addIssue(issueType, message string) {
+	r.Issues = append(r.Issues, ScanIssue{Type: issueType, Message: message})
+	r.Confidence -= scanIssuePenalty[issueType]
+	if r.Confidence < 0 {
+		r.Confidence = 0
+	}
+}
+
+// ReviewScanData checks the data scanned from a turn sheet image. The turn sheet code
+// read from the image is checked against the code the turn sheet was printed with,
+// where an empty code means no code could be read. The scanned data is validated
+// against the scanned data schema for the turn sheet type, and against the choices
+// offered on the turn sheet when the document scanner implements ScanDataValidator.
+func ReviewScanData(l logger.Logger, cfg config.Config, sheetType string, sheetData []byte, scanData []byte, code string) (*ScanReview, error) {
+	l = l.WithFunctionContext("ReviewScanData")
+
+	review := &ScanReview{
+		Confidence: 1,
+		Issues:     []ScanIssue{},
+	}
+
+	var templateData TurnSheetTemplateData
+	if err := json.Unmarshal(sheetData, &templateData); err != nil {
+		return nil, fmt.Errorf("failed to parse sheet data: %w", err)
+	}
+
+	switch {
+	case code == "":
+		review.addIssue(ScanIssueTypeMissingCode, "the turn sheet code could not be read from the scanned image")
+	case templateData.TurnSheetCode != nil && *templateData.TurnSheetCode != code:
+		review.addIssue(ScanIssueTypeWrongCode, "the turn sheet code on the scanned image is for a different turn sheet")
+	}
+
+	if err := ValidateScanData(l, cfg, sheetType, sheetData, scanData); err != nil {
+		l.Info("scanned data for sheet type >%s< needs review >%v<", sheetType, err)
+		issueType := ScanIssueTypeOutOfRange
+		var schemaErr *scanDataSchemaError
+		if errors.As(err, &schemaErr) {
+			issueType = ScanIssueTypeInvalidData
+		}
+		review.addIssue(issueType, err.Error())
+	}
+
+	return review, nil
+}
+
+// scanDataSchemaError is returned when scanned data does not match its schema
+type scanDataSchemaError struct {
+	err error
+}
+
+func (e *scanDataSchemaError) Error() string {
+	return fmt.Sprintf("the scanned data is not valid for this turn sheet: %v", e.err)
+}
+
+// ValidateScanData validates scanned data against the scanned data schema for the turn
+// sheet type and against the choices offered on the turn sheet. This is used to check
+// scanned data read from an image as well as scanned data corrected by a manager.
+func ValidateScanData(l logger.Logger, cfg config.Config, sheetType string, sheetData []byte, scanData []byte) error {
+	if schemaName := ScannedDataSchemaName(sheetType); schemaName != "" {
+		schema := jsonschema.SchemaWithReferences{
+			Main: jsonschema.Schema{
+				Location: ScannedDataSchemaLocationForType(sheetType),
+				Name:     schemaName,
+			},
+		}
+		schema = jsonschema.ResolveSchemaLocation(cfg.SchemaPath, schema)
+		if err := jsonschema.ValidateJSON(schema, scanData); err != nil {
+			return &scanDataSchemaError{err: err}
+		}
+	}
+
+	processor, err := GetDocumentProcessor(l, cfg, sheetType)
+	if err != nil {
+		return err
+	}
+
+	if validator, ok := processor.(ScanDataValidator); ok {
+		if err := validator.ValidateScanData(sheetData, scanData); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package turnsheet_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	coreconfig "gitlab.com/alienspaces/playbymail/core/config"
+	"gitlab.com/alienspaces/playbymail/core/convert"
+	corelog "gitlab.com/alienspaces/playbymail/core/log"
+	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+func TestReviewScanData(t *testing.T) {
+	t.Parallel()
+
+	l := corelog.NewDefaultLogger()
+	cfg := config.Config{Config: coreconfig.Config{
+		TemplatesPath: "../../templates",
+		SchemaPath:    "../../schema",
+	}}
+
+	sheetData, err := json.Marshal(turnsheet.LocationChoiceData{
+		TurnSheetTemplateData: turnsheet.TurnSheetTemplateData{
+			TurnSheetCode: convert.Ptr("turn-sheet-code"),
+		},
+		LocationOptions: []turnsheet.LocationOption{
+			{LocationID: "location-1", LocationLinkName: "North"},
+			{LocationID: "location-2", LocationLinkName: "South", IsLocked: true},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		scanData       string
+		code           string
+		wantIssueTypes []string
+		wantConfidence float64
+	}{
+		{
+			name:           "offered choice with matching code needs no review",
+			scanData:       `{"choices":["location-1"]}`,
+			code:           "turn-sheet-code",
+			wantConfidence: 1,
+		},
+		{
+			name:           "missing code needs review",
+			scanData:       `{"choices":["location-1"]}`,
+			code:           "",
+			wantIssueTypes: []string{turnsheet.ScanIssueTypeMissingCode},
+			wantConfidence: 0.75,
+		},
+		{
+			name:           "code for another turn sheet needs review",
+			scanData:       `{"choices":["location-1"]}`,
+			code:           "another-turn-sheet-code",
+			wantIssueTypes: []string{turnsheet.ScanIssueTypeWrongCode},
+			wantConfidence: 0,
+		},
+		{
+			name:           "locked location choice is out of range",
+			scanData:       `{"choices":["location-2"]}`,
+			code:           "turn-sheet-code",
+			wantIssueTypes: []string{turnsheet.ScanIssueTypeOutOfRange},
+			wantConfidence: 0.5,
+		},
+		{
+			name:           "scanned data not matching the schema is invalid",
+			scanData:       `{"choices":"location-1"}`,
+			code:           "",
+			wantIssueTypes: []string{turnsheet.ScanIssueTypeMissingCode, turnsheet.ScanIssueTypeInvalidData},
+			wantConfidence: 0.25,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			review, err := turnsheet.ReviewScanData(l, cfg, adventure_game_record.AdventureGameTurnSheetTypeLocationChoice, sheetData, []byte(tt.scanData), tt.code)
+			require.NoError(t, err)
+
+			issueTypes := []string{}
+			for _, issue := range review.Issues {
+				issueTypes = append(issueTypes, issue.Type)
+			}
+			if tt.wantIssueTypes == nil {
+				tt.wantIssueTypes = []string{}
+			}
+			require.Equal(t, tt.wantIssueTypes, issueTypes)
+			require.Equal(t, len(tt.wantIssueTypes) > 0, review.NeedsReview())
+			require.InDelta(t, tt.wantConfidence, review.Confidence, 0.001)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/agent"
 	"gitlab.com/alienspaces/playbymail/internal/utils/config"
 )
 
@@ -21,8 +22,16 @@ type turnSheetScanner struct {
 	cfg config.Config
 }
 
-// NewScanner creates a new turn sheet scanner instance
+// NewScanner creates a new turn sheet scanner instance. The local optical mark
+// reader is used when the agent provider is "local", otherwise turn sheets are
+// read by the hosted AI agent.
 func NewScanner(cfg config.Config) (TurnSheetScanner, error) {
+	if cfg.AgentProvider == agent.ProviderLocal {
+		return &localTurnSheetScanner{
+			cfg: cfg,
+		}, nil
+	}
+
 	return &turnSheetScanner{
 		cfg: cfg,
 	}, nil
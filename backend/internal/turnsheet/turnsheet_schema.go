@@ -3,6 +3,7 @@ package turnsheet
 import (
 	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
 	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_tactics_game_record"
 )
 
 // ScannedDataSchemaLocation is the subdirectory (relative to SchemaPath) where scanned_data
@@ -12,14 +13,20 @@ const ScannedDataSchemaLocation = "turnsheet/adventure_game"
 // MechaGameScannedDataSchemaLocation is the subdirectory for mecha scanned_data schemas.
 const MechaGameScannedDataSchemaLocation = "turnsheet/mecha_game"
 
+// MechaTacticsGameScannedDataSchemaLocation is the subdirectory for mecha tactics scanned_data schemas.
+const MechaTacticsGameScannedDataSchemaLocation = "turnsheet/mecha_tactics_game"
+
 // scannedDataSchemaLocationBySheetType maps turn sheet types to their schema subdirectory.
 var scannedDataSchemaLocationBySheetType = map[string]string{
 	adventure_game_record.AdventureGameTurnSheetTypeLocationChoice:      ScannedDataSchemaLocation,
 	adventure_game_record.AdventureGameTurnSheetTypeInventoryManagement: ScannedDataSchemaLocation,
 	adventure_game_record.AdventureGameTurnSheetTypeCreatureEncounter:   ScannedDataSchemaLocation,
-	mecha_game_record.MechaGameTurnSheetTypeJoinGame:                             MechaGameScannedDataSchemaLocation,
-	mecha_game_record.MechaGameTurnSheetTypeOrders:                               MechaGameScannedDataSchemaLocation,
-	mecha_game_record.MechaGameTurnSheetTypeSquadManagement:                      MechaGameScannedDataSchemaLocation,
+	mecha_game_record.MechaGameTurnSheetTypeJoinGame:                    MechaGameScannedDataSchemaLocation,
+	mecha_game_record.MechaGameTurnSheetTypeOrders:                      MechaGameScannedDataSchemaLocation,
+	mecha_game_record.MechaGameTurnSheetTypeSquadManagement:             MechaGameScannedDataSchemaLocation,
+	mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeJoinGame:     MechaTacticsGameScannedDataSchemaLocation,
+	mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders:       MechaTacticsGameScannedDataSchemaLocation,
+	mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeRepair:       MechaTacticsGameScannedDataSchemaLocation,
 }
 
 // scannedDataSchemaNameBySheetType maps turn sheet types to their scanned_data schema filename.
@@ -28,9 +35,12 @@ var scannedDataSchemaNameBySheetType = map[string]string{
 	adventure_game_record.AdventureGameTurnSheetTypeLocationChoice:      LocationChoiceScannedDataSchemaName,
 	adventure_game_record.AdventureGameTurnSheetTypeInventoryManagement: InventoryManagementScannedDataSchemaName,
 	adventure_game_record.AdventureGameTurnSheetTypeCreatureEncounter:   MonsterEncounterScannedDataSchemaName,
-	mecha_game_record.MechaGameTurnSheetTypeJoinGame:                             JoinGameScannedDataSchemaName,
-	mecha_game_record.MechaGameTurnSheetTypeOrders:                               OrdersScannedDataSchemaName,
-	mecha_game_record.MechaGameTurnSheetTypeSquadManagement:                      SquadManagementScannedDataSchemaName,
+	mecha_game_record.MechaGameTurnSheetTypeJoinGame:                    JoinGameScannedDataSchemaName,
+	mecha_game_record.MechaGameTurnSheetTypeOrders:                      OrdersScannedDataSchemaName,
+	mecha_game_record.MechaGameTurnSheetTypeSquadManagement:             SquadManagementScannedDataSchemaName,
+	mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeJoinGame:     JoinGameScannedDataSchemaName,
+	mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeOrders:       OrdersScannedDataSchemaName,
+	mecha_tactics_game_record.MechaTacticsGameTurnSheetTypeRepair:       MechaTacticsGameRepairScannedDataSchemaName,
 }
 
 // ScannedDataSchemaName returns the JSON schema filename for the given sheet type's scanned_data,
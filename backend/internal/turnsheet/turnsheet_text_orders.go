@@ -0,0 +1,105 @@
+package turnsheet
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gitlab.com/alienspaces/playbymail/core/type/logger"
+	"gitlab.com/alienspaces/playbymail/internal/utils/config"
+)
+
+// TextOrder is a single order line from a plain-text turn submission, for
+// example the body of an email reply to a turn sheet notification.
+//
+// Each order line starts with a keyword followed by its arguments:
+//
+//	GO <location option>
+//	MECH <callsign> MOVE <sector> ATTACK <enemy callsign>
+//
+// Keywords and names are matched case-insensitively.
+type TextOrder struct {
+	Line    int
+	Keyword string
+	Args    string
+}
+
+func (o TextOrder) String() string {
+	return strings.TrimSpace(o.Keyword + " " + o.Args)
+}
+
+// TextOrderParser is implemented by document processors whose turn sheets can
+// be completed with plain-text orders.
+type TextOrderParser interface {
+	// TextOrderKeywords returns the upper case order keywords this sheet type accepts
+	TextOrderKeywords() []string
+	// ParseTextOrders converts order lines into scan data
+	// sheetData: JSON-encoded sheet data from the database
+	// orders: the order lines whose keyword is one of TextOrderKeywords
+	// Returns: JSON-encoded scan results to store in the database
+	ParseTextOrders(ctx context.Context, l logger.Logger, sheetData []byte, orders []TextOrder) ([]byte, error)
+}
+
+var textOrderLineRe = regexp.MustCompile(`^([A-Za-z]+)(?:[\s:]+(.*))?$`)
+
+// ParseTextOrderLines splits plain text into order lines. Blank lines are
+// skipped and the keyword of each remaining line is upper cased.
+func ParseTextOrderLines(text string) []TextOrder {
+	var orders []TextOrder
+	for i, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		order := TextOrder{Line: i + 1}
+		if match := textOrderLineRe.FindStringSubmatch(line); match != nil {
+			order.Keyword = strings.ToUpper(match[1])
+			order.Args = strings.TrimSpace(match[2])
+		} else {
+			order.Args = line
+		}
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+// GetTextOrderParser returns the text order parser for a turn sheet type, or
+// nil when the sheet type can only be completed on the sheet itself.
+func GetTextOrderParser(l logger.Logger, cfg config.Config, sheetType string) (TextOrderParser, error) {
+	processor, err := GetDocumentProcessor(l, cfg, sheetType)
+	if err != nil {
+		return nil, err
+	}
+
+	parser, ok := processor.(TextOrderParser)
+	if !ok {
+		return nil, nil
+	}
+
+	return parser, nil
+}
+
+// matchTextOrderName returns the value of the option whose name matches the
+// given name, ignoring case and surrounding whitespace.
+func matchTextOrderName(name string, options map[string]string) (string, bool) {
+	name = strings.TrimSpace(name)
+	for optionName, value := range options {
+		if strings.EqualFold(strings.TrimSpace(optionName), name) {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// textOrderOptionNames returns option names for use in error messages
+func textOrderOptionNames(options map[string]string) string {
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, fmt.Sprintf("%q", name))
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,187 @@
+package turnsheet_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/alienspaces/playbymail/internal/record/adventure_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/game_record"
+	"gitlab.com/alienspaces/playbymail/internal/record/mecha_game_record"
+	"gitlab.com/alienspaces/playbymail/internal/turnsheet"
+	"gitlab.com/alienspaces/playbymail/internal/utils/testutil"
+)
+
+func TestParseTextOrderLines(t *testing.T) {
+	orders := turnsheet.ParseTextOrderLines("go  Enter the Forest\r\n\r\nMECH: Hammer move Ridge\n42\n")
+
+	require.Equal(t, []turnsheet.TextOrder{
+		{Line: 1, Keyword: "GO", Args: "Enter the Forest"},
+		{Line: 3, Keyword: "MECH", Args: "Hammer move Ridge"},
+		{Line: 4, Keyword: "", Args: "42"},
+	}, orders)
+}
+
+func TestGetTextOrderParser(t *testing.T) {
+	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
+
+	parser, err := turnsheet.GetTextOrderParser(l, cfg, adventure_game_record.AdventureGameTurnSheetTypeLocationChoice)
+	require.NoError(t, err)
+	require.NotNil(t, parser)
+
+	parser, err = turnsheet.GetTextOrderParser(l, cfg, mecha_game_record.MechaGameTurnSheetTypeOrders)
+	require.NoError(t, err)
+	require.NotNil(t, parser)
+
+	parser, err = turnsheet.GetTextOrderParser(l, cfg, game_record.GameTurnSheetTypeGameResults)
+	require.NoError(t, err)
+	require.Nil(t, parser, "informational sheets do not accept text orders")
+}
+
+func TestLocationChoiceProcessor_ParseTextOrders(t *testing.T) {
+	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
+
+	processor, err := turnsheet.NewLocationChoiceProcessor(l, cfg)
+	require.NoError(t, err)
+
+	sheetData, err := json.Marshal(turnsheet.LocationChoiceData{
+		LocationName: "Village",
+		LocationOptions: []turnsheet.LocationOption{
+			{LocationID: "loc-1", LocationLinkName: "Enter the Forest"},
+			{LocationID: "loc-2", LocationLinkName: "Follow the River"},
+			{LocationID: "loc-3", LocationLinkName: "The Iron Gate", IsLocked: true},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		text      string
+		wantID    string
+		wantError string
+	}{
+		{name: "matches option ignoring case", text: "GO follow the river", wantID: "loc-2"},
+		{name: "unknown option", text: "GO Fly Away", wantError: "is not one of the available locations"},
+		{name: "locked option", text: "GO The Iron Gate", wantError: "is not one of the available locations"},
+		{name: "more than one order", text: "GO Enter the Forest\nGO Follow the River", wantError: "exactly one location"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scanData, err := processor.ParseTextOrders(context.Background(), l, sheetData, turnsheet.ParseTextOrderLines(tt.text))
+			if tt.wantError != "" {
+				require.ErrorContains(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+
+			var got turnsheet.LocationChoiceScanData
+			require.NoError(t, json.Unmarshal(scanData, &got))
+			require.Equal(t, []string{tt.wantID}, got.GetChoices())
+		})
+	}
+}
+
+func TestMechaGameOrdersProcessor_ParseTextOrders(t *testing.T) {
+	cfg, l, _, _, _ := testutil.NewDefaultDependencies(t)
+
+	processor, err := turnsheet.NewMechaGameOrdersProcessor(l, cfg)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"MECH"}, processor.TextOrderKeywords())
+
+	sheetData, err := json.Marshal(turnsheet.OrdersData{
+		SquadMechs: []turnsheet.MechOrderEntry{
+			{
+				MechInstanceID: "mech-1",
+				MechCallsign:   "Hammer",
+				ReachableSectors: []turnsheet.SectorOption{
+					{SectorInstanceID: "sector-2", SectorName: "Northern Ridge"},
+				},
+				OverwatchSectors: []turnsheet.SectorOption{
+					{SectorInstanceID: "sector-1", SectorName: "Drop Zone"},
+					{SectorInstanceID: "sector-2", SectorName: "Northern Ridge"},
+				},
+			},
+			{MechInstanceID: "mech-2", MechCallsign: "Anvil"},
+		},
+		AvailableSectors: []turnsheet.SectorOption{
+			{SectorInstanceID: "sector-3", SectorName: "Central Wastes"},
+		},
+		EnemyMechs: []turnsheet.EnemyMechOption{
+			{MechInstanceID: "enemy-1", Callsign: "Stalker"},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		text      string
+		want      []turnsheet.ScannedMechOrder
+		wantError string
+	}{
+		{
+			name: "move and attack orders",
+			text: "MECH Hammer MOVE northern ridge ATTACK Stalker\nmech anvil attack stalker",
+			want: []turnsheet.ScannedMechOrder{
+				{MechInstanceID: "mech-1", MoveToSectorInstanceID: "sector-2", AttackTargetMechInstanceID: "enemy-1"},
+				{MechInstanceID: "mech-2", AttackTargetMechInstanceID: "enemy-1"},
+			},
+		},
+		{
+			name: "mech without reachable sectors uses available sectors",
+			text: "MECH Anvil MOVE Central Wastes",
+			want: []turnsheet.ScannedMechOrder{
+				{MechInstanceID: "mech-2", MoveToSectorInstanceID: "sector-3"},
+			},
+		},
+		{
+			name: "hold",
+			text: "MECH Hammer HOLD",
+			want: []turnsheet.ScannedMechOrder{
+				{MechInstanceID: "mech-1"},
+			},
+		},
+		{
+			name: "move then hold",
+			text: "MECH Hammer MOVE Northern Ridge HOLD ATTACK Stalker",
+			want: []turnsheet.ScannedMechOrder{
+				{MechInstanceID: "mech-1", MoveToSectorInstanceID: "sector-2", AttackTargetMechInstanceID: "enemy-1", HoldAfterMove: true},
+			},
+		},
+		{
+			name: "overwatch and intercept",
+			text: "MECH Hammer OVERWATCH northern ridge ATTACK Stalker\nMECH Anvil INTERCEPT Stalker",
+			want: []turnsheet.ScannedMechOrder{
+				{MechInstanceID: "mech-1", AttackTargetMechInstanceID: "enemy-1", OverwatchSectorInstanceID: "sector-2"},
+				{MechInstanceID: "mech-2", InterceptTargetMechInstanceID: "enemy-1"},
+			},
+		},
+		{name: "unknown mech", text: "MECH Sledge HOLD", wantError: "is not one of your mechs"},
+		{name: "hold without move", text: "MECH Hammer HOLD ATTACK Stalker", wantError: "HOLD can only be combined with MOVE"},
+		{name: "overwatch with move", text: "MECH Hammer MOVE Northern Ridge OVERWATCH Drop Zone", wantError: "OVERWATCH cannot be combined with MOVE"},
+		{name: "intercept with move", text: "MECH Hammer INTERCEPT Stalker MOVE Northern Ridge", wantError: "INTERCEPT cannot be combined with MOVE or OVERWATCH"},
+		{name: "sector out of overwatch", text: "MECH Anvil OVERWATCH Drop Zone", wantError: "is not a sector Anvil can overwatch"},
+		{name: "unreachable sector", text: "MECH Hammer MOVE Central Wastes", wantError: "is not a sector Hammer can move to"},
+		{name: "unknown target", text: "MECH Hammer ATTACK Ghost", wantError: "is not a visible enemy mech"},
+		{name: "missing action", text: "MECH Hammer", wantError: "followed by MOVE, ATTACK, HOLD, OVERWATCH or INTERCEPT"},
+		{name: "duplicate mech", text: "MECH Hammer HOLD\nMECH Hammer ATTACK Stalker", wantError: "already has orders"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scanData, err := processor.ParseTextOrders(context.Background(), l, sheetData, turnsheet.ParseTextOrderLines(tt.text))
+			if tt.wantError != "" {
+				require.ErrorContains(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+
+			var got turnsheet.OrdersScanData
+			require.NoError(t, json.Unmarshal(scanData, &got))
+			require.Equal(t, tt.want, got.MechOrders)
+		})
+	}
+}
@@ -1,6 +1,11 @@
 package turnsheet
 
-import "time"
+import (
+	"fmt"
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/internal/i18n"
+)
 
 type DocumentFormat string
 
@@ -39,6 +44,29 @@ type TurnEvent struct {
 	Category string `json:"category"` // "combat", "inventory", "movement", "world", "flee", "flee_context"
 	Icon     string `json:"icon"`     // unicode emoji
 	Message  string `json:"message"`  // human-readable narrative
+
+	// Catalogue message the narrative is rendered from in the player's locale.
+	// Events without a message key are rendered from Message as they are.
+	MessageKey  string            `json:"message_key,omitempty"`
+	MessageArgs map[string]string `json:"message_args,omitempty"`
+}
+
+// NewTurnEvent returns a turn event with a catalogue message, with Message set to
+// the English narrative. Arguments are pairs of placeholder name and value.
+func NewTurnEvent(category, icon, key string, args ...any) TurnEvent {
+	event := TurnEvent{
+		Category:   category,
+		Icon:       icon,
+		Message:    i18n.T(i18n.DefaultLocale, key, args...),
+		MessageKey: key,
+	}
+	if len(args) > 1 {
+		event.MessageArgs = make(map[string]string, len(args)/2)
+		for i := 0; i+1 < len(args); i += 2 {
+			event.MessageArgs[fmt.Sprint(args[i])] = fmt.Sprint(args[i+1])
+		}
+	}
+	return event
 }
 
 // FleeContext is stored as a TurnEvent with category "flee_context" to pass flee state
@@ -76,6 +104,9 @@ type TurnSheetTemplateData struct {
 	// Account data
 	AccountName *string `json:"account_name"`
 
+	// Locale the turn sheet is rendered in, the default locale when not set
+	Locale *string `json:"locale,omitempty"`
+
 	// Background image (single image covering the page)
 	BackgroundImage *string `json:"background_image"`
 
@@ -89,3 +120,11 @@ type TurnSheetTemplateData struct {
 	TurnEvents    []TurnEvent `json:"turn_events,omitempty"`
 	HideNarrative bool        `json:"hide_narrative,omitempty"`
 }
+
+// TemplateLocale returns the locale the turn sheet is rendered in
+func (d TurnSheetTemplateData) TemplateLocale() string {
+	if d.Locale == nil {
+		return i18n.DefaultLocale
+	}
+	return i18n.NormalizeLocale(*d.Locale)
+}
@@ -16,6 +16,48 @@ type Config struct {
 	// Game turn queueing periodic job interval
 	GameTurnQueueingIntervalSeconds int `env:"GAME_TURN_QUEUEING_INTERVAL_SECONDS" envDefault:"3600"`
 
+	// Turn deadline reminder emails for players with turn sheets still to submit.
+	// - TurnReminderHours: comma separated hours before the turn deadline a reminder is sent,
+	//   "0" disables reminders
+	// - TurnReminderIntervalSeconds: turn reminder periodic job interval
+	TurnReminderHours           []int `env:"TURN_REMINDER_HOURS" envDefault:"24,2"`
+	TurnReminderIntervalSeconds int   `env:"TURN_REMINDER_INTERVAL_SECONDS" envDefault:"600"`
+
+	// Print device for physical post and local delivery turn sheet print batches.
+	// When PRINT_DEVICE_HOST is empty print batches are recorded but not submitted.
+	// - PrintDeviceProtocol: "cups" (IPP, supports job tracking), "raw", "http"
+	// - PrintDeviceQueue: CUPS printer queue name
+	PrintDeviceHost     string `env:"PRINT_DEVICE_HOST" envDefault:""`
+	PrintDevicePort     int    `env:"PRINT_DEVICE_PORT" envDefault:"631"`
+	PrintDeviceProtocol string `env:"PRINT_DEVICE_PROTOCOL" envDefault:"cups"`
+	PrintDeviceQueue    string `env:"PRINT_DEVICE_QUEUE" envDefault:"default"`
+	PrintPaperSize      string `env:"PRINT_PAPER_SIZE" envDefault:"iso_a4_210x297mm"`
+
+	// Print batch job state polling periodic job interval
+	PrintBatchStatusIntervalSeconds int `env:"PRINT_BATCH_STATUS_INTERVAL_SECONDS" envDefault:"60"`
+
+	// Inbound mail for turn submission by replying to turn sheet notification emails.
+	// When INBOUND_MAIL_PROVIDER is empty inbound mail is not polled.
+	// - InboundMailProvider: "maildir" (any MTA delivering to a local maildir)
+	// - InboundMailMaildirPath: maildir directory containing new/, cur/ and tmp/
+	// - InboundMailAddress: address players reply to, used as the sender of turn sheet notifications
+	// - InboundMailRequireSenderAuth: reject mail the receiving MTA did not pass DKIM, SPF or DMARC
+	//   checks for the sender's domain, as reported in the Authentication-Results header
+	// - InboundMailAuthServID: authserv-id of the receiving MTA, only its Authentication-Results
+	//   header is trusted; when empty the topmost header is used
+	InboundMailProvider            string `env:"INBOUND_MAIL_PROVIDER" envDefault:""`
+	InboundMailMaildirPath         string `env:"INBOUND_MAIL_MAILDIR_PATH" envDefault:""`
+	InboundMailAddress             string `env:"INBOUND_MAIL_ADDRESS" envDefault:"turns@playbymail.games"`
+	InboundMailPollIntervalSeconds int    `env:"INBOUND_MAIL_POLL_INTERVAL_SECONDS" envDefault:"60"`
+	InboundMailRequireSenderAuth   bool   `env:"INBOUND_MAIL_REQUIRE_SENDER_AUTH" envDefault:"true"`
+	InboundMailAuthServID          string `env:"INBOUND_MAIL_AUTHSERV_ID" envDefault:""`
+
+	// Turn sheet rendering with a shared headless Chrome browser.
+	// - RendererMaxTabs: browser tabs rendering documents at once
+	// - RendererPDFCacheMB: size of the in memory cache of rendered PDFs, "0" disables the cache
+	RendererMaxTabs    int `env:"RENDERER_MAX_TABS" envDefault:"4"`
+	RendererPDFCacheMB int `env:"RENDERER_PDF_CACHE_MB" envDefault:"64"`
+
 	// Email addresses
 	SupportEmailAddress string `env:"SUPPORT_EMAIL_ADDRESS" envDefault:"support@playbymail.games"`
 	NoReplyEmailAddress string `env:"NO_REPLY_EMAIL_ADDRESS" envDefault:"noreply@playbymail.games"`
@@ -50,7 +92,8 @@ type Config struct {
 	// OpenAIVisionModel above for reasoning.
 	OpenAITextModel string `env:"OPENAI_TEXT_MODEL"`
 
-	// Anthropic settings (future)
+	// Anthropic settings, used when AgentProvider is "anthropic". AnthropicModel is
+	// used for both turn sheet scanning and text generation.
 	AnthropicAPIKey string `env:"ANTHROPIC_API_KEY" envDefault:""`
 	AnthropicModel  string `env:"ANTHROPIC_MODEL" envDefault:"claude-3-opus"`
 }
@@ -8,12 +8,14 @@ import (
 
 // AccountResponseData -
 type AccountResponseData struct {
-	ID        string     `json:"id"`
-	Name      string     `json:"name"`
-	Status    string     `json:"status"`
-	Timezone  *string    `json:"timezone,omitempty"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	ID                 string     `json:"id"`
+	Name               string     `json:"name"`
+	Status             string     `json:"status"`
+	Timezone           *string    `json:"timezone,omitempty"`
+	Locale             *string    `json:"locale,omitempty"`
+	TurnReminderOptOut bool       `json:"turn_reminder_opt_out"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
 }
 
 type AccountResponse struct {
@@ -30,8 +32,10 @@ type AccountCollectionResponse struct {
 
 type AccountRequest struct {
 	common_schema.Request
-	Name     *string `json:"name,omitempty"`
-	Timezone *string `json:"timezone,omitempty"`
+	Name               *string `json:"name,omitempty"`
+	Timezone           *string `json:"timezone,omitempty"`
+	Locale             *string `json:"locale,omitempty"`
+	TurnReminderOptOut *bool   `json:"turn_reminder_opt_out,omitempty"`
 }
 
 type AccountQueryParams struct {
@@ -139,3 +143,56 @@ type AccountUserQueryParams struct {
 	common_schema.QueryParamsPagination
 	AccountUserResponseData
 }
+
+// AccountUserSessionResponseData -
+type AccountUserSessionResponseData struct {
+	ID            string     `json:"id"`
+	AccountUserID string     `json:"account_user_id"`
+	UserAgent     string     `json:"user_agent,omitempty"`
+	Current       bool       `json:"current"`
+	LastSeenAt    time.Time  `json:"last_seen_at"`
+	ExpiresAt     time.Time  `json:"expires_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
+
+type AccountUserSessionCollectionResponse struct {
+	Data       []*AccountUserSessionResponseData `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+// AccountUserAPITokenResponseData -
+type AccountUserAPITokenResponseData struct {
+	ID            string     `json:"id"`
+	AccountUserID string     `json:"account_user_id"`
+	Name          string     `json:"name"`
+	Token         string     `json:"token,omitempty"` // Only returned when the token is created
+	TokenPrefix   string     `json:"token_prefix"`
+	Permissions   []string   `json:"permissions"`
+	GameIDs       []string   `json:"game_ids"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt    *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
+
+type AccountUserAPITokenResponse struct {
+	Data       *AccountUserAPITokenResponseData  `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+type AccountUserAPITokenCollectionResponse struct {
+	Data       []*AccountUserAPITokenResponseData `json:"data"`
+	Error      *common_schema.ResponseError       `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination  `json:"pagination,omitempty"`
+}
+
+type AccountUserAPITokenRequest struct {
+	common_schema.Request
+	Name        string     `json:"name"`
+	Permissions []string   `json:"permissions"`
+	GameIDs     []string   `json:"game_ids,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
@@ -16,6 +16,7 @@ type AdventureGameItemResponseData struct {
 	ItemCategory   string     `json:"item_category,omitempty"`
 	EquipmentSlot  string     `json:"equipment_slot,omitempty"`
 	IsStartingItem bool       `json:"is_starting_item"`
+	IsGoalItem     bool       `json:"is_goal_item"`
 	CreatedAt      time.Time  `json:"created_at"`
 	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
 	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
@@ -41,6 +42,7 @@ type AdventureGameItemRequest struct {
 	ItemCategory   string `json:"item_category,omitempty"`
 	EquipmentSlot  string `json:"equipment_slot,omitempty"`
 	IsStartingItem bool   `json:"is_starting_item"`
+	IsGoalItem     bool   `json:"is_goal_item"`
 }
 
 type AdventureGameItemQueryParams struct {
@@ -13,6 +13,7 @@ type AdventureGameLocationResponseData struct {
 	Name               string     `json:"name"`
 	Description        string     `json:"description"`
 	IsStartingLocation bool       `json:"is_starting_location"`
+	IsGoalLocation     bool       `json:"is_goal_location"`
 	CreatedAt          time.Time  `json:"created_at"`
 	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
 	DeletedAt          *time.Time `json:"deleted_at,omitempty"`
@@ -35,6 +36,7 @@ type AdventureGameLocationRequest struct {
 	Name               string `json:"name"`
 	Description        string `json:"description"`
 	IsStartingLocation bool   `json:"is_starting_location,omitempty"`
+	IsGoalLocation     bool   `json:"is_goal_location,omitempty"`
 }
 
 type AdventureGameLocationQueryParams struct {
@@ -24,6 +24,10 @@ type GameInstanceResponseData struct {
 	PlayerCount                       int        `json:"player_count"`
 	IsClosedTesting                   bool       `json:"is_closed_testing"`
 	ProcessWhenAllSubmitted           bool       `json:"process_when_all_submitted"`
+	MaxTurns                          *int64     `json:"max_turns,omitempty"`
+	MissedTurnPolicy                  string     `json:"missed_turn_policy"`
+	MissedTurnTakeoverAfter           *int64     `json:"missed_turn_takeover_after,omitempty"`
+	MissedTurnDropAfter               *int64     `json:"missed_turn_drop_after,omitempty"`
 	ClosedTestingJoinGameKey          *string    `json:"join_game_key,omitempty"`
 	ClosedTestingJoinGameKeyExpiresAt *time.Time `json:"join_game_key_expires_at,omitempty"`
 	CreatedAt                         time.Time  `json:"created_at"`
@@ -58,6 +62,10 @@ type GameInstanceRequest struct {
 	RequiredPlayerCount   int        `json:"required_player_count,omitempty"`
 	IsClosedTesting            bool `json:"is_closed_testing,omitempty"`
 	ProcessWhenAllSubmitted    bool `json:"process_when_all_submitted,omitempty"`
+	MaxTurns                   *int64 `json:"max_turns,omitempty"`
+	MissedTurnPolicy           string `json:"missed_turn_policy,omitempty"`
+	MissedTurnTakeoverAfter    *int64 `json:"missed_turn_takeover_after,omitempty"`
+	MissedTurnDropAfter        *int64 `json:"missed_turn_drop_after,omitempty"`
 }
 
 type JoinGameLinkResponseData struct {
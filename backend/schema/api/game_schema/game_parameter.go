@@ -10,6 +10,8 @@ type GameParameter struct {
 	Description  *string `json:"description,omitempty"`
 	ValueType    string  `json:"value_type"`
 	DefaultValue *string `json:"default_value,omitempty"`
+	MinValue     *int    `json:"min_value,omitempty"`
+	MaxValue     *int    `json:"max_value,omitempty"`
 }
 
 type GameParameterCollectionResponse struct {
@@ -0,0 +1,45 @@
+package game_schema
+
+import (
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/schema/api/common_schema"
+)
+
+type GamePrintBatch struct {
+	ID                         string     `json:"id"`
+	GameID                     string     `json:"game_id"`
+	GameInstanceID             string     `json:"game_instance_id"`
+	GameSubscriptionInstanceID string     `json:"game_subscription_instance_id,omitempty"`
+	TurnNumber                 int        `json:"turn_number"`
+	Status                     string     `json:"status"`
+	PlayerCount                int        `json:"player_count"`
+	DocumentCount              int        `json:"document_count"`
+	PrinterJobID               string     `json:"printer_job_id,omitempty"`
+	PrinterJobState            string     `json:"printer_job_state,omitempty"`
+	PrinterJobStateMessage     string     `json:"printer_job_state_message,omitempty"`
+	ErrorMessage               string     `json:"error_message,omitempty"`
+	SubmittedAt                *time.Time `json:"submitted_at,omitempty"`
+	CompletedAt                *time.Time `json:"completed_at,omitempty"`
+	CreatedAt                  time.Time  `json:"created_at"`
+	UpdatedAt                  *time.Time `json:"updated_at,omitempty"`
+}
+
+type GamePrintBatchResponse struct {
+	Data       *GamePrintBatch                   `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+type GamePrintBatchCollectionResponse struct {
+	Data       []*GamePrintBatch                 `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+// GamePrintBatchReprintRequest requests a reprint of a print batch turn. When
+// GameSubscriptionInstanceID is set only that player's turn sheets are reprinted.
+type GamePrintBatchReprintRequest struct {
+	common_schema.Request
+	GameSubscriptionInstanceID string `json:"game_subscription_instance_id,omitempty"`
+}
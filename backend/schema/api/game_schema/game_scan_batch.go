@@ -0,0 +1,57 @@
+package game_schema
+
+import (
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/schema/api/common_schema"
+)
+
+type GameScanBatch struct {
+	ID              string     `json:"id"`
+	GameID          string     `json:"game_id"`
+	GameInstanceID  string     `json:"game_instance_id"`
+	Status          string     `json:"status"`
+	FileName        string     `json:"file_name"`
+	PageCount       int        `json:"page_count"`
+	PagesProcessed  int        `json:"pages_processed"`
+	MatchedCount    int        `json:"matched_count"`
+	DuplicateCount  int        `json:"duplicate_count"`
+	UnreadableCount int        `json:"unreadable_count"`
+	WrongTurnCount  int        `json:"wrong_turn_count"`
+	WrongGameCount  int        `json:"wrong_game_count"`
+	ErrorMessage    string     `json:"error_message,omitempty"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+type GameScanBatchResponse struct {
+	Data       *GameScanBatch                    `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+type GameScanBatchCollectionResponse struct {
+	Data       []*GameScanBatch                  `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+type GameScanBatchPage struct {
+	ID              string     `json:"id"`
+	GameScanBatchID string     `json:"game_scan_batch_id"`
+	PageNumber      int        `json:"page_number"`
+	Source          string     `json:"source"`
+	Status          string     `json:"status"`
+	GameTurnSheetID string     `json:"game_turn_sheet_id,omitempty"`
+	SheetType       string     `json:"sheet_type,omitempty"`
+	Message         string     `json:"message,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+type GameScanBatchPageCollectionResponse struct {
+	Data       []*GameScanBatchPage              `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
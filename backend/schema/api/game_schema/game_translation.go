@@ -0,0 +1,38 @@
+package game_schema
+
+import (
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/schema/api/common_schema"
+)
+
+type GameTranslation struct {
+	ID        string     `json:"id"`
+	GameID    string     `json:"game_id"`
+	Locale    string     `json:"locale"`
+	RecordID  string     `json:"record_id"`
+	FieldName string     `json:"field_name"`
+	Text      string     `json:"text"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+type GameTranslationResponse struct {
+	Data       *GameTranslation                  `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+type GameTranslationCollectionResponse struct {
+	Data       []*GameTranslation                `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+type GameTranslationRequest struct {
+	common_schema.Request
+	Locale    string `json:"locale"`
+	RecordID  string `json:"record_id"`
+	FieldName string `json:"field_name"`
+	Text      string `json:"text"`
+}
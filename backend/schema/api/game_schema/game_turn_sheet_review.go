@@ -0,0 +1,50 @@
+package game_schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/schema/api/common_schema"
+)
+
+// GameTurnSheetReview is a scanned turn sheet held for a manager to review, with the
+// data scanned from the turn sheet image and the issues found checking it
+type GameTurnSheetReview struct {
+	GameTurnSheetID         string                     `json:"game_turn_sheet_id"`
+	GameInstanceID          string                     `json:"game_instance_id"`
+	AccountUserID           string                     `json:"account_user_id"`
+	TurnNumber              int                        `json:"turn_number"`
+	SheetType               string                     `json:"sheet_type"`
+	ProcessingStatus        string                     `json:"processing_status"`
+	SheetData               json.RawMessage            `json:"sheet_data"`
+	ScannedData             json.RawMessage            `json:"scanned_data,omitempty"`
+	ScannedAt               *time.Time                 `json:"scanned_at,omitempty"`
+	Confidence              float64                    `json:"confidence"`
+	Issues                  []GameTurnSheetReviewIssue `json:"issues"`
+	HasImage                bool                       `json:"has_image"`
+	ReviewedByAccountUserID string                     `json:"reviewed_by_account_user_id,omitempty"`
+	ReviewedAt              *time.Time                 `json:"reviewed_at,omitempty"`
+}
+
+// GameTurnSheetReviewIssue is a problem found checking the scanned data
+type GameTurnSheetReviewIssue struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+type GameTurnSheetReviewResponse struct {
+	Data       *GameTurnSheetReview              `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+type GameTurnSheetReviewCollectionResponse struct {
+	Data       []*GameTurnSheetReview            `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+// GameTurnSheetReviewRequest corrects the scanned data of a turn sheet held for review
+type GameTurnSheetReviewRequest struct {
+	ScannedData json.RawMessage `json:"scanned_data"`
+}
@@ -0,0 +1,40 @@
+package mecha_game_schema
+
+import (
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/schema/api/common_schema"
+)
+
+type MechaGameCampaignResponseData struct {
+	ID            string     `json:"id"`
+	AccountUserID string     `json:"account_user_id"`
+	Name          string     `json:"name"`
+	Description   string     `json:"description"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+}
+
+type MechaGameCampaignResponse struct {
+	Data       *MechaGameCampaignResponseData    `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+type MechaGameCampaignCollectionResponse struct {
+	Data       []*MechaGameCampaignResponseData  `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+type MechaGameCampaignRequest struct {
+	common_schema.Request
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type MechaGameCampaignQueryParams struct {
+	common_schema.QueryParamsPagination
+	MechaGameCampaignResponseData
+}
@@ -0,0 +1,40 @@
+package mecha_game_schema
+
+import (
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/schema/api/common_schema"
+)
+
+type MechaGameCampaignInstanceResponseData struct {
+	ID                  string     `json:"id"`
+	MechaGameCampaignID string     `json:"mecha_game_campaign_id"`
+	GameInstanceID      string     `json:"game_instance_id"`
+	SequenceNumber      int        `json:"sequence_number"`
+	RecordedAt          *time.Time `json:"recorded_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+	DeletedAt           *time.Time `json:"deleted_at,omitempty"`
+}
+
+type MechaGameCampaignInstanceResponse struct {
+	Data       *MechaGameCampaignInstanceResponseData `json:"data"`
+	Error      *common_schema.ResponseError           `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination      `json:"pagination,omitempty"`
+}
+
+type MechaGameCampaignInstanceCollectionResponse struct {
+	Data       []*MechaGameCampaignInstanceResponseData `json:"data"`
+	Error      *common_schema.ResponseError             `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination        `json:"pagination,omitempty"`
+}
+
+type MechaGameCampaignInstanceRequest struct {
+	common_schema.Request
+	GameInstanceID string `json:"game_instance_id"`
+}
+
+type MechaGameCampaignInstanceQueryParams struct {
+	common_schema.QueryParamsPagination
+	MechaGameCampaignInstanceResponseData
+}
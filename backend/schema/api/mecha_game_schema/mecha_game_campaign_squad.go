@@ -0,0 +1,54 @@
+package mecha_game_schema
+
+import (
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/schema/api/common_schema"
+)
+
+type MechaGameCampaignSquadResponseData struct {
+	ID                  string                              `json:"id"`
+	MechaGameCampaignID string                              `json:"mecha_game_campaign_id"`
+	AccountUserID       string                              `json:"account_user_id"`
+	SupplyPoints        int                                 `json:"supply_points"`
+	Salvage             []MechaGameCampaignSalvageItem      `json:"salvage"`
+	BattlesFought       int                                 `json:"battles_fought"`
+	Mechs               []MechaGameCampaignMechResponseData `json:"mechs"`
+	CreatedAt           time.Time                           `json:"created_at"`
+	UpdatedAt           *time.Time                          `json:"updated_at,omitempty"`
+	DeletedAt           *time.Time                          `json:"deleted_at,omitempty"`
+}
+
+type MechaGameCampaignSalvageItem struct {
+	WeaponName string `json:"weapon_name"`
+	Quantity   int    `json:"quantity"`
+}
+
+type MechaGameCampaignMechResponseData struct {
+	ID               string                           `json:"id"`
+	Callsign         string                           `json:"callsign"`
+	ChassisName      string                           `json:"chassis_name"`
+	CurrentArmor     int                              `json:"current_armor"`
+	CurrentStructure int                              `json:"current_structure"`
+	PilotSkill       int                              `json:"pilot_skill"`
+	ExperiencePoints int                              `json:"experience_points"`
+	Weapons          []MechaGameCampaignMechWeapon    `json:"weapons"`
+	Equipment        []MechaGameCampaignMechEquipment `json:"equipment"`
+	BattlesSurvived  int                              `json:"battles_survived"`
+}
+
+type MechaGameCampaignMechWeapon struct {
+	WeaponName   string `json:"weapon_name"`
+	SlotLocation string `json:"slot_location"`
+}
+
+type MechaGameCampaignMechEquipment struct {
+	EquipmentName string `json:"equipment_name"`
+	SlotLocation  string `json:"slot_location"`
+}
+
+type MechaGameCampaignSquadCollectionResponse struct {
+	Data       []*MechaGameCampaignSquadResponseData `json:"data"`
+	Error      *common_schema.ResponseError          `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination     `json:"pagination,omitempty"`
+}
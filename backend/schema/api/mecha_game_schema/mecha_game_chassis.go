@@ -19,6 +19,7 @@ type MechaGameChassisResponseData struct {
 	SmallSlots      int        `json:"small_slots"`
 	MediumSlots     int        `json:"medium_slots"`
 	LargeSlots      int        `json:"large_slots"`
+	SensorRange     int        `json:"sensor_range"`
 	CreatedAt       time.Time  `json:"created_at"`
 	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
 	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
@@ -48,6 +49,9 @@ type MechaGameChassisRequest struct {
 	SmallSlots      int    `json:"small_slots,omitempty"`
 	MediumSlots     int    `json:"medium_slots,omitempty"`
 	LargeSlots      int    `json:"large_slots,omitempty"`
+	// SensorRange is a pointer so an omitted value can be told apart from a
+	// chassis with no sensors
+	SensorRange *int `json:"sensor_range,omitempty"`
 }
 
 type MechaGameChassisQueryParams struct {
@@ -7,39 +7,45 @@ import (
 )
 
 type MechaGameSectorResponseData struct {
-	ID               string     `json:"id"`
-	GameID           string     `json:"game_id"`
-	Name             string     `json:"name"`
-	Description      string     `json:"description"`
-	TerrainType      string     `json:"terrain_type"`
-	Elevation        int        `json:"elevation"`
-	CoverModifier    int        `json:"cover_modifier"`
-	IsStartingSector bool       `json:"is_starting_sector"`
-	CreatedAt        time.Time  `json:"created_at"`
-	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
-	DeletedAt        *time.Time `json:"deleted_at,omitempty"`
+	ID                string     `json:"id"`
+	GameID            string     `json:"game_id"`
+	Name              string     `json:"name"`
+	Description       string     `json:"description"`
+	TerrainType       string     `json:"terrain_type"`
+	Elevation         int        `json:"elevation"`
+	CoverModifier     int        `json:"cover_modifier"`
+	IsStartingSector  bool       `json:"is_starting_sector"`
+	IsObjectiveSector bool       `json:"is_objective_sector"`
+	VictoryPoints     int        `json:"victory_points"`
+	CaptureTurns      int        `json:"capture_turns"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
 }
 
 type MechaGameSectorResponse struct {
-	Data       *MechaGameSectorResponseData          `json:"data"`
+	Data       *MechaGameSectorResponseData      `json:"data"`
 	Error      *common_schema.ResponseError      `json:"error,omitempty"`
 	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
 }
 
 type MechaGameSectorCollectionResponse struct {
-	Data       []*MechaGameSectorResponseData        `json:"data"`
+	Data       []*MechaGameSectorResponseData    `json:"data"`
 	Error      *common_schema.ResponseError      `json:"error,omitempty"`
 	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
 }
 
 type MechaGameSectorRequest struct {
 	common_schema.Request
-	Name             string `json:"name"`
-	Description      string `json:"description"`
-	TerrainType      string `json:"terrain_type,omitempty"`
-	Elevation        int    `json:"elevation,omitempty"`
-	CoverModifier    int    `json:"cover_modifier,omitempty"`
-	IsStartingSector bool   `json:"is_starting_sector,omitempty"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	TerrainType       string `json:"terrain_type,omitempty"`
+	Elevation         int    `json:"elevation,omitempty"`
+	CoverModifier     int    `json:"cover_modifier,omitempty"`
+	IsStartingSector  bool   `json:"is_starting_sector,omitempty"`
+	IsObjectiveSector bool   `json:"is_objective_sector,omitempty"`
+	VictoryPoints     int    `json:"victory_points,omitempty"`
+	CaptureTurns      int    `json:"capture_turns,omitempty"`
 }
 
 type MechaGameSectorQueryParams struct {
@@ -0,0 +1,44 @@
+package mecha_tactics_game_schema
+
+import (
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/schema/api/common_schema"
+)
+
+type MechaTacticsGameComputerOpponentResponseData struct {
+	ID          string     `json:"id"`
+	GameID      string     `json:"game_id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Aggression  int        `json:"aggression"`
+	IQ          int        `json:"iq"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+type MechaTacticsGameComputerOpponentResponse struct {
+	Data       *MechaTacticsGameComputerOpponentResponseData `json:"data"`
+	Error      *common_schema.ResponseError                  `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination             `json:"pagination,omitempty"`
+}
+
+type MechaTacticsGameComputerOpponentCollectionResponse struct {
+	Data       []*MechaTacticsGameComputerOpponentResponseData `json:"data"`
+	Error      *common_schema.ResponseError                    `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination               `json:"pagination,omitempty"`
+}
+
+type MechaTacticsGameComputerOpponentRequest struct {
+	common_schema.Request
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Aggression  int    `json:"aggression,omitempty"`
+	IQ          int    `json:"iq,omitempty"`
+}
+
+type MechaTacticsGameComputerOpponentQueryParams struct {
+	common_schema.QueryParamsPagination
+	MechaTacticsGameComputerOpponentResponseData
+}
@@ -0,0 +1,50 @@
+package mecha_tactics_game_schema
+
+import (
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/schema/api/common_schema"
+)
+
+type MechaTacticsGameHexResponseData struct {
+	ID                            string     `json:"id"`
+	GameID                        string     `json:"game_id"`
+	MechaTacticsGameTerrainTypeID string     `json:"mecha_tactics_game_terrain_type_id"`
+	HexColumn                     int        `json:"hex_column"`
+	HexRow                        int        `json:"hex_row"`
+	Name                          string     `json:"name"`
+	Description                   string     `json:"description"`
+	Elevation                     int        `json:"elevation"`
+	IsStartingHex                 bool       `json:"is_starting_hex"`
+	CreatedAt                     time.Time  `json:"created_at"`
+	UpdatedAt                     *time.Time `json:"updated_at,omitempty"`
+	DeletedAt                     *time.Time `json:"deleted_at,omitempty"`
+}
+
+type MechaTacticsGameHexResponse struct {
+	Data       *MechaTacticsGameHexResponseData  `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+type MechaTacticsGameHexCollectionResponse struct {
+	Data       []*MechaTacticsGameHexResponseData `json:"data"`
+	Error      *common_schema.ResponseError       `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination  `json:"pagination,omitempty"`
+}
+
+type MechaTacticsGameHexRequest struct {
+	common_schema.Request
+	MechaTacticsGameTerrainTypeID string `json:"mecha_tactics_game_terrain_type_id"`
+	HexColumn                     int    `json:"hex_column"`
+	HexRow                        int    `json:"hex_row"`
+	Name                          string `json:"name,omitempty"`
+	Description                   string `json:"description,omitempty"`
+	Elevation                     int    `json:"elevation,omitempty"`
+	IsStartingHex                 bool   `json:"is_starting_hex,omitempty"`
+}
+
+type MechaTacticsGameHexQueryParams struct {
+	common_schema.QueryParamsPagination
+	MechaTacticsGameHexResponseData
+}
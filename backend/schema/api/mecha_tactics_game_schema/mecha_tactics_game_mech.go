@@ -0,0 +1,49 @@
+package mecha_tactics_game_schema
+
+import (
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/schema/api/common_schema"
+)
+
+type WeaponConfigEntry struct {
+	WeaponID     string `json:"weapon_id"`
+	SlotLocation string `json:"slot_location"`
+}
+
+type MechaTacticsGameMechResponseData struct {
+	ID                        string              `json:"id"`
+	GameID                    string              `json:"game_id"`
+	MechaTacticsGameChassisID string              `json:"mecha_tactics_game_chassis_id"`
+	MechType                  string              `json:"mech_type"`
+	Callsign                  string              `json:"callsign"`
+	WeaponConfig              []WeaponConfigEntry `json:"weapon_config"`
+	CreatedAt                 time.Time           `json:"created_at"`
+	UpdatedAt                 *time.Time          `json:"updated_at,omitempty"`
+	DeletedAt                 *time.Time          `json:"deleted_at,omitempty"`
+}
+
+type MechaTacticsGameMechResponse struct {
+	Data       *MechaTacticsGameMechResponseData `json:"data"`
+	Error      *common_schema.ResponseError      `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination `json:"pagination,omitempty"`
+}
+
+type MechaTacticsGameMechCollectionResponse struct {
+	Data       []*MechaTacticsGameMechResponseData `json:"data"`
+	Error      *common_schema.ResponseError        `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination   `json:"pagination,omitempty"`
+}
+
+type MechaTacticsGameMechRequest struct {
+	common_schema.Request
+	MechaTacticsGameChassisID string              `json:"mecha_tactics_game_chassis_id"`
+	MechType                  string              `json:"mech_type,omitempty"`
+	Callsign                  string              `json:"callsign"`
+	WeaponConfig              []WeaponConfigEntry `json:"weapon_config,omitempty"`
+}
+
+type MechaTacticsGameMechQueryParams struct {
+	common_schema.QueryParamsPagination
+	MechaTacticsGameMechResponseData
+}
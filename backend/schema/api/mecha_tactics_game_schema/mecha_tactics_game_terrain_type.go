@@ -0,0 +1,44 @@
+package mecha_tactics_game_schema
+
+import (
+	"time"
+
+	"gitlab.com/alienspaces/playbymail/schema/api/common_schema"
+)
+
+type MechaTacticsGameTerrainTypeResponseData struct {
+	ID                string     `json:"id"`
+	GameID            string     `json:"game_id"`
+	Name              string     `json:"name"`
+	Description       string     `json:"description"`
+	MovementPointCost int        `json:"movement_point_cost"`
+	CoverModifier     int        `json:"cover_modifier"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
+}
+
+type MechaTacticsGameTerrainTypeResponse struct {
+	Data       *MechaTacticsGameTerrainTypeResponseData `json:"data"`
+	Error      *common_schema.ResponseError             `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination        `json:"pagination,omitempty"`
+}
+
+type MechaTacticsGameTerrainTypeCollectionResponse struct {
+	Data       []*MechaTacticsGameTerrainTypeResponseData `json:"data"`
+	Error      *common_schema.ResponseError               `json:"error,omitempty"`
+	Pagination *common_schema.ResponsePagination          `json:"pagination,omitempty"`
+}
+
+type MechaTacticsGameTerrainTypeRequest struct {
+	common_schema.Request
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	MovementPointCost int    `json:"movement_point_cost,omitempty"`
+	CoverModifier     int    `json:"cover_modifier,omitempty"`
+}
+
+type MechaTacticsGameTerrainTypeQueryParams struct {
+	common_schema.QueryParamsPagination
+	MechaTacticsGameTerrainTypeResponseData
+}
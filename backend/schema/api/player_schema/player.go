@@ -13,3 +13,17 @@ type VerifyGameSubscriptionTokenRequest struct {
 type VerifyGameSubscriptionTokenResponse struct {
 	SessionToken string `json:"session_token"`
 }
+
+// UpdateGameSubscriptionInstanceStandingOrdersRequest maps to the update standing orders request schema.
+type UpdateGameSubscriptionInstanceStandingOrdersRequest struct {
+	StandingOrders map[string]string `json:"standing_orders"`
+}
+
+// GameSubscriptionInstanceStandingOrdersResponse maps to the standing orders response schema.
+type GameSubscriptionInstanceStandingOrdersResponse struct {
+	GameSubscriptionInstanceID string              `json:"game_subscription_instance_id"`
+	MissedTurnPolicy           string              `json:"missed_turn_policy"`
+	MissedTurns                int                 `json:"missed_turns"`
+	StandingOrders             map[string]string   `json:"standing_orders"`
+	AvailableStandingOrders    map[string][]string `json:"available_standing_orders"`
+}
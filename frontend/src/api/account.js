@@ -86,3 +86,30 @@ export async function deleteAccountContact(accountId, accountUserId, contactId)
   await handleApiError(res, 'Failed to delete account contact');
   return true;
 }
+
+export async function getSessions() {
+  const res = await apiFetch(`${baseUrl}/api/v1/me/sessions`, {
+    headers: { 'Content-Type': 'application/json', ...getAuthHeaders() }
+  });
+  await handleApiError(res, 'Failed to fetch sessions');
+  const data = await res.json();
+  return data.data || [];
+}
+
+export async function revokeSession(sessionId) {
+  const res = await apiFetch(`${baseUrl}/api/v1/me/sessions/${sessionId}`, {
+    method: 'DELETE',
+    headers: { 'Content-Type': 'application/json', ...getAuthHeaders() }
+  });
+  await handleApiError(res, 'Failed to sign out session');
+  return true;
+}
+
+export async function revokeAllSessions() {
+  const res = await apiFetch(`${baseUrl}/api/v1/me/sessions`, {
+    method: 'DELETE',
+    headers: { 'Content-Type': 'application/json', ...getAuthHeaders() }
+  });
+  await handleApiError(res, 'Failed to sign out all sessions');
+  return true;
+}
@@ -20,6 +20,9 @@ import {
   createAccountContact,
   updateAccountContact,
   deleteAccountContact,
+  getSessions,
+  revokeSession,
+  revokeAllSessions,
 } from './account'
 
 describe('account API', () => {
@@ -246,4 +249,52 @@ describe('account API', () => {
       expect(result).toBe(true)
     })
   })
+
+  describe('getSessions', () => {
+    it('calls GET /api/v1/me/sessions and returns data', async () => {
+      const sessions = [{ id: 's1', current: true }, { id: 's2', current: false }]
+      mockApiFetch.mockResolvedValue({
+        ok: true,
+        json: () => Promise.resolve({ data: sessions }),
+      })
+
+      const result = await getSessions()
+
+      expect(mockApiFetch).toHaveBeenCalledWith(
+        'http://localhost:8080/api/v1/me/sessions',
+        expect.objectContaining({
+          headers: expect.objectContaining({ Authorization: 'Bearer test-token' }),
+        }),
+      )
+      expect(result).toEqual(sessions)
+    })
+  })
+
+  describe('revokeSession', () => {
+    it('sends DELETE to /api/v1/me/sessions/:sessionId', async () => {
+      mockApiFetch.mockResolvedValue({ ok: true })
+
+      const result = await revokeSession('s2')
+
+      expect(mockApiFetch).toHaveBeenCalledWith(
+        'http://localhost:8080/api/v1/me/sessions/s2',
+        expect.objectContaining({ method: 'DELETE' }),
+      )
+      expect(result).toBe(true)
+    })
+  })
+
+  describe('revokeAllSessions', () => {
+    it('sends DELETE to /api/v1/me/sessions', async () => {
+      mockApiFetch.mockResolvedValue({ ok: true })
+
+      const result = await revokeAllSessions()
+
+      expect(mockApiFetch).toHaveBeenCalledWith(
+        'http://localhost:8080/api/v1/me/sessions',
+        expect.objectContaining({ method: 'DELETE' }),
+      )
+      expect(result).toBe(true)
+    })
+  })
 })
@@ -0,0 +1,73 @@
+import { baseUrl, getAuthHeaders, apiFetch, handleApiError } from './baseUrl';
+
+/**
+ * Upload a bulk scan of returned turn sheets for a game instance
+ * @param {string} gameId - The game ID
+ * @param {string} instanceId - The game instance ID
+ * @param {File} file - The multi-page PDF, ZIP file or page image to upload
+ * @returns {Promise<Object>} - The created scan batch
+ */
+export async function uploadGameScanBatch(gameId, instanceId, file) {
+  const formData = new FormData();
+  formData.append('file', file);
+
+  const res = await apiFetch(`${baseUrl}/api/v1/manager/games/${gameId}/instances/${instanceId}/scan-batches`, {
+    method: 'POST',
+    headers: { ...getAuthHeaders() },
+    body: formData,
+  });
+
+  await handleApiError(res, 'Failed to upload scan batch');
+
+  return await res.json();
+}
+
+/**
+ * Get the scan batches uploaded for a game instance
+ * @param {string} gameId - The game ID
+ * @param {string} instanceId - The game instance ID
+ * @returns {Promise<Object>} - The scan batches
+ */
+export async function getGameScanBatches(gameId, instanceId) {
+  const res = await apiFetch(`${baseUrl}/api/v1/manager/games/${gameId}/instances/${instanceId}/scan-batches`, {
+    headers: { 'Content-Type': 'application/json', ...getAuthHeaders() },
+  });
+
+  await handleApiError(res, 'Failed to fetch scan batches');
+
+  return await res.json();
+}
+
+/**
+ * Get a scan batch, including its processing progress
+ * @param {string} gameId - The game ID
+ * @param {string} instanceId - The game instance ID
+ * @param {string} scanBatchId - The scan batch ID
+ * @returns {Promise<Object>} - The scan batch
+ */
+export async function getGameScanBatch(gameId, instanceId, scanBatchId) {
+  const res = await apiFetch(`${baseUrl}/api/v1/manager/games/${gameId}/instances/${instanceId}/scan-batches/${scanBatchId}`, {
+    headers: { 'Content-Type': 'application/json', ...getAuthHeaders() },
+  });
+
+  await handleApiError(res, 'Failed to fetch scan batch');
+
+  return await res.json();
+}
+
+/**
+ * Get the page outcomes of a scan batch
+ * @param {string} gameId - The game ID
+ * @param {string} instanceId - The game instance ID
+ * @param {string} scanBatchId - The scan batch ID
+ * @returns {Promise<Object>} - The scan batch pages
+ */
+export async function getGameScanBatchPages(gameId, instanceId, scanBatchId) {
+  const res = await apiFetch(`${baseUrl}/api/v1/manager/games/${gameId}/instances/${instanceId}/scan-batches/${scanBatchId}/pages`, {
+    headers: { 'Content-Type': 'application/json', ...getAuthHeaders() },
+  });
+
+  await handleApiError(res, 'Failed to fetch scan batch pages');
+
+  return await res.json();
+}
@@ -0,0 +1,110 @@
+import { describe, it, expect, vi, beforeEach } from 'vitest'
+
+const mockApiFetch = vi.fn()
+const mockHandleApiError = vi.fn()
+
+vi.mock('./baseUrl', () => ({
+  baseUrl: 'http://localhost:8080',
+  getAuthHeaders: () => ({ Authorization: 'Bearer test-token' }),
+  apiFetch: (...args) => mockApiFetch(...args),
+  handleApiError: (...args) => mockHandleApiError(...args),
+}))
+
+import {
+  uploadGameScanBatch,
+  getGameScanBatches,
+  getGameScanBatch,
+  getGameScanBatchPages,
+} from './gameScanBatches'
+
+describe('gameScanBatches API', () => {
+  beforeEach(() => {
+    vi.clearAllMocks()
+    mockHandleApiError.mockImplementation((res) => res)
+  })
+
+  describe('uploadGameScanBatch', () => {
+    it('calls POST with FormData containing the file', async () => {
+      const responseData = { data: { id: 'batch-1', status: 'pending' } }
+      mockApiFetch.mockResolvedValue({
+        ok: true,
+        json: () => Promise.resolve(responseData),
+      })
+
+      const result = await uploadGameScanBatch('game-1', 'instance-1', { name: 'scan.pdf' })
+
+      expect(mockApiFetch).toHaveBeenCalledWith(
+        'http://localhost:8080/api/v1/manager/games/game-1/instances/instance-1/scan-batches',
+        expect.objectContaining({
+          method: 'POST',
+          headers: expect.objectContaining({ Authorization: 'Bearer test-token' }),
+        })
+      )
+      const callOptions = mockApiFetch.mock.calls[0][1]
+      expect(callOptions.body).toBeInstanceOf(FormData)
+      expect(callOptions.body.has('file')).toBe(true)
+      expect(result).toEqual(responseData)
+    })
+  })
+
+  describe('getGameScanBatches', () => {
+    it('calls GET for the instance scan batches', async () => {
+      const responseData = { data: [] }
+      mockApiFetch.mockResolvedValue({
+        ok: true,
+        json: () => Promise.resolve(responseData),
+      })
+
+      const result = await getGameScanBatches('game-1', 'instance-1')
+
+      expect(mockApiFetch).toHaveBeenCalledWith(
+        'http://localhost:8080/api/v1/manager/games/game-1/instances/instance-1/scan-batches',
+        expect.any(Object)
+      )
+      expect(result).toEqual(responseData)
+    })
+  })
+
+  describe('getGameScanBatch', () => {
+    it('calls GET for a single scan batch', async () => {
+      const responseData = { data: { id: 'batch-1' } }
+      mockApiFetch.mockResolvedValue({
+        ok: true,
+        json: () => Promise.resolve(responseData),
+      })
+
+      const result = await getGameScanBatch('game-1', 'instance-1', 'batch-1')
+
+      expect(mockApiFetch).toHaveBeenCalledWith(
+        'http://localhost:8080/api/v1/manager/games/game-1/instances/instance-1/scan-batches/batch-1',
+        expect.any(Object)
+      )
+      expect(result).toEqual(responseData)
+    })
+  })
+
+  describe('getGameScanBatchPages', () => {
+    it('calls GET for the scan batch pages', async () => {
+      const responseData = { data: [{ page_number: 1, status: 'matched' }] }
+      mockApiFetch.mockResolvedValue({
+        ok: true,
+        json: () => Promise.resolve(responseData),
+      })
+
+      const result = await getGameScanBatchPages('game-1', 'instance-1', 'batch-1')
+
+      expect(mockApiFetch).toHaveBeenCalledWith(
+        'http://localhost:8080/api/v1/manager/games/game-1/instances/instance-1/scan-batches/batch-1/pages',
+        expect.any(Object)
+      )
+      expect(result).toEqual(responseData)
+    })
+
+    it('propagates API errors', async () => {
+      mockApiFetch.mockResolvedValue({ ok: false })
+      mockHandleApiError.mockRejectedValue(new Error('Failed to fetch scan batch pages'))
+
+      await expect(getGameScanBatchPages('game-1', 'instance-1', 'batch-1')).rejects.toThrow('Failed to fetch scan batch pages')
+    })
+  })
+})
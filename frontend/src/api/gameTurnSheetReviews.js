@@ -0,0 +1,89 @@
+import { baseUrl, getAuthHeaders, apiFetch, handleApiError } from './baseUrl';
+
+/**
+ * Get the scanned turn sheets waiting on review for a game instance
+ * @param {string} gameId - The game ID
+ * @param {string} instanceId - The game instance ID
+ * @returns {Promise<Object>} - The turn sheet reviews
+ */
+export async function getGameTurnSheetReviews(gameId, instanceId) {
+  const res = await apiFetch(`${baseUrl}/api/v1/manager/games/${gameId}/instances/${instanceId}/turn-sheet-reviews`, {
+    headers: { 'Content-Type': 'application/json', ...getAuthHeaders() },
+  });
+
+  await handleApiError(res, 'Failed to fetch turn sheet reviews');
+
+  return await res.json();
+}
+
+/**
+ * Get a scanned turn sheet review, including the scanned data and issues found
+ * @param {string} gameId - The game ID
+ * @param {string} instanceId - The game instance ID
+ * @param {string} turnSheetId - The game turn sheet ID
+ * @returns {Promise<Object>} - The turn sheet review
+ */
+export async function getGameTurnSheetReview(gameId, instanceId, turnSheetId) {
+  const res = await apiFetch(`${baseUrl}/api/v1/manager/games/${gameId}/instances/${instanceId}/turn-sheet-reviews/${turnSheetId}`, {
+    headers: { 'Content-Type': 'application/json', ...getAuthHeaders() },
+  });
+
+  await handleApiError(res, 'Failed to fetch turn sheet review');
+
+  return await res.json();
+}
+
+/**
+ * Get the scanned image of a turn sheet waiting on review
+ * @param {string} gameId - The game ID
+ * @param {string} instanceId - The game instance ID
+ * @param {string} turnSheetId - The game turn sheet ID
+ * @returns {Promise<Blob>} - The scanned image
+ */
+export async function getGameTurnSheetReviewImage(gameId, instanceId, turnSheetId) {
+  const res = await apiFetch(`${baseUrl}/api/v1/manager/games/${gameId}/instances/${instanceId}/turn-sheet-reviews/${turnSheetId}/image`, {
+    headers: { ...getAuthHeaders() },
+  });
+
+  await handleApiError(res, 'Failed to fetch scanned turn sheet image');
+
+  return await res.blob();
+}
+
+/**
+ * Correct the scanned data of a turn sheet waiting on review
+ * @param {string} gameId - The game ID
+ * @param {string} instanceId - The game instance ID
+ * @param {string} turnSheetId - The game turn sheet ID
+ * @param {Object} scannedData - The corrected scanned data
+ * @returns {Promise<Object>} - The updated turn sheet review
+ */
+export async function updateGameTurnSheetReview(gameId, instanceId, turnSheetId, scannedData) {
+  const res = await apiFetch(`${baseUrl}/api/v1/manager/games/${gameId}/instances/${instanceId}/turn-sheet-reviews/${turnSheetId}`, {
+    method: 'PUT',
+    headers: { 'Content-Type': 'application/json', ...getAuthHeaders() },
+    body: JSON.stringify({ scanned_data: scannedData }),
+  });
+
+  await handleApiError(res, 'Failed to update turn sheet review');
+
+  return await res.json();
+}
+
+/**
+ * Approve the scanned data of a turn sheet waiting on review
+ * @param {string} gameId - The game ID
+ * @param {string} instanceId - The game instance ID
+ * @param {string} turnSheetId - The game turn sheet ID
+ * @returns {Promise<Object>} - The approved turn sheet review
+ */
+export async function approveGameTurnSheetReview(gameId, instanceId, turnSheetId) {
+  const res = await apiFetch(`${baseUrl}/api/v1/manager/games/${gameId}/instances/${instanceId}/turn-sheet-reviews/${turnSheetId}/approve`, {
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...getAuthHeaders() },
+  });
+
+  await handleApiError(res, 'Failed to approve turn sheet review');
+
+  return await res.json();
+}
@@ -0,0 +1,102 @@
+import { describe, it, expect, vi, beforeEach } from 'vitest'
+
+const mockApiFetch = vi.fn()
+const mockHandleApiError = vi.fn()
+
+vi.mock('./baseUrl', () => ({
+  baseUrl: 'http://localhost:8080',
+  getAuthHeaders: () => ({ Authorization: 'Bearer test-token' }),
+  apiFetch: (...args) => mockApiFetch(...args),
+  handleApiError: (...args) => mockHandleApiError(...args),
+}))
+
+import {
+  getGameTurnSheetReviews,
+  getGameTurnSheetReviewImage,
+  updateGameTurnSheetReview,
+  approveGameTurnSheetReview,
+} from './gameTurnSheetReviews'
+
+const reviewsUrl = 'http://localhost:8080/api/v1/manager/games/game-1/instances/instance-1/turn-sheet-reviews'
+
+describe('gameTurnSheetReviews API', () => {
+  beforeEach(() => {
+    vi.clearAllMocks()
+    mockHandleApiError.mockImplementation((res) => res)
+  })
+
+  describe('getGameTurnSheetReviews', () => {
+    it('calls GET for the instance turn sheet reviews', async () => {
+      const responseData = { data: [] }
+      mockApiFetch.mockResolvedValue({
+        ok: true,
+        json: () => Promise.resolve(responseData),
+      })
+
+      const result = await getGameTurnSheetReviews('game-1', 'instance-1')
+
+      expect(mockApiFetch).toHaveBeenCalledWith(
+        reviewsUrl,
+        expect.objectContaining({
+          headers: expect.objectContaining({ Authorization: 'Bearer test-token' }),
+        })
+      )
+      expect(result).toEqual(responseData)
+    })
+  })
+
+  describe('getGameTurnSheetReviewImage', () => {
+    it('returns the scanned image as a blob', async () => {
+      const blob = new Blob(['image'])
+      mockApiFetch.mockResolvedValue({
+        ok: true,
+        blob: () => Promise.resolve(blob),
+      })
+
+      const result = await getGameTurnSheetReviewImage('game-1', 'instance-1', 'sheet-1')
+
+      expect(mockApiFetch).toHaveBeenCalledWith(`${reviewsUrl}/sheet-1/image`, expect.any(Object))
+      expect(result).toBe(blob)
+    })
+  })
+
+  describe('updateGameTurnSheetReview', () => {
+    it('calls PUT with the corrected scanned data', async () => {
+      const responseData = { data: { game_turn_sheet_id: 'sheet-1' } }
+      mockApiFetch.mockResolvedValue({
+        ok: true,
+        json: () => Promise.resolve(responseData),
+      })
+
+      const scannedData = { choices: ['location-1'] }
+      const result = await updateGameTurnSheetReview('game-1', 'instance-1', 'sheet-1', scannedData)
+
+      expect(mockApiFetch).toHaveBeenCalledWith(
+        `${reviewsUrl}/sheet-1`,
+        expect.objectContaining({
+          method: 'PUT',
+          body: JSON.stringify({ scanned_data: scannedData }),
+        })
+      )
+      expect(result).toEqual(responseData)
+    })
+  })
+
+  describe('approveGameTurnSheetReview', () => {
+    it('calls POST to approve the turn sheet', async () => {
+      const responseData = { data: { game_turn_sheet_id: 'sheet-1', processing_status: 'processed' } }
+      mockApiFetch.mockResolvedValue({
+        ok: true,
+        json: () => Promise.resolve(responseData),
+      })
+
+      const result = await approveGameTurnSheetReview('game-1', 'instance-1', 'sheet-1')
+
+      expect(mockApiFetch).toHaveBeenCalledWith(
+        `${reviewsUrl}/sheet-1/approve`,
+        expect.objectContaining({ method: 'POST' })
+      )
+      expect(result).toEqual(responseData)
+    })
+  })
+})
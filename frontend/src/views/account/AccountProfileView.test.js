@@ -5,23 +5,34 @@ const mockGetMe = vi.fn()
 const mockGetAccount = vi.fn()
 const mockUpdateAccount = vi.fn()
 const mockDeleteAccountUser = vi.fn()
+const mockGetSessions = vi.fn()
+const mockRevokeSession = vi.fn()
+const mockRevokeAllSessions = vi.fn()
 const mockSetAccountTimezone = vi.fn()
+const mockLogout = vi.fn()
 
 vi.mock('@/api/account', () => ({
   getMe: (...args) => mockGetMe(...args),
   getAccount: (...args) => mockGetAccount(...args),
   updateAccount: (...args) => mockUpdateAccount(...args),
   deleteAccountUser: (...args) => mockDeleteAccountUser(...args),
+  getSessions: (...args) => mockGetSessions(...args),
+  revokeSession: (...args) => mockRevokeSession(...args),
+  revokeAllSessions: (...args) => mockRevokeAllSessions(...args),
 }))
 
 vi.mock('@/stores/auth', () => ({
   useAuthStore: vi.fn(() => ({
     accountTimezone: null,
     setAccountTimezone: mockSetAccountTimezone,
-    logout: vi.fn(),
+    logout: mockLogout,
   })),
 }))
 
+vi.mock('vue-router', () => ({
+  useRouter: vi.fn(() => ({ push: vi.fn() })),
+}))
+
 vi.mock('@/utils/dateFormat', () => ({
   formatDateTime: vi.fn((str) => (str ? 'Formatted: ' + str : 'N/A')),
 }))
@@ -87,6 +98,22 @@ describe('AccountProfileView', () => {
     created_at: '2026-01-01T00:00:00Z',
   }
   const accountData = { id: 'acct-1', name: 'Test Player', timezone: null }
+  const sessions = [
+    {
+      id: 'session-1',
+      account_user_id: 'user-1',
+      user_agent: 'Desktop Browser',
+      current: true,
+      last_seen_at: '2026-01-02T00:00:00Z',
+    },
+    {
+      id: 'session-2',
+      account_user_id: 'user-1',
+      user_agent: 'Phone Browser',
+      current: false,
+      last_seen_at: '2026-01-01T00:00:00Z',
+    },
+  ]
 
   beforeEach(() => {
     vi.clearAllMocks()
@@ -94,6 +121,9 @@ describe('AccountProfileView', () => {
     mockGetAccount.mockResolvedValue(accountData)
     mockUpdateAccount.mockResolvedValue({ ...accountData, name: 'Updated' })
     mockDeleteAccountUser.mockResolvedValue(true)
+    mockGetSessions.mockResolvedValue(sessions)
+    mockRevokeSession.mockResolvedValue(true)
+    mockRevokeAllSessions.mockResolvedValue(true)
   })
 
   it('loads account data on mount', async () => {
@@ -183,7 +213,7 @@ describe('AccountProfileView', () => {
 
     // Find the timezone Edit button (second Edit button)
     const editBtns = wrapper.findAll('button').filter((b) => b.text().trim() === 'Edit')
-    await editBtns[editBtns.length - 1].trigger('click')
+    await editBtns[1].trigger('click')
 
     // Set timezone select
     const select = wrapper.find('select.timezone-select')
@@ -197,4 +227,75 @@ describe('AccountProfileView', () => {
     expect(mockUpdateAccount).toHaveBeenCalledWith('acct-1', { timezone: 'America/New_York' })
     expect(mockSetAccountTimezone).toHaveBeenCalledWith('America/New_York')
   })
+
+  it('turns off turn reminder emails via updateAccount', async () => {
+    mockUpdateAccount.mockResolvedValue({ ...accountData, turn_reminder_opt_out: true })
+    const wrapper = mount(AccountProfileView)
+    await flushPromises()
+
+    const toggleBtn = wrapper.findAll('button').find((b) => b.text().trim() === 'Turn Off')
+    await toggleBtn.trigger('click')
+    await flushPromises()
+
+    expect(mockUpdateAccount).toHaveBeenCalledWith('acct-1', { turn_reminder_opt_out: true })
+    expect(wrapper.text()).toContain('Turn On')
+  })
+
+  it('saves language via updateAccount when language is changed', async () => {
+    mockUpdateAccount.mockResolvedValue({ ...accountData, locale: 'es' })
+    const wrapper = mount(AccountProfileView)
+    await flushPromises()
+
+    expect(wrapper.text()).toContain('English')
+
+    // Find the language Edit button (third Edit button)
+    const editBtns = wrapper.findAll('button').filter((b) => b.text().trim() === 'Edit')
+    await editBtns[2].trigger('click')
+
+    const select = wrapper.find('select.locale-select')
+    await select.setValue('es')
+
+    const saveBtn = wrapper.findAll('button').find((b) => b.text().trim() === 'Save')
+    await saveBtn.trigger('click')
+    await flushPromises()
+
+    expect(mockUpdateAccount).toHaveBeenCalledWith('acct-1', { locale: 'es' })
+    expect(wrapper.text()).toContain('Español')
+  })
+
+  it('lists signed-in devices', async () => {
+    const wrapper = mount(AccountProfileView)
+    await flushPromises()
+
+    expect(mockGetSessions).toHaveBeenCalled()
+    expect(wrapper.text()).toContain('This device')
+    expect(wrapper.text()).toContain('Desktop Browser')
+    expect(wrapper.text()).toContain('Phone Browser')
+  })
+
+  it('signs out another device via revokeSession', async () => {
+    const wrapper = mount(AccountProfileView)
+    await flushPromises()
+
+    // Only devices other than the current one can be signed out individually
+    const signOutBtns = wrapper.findAll('button').filter((b) => b.text().trim() === 'Sign Out')
+    expect(signOutBtns).toHaveLength(1)
+    await signOutBtns[0].trigger('click')
+    await flushPromises()
+
+    expect(mockRevokeSession).toHaveBeenCalledWith('session-2')
+    expect(mockGetSessions).toHaveBeenCalledTimes(2)
+  })
+
+  it('signs out everywhere via revokeAllSessions and logs out', async () => {
+    const wrapper = mount(AccountProfileView)
+    await flushPromises()
+
+    const btn = wrapper.findAll('button').find((b) => b.text().trim() === 'Sign Out Everywhere')
+    await btn.trigger('click')
+    await flushPromises()
+
+    expect(mockRevokeAllSessions).toHaveBeenCalled()
+    expect(mockLogout).toHaveBeenCalled()
+  })
 })
@@ -60,13 +60,17 @@ describe('StudioSectorsView', () => {
     const headerTexts = ths.map(th => th.text());
     expect(headerTexts).toContain('Name');
     expect(headerTexts).toContain('Description');
+    expect(headerTexts).toContain('Terrain');
     expect(headerTexts).toContain('Starting');
+    expect(headerTexts).toContain('Objective');
+    expect(headerTexts).toContain('VP');
     expect(headerTexts).toContain('Actions');
 
     const tds = wrapper.findAll('td');
     const cellTexts = tds.map(td => td.text());
     expect(cellTexts).toContain('Drop Zone');
     expect(cellTexts).toContain('The staging area.');
+    expect(cellTexts).toContain('urban');
   });
 
   it('formats is_starting_sector boolean values correctly', async () => {